@@ -9,3 +9,28 @@ type CacheKeysContract struct {
 type ErrorContract struct {
 	Status string `json:"status"`
 }
+
+// CacheTypeStatsContract is a data contract used for usage counters of one cache type.
+type CacheTypeStatsContract struct {
+	Entries int64 `json:"entries"`
+	Bytes   int64 `json:"bytes"`
+	Evicted int64 `json:"evicted"`
+	Reaped  int64 `json:"reaped"`
+}
+
+// CacheStatsContract is a data contract used for usage counters of all cache types.
+type CacheStatsContract struct {
+	String      CacheTypeStatsContract `json:"string"`
+	List        CacheTypeStatsContract `json:"list"`
+	Dictionary  CacheTypeStatsContract `json:"dictionary"`
+	Set         CacheTypeStatsContract `json:"set"`
+	SortedSet   CacheTypeStatsContract `json:"sortedset"`
+	Document    CacheTypeStatsContract `json:"document"`
+	HyperLogLog CacheTypeStatsContract `json:"hyperloglog"`
+	BloomFilter CacheTypeStatsContract `json:"bloom"`
+	Stream      CacheTypeStatsContract `json:"stream"`
+	Geo         CacheTypeStatsContract `json:"geo"`
+	Queue       CacheTypeStatsContract `json:"queue"`
+	RateLimit   CacheTypeStatsContract `json:"ratelimit"`
+	Lock        CacheTypeStatsContract `json:"lock"`
+}
@@ -0,0 +1,76 @@
+package contracts
+
+// SetBitStringCacheContract is used to set the bit of string value using API, bit 0 is the most significant bit of the first byte.
+// If create flag is set the missing key is created with zero bytes and the TTL specified.
+type SetBitStringCacheContract struct {
+	Offset  int64  `form:"offset" json:"offset"`
+	Bit     int32  `form:"bit" json:"bit"`
+	Create  bool   `form:"create" json:"create"`
+	TTL     string `form:"ttl" json:"ttl"`
+	Sliding bool   `form:"sliding" json:"sliding"`
+	Version int64  `form:"version" json:"version"`
+}
+
+// StringCacheBitContract is used to serialize the bit of string value via API, the previous bit is returned when the bit is set.
+type StringCacheBitContract struct {
+	Key     string `json:"key"`
+	Bit     int32  `json:"bit"`
+	Version int64  `json:"version"`
+}
+
+// StringCacheBitCountContract is used to serialize the number of bits set in string value via API.
+type StringCacheBitCountContract struct {
+	Key     string `json:"key"`
+	Count   int64  `json:"count"`
+	Version int64  `json:"version"`
+}
+
+// StringCacheBitPosContract is used to serialize the position of the first bit found via API, -1 means that the bit was not found.
+type StringCacheBitPosContract struct {
+	Key      string `json:"key"`
+	Position int64  `json:"position"`
+	Version  int64  `json:"version"`
+}
+
+// BitOpStringCacheContract is used to store the result of and, or, xor or not operation on the values of the keys using API.
+// Missing keys are treated as empty values.
+type BitOpStringCacheContract struct {
+	Op      string   `form:"op" json:"op" binding:"required"`
+	Keys    []string `form:"keys" json:"keys" binding:"required"`
+	Version int64    `form:"version" json:"version"`
+}
+
+// StringCacheBitOpContract is used to serialize the length in bytes of the value stored by bitwise operation via API.
+type StringCacheBitOpContract struct {
+	Key     string `json:"key"`
+	Length  int64  `json:"length"`
+	Version int64  `json:"version"`
+}
+
+// BitFieldOperationContract is a get, set or incrby subcommand of bitfield request.
+// Type is i1-i64 or u1-u63, offset is in bits or prefixed with "#" to be multiplied by the type width.
+// Overflow is wrap, sat or fail, wrap by default.
+type BitFieldOperationContract struct {
+	Op       string `form:"op" json:"op" binding:"required"`
+	Type     string `form:"type" json:"type" binding:"required"`
+	Offset   string `form:"offset" json:"offset" binding:"required"`
+	Value    int64  `form:"value" json:"value"`
+	Overflow string `form:"overflow" json:"overflow"`
+}
+
+// BitFieldStringCacheContract is used to apply bitfield subcommands to string value in order using API.
+// If create flag is set the missing key is created with zero bytes and the TTL specified.
+type BitFieldStringCacheContract struct {
+	Operations []BitFieldOperationContract `form:"operations" json:"operations" binding:"required"`
+	Create     bool                        `form:"create" json:"create"`
+	TTL        string                      `form:"ttl" json:"ttl"`
+	Sliding    bool                        `form:"sliding" json:"sliding"`
+	Version    int64                       `form:"version" json:"version"`
+}
+
+// StringCacheBitFieldContract is used to serialize the results of bitfield subcommands via API, null means fail overflow.
+type StringCacheBitFieldContract struct {
+	Key     string   `json:"key"`
+	Results []*int64 `json:"results"`
+	Version int64    `json:"version"`
+}
@@ -0,0 +1,40 @@
+package contracts
+
+// BloomFilterCacheInfoContract is used to serialize Bloom filter options and the number of values added via API.
+type BloomFilterCacheInfoContract struct {
+	Key       string  `json:"key"`
+	Capacity  int64   `json:"capacity"`
+	ErrorRate float64 `json:"errorRate"`
+	Bits      uint64  `json:"bits"`
+	Hashes    int32   `json:"hashes"`
+	Count     int64   `json:"count"`
+	Version   int64   `json:"version"`
+}
+
+// NewBloomFilterCacheContract is used to reserve new Bloom filter cache entry using API.
+// Capacity is 100 and error rate is 0.01 by default.
+type NewBloomFilterCacheContract struct {
+	Key       string  `form:"key" json:"key" binding:"required"`
+	Capacity  int64   `form:"capacity" json:"capacity"`
+	ErrorRate float64 `form:"errorRate" json:"errorRate"`
+	TTL       string  `form:"ttl" json:"ttl"`
+	Sliding   bool    `form:"sliding" json:"sliding"`
+}
+
+// AddBloomFilterCacheValuesContract is used to add values to Bloom filter cache entry using API,
+// capacity and error rate are used only when the missing filter is created.
+type AddBloomFilterCacheValuesContract struct {
+	Values    []string `form:"values" json:"values" binding:"required"`
+	Create    bool     `form:"create" json:"create"`
+	Capacity  int64    `form:"capacity" json:"capacity"`
+	ErrorRate float64  `form:"errorRate" json:"errorRate"`
+	Version   int64    `form:"version" json:"version"`
+}
+
+// BloomFilterCacheResultsContract is used to serialize the result for each value checked or added via API.
+type BloomFilterCacheResultsContract struct {
+	Key     string   `json:"key"`
+	Values  []string `json:"values"`
+	Results []bool   `json:"results"`
+	Version int64    `json:"version"`
+}
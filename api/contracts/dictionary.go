@@ -8,24 +8,69 @@ type DictionaryKeyValueContract struct {
 
 // DictionaryCacheValueContract is used to serialize dictionary cache entry via API.
 type DictionaryCacheValueContract struct {
-	Key    string                       `json:"key"`
-	Values []DictionaryKeyValueContract `json:"values"`
+	Key     string                       `json:"key"`
+	Values  []DictionaryKeyValueContract `json:"values"`
+	Version int64                        `json:"version"`
 }
 
 // NewDictionaryCacheValuesContract is used to add new dictionary cache entry using API.
 type NewDictionaryCacheValuesContract struct {
-	Key    string                       `form:"key" json:"key" binding:"required"`
-	Values []DictionaryKeyValueContract `form:"values" json:"values" binding:"required"`
-	TTL    string                       `form:"ttl" json:"ttl"`
+	Key     string                       `form:"key" json:"key" binding:"required"`
+	Values  []DictionaryKeyValueContract `form:"values" json:"values" binding:"required"`
+	TTL     string                       `form:"ttl" json:"ttl"`
+	Sliding bool                         `form:"sliding" json:"sliding"`
 }
 
-// UpdateDictionaryCacheValueContract is used to update dictionary cache entry value using API.
+// UpdateDictionaryCacheValueContract is used to update dictionary cache entry value using API, original value or version is required.
 type UpdateDictionaryCacheValueContract struct {
 	Value    string `form:"value" json:"value" binding:"required"`
-	Original string `form:"original" json:"original" binding:"required"`
+	Original string `form:"original" json:"original"`
+	Version  int64  `form:"version" json:"version"`
 }
 
 // AddDictionaryCacheValueContract is used to add new dictionary cache entry value using API.
+// With ttl specified the value expires on its own while the rest of the dictionary is kept.
 type AddDictionaryCacheValueContract struct {
-	Value DictionaryKeyValueContract `form:"value" json:"value" binding:"required"`
+	Value   DictionaryKeyValueContract `form:"value" json:"value" binding:"required"`
+	TTL     string                     `form:"ttl" json:"ttl"`
+	Version int64                      `form:"version" json:"version"`
+}
+
+// DictionaryCacheSubKeyValueContract is used to serialize the value of dictionary sub-key via API.
+type DictionaryCacheSubKeyValueContract struct {
+	Key     string `json:"key"`
+	SubKey  string `json:"subkey"`
+	Value   string `json:"value"`
+	Version int64  `json:"version"`
+}
+
+// SetDictionaryCacheValuesContract is used to add or update many dictionary sub-keys at once using API.
+// If create flag is set the missing key is created with the TTL specified.
+type SetDictionaryCacheValuesContract struct {
+	Values  []DictionaryKeyValueContract `form:"values" json:"values" binding:"required"`
+	Create  bool                         `form:"create" json:"create"`
+	TTL     string                       `form:"ttl" json:"ttl"`
+	Sliding bool                         `form:"sliding" json:"sliding"`
+	Version int64                        `form:"version" json:"version"`
+}
+
+// DictionaryCacheSetResultContract is used to serialize the number of sub-keys added and updated via API.
+type DictionaryCacheSetResultContract struct {
+	Key     string `json:"key"`
+	Added   int32  `json:"added"`
+	Updated int32  `json:"updated"`
+	Version int64  `json:"version"`
+}
+
+// DictionaryCacheSubKeysContract is used to serialize dictionary sub-keys without their values via API.
+type DictionaryCacheSubKeysContract struct {
+	Key     string   `json:"key"`
+	SubKeys []string `json:"subkeys"`
+	Version int64    `json:"version"`
+}
+
+// DictionaryCacheExistsContract is used to serialize the existence of dictionary sub-keys via API.
+type DictionaryCacheExistsContract struct {
+	Key    string          `json:"key"`
+	Exists map[string]bool `json:"exists"`
 }
@@ -0,0 +1,50 @@
+package contracts
+
+import "encoding/json"
+
+// DocumentCacheValueContract is used to serialize document cache entry or the value at its path via API.
+type DocumentCacheValueContract struct {
+	Key     string          `json:"key"`
+	Path    string          `json:"path,omitempty"`
+	Value   json.RawMessage `json:"value"`
+	Version int64           `json:"version"`
+}
+
+// NewDocumentCacheValueContract is used to add new document cache entry using API, the value is any JSON value.
+type NewDocumentCacheValueContract struct {
+	Key     string          `form:"key" json:"key" binding:"required"`
+	Value   json.RawMessage `form:"value" json:"value" binding:"required"`
+	TTL     string          `form:"ttl" json:"ttl"`
+	Sliding bool            `form:"sliding" json:"sliding"`
+}
+
+// UpdateDocumentCachePathContract is used to add or replace the value at the path of the document using API.
+// Empty path replaces the whole document.
+type UpdateDocumentCachePathContract struct {
+	Path    string          `form:"path" json:"path"`
+	Value   json.RawMessage `form:"value" json:"value" binding:"required"`
+	Version int64           `form:"version" json:"version"`
+}
+
+// AppendDocumentCacheValuesContract is used to append values to the array at the path of the document using API.
+type AppendDocumentCacheValuesContract struct {
+	Path    string            `form:"path" json:"path"`
+	Values  []json.RawMessage `form:"values" json:"values" binding:"required"`
+	Version int64             `form:"version" json:"version"`
+}
+
+// DocumentCacheLengthContract is used to serialize the length of the array after the append via API.
+type DocumentCacheLengthContract struct {
+	Key     string `json:"key"`
+	Path    string `json:"path"`
+	Length  int64  `json:"length"`
+	Version int64  `json:"version"`
+}
+
+// DocumentPatchOperationContract is JSON Patch (RFC 6902) operation: add, remove, replace, move, copy or test.
+type DocumentPatchOperationContract struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	From  string          `json:"from,omitempty"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
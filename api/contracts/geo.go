@@ -0,0 +1,77 @@
+package contracts
+
+// GeoMemberContract is a member with its longitude and latitude in degrees for geo API.
+type GeoMemberContract struct {
+	Member    string  `form:"member" json:"member" binding:"required"`
+	Longitude float64 `form:"longitude" json:"longitude"`
+	Latitude  float64 `form:"latitude" json:"latitude"`
+}
+
+// GeoPositionContract is used to serialize the member with its position decoded from the geohash via API.
+type GeoPositionContract struct {
+	Member    string  `json:"member"`
+	Longitude float64 `json:"longitude"`
+	Latitude  float64 `json:"latitude"`
+	Geohash   string  `json:"geohash"`
+}
+
+// GeoSearchResultContract is used to serialize the member found with its distance from the center in the unit requested via API.
+type GeoSearchResultContract struct {
+	GeoPositionContract
+	Distance float64 `json:"distance"`
+}
+
+// GeoCacheMembersContract is used to serialize geo cache entry via API.
+type GeoCacheMembersContract struct {
+	Key     string                `json:"key"`
+	Members []GeoPositionContract `json:"members"`
+	Version int64                 `json:"version"`
+}
+
+// NewGeoCacheContract is used to add new geo cache entry using API.
+type NewGeoCacheContract struct {
+	Key     string              `form:"key" json:"key" binding:"required"`
+	Members []GeoMemberContract `form:"members" json:"members"`
+	TTL     string              `form:"ttl" json:"ttl"`
+	Sliding bool                `form:"sliding" json:"sliding"`
+}
+
+// AddGeoCacheMembersContract is used to add new members or move the existing ones using API.
+// If create flag is set the missing key is created.
+type AddGeoCacheMembersContract struct {
+	Members []GeoMemberContract `form:"members" json:"members" binding:"required"`
+	Create  bool                `form:"create" json:"create"`
+	Version int64               `form:"version" json:"version"`
+}
+
+// GeoCacheAddContract is used to serialize the number of members added and moved via API.
+type GeoCacheAddContract struct {
+	Key     string `json:"key"`
+	Added   int32  `json:"added"`
+	Updated int32  `json:"updated"`
+	Version int64  `json:"version"`
+}
+
+// GeoCachePositionsContract is used to serialize the positions of the members and the members which were not found via API.
+type GeoCachePositionsContract struct {
+	Key       string                `json:"key"`
+	Positions []GeoPositionContract `json:"positions"`
+	Missing   []string              `json:"missing"`
+}
+
+// GeoCacheDistanceContract is used to serialize the distance between two members via API.
+type GeoCacheDistanceContract struct {
+	Key      string  `json:"key"`
+	From     string  `json:"from"`
+	To       string  `json:"to"`
+	Distance float64 `json:"distance"`
+	Unit     string  `json:"unit"`
+}
+
+// GeoCacheSearchContract is used to serialize the members found by radius or box search sorted by distance via API.
+type GeoCacheSearchContract struct {
+	Key     string                    `json:"key"`
+	Unit    string                    `json:"unit"`
+	Results []GeoSearchResultContract `json:"results"`
+	Version int64                     `json:"version"`
+}
@@ -0,0 +1,40 @@
+package contracts
+
+// HyperLogLogCacheCountContract is used to serialize the estimated number of distinct values via API.
+// Keys are set when the count is the estimate of the union of several HyperLogLogs.
+type HyperLogLogCacheCountContract struct {
+	Key     string   `json:"key"`
+	Keys    []string `json:"keys,omitempty"`
+	Count   int64    `json:"count"`
+	Version int64    `json:"version"`
+}
+
+// NewHyperLogLogCacheValuesContract is used to add new HyperLogLog cache entry using API, the values are optional.
+type NewHyperLogLogCacheValuesContract struct {
+	Key     string   `form:"key" json:"key" binding:"required"`
+	Values  []string `form:"values" json:"values"`
+	TTL     string   `form:"ttl" json:"ttl"`
+	Sliding bool     `form:"sliding" json:"sliding"`
+}
+
+// AddHyperLogLogCacheValuesContract is used to add values to HyperLogLog cache entry using API.
+type AddHyperLogLogCacheValuesContract struct {
+	Values  []string `form:"values" json:"values" binding:"required"`
+	Create  bool     `form:"create" json:"create"`
+	Version int64    `form:"version" json:"version"`
+}
+
+// HyperLogLogCacheAddContract is used to serialize the result of adding values to HyperLogLog via API.
+// Changed is true if the estimate could change like PFADD reply.
+type HyperLogLogCacheAddContract struct {
+	Key     string `json:"key"`
+	Changed bool   `json:"changed"`
+	Count   int64  `json:"count"`
+	Version int64  `json:"version"`
+}
+
+// MergeHyperLogLogCacheContract is used to merge HyperLogLogs into the cache entry using API.
+type MergeHyperLogLogCacheContract struct {
+	Keys    []string `form:"keys" json:"keys" binding:"required"`
+	Version int64    `form:"version" json:"version"`
+}
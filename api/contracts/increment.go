@@ -0,0 +1,15 @@
+package contracts
+
+import "encoding/json"
+
+// IncrementCacheValueContract is used to change numeric string cache entry or dictionary value using API.
+// Delta is 1 by default, delta with fraction or exponent or float flag switch to float arithmetic.
+// If create flag is set the missing key is created with zero value and the TTL specified before the increment.
+type IncrementCacheValueContract struct {
+	Delta   json.Number `form:"delta" json:"delta,omitempty"`
+	Float   bool        `form:"float" json:"float"`
+	Create  bool        `form:"create" json:"create"`
+	TTL     string      `form:"ttl" json:"ttl"`
+	Sliding bool        `form:"sliding" json:"sliding"`
+	Version int64       `form:"version" json:"version"`
+}
@@ -2,18 +2,89 @@ package contracts
 
 // ListCacheValueContract is used to serialize list cache entry via API.
 type ListCacheValueContract struct {
-	Key    string   `json:"key"`
-	Values []string `json:"values"`
+	Key     string   `json:"key"`
+	Values  []string `json:"values"`
+	Version int64    `json:"version"`
 }
 
 // NewListCacheValuesContract is used to add new list cache entry using API.
 type NewListCacheValuesContract struct {
-	Key    string   `form:"key" json:"key" binding:"required"`
-	Values []string `form:"values" json:"values" binding:"required"`
-	TTL    string   `form:"ttl" json:"ttl"`
+	Key     string   `form:"key" json:"key" binding:"required"`
+	Values  []string `form:"values" json:"values" binding:"required"`
+	TTL     string   `form:"ttl" json:"ttl"`
+	Sliding bool     `form:"sliding" json:"sliding"`
 }
 
 // UpdateListCacheValueContract is used to update list cache entry using API.
 type UpdateListCacheValueContract struct {
-	Value string `form:"value" json:"value" binding:"required"`
+	Value   string `form:"value" json:"value" binding:"required"`
+	Version int64  `form:"version" json:"version"`
+}
+
+// ListCacheIndexValueContract is used to serialize list value with its index via API.
+type ListCacheIndexValueContract struct {
+	Key     string `json:"key"`
+	Index   int64  `json:"index"`
+	Value   string `json:"value"`
+	Version int64  `json:"version"`
+}
+
+// ListCacheRangeContract is used to serialize the range of list values via API.
+// Start is the index of the first value and Length is the length of the whole list, so the next page starts from Start + len(Values).
+type ListCacheRangeContract struct {
+	Key     string   `json:"key"`
+	Values  []string `json:"values"`
+	Start   int64    `json:"start"`
+	Length  int64    `json:"length"`
+	Version int64    `json:"version"`
+}
+
+// ListCacheLengthContract is used to serialize the length of the list after the update via API.
+type ListCacheLengthContract struct {
+	Key     string `json:"key"`
+	Length  int64  `json:"length"`
+	Version int64  `json:"version"`
+}
+
+// ListCacheTrimContract is used to serialize the result of the list trim via API.
+type ListCacheTrimContract struct {
+	Key     string `json:"key"`
+	Removed int64  `json:"removed"`
+	Length  int64  `json:"length"`
+	Version int64  `json:"version"`
+}
+
+// UpdateListCacheIndexContract is used to set or insert list value by its index using API.
+type UpdateListCacheIndexContract struct {
+	Index   int64  `form:"index" json:"index"`
+	Value   string `form:"value" json:"value" binding:"required"`
+	Version int64  `form:"version" json:"version"`
+}
+
+// RemoveListCacheIndexContract is used to remove list value by its index using API.
+type RemoveListCacheIndexContract struct {
+	Index   int64 `form:"index" json:"index"`
+	Version int64 `form:"version" json:"version"`
+}
+
+// PushListCacheValuesContract is used to add values to the head or the tail of the list using API.
+type PushListCacheValuesContract struct {
+	Values  []string `form:"values" json:"values" binding:"required"`
+	Create  bool     `form:"create" json:"create"`
+	Version int64    `form:"version" json:"version"`
+}
+
+// PopListCacheValuesContract is used to remove values from the head or the tail of the list using API.
+// Count is 1 by default, with timeout specified the request waits for the values if the list is empty or missing.
+type PopListCacheValuesContract struct {
+	Count   int32  `form:"count" json:"count"`
+	Timeout string `form:"timeout" json:"timeout"`
+	Version int64  `form:"version" json:"version"`
+}
+
+// TrimListCacheContract is used to keep only the values from start to stop indexes of the list using API.
+type TrimListCacheContract struct {
+	Start   int64 `form:"start" json:"start"`
+	Stop    int64 `form:"stop" json:"stop"`
+	Version int64 `form:"version" json:"version"`
 }
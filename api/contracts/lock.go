@@ -0,0 +1,33 @@
+package contracts
+
+// AcquireLockContract is used to acquire the lock using API, the owner token is generated if it is not specified.
+type AcquireLockContract struct {
+	Owner string `form:"owner" json:"owner"`
+	Lease string `form:"lease" json:"lease" binding:"required"`
+}
+
+// RenewLockContract is used to extend the lease of the lock held by the owner using API.
+type RenewLockContract struct {
+	Owner string `form:"owner" json:"owner" binding:"required"`
+	Lease string `form:"lease" json:"lease" binding:"required"`
+}
+
+// LockContract is used to serialize the result of the lock acquisition or renewal via API.
+// If the lock is held by another owner the owner is omitted and the lease is the time remaining until the lock expires.
+type LockContract struct {
+	Key      string `json:"key"`
+	Acquired bool   `json:"acquired"`
+	Owner    string `json:"owner,omitempty"`
+	Fence    int64  `json:"fence"`
+	Lease    string `json:"lease,omitempty"`
+	Version  int64  `json:"version"`
+}
+
+// LockInfoContract is used to serialize the held lock via API, the owner token is never exposed.
+type LockInfoContract struct {
+	Key      string `json:"key"`
+	Fence    int64  `json:"fence"`
+	Acquired string `json:"acquired"`
+	Lease    string `json:"lease,omitempty"`
+	Version  int64  `json:"version"`
+}
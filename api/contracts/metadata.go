@@ -0,0 +1,14 @@
+package contracts
+
+// CacheEntryMetadataContract is used to serialize cache entry metadata via API.
+type CacheEntryMetadataContract struct {
+	Key       string `json:"key"`
+	Created   string `json:"created"`
+	Updated   string `json:"updated"`
+	TTL       string `json:"ttl"`
+	Expires   string `json:"expires"`
+	Sliding   bool   `json:"sliding"`
+	Persisted bool   `json:"persisted"`
+	Size      int64  `json:"size"`
+	Version   int64  `json:"version"`
+}
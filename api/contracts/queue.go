@@ -0,0 +1,112 @@
+package contracts
+
+// NewQueueItemContract is an item to be pushed to the queue for queue API.
+// Delay is a duration or the time the item can be delivered after, the items with higher priority are delivered first.
+type NewQueueItemContract struct {
+	Value    string `form:"value" json:"value" binding:"required"`
+	Priority int64  `form:"priority" json:"priority"`
+	Delay    string `form:"delay" json:"delay"`
+}
+
+// QueueItemContract is used to serialize the item of the queue with its receipt and delivery attempts via API.
+// Deadline is the time the delivered item returns to the queue if it is not acknowledged.
+type QueueItemContract struct {
+	ID        uint64 `json:"id"`
+	Receipt   string `json:"receipt"`
+	Value     string `json:"value"`
+	Priority  int64  `json:"priority"`
+	Attempts  int64  `json:"attempts"`
+	NotBefore string `json:"notBefore,omitempty"`
+	Enqueued  string `json:"enqueued"`
+	Deadline  string `json:"deadline,omitempty"`
+}
+
+// QueueCacheInfoContract is used to serialize the number of ready, delayed, in-flight and dead items of queue cache entry via API.
+type QueueCacheInfoContract struct {
+	Key         string `json:"key"`
+	Ready       int64  `json:"ready"`
+	Delayed     int64  `json:"delayed"`
+	InFlight    int64  `json:"inFlight"`
+	Dead        int64  `json:"dead"`
+	Visibility  string `json:"visibility"`
+	MaxAttempts int64  `json:"maxAttempts"`
+	Version     int64  `json:"version"`
+}
+
+// NewQueueCacheContract is used to add new queue cache entry using API.
+// Visibility is the default time the delivered items stay in flight, zero maxAttempts means that the items never die.
+type NewQueueCacheContract struct {
+	Key         string                 `form:"key" json:"key" binding:"required"`
+	Items       []NewQueueItemContract `form:"items" json:"items"`
+	Visibility  string                 `form:"visibility" json:"visibility"`
+	MaxAttempts int64                  `form:"maxAttempts" json:"maxAttempts"`
+	TTL         string                 `form:"ttl" json:"ttl"`
+	Sliding     bool                   `form:"sliding" json:"sliding"`
+}
+
+// PushQueueCacheContract is used to push the items to queue cache entry using API.
+// If create flag is set the missing key is created.
+type PushQueueCacheContract struct {
+	Items   []NewQueueItemContract `form:"items" json:"items" binding:"required"`
+	Create  bool                   `form:"create" json:"create"`
+	Version int64                  `form:"version" json:"version"`
+}
+
+// QueueCachePushContract is used to serialize the IDs of the items pushed via API.
+type QueueCachePushContract struct {
+	Key     string   `json:"key"`
+	IDs     []uint64 `json:"ids"`
+	Version int64    `json:"version"`
+}
+
+// PopQueueCacheContract is used to deliver the items of queue cache entry using API, zero count means one item,
+// empty visibility means the default visibility timeout of the queue.
+type PopQueueCacheContract struct {
+	Count      int32  `form:"count" json:"count"`
+	Visibility string `form:"visibility" json:"visibility"`
+}
+
+// QueueCacheItemsContract is used to serialize the items delivered, peeked or dead via API.
+type QueueCacheItemsContract struct {
+	Key     string              `json:"key"`
+	Items   []QueueItemContract `json:"items"`
+	Version int64               `json:"version"`
+}
+
+// AckQueueCacheContract is used to acknowledge the delivered items by their receipts using API.
+type AckQueueCacheContract struct {
+	Receipts []string `form:"receipts" json:"receipts" binding:"required"`
+}
+
+// QueueCacheAckContract is used to serialize the number of items acknowledged via API.
+type QueueCacheAckContract struct {
+	Key     string `json:"key"`
+	Acked   int32  `json:"acked"`
+	Version int64  `json:"version"`
+}
+
+// NackQueueCacheContract is used to return the delivered item to the queue using API,
+// delay is a duration or the time the item can be delivered again after.
+type NackQueueCacheContract struct {
+	Receipt string `form:"receipt" json:"receipt" binding:"required"`
+	Delay   string `form:"delay" json:"delay"`
+}
+
+// QueueCacheNackContract is used to serialize whether the item returned was moved to the dead letters via API.
+type QueueCacheNackContract struct {
+	Key     string `json:"key"`
+	Dead    bool   `json:"dead"`
+	Version int64  `json:"version"`
+}
+
+// RedriveQueueCacheContract is used to move the dead letters back to the queue using API, zero count means all of them.
+type RedriveQueueCacheContract struct {
+	Count int32 `form:"count" json:"count"`
+}
+
+// QueueCacheRedriveContract is used to serialize the number of dead letters moved back to the queue via API.
+type QueueCacheRedriveContract struct {
+	Key      string `json:"key"`
+	Redriven int32  `json:"redriven"`
+	Version  int64  `json:"version"`
+}
@@ -0,0 +1,36 @@
+package contracts
+
+// TakeRateLimitContract is used to take the cost from the rate limiter using API, the missing rate limiter is created full.
+// Algorithm is token-bucket (default) or sliding-log, refill rate is in units per second.
+// The window of sliding-log is capacity / refillRate unless it is specified, zero cost means 1.
+type TakeRateLimitContract struct {
+	Algorithm  string  `form:"algorithm" json:"algorithm"`
+	Capacity   int64   `form:"capacity" json:"capacity" binding:"required"`
+	RefillRate float64 `form:"refillRate" json:"refillRate"`
+	Window     string  `form:"window" json:"window"`
+	Cost       int64   `form:"cost" json:"cost"`
+}
+
+// RateLimitContract is used to serialize the decision of the rate limiter via API.
+// RetryAfter is the time after which the same cost would be allowed, ResetAfter is the time after which the limiter is full again.
+type RateLimitContract struct {
+	Key        string `json:"key"`
+	Allowed    bool   `json:"allowed"`
+	Limit      int64  `json:"limit"`
+	Remaining  int64  `json:"remaining"`
+	RetryAfter string `json:"retryAfter,omitempty"`
+	ResetAfter string `json:"resetAfter,omitempty"`
+	Version    int64  `json:"version"`
+}
+
+// RateLimitInfoContract is used to serialize the limits and the remaining capacity of the rate limiter via API.
+type RateLimitInfoContract struct {
+	Key        string  `json:"key"`
+	Algorithm  string  `json:"algorithm"`
+	Capacity   int64   `json:"capacity"`
+	RefillRate float64 `json:"refillRate"`
+	Window     string  `json:"window,omitempty"`
+	Remaining  int64   `json:"remaining"`
+	ResetAfter string  `json:"resetAfter,omitempty"`
+	Version    int64   `json:"version"`
+}
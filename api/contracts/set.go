@@ -0,0 +1,41 @@
+package contracts
+
+// SetCacheValuesContract is used to serialize set cache entry via API.
+type SetCacheValuesContract struct {
+	Key     string   `json:"key"`
+	Values  []string `json:"values"`
+	Version int64    `json:"version"`
+}
+
+// NewSetCacheValuesContract is used to add new set cache entry using API, duplicate values are ignored.
+type NewSetCacheValuesContract struct {
+	Key     string   `form:"key" json:"key" binding:"required"`
+	Values  []string `form:"values" json:"values" binding:"required"`
+	TTL     string   `form:"ttl" json:"ttl"`
+	Sliding bool     `form:"sliding" json:"sliding"`
+}
+
+// UpdateSetCacheValuesContract is used to add new members to set cache entry using API.
+type UpdateSetCacheValuesContract struct {
+	Values  []string `form:"values" json:"values" binding:"required"`
+	Version int64    `form:"version" json:"version"`
+}
+
+// SetCacheCountContract is used to serialize the number of set members via API.
+type SetCacheCountContract struct {
+	Key   string `json:"key"`
+	Count int32  `json:"count"`
+}
+
+// SetCacheContainsContract is used to serialize set membership check result via API.
+type SetCacheContainsContract struct {
+	Key      string `json:"key"`
+	Value    string `json:"value"`
+	Contains bool   `json:"contains"`
+}
+
+// SetCacheAlgebraContract is used to serialize union, intersection or difference of set cache entries via API.
+type SetCacheAlgebraContract struct {
+	Keys   []string `json:"keys"`
+	Values []string `json:"values"`
+}
@@ -0,0 +1,56 @@
+package contracts
+
+// SortedSetMemberContract is used to serialize sorted set member and its score via API.
+type SortedSetMemberContract struct {
+	Member string  `form:"member" json:"member"`
+	Score  float64 `form:"score" json:"score"`
+}
+
+// SortedSetCacheValuesContract is used to serialize sorted set cache entry or its range via API.
+type SortedSetCacheValuesContract struct {
+	Key     string                    `json:"key"`
+	Members []SortedSetMemberContract `json:"members"`
+	Version int64                     `json:"version"`
+}
+
+// NewSortedSetCacheValuesContract is used to add new sorted set cache entry using API, the last score wins for duplicate members.
+type NewSortedSetCacheValuesContract struct {
+	Key     string                    `form:"key" json:"key" binding:"required"`
+	Members []SortedSetMemberContract `form:"members" json:"members" binding:"required"`
+	TTL     string                    `form:"ttl" json:"ttl"`
+	Sliding bool                      `form:"sliding" json:"sliding"`
+}
+
+// UpdateSortedSetCacheValuesContract is used to add new members or update scores of existing ones using API.
+type UpdateSortedSetCacheValuesContract struct {
+	Members []SortedSetMemberContract `form:"members" json:"members" binding:"required"`
+	Version int64                     `form:"version" json:"version"`
+}
+
+// IncrementSortedSetCacheScoreContract is used to add delta to the score of sorted set member using API.
+type IncrementSortedSetCacheScoreContract struct {
+	Member  string  `form:"member" json:"member" binding:"required"`
+	Delta   float64 `form:"delta" json:"delta"`
+	Version int64   `form:"version" json:"version"`
+}
+
+// SortedSetCacheScoreContract is used to serialize the score of sorted set member via API.
+type SortedSetCacheScoreContract struct {
+	Key    string  `json:"key"`
+	Member string  `json:"member"`
+	Score  float64 `json:"score"`
+}
+
+// SortedSetCacheRankContract is used to serialize zero-based rank and score of sorted set member via API.
+type SortedSetCacheRankContract struct {
+	Key    string  `json:"key"`
+	Member string  `json:"member"`
+	Rank   int32   `json:"rank"`
+	Score  float64 `json:"score"`
+}
+
+// SortedSetCacheCountContract is used to serialize the number of sorted set members added via API.
+type SortedSetCacheCountContract struct {
+	Key   string `json:"key"`
+	Count int32  `json:"count"`
+}
@@ -0,0 +1,133 @@
+package contracts
+
+// StreamFieldContract is a field of stream entry for stream API.
+type StreamFieldContract struct {
+	Field string `json:"field"`
+	Value string `json:"value"`
+}
+
+// StreamEntryContract is used to serialize stream entry with its ID via API.
+type StreamEntryContract struct {
+	ID     string                `json:"id"`
+	Fields []StreamFieldContract `json:"fields"`
+}
+
+// StreamCacheInfoContract is used to serialize the length, the first and the last IDs of stream cache entry via API.
+type StreamCacheInfoContract struct {
+	Key     string `json:"key"`
+	Length  int64  `json:"length"`
+	MaxLen  int64  `json:"maxLen"`
+	FirstID string `json:"firstId"`
+	LastID  string `json:"lastId"`
+	Groups  int32  `json:"groups"`
+	Version int64  `json:"version"`
+}
+
+// NewStreamCacheContract is used to add new empty stream cache entry using API, zero maxLen means that the length is not capped.
+type NewStreamCacheContract struct {
+	Key     string `form:"key" json:"key" binding:"required"`
+	MaxLen  int64  `form:"maxLen" json:"maxLen"`
+	TTL     string `form:"ttl" json:"ttl"`
+	Sliding bool   `form:"sliding" json:"sliding"`
+}
+
+// AppendStreamCacheEntryContract is used to append the entry to stream cache entry using API.
+// Empty or "*" ID is generated from the current time, maxLen trims the stream after the entry is appended.
+type AppendStreamCacheEntryContract struct {
+	ID      string                `form:"id" json:"id"`
+	Fields  []StreamFieldContract `form:"fields" json:"fields" binding:"required"`
+	MaxLen  int64                 `form:"maxLen" json:"maxLen"`
+	Create  bool                  `form:"create" json:"create"`
+	Version int64                 `form:"version" json:"version"`
+}
+
+// StreamCacheAppendContract is used to serialize the ID of the entry appended and the new length of the stream via API.
+type StreamCacheAppendContract struct {
+	Key     string `json:"key"`
+	ID      string `json:"id"`
+	Length  int64  `json:"length"`
+	Version int64  `json:"version"`
+}
+
+// StreamCacheEntriesContract is used to serialize the entries of the stream via API.
+// Group is set when the entries were delivered to the consumer of the group.
+type StreamCacheEntriesContract struct {
+	Key     string                `json:"key"`
+	Group   string                `json:"group,omitempty"`
+	Entries []StreamEntryContract `json:"entries"`
+	Version int64                 `json:"version"`
+}
+
+// TrimStreamCacheContract is used to remove the oldest entries of stream cache entry using API.
+type TrimStreamCacheContract struct {
+	MaxLen  int64 `form:"maxLen" json:"maxLen"`
+	Version int64 `form:"version" json:"version"`
+}
+
+// StreamCacheTrimContract is used to serialize the number of entries removed and the new length of the stream via API.
+type StreamCacheTrimContract struct {
+	Key     string `json:"key"`
+	Removed int64  `json:"removed"`
+	Length  int64  `json:"length"`
+	Version int64  `json:"version"`
+}
+
+// StreamGroupContract is used to serialize the summary of the consumer group via API.
+type StreamGroupContract struct {
+	Name            string `json:"name"`
+	LastDeliveredID string `json:"lastDeliveredId"`
+	Pending         int64  `json:"pending"`
+	Consumers       int32  `json:"consumers"`
+}
+
+// StreamCacheGroupsContract is used to serialize the consumer groups of the stream via API.
+type StreamCacheGroupsContract struct {
+	Key     string                `json:"key"`
+	Groups  []StreamGroupContract `json:"groups"`
+	Version int64                 `json:"version"`
+}
+
+// NewStreamGroupContract is used to add the consumer group to stream cache entry using API.
+// Start is the ID after which the entries are delivered, "$" or empty start means the last ID of the stream.
+// If create flag is set the missing key is created.
+type NewStreamGroupContract struct {
+	Group  string `form:"group" json:"group" binding:"required"`
+	Start  string `form:"start" json:"start"`
+	Create bool   `form:"create" json:"create"`
+}
+
+// ReadStreamGroupContract is used to read the entries of stream cache entry by the consumer of the group using API.
+// Empty or ">" ID reads new entries, other IDs read the entries pending for the consumer after the ID.
+type ReadStreamGroupContract struct {
+	Consumer string `form:"consumer" json:"consumer" binding:"required"`
+	ID       string `form:"id" json:"id"`
+	Count    int32  `form:"count" json:"count"`
+}
+
+// AckStreamGroupContract is used to acknowledge the pending entries of the group using API.
+type AckStreamGroupContract struct {
+	IDs []string `form:"ids" json:"ids" binding:"required"`
+}
+
+// StreamCacheAckContract is used to serialize the number of entries acknowledged via API.
+type StreamCacheAckContract struct {
+	Key     string `json:"key"`
+	Group   string `json:"group"`
+	Acked   int32  `json:"acked"`
+	Version int64  `json:"version"`
+}
+
+// StreamPendingEntryContract is used to serialize the entry delivered to the consumer and not acknowledged yet via API.
+type StreamPendingEntryContract struct {
+	ID         string `json:"id"`
+	Consumer   string `json:"consumer"`
+	Idle       string `json:"idle"`
+	Deliveries int64  `json:"deliveries"`
+}
+
+// StreamCachePendingContract is used to serialize the pending entries of the group via API.
+type StreamCachePendingContract struct {
+	Key     string                       `json:"key"`
+	Group   string                       `json:"group"`
+	Entries []StreamPendingEntryContract `json:"entries"`
+}
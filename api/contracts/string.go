@@ -2,19 +2,22 @@ package contracts
 
 // StringCacheValueContract is used to serialize string cache entry via API.
 type StringCacheValueContract struct {
-	Key   string `json:"key"`
-	Value string `json:"value"`
+	Key     string `json:"key"`
+	Value   string `json:"value"`
+	Version int64  `json:"version"`
 }
 
 // NewStringCacheValueContract is used to add new string cache entry using API.
 type NewStringCacheValueContract struct {
-	Key   string `form:"key" json:"key" binding:"required"`
-	Value string `form:"value" json:"value" binding:"required"`
-	TTL   string `form:"ttl" json:"ttl"`
+	Key     string `form:"key" json:"key" binding:"required"`
+	Value   string `form:"value" json:"value" binding:"required"`
+	TTL     string `form:"ttl" json:"ttl"`
+	Sliding bool   `form:"sliding" json:"sliding"`
 }
 
-// UpdateStringCacheValueContract is used to update string cache entry using API.
+// UpdateStringCacheValueContract is used to update string cache entry using API, original value or version is required.
 type UpdateStringCacheValueContract struct {
 	NewValue      string `form:"value" json:"value" binding:"required"`
-	OriginalValue string `form:"original" json:"original" binding:"required"`
+	OriginalValue string `form:"original" json:"original"`
+	Version       int64  `form:"version" json:"version"`
 }
@@ -0,0 +1,15 @@
+package contracts
+
+// CacheTTLContract is used to serialize cache entry expiration via API.
+type CacheTTLContract struct {
+	Key     string `json:"key"`
+	TTL     string `json:"ttl"`
+	Expires string `json:"expires"`
+	Sliding bool   `json:"sliding"`
+}
+
+// UpdateCacheTTLContract is used to set new cache entry expiration using API.
+type UpdateCacheTTLContract struct {
+	TTL     string `form:"ttl" json:"ttl" binding:"required"`
+	Sliding bool   `form:"sliding" json:"sliding"`
+}
@@ -0,0 +1,234 @@
+package controllers
+
+import (
+	"fmt"
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/VitalKrasilnikau/memcache/api/contracts"
+	"github.com/VitalKrasilnikau/memcache/api/utils"
+	"github.com/VitalKrasilnikau/memcache/core/actors"
+	"github.com/gin-gonic/gin"
+	"strconv"
+	"sync"
+)
+
+// GetBitStringCacheKeyHandler API which gets the bit of string value by "offset" like GETBIT,
+// bit 0 is the most significant bit of the first byte and the bits beyond the end are 0.
+func GetBitStringCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		offset, err := strconv.ParseInt(c.DefaultQuery("offset", "0"), 10, 64)
+		if err != nil {
+			api.Bad(c, fmt.Sprintf("malformed offset '%s'", c.Query("offset")))
+			return
+		}
+		act.Await(
+			pid,
+			func(wg *sync.WaitGroup) *actor.PID {
+				return createStringReplyActor(c, wg)
+			},
+			func() interface{} {
+				return &act.GetBitStringCacheKeyMessage{Key: key, Offset: offset}
+			})
+	}
+}
+
+// SetBitStringCacheKeyHandler API which sets the bit of string value like SETBIT and replies with the previous bit.
+// The value is padded with zero bytes if the offset is beyond its end.
+func SetBitStringCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		var json contracts.SetBitStringCacheContract
+		if err := c.ShouldBindJSON(&json); err == nil {
+			ttl, err := api.ParseTTL(json.TTL)
+			if err != nil {
+				api.Bad(c, fmt.Sprintf("malformed request: %s", err.Error()))
+				return
+			}
+			version, ok := requestVersion(c, json.Version)
+			if !ok {
+				return
+			}
+			act.Await(
+				pid,
+				func(wg *sync.WaitGroup) *actor.PID {
+					return createStringReplyActor(c, wg)
+				},
+				func() interface{} {
+					return &act.SetBitStringCacheKeyMessage{
+						Key:     key,
+						Offset:  json.Offset,
+						Bit:     json.Bit,
+						Create:  json.Create,
+						TTL:     ttl,
+						Sliding: json.Sliding,
+						Version: version}
+				})
+		} else {
+			api.Bad(c, fmt.Sprintf("malformed request: %s", err.Error()))
+		}
+	}
+}
+
+// CountBitsStringCacheKeyHandler API which counts the bits set in string value from "start" to "end" inclusive like BITCOUNT,
+// negative indexes are counted from the end. The range is in bytes unless "unit" is bit.
+func CountBitsStringCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		start, end, _, bitUnit, ok := queryBitRange(c)
+		if !ok {
+			return
+		}
+		act.Await(
+			pid,
+			func(wg *sync.WaitGroup) *actor.PID {
+				return createStringReplyActor(c, wg)
+			},
+			func() interface{} {
+				return &act.CountBitsStringCacheKeyMessage{Key: key, Start: start, End: end, BitUnit: bitUnit}
+			})
+	}
+}
+
+// GetBitPosStringCacheKeyHandler API which finds the first "bit" set to 0 or 1 in string value from "start" to "end" like BITPOS,
+// -1 is returned if the bit was not found. If clear bit is searched without "end", the value is treated as padded with zeros.
+func GetBitPosStringCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		bit, err := strconv.Atoi(c.Query("bit"))
+		if err != nil {
+			api.Bad(c, fmt.Sprintf("malformed bit '%s'", c.Query("bit")))
+			return
+		}
+		start, end, hasEnd, bitUnit, ok := queryBitRange(c)
+		if !ok {
+			return
+		}
+		act.Await(
+			pid,
+			func(wg *sync.WaitGroup) *actor.PID {
+				return createStringReplyActor(c, wg)
+			},
+			func() interface{} {
+				return &act.GetBitPosStringCacheKeyMessage{
+					Key:     key,
+					Bit:     int32(bit),
+					Start:   start,
+					End:     end,
+					HasEnd:  hasEnd,
+					BitUnit: bitUnit}
+			})
+	}
+}
+
+// BitOpStringCacheKeyHandler API which stores the result of and, or, xor or not operation on the values of the keys like BITOP.
+// Shorter and missing values are padded with zero bytes, the empty result deletes the key.
+func BitOpStringCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		var json contracts.BitOpStringCacheContract
+		if err := c.ShouldBindJSON(&json); err == nil {
+			version, ok := requestVersion(c, json.Version)
+			if !ok {
+				return
+			}
+			sources := readStringValues(pid, json.Keys)
+			act.Await(
+				pid,
+				func(wg *sync.WaitGroup) *actor.PID {
+					return createStringReplyActor(c, wg)
+				},
+				func() interface{} {
+					return &act.BitOpStringCacheKeyMessage{Key: key, Op: json.Op, Sources: sources, Version: version}
+				})
+		} else {
+			api.Bad(c, fmt.Sprintf("malformed request: %s", err.Error()))
+		}
+	}
+}
+
+// BitFieldStringCacheKeyHandler API which gets, sets and increments integers of arbitrary width in string value like BITFIELD.
+// The operations are applied in order, the result of the operation failed due to fail overflow is null.
+func BitFieldStringCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		var json contracts.BitFieldStringCacheContract
+		if err := c.ShouldBindJSON(&json); err == nil {
+			ttl, err := api.ParseTTL(json.TTL)
+			if err != nil {
+				api.Bad(c, fmt.Sprintf("malformed request: %s", err.Error()))
+				return
+			}
+			version, ok := requestVersion(c, json.Version)
+			if !ok {
+				return
+			}
+			ops := make([]act.BitFieldOperation, len(json.Operations))
+			for i, op := range json.Operations {
+				ops[i] = act.BitFieldOperation{Op: op.Op, Type: op.Type, Offset: op.Offset, Value: op.Value, Overflow: op.Overflow}
+			}
+			act.Await(
+				pid,
+				func(wg *sync.WaitGroup) *actor.PID {
+					return createStringReplyActor(c, wg)
+				},
+				func() interface{} {
+					return &act.BitFieldStringCacheKeyMessage{
+						Key:        key,
+						Operations: ops,
+						Create:     json.Create,
+						TTL:        ttl,
+						Sliding:    json.Sliding,
+						Version:    version}
+				})
+		} else {
+			api.Bad(c, fmt.Sprintf("malformed request: %s", err.Error()))
+		}
+	}
+}
+
+// queryBitRange reads "start", "end" and "unit" query parameters, the second flag is true if the end was specified.
+func queryBitRange(c *gin.Context) (int64, int64, bool, bool, bool) {
+	start, err := strconv.ParseInt(c.DefaultQuery("start", "0"), 10, 64)
+	if err != nil {
+		api.Bad(c, fmt.Sprintf("malformed start '%s'", c.Query("start")))
+		return 0, 0, false, false, false
+	}
+	e, hasEnd := c.GetQuery("end")
+	end := int64(-1)
+	if hasEnd {
+		if end, err = strconv.ParseInt(e, 10, 64); err != nil {
+			api.Bad(c, fmt.Sprintf("malformed end '%s'", e))
+			return 0, 0, false, false, false
+		}
+	}
+	switch unit := c.DefaultQuery("unit", "byte"); unit {
+	case "byte":
+		return start, end, hasEnd, false, true
+	case "bit":
+		return start, end, hasEnd, true, true
+	default:
+		api.Bad(c, fmt.Sprintf("unsupported unit '%s', must be byte or bit", unit))
+		return 0, 0, false, false, false
+	}
+}
+
+// readStringValues requests the values one by one since they can be stored by different actors, missing keys are empty values.
+func readStringValues(pid *actor.PID, keys []string) [][]byte {
+	res := make([][]byte, len(keys))
+	for i, k := range keys {
+		if s, ok := act.AwaitReply(pid, &act.GetStringCacheKeyMessage{Key: k}).(*act.GetStringCacheKeyReply); ok && s.Success {
+			res[i] = []byte(s.Value)
+		}
+	}
+	return res
+}
+
+func toBitFieldResults(results []act.BitFieldResult) []*int64 {
+	res := make([]*int64, len(results))
+	for i := range results {
+		if !results[i].Nil {
+			res[i] = &results[i].Value
+		}
+	}
+	return res
+}
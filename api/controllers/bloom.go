@@ -0,0 +1,198 @@
+package controllers
+
+import (
+	"fmt"
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/VitalKrasilnikau/memcache/api/contracts"
+	"github.com/VitalKrasilnikau/memcache/api/utils"
+	"github.com/VitalKrasilnikau/memcache/core/actors"
+	"github.com/gin-gonic/gin"
+	"sync"
+)
+
+// GetBloomFilterCacheKeyHandler API which gets Bloom filter options and the number of values added or its metadata by key.
+func GetBloomFilterCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		if isMetadataRequest(c) {
+			getCacheMetadata(c, pid, key)
+			return
+		}
+		act.Await(
+			pid,
+			func(wg *sync.WaitGroup) *actor.PID {
+				return createBloomFilterReplyActor(c, wg)
+			},
+			func() interface{} {
+				return &act.GetBloomFilterCacheKeyMessage{Key: key}
+			})
+	}
+}
+
+// DeleteBloomFilterCacheKeyHandler API which deletes Bloom filter cache entry by key, If-Match header makes the deletion conditional.
+func DeleteBloomFilterCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		version, ok := requestVersion(c, 0)
+		if !ok {
+			return
+		}
+		act.Await(
+			pid,
+			func(wg *sync.WaitGroup) *actor.PID {
+				return createBloomFilterReplyActor(c, wg)
+			},
+			func() interface{} {
+				return &act.DeleteBloomFilterCacheKeyMessage{Key: key, Version: version}
+			})
+	}
+}
+
+// PostBloomFilterCacheKeyHandler API which reserves new Bloom filter for the capacity and the error rate specified like BF.RESERVE.
+func PostBloomFilterCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		var json contracts.NewBloomFilterCacheContract
+		if err := c.ShouldBindJSON(&json); err == nil {
+			ttl, err := api.ParseTTL(json.TTL)
+			if err != nil {
+				api.Bad(c, fmt.Sprintf("malformed request: %s", err.Error()))
+				return
+			}
+			act.Await(
+				pid,
+				func(wg *sync.WaitGroup) *actor.PID {
+					return createBloomFilterReplyActor(c, wg)
+				},
+				func() interface{} {
+					return &act.PostBloomFilterCacheKeyMessage{
+						Key:       json.Key,
+						Capacity:  json.Capacity,
+						ErrorRate: json.ErrorRate,
+						TTL:       ttl,
+						Sliding:   json.Sliding}
+				})
+		} else {
+			api.Bad(c, fmt.Sprintf("malformed request: %s", err.Error()))
+		}
+	}
+}
+
+// AddBloomFilterCacheValuesHandler API which adds values to Bloom filter like BF.MADD, "create" option creates the missing key.
+func AddBloomFilterCacheValuesHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		var json contracts.AddBloomFilterCacheValuesContract
+		if err := c.ShouldBindJSON(&json); err == nil {
+			version, ok := requestVersion(c, json.Version)
+			if !ok {
+				return
+			}
+			act.Await(
+				pid,
+				func(wg *sync.WaitGroup) *actor.PID {
+					return createBloomFilterReplyActor(c, wg)
+				},
+				func() interface{} {
+					return &act.AddBloomFilterCacheValuesMessage{
+						Key:       key,
+						Values:    json.Values,
+						Create:    json.Create,
+						Capacity:  json.Capacity,
+						ErrorRate: json.ErrorRate,
+						Version:   version}
+				})
+		} else {
+			api.Bad(c, fmt.Sprintf("malformed request: %s", err.Error()))
+		}
+	}
+}
+
+// ExistsBloomFilterCacheValuesHandler API which checks the values specified in repeated "value" query parameter like BF.MEXISTS.
+func ExistsBloomFilterCacheValuesHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		values := c.QueryArray("value")
+		if len(values) == 0 {
+			api.Bad(c, "malformed request: value query parameter is required")
+			return
+		}
+		act.Await(
+			pid,
+			func(wg *sync.WaitGroup) *actor.PID {
+				return createBloomFilterReplyActor(c, wg)
+			},
+			func() interface{} {
+				return &act.ExistsBloomFilterCacheValuesMessage{Key: key, Values: values}
+			})
+	}
+}
+
+func dispatchBloomFilterReply(c *gin.Context, ctx actor.Context, wg *sync.WaitGroup) {
+	switch s := ctx.Message().(type) {
+	case *act.GetBloomFilterCacheKeyReply:
+		defer wg.Done()
+		if s.Success {
+			api.SetVersion(c, s.Version)
+			api.OK(c, contracts.BloomFilterCacheInfoContract{
+				Key:       s.Key,
+				Capacity:  s.Capacity,
+				ErrorRate: s.ErrorRate,
+				Bits:      s.Bits,
+				Hashes:    s.Hashes,
+				Count:     s.Count,
+				Version:   s.Version})
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	case *act.DeleteBloomFilterCacheKeyReply:
+		defer wg.Done()
+		if s.Success {
+			api.NoContent(c)
+		} else if s.Conflict {
+			api.PreconditionFailed(c, fmt.Sprintf("version of key '%s' does not match", s.Key))
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	case *act.PostBloomFilterCacheKeyReply:
+		defer wg.Done()
+		if s.Success {
+			api.SetVersion(c, s.Version)
+			api.Created(c)
+		} else if s.Error != "" {
+			api.Bad(c, fmt.Sprintf("malformed request: %s", s.Error))
+		} else {
+			api.Bad(c, fmt.Sprintf("key '%s' was already used", s.Key))
+		}
+		break
+	case *act.AddBloomFilterCacheValuesReply:
+		defer wg.Done()
+		if s.Success {
+			api.SetVersion(c, s.Version)
+			api.OK(c, contracts.BloomFilterCacheResultsContract{Key: s.Key, Values: s.Values, Results: s.Results, Version: s.Version})
+		} else if s.Conflict {
+			api.PreconditionFailed(c, fmt.Sprintf("version of key '%s' does not match", s.Key))
+		} else if s.Error != "" {
+			api.Bad(c, fmt.Sprintf("malformed request: %s", s.Error))
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	case *act.ExistsBloomFilterCacheValuesReply:
+		defer wg.Done()
+		if s.Success {
+			api.SetVersion(c, s.Version)
+			api.OK(c, contracts.BloomFilterCacheResultsContract{Key: s.Key, Values: s.Values, Results: s.Results, Version: s.Version})
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	}
+}
+
+func createBloomFilterReplyActor(c *gin.Context, wg *sync.WaitGroup) *actor.PID {
+	return actor.Spawn(actor.FromFunc(func(ctx actor.Context) {
+		dispatchBloomFilterReply(c, ctx, wg)
+	}))
+}
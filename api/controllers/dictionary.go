@@ -11,10 +11,14 @@ import (
 	"sync"
 )
 
-// GetDictionaryCacheKeyHandler API which gets dictionary cache entry by key.
+// GetDictionaryCacheKeyHandler API which gets dictionary cache entry or its metadata by key.
 func GetDictionaryCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
 	return func(c *gin.Context) {
 		key := c.Param("key")
+		if isMetadataRequest(c) {
+			getCacheMetadata(c, pid, key)
+			return
+		}
 		act.Await(
 			pid,
 			func(wg *sync.WaitGroup) *actor.PID {
@@ -30,13 +34,17 @@ func GetDictionaryCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
 func DeleteDictionaryCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
 	return func(c *gin.Context) {
 		key := c.Param("key")
+		version, ok := requestVersion(c, 0)
+		if !ok {
+			return
+		}
 		act.Await(
 			pid,
 			func(wg *sync.WaitGroup) *actor.PID {
 				return createReplyActor(c, wg)
 			},
 			func() interface{} {
-				return &act.DeleteDictionaryCacheKeyMessage{Key: key}
+				return &act.DeleteDictionaryCacheKeyMessage{Key: key, Version: version}
 			})
 	}
 }
@@ -46,6 +54,11 @@ func PostDictionaryCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
 	return func(c *gin.Context) {
 		var json contracts.NewDictionaryCacheValuesContract
 		if err := c.ShouldBindJSON(&json); err == nil {
+			ttl, err := api.ParseTTL(json.TTL)
+			if err != nil {
+				api.Bad(c, fmt.Sprintf("malformed request: %s", err.Error()))
+				return
+			}
 			act.Await(
 				pid,
 				func(wg *sync.WaitGroup) *actor.PID {
@@ -55,7 +68,8 @@ func PostDictionaryCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
 					return &act.PostDictionaryCacheKeyMessage{
 						Key:     json.Key,
 						Values:  fromDto(json.Values),
-						TTL:     api.ParseDuration(json.TTL)}
+						TTL:     ttl,
+						Sliding: json.Sliding}
 				})
 		} else {
 			api.Bad(c, fmt.Sprintf("malformed request: %s", err.Error()))
@@ -70,6 +84,14 @@ func PutDictionaryCacheValueHandler(pid *actor.PID) func(*gin.Context) {
 		subkey := c.Param("subkey")
 		var json contracts.UpdateDictionaryCacheValueContract
 		if err := c.ShouldBindJSON(&json); err == nil {
+			version, ok := requestVersion(c, json.Version)
+			if !ok {
+				return
+			}
+			if version == 0 && json.Original == "" {
+				api.Bad(c, "original value or version is required")
+				return
+			}
 			act.Await(
 				pid,
 				func(wg *sync.WaitGroup) *actor.PID {
@@ -80,7 +102,8 @@ func PutDictionaryCacheValueHandler(pid *actor.PID) func(*gin.Context) {
 						Key:           key,
 						SubKey:        subkey,
 						NewValue:      json.Value,
-						OriginalValue: json.Original}
+						OriginalValue: json.Original,
+						Version:       version}
 				})
 		} else {
 			api.Bad(c, fmt.Sprintf("malformed request: %s", err.Error()))
@@ -94,6 +117,15 @@ func PostDictionaryCacheValueHandler(pid *actor.PID) func(*gin.Context) {
 		key := c.Param("key")
 		var json contracts.AddDictionaryCacheValueContract
 		if err := c.ShouldBindJSON(&json); err == nil {
+			ttl, err := api.ParseTTL(json.TTL)
+			if err != nil {
+				api.Bad(c, fmt.Sprintf("malformed request: %s", err.Error()))
+				return
+			}
+			version, ok := requestVersion(c, json.Version)
+			if !ok {
+				return
+			}
 			act.Await(
 				pid,
 				func(wg *sync.WaitGroup) *actor.PID {
@@ -102,7 +134,9 @@ func PostDictionaryCacheValueHandler(pid *actor.PID) func(*gin.Context) {
 				func() interface{} {
 					return &act.PostDictionaryCacheValueMessage{
 						Key:      key,
-						NewValue: cache.KeyValue{Key: json.Value.Key, Value: json.Value.Value}}
+						NewValue: cache.KeyValue{Key: json.Value.Key, Value: json.Value.Value},
+						TTL:      ttl,
+						Version:  version}
 				})
 		} else {
 			api.Bad(c, fmt.Sprintf("malformed request: %s", err.Error()))
@@ -115,63 +149,294 @@ func DeleteDictionaryCacheValueHandler(pid *actor.PID) func(*gin.Context) {
 	return func(c *gin.Context) {
 		key := c.Param("key")
 		value := c.Param("subkey")
+		version, ok := requestVersion(c, 0)
+		if !ok {
+			return
+		}
+		act.Await(
+			pid,
+			func(wg *sync.WaitGroup) *actor.PID {
+				return createReplyActor(c, wg)
+			},
+			func() interface{} {
+				return &act.DeleteDictionaryCacheValueMessage{Key: key, SubKey: value, Version: version}
+			})
+	}
+}
+
+// IncrementDictionaryCacheValueHandler API which atomically increments numeric value of the sub-key and replies with the new value.
+// Missing sub-key is treated as zero.
+func IncrementDictionaryCacheValueHandler(pid *actor.PID) func(*gin.Context) {
+	return incrementDictionaryCacheValue(pid, 1)
+}
+
+// DecrementDictionaryCacheValueHandler API which atomically decrements numeric value of the sub-key and replies with the new value.
+// Missing sub-key is treated as zero.
+func DecrementDictionaryCacheValueHandler(pid *actor.PID) func(*gin.Context) {
+	return incrementDictionaryCacheValue(pid, -1)
+}
+
+func incrementDictionaryCacheValue(pid *actor.PID, sign int64) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		subkey := c.Param("subkey")
+		json, inc, ttl, ok := bindIncrement(c, sign)
+		if !ok {
+			return
+		}
+		version, ok := requestVersion(c, json.Version)
+		if !ok {
+			return
+		}
+		act.Await(
+			pid,
+			func(wg *sync.WaitGroup) *actor.PID {
+				return createReplyActor(c, wg)
+			},
+			func() interface{} {
+				return &act.IncrementDictionaryCacheValueMessage{
+					Key:        key,
+					SubKey:     subkey,
+					Delta:      inc.Delta,
+					FloatDelta: inc.FloatDelta,
+					Float:      inc.Float,
+					Create:     json.Create,
+					TTL:        ttl,
+					Sliding:    json.Sliding,
+					Version:    version}
+			})
+	}
+}
+
+// GetDictionaryCacheValuesHandler API which gets the values of the sub-keys specified in "subkey" query parameter,
+// missing sub-keys are skipped.
+func GetDictionaryCacheValuesHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		subKeys := queryList(c, "subkey")
+		act.Await(
+			pid,
+			func(wg *sync.WaitGroup) *actor.PID {
+				return createReplyActor(c, wg)
+			},
+			func() interface{} {
+				return &act.GetDictionaryCacheValuesMessage{Key: key, SubKeys: subKeys}
+			})
+	}
+}
+
+// GetDictionaryCacheSubKeysHandler API which gets the sorted sub-keys of the dictionary without their values.
+func GetDictionaryCacheSubKeysHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		act.Await(
+			pid,
+			func(wg *sync.WaitGroup) *actor.PID {
+				return createReplyActor(c, wg)
+			},
+			func() interface{} {
+				return &act.GetDictionaryCacheSubKeysMessage{Key: key}
+			})
+	}
+}
+
+// ContainsDictionaryCacheValuesHandler API which checks if the dictionary contains the sub-keys specified in "subkey" query parameter.
+func ContainsDictionaryCacheValuesHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		subKeys := queryList(c, "subkey")
+		act.Await(
+			pid,
+			func(wg *sync.WaitGroup) *actor.PID {
+				return createReplyActor(c, wg)
+			},
+			func() interface{} {
+				return &act.ContainsDictionaryCacheValuesMessage{Key: key, SubKeys: subKeys}
+			})
+	}
+}
+
+// PatchDictionaryCacheValuesHandler API which adds missing sub-keys and updates existing ones in one step
+// and replies with the number of sub-keys added and updated.
+func PatchDictionaryCacheValuesHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		var json contracts.SetDictionaryCacheValuesContract
+		if err := c.ShouldBindJSON(&json); err == nil {
+			ttl, err := api.ParseTTL(json.TTL)
+			if err != nil {
+				api.Bad(c, fmt.Sprintf("malformed request: %s", err.Error()))
+				return
+			}
+			version, ok := requestVersion(c, json.Version)
+			if !ok {
+				return
+			}
+			act.Await(
+				pid,
+				func(wg *sync.WaitGroup) *actor.PID {
+					return createReplyActor(c, wg)
+				},
+				func() interface{} {
+					return &act.SetDictionaryCacheValuesMessage{
+						Key:     key,
+						Values:  fromDto(json.Values),
+						Create:  json.Create,
+						TTL:     ttl,
+						Sliding: json.Sliding,
+						Version: version}
+				})
+		} else {
+			api.Bad(c, fmt.Sprintf("malformed request: %s", err.Error()))
+		}
+	}
+}
+
+// DeleteDictionaryCacheValuesHandler API which deletes the sub-keys specified in "subkey" query parameter in one step
+// and replies with the values deleted.
+func DeleteDictionaryCacheValuesHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		subKeys := queryList(c, "subkey")
+		version, ok := requestVersion(c, 0)
+		if !ok {
+			return
+		}
 		act.Await(
 			pid,
 			func(wg *sync.WaitGroup) *actor.PID {
 				return createReplyActor(c, wg)
 			},
 			func() interface{} {
-				return &act.DeleteDictionaryCacheValueMessage{Key: key, SubKey: value}
+				return &act.DeleteDictionaryCacheValuesMessage{Key: key, SubKeys: subKeys, Version: version}
 			})
 	}
 }
 
 func dispatchReply(c *gin.Context, ctx actor.Context, wg *sync.WaitGroup) {
 	switch s := ctx.Message().(type) {
-	case act.GetDictionaryCacheKeyReply:
+	case *act.GetDictionaryCacheKeyReply:
 		defer wg.Done()
 		if s.Success {
-			api.OK(c, contracts.DictionaryCacheValueContract{Key: s.Key, Values: toDto(s.Values)})
+			api.SetVersion(c, s.Version)
+			api.OK(c, contracts.DictionaryCacheValueContract{Key: s.Key, Values: toDto(s.Values), Version: s.Version})
 		} else {
 			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
 		}
 		break
-	case act.DeleteDictionaryCacheKeyReply:
+	case *act.DeleteDictionaryCacheKeyReply:
 		defer wg.Done()
 		if s.Success {
 			api.NoContent(c)
+		} else if s.Conflict {
+			api.PreconditionFailed(c, fmt.Sprintf("version of key '%s' does not match", s.Key))
 		} else {
 			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
 		}
 		break
-	case act.PostDictionaryCacheKeyReply:
+	case *act.PostDictionaryCacheKeyReply:
 		defer wg.Done()
 		if s.Success {
+			api.SetVersion(c, s.Version)
 			api.Created(c)
 		} else {
 			api.Bad(c, fmt.Sprintf("key '%s' was already used", s.Key))
 		}
 		break
-	case act.PutDictionaryCacheValueReply:
+	case *act.PutDictionaryCacheValueReply:
 		defer wg.Done()
 		if s.Success {
+			api.SetVersion(c, s.Version)
 			api.NoContent(c)
+		} else if s.Conflict {
+			api.PreconditionFailed(c, fmt.Sprintf("version of key '%s' does not match", s.Key))
 		} else {
 			api.Bad(c, fmt.Sprintf("dictionary subkey '%s' of key '%s' was already changed or never existed", s.SubKey, s.Key))
 		}
 		break
-	case act.PostDictionaryCacheValueReply:
+	case *act.PostDictionaryCacheValueReply:
 		defer wg.Done()
 		if s.Success {
+			api.SetVersion(c, s.Version)
 			api.Created(c)
+		} else if s.Conflict {
+			api.PreconditionFailed(c, fmt.Sprintf("version of key '%s' does not match", s.Key))
 		} else {
 			api.Bad(c, fmt.Sprintf("key '%s' was not found", s.Key))
 		}
 		break
-	case act.DeleteDictionaryCacheValueReply:
+	case *act.GetDictionaryCacheValuesReply:
+		defer wg.Done()
+		if s.Success {
+			api.SetVersion(c, s.Version)
+			api.OK(c, contracts.DictionaryCacheValueContract{Key: s.Key, Values: toDto(s.Values), Version: s.Version})
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	case *act.SetDictionaryCacheValuesReply:
+		defer wg.Done()
+		if s.Success {
+			api.SetVersion(c, s.Version)
+			api.OK(c, contracts.DictionaryCacheSetResultContract{Key: s.Key, Added: s.Added, Updated: s.Updated, Version: s.Version})
+		} else if s.Conflict {
+			api.PreconditionFailed(c, fmt.Sprintf("version of key '%s' does not match", s.Key))
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	case *act.DeleteDictionaryCacheValuesReply:
+		defer wg.Done()
+		if s.Success {
+			api.SetVersion(c, s.Version)
+			api.OK(c, contracts.DictionaryCacheValueContract{Key: s.Key, Values: toDto(s.DeletedValues), Version: s.Version})
+		} else if s.Conflict {
+			api.PreconditionFailed(c, fmt.Sprintf("version of key '%s' does not match", s.Key))
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	case *act.GetDictionaryCacheSubKeysReply:
+		defer wg.Done()
+		if s.Success {
+			api.SetVersion(c, s.Version)
+			api.OK(c, contracts.DictionaryCacheSubKeysContract{Key: s.Key, SubKeys: s.SubKeys, Version: s.Version})
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	case *act.ContainsDictionaryCacheValuesReply:
+		defer wg.Done()
+		if s.Success {
+			exists := make(map[string]bool, len(s.SubKeys))
+			for i, k := range s.SubKeys {
+				exists[k] = s.Exists[i]
+			}
+			api.OK(c, contracts.DictionaryCacheExistsContract{Key: s.Key, Exists: exists})
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	case *act.IncrementDictionaryCacheValueReply:
+		defer wg.Done()
+		if s.Success {
+			api.SetVersion(c, s.Version)
+			api.OK(c, contracts.DictionaryCacheSubKeyValueContract{Key: s.Key, SubKey: s.SubKey, Value: s.Value, Version: s.Version})
+		} else if s.Conflict {
+			api.PreconditionFailed(c, fmt.Sprintf("version of key '%s' does not match", s.Key))
+		} else if s.Error != "" {
+			api.Bad(c, fmt.Sprintf("value of sub-key '%s' of key '%s' cannot be incremented: %s", s.SubKey, s.Key, s.Error))
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	case *act.DeleteDictionaryCacheValueReply:
 		defer wg.Done()
 		if s.Success {
+			api.SetVersion(c, s.Version)
 			api.NoContent(c)
+		} else if s.Conflict {
+			api.PreconditionFailed(c, fmt.Sprintf("version of key '%s' does not match", s.Key))
 		} else {
 			api.Bad(c, fmt.Sprintf("dictionary value '%s' of key '%s' was already deleted or never existed", s.SubKey, s.Key))
 		}
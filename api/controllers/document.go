@@ -0,0 +1,329 @@
+package controllers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/VitalKrasilnikau/memcache/api/contracts"
+	"github.com/VitalKrasilnikau/memcache/api/utils"
+	"github.com/VitalKrasilnikau/memcache/core/actors"
+	"github.com/VitalKrasilnikau/memcache/core/cache"
+	"github.com/gin-gonic/gin"
+	"sync"
+)
+
+const (
+	jsonPatchContentType  = "application/json-patch+json"
+	mergePatchContentType = "application/merge-patch+json"
+)
+
+// GetDocumentCacheKeyHandler API which gets document cache entry, the value at its path or its metadata by key.
+func GetDocumentCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		if isMetadataRequest(c) {
+			getCacheMetadata(c, pid, key)
+			return
+		}
+		act.Await(
+			pid,
+			func(wg *sync.WaitGroup) *actor.PID {
+				return createDocumentReplyActor(c, wg)
+			},
+			func() interface{} {
+				if path, ok := c.GetQuery("path"); ok {
+					return &act.GetDocumentCachePathMessage{Key: key, Path: path}
+				}
+				return &act.GetDocumentCacheKeyMessage{Key: key}
+			})
+	}
+}
+
+// DeleteDocumentCacheKeyHandler API which deletes document cache entry or the value at its path by key, If-Match header makes the deletion conditional.
+func DeleteDocumentCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		version, ok := requestVersion(c, 0)
+		if !ok {
+			return
+		}
+		act.Await(
+			pid,
+			func(wg *sync.WaitGroup) *actor.PID {
+				return createDocumentReplyActor(c, wg)
+			},
+			func() interface{} {
+				if path, ok := c.GetQuery("path"); ok {
+					return &act.DeleteDocumentCachePathMessage{Key: key, Path: path, Version: version}
+				}
+				return &act.DeleteDocumentCacheKeyMessage{Key: key, Version: version}
+			})
+	}
+}
+
+// PostDocumentCacheKeyHandler API which posts new JSON document.
+func PostDocumentCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		var json contracts.NewDocumentCacheValueContract
+		if err := c.ShouldBindJSON(&json); err == nil {
+			ttl, err := api.ParseTTL(json.TTL)
+			if err != nil {
+				api.Bad(c, fmt.Sprintf("malformed request: %s", err.Error()))
+				return
+			}
+			act.Await(
+				pid,
+				func(wg *sync.WaitGroup) *actor.PID {
+					return createDocumentReplyActor(c, wg)
+				},
+				func() interface{} {
+					return &act.PostDocumentCacheKeyMessage{
+						Key:     json.Key,
+						Value:   string(json.Value),
+						TTL:     ttl,
+						Sliding: json.Sliding}
+				})
+		} else {
+			api.Bad(c, fmt.Sprintf("malformed request: %s", err.Error()))
+		}
+	}
+}
+
+// PutDocumentCachePathHandler API which adds or replaces the value at the path of the document.
+func PutDocumentCachePathHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		var json contracts.UpdateDocumentCachePathContract
+		if err := c.ShouldBindJSON(&json); err == nil {
+			version, ok := requestVersion(c, json.Version)
+			if !ok {
+				return
+			}
+			act.Await(
+				pid,
+				func(wg *sync.WaitGroup) *actor.PID {
+					return createDocumentReplyActor(c, wg)
+				},
+				func() interface{} {
+					return &act.SetDocumentCachePathMessage{
+						Key:     key,
+						Path:    json.Path,
+						Value:   string(json.Value),
+						Version: version}
+				})
+		} else {
+			api.Bad(c, fmt.Sprintf("malformed request: %s", err.Error()))
+		}
+	}
+}
+
+// AppendDocumentCacheValuesHandler API which appends values to the array at the path of the document and replies with the new length of the array.
+func AppendDocumentCacheValuesHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		var json contracts.AppendDocumentCacheValuesContract
+		if err := c.ShouldBindJSON(&json); err == nil {
+			version, ok := requestVersion(c, json.Version)
+			if !ok {
+				return
+			}
+			values := make([]string, len(json.Values))
+			for i, v := range json.Values {
+				values[i] = string(v)
+			}
+			act.Await(
+				pid,
+				func(wg *sync.WaitGroup) *actor.PID {
+					return createDocumentReplyActor(c, wg)
+				},
+				func() interface{} {
+					return &act.AppendDocumentCacheValuesMessage{
+						Key:     key,
+						Path:    json.Path,
+						Values:  values,
+						Version: version}
+				})
+		} else {
+			api.Bad(c, fmt.Sprintf("malformed request: %s", err.Error()))
+		}
+	}
+}
+
+// PatchDocumentCacheHandler API which applies JSON Patch (application/json-patch+json) or JSON Merge Patch (application/merge-patch+json)
+// to the document and replies with the result. With application/json content type the array is treated as JSON Patch.
+func PatchDocumentCacheHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		version, ok := requestVersion(c, 0)
+		if !ok {
+			return
+		}
+		body, err := c.GetRawData()
+		if err != nil {
+			api.Bad(c, fmt.Sprintf("malformed request: %s", err.Error()))
+			return
+		}
+		contentType := c.ContentType()
+		if contentType == jsonPatchContentType || (contentType != mergePatchContentType && bytes.HasPrefix(bytes.TrimSpace(body), []byte("["))) {
+			var ops []contracts.DocumentPatchOperationContract
+			if err := json.Unmarshal(body, &ops); err != nil {
+				api.Bad(c, fmt.Sprintf("malformed request: %s", err.Error()))
+				return
+			}
+			act.Await(
+				pid,
+				func(wg *sync.WaitGroup) *actor.PID {
+					return createDocumentReplyActor(c, wg)
+				},
+				func() interface{} {
+					return &act.PatchDocumentCacheMessage{Key: key, Operations: toPatchOperations(ops), Version: version}
+				})
+			return
+		}
+		act.Await(
+			pid,
+			func(wg *sync.WaitGroup) *actor.PID {
+				return createDocumentReplyActor(c, wg)
+			},
+			func() interface{} {
+				return &act.MergeDocumentCacheMessage{Key: key, Patch: string(body), Version: version}
+			})
+	}
+}
+
+func toPatchOperations(ops []contracts.DocumentPatchOperationContract) []act.DocumentPatchOperation {
+	res := make([]act.DocumentPatchOperation, len(ops))
+	for i, op := range ops {
+		res[i] = act.DocumentPatchOperation{Op: op.Op, Path: op.Path, From: op.From, Value: string(op.Value)}
+	}
+	return res
+}
+
+// documentError replies with 404 status if the path was not found and with 400 status for other errors.
+func documentError(c *gin.Context, key string, e string) {
+	if e == cache.ErrPathNotFound.Error() {
+		api.NotFound(c, fmt.Sprintf("path of key '%s' was not found", key))
+	} else {
+		api.Bad(c, fmt.Sprintf("document '%s' cannot be changed: %s", key, e))
+	}
+}
+
+func dispatchDocumentReply(c *gin.Context, ctx actor.Context, wg *sync.WaitGroup) {
+	switch s := ctx.Message().(type) {
+	case *act.GetDocumentCacheKeyReply:
+		defer wg.Done()
+		if s.Success {
+			api.SetVersion(c, s.Version)
+			api.OK(c, contracts.DocumentCacheValueContract{Key: s.Key, Value: json.RawMessage(s.Value), Version: s.Version})
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	case *act.GetDocumentCachePathReply:
+		defer wg.Done()
+		if s.Error != "" {
+			api.Bad(c, fmt.Sprintf("malformed request: %s", s.Error))
+		} else if s.Found {
+			api.SetVersion(c, s.Version)
+			api.OK(c, contracts.DocumentCacheValueContract{Key: s.Key, Path: s.Path, Value: json.RawMessage(s.Value), Version: s.Version})
+		} else if s.Success {
+			api.NotFound(c, fmt.Sprintf("path '%s' of key '%s' was not found", s.Path, s.Key))
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	case *act.DeleteDocumentCacheKeyReply:
+		defer wg.Done()
+		if s.Success {
+			api.NoContent(c)
+		} else if s.Conflict {
+			api.PreconditionFailed(c, fmt.Sprintf("version of key '%s' does not match", s.Key))
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	case *act.PostDocumentCacheKeyReply:
+		defer wg.Done()
+		if s.Success {
+			api.SetVersion(c, s.Version)
+			api.Created(c)
+		} else if s.Error != "" {
+			api.Bad(c, fmt.Sprintf("malformed request: %s", s.Error))
+		} else {
+			api.Bad(c, fmt.Sprintf("key '%s' was already used", s.Key))
+		}
+		break
+	case *act.SetDocumentCachePathReply:
+		defer wg.Done()
+		if s.Conflict {
+			api.PreconditionFailed(c, fmt.Sprintf("version of key '%s' does not match", s.Key))
+		} else if s.Error != "" {
+			documentError(c, s.Key, s.Error)
+		} else if s.Success {
+			api.SetVersion(c, s.Version)
+			api.NoContent(c)
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	case *act.DeleteDocumentCachePathReply:
+		defer wg.Done()
+		if s.Conflict {
+			api.PreconditionFailed(c, fmt.Sprintf("version of key '%s' does not match", s.Key))
+		} else if s.Error != "" {
+			documentError(c, s.Key, s.Error)
+		} else if s.Success {
+			api.SetVersion(c, s.Version)
+			api.NoContent(c)
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	case *act.AppendDocumentCacheValuesReply:
+		defer wg.Done()
+		if s.Conflict {
+			api.PreconditionFailed(c, fmt.Sprintf("version of key '%s' does not match", s.Key))
+		} else if s.Error != "" {
+			documentError(c, s.Key, s.Error)
+		} else if s.Success {
+			api.SetVersion(c, s.Version)
+			api.OK(c, contracts.DocumentCacheLengthContract{Key: s.Key, Path: s.Path, Length: s.Length, Version: s.Version})
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	case *act.MergeDocumentCacheReply:
+		defer wg.Done()
+		if s.Conflict {
+			api.PreconditionFailed(c, fmt.Sprintf("version of key '%s' does not match", s.Key))
+		} else if s.Error != "" {
+			api.Bad(c, fmt.Sprintf("malformed request: %s", s.Error))
+		} else if s.Success {
+			api.SetVersion(c, s.Version)
+			api.OK(c, contracts.DocumentCacheValueContract{Key: s.Key, Value: json.RawMessage(s.Value), Version: s.Version})
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	case *act.PatchDocumentCacheReply:
+		defer wg.Done()
+		if s.Conflict {
+			api.PreconditionFailed(c, fmt.Sprintf("version of key '%s' does not match", s.Key))
+		} else if s.Error != "" {
+			api.Bad(c, fmt.Sprintf("document '%s' cannot be patched: %s", s.Key, s.Error))
+		} else if s.Success {
+			api.SetVersion(c, s.Version)
+			api.OK(c, contracts.DocumentCacheValueContract{Key: s.Key, Value: json.RawMessage(s.Value), Version: s.Version})
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	}
+}
+
+func createDocumentReplyActor(c *gin.Context, wg *sync.WaitGroup) *actor.PID {
+	return actor.Spawn(actor.FromFunc(func(ctx actor.Context) {
+		dispatchDocumentReply(c, ctx, wg)
+	}))
+}
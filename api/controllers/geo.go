@@ -0,0 +1,354 @@
+package controllers
+
+import (
+	"fmt"
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/VitalKrasilnikau/memcache/api/contracts"
+	"github.com/VitalKrasilnikau/memcache/api/utils"
+	"github.com/VitalKrasilnikau/memcache/core/actors"
+	"github.com/VitalKrasilnikau/memcache/core/cache"
+	"github.com/gin-gonic/gin"
+	"strconv"
+	"sync"
+)
+
+// GetGeoCacheKeyHandler API which gets all the members of geo cache entry with their positions or its metadata by key.
+func GetGeoCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		if isMetadataRequest(c) {
+			getCacheMetadata(c, pid, key)
+			return
+		}
+		act.Await(
+			pid,
+			func(wg *sync.WaitGroup) *actor.PID {
+				return createGeoReplyActor(c, wg)
+			},
+			func() interface{} {
+				return &act.GetGeoCacheKeyMessage{Key: key}
+			})
+	}
+}
+
+// DeleteGeoCacheKeyHandler API which deletes geo cache entry by key, If-Match header makes the deletion conditional.
+func DeleteGeoCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		version, ok := requestVersion(c, 0)
+		if !ok {
+			return
+		}
+		act.Await(
+			pid,
+			func(wg *sync.WaitGroup) *actor.PID {
+				return createGeoReplyActor(c, wg)
+			},
+			func() interface{} {
+				return &act.DeleteGeoCacheKeyMessage{Key: key, Version: version}
+			})
+	}
+}
+
+// PostGeoCacheKeyHandler API which posts new geo cache entry with the members specified.
+func PostGeoCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		var json contracts.NewGeoCacheContract
+		if err := c.ShouldBindJSON(&json); err == nil {
+			ttl, err := api.ParseTTL(json.TTL)
+			if err != nil {
+				api.Bad(c, fmt.Sprintf("malformed request: %s", err.Error()))
+				return
+			}
+			act.Await(
+				pid,
+				func(wg *sync.WaitGroup) *actor.PID {
+					return createGeoReplyActor(c, wg)
+				},
+				func() interface{} {
+					return &act.PostGeoCacheKeyMessage{
+						Key:     json.Key,
+						Members: toGeoMembers(json.Members),
+						TTL:     ttl,
+						Sliding: json.Sliding}
+				})
+		} else {
+			api.Bad(c, fmt.Sprintf("malformed request: %s", err.Error()))
+		}
+	}
+}
+
+// AddGeoCacheMembersHandler API which adds new members or moves the existing ones like GEOADD, "create" option creates the missing key.
+func AddGeoCacheMembersHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		var json contracts.AddGeoCacheMembersContract
+		if err := c.ShouldBindJSON(&json); err == nil {
+			version, ok := requestVersion(c, json.Version)
+			if !ok {
+				return
+			}
+			act.Await(
+				pid,
+				func(wg *sync.WaitGroup) *actor.PID {
+					return createGeoReplyActor(c, wg)
+				},
+				func() interface{} {
+					return &act.AddGeoCacheMembersMessage{
+						Key:     key,
+						Members: toGeoMembers(json.Members),
+						Create:  json.Create,
+						Version: version}
+				})
+		} else {
+			api.Bad(c, fmt.Sprintf("malformed request: %s", err.Error()))
+		}
+	}
+}
+
+// RemoveGeoCacheMemberHandler API which removes the member from geo cache entry.
+func RemoveGeoCacheMemberHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		member := c.Param("member")
+		version, ok := requestVersion(c, 0)
+		if !ok {
+			return
+		}
+		act.Await(
+			pid,
+			func(wg *sync.WaitGroup) *actor.PID {
+				return createGeoReplyActor(c, wg)
+			},
+			func() interface{} {
+				return &act.RemoveGeoCacheMembersMessage{Key: key, Members: []string{member}, Version: version}
+			})
+	}
+}
+
+// GetGeoCachePositionsHandler API which gets the positions of the members specified in "members" query parameter like GEOPOS.
+func GetGeoCachePositionsHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		members := queryList(c, "members")
+		act.Await(
+			pid,
+			func(wg *sync.WaitGroup) *actor.PID {
+				return createGeoReplyActor(c, wg)
+			},
+			func() interface{} {
+				return &act.GetGeoCachePositionsMessage{Key: key, Members: members}
+			})
+	}
+}
+
+// GetGeoCacheDistanceHandler API which gets the distance between "from" and "to" members in the unit specified like GEODIST.
+func GetGeoCacheDistanceHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		if _, err := cache.GeoUnitFactor(c.Query("unit")); err != nil {
+			api.Bad(c, fmt.Sprintf("malformed request: %s", err.Error()))
+			return
+		}
+		act.Await(
+			pid,
+			func(wg *sync.WaitGroup) *actor.PID {
+				return createGeoReplyActor(c, wg)
+			},
+			func() interface{} {
+				return &act.GetGeoCacheDistanceMessage{Key: key, From: c.Query("from"), To: c.Query("to")}
+			})
+	}
+}
+
+// SearchGeoCacheHandler API which finds the members within the radius or the box around the member or the position like GEOSEARCH.
+// The results are sorted by distance, sort=desc returns the farthest members first.
+func SearchGeoCacheHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		factor, err := cache.GeoUnitFactor(c.Query("unit"))
+		if err != nil {
+			api.Bad(c, fmt.Sprintf("malformed request: %s", err.Error()))
+			return
+		}
+		count, err := strconv.Atoi(c.DefaultQuery("count", "0"))
+		if err != nil {
+			api.Bad(c, fmt.Sprintf("malformed count '%s'", c.Query("count")))
+			return
+		}
+		var values [5]float64
+		for i, name := range []string{"longitude", "latitude", "radius", "width", "height"} {
+			if values[i], err = strconv.ParseFloat(c.DefaultQuery(name, "0"), 64); err != nil {
+				api.Bad(c, fmt.Sprintf("malformed %s '%s'", name, c.Query(name)))
+				return
+			}
+		}
+		member := c.Query("member")
+		if member == "" && (c.Query("longitude") == "" || c.Query("latitude") == "") {
+			api.Bad(c, "either member or longitude and latitude must be specified")
+			return
+		}
+		if values[2] <= 0 && (values[3] <= 0 || values[4] <= 0) {
+			api.Bad(c, "either radius or width and height must be specified")
+			return
+		}
+		act.Await(
+			pid,
+			func(wg *sync.WaitGroup) *actor.PID {
+				return createGeoReplyActor(c, wg)
+			},
+			func() interface{} {
+				return &act.SearchGeoCacheMessage{
+					Key:        key,
+					Member:     member,
+					Longitude:  values[0],
+					Latitude:   values[1],
+					Radius:     values[2] * factor,
+					Width:      values[3] * factor,
+					Height:     values[4] * factor,
+					Count:      int32(count),
+					Descending: c.Query("sort") == "desc"}
+			})
+	}
+}
+
+// geoUnit returns the unit requested, meters by default.
+func geoUnit(c *gin.Context) (string, float64) {
+	unit := c.Query("unit")
+	if unit == "" {
+		unit = "m"
+	}
+	factor, _ := cache.GeoUnitFactor(unit)
+	return unit, factor
+}
+
+func toGeoMembers(members []contracts.GeoMemberContract) []act.GeoMember {
+	res := make([]act.GeoMember, len(members))
+	for i, m := range members {
+		res[i] = act.GeoMember{Member: m.Member, Longitude: m.Longitude, Latitude: m.Latitude}
+	}
+	return res
+}
+
+func toGeoPositionsDto(results []act.GeoResult) []contracts.GeoPositionContract {
+	res := make([]contracts.GeoPositionContract, len(results))
+	for i, r := range results {
+		res[i] = contracts.GeoPositionContract{Member: r.Member, Longitude: r.Longitude, Latitude: r.Latitude, Geohash: r.Geohash}
+	}
+	return res
+}
+
+// geoError replies with 404 if the member used as the center of the search was not found and with 400 otherwise.
+func geoError(c *gin.Context, key string, err string) {
+	if err == cache.ErrGeoMemberNotFound.Error() {
+		api.NotFound(c, fmt.Sprintf("member '%s' of key '%s' was not found", c.Query("member"), key))
+	} else {
+		api.Bad(c, fmt.Sprintf("malformed request: %s", err))
+	}
+}
+
+func dispatchGeoReply(c *gin.Context, ctx actor.Context, wg *sync.WaitGroup) {
+	switch s := ctx.Message().(type) {
+	case *act.GetGeoCacheKeyReply:
+		defer wg.Done()
+		if s.Success {
+			api.SetVersion(c, s.Version)
+			api.OK(c, contracts.GeoCacheMembersContract{Key: s.Key, Members: toGeoPositionsDto(s.Members), Version: s.Version})
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	case *act.DeleteGeoCacheKeyReply:
+		defer wg.Done()
+		if s.Success {
+			api.NoContent(c)
+		} else if s.Conflict {
+			api.PreconditionFailed(c, fmt.Sprintf("version of key '%s' does not match", s.Key))
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	case *act.PostGeoCacheKeyReply:
+		defer wg.Done()
+		if s.Success {
+			api.SetVersion(c, s.Version)
+			api.Created(c)
+		} else if s.Error != "" {
+			api.Bad(c, fmt.Sprintf("malformed request: %s", s.Error))
+		} else {
+			api.Bad(c, fmt.Sprintf("key '%s' was already used", s.Key))
+		}
+		break
+	case *act.AddGeoCacheMembersReply:
+		defer wg.Done()
+		if s.Success {
+			api.SetVersion(c, s.Version)
+			api.OK(c, contracts.GeoCacheAddContract{Key: s.Key, Added: s.Added, Updated: s.Updated, Version: s.Version})
+		} else if s.Conflict {
+			api.PreconditionFailed(c, fmt.Sprintf("version of key '%s' does not match", s.Key))
+		} else if s.Error != "" {
+			api.Bad(c, fmt.Sprintf("malformed request: %s", s.Error))
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	case *act.RemoveGeoCacheMembersReply:
+		defer wg.Done()
+		if s.Success && s.Removed > 0 {
+			api.SetVersion(c, s.Version)
+			api.NoContent(c)
+		} else if s.Success {
+			api.Bad(c, fmt.Sprintf("geo member of key '%s' was already deleted or never existed", s.Key))
+		} else if s.Conflict {
+			api.PreconditionFailed(c, fmt.Sprintf("version of key '%s' does not match", s.Key))
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	case *act.GetGeoCachePositionsReply:
+		defer wg.Done()
+		if s.Success {
+			api.SetVersion(c, s.Version)
+			api.OK(c, contracts.GeoCachePositionsContract{Key: s.Key, Positions: toGeoPositionsDto(s.Positions), Missing: s.Missing})
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	case *act.GetGeoCacheDistanceReply:
+		defer wg.Done()
+		if s.Success && s.Found {
+			unit, factor := geoUnit(c)
+			api.SetVersion(c, s.Version)
+			api.OK(c, contracts.GeoCacheDistanceContract{Key: s.Key, From: c.Query("from"), To: c.Query("to"), Distance: s.Distance / factor, Unit: unit})
+		} else if s.Success {
+			api.NotFound(c, fmt.Sprintf("member '%s' or '%s' of key '%s' was not found", c.Query("from"), c.Query("to"), s.Key))
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	case *act.SearchGeoCacheReply:
+		defer wg.Done()
+		if s.Success {
+			unit, factor := geoUnit(c)
+			results := make([]contracts.GeoSearchResultContract, len(s.Results))
+			for i, r := range s.Results {
+				results[i] = contracts.GeoSearchResultContract{
+					GeoPositionContract: contracts.GeoPositionContract{Member: r.Member, Longitude: r.Longitude, Latitude: r.Latitude, Geohash: r.Geohash},
+					Distance:            r.Distance / factor}
+			}
+			api.SetVersion(c, s.Version)
+			api.OK(c, contracts.GeoCacheSearchContract{Key: s.Key, Unit: unit, Results: results, Version: s.Version})
+		} else if s.Error != "" {
+			geoError(c, s.Key, s.Error)
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	}
+}
+
+func createGeoReplyActor(c *gin.Context, wg *sync.WaitGroup) *actor.PID {
+	return actor.Spawn(actor.FromFunc(func(ctx actor.Context) {
+		dispatchGeoReply(c, ctx, wg)
+	}))
+}
@@ -0,0 +1,216 @@
+package controllers
+
+import (
+	"fmt"
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/VitalKrasilnikau/memcache/api/contracts"
+	"github.com/VitalKrasilnikau/memcache/api/utils"
+	"github.com/VitalKrasilnikau/memcache/core/actors"
+	"github.com/VitalKrasilnikau/memcache/core/cache"
+	"github.com/gin-gonic/gin"
+	"sync"
+)
+
+// GetHyperLogLogCacheKeyHandler API which gets the estimated number of distinct values of HyperLogLog or its metadata by key.
+// With "with" query parameter the estimate of the union of the HyperLogLogs is returned like PFCOUNT with several keys.
+func GetHyperLogLogCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		if isMetadataRequest(c) {
+			getCacheMetadata(c, pid, key)
+			return
+		}
+		if others := queryList(c, "with"); len(others) > 0 {
+			keys := append([]string{key}, others...)
+			union := cache.NewHyperLogLog()
+			for _, data := range readHyperLogLogs(pid, keys) {
+				if h, err := cache.UnmarshalHyperLogLog(data); err == nil {
+					union.Merge(h)
+				}
+			}
+			api.OK(c, contracts.HyperLogLogCacheCountContract{Key: key, Keys: keys, Count: union.Count()})
+			return
+		}
+		act.Await(
+			pid,
+			func(wg *sync.WaitGroup) *actor.PID {
+				return createHyperLogLogReplyActor(c, wg)
+			},
+			func() interface{} {
+				return &act.GetHyperLogLogCacheKeyMessage{Key: key}
+			})
+	}
+}
+
+// DeleteHyperLogLogCacheKeyHandler API which deletes HyperLogLog cache entry by key, If-Match header makes the deletion conditional.
+func DeleteHyperLogLogCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		version, ok := requestVersion(c, 0)
+		if !ok {
+			return
+		}
+		act.Await(
+			pid,
+			func(wg *sync.WaitGroup) *actor.PID {
+				return createHyperLogLogReplyActor(c, wg)
+			},
+			func() interface{} {
+				return &act.DeleteHyperLogLogCacheKeyMessage{Key: key, Version: version}
+			})
+	}
+}
+
+// PostHyperLogLogCacheKeyHandler API which posts new HyperLogLog with optional values.
+func PostHyperLogLogCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		var json contracts.NewHyperLogLogCacheValuesContract
+		if err := c.ShouldBindJSON(&json); err == nil {
+			ttl, err := api.ParseTTL(json.TTL)
+			if err != nil {
+				api.Bad(c, fmt.Sprintf("malformed request: %s", err.Error()))
+				return
+			}
+			act.Await(
+				pid,
+				func(wg *sync.WaitGroup) *actor.PID {
+					return createHyperLogLogReplyActor(c, wg)
+				},
+				func() interface{} {
+					return &act.PostHyperLogLogCacheKeyMessage{
+						Key:     json.Key,
+						Values:  json.Values,
+						TTL:     ttl,
+						Sliding: json.Sliding}
+				})
+		} else {
+			api.Bad(c, fmt.Sprintf("malformed request: %s", err.Error()))
+		}
+	}
+}
+
+// AddHyperLogLogCacheValuesHandler API which adds values to HyperLogLog like PFADD, "create" option creates the missing key.
+func AddHyperLogLogCacheValuesHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		var json contracts.AddHyperLogLogCacheValuesContract
+		if err := c.ShouldBindJSON(&json); err == nil {
+			version, ok := requestVersion(c, json.Version)
+			if !ok {
+				return
+			}
+			act.Await(
+				pid,
+				func(wg *sync.WaitGroup) *actor.PID {
+					return createHyperLogLogReplyActor(c, wg)
+				},
+				func() interface{} {
+					return &act.AddHyperLogLogCacheValuesMessage{
+						Key:     key,
+						Values:  json.Values,
+						Create:  json.Create,
+						Version: version}
+				})
+		} else {
+			api.Bad(c, fmt.Sprintf("malformed request: %s", err.Error()))
+		}
+	}
+}
+
+// MergeHyperLogLogCacheHandler API which merges the HyperLogLogs specified into the one by key like PFMERGE.
+// The key is created if missing, missing sources are skipped.
+func MergeHyperLogLogCacheHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		var json contracts.MergeHyperLogLogCacheContract
+		if err := c.ShouldBindJSON(&json); err == nil {
+			version, ok := requestVersion(c, json.Version)
+			if !ok {
+				return
+			}
+			sources := readHyperLogLogs(pid, json.Keys)
+			act.Await(
+				pid,
+				func(wg *sync.WaitGroup) *actor.PID {
+					return createHyperLogLogReplyActor(c, wg)
+				},
+				func() interface{} {
+					return &act.MergeHyperLogLogCacheMessage{Key: key, Sources: sources, Version: version}
+				})
+		} else {
+			api.Bad(c, fmt.Sprintf("malformed request: %s", err.Error()))
+		}
+	}
+}
+
+// readHyperLogLogs requests serialized HyperLogLogs one by one since they can be stored by different actors, missing keys are skipped.
+func readHyperLogLogs(pid *actor.PID, keys []string) [][]byte {
+	var res [][]byte
+	for _, k := range keys {
+		if s, ok := act.AwaitReply(pid, &act.GetHyperLogLogCacheKeyMessage{Key: k}).(*act.GetHyperLogLogCacheKeyReply); ok && s.Success {
+			res = append(res, s.Data)
+		}
+	}
+	return res
+}
+
+func dispatchHyperLogLogReply(c *gin.Context, ctx actor.Context, wg *sync.WaitGroup) {
+	switch s := ctx.Message().(type) {
+	case *act.GetHyperLogLogCacheKeyReply:
+		defer wg.Done()
+		if s.Success {
+			api.SetVersion(c, s.Version)
+			api.OK(c, contracts.HyperLogLogCacheCountContract{Key: s.Key, Count: s.Count, Version: s.Version})
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	case *act.DeleteHyperLogLogCacheKeyReply:
+		defer wg.Done()
+		if s.Success {
+			api.NoContent(c)
+		} else if s.Conflict {
+			api.PreconditionFailed(c, fmt.Sprintf("version of key '%s' does not match", s.Key))
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	case *act.PostHyperLogLogCacheKeyReply:
+		defer wg.Done()
+		if s.Success {
+			api.SetVersion(c, s.Version)
+			api.Created(c)
+		} else {
+			api.Bad(c, fmt.Sprintf("key '%s' was already used", s.Key))
+		}
+		break
+	case *act.AddHyperLogLogCacheValuesReply:
+		defer wg.Done()
+		if s.Success {
+			api.SetVersion(c, s.Version)
+			api.OK(c, contracts.HyperLogLogCacheAddContract{Key: s.Key, Changed: s.Changed, Count: s.Count, Version: s.Version})
+		} else if s.Conflict {
+			api.PreconditionFailed(c, fmt.Sprintf("version of key '%s' does not match", s.Key))
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	case *act.MergeHyperLogLogCacheReply:
+		defer wg.Done()
+		if s.Success {
+			api.SetVersion(c, s.Version)
+			api.OK(c, contracts.HyperLogLogCacheCountContract{Key: s.Key, Count: s.Count, Version: s.Version})
+		} else if s.Conflict {
+			api.PreconditionFailed(c, fmt.Sprintf("version of key '%s' does not match", s.Key))
+		} else {
+			api.Bad(c, fmt.Sprintf("malformed request: %s", s.Error))
+		}
+		break
+	}
+}
+
+func createHyperLogLogReplyActor(c *gin.Context, wg *sync.WaitGroup) *actor.PID {
+	return actor.Spawn(actor.FromFunc(func(ctx actor.Context) {
+		dispatchHyperLogLogReply(c, ctx, wg)
+	}))
+}
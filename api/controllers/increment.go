@@ -0,0 +1,53 @@
+package controllers
+
+import (
+	"fmt"
+	"github.com/VitalKrasilnikau/memcache/api/contracts"
+	"github.com/VitalKrasilnikau/memcache/api/utils"
+	"github.com/VitalKrasilnikau/memcache/core/cache"
+	"github.com/gin-gonic/gin"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// bindIncrement reads optional increment request body, empty body means increment by one.
+// The sign is -1 for decrements.
+func bindIncrement(c *gin.Context, sign int64) (contracts.IncrementCacheValueContract, cache.Increment, time.Duration, bool) {
+	var json contracts.IncrementCacheValueContract
+	if err := c.ShouldBindJSON(&json); err != nil && err != io.EOF {
+		api.Bad(c, fmt.Sprintf("malformed request: %s", err.Error()))
+		return json, cache.Increment{}, 0, false
+	}
+	ttl, err := api.ParseTTL(json.TTL)
+	if err != nil {
+		api.Bad(c, fmt.Sprintf("malformed request: %s", err.Error()))
+		return json, cache.Increment{}, 0, false
+	}
+	inc, err := toIncrement(string(json.Delta), json.Float, sign)
+	if err != nil {
+		api.Bad(c, err.Error())
+		return json, cache.Increment{}, 0, false
+	}
+	return json, inc, ttl, true
+}
+
+func toIncrement(delta string, float bool, sign int64) (cache.Increment, error) {
+	if delta == "" {
+		delta = "1"
+	}
+	if float || strings.ContainsAny(delta, ".eE") {
+		f, err := strconv.ParseFloat(delta, 64)
+		if err != nil || math.IsNaN(f) || math.IsInf(f, 0) {
+			return cache.Increment{}, fmt.Errorf("malformed delta '%s'", delta)
+		}
+		return cache.Increment{FloatDelta: f * float64(sign), Float: true}, nil
+	}
+	d, err := strconv.ParseInt(delta, 10, 64)
+	if err != nil || (sign < 0 && d == math.MinInt64) {
+		return cache.Increment{}, fmt.Errorf("malformed delta '%s'", delta)
+	}
+	return cache.Increment{Delta: d * sign}, nil
+}
@@ -7,13 +7,19 @@ import (
 	"github.com/VitalKrasilnikau/memcache/api/utils"
 	"github.com/VitalKrasilnikau/memcache/core/actors"
 	"github.com/gin-gonic/gin"
+	"io"
+	"strconv"
 	"sync"
 )
 
-// GetListCacheKeyHandler API which gets list cache entry by key.
+// GetListCacheKeyHandler API which gets list cache entry or its metadata by key.
 func GetListCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
 	return func(c *gin.Context) {
 		key := c.Param("key")
+		if isMetadataRequest(c) {
+			getCacheMetadata(c, pid, key)
+			return
+		}
 		act.Await(
 			pid,
 			func(wg *sync.WaitGroup) *actor.PID {
@@ -29,13 +35,17 @@ func GetListCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
 func DeleteListCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
 	return func(c *gin.Context) {
 		key := c.Param("key")
+		version, ok := requestVersion(c, 0)
+		if !ok {
+			return
+		}
 		act.Await(
 			pid,
 			func(wg *sync.WaitGroup) *actor.PID {
 				return createListReplyActor(c, wg)
 			},
 			func() interface{} {
-				return &act.DeleteListCacheKeyMessage{Key: key}
+				return &act.DeleteListCacheKeyMessage{Key: key, Version: version}
 			})
 	}
 }
@@ -45,6 +55,11 @@ func PostListCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
 	return func(c *gin.Context) {
 		var json contracts.NewListCacheValuesContract
 		if err := c.ShouldBindJSON(&json); err == nil {
+			ttl, err := api.ParseTTL(json.TTL)
+			if err != nil {
+				api.Bad(c, fmt.Sprintf("malformed request: %s", err.Error()))
+				return
+			}
 			act.Await(
 				pid,
 				func(wg *sync.WaitGroup) *actor.PID {
@@ -54,7 +69,8 @@ func PostListCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
 					return &act.PostListCacheKeyMessage{
 						Key:     json.Key,
 						Values:  json.Values,
-						TTL:     api.ParseDuration(json.TTL)}
+						TTL:     ttl,
+						Sliding: json.Sliding}
 				})
 		} else {
 			api.Bad(c, fmt.Sprintf("malformed request: %s", err.Error()))
@@ -69,13 +85,17 @@ func PutListCacheValueHandler(pid *actor.PID) func(*gin.Context) {
 		value := c.Param("value")
 		var json contracts.UpdateListCacheValueContract
 		if err := c.ShouldBindJSON(&json); err == nil {
+			version, ok := requestVersion(c, json.Version)
+			if !ok {
+				return
+			}
 			act.Await(
 				pid,
 				func(wg *sync.WaitGroup) *actor.PID {
 					return createListReplyActor(c, wg)
 				},
 				func() interface{} {
-					return &act.PutListCacheValueMessage{Key: key, NewValue: json.Value, OriginalValue: value}
+					return &act.PutListCacheValueMessage{Key: key, NewValue: json.Value, OriginalValue: value, Version: version}
 				})
 		} else {
 			api.Bad(c, fmt.Sprintf("malformed request: %s", err.Error()))
@@ -89,13 +109,17 @@ func PostListCacheValueHandler(pid *actor.PID) func(*gin.Context) {
 		key := c.Param("key")
 		var json contracts.UpdateListCacheValueContract
 		if err := c.ShouldBindJSON(&json); err == nil {
+			version, ok := requestVersion(c, json.Version)
+			if !ok {
+				return
+			}
 			act.Await(
 				pid,
 				func(wg *sync.WaitGroup) *actor.PID {
 					return createListReplyActor(c, wg)
 				},
 				func() interface{} {
-					return &act.PostListCacheValueMessage{Key: key, NewValue: json.Value}
+					return &act.PostListCacheValueMessage{Key: key, NewValue: json.Value, Version: version}
 				})
 		} else {
 			api.Bad(c, fmt.Sprintf("malformed request: %s", err.Error()))
@@ -108,67 +132,395 @@ func DeleteListCacheValueHandler(pid *actor.PID) func(*gin.Context) {
 	return func(c *gin.Context) {
 		key := c.Param("key")
 		value := c.Param("value")
+		version, ok := requestVersion(c, 0)
+		if !ok {
+			return
+		}
+		act.Await(
+			pid,
+			func(wg *sync.WaitGroup) *actor.PID {
+				return createListReplyActor(c, wg)
+			},
+			func() interface{} {
+				return &act.DeleteListCacheValueMessage{Key: key, Value: value, Version: version}
+			})
+	}
+}
+
+// GetListCacheIndexHandler API which gets the list value by zero-based "index", negative indexes are counted from the end.
+func GetListCacheIndexHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		index, err := strconv.ParseInt(c.DefaultQuery("index", "0"), 10, 64)
+		if err != nil {
+			api.Bad(c, fmt.Sprintf("malformed index '%s'", c.Query("index")))
+			return
+		}
+		act.Await(
+			pid,
+			func(wg *sync.WaitGroup) *actor.PID {
+				return createListReplyActor(c, wg)
+			},
+			func() interface{} {
+				return &act.GetListCacheIndexMessage{Key: key, Index: index}
+			})
+	}
+}
+
+// GetListCacheRangeHandler API which gets the list values from "start" to "stop" zero-based indexes inclusive like LRANGE,
+// negative indexes are counted from the end. The reply contains the length of the list for pagination.
+func GetListCacheRangeHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		start, err := strconv.ParseInt(c.DefaultQuery("start", "0"), 10, 64)
+		if err != nil {
+			api.Bad(c, fmt.Sprintf("malformed start '%s'", c.Query("start")))
+			return
+		}
+		stop, err := strconv.ParseInt(c.DefaultQuery("stop", "-1"), 10, 64)
+		if err != nil {
+			api.Bad(c, fmt.Sprintf("malformed stop '%s'", c.Query("stop")))
+			return
+		}
 		act.Await(
 			pid,
 			func(wg *sync.WaitGroup) *actor.PID {
 				return createListReplyActor(c, wg)
 			},
 			func() interface{} {
-				return &act.DeleteListCacheValueMessage{Key: key, Value: value}
+				return &act.GetListCacheRangeMessage{Key: key, Start: start, Stop: stop}
 			})
 	}
 }
 
+// SetListCacheIndexHandler API which replaces the list value by its index, duplicates of the value are kept.
+func SetListCacheIndexHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		var json contracts.UpdateListCacheIndexContract
+		if err := c.ShouldBindJSON(&json); err == nil {
+			version, ok := requestVersion(c, json.Version)
+			if !ok {
+				return
+			}
+			act.Await(
+				pid,
+				func(wg *sync.WaitGroup) *actor.PID {
+					return createListReplyActor(c, wg)
+				},
+				func() interface{} {
+					return &act.SetListCacheIndexMessage{Key: key, Index: json.Index, Value: json.Value, Version: version}
+				})
+		} else {
+			api.Bad(c, fmt.Sprintf("malformed request: %s", err.Error()))
+		}
+	}
+}
+
+// InsertListCacheValueHandler API which inserts the value before the index and replies with the new length of the list,
+// the index equal to the length appends the value.
+func InsertListCacheValueHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		var json contracts.UpdateListCacheIndexContract
+		if err := c.ShouldBindJSON(&json); err == nil {
+			version, ok := requestVersion(c, json.Version)
+			if !ok {
+				return
+			}
+			act.Await(
+				pid,
+				func(wg *sync.WaitGroup) *actor.PID {
+					return createListReplyActor(c, wg)
+				},
+				func() interface{} {
+					return &act.InsertListCacheValueMessage{Key: key, Index: json.Index, Value: json.Value, Version: version}
+				})
+		} else {
+			api.Bad(c, fmt.Sprintf("malformed request: %s", err.Error()))
+		}
+	}
+}
+
+// RemoveListCacheIndexHandler API which removes the list value by its index and replies with the value removed.
+func RemoveListCacheIndexHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		var json contracts.RemoveListCacheIndexContract
+		if err := c.ShouldBindJSON(&json); err == nil {
+			version, ok := requestVersion(c, json.Version)
+			if !ok {
+				return
+			}
+			act.Await(
+				pid,
+				func(wg *sync.WaitGroup) *actor.PID {
+					return createListReplyActor(c, wg)
+				},
+				func() interface{} {
+					return &act.RemoveListCacheIndexMessage{Key: key, Index: json.Index, Version: version}
+				})
+		} else {
+			api.Bad(c, fmt.Sprintf("malformed request: %s", err.Error()))
+		}
+	}
+}
+
+// PushLeftListCacheValuesHandler API which adds the values to the head of the list like LPUSH and replies with the new length of the list.
+func PushLeftListCacheValuesHandler(pid *actor.PID) func(*gin.Context) {
+	return pushListCacheValues(pid, true)
+}
+
+// PushRightListCacheValuesHandler API which adds the values to the tail of the list like RPUSH and replies with the new length of the list.
+func PushRightListCacheValuesHandler(pid *actor.PID) func(*gin.Context) {
+	return pushListCacheValues(pid, false)
+}
+
+func pushListCacheValues(pid *actor.PID, left bool) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		var json contracts.PushListCacheValuesContract
+		if err := c.ShouldBindJSON(&json); err == nil {
+			version, ok := requestVersion(c, json.Version)
+			if !ok {
+				return
+			}
+			act.Await(
+				pid,
+				func(wg *sync.WaitGroup) *actor.PID {
+					return createListReplyActor(c, wg)
+				},
+				func() interface{} {
+					return &act.PushListCacheValuesMessage{Key: key, Values: json.Values, Left: left, Create: json.Create, Version: version}
+				})
+		} else {
+			api.Bad(c, fmt.Sprintf("malformed request: %s", err.Error()))
+		}
+	}
+}
+
+// PopLeftListCacheValuesHandler API which removes the values from the head of the list like LPOP and replies with them,
+// with timeout specified waits for the values like BLPOP.
+func PopLeftListCacheValuesHandler(pid *actor.PID) func(*gin.Context) {
+	return popListCacheValues(pid, true)
+}
+
+// PopRightListCacheValuesHandler API which removes the values from the tail of the list like RPOP and replies with them,
+// with timeout specified waits for the values like BRPOP.
+func PopRightListCacheValuesHandler(pid *actor.PID) func(*gin.Context) {
+	return popListCacheValues(pid, false)
+}
+
+func popListCacheValues(pid *actor.PID, left bool) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		var json contracts.PopListCacheValuesContract
+		if err := c.ShouldBindJSON(&json); err != nil && err != io.EOF {
+			api.Bad(c, fmt.Sprintf("malformed request: %s", err.Error()))
+			return
+		}
+		timeout, err := api.ParseTTL(json.Timeout)
+		if err != nil {
+			api.Bad(c, fmt.Sprintf("malformed request: %s", err.Error()))
+			return
+		}
+		version, ok := requestVersion(c, json.Version)
+		if !ok {
+			return
+		}
+		act.Await(
+			pid,
+			func(wg *sync.WaitGroup) *actor.PID {
+				return createListReplyActor(c, wg)
+			},
+			func() interface{} {
+				return &act.PopListCacheValuesMessage{Key: key, Count: json.Count, Left: left, Timeout: timeout, Version: version}
+			})
+	}
+}
+
+// TrimListCacheKeyHandler API which keeps only the values from start to stop indexes inclusive like LTRIM,
+// e.g. start -100 and stop -1 keep the last 100 values.
+func TrimListCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		var json contracts.TrimListCacheContract
+		if err := c.ShouldBindJSON(&json); err == nil {
+			version, ok := requestVersion(c, json.Version)
+			if !ok {
+				return
+			}
+			act.Await(
+				pid,
+				func(wg *sync.WaitGroup) *actor.PID {
+					return createListReplyActor(c, wg)
+				},
+				func() interface{} {
+					return &act.TrimListCacheKeyMessage{Key: key, Start: json.Start, Stop: json.Stop, Version: version}
+				})
+		} else {
+			api.Bad(c, fmt.Sprintf("malformed request: %s", err.Error()))
+		}
+	}
+}
+
 func dispatchListReply(c *gin.Context, ctx actor.Context, wg *sync.WaitGroup) {
 	switch s := ctx.Message().(type) {
-	case act.GetListCacheKeyReply:
+	case *act.GetListCacheKeyReply:
 		defer wg.Done()
 		if s.Success {
-			api.OK(c, contracts.ListCacheValueContract{Key: s.Key, Values: s.Values})
+			api.SetVersion(c, s.Version)
+			api.OK(c, contracts.ListCacheValueContract{Key: s.Key, Values: s.Values, Version: s.Version})
 		} else {
 			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
 		}
 		break
-	case act.DeleteListCacheKeyReply:
+	case *act.DeleteListCacheKeyReply:
 		defer wg.Done()
 		if s.Success {
 			api.NoContent(c)
+		} else if s.Conflict {
+			api.PreconditionFailed(c, fmt.Sprintf("version of key '%s' does not match", s.Key))
 		} else {
 			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
 		}
 		break
-	case act.PostListCacheKeyReply:
+	case *act.PostListCacheKeyReply:
 		defer wg.Done()
 		if s.Success {
+			api.SetVersion(c, s.Version)
 			api.Created(c)
 		} else {
 			api.Bad(c, fmt.Sprintf("key '%s' was already used", s.Key))
 		}
 		break
-	case act.PutListCacheValueReply:
+	case *act.PutListCacheValueReply:
 		defer wg.Done()
 		if s.Success {
+			api.SetVersion(c, s.Version)
 			api.NoContent(c)
+		} else if s.Conflict {
+			api.PreconditionFailed(c, fmt.Sprintf("version of key '%s' does not match", s.Key))
 		} else {
 			api.Bad(c, fmt.Sprintf("list value '%s' of key '%s' was already changed or never existed", s.OriginalValue, s.Key))
 		}
 		break
-	case act.PostListCacheValueReply:
+	case *act.PostListCacheValueReply:
 		defer wg.Done()
 		if s.Success {
+			api.SetVersion(c, s.Version)
 			api.Created(c)
+		} else if s.Conflict {
+			api.PreconditionFailed(c, fmt.Sprintf("version of key '%s' does not match", s.Key))
 		} else {
 			api.Bad(c, fmt.Sprintf("key '%s' was not found", s.Key))
 		}
 		break
-	case act.DeleteListCacheValueReply:
+	case *act.DeleteListCacheValueReply:
 		defer wg.Done()
 		if s.Success {
+			api.SetVersion(c, s.Version)
 			api.NoContent(c)
+		} else if s.Conflict {
+			api.PreconditionFailed(c, fmt.Sprintf("version of key '%s' does not match", s.Key))
 		} else {
 			api.Bad(c, fmt.Sprintf("list value '%s' of key '%s' was already deleted or never existed", s.DeletedValue, s.Key))
 		}
 		break
+	case *act.GetListCacheIndexReply:
+		defer wg.Done()
+		if s.Success {
+			api.SetVersion(c, s.Version)
+			api.OK(c, contracts.ListCacheIndexValueContract{Key: s.Key, Index: s.Index, Value: s.Value, Version: s.Version})
+		} else if s.OutOfRange {
+			api.NotFound(c, fmt.Sprintf("index %d is out of range of key '%s'", s.Index, s.Key))
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	case *act.GetListCacheRangeReply:
+		defer wg.Done()
+		if s.Success {
+			api.SetVersion(c, s.Version)
+			api.OK(c, contracts.ListCacheRangeContract{Key: s.Key, Values: s.Values, Start: s.Start, Length: s.Length, Version: s.Version})
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	case *act.SetListCacheIndexReply:
+		defer wg.Done()
+		if s.Success {
+			api.SetVersion(c, s.Version)
+			api.NoContent(c)
+		} else if s.Conflict {
+			api.PreconditionFailed(c, fmt.Sprintf("version of key '%s' does not match", s.Key))
+		} else if s.OutOfRange {
+			api.Bad(c, fmt.Sprintf("index %d is out of range of key '%s'", s.Index, s.Key))
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	case *act.InsertListCacheValueReply:
+		defer wg.Done()
+		if s.Success {
+			api.SetVersion(c, s.Version)
+			api.OK(c, contracts.ListCacheLengthContract{Key: s.Key, Length: s.Length, Version: s.Version})
+		} else if s.Conflict {
+			api.PreconditionFailed(c, fmt.Sprintf("version of key '%s' does not match", s.Key))
+		} else if s.OutOfRange {
+			api.Bad(c, fmt.Sprintf("index %d is out of range of key '%s'", s.Index, s.Key))
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	case *act.RemoveListCacheIndexReply:
+		defer wg.Done()
+		if s.Success {
+			api.SetVersion(c, s.Version)
+			api.OK(c, contracts.ListCacheIndexValueContract{Key: s.Key, Index: s.Index, Value: s.RemovedValue, Version: s.Version})
+		} else if s.Conflict {
+			api.PreconditionFailed(c, fmt.Sprintf("version of key '%s' does not match", s.Key))
+		} else if s.OutOfRange {
+			api.Bad(c, fmt.Sprintf("index %d is out of range of key '%s'", s.Index, s.Key))
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	case *act.PushListCacheValuesReply:
+		defer wg.Done()
+		if s.Success {
+			api.SetVersion(c, s.Version)
+			api.OK(c, contracts.ListCacheLengthContract{Key: s.Key, Length: s.Length, Version: s.Version})
+		} else if s.Conflict {
+			api.PreconditionFailed(c, fmt.Sprintf("version of key '%s' does not match", s.Key))
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	case *act.PopListCacheValuesReply:
+		defer wg.Done()
+		if s.Success {
+			api.SetVersion(c, s.Version)
+			api.OK(c, contracts.ListCacheValueContract{Key: s.Key, Values: s.Values, Version: s.Version})
+		} else if s.Conflict {
+			api.PreconditionFailed(c, fmt.Sprintf("version of key '%s' does not match", s.Key))
+		} else if s.TimedOut {
+			api.NotFound(c, fmt.Sprintf("no values were pushed to key '%s' before the timeout", s.Key))
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found or empty", s.Key))
+		}
+		break
+	case *act.TrimListCacheKeyReply:
+		defer wg.Done()
+		if s.Success {
+			api.SetVersion(c, s.Version)
+			api.OK(c, contracts.ListCacheTrimContract{Key: s.Key, Removed: s.Removed, Length: s.Length, Version: s.Version})
+		} else if s.Conflict {
+			api.PreconditionFailed(c, fmt.Sprintf("version of key '%s' does not match", s.Key))
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
 	}
 }
 
@@ -0,0 +1,169 @@
+package controllers
+
+import (
+	"fmt"
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/VitalKrasilnikau/memcache/api/contracts"
+	"github.com/VitalKrasilnikau/memcache/api/utils"
+	"github.com/VitalKrasilnikau/memcache/core/actors"
+	"github.com/gin-gonic/gin"
+	"sync"
+)
+
+// GetLockCacheKeyHandler API which gets the fencing token and the remaining lease of the held lock or its metadata by key.
+func GetLockCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		if isMetadataRequest(c) {
+			getCacheMetadata(c, pid, key)
+			return
+		}
+		act.Await(
+			pid,
+			func(wg *sync.WaitGroup) *actor.PID {
+				return createLockReplyActor(c, wg)
+			},
+			func() interface{} {
+				return &act.GetLockCacheKeyMessage{Key: key}
+			})
+	}
+}
+
+// AcquireLockCacheKeyHandler API which acquires the lock for the owner with the lease and replies with the fencing token.
+// The lock held by another owner is not acquired and the time remaining until it expires is replied, so the caller can retry.
+func AcquireLockCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		var json contracts.AcquireLockContract
+		if err := c.ShouldBindJSON(&json); err == nil {
+			lease, err := api.ParseTTL(json.Lease)
+			if err != nil {
+				api.Bad(c, fmt.Sprintf("malformed lease: %s", err.Error()))
+				return
+			}
+			act.Await(
+				pid,
+				func(wg *sync.WaitGroup) *actor.PID {
+					return createLockReplyActor(c, wg)
+				},
+				func() interface{} {
+					return &act.AcquireLockCacheKeyMessage{Key: key, Owner: json.Owner, Lease: lease}
+				})
+		} else {
+			api.Bad(c, fmt.Sprintf("malformed request: %s", err.Error()))
+		}
+	}
+}
+
+// RenewLockCacheKeyHandler API which extends the lease of the lock held by the owner, the fencing token is not changed.
+func RenewLockCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		var json contracts.RenewLockContract
+		if err := c.ShouldBindJSON(&json); err == nil {
+			lease, err := api.ParseTTL(json.Lease)
+			if err != nil {
+				api.Bad(c, fmt.Sprintf("malformed lease: %s", err.Error()))
+				return
+			}
+			act.Await(
+				pid,
+				func(wg *sync.WaitGroup) *actor.PID {
+					return createLockReplyActor(c, wg)
+				},
+				func() interface{} {
+					return &act.RenewLockCacheKeyMessage{Key: key, Owner: json.Owner, Lease: lease}
+				})
+		} else {
+			api.Bad(c, fmt.Sprintf("malformed request: %s", err.Error()))
+		}
+	}
+}
+
+// ReleaseLockCacheKeyHandler API which releases the lock if it is held by the owner specified by the query parameter.
+func ReleaseLockCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		owner := c.Query("owner")
+		if owner == "" {
+			api.Bad(c, "malformed request: owner is required")
+			return
+		}
+		act.Await(
+			pid,
+			func(wg *sync.WaitGroup) *actor.PID {
+				return createLockReplyActor(c, wg)
+			},
+			func() interface{} {
+				return &act.ReleaseLockCacheKeyMessage{Key: key, Owner: owner}
+			})
+	}
+}
+
+func dispatchLockReply(c *gin.Context, ctx actor.Context, wg *sync.WaitGroup) {
+	switch s := ctx.Message().(type) {
+	case *act.GetLockCacheKeyReply:
+		defer wg.Done()
+		if s.Success {
+			api.SetVersion(c, s.Version)
+			api.OK(c, contracts.LockInfoContract{
+				Key:      s.Key,
+				Fence:    s.Fence,
+				Acquired: formatUnixNano(s.Acquired),
+				Lease:    api.DurationToString(s.Lease),
+				Version:  s.Version})
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	case *act.AcquireLockCacheKeyReply:
+		defer wg.Done()
+		if s.Success {
+			api.SetVersion(c, s.Version)
+			api.OK(c, contracts.LockContract{
+				Key:      s.Key,
+				Acquired: s.Acquired,
+				Owner:    s.Owner,
+				Fence:    s.Fence,
+				Lease:    api.DurationToString(s.Lease),
+				Version:  s.Version})
+		} else {
+			api.Bad(c, fmt.Sprintf("malformed request: %s", s.Error))
+		}
+		break
+	case *act.RenewLockCacheKeyReply:
+		defer wg.Done()
+		if s.Error != "" {
+			api.Bad(c, fmt.Sprintf("malformed request: %s", s.Error))
+		} else if s.Renewed {
+			api.SetVersion(c, s.Version)
+			api.OK(c, contracts.LockContract{
+				Key:      s.Key,
+				Acquired: true,
+				Fence:    s.Fence,
+				Lease:    api.DurationToString(s.Lease),
+				Version:  s.Version})
+		} else if s.Success {
+			api.Conflict(c, fmt.Sprintf("lock '%s' is held by another owner", s.Key))
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	case *act.ReleaseLockCacheKeyReply:
+		defer wg.Done()
+		if s.Released {
+			api.NoContent(c)
+		} else if s.Success {
+			api.Conflict(c, fmt.Sprintf("lock '%s' is held by another owner", s.Key))
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	}
+}
+
+func createLockReplyActor(c *gin.Context, wg *sync.WaitGroup) *actor.PID {
+	return actor.Spawn(actor.FromFunc(func(ctx actor.Context) {
+		dispatchLockReply(c, ctx, wg)
+	}))
+}
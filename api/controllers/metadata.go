@@ -0,0 +1,69 @@
+package controllers
+
+import (
+	"fmt"
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/VitalKrasilnikau/memcache/api/contracts"
+	"github.com/VitalKrasilnikau/memcache/api/utils"
+	"github.com/VitalKrasilnikau/memcache/core/actors"
+	"github.com/gin-gonic/gin"
+	"sync"
+	"time"
+)
+
+// isMetadataRequest returns true if entry metadata is requested instead of its value by "meta=true" query parameter.
+func isMetadataRequest(c *gin.Context) bool {
+	return c.Query("meta") == "true"
+}
+
+// getCacheMetadata replies with timestamps, expiration, version and size of string, list or dictionary cache entry by key.
+func getCacheMetadata(c *gin.Context, pid *actor.PID, key string) {
+	act.Await(
+		pid,
+		func(wg *sync.WaitGroup) *actor.PID {
+			return createMetadataReplyActor(c, wg)
+		},
+		func() interface{} {
+			return &act.GetCacheMetadataMessage{Key: key}
+		})
+}
+
+func dispatchMetadataReply(c *gin.Context, ctx actor.Context, wg *sync.WaitGroup) {
+	switch s := ctx.Message().(type) {
+	case *act.GetCacheMetadataReply:
+		defer wg.Done()
+		if s.Success {
+			api.OK(c, toMetadataContract(s))
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	}
+}
+
+func toMetadataContract(s *act.GetCacheMetadataReply) contracts.CacheEntryMetadataContract {
+	ttl, expires := formatExpiration(s.ExpireAfter)
+	return contracts.CacheEntryMetadataContract{
+		Key:       s.Key,
+		Created:   formatUnixTime(s.Added),
+		Updated:   formatUnixTime(s.Updated),
+		TTL:       ttl,
+		Expires:   expires,
+		Sliding:   s.Sliding > 0,
+		Persisted: s.Persisted,
+		Size:      s.Bytes,
+		Version:   s.Version}
+}
+
+func formatUnixTime(sec int64) string {
+	if sec == 0 {
+		return ""
+	}
+	return time.Unix(sec, 0).UTC().Format(time.RFC3339)
+}
+
+func createMetadataReplyActor(c *gin.Context, wg *sync.WaitGroup) *actor.PID {
+	return actor.Spawn(actor.FromFunc(func(ctx actor.Context) {
+		dispatchMetadataReply(c, ctx, wg)
+	}))
+}
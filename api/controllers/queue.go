@@ -0,0 +1,418 @@
+package controllers
+
+import (
+	"fmt"
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/VitalKrasilnikau/memcache/api/contracts"
+	"github.com/VitalKrasilnikau/memcache/api/utils"
+	"github.com/VitalKrasilnikau/memcache/core/actors"
+	"github.com/gin-gonic/gin"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// GetQueueCacheKeyHandler API which gets the number of ready, delayed, in-flight and dead items of queue or its metadata by key.
+func GetQueueCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		if isMetadataRequest(c) {
+			getCacheMetadata(c, pid, key)
+			return
+		}
+		act.Await(
+			pid,
+			func(wg *sync.WaitGroup) *actor.PID {
+				return createQueueReplyActor(c, wg)
+			},
+			func() interface{} {
+				return &act.GetQueueCacheKeyMessage{Key: key}
+			})
+	}
+}
+
+// DeleteQueueCacheKeyHandler API which deletes queue cache entry by key, If-Match header makes the deletion conditional.
+func DeleteQueueCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		version, ok := requestVersion(c, 0)
+		if !ok {
+			return
+		}
+		act.Await(
+			pid,
+			func(wg *sync.WaitGroup) *actor.PID {
+				return createQueueReplyActor(c, wg)
+			},
+			func() interface{} {
+				return &act.DeleteQueueCacheKeyMessage{Key: key, Version: version}
+			})
+	}
+}
+
+// PostQueueCacheKeyHandler API which posts new queue with the items specified, the default visibility timeout and the limit of delivery attempts.
+func PostQueueCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		var json contracts.NewQueueCacheContract
+		if err := c.ShouldBindJSON(&json); err == nil {
+			ttl, err := api.ParseTTL(json.TTL)
+			if err != nil {
+				api.Bad(c, fmt.Sprintf("malformed request: %s", err.Error()))
+				return
+			}
+			visibility, err := api.ParseTTL(json.Visibility)
+			if err != nil {
+				api.Bad(c, fmt.Sprintf("malformed visibility: %s", err.Error()))
+				return
+			}
+			if json.MaxAttempts < 0 {
+				api.Bad(c, "malformed request: maxAttempts must not be negative")
+				return
+			}
+			items, ok := toNewQueueItems(c, json.Items)
+			if !ok {
+				return
+			}
+			act.Await(
+				pid,
+				func(wg *sync.WaitGroup) *actor.PID {
+					return createQueueReplyActor(c, wg)
+				},
+				func() interface{} {
+					return &act.PostQueueCacheKeyMessage{
+						Key:         json.Key,
+						Items:       items,
+						Visibility:  visibility,
+						MaxAttempts: json.MaxAttempts,
+						TTL:         ttl,
+						Sliding:     json.Sliding}
+				})
+		} else {
+			api.Bad(c, fmt.Sprintf("malformed request: %s", err.Error()))
+		}
+	}
+}
+
+// PushQueueCacheItemsHandler API which pushes the items with their priorities and delays, "create" option creates the missing key.
+func PushQueueCacheItemsHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		var json contracts.PushQueueCacheContract
+		if err := c.ShouldBindJSON(&json); err == nil {
+			version, ok := requestVersion(c, json.Version)
+			if !ok {
+				return
+			}
+			items, ok := toNewQueueItems(c, json.Items)
+			if !ok {
+				return
+			}
+			act.Await(
+				pid,
+				func(wg *sync.WaitGroup) *actor.PID {
+					return createQueueReplyActor(c, wg)
+				},
+				func() interface{} {
+					return &act.PushQueueCacheItemsMessage{Key: key, Items: items, Create: json.Create, Version: version}
+				})
+		} else {
+			api.Bad(c, fmt.Sprintf("malformed request: %s", err.Error()))
+		}
+	}
+}
+
+// PopQueueCacheItemsHandler API which delivers up to count items with the highest priority which can be delivered now.
+// The items stay in flight until they are acknowledged or the visibility timeout passes.
+func PopQueueCacheItemsHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		var json contracts.PopQueueCacheContract
+		if err := c.ShouldBindJSON(&json); err == nil {
+			if json.Count < 0 {
+				api.Bad(c, "malformed request: count must not be negative")
+				return
+			}
+			visibility, err := api.ParseTTL(json.Visibility)
+			if err != nil {
+				api.Bad(c, fmt.Sprintf("malformed visibility: %s", err.Error()))
+				return
+			}
+			act.Await(
+				pid,
+				func(wg *sync.WaitGroup) *actor.PID {
+					return createQueueReplyActor(c, wg)
+				},
+				func() interface{} {
+					return &act.PopQueueCacheItemsMessage{Key: key, Count: json.Count, Visibility: visibility}
+				})
+		} else {
+			api.Bad(c, fmt.Sprintf("malformed request: %s", err.Error()))
+		}
+	}
+}
+
+// PeekQueueCacheItemsHandler API which gets up to count items which would be delivered next without delivering them.
+func PeekQueueCacheItemsHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		count, err := strconv.Atoi(c.DefaultQuery("count", "1"))
+		if err != nil || count < 0 {
+			api.Bad(c, fmt.Sprintf("malformed count '%s'", c.Query("count")))
+			return
+		}
+		act.Await(
+			pid,
+			func(wg *sync.WaitGroup) *actor.PID {
+				return createQueueReplyActor(c, wg)
+			},
+			func() interface{} {
+				return &act.PeekQueueCacheItemsMessage{Key: key, Count: int32(count)}
+			})
+	}
+}
+
+// AckQueueCacheItemsHandler API which removes the delivered items by their receipts,
+// the receipts of the items which returned to the queue are ignored.
+func AckQueueCacheItemsHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		var json contracts.AckQueueCacheContract
+		if err := c.ShouldBindJSON(&json); err == nil {
+			act.Await(
+				pid,
+				func(wg *sync.WaitGroup) *actor.PID {
+					return createQueueReplyActor(c, wg)
+				},
+				func() interface{} {
+					return &act.AckQueueCacheItemsMessage{Key: key, Receipts: json.Receipts}
+				})
+		} else {
+			api.Bad(c, fmt.Sprintf("malformed request: %s", err.Error()))
+		}
+	}
+}
+
+// NackQueueCacheItemHandler API which returns the delivered item to the queue to be delivered again after the delay,
+// the item is moved to the dead letters if it was delivered the maximum number of times.
+func NackQueueCacheItemHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		var json contracts.NackQueueCacheContract
+		if err := c.ShouldBindJSON(&json); err == nil {
+			delay, err := api.ParseTTL(json.Delay)
+			if err != nil {
+				api.Bad(c, fmt.Sprintf("malformed delay: %s", err.Error()))
+				return
+			}
+			act.Await(
+				pid,
+				func(wg *sync.WaitGroup) *actor.PID {
+					return createQueueReplyActor(c, wg)
+				},
+				func() interface{} {
+					return &act.NackQueueCacheItemMessage{Key: key, Receipt: json.Receipt, Delay: delay}
+				})
+		} else {
+			api.Bad(c, fmt.Sprintf("malformed request: %s", err.Error()))
+		}
+	}
+}
+
+// GetQueueCacheDeadHandler API which gets up to count dead letters of the queue in the order they died, zero count means all of them.
+func GetQueueCacheDeadHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		count, err := strconv.Atoi(c.DefaultQuery("count", "0"))
+		if err != nil || count < 0 {
+			api.Bad(c, fmt.Sprintf("malformed count '%s'", c.Query("count")))
+			return
+		}
+		act.Await(
+			pid,
+			func(wg *sync.WaitGroup) *actor.PID {
+				return createQueueReplyActor(c, wg)
+			},
+			func() interface{} {
+				return &act.GetQueueCacheDeadMessage{Key: key, Count: int32(count)}
+			})
+	}
+}
+
+// RedriveQueueCacheDeadHandler API which moves up to count dead letters back to the queue resetting their attempts.
+func RedriveQueueCacheDeadHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		var json contracts.RedriveQueueCacheContract
+		if err := c.ShouldBindJSON(&json); err == nil {
+			if json.Count < 0 {
+				api.Bad(c, "malformed request: count must not be negative")
+				return
+			}
+			act.Await(
+				pid,
+				func(wg *sync.WaitGroup) *actor.PID {
+					return createQueueReplyActor(c, wg)
+				},
+				func() interface{} {
+					return &act.RedriveQueueCacheDeadMessage{Key: key, Count: json.Count}
+				})
+		} else {
+			api.Bad(c, fmt.Sprintf("malformed request: %s", err.Error()))
+		}
+	}
+}
+
+// toNewQueueItems parses the delays of the items, replies with 400 status if any of them is malformed.
+func toNewQueueItems(c *gin.Context, items []contracts.NewQueueItemContract) ([]act.NewQueueItem, bool) {
+	res := make([]act.NewQueueItem, len(items))
+	for i, item := range items {
+		delay, err := api.ParseTTL(item.Delay)
+		if err != nil {
+			api.Bad(c, fmt.Sprintf("malformed delay of item %d: %s", i, err.Error()))
+			return nil, false
+		}
+		res[i] = act.NewQueueItem{Value: item.Value, Priority: item.Priority, Delay: delay}
+	}
+	return res, true
+}
+
+func toQueueItemsDto(items []act.QueueItem) []contracts.QueueItemContract {
+	res := make([]contracts.QueueItemContract, len(items))
+	for i, item := range items {
+		res[i] = contracts.QueueItemContract{
+			ID:        item.ID,
+			Receipt:   item.Receipt,
+			Value:     item.Value,
+			Priority:  item.Priority,
+			Attempts:  item.Attempts,
+			NotBefore: formatUnixNano(item.NotBefore),
+			Enqueued:  formatUnixNano(item.Enqueued),
+			Deadline:  formatUnixNano(item.Deadline)}
+	}
+	return res
+}
+
+func formatUnixNano(nsec int64) string {
+	if nsec == 0 {
+		return ""
+	}
+	return time.Unix(0, nsec).UTC().Format(time.RFC3339Nano)
+}
+
+func dispatchQueueReply(c *gin.Context, ctx actor.Context, wg *sync.WaitGroup) {
+	switch s := ctx.Message().(type) {
+	case *act.GetQueueCacheKeyReply:
+		defer wg.Done()
+		if s.Success {
+			api.SetVersion(c, s.Version)
+			api.OK(c, contracts.QueueCacheInfoContract{
+				Key:         s.Key,
+				Ready:       s.Ready,
+				Delayed:     s.Delayed,
+				InFlight:    s.InFlight,
+				Dead:        s.Dead,
+				Visibility:  api.DurationToString(s.Visibility),
+				MaxAttempts: s.MaxAttempts,
+				Version:     s.Version})
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	case *act.DeleteQueueCacheKeyReply:
+		defer wg.Done()
+		if s.Success {
+			api.NoContent(c)
+		} else if s.Conflict {
+			api.PreconditionFailed(c, fmt.Sprintf("version of key '%s' does not match", s.Key))
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	case *act.PostQueueCacheKeyReply:
+		defer wg.Done()
+		if s.Success {
+			api.SetVersion(c, s.Version)
+			api.Created(c)
+		} else {
+			api.Bad(c, fmt.Sprintf("key '%s' was already used", s.Key))
+		}
+		break
+	case *act.PushQueueCacheItemsReply:
+		defer wg.Done()
+		if s.Success {
+			api.SetVersion(c, s.Version)
+			api.OK(c, contracts.QueueCachePushContract{Key: s.Key, IDs: s.IDs, Version: s.Version})
+		} else if s.Conflict {
+			api.PreconditionFailed(c, fmt.Sprintf("version of key '%s' does not match", s.Key))
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	case *act.PopQueueCacheItemsReply:
+		defer wg.Done()
+		if s.Success {
+			api.SetVersion(c, s.Version)
+			api.OK(c, contracts.QueueCacheItemsContract{Key: s.Key, Items: toQueueItemsDto(s.Items), Version: s.Version})
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	case *act.PeekQueueCacheItemsReply:
+		defer wg.Done()
+		if s.Success {
+			api.SetVersion(c, s.Version)
+			api.OK(c, contracts.QueueCacheItemsContract{Key: s.Key, Items: toQueueItemsDto(s.Items), Version: s.Version})
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	case *act.AckQueueCacheItemsReply:
+		defer wg.Done()
+		if s.Success {
+			api.SetVersion(c, s.Version)
+			api.OK(c, contracts.QueueCacheAckContract{Key: s.Key, Acked: s.Acked, Version: s.Version})
+		} else if s.Error != "" {
+			api.Bad(c, fmt.Sprintf("malformed request: %s", s.Error))
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	case *act.NackQueueCacheItemReply:
+		defer wg.Done()
+		if s.Success && s.Found {
+			api.SetVersion(c, s.Version)
+			api.OK(c, contracts.QueueCacheNackContract{Key: s.Key, Dead: s.Dead, Version: s.Version})
+		} else if s.Success {
+			api.NotFound(c, fmt.Sprintf("receipt was not found in flight items of key '%s'", s.Key))
+		} else if s.Error != "" {
+			api.Bad(c, fmt.Sprintf("malformed request: %s", s.Error))
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	case *act.GetQueueCacheDeadReply:
+		defer wg.Done()
+		if s.Success {
+			api.SetVersion(c, s.Version)
+			api.OK(c, contracts.QueueCacheItemsContract{Key: s.Key, Items: toQueueItemsDto(s.Items), Version: s.Version})
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	case *act.RedriveQueueCacheDeadReply:
+		defer wg.Done()
+		if s.Success {
+			api.SetVersion(c, s.Version)
+			api.OK(c, contracts.QueueCacheRedriveContract{Key: s.Key, Redriven: s.Redriven, Version: s.Version})
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	}
+}
+
+func createQueueReplyActor(c *gin.Context, wg *sync.WaitGroup) *actor.PID {
+	return actor.Spawn(actor.FromFunc(func(ctx actor.Context) {
+		dispatchQueueReply(c, ctx, wg)
+	}))
+}
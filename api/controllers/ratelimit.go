@@ -0,0 +1,145 @@
+package controllers
+
+import (
+	"fmt"
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/VitalKrasilnikau/memcache/api/contracts"
+	"github.com/VitalKrasilnikau/memcache/api/utils"
+	"github.com/VitalKrasilnikau/memcache/core/actors"
+	"github.com/gin-gonic/gin"
+	"sync"
+)
+
+// GetRateLimitCacheKeyHandler API which gets the limits and the remaining capacity of the rate limiter or its metadata by key.
+func GetRateLimitCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		if isMetadataRequest(c) {
+			getCacheMetadata(c, pid, key)
+			return
+		}
+		act.Await(
+			pid,
+			func(wg *sync.WaitGroup) *actor.PID {
+				return createRateLimitReplyActor(c, wg)
+			},
+			func() interface{} {
+				return &act.GetRateLimitCacheKeyMessage{Key: key}
+			})
+	}
+}
+
+// DeleteRateLimitCacheKeyHandler API which deletes the rate limiter by key, so it is full again.
+// If-Match header makes the deletion conditional.
+func DeleteRateLimitCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		version, ok := requestVersion(c, 0)
+		if !ok {
+			return
+		}
+		act.Await(
+			pid,
+			func(wg *sync.WaitGroup) *actor.PID {
+				return createRateLimitReplyActor(c, wg)
+			},
+			func() interface{} {
+				return &act.DeleteRateLimitCacheKeyMessage{Key: key, Version: version}
+			})
+	}
+}
+
+// TakeRateLimitCacheKeyHandler API which takes the cost from the rate limiter if it is allowed and replies with the decision,
+// the missing rate limiter is created full. The cost which is not allowed is not taken, so the caller can retry after the time returned.
+func TakeRateLimitCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		var json contracts.TakeRateLimitContract
+		if err := c.ShouldBindJSON(&json); err == nil {
+			window, err := api.ParseTTL(json.Window)
+			if err != nil {
+				api.Bad(c, fmt.Sprintf("malformed window: %s", err.Error()))
+				return
+			}
+			if json.Cost < 0 {
+				api.Bad(c, "malformed request: cost must not be negative")
+				return
+			}
+			if json.Cost == 0 {
+				json.Cost = 1
+			}
+			act.Await(
+				pid,
+				func(wg *sync.WaitGroup) *actor.PID {
+					return createRateLimitReplyActor(c, wg)
+				},
+				func() interface{} {
+					return &act.TakeRateLimitCacheKeyMessage{
+						Key:        key,
+						Algorithm:  json.Algorithm,
+						Capacity:   json.Capacity,
+						RefillRate: json.RefillRate,
+						Window:     window,
+						Cost:       json.Cost}
+				})
+		} else {
+			api.Bad(c, fmt.Sprintf("malformed request: %s", err.Error()))
+		}
+	}
+}
+
+func dispatchRateLimitReply(c *gin.Context, ctx actor.Context, wg *sync.WaitGroup) {
+	switch s := ctx.Message().(type) {
+	case *act.GetRateLimitCacheKeyReply:
+		defer wg.Done()
+		if s.Success {
+			api.SetVersion(c, s.Version)
+			api.SetRateLimit(c, s.Capacity, s.Remaining, 0)
+			api.OK(c, contracts.RateLimitInfoContract{
+				Key:        s.Key,
+				Algorithm:  s.Algorithm,
+				Capacity:   s.Capacity,
+				RefillRate: s.RefillRate,
+				Window:     api.DurationToString(s.Window),
+				Remaining:  s.Remaining,
+				ResetAfter: api.DurationToString(s.ResetAfter),
+				Version:    s.Version})
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	case *act.DeleteRateLimitCacheKeyReply:
+		defer wg.Done()
+		if s.Success {
+			api.NoContent(c)
+		} else if s.Conflict {
+			api.PreconditionFailed(c, fmt.Sprintf("version of key '%s' does not match", s.Key))
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	case *act.TakeRateLimitCacheKeyReply:
+		defer wg.Done()
+		if s.Success {
+			api.SetVersion(c, s.Version)
+			api.SetRateLimit(c, s.Limit, s.Remaining, s.RetryAfter)
+			api.OK(c, contracts.RateLimitContract{
+				Key:        s.Key,
+				Allowed:    s.Allowed,
+				Limit:      s.Limit,
+				Remaining:  s.Remaining,
+				RetryAfter: api.DurationToString(s.RetryAfter),
+				ResetAfter: api.DurationToString(s.ResetAfter),
+				Version:    s.Version})
+		} else {
+			api.Bad(c, fmt.Sprintf("malformed request: %s", s.Error))
+		}
+		break
+	}
+}
+
+func createRateLimitReplyActor(c *gin.Context, wg *sync.WaitGroup) *actor.PID {
+	return actor.Spawn(actor.FromFunc(func(ctx actor.Context) {
+		dispatchRateLimitReply(c, ctx, wg)
+	}))
+}
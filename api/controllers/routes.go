@@ -0,0 +1,43 @@
+package controllers
+
+import (
+	"fmt"
+	"github.com/VitalKrasilnikau/memcache/api/utils"
+	"github.com/gin-gonic/gin"
+	"strings"
+)
+
+// WithOperationRoutes routes "/:key/:param" requests to the handlers by the value of the parameter specified.
+func WithOperationRoutes(param string, handlers map[string]func(*gin.Context)) func(*gin.Context) {
+	return func(c *gin.Context) {
+		if handler, ok := handlers[c.Param(param)]; ok {
+			handler(c)
+		} else {
+			api.NotFound(c, fmt.Sprintf("operation '%s' is not supported", c.Param(param)))
+		}
+	}
+}
+
+// WithQueryRoute routes the requests with the query parameter specified to the query handler and all other requests to the handler specified.
+func WithQueryRoute(param string, queryHandler func(*gin.Context), handler func(*gin.Context)) func(*gin.Context) {
+	return func(c *gin.Context) {
+		if _, ok := c.GetQueryArray(param); ok {
+			queryHandler(c)
+		} else {
+			handler(c)
+		}
+	}
+}
+
+// queryList returns the values of the query parameter which can be repeated or comma-separated, empty values are skipped.
+func queryList(c *gin.Context, name string) []string {
+	var values []string
+	for _, v := range c.QueryArray(name) {
+		for _, s := range strings.Split(v, ",") {
+			if s != "" {
+				values = append(values, s)
+			}
+		}
+	}
+	return values
+}
@@ -0,0 +1,290 @@
+package controllers
+
+import (
+	"fmt"
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/VitalKrasilnikau/memcache/api/contracts"
+	"github.com/VitalKrasilnikau/memcache/api/utils"
+	"github.com/VitalKrasilnikau/memcache/core/actors"
+	"github.com/VitalKrasilnikau/memcache/core/cache"
+	"github.com/gin-gonic/gin"
+	"strconv"
+	"sync"
+)
+
+// GetSetCacheKeyHandler API which gets set cache entry or its metadata by key.
+func GetSetCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		if isMetadataRequest(c) {
+			getCacheMetadata(c, pid, key)
+			return
+		}
+		act.Await(
+			pid,
+			func(wg *sync.WaitGroup) *actor.PID {
+				return createSetReplyActor(c, wg)
+			},
+			func() interface{} {
+				return &act.GetSetCacheKeyMessage{Key: key}
+			})
+	}
+}
+
+// DeleteSetCacheKeyHandler API which deletes set cache entry by key, If-Match header makes the deletion conditional.
+func DeleteSetCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		version, ok := requestVersion(c, 0)
+		if !ok {
+			return
+		}
+		act.Await(
+			pid,
+			func(wg *sync.WaitGroup) *actor.PID {
+				return createSetReplyActor(c, wg)
+			},
+			func() interface{} {
+				return &act.DeleteSetCacheKeyMessage{Key: key, Version: version}
+			})
+	}
+}
+
+// PostSetCacheKeyHandler API which posts new set value.
+func PostSetCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		var json contracts.NewSetCacheValuesContract
+		if err := c.ShouldBindJSON(&json); err == nil {
+			ttl, err := api.ParseTTL(json.TTL)
+			if err != nil {
+				api.Bad(c, fmt.Sprintf("malformed request: %s", err.Error()))
+				return
+			}
+			act.Await(
+				pid,
+				func(wg *sync.WaitGroup) *actor.PID {
+					return createSetReplyActor(c, wg)
+				},
+				func() interface{} {
+					return &act.PostSetCacheKeyMessage{
+						Key:     json.Key,
+						Values:  json.Values,
+						TTL:     ttl,
+						Sliding: json.Sliding}
+				})
+		} else {
+			api.Bad(c, fmt.Sprintf("malformed request: %s", err.Error()))
+		}
+	}
+}
+
+// PostSetCacheValuesHandler API which adds new members to the existing set and replies with the number of members added.
+func PostSetCacheValuesHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		var json contracts.UpdateSetCacheValuesContract
+		if err := c.ShouldBindJSON(&json); err == nil {
+			version, ok := requestVersion(c, json.Version)
+			if !ok {
+				return
+			}
+			act.Await(
+				pid,
+				func(wg *sync.WaitGroup) *actor.PID {
+					return createSetReplyActor(c, wg)
+				},
+				func() interface{} {
+					return &act.PostSetCacheValuesMessage{Key: key, Values: json.Values, Version: version}
+				})
+		} else {
+			api.Bad(c, fmt.Sprintf("malformed request: %s", err.Error()))
+		}
+	}
+}
+
+// DeleteSetCacheValueHandler API which removes the member from the existing set.
+func DeleteSetCacheValueHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		value := c.Param("value")
+		version, ok := requestVersion(c, 0)
+		if !ok {
+			return
+		}
+		act.Await(
+			pid,
+			func(wg *sync.WaitGroup) *actor.PID {
+				return createSetReplyActor(c, wg)
+			},
+			func() interface{} {
+				return &act.DeleteSetCacheValuesMessage{Key: key, Values: []string{value}, Version: version}
+			})
+	}
+}
+
+// ContainsSetCacheValueHandler API which checks if the set contains the member specified in "value" query parameter.
+func ContainsSetCacheValueHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		value := c.Query("value")
+		act.Await(
+			pid,
+			func(wg *sync.WaitGroup) *actor.PID {
+				return createSetReplyActor(c, wg)
+			},
+			func() interface{} {
+				return &act.ContainsSetCacheValueMessage{Key: key, Value: value}
+			})
+	}
+}
+
+// GetSetCacheCardinalityHandler API which gets the number of members in the set.
+func GetSetCacheCardinalityHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		act.Await(
+			pid,
+			func(wg *sync.WaitGroup) *actor.PID {
+				return createSetReplyActor(c, wg)
+			},
+			func() interface{} {
+				return &act.GetSetCacheCardinalityMessage{Key: key}
+			})
+	}
+}
+
+// GetSetCacheRandomValuesHandler API which gets up to "count" distinct random members of the set, one member by default.
+func GetSetCacheRandomValuesHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		count, err := strconv.Atoi(c.DefaultQuery("count", "1"))
+		if err != nil || count < 1 {
+			api.Bad(c, fmt.Sprintf("malformed count '%s'", c.Query("count")))
+			return
+		}
+		act.Await(
+			pid,
+			func(wg *sync.WaitGroup) *actor.PID {
+				return createSetReplyActor(c, wg)
+			},
+			func() interface{} {
+				return &act.GetSetCacheRandomValuesMessage{Key: key, Count: int32(count)}
+			})
+	}
+}
+
+// GetSetCacheUnionHandler API which gets members of the set and the sets specified in "with" query parameter.
+func GetSetCacheUnionHandler(pid *actor.PID) func(*gin.Context) {
+	return setAlgebraHandler(pid, cache.Union)
+}
+
+// GetSetCacheIntersectionHandler API which gets members which are in the set and all the sets specified in "with" query parameter.
+func GetSetCacheIntersectionHandler(pid *actor.PID) func(*gin.Context) {
+	return setAlgebraHandler(pid, cache.Intersection)
+}
+
+// GetSetCacheDifferenceHandler API which gets members of the set which are not in any of the sets specified in "with" query parameter.
+func GetSetCacheDifferenceHandler(pid *actor.PID) func(*gin.Context) {
+	return setAlgebraHandler(pid, cache.Difference)
+}
+
+// setAlgebraHandler requests the sets one by one since they can be stored by different actors, missing keys are treated as empty sets.
+func setAlgebraHandler(pid *actor.PID, op func(...[]string) []string) func(*gin.Context) {
+	return func(c *gin.Context) {
+		keys := append([]string{c.Param("key")}, queryList(c, "with")...)
+		sets := make([][]string, len(keys))
+		for i, k := range keys {
+			if s, ok := act.AwaitReply(pid, &act.GetSetCacheKeyMessage{Key: k}).(*act.GetSetCacheKeyReply); ok && s.Success {
+				sets[i] = s.Values
+			}
+		}
+		api.OK(c, contracts.SetCacheAlgebraContract{Keys: keys, Values: op(sets...)})
+	}
+}
+
+func dispatchSetReply(c *gin.Context, ctx actor.Context, wg *sync.WaitGroup) {
+	switch s := ctx.Message().(type) {
+	case *act.GetSetCacheKeyReply:
+		defer wg.Done()
+		if s.Success {
+			api.SetVersion(c, s.Version)
+			api.OK(c, contracts.SetCacheValuesContract{Key: s.Key, Values: s.Values, Version: s.Version})
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	case *act.DeleteSetCacheKeyReply:
+		defer wg.Done()
+		if s.Success {
+			api.NoContent(c)
+		} else if s.Conflict {
+			api.PreconditionFailed(c, fmt.Sprintf("version of key '%s' does not match", s.Key))
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	case *act.PostSetCacheKeyReply:
+		defer wg.Done()
+		if s.Success {
+			api.SetVersion(c, s.Version)
+			api.Created(c)
+		} else {
+			api.Bad(c, fmt.Sprintf("key '%s' was already used", s.Key))
+		}
+		break
+	case *act.PostSetCacheValuesReply:
+		defer wg.Done()
+		if s.Success {
+			api.SetVersion(c, s.Version)
+			api.OK(c, contracts.SetCacheCountContract{Key: s.Key, Count: s.AddedCount})
+		} else if s.Conflict {
+			api.PreconditionFailed(c, fmt.Sprintf("version of key '%s' does not match", s.Key))
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	case *act.DeleteSetCacheValuesReply:
+		defer wg.Done()
+		if s.Success && s.DeletedCount > 0 {
+			api.SetVersion(c, s.Version)
+			api.NoContent(c)
+		} else if s.Success {
+			api.Bad(c, fmt.Sprintf("set member of key '%s' was already deleted or never existed", s.Key))
+		} else if s.Conflict {
+			api.PreconditionFailed(c, fmt.Sprintf("version of key '%s' does not match", s.Key))
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	case *act.ContainsSetCacheValueReply:
+		defer wg.Done()
+		if s.Success {
+			api.OK(c, contracts.SetCacheContainsContract{Key: s.Key, Value: s.Value, Contains: s.Contains})
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	case *act.GetSetCacheCardinalityReply:
+		defer wg.Done()
+		if s.Success {
+			api.OK(c, contracts.SetCacheCountContract{Key: s.Key, Count: s.Cardinality})
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	case *act.GetSetCacheRandomValuesReply:
+		defer wg.Done()
+		if s.Success {
+			api.OK(c, contracts.SetCacheValuesContract{Key: s.Key, Values: s.Values})
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	}
+}
+
+func createSetReplyActor(c *gin.Context, wg *sync.WaitGroup) *actor.PID {
+	return actor.Spawn(actor.FromFunc(func(ctx actor.Context) {
+		dispatchSetReply(c, ctx, wg)
+	}))
+}
@@ -0,0 +1,373 @@
+package controllers
+
+import (
+	"fmt"
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/VitalKrasilnikau/memcache/api/contracts"
+	"github.com/VitalKrasilnikau/memcache/api/utils"
+	"github.com/VitalKrasilnikau/memcache/core/actors"
+	"github.com/gin-gonic/gin"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// GetSortedSetCacheKeyHandler API which gets sorted set cache entry or its metadata by key.
+func GetSortedSetCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		if isMetadataRequest(c) {
+			getCacheMetadata(c, pid, key)
+			return
+		}
+		act.Await(
+			pid,
+			func(wg *sync.WaitGroup) *actor.PID {
+				return createSortedSetReplyActor(c, wg)
+			},
+			func() interface{} {
+				return &act.GetSortedSetCacheKeyMessage{Key: key}
+			})
+	}
+}
+
+// DeleteSortedSetCacheKeyHandler API which deletes sorted set cache entry by key, If-Match header makes the deletion conditional.
+func DeleteSortedSetCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		version, ok := requestVersion(c, 0)
+		if !ok {
+			return
+		}
+		act.Await(
+			pid,
+			func(wg *sync.WaitGroup) *actor.PID {
+				return createSortedSetReplyActor(c, wg)
+			},
+			func() interface{} {
+				return &act.DeleteSortedSetCacheKeyMessage{Key: key, Version: version}
+			})
+	}
+}
+
+// PostSortedSetCacheKeyHandler API which posts new sorted set value.
+func PostSortedSetCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		var json contracts.NewSortedSetCacheValuesContract
+		if err := c.ShouldBindJSON(&json); err == nil {
+			ttl, err := api.ParseTTL(json.TTL)
+			if err != nil {
+				api.Bad(c, fmt.Sprintf("malformed request: %s", err.Error()))
+				return
+			}
+			act.Await(
+				pid,
+				func(wg *sync.WaitGroup) *actor.PID {
+					return createSortedSetReplyActor(c, wg)
+				},
+				func() interface{} {
+					return &act.PostSortedSetCacheKeyMessage{
+						Key:     json.Key,
+						Members: toSortedSetMembers(json.Members),
+						TTL:     ttl,
+						Sliding: json.Sliding}
+				})
+		} else {
+			api.Bad(c, fmt.Sprintf("malformed request: %s", err.Error()))
+		}
+	}
+}
+
+// PostSortedSetCacheValuesHandler API which adds new members to the existing sorted set or updates scores of existing ones
+// and replies with the number of members added.
+func PostSortedSetCacheValuesHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		var json contracts.UpdateSortedSetCacheValuesContract
+		if err := c.ShouldBindJSON(&json); err == nil {
+			version, ok := requestVersion(c, json.Version)
+			if !ok {
+				return
+			}
+			act.Await(
+				pid,
+				func(wg *sync.WaitGroup) *actor.PID {
+					return createSortedSetReplyActor(c, wg)
+				},
+				func() interface{} {
+					return &act.PostSortedSetCacheValuesMessage{Key: key, Members: toSortedSetMembers(json.Members), Version: version}
+				})
+		} else {
+			api.Bad(c, fmt.Sprintf("malformed request: %s", err.Error()))
+		}
+	}
+}
+
+// IncrementSortedSetCacheScoreHandler API which adds delta to the score of the member and replies with the new score,
+// missing member is added with delta as its score.
+func IncrementSortedSetCacheScoreHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		var json contracts.IncrementSortedSetCacheScoreContract
+		if err := c.ShouldBindJSON(&json); err == nil {
+			version, ok := requestVersion(c, json.Version)
+			if !ok {
+				return
+			}
+			act.Await(
+				pid,
+				func(wg *sync.WaitGroup) *actor.PID {
+					return createSortedSetReplyActor(c, wg)
+				},
+				func() interface{} {
+					return &act.IncrementSortedSetCacheScoreMessage{Key: key, Member: json.Member, Delta: json.Delta, Version: version}
+				})
+		} else {
+			api.Bad(c, fmt.Sprintf("malformed request: %s", err.Error()))
+		}
+	}
+}
+
+// DeleteSortedSetCacheValueHandler API which removes the member from the existing sorted set.
+func DeleteSortedSetCacheValueHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		member := c.Param("member")
+		version, ok := requestVersion(c, 0)
+		if !ok {
+			return
+		}
+		act.Await(
+			pid,
+			func(wg *sync.WaitGroup) *actor.PID {
+				return createSortedSetReplyActor(c, wg)
+			},
+			func() interface{} {
+				return &act.DeleteSortedSetCacheValuesMessage{Key: key, Members: []string{member}, Version: version}
+			})
+	}
+}
+
+// GetSortedSetCacheRangeHandler API which gets the members from "start" to "stop" zero-based ranks inclusive,
+// negative ranks are counted from the end. With reverse=true the ranks start from the highest score.
+func GetSortedSetCacheRangeHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		start, err := strconv.Atoi(c.DefaultQuery("start", "0"))
+		if err != nil {
+			api.Bad(c, fmt.Sprintf("malformed start '%s'", c.Query("start")))
+			return
+		}
+		stop, err := strconv.Atoi(c.DefaultQuery("stop", "-1"))
+		if err != nil {
+			api.Bad(c, fmt.Sprintf("malformed stop '%s'", c.Query("stop")))
+			return
+		}
+		reverse := c.Query("reverse") == "true"
+		act.Await(
+			pid,
+			func(wg *sync.WaitGroup) *actor.PID {
+				return createSortedSetReplyActor(c, wg)
+			},
+			func() interface{} {
+				return &act.GetSortedSetCacheRangeMessage{Key: key, Start: int32(start), Stop: int32(stop), Reverse: reverse}
+			})
+	}
+}
+
+// GetSortedSetCacheRangeByScoreHandler API which gets the members with the scores from "min" to "max",
+// "(" prefix makes the bound exclusive, "-inf" and "+inf" are accepted. "offset" and "count" limit the members returned.
+func GetSortedSetCacheRangeByScoreHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		min, minExclusive, err := parseScoreBound(c.DefaultQuery("min", "-inf"))
+		if err != nil {
+			api.Bad(c, fmt.Sprintf("malformed min '%s'", c.Query("min")))
+			return
+		}
+		max, maxExclusive, err := parseScoreBound(c.DefaultQuery("max", "+inf"))
+		if err != nil {
+			api.Bad(c, fmt.Sprintf("malformed max '%s'", c.Query("max")))
+			return
+		}
+		offset, err := strconv.Atoi(c.DefaultQuery("offset", "0"))
+		if err != nil || offset < 0 {
+			api.Bad(c, fmt.Sprintf("malformed offset '%s'", c.Query("offset")))
+			return
+		}
+		count, err := strconv.Atoi(c.DefaultQuery("count", "0"))
+		if err != nil {
+			api.Bad(c, fmt.Sprintf("malformed count '%s'", c.Query("count")))
+			return
+		}
+		reverse := c.Query("reverse") == "true"
+		act.Await(
+			pid,
+			func(wg *sync.WaitGroup) *actor.PID {
+				return createSortedSetReplyActor(c, wg)
+			},
+			func() interface{} {
+				return &act.GetSortedSetCacheRangeByScoreMessage{
+					Key:          key,
+					Min:          min,
+					Max:          max,
+					MinExclusive: minExclusive,
+					MaxExclusive: maxExclusive,
+					Offset:       int32(offset),
+					Count:        int32(count),
+					Reverse:      reverse}
+			})
+	}
+}
+
+// GetSortedSetCacheRankHandler API which gets zero-based rank and score of the member specified in "member" query parameter.
+// With reverse=true the rank is counted from the highest score.
+func GetSortedSetCacheRankHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		member := c.Query("member")
+		reverse := c.Query("reverse") == "true"
+		act.Await(
+			pid,
+			func(wg *sync.WaitGroup) *actor.PID {
+				return createSortedSetReplyActor(c, wg)
+			},
+			func() interface{} {
+				return &act.GetSortedSetCacheRankMessage{Key: key, Member: member, Reverse: reverse}
+			})
+	}
+}
+
+// parseScoreBound parses score range bound, "(" prefix makes the bound exclusive.
+func parseScoreBound(s string) (float64, bool, error) {
+	exclusive := strings.HasPrefix(s, "(")
+	// "+" in the query string is decoded as space
+	v, err := strconv.ParseFloat(strings.TrimSpace(strings.TrimPrefix(s, "(")), 64)
+	if err == nil && math.IsNaN(v) {
+		err = fmt.Errorf("score bound is not a number")
+	}
+	return v, exclusive, err
+}
+
+func toSortedSetMembers(members []contracts.SortedSetMemberContract) []act.SortedSetMember {
+	res := make([]act.SortedSetMember, len(members))
+	for i, m := range members {
+		res[i] = act.SortedSetMember{Member: m.Member, Score: m.Score}
+	}
+	return res
+}
+
+func toSortedSetMemberContracts(members []act.SortedSetMember) []contracts.SortedSetMemberContract {
+	res := make([]contracts.SortedSetMemberContract, len(members))
+	for i, m := range members {
+		res[i] = contracts.SortedSetMemberContract{Member: m.Member, Score: m.Score}
+	}
+	return res
+}
+
+func dispatchSortedSetReply(c *gin.Context, ctx actor.Context, wg *sync.WaitGroup) {
+	switch s := ctx.Message().(type) {
+	case *act.GetSortedSetCacheKeyReply:
+		defer wg.Done()
+		if s.Success {
+			api.SetVersion(c, s.Version)
+			api.OK(c, contracts.SortedSetCacheValuesContract{Key: s.Key, Members: toSortedSetMemberContracts(s.Members), Version: s.Version})
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	case *act.DeleteSortedSetCacheKeyReply:
+		defer wg.Done()
+		if s.Success {
+			api.NoContent(c)
+		} else if s.Conflict {
+			api.PreconditionFailed(c, fmt.Sprintf("version of key '%s' does not match", s.Key))
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	case *act.PostSortedSetCacheKeyReply:
+		defer wg.Done()
+		if s.Success {
+			api.SetVersion(c, s.Version)
+			api.Created(c)
+		} else if s.Error != "" {
+			api.Bad(c, fmt.Sprintf("malformed request: %s", s.Error))
+		} else {
+			api.Bad(c, fmt.Sprintf("key '%s' was already used", s.Key))
+		}
+		break
+	case *act.PostSortedSetCacheValuesReply:
+		defer wg.Done()
+		if s.Success {
+			api.SetVersion(c, s.Version)
+			api.OK(c, contracts.SortedSetCacheCountContract{Key: s.Key, Count: s.AddedCount})
+		} else if s.Error != "" {
+			api.Bad(c, fmt.Sprintf("malformed request: %s", s.Error))
+		} else if s.Conflict {
+			api.PreconditionFailed(c, fmt.Sprintf("version of key '%s' does not match", s.Key))
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	case *act.IncrementSortedSetCacheScoreReply:
+		defer wg.Done()
+		if s.Success {
+			api.SetVersion(c, s.Version)
+			api.OK(c, contracts.SortedSetCacheScoreContract{Key: s.Key, Member: s.Member, Score: s.Score})
+		} else if s.Error != "" {
+			api.Bad(c, fmt.Sprintf("malformed request: %s", s.Error))
+		} else if s.Conflict {
+			api.PreconditionFailed(c, fmt.Sprintf("version of key '%s' does not match", s.Key))
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	case *act.DeleteSortedSetCacheValuesReply:
+		defer wg.Done()
+		if s.Success && s.DeletedCount > 0 {
+			api.SetVersion(c, s.Version)
+			api.NoContent(c)
+		} else if s.Success {
+			api.Bad(c, fmt.Sprintf("sorted set member of key '%s' was already deleted or never existed", s.Key))
+		} else if s.Conflict {
+			api.PreconditionFailed(c, fmt.Sprintf("version of key '%s' does not match", s.Key))
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	case *act.GetSortedSetCacheRangeReply:
+		defer wg.Done()
+		if s.Success {
+			api.OK(c, contracts.SortedSetCacheValuesContract{Key: s.Key, Members: toSortedSetMemberContracts(s.Members)})
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	case *act.GetSortedSetCacheRangeByScoreReply:
+		defer wg.Done()
+		if s.Success {
+			api.OK(c, contracts.SortedSetCacheValuesContract{Key: s.Key, Members: toSortedSetMemberContracts(s.Members)})
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	case *act.GetSortedSetCacheRankReply:
+		defer wg.Done()
+		if s.Success && s.Found {
+			api.OK(c, contracts.SortedSetCacheRankContract{Key: s.Key, Member: s.Member, Rank: s.Rank, Score: s.Score})
+		} else if s.Success {
+			api.NotFound(c, fmt.Sprintf("member '%s' of key '%s' was not found", s.Member, s.Key))
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	}
+}
+
+func createSortedSetReplyActor(c *gin.Context, wg *sync.WaitGroup) *actor.PID {
+	return actor.Spawn(actor.FromFunc(func(ctx actor.Context) {
+		dispatchSortedSetReply(c, ctx, wg)
+	}))
+}
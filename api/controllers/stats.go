@@ -0,0 +1,49 @@
+package controllers
+
+import (
+	"github.com/VitalKrasilnikau/memcache/api/contracts"
+	"github.com/VitalKrasilnikau/memcache/api/utils"
+	"github.com/VitalKrasilnikau/memcache/core/actors"
+	"github.com/gin-gonic/gin"
+)
+
+// CacheStatsGroups are the key groups of the caches which report usage counters, one field per cache type.
+type CacheStatsGroups struct {
+	String      *act.BroadcastStringKeysGroup
+	List        *act.BroadcastStringKeysGroup
+	Dictionary  *act.BroadcastStringKeysGroup
+	Set         *act.BroadcastStringKeysGroup
+	SortedSet   *act.BroadcastStringKeysGroup
+	Document    *act.BroadcastStringKeysGroup
+	HyperLogLog *act.BroadcastStringKeysGroup
+	BloomFilter *act.BroadcastStringKeysGroup
+	Stream      *act.BroadcastStringKeysGroup
+	Geo         *act.BroadcastStringKeysGroup
+	Queue       *act.BroadcastStringKeysGroup
+	RateLimit   *act.BroadcastStringKeysGroup
+	Lock        *act.BroadcastStringKeysGroup
+}
+
+// GetCacheStatsHandler API which gets usage counters of string, list, dictionary, set, sorted set, document, HyperLogLog, Bloom filter, stream, geo, queue, rate limit and lock caches.
+func GetCacheStatsHandler(groups CacheStatsGroups) func(*gin.Context) {
+	return func(c *gin.Context) {
+		api.OK(c, contracts.CacheStatsContract{
+			String:      toStatsContract(groups.String.RequestStats()),
+			List:        toStatsContract(groups.List.RequestStats()),
+			Dictionary:  toStatsContract(groups.Dictionary.RequestStats()),
+			Set:         toStatsContract(groups.Set.RequestStats()),
+			SortedSet:   toStatsContract(groups.SortedSet.RequestStats()),
+			Document:    toStatsContract(groups.Document.RequestStats()),
+			HyperLogLog: toStatsContract(groups.HyperLogLog.RequestStats()),
+			BloomFilter: toStatsContract(groups.BloomFilter.RequestStats()),
+			Stream:      toStatsContract(groups.Stream.RequestStats()),
+			Geo:         toStatsContract(groups.Geo.RequestStats()),
+			Queue:       toStatsContract(groups.Queue.RequestStats()),
+			RateLimit:   toStatsContract(groups.RateLimit.RequestStats()),
+			Lock:        toStatsContract(groups.Lock.RequestStats())})
+	}
+}
+
+func toStatsContract(s act.GetCacheStatsReply) contracts.CacheTypeStatsContract {
+	return contracts.CacheTypeStatsContract{Entries: s.Entries, Bytes: s.Bytes, Evicted: s.Evicted, Reaped: s.Reaped}
+}
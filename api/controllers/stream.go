@@ -0,0 +1,455 @@
+package controllers
+
+import (
+	"fmt"
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/VitalKrasilnikau/memcache/api/contracts"
+	"github.com/VitalKrasilnikau/memcache/api/utils"
+	"github.com/VitalKrasilnikau/memcache/core/actors"
+	"github.com/VitalKrasilnikau/memcache/core/cache"
+	"github.com/gin-gonic/gin"
+	"strconv"
+	"sync"
+)
+
+// GetStreamCacheKeyHandler API which gets the length, the first and the last IDs of stream or its metadata by key.
+func GetStreamCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		if isMetadataRequest(c) {
+			getCacheMetadata(c, pid, key)
+			return
+		}
+		act.Await(
+			pid,
+			func(wg *sync.WaitGroup) *actor.PID {
+				return createStreamReplyActor(c, wg)
+			},
+			func() interface{} {
+				return &act.GetStreamCacheKeyMessage{Key: key}
+			})
+	}
+}
+
+// DeleteStreamCacheKeyHandler API which deletes stream cache entry by key, If-Match header makes the deletion conditional.
+func DeleteStreamCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		version, ok := requestVersion(c, 0)
+		if !ok {
+			return
+		}
+		act.Await(
+			pid,
+			func(wg *sync.WaitGroup) *actor.PID {
+				return createStreamReplyActor(c, wg)
+			},
+			func() interface{} {
+				return &act.DeleteStreamCacheKeyMessage{Key: key, Version: version}
+			})
+	}
+}
+
+// PostStreamCacheKeyHandler API which posts new empty stream capped to maxLen entries.
+func PostStreamCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		var json contracts.NewStreamCacheContract
+		if err := c.ShouldBindJSON(&json); err == nil {
+			ttl, err := api.ParseTTL(json.TTL)
+			if err != nil {
+				api.Bad(c, fmt.Sprintf("malformed request: %s", err.Error()))
+				return
+			}
+			act.Await(
+				pid,
+				func(wg *sync.WaitGroup) *actor.PID {
+					return createStreamReplyActor(c, wg)
+				},
+				func() interface{} {
+					return &act.PostStreamCacheKeyMessage{
+						Key:     json.Key,
+						MaxLen:  json.MaxLen,
+						TTL:     ttl,
+						Sliding: json.Sliding}
+				})
+		} else {
+			api.Bad(c, fmt.Sprintf("malformed request: %s", err.Error()))
+		}
+	}
+}
+
+// AppendStreamCacheEntryHandler API which appends the entry to the stream like XADD, "create" option creates the missing key.
+func AppendStreamCacheEntryHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		var json contracts.AppendStreamCacheEntryContract
+		if err := c.ShouldBindJSON(&json); err == nil {
+			version, ok := requestVersion(c, json.Version)
+			if !ok {
+				return
+			}
+			fields := make([]cache.KeyValue, len(json.Fields))
+			for i, f := range json.Fields {
+				fields[i] = cache.KeyValue{Key: f.Field, Value: f.Value}
+			}
+			act.Await(
+				pid,
+				func(wg *sync.WaitGroup) *actor.PID {
+					return createStreamReplyActor(c, wg)
+				},
+				func() interface{} {
+					return &act.AppendStreamCacheEntryMessage{
+						Key:     key,
+						ID:      json.ID,
+						Fields:  fields,
+						MaxLen:  json.MaxLen,
+						Create:  json.Create,
+						Version: version}
+				})
+		} else {
+			api.Bad(c, fmt.Sprintf("malformed request: %s", err.Error()))
+		}
+	}
+}
+
+// GetStreamCacheRangeHandler API which gets up to count entries with IDs from start to end inclusive like XRANGE,
+// "-" and "+" are the smallest and the greatest IDs, reverse=true starts from the end like XREVRANGE.
+func GetStreamCacheRangeHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		count, err := strconv.Atoi(c.DefaultQuery("count", "0"))
+		if err != nil {
+			api.Bad(c, fmt.Sprintf("malformed count '%s'", c.Query("count")))
+			return
+		}
+		act.Await(
+			pid,
+			func(wg *sync.WaitGroup) *actor.PID {
+				return createStreamReplyActor(c, wg)
+			},
+			func() interface{} {
+				return &act.GetStreamCacheRangeMessage{
+					Key:     key,
+					Start:   c.DefaultQuery("start", "-"),
+					End:     c.DefaultQuery("end", "+"),
+					Count:   int32(count),
+					Reverse: c.Query("reverse") == "true"}
+			})
+	}
+}
+
+// TrimStreamCacheKeyHandler API which removes the oldest entries so that at most maxLen entries are left like XTRIM.
+func TrimStreamCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		var json contracts.TrimStreamCacheContract
+		if err := c.ShouldBindJSON(&json); err == nil {
+			if json.MaxLen < 0 {
+				api.Bad(c, "malformed request: maxLen must not be negative")
+				return
+			}
+			version, ok := requestVersion(c, json.Version)
+			if !ok {
+				return
+			}
+			act.Await(
+				pid,
+				func(wg *sync.WaitGroup) *actor.PID {
+					return createStreamReplyActor(c, wg)
+				},
+				func() interface{} {
+					return &act.TrimStreamCacheKeyMessage{Key: key, MaxLen: json.MaxLen, Version: version}
+				})
+		} else {
+			api.Bad(c, fmt.Sprintf("malformed request: %s", err.Error()))
+		}
+	}
+}
+
+// GetStreamCacheGroupsHandler API which gets the consumer groups of the stream with the number of pending entries and consumers.
+func GetStreamCacheGroupsHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		act.Await(
+			pid,
+			func(wg *sync.WaitGroup) *actor.PID {
+				return createStreamReplyActor(c, wg)
+			},
+			func() interface{} {
+				return &act.GetStreamCacheGroupsMessage{Key: key}
+			})
+	}
+}
+
+// CreateStreamCacheGroupHandler API which adds the consumer group to the stream like XGROUP CREATE, "create" option creates the missing key.
+func CreateStreamCacheGroupHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		var json contracts.NewStreamGroupContract
+		if err := c.ShouldBindJSON(&json); err == nil {
+			act.Await(
+				pid,
+				func(wg *sync.WaitGroup) *actor.PID {
+					return createStreamReplyActor(c, wg)
+				},
+				func() interface{} {
+					return &act.CreateStreamCacheGroupMessage{Key: key, Group: json.Group, Start: json.Start, Create: json.Create}
+				})
+		} else {
+			api.Bad(c, fmt.Sprintf("malformed request: %s", err.Error()))
+		}
+	}
+}
+
+// DeleteStreamCacheGroupHandler API which removes the consumer group with its pending entries like XGROUP DESTROY.
+func DeleteStreamCacheGroupHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		group := c.Param("group")
+		act.Await(
+			pid,
+			func(wg *sync.WaitGroup) *actor.PID {
+				return createStreamReplyActor(c, wg)
+			},
+			func() interface{} {
+				return &act.DeleteStreamCacheGroupMessage{Key: key, Group: group}
+			})
+	}
+}
+
+// ReadStreamCacheGroupHandler API which delivers the entries to the consumer of the group like XREADGROUP.
+// New entries are added to the pending entries of the consumer until they are acknowledged.
+func ReadStreamCacheGroupHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		group := c.Param("group")
+		var json contracts.ReadStreamGroupContract
+		if err := c.ShouldBindJSON(&json); err == nil {
+			act.Await(
+				pid,
+				func(wg *sync.WaitGroup) *actor.PID {
+					return createStreamReplyActor(c, wg)
+				},
+				func() interface{} {
+					return &act.ReadStreamCacheGroupMessage{Key: key, Group: group, Consumer: json.Consumer, ID: json.ID, Count: json.Count}
+				})
+		} else {
+			api.Bad(c, fmt.Sprintf("malformed request: %s", err.Error()))
+		}
+	}
+}
+
+// AckStreamCacheGroupHandler API which acknowledges the pending entries of the group like XACK.
+func AckStreamCacheGroupHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		group := c.Param("group")
+		var json contracts.AckStreamGroupContract
+		if err := c.ShouldBindJSON(&json); err == nil {
+			act.Await(
+				pid,
+				func(wg *sync.WaitGroup) *actor.PID {
+					return createStreamReplyActor(c, wg)
+				},
+				func() interface{} {
+					return &act.AckStreamCacheGroupMessage{Key: key, Group: group, IDs: json.IDs}
+				})
+		} else {
+			api.Bad(c, fmt.Sprintf("malformed request: %s", err.Error()))
+		}
+	}
+}
+
+// GetStreamCachePendingHandler API which gets up to count pending entries of the group like XPENDING,
+// "consumer" query parameter returns only the entries of the consumer.
+func GetStreamCachePendingHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		group := c.Param("group")
+		count, err := strconv.Atoi(c.DefaultQuery("count", "0"))
+		if err != nil {
+			api.Bad(c, fmt.Sprintf("malformed count '%s'", c.Query("count")))
+			return
+		}
+		act.Await(
+			pid,
+			func(wg *sync.WaitGroup) *actor.PID {
+				return createStreamReplyActor(c, wg)
+			},
+			func() interface{} {
+				return &act.GetStreamCachePendingMessage{Key: key, Group: group, Consumer: c.Query("consumer"), Count: int32(count)}
+			})
+	}
+}
+
+// streamError replies with 404 status if the consumer group was not found and with 400 status for other errors.
+func streamError(c *gin.Context, key string, group string, e string) {
+	if e == cache.ErrStreamGroupNotFound.Error() {
+		api.NotFound(c, fmt.Sprintf("group '%s' of key '%s' was not found", group, key))
+	} else {
+		api.Bad(c, fmt.Sprintf("malformed request: %s", e))
+	}
+}
+
+func toStreamEntriesDto(entries []act.StreamEntry) []contracts.StreamEntryContract {
+	res := make([]contracts.StreamEntryContract, len(entries))
+	for i, e := range entries {
+		fields := make([]contracts.StreamFieldContract, len(e.Fields))
+		for j, f := range e.Fields {
+			fields[j] = contracts.StreamFieldContract{Field: f.Key, Value: f.Value}
+		}
+		res[i] = contracts.StreamEntryContract{ID: e.ID, Fields: fields}
+	}
+	return res
+}
+
+func dispatchStreamReply(c *gin.Context, ctx actor.Context, wg *sync.WaitGroup) {
+	switch s := ctx.Message().(type) {
+	case *act.GetStreamCacheKeyReply:
+		defer wg.Done()
+		if s.Success {
+			api.SetVersion(c, s.Version)
+			api.OK(c, contracts.StreamCacheInfoContract{
+				Key:     s.Key,
+				Length:  s.Length,
+				MaxLen:  s.MaxLen,
+				FirstID: s.FirstID,
+				LastID:  s.LastID,
+				Groups:  s.Groups,
+				Version: s.Version})
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	case *act.DeleteStreamCacheKeyReply:
+		defer wg.Done()
+		if s.Success {
+			api.NoContent(c)
+		} else if s.Conflict {
+			api.PreconditionFailed(c, fmt.Sprintf("version of key '%s' does not match", s.Key))
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	case *act.PostStreamCacheKeyReply:
+		defer wg.Done()
+		if s.Success {
+			api.SetVersion(c, s.Version)
+			api.Created(c)
+		} else {
+			api.Bad(c, fmt.Sprintf("key '%s' was already used", s.Key))
+		}
+		break
+	case *act.AppendStreamCacheEntryReply:
+		defer wg.Done()
+		if s.Success {
+			api.SetVersion(c, s.Version)
+			api.OK(c, contracts.StreamCacheAppendContract{Key: s.Key, ID: s.ID, Length: s.Length, Version: s.Version})
+		} else if s.Conflict {
+			api.PreconditionFailed(c, fmt.Sprintf("version of key '%s' does not match", s.Key))
+		} else if s.Error != "" {
+			api.Bad(c, fmt.Sprintf("malformed request: %s", s.Error))
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	case *act.GetStreamCacheRangeReply:
+		defer wg.Done()
+		if s.Success {
+			api.SetVersion(c, s.Version)
+			api.OK(c, contracts.StreamCacheEntriesContract{Key: s.Key, Entries: toStreamEntriesDto(s.Entries), Version: s.Version})
+		} else if s.Error != "" {
+			api.Bad(c, fmt.Sprintf("malformed request: %s", s.Error))
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	case *act.TrimStreamCacheKeyReply:
+		defer wg.Done()
+		if s.Success {
+			api.SetVersion(c, s.Version)
+			api.OK(c, contracts.StreamCacheTrimContract{Key: s.Key, Removed: s.Removed, Length: s.Length, Version: s.Version})
+		} else if s.Conflict {
+			api.PreconditionFailed(c, fmt.Sprintf("version of key '%s' does not match", s.Key))
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	case *act.GetStreamCacheGroupsReply:
+		defer wg.Done()
+		if s.Success {
+			groups := make([]contracts.StreamGroupContract, len(s.Groups))
+			for i, g := range s.Groups {
+				groups[i] = contracts.StreamGroupContract{Name: g.Name, LastDeliveredID: g.LastDeliveredID, Pending: g.Pending, Consumers: g.Consumers}
+			}
+			api.SetVersion(c, s.Version)
+			api.OK(c, contracts.StreamCacheGroupsContract{Key: s.Key, Groups: groups, Version: s.Version})
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	case *act.CreateStreamCacheGroupReply:
+		defer wg.Done()
+		if s.Success {
+			api.SetVersion(c, s.Version)
+			api.Created(c)
+		} else if s.Error != "" {
+			api.Bad(c, fmt.Sprintf("group '%s' of key '%s' cannot be created: %s", s.Group, s.Key, s.Error))
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	case *act.DeleteStreamCacheGroupReply:
+		defer wg.Done()
+		if s.Deleted {
+			api.NoContent(c)
+		} else if s.Success {
+			api.NotFound(c, fmt.Sprintf("group '%s' of key '%s' was not found", s.Group, s.Key))
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	case *act.ReadStreamCacheGroupReply:
+		defer wg.Done()
+		if s.Success {
+			api.SetVersion(c, s.Version)
+			api.OK(c, contracts.StreamCacheEntriesContract{Key: s.Key, Group: s.Group, Entries: toStreamEntriesDto(s.Entries), Version: s.Version})
+		} else if s.Error != "" {
+			streamError(c, s.Key, s.Group, s.Error)
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	case *act.AckStreamCacheGroupReply:
+		defer wg.Done()
+		if s.Success {
+			api.SetVersion(c, s.Version)
+			api.OK(c, contracts.StreamCacheAckContract{Key: s.Key, Group: s.Group, Acked: s.Acked, Version: s.Version})
+		} else if s.Error != "" {
+			streamError(c, s.Key, s.Group, s.Error)
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	case *act.GetStreamCachePendingReply:
+		defer wg.Done()
+		if s.Success {
+			entries := make([]contracts.StreamPendingEntryContract, len(s.Entries))
+			for i, p := range s.Entries {
+				entries[i] = contracts.StreamPendingEntryContract{ID: p.ID, Consumer: p.Consumer, Idle: api.DurationToString(p.Idle), Deliveries: p.Deliveries}
+			}
+			api.OK(c, contracts.StreamCachePendingContract{Key: s.Key, Group: s.Group, Entries: entries})
+		} else if s.Error != "" {
+			streamError(c, s.Key, s.Group, s.Error)
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	}
+}
+
+func createStreamReplyActor(c *gin.Context, wg *sync.WaitGroup) *actor.PID {
+	return actor.Spawn(actor.FromFunc(func(ctx actor.Context) {
+		dispatchStreamReply(c, ctx, wg)
+	}))
+}
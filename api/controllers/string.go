@@ -10,10 +10,14 @@ import (
 	"sync"
 )
 
-// GetStringCacheKeyHandler API which gets string cache entry by key.
+// GetStringCacheKeyHandler API which gets string cache entry or its metadata by key.
 func GetStringCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
 	return func(c *gin.Context) {
 		key := c.Param("key")
+		if isMetadataRequest(c) {
+			getCacheMetadata(c, pid, key)
+			return
+		}
 		act.Await(
 			pid,
 			func(wg *sync.WaitGroup) *actor.PID {
@@ -25,26 +29,40 @@ func GetStringCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
 	}
 }
 
-// DeleteStringCacheKeyHandler API which deletes string cache entry by key.
+// DeleteStringCacheKeyHandler API which deletes string cache entry by key, If-Match header makes the deletion conditional.
 func DeleteStringCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
 	return func(c *gin.Context) {
 		key := c.Param("key")
+		version, ok := requestVersion(c, 0)
+		if !ok {
+			return
+		}
 		act.Await(
 			pid,
 			func(wg *sync.WaitGroup) *actor.PID {
 				return createStringReplyActor(c, wg)
 			},
 			func() interface{} {
-				return &act.DeleteStringCacheKeyMessage{Key: key}
+				return &act.DeleteStringCacheKeyMessage{Key: key, Version: version}
 			})
 	}
 }
 
 // PostStringCacheKeyHandler API which posts new string value.
+// The body of any other content type than JSON or form is stored as is, the key and ttl are taken from the query then.
 func PostStringCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
 	return func(c *gin.Context) {
+		if contentType, ok := rawContentType(c); ok {
+			postStringCacheBytes(c, pid, contentType)
+			return
+		}
 		var json contracts.NewStringCacheValueContract
 		if err := c.ShouldBindJSON(&json); err == nil {
+			ttl, err := api.ParseTTL(json.TTL)
+			if err != nil {
+				api.Bad(c, fmt.Sprintf("malformed request: %s", err.Error()))
+				return
+			}
 			act.Await(
 				pid,
 				func(wg *sync.WaitGroup) *actor.PID {
@@ -54,7 +72,8 @@ func PostStringCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
 					return &act.PostStringCacheKeyMessage{
 						Key:     json.Key,
 						Value:   json.Value,
-						TTL:     api.ParseDuration(json.TTL)}
+						TTL:     ttl,
+						Sliding: json.Sliding}
 				})
 		} else {
 			api.Bad(c, fmt.Sprintf("malformed request: %s", err.Error()))
@@ -63,11 +82,25 @@ func PostStringCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
 }
 
 // PutStringCacheKeyHandler API which updates existing string value by the key and new value specified.
+// The update is applied if the original value or the version from If-Match header or body matches the entry.
+// The body of any other content type than JSON or form is stored as is, the version is required then.
 func PutStringCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
 	return func(c *gin.Context) {
 		key := c.Param("key")
+		if contentType, ok := rawContentType(c); ok {
+			putStringCacheBytes(c, pid, key, contentType)
+			return
+		}
 		var json contracts.UpdateStringCacheValueContract
 		if err := c.ShouldBindJSON(&json); err == nil {
+			version, ok := requestVersion(c, json.Version)
+			if !ok {
+				return
+			}
+			if version == 0 && json.OriginalValue == "" {
+				api.Bad(c, "original value or version is required")
+				return
+			}
 			act.Await(
 				pid,
 				func(wg *sync.WaitGroup) *actor.PID {
@@ -77,7 +110,8 @@ func PutStringCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
 					return &act.PutStringCacheKeyMessage{
 						Key:           key,
 						NewValue:      json.NewValue,
-						OriginalValue: json.OriginalValue}
+						OriginalValue: json.OriginalValue,
+						Version:       version}
 				})
 		} else {
 			api.Bad(c, fmt.Sprintf("malformed request: %s", err.Error()))
@@ -85,40 +119,239 @@ func PutStringCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
 	}
 }
 
+func postStringCacheBytes(c *gin.Context, pid *actor.PID, contentType string) {
+	key := c.Query("key")
+	if key == "" {
+		api.Bad(c, "key query parameter is required")
+		return
+	}
+	ttl, err := api.ParseTTL(c.Query("ttl"))
+	if err != nil {
+		api.Bad(c, fmt.Sprintf("malformed request: %s", err.Error()))
+		return
+	}
+	data, err := c.GetRawData()
+	if err != nil {
+		api.Bad(c, fmt.Sprintf("malformed request: %s", err.Error()))
+		return
+	}
+	act.Await(
+		pid,
+		func(wg *sync.WaitGroup) *actor.PID {
+			return createStringReplyActor(c, wg)
+		},
+		func() interface{} {
+			return &act.PostStringCacheKeyMessage{
+				Key:         key,
+				Value:       string(data),
+				ContentType: contentType,
+				TTL:         ttl,
+				Sliding:     c.Query("sliding") == "true"}
+		})
+}
+
+func putStringCacheBytes(c *gin.Context, pid *actor.PID, key string, contentType string) {
+	version, ok := requestVersion(c, 0)
+	if !ok {
+		return
+	}
+	if version == 0 {
+		api.Bad(c, "If-Match header is required")
+		return
+	}
+	data, err := c.GetRawData()
+	if err != nil {
+		api.Bad(c, fmt.Sprintf("malformed request: %s", err.Error()))
+		return
+	}
+	act.Await(
+		pid,
+		func(wg *sync.WaitGroup) *actor.PID {
+			return createStringReplyActor(c, wg)
+		},
+		func() interface{} {
+			return &act.PutStringCacheKeyMessage{
+				Key:         key,
+				NewValue:    string(data),
+				ContentType: contentType,
+				Version:     version}
+		})
+}
+
+// rawContentType returns the content type of the request body which is stored as is, JSON and form bodies are bound to the contracts.
+func rawContentType(c *gin.Context) (string, bool) {
+	switch c.ContentType() {
+	case "", gin.MIMEJSON, gin.MIMEPOSTForm, gin.MIMEMultipartPOSTForm:
+		return "", false
+	}
+	return c.GetHeader("Content-Type"), true
+}
+
+// IncrementStringCacheKeyHandler API which atomically increments numeric string value by the key and replies with the new value.
+func IncrementStringCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return incrementStringCacheKey(pid, 1)
+}
+
+// DecrementStringCacheKeyHandler API which atomically decrements numeric string value by the key and replies with the new value.
+func DecrementStringCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return incrementStringCacheKey(pid, -1)
+}
+
+func incrementStringCacheKey(pid *actor.PID, sign int64) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		json, inc, ttl, ok := bindIncrement(c, sign)
+		if !ok {
+			return
+		}
+		version, ok := requestVersion(c, json.Version)
+		if !ok {
+			return
+		}
+		act.Await(
+			pid,
+			func(wg *sync.WaitGroup) *actor.PID {
+				return createStringReplyActor(c, wg)
+			},
+			func() interface{} {
+				return &act.IncrementStringCacheKeyMessage{
+					Key:        key,
+					Delta:      inc.Delta,
+					FloatDelta: inc.FloatDelta,
+					Float:      inc.Float,
+					Create:     json.Create,
+					TTL:        ttl,
+					Sliding:    json.Sliding,
+					Version:    version}
+			})
+	}
+}
+
 func dispatchStringReply(c *gin.Context, ctx actor.Context, wg *sync.WaitGroup) {
 	switch s := ctx.Message().(type) {
-	case act.GetStringCacheKeyReply:
+	case *act.GetStringCacheKeyReply:
 		defer wg.Done()
-		if s.Success {
-			api.OK(c, contracts.StringCacheValueContract{Key: s.Key, Value: s.Value})
+		if s.Success && s.ContentType != "" {
+			api.SetVersion(c, s.Version)
+			api.Data(c, s.ContentType, []byte(s.Value))
+		} else if s.Success {
+			api.SetVersion(c, s.Version)
+			api.OK(c, contracts.StringCacheValueContract{Key: s.Key, Value: s.Value, Version: s.Version})
 		} else {
 			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
 		}
 		break
-	case act.DeleteStringCacheKeyReply:
+	case *act.DeleteStringCacheKeyReply:
 		defer wg.Done()
 		if s.Success {
 			api.NoContent(c)
+		} else if s.Conflict {
+			api.PreconditionFailed(c, fmt.Sprintf("version of key '%s' does not match", s.Key))
 		} else {
 			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
 		}
 		break
-	case act.PostStringCacheKeyReply:
+	case *act.PostStringCacheKeyReply:
 		defer wg.Done()
 		if s.Success {
+			api.SetVersion(c, s.Version)
 			api.Created(c)
 		} else {
 			api.Bad(c, fmt.Sprintf("key '%s' was already used", s.Key))
 		}
 		break
-	case act.PutStringCacheKeyReply:
+	case *act.PutStringCacheKeyReply:
 		defer wg.Done()
 		if s.Success {
+			api.SetVersion(c, s.Version)
 			api.NoContent(c)
+		} else if s.Conflict {
+			api.PreconditionFailed(c, fmt.Sprintf("version of key '%s' does not match", s.Key))
 		} else {
 			api.Bad(c, fmt.Sprintf("key '%s' was already changed to '%s'", s.Key, s.OriginalValue))
 		}
 		break
+	case *act.IncrementStringCacheKeyReply:
+		defer wg.Done()
+		if s.Success {
+			api.SetVersion(c, s.Version)
+			api.OK(c, contracts.StringCacheValueContract{Key: s.Key, Value: s.Value, Version: s.Version})
+		} else if s.Conflict {
+			api.PreconditionFailed(c, fmt.Sprintf("version of key '%s' does not match", s.Key))
+		} else if s.Error != "" {
+			api.Bad(c, fmt.Sprintf("value of key '%s' cannot be incremented: %s", s.Key, s.Error))
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	case *act.SetBitStringCacheKeyReply:
+		defer wg.Done()
+		if s.Success {
+			api.SetVersion(c, s.Version)
+			api.OK(c, contracts.StringCacheBitContract{Key: s.Key, Bit: s.Previous, Version: s.Version})
+		} else if s.Conflict {
+			api.PreconditionFailed(c, fmt.Sprintf("version of key '%s' does not match", s.Key))
+		} else if s.Error != "" {
+			api.Bad(c, fmt.Sprintf("bit of key '%s' cannot be set: %s", s.Key, s.Error))
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	case *act.GetBitStringCacheKeyReply:
+		defer wg.Done()
+		if s.Success {
+			api.SetVersion(c, s.Version)
+			api.OK(c, contracts.StringCacheBitContract{Key: s.Key, Bit: s.Bit, Version: s.Version})
+		} else if s.Error != "" {
+			api.Bad(c, s.Error)
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	case *act.CountBitsStringCacheKeyReply:
+		defer wg.Done()
+		if s.Success {
+			api.SetVersion(c, s.Version)
+			api.OK(c, contracts.StringCacheBitCountContract{Key: s.Key, Count: s.Count, Version: s.Version})
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	case *act.GetBitPosStringCacheKeyReply:
+		defer wg.Done()
+		if s.Success {
+			api.SetVersion(c, s.Version)
+			api.OK(c, contracts.StringCacheBitPosContract{Key: s.Key, Position: s.Position, Version: s.Version})
+		} else if s.Error != "" {
+			api.Bad(c, s.Error)
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	case *act.BitOpStringCacheKeyReply:
+		defer wg.Done()
+		if s.Success {
+			api.SetVersion(c, s.Version)
+			api.OK(c, contracts.StringCacheBitOpContract{Key: s.Key, Length: s.Length, Version: s.Version})
+		} else if s.Conflict {
+			api.PreconditionFailed(c, fmt.Sprintf("version of key '%s' does not match", s.Key))
+		} else {
+			api.Bad(c, s.Error)
+		}
+		break
+	case *act.BitFieldStringCacheKeyReply:
+		defer wg.Done()
+		if s.Success {
+			api.SetVersion(c, s.Version)
+			api.OK(c, contracts.StringCacheBitFieldContract{Key: s.Key, Results: toBitFieldResults(s.Results), Version: s.Version})
+		} else if s.Conflict {
+			api.PreconditionFailed(c, fmt.Sprintf("version of key '%s' does not match", s.Key))
+		} else if s.Error != "" {
+			api.Bad(c, fmt.Sprintf("bitfield of key '%s' cannot be changed: %s", s.Key, s.Error))
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
 	}
 }
 
@@ -0,0 +1,120 @@
+package controllers
+
+import (
+	"fmt"
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/VitalKrasilnikau/memcache/api/contracts"
+	"github.com/VitalKrasilnikau/memcache/api/utils"
+	"github.com/VitalKrasilnikau/memcache/core/actors"
+	"github.com/gin-gonic/gin"
+	"sync"
+	"time"
+)
+
+// GetCacheTTLHandler API which gets expiration of string, list or dictionary cache entry by key.
+func GetCacheTTLHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		act.Await(
+			pid,
+			func(wg *sync.WaitGroup) *actor.PID {
+				return createTTLReplyActor(c, wg)
+			},
+			func() interface{} {
+				return &act.GetCacheTTLMessage{Key: key}
+			})
+	}
+}
+
+// PutCacheTTLHandler API which sets new TTL for string, list or dictionary cache entry by key.
+func PutCacheTTLHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		var json contracts.UpdateCacheTTLContract
+		if err := c.ShouldBindJSON(&json); err == nil {
+			ttl, err := api.ParseTTL(json.TTL)
+			if err != nil || ttl == 0 {
+				api.Bad(c, fmt.Sprintf("malformed ttl '%s'", json.TTL))
+				return
+			}
+			act.Await(
+				pid,
+				func(wg *sync.WaitGroup) *actor.PID {
+					return createTTLReplyActor(c, wg)
+				},
+				func() interface{} {
+					return &act.SetCacheTTLMessage{Key: key, TTL: ttl, Sliding: json.Sliding}
+				})
+		} else {
+			api.Bad(c, fmt.Sprintf("malformed request: %s", err.Error()))
+		}
+	}
+}
+
+// DeleteCacheTTLHandler API which removes TTL of string, list or dictionary cache entry by key, so it never expires.
+func DeleteCacheTTLHandler(pid *actor.PID) func(*gin.Context) {
+	return func(c *gin.Context) {
+		key := c.Param("key")
+		act.Await(
+			pid,
+			func(wg *sync.WaitGroup) *actor.PID {
+				return createTTLReplyActor(c, wg)
+			},
+			func() interface{} {
+				return &act.PersistCacheKeyMessage{Key: key}
+			})
+	}
+}
+
+func dispatchTTLReply(c *gin.Context, ctx actor.Context, wg *sync.WaitGroup) {
+	switch s := ctx.Message().(type) {
+	case *act.GetCacheTTLReply:
+		defer wg.Done()
+		if s.Success {
+			api.OK(c, toTTLContract(s))
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	case *act.SetCacheTTLReply:
+		defer wg.Done()
+		if s.Success {
+			api.NoContent(c)
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	case *act.PersistCacheKeyReply:
+		defer wg.Done()
+		if s.Success {
+			api.NoContent(c)
+		} else {
+			api.NotFound(c, fmt.Sprintf("key '%s' was not found", s.Key))
+		}
+		break
+	}
+}
+
+func toTTLContract(s *act.GetCacheTTLReply) contracts.CacheTTLContract {
+	ttl, expires := formatExpiration(s.ExpireAfter)
+	return contracts.CacheTTLContract{Key: s.Key, TTL: ttl, Expires: expires, Sliding: s.Sliding > 0}
+}
+
+// formatExpiration converts ExpireAfter in Unix milliseconds to remaining ttl and RFC3339 expiration time, empty strings mean no expiration.
+func formatExpiration(expireAfter int64) (string, string) {
+	if expireAfter == 0 {
+		return "", ""
+	}
+	expires := time.Unix(0, expireAfter*int64(time.Millisecond)).UTC()
+	ttl := time.Until(expires)
+	if ttl < time.Millisecond {
+		ttl = time.Millisecond
+	}
+	return ttl.Round(time.Millisecond).String(), expires.Format(time.RFC3339Nano)
+}
+
+func createTTLReplyActor(c *gin.Context, wg *sync.WaitGroup) *actor.PID {
+	return actor.Spawn(actor.FromFunc(func(ctx actor.Context) {
+		dispatchTTLReply(c, ctx, wg)
+	}))
+}
@@ -0,0 +1,20 @@
+package controllers
+
+import (
+	"github.com/VitalKrasilnikau/memcache/api/utils"
+	"github.com/gin-gonic/gin"
+)
+
+// requestVersion returns the entry version expected by the request from If-Match header or the version specified in body.
+// Zero version means any version, malformed header is replied with 400 status and false is returned.
+func requestVersion(c *gin.Context, bodyVersion int64) (int64, bool) {
+	version, err := api.IfMatchVersion(c)
+	if err != nil {
+		api.Bad(c, err.Error())
+		return 0, false
+	}
+	if version == 0 {
+		version = bodyVersion
+	}
+	return version, true
+}
@@ -18,19 +18,19 @@ var doc = `{
         "version": "1.0"
     },
     "paths": {
-        "/api/dictionary": {
+        "/api/bloom": {
             "get": {
-                "description": "gets all dictionary cache keys",
+                "description": "gets all Bloom filter cache keys",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
-                "summary": "gets all dictionary cache keys",
+                "summary": "gets all Bloom filter cache keys",
                 "responses": {
                     "200": {
-                        "description": "string cache keys",
+                        "description": "Bloom filter cache keys",
                         "schema": {
                             "type": "object",
                             "$ref": "#/definitions/contracts.CacheKeysContract"
@@ -46,37 +46,35 @@ var doc = `{
                 }
             }
         },
-        "/api/dictionary/": {
-            "post": {
-                "description": "posts new dictionary value",
+        "/api/bloom-ttl/{key}": {
+            "get": {
+                "description": "gets remaining ttl and expiration time of Bloom filter cache entry by key",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
-                "summary": "posts new dictionary value",
+                "summary": "gets ttl of Bloom filter cache entry by key",
                 "parameters": [
                     {
-                        "description": "body",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "object",
-                            "$ref": "#/definitions/contracts.NewDictionaryCacheValuesContract"
-                        }
+                        "type": "string",
+                        "description": "key",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "created",
+                    "200": {
+                        "description": "remaining ttl, expiration time and sliding flag",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.CacheTTLContract"
                         }
                     },
-                    "400": {
-                        "description": "bad request",
+                    "404": {
+                        "description": "key was not found",
                         "schema": {
                             "type": "object",
                             "$ref": "#/definitions/contracts.ErrorContract"
@@ -92,23 +90,33 @@ var doc = `{
                 }
             }
         },
-        "/api/dictionary/{deleted-key}": {
-            "delete": {
-                "description": "deletes dictionary cache entry by key",
+        "/api/bloom-ttl/{update-key}": {
+            "put": {
+                "description": "sets new ttl of Bloom filter cache entry by key keeping its value, sliding ttl is renewed each time the entry is read or updated",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
-                "summary": "deletes dictionary cache entry by key",
+                "summary": "sets ttl of Bloom filter cache entry by key",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "deleted-key",
-                        "name": "deleted-key",
+                        "description": "update-key",
+                        "name": "update-key",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.UpdateCacheTTLContract"
+                        }
                     }
                 ],
                 "responses": {
@@ -118,6 +126,13 @@ var doc = `{
                             "type": "string"
                         }
                     },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
                     "404": {
                         "description": "key was not found",
                         "schema": {
@@ -133,33 +148,30 @@ var doc = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/dictionary/{key}": {
-            "get": {
-                "description": "gets dictionary cache entry by key",
+            },
+            "delete": {
+                "description": "removes ttl of Bloom filter cache entry by key, so it never expires",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
-                "summary": "gets dictionary cache entry by key",
+                "summary": "removes ttl of Bloom filter cache entry by key",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "key",
-                        "name": "key",
+                        "description": "update-key",
+                        "name": "update-key",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "key and corresponding list value",
+                    "204": {
+                        "description": "no content",
                         "schema": {
-                            "type": "object",
-                            "$ref": "#/definitions/contracts.DictionaryCacheValueContract"
+                            "type": "string"
                         }
                     },
                     "404": {
@@ -179,24 +191,17 @@ var doc = `{
                 }
             }
         },
-        "/api/dictionary/{update-key}": {
+        "/api/bloom/": {
             "post": {
-                "description": "updates existing dictionary value by the key and new added value specified in body",
+                "description": "reserves new Bloom filter for the capacity and the false positive rate specified like BF.RESERVE, capacity is 100 and error rate is 0.01 by default",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
-                "summary": "updates existing dictionary value by the key and new added value specified in body",
+                "summary": "reserves new Bloom filter",
                 "parameters": [
-                    {
-                        "type": "string",
-                        "description": "update-key",
-                        "name": "update-key",
-                        "in": "path",
-                        "required": true
-                    },
                     {
                         "description": "body",
                         "name": "body",
@@ -204,7 +209,7 @@ var doc = `{
                         "required": true,
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/contracts.AddDictionaryCacheValueContract"
+                            "$ref": "#/definitions/contracts.NewBloomFilterCacheContract"
                         }
                     }
                 ],
@@ -232,30 +237,30 @@ var doc = `{
                 }
             }
         },
-        "/api/dictionary/{update-key}/{delete-sub-key}": {
+        "/api/bloom/{deleted-key}": {
             "delete": {
-                "description": "updates existing dictionary value by the key and deleting the subkey specified",
+                "description": "deletes Bloom filter cache entry by key",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
-                "summary": "updates existing dictionary value by the key and deleting the subkey specified",
+                "summary": "deletes Bloom filter cache entry by key",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "update-key",
-                        "name": "update-key",
+                        "description": "deleted-key",
+                        "name": "deleted-key",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "delete-sub-key",
-                        "name": "delete-sub-key",
-                        "in": "path",
-                        "required": true
+                        "description": "expected entry version",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": false
                     }
                 ],
                 "responses": {
@@ -265,8 +270,15 @@ var doc = `{
                             "type": "string"
                         }
                     },
-                    "400": {
-                        "description": "bad request",
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "412": {
+                        "description": "entry version does not match",
                         "schema": {
                             "type": "object",
                             "$ref": "#/definitions/contracts.ErrorContract"
@@ -282,51 +294,42 @@ var doc = `{
                 }
             }
         },
-        "/api/dictionary/{update-key}/{update-sub-key}": {
-            "put": {
-                "description": "updates existing string value in the dictionary by the key and new value specified in body",
+        "/api/bloom/{key}": {
+            "get": {
+                "description": "gets capacity, error rate, number of bits and hash functions and number of values added to Bloom filter by key, with meta=true gets contracts.CacheEntryMetadataContract with timestamps, ttl, persisted flag, size and version instead",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
-                "summary": "updates existing string value in the dictionary by the key and new value specified in body",
+                "summary": "gets Bloom filter info by key",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "update-key",
-                        "name": "update-key",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "update-sub-key",
-                        "name": "update-sub-key",
+                        "description": "key",
+                        "name": "key",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "body",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "object",
-                            "$ref": "#/definitions/contracts.UpdateDictionaryCacheValueContract"
-                        }
+                        "type": "boolean",
+                        "description": "return entry metadata instead of value",
+                        "name": "meta",
+                        "in": "query",
+                        "required": false
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "no content",
+                    "200": {
+                        "description": "Bloom filter options and number of values",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.BloomFilterCacheInfoContract"
                         }
                     },
-                    "400": {
-                        "description": "bad request",
+                    "404": {
+                        "description": "key was not found",
                         "schema": {
                             "type": "object",
                             "$ref": "#/definitions/contracts.ErrorContract"
@@ -342,22 +345,52 @@ var doc = `{
                 }
             }
         },
-        "/api/list": {
+        "/api/bloom/{key}/exists": {
             "get": {
-                "description": "gets all list cache keys",
+                "description": "checks the values in Bloom filter like BF.MEXISTS, the result for each value is true if it was probably added and false if it definitely was not",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
-                "summary": "gets all list cache keys",
+                "summary": "checks values in Bloom filter",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "key",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "value, can be repeated",
+                        "name": "value",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
                 "responses": {
                     "200": {
-                        "description": "string cache keys",
+                        "description": "result for each value",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/contracts.CacheKeysContract"
+                            "$ref": "#/definitions/contracts.BloomFilterCacheResultsContract"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
                         }
                     },
                     "500": {
@@ -370,17 +403,24 @@ var doc = `{
                 }
             }
         },
-        "/api/list/": {
+        "/api/bloom/{update-key}": {
             "post": {
-                "description": "posts new list value",
+                "description": "adds values to Bloom filter like BF.MADD, the result for each value is true if it was not in the filter before, \"create\" option creates the missing key with the capacity and the error rate specified",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
-                "summary": "posts new list value",
+                "summary": "adds values to Bloom filter",
                 "parameters": [
+                    {
+                        "type": "string",
+                        "description": "update-key",
+                        "name": "update-key",
+                        "in": "path",
+                        "required": true
+                    },
                     {
                         "description": "body",
                         "name": "body",
@@ -388,15 +428,23 @@ var doc = `{
                         "required": true,
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/contracts.NewListCacheValuesContract"
+                            "$ref": "#/definitions/contracts.AddBloomFilterCacheValuesContract"
                         }
+                    },
+                    {
+                        "type": "string",
+                        "description": "expected entry version",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": false
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "created",
+                    "200": {
+                        "description": "result for each value",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.BloomFilterCacheResultsContract"
                         }
                     },
                     "400": {
@@ -406,6 +454,20 @@ var doc = `{
                             "$ref": "#/definitions/contracts.ErrorContract"
                         }
                     },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "412": {
+                        "description": "entry version does not match",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
                     "500": {
                         "description": "server error",
                         "schema": {
@@ -416,37 +478,22 @@ var doc = `{
                 }
             }
         },
-        "/api/list/{deleted-key}": {
-            "delete": {
-                "description": "deletes list cache entry by key",
+        "/api/dictionary": {
+            "get": {
+                "description": "gets all dictionary cache keys",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
-                "summary": "deletes list cache entry by key",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "deleted-key",
-                        "name": "deleted-key",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
+                "summary": "gets all dictionary cache keys",
                 "responses": {
-                    "204": {
-                        "description": "no content",
-                        "schema": {
-                            "type": "string"
-                        }
-                    },
-                    "404": {
-                        "description": "key was not found",
+                    "200": {
+                        "description": "string cache keys",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/contracts.ErrorContract"
+                            "$ref": "#/definitions/contracts.CacheKeysContract"
                         }
                     },
                     "500": {
@@ -459,16 +506,16 @@ var doc = `{
                 }
             }
         },
-        "/api/list/{key}": {
+        "/api/dictionary-ttl/{key}": {
             "get": {
-                "description": "gets list cache entry by key",
+                "description": "gets remaining ttl and expiration time of dictionary cache entry by key",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
-                "summary": "gets list cache entry by key",
+                "summary": "gets ttl of dictionary cache entry by key",
                 "parameters": [
                     {
                         "type": "string",
@@ -480,10 +527,10 @@ var doc = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "key and corresponding list value",
+                        "description": "remaining ttl, expiration time and sliding flag",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/contracts.ListCacheValueContract"
+                            "$ref": "#/definitions/contracts.CacheTTLContract"
                         }
                     },
                     "404": {
@@ -503,16 +550,16 @@ var doc = `{
                 }
             }
         },
-        "/api/list/{update-key}": {
-            "post": {
-                "description": "updates existing list value by the key and new added value specified in body",
+        "/api/dictionary-ttl/{update-key}": {
+            "put": {
+                "description": "sets new ttl of dictionary cache entry by key keeping its value, sliding ttl is renewed each time the entry is read or updated",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
-                "summary": "updates existing list value by the key and new added value specified in body",
+                "summary": "sets ttl of dictionary cache entry by key",
                 "parameters": [
                     {
                         "type": "string",
@@ -528,13 +575,13 @@ var doc = `{
                         "required": true,
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/contracts.UpdateListCacheValueContract"
+                            "$ref": "#/definitions/contracts.UpdateCacheTTLContract"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "created",
+                    "204": {
+                        "description": "no content",
                         "schema": {
                             "type": "string"
                         }
@@ -546,6 +593,13 @@ var doc = `{
                             "$ref": "#/definitions/contracts.ErrorContract"
                         }
                     },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
                     "500": {
                         "description": "server error",
                         "schema": {
@@ -554,18 +608,16 @@ var doc = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/list/{update-key}/{delete-value}": {
+            },
             "delete": {
-                "description": "updates existing list value by the key and deleting the value specified",
+                "description": "removes ttl of dictionary cache entry by key, so it never expires",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
-                "summary": "updates existing list value by the key and deleting the value specified",
+                "summary": "removes ttl of dictionary cache entry by key",
                 "parameters": [
                     {
                         "type": "string",
@@ -573,13 +625,6 @@ var doc = `{
                         "name": "update-key",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "delete-value",
-                        "name": "delete-value",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
@@ -589,6 +634,52 @@ var doc = `{
                             "type": "string"
                         }
                     },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/dictionary/": {
+            "post": {
+                "description": "posts new dictionary value",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "posts new dictionary value",
+                "parameters": [
+                    {
+                        "description": "body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.NewDictionaryCacheValuesContract"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "created",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
                     "400": {
                         "description": "bad request",
                         "schema": {
@@ -606,51 +697,62 @@ var doc = `{
                 }
             }
         },
-        "/api/list/{update-key}/{update-value}": {
-            "put": {
-                "description": "updates existing string value in the list by the key and new value specified in body",
+        "/api/dictionary/{deleted-key}": {
+            "delete": {
+                "description": "deletes dictionary cache entry by key, if \"subkey\" is specified deletes only the sub-keys atomically and replies with the values deleted, missing sub-keys are skipped",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
-                "summary": "updates existing string value in the list by the key and new value specified in body",
+                "summary": "deletes dictionary cache entry or its sub-keys by key",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "update-key",
-                        "name": "update-key",
+                        "description": "deleted-key",
+                        "name": "deleted-key",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "update-value",
-                        "name": "update-value",
-                        "in": "path",
-                        "required": true
+                        "description": "sub-key, can be repeated or comma-separated",
+                        "name": "subkey",
+                        "in": "query",
+                        "required": false
                     },
                     {
-                        "description": "body",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "object",
-                            "$ref": "#/definitions/contracts.UpdateListCacheValueContract"
-                        }
+                        "type": "string",
+                        "description": "expected entry version",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": false
                     }
                 ],
                 "responses": {
+                    "200": {
+                        "description": "sub-key values deleted",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.DictionaryCacheValueContract"
+                        }
+                    },
                     "204": {
                         "description": "no content",
                         "schema": {
                             "type": "string"
                         }
                     },
-                    "400": {
-                        "description": "bad request",
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "412": {
+                        "description": "entry version does not match",
                         "schema": {
                             "type": "object",
                             "$ref": "#/definitions/contracts.ErrorContract"
@@ -666,22 +768,45 @@ var doc = `{
                 }
             }
         },
-        "/api/string": {
+        "/api/dictionary/{key}": {
             "get": {
-                "description": "gets all string cache keys",
+                "description": "gets dictionary cache entry by key, with meta=true gets contracts.CacheEntryMetadataContract with timestamps, ttl, persisted flag, size and version instead",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
-                "summary": "gets all string cache keys",
+                "summary": "gets dictionary cache entry by key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "key",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "return entry metadata instead of value",
+                        "name": "meta",
+                        "in": "query",
+                        "required": false
+                    }
+                ],
                 "responses": {
                     "200": {
-                        "description": "string cache keys",
+                        "description": "key and corresponding list value",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/contracts.CacheKeysContract"
+                            "$ref": "#/definitions/contracts.DictionaryCacheValueContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
                         }
                     },
                     "500": {
@@ -692,19 +817,24 @@ var doc = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/string/": {
-            "post": {
-                "description": "posts new string value",
+            },
+            "patch": {
+                "description": "adds missing dictionary sub-keys and updates existing ones atomically",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
-                "summary": "posts new string value",
+                "summary": "adds missing dictionary sub-keys and updates existing ones atomically",
                 "parameters": [
+                    {
+                        "type": "string",
+                        "description": "key",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    },
                     {
                         "description": "body",
                         "name": "body",
@@ -712,15 +842,23 @@ var doc = `{
                         "required": true,
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/contracts.NewStringCacheValueContract"
+                            "$ref": "#/definitions/contracts.SetDictionaryCacheValuesContract"
                         }
+                    },
+                    {
+                        "type": "string",
+                        "description": "expected entry version",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": false
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "created",
+                    "200": {
+                        "description": "number of sub-keys added and updated",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.DictionaryCacheSetResultContract"
                         }
                     },
                     "400": {
@@ -730,6 +868,20 @@ var doc = `{
                             "$ref": "#/definitions/contracts.ErrorContract"
                         }
                     },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "412": {
+                        "description": "entry version does not match",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
                     "500": {
                         "description": "server error",
                         "schema": {
@@ -740,30 +892,38 @@ var doc = `{
                 }
             }
         },
-        "/api/string/{deleted-key}": {
-            "delete": {
-                "description": "deletes string cache entry by key",
+        "/api/dictionary/{key}/exists": {
+            "get": {
+                "description": "checks if the dictionary contains the sub-keys",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
-                "summary": "deletes string cache entry by key",
+                "summary": "checks if the dictionary contains the sub-keys",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "deleted-key",
-                        "name": "deleted-key",
+                        "description": "key",
+                        "name": "key",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "sub-key, can be repeated or comma-separated",
+                        "name": "subkey",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "no content",
+                    "200": {
+                        "description": "existence of each sub-key",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.DictionaryCacheExistsContract"
                         }
                     },
                     "404": {
@@ -783,16 +943,16 @@ var doc = `{
                 }
             }
         },
-        "/api/string/{key}": {
+        "/api/dictionary/{key}/subkeys": {
             "get": {
-                "description": "gets string cache entry by key",
+                "description": "gets the sorted dictionary sub-keys without their values",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
-                "summary": "gets string cache entry by key",
+                "summary": "gets the sorted dictionary sub-keys without their values",
                 "parameters": [
                     {
                         "type": "string",
@@ -804,10 +964,10 @@ var doc = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "key and corresponding value",
+                        "description": "key and sub-keys",
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/contracts.StringCacheValueContract"
+                            "$ref": "#/definitions/contracts.DictionaryCacheSubKeysContract"
                         }
                     },
                     "404": {
@@ -827,21 +987,79 @@ var doc = `{
                 }
             }
         },
-        "/api/string/{update-key}": {
-            "put": {
-                "description": "updates existing string value by the key and new value specified",
+        "/api/dictionary/{key}/values": {
+            "get": {
+                "description": "gets the values of the selected dictionary sub-keys, missing sub-keys are skipped",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
-                "summary": "updates existing string value by the key and new value specified",
+                "summary": "gets the values of the selected dictionary sub-keys, missing sub-keys are skipped",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "update-key",
-                        "name": "update-key",
+                        "description": "key",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "sub-key, can be repeated or comma-separated",
+                        "name": "subkey",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "key and values of the sub-keys found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.DictionaryCacheValueContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/dictionary/{key}/{subkey}/decr": {
+            "post": {
+                "description": "atomically decrements numeric value of the dictionary sub-key, missing sub-key is treated as zero",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "atomically decrements numeric value of the dictionary sub-key, missing sub-key is treated as zero",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "key",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "subkey",
+                        "name": "subkey",
                         "in": "path",
                         "required": true
                     },
@@ -849,18 +1067,26 @@ var doc = `{
                         "description": "body",
                         "name": "body",
                         "in": "body",
-                        "required": true,
+                        "required": false,
                         "schema": {
                             "type": "object",
-                            "$ref": "#/definitions/contracts.UpdateStringCacheValueContract"
+                            "$ref": "#/definitions/contracts.IncrementCacheValueContract"
                         }
+                    },
+                    {
+                        "type": "string",
+                        "description": "expected entry version",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": false
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "no content",
+                    "200": {
+                        "description": "key, sub-key and new value",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.DictionaryCacheSubKeyValueContract"
                         }
                     },
                     "400": {
@@ -870,6 +1096,20 @@ var doc = `{
                             "$ref": "#/definitions/contracts.ErrorContract"
                         }
                     },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "412": {
+                        "description": "entry version does not match",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
                     "500": {
                         "description": "server error",
                         "schema": {
@@ -879,26 +1119,9205 @@ var doc = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "contracts.AddDictionaryCacheValueContract": {
-            "type": "object",
-            "properties": {
-                "Value": {
+        },
+        "/api/dictionary/{key}/{subkey}/incr": {
+            "post": {
+                "description": "atomically increments numeric value of the dictionary sub-key, missing sub-key is treated as zero",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "atomically increments numeric value of the dictionary sub-key, missing sub-key is treated as zero",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "key",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "subkey",
+                        "name": "subkey",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body",
+                        "name": "body",
+                        "in": "body",
+                        "required": false,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.IncrementCacheValueContract"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "expected entry version",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": false
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "key, sub-key and new value",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.DictionaryCacheSubKeyValueContract"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "412": {
+                        "description": "entry version does not match",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/dictionary/{update-key}": {
+            "post": {
+                "description": "updates existing dictionary value by the key and new added value specified in body",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "updates existing dictionary value by the key and new added value specified in body",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "update-key",
+                        "name": "update-key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.AddDictionaryCacheValueContract"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "expected entry version",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": false
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "created",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "412": {
+                        "description": "entry version does not match",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/dictionary/{update-key}/{delete-sub-key}": {
+            "delete": {
+                "description": "updates existing dictionary value by the key and deleting the subkey specified",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "updates existing dictionary value by the key and deleting the subkey specified",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "update-key",
+                        "name": "update-key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "delete-sub-key",
+                        "name": "delete-sub-key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "expected entry version",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": false
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "no content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "412": {
+                        "description": "entry version does not match",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/dictionary/{update-key}/{update-sub-key}": {
+            "put": {
+                "description": "updates existing string value in the dictionary by the key and new value specified in body",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "updates existing string value in the dictionary by the key and new value specified in body",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "update-key",
+                        "name": "update-key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "update-sub-key",
+                        "name": "update-sub-key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.UpdateDictionaryCacheValueContract"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "expected entry version",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": false
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "no content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "412": {
+                        "description": "entry version does not match",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/document": {
+            "get": {
+                "description": "gets all document cache keys",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "gets all document cache keys",
+                "responses": {
+                    "200": {
+                        "description": "document cache keys",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.CacheKeysContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/document-ttl/{key}": {
+            "get": {
+                "description": "gets remaining ttl and expiration time of document cache entry by key",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "gets ttl of document cache entry by key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "key",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "remaining ttl, expiration time and sliding flag",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.CacheTTLContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/document-ttl/{update-key}": {
+            "put": {
+                "description": "sets new ttl of document cache entry by key keeping its value, sliding ttl is renewed each time the entry is read or updated",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "sets ttl of document cache entry by key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "update-key",
+                        "name": "update-key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.UpdateCacheTTLContract"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "no content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "removes ttl of document cache entry by key, so it never expires",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "removes ttl of document cache entry by key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "update-key",
+                        "name": "update-key",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "no content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/document/": {
+            "post": {
+                "description": "posts new JSON document",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "posts new JSON document",
+                "parameters": [
+                    {
+                        "description": "body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.NewDocumentCacheValueContract"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "created",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/document/{deleted-key}": {
+            "delete": {
+                "description": "deletes document cache entry by key, with path deletes only the value at the path",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "deletes document cache entry by key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "deleted-key",
+                        "name": "deleted-key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "path inside the document",
+                        "name": "path",
+                        "in": "query",
+                        "required": false
+                    },
+                    {
+                        "type": "string",
+                        "description": "expected entry version",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": false
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "no content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key or path was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "412": {
+                        "description": "entry version does not match",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/document/{key}": {
+            "get": {
+                "description": "gets JSON document by key, with path gets the value at JSON Pointer (\"/a/0\") or dot notation (\"$.a[0]\") path, with meta=true gets contracts.CacheEntryMetadataContract with timestamps, ttl, persisted flag, size and version instead",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "gets document cache entry by key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "key",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "path inside the document",
+                        "name": "path",
+                        "in": "query",
+                        "required": false
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "return entry metadata instead of value",
+                        "name": "meta",
+                        "in": "query",
+                        "required": false
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "key and corresponding document or value at the path",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.DocumentCacheValueContract"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key or path was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/document/{update-key}": {
+            "put": {
+                "description": "adds or replaces the value at the path of the document, the parent object or array must exist, \"-\" as the last array index appends the value and empty path replaces the whole document",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "sets the value at the path of the document",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "update-key",
+                        "name": "update-key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.UpdateDocumentCachePathContract"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "expected entry version",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": false
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "no content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key or path was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "412": {
+                        "description": "entry version does not match",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "applies JSON Patch (RFC 6902) operations with application/json-patch+json or JSON Merge Patch (RFC 7396) with application/merge-patch+json content type, with application/json an array is treated as JSON Patch; JSON Patch is applied all or nothing",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "patches the document",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "update-key",
+                        "name": "update-key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "array of operations or merge patch",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.DocumentPatchOperationContract"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "expected entry version",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": false
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "patched document",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.DocumentCacheValueContract"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "412": {
+                        "description": "entry version does not match",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/document/{update-key}/append": {
+            "post": {
+                "description": "appends values to the array at the path of the document",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "appends values to the array inside the document",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "update-key",
+                        "name": "update-key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.AppendDocumentCacheValuesContract"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "expected entry version",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": false
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "new length of the array",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.DocumentCacheLengthContract"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key or path was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "412": {
+                        "description": "entry version does not match",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/geo": {
+            "get": {
+                "description": "gets all geo cache keys",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "gets all geo cache keys",
+                "responses": {
+                    "200": {
+                        "description": "geo cache keys",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.CacheKeysContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/geo-ttl/{key}": {
+            "get": {
+                "description": "gets remaining ttl and expiration time of geo cache entry by key",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "gets ttl of geo cache entry by key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "key",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "remaining ttl, expiration time and sliding flag",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.CacheTTLContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/geo-ttl/{update-key}": {
+            "put": {
+                "description": "sets new ttl of geo cache entry by key keeping its value, sliding ttl is renewed each time the entry is read or updated",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "sets ttl of geo cache entry by key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "update-key",
+                        "name": "update-key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.UpdateCacheTTLContract"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "no content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "removes ttl of geo cache entry by key, so it never expires",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "removes ttl of geo cache entry by key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "update-key",
+                        "name": "update-key",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "no content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/geo/": {
+            "post": {
+                "description": "posts new geo cache entry with the members specified, longitude must be within [-180, 180] and latitude within [-85.05112878, 85.05112878]",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "posts new geo cache entry",
+                "parameters": [
+                    {
+                        "description": "body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.NewGeoCacheContract"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "created",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/geo/{deleted-key}": {
+            "delete": {
+                "description": "deletes geo cache entry by key",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "deletes geo cache entry by key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "deleted-key",
+                        "name": "deleted-key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "expected entry version",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": false
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "no content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "412": {
+                        "description": "entry version does not match",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/geo/{key}": {
+            "get": {
+                "description": "gets all the members of geo cache entry with their positions and geohashes sorted by member, with meta=true gets contracts.CacheEntryMetadataContract with timestamps, ttl, persisted flag, size and version instead",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "gets geo cache entry by key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "key",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "return entry metadata instead of value",
+                        "name": "meta",
+                        "in": "query",
+                        "required": false
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "members with positions",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.GeoCacheMembersContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/geo/{key}/distance": {
+            "get": {
+                "description": "gets the great-circle distance between two members like GEODIST in m, km, mi or ft",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "gets distance between geo members",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "key",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "first member",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "second member",
+                        "name": "to",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "m, km, mi or ft, m by default",
+                        "name": "unit",
+                        "in": "query",
+                        "required": false
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "distance",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.GeoCacheDistanceContract"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key or member was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/geo/{key}/positions": {
+            "get": {
+                "description": "gets the positions and geohashes of the members like GEOPOS, the members which were not found are listed separately",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "gets positions of geo members",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "key",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma-separated members",
+                        "name": "members",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "positions of the members",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.GeoCachePositionsContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/geo/{key}/search": {
+            "get": {
+                "description": "finds the members within the radius or the width x height box around the member or the position like GEOSEARCH, the results are sorted by distance, nearest first unless sort=desc",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "searches geo members by radius or box",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "key",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "member used as the center",
+                        "name": "member",
+                        "in": "query",
+                        "required": false
+                    },
+                    {
+                        "type": "number",
+                        "description": "longitude of the center",
+                        "name": "longitude",
+                        "in": "query",
+                        "required": false
+                    },
+                    {
+                        "type": "number",
+                        "description": "latitude of the center",
+                        "name": "latitude",
+                        "in": "query",
+                        "required": false
+                    },
+                    {
+                        "type": "number",
+                        "description": "radius",
+                        "name": "radius",
+                        "in": "query",
+                        "required": false
+                    },
+                    {
+                        "type": "number",
+                        "description": "width of the box",
+                        "name": "width",
+                        "in": "query",
+                        "required": false
+                    },
+                    {
+                        "type": "number",
+                        "description": "height of the box",
+                        "name": "height",
+                        "in": "query",
+                        "required": false
+                    },
+                    {
+                        "type": "string",
+                        "description": "m, km, mi or ft, m by default",
+                        "name": "unit",
+                        "in": "query",
+                        "required": false
+                    },
+                    {
+                        "type": "integer",
+                        "description": "maximum number of members, 0 means all",
+                        "name": "count",
+                        "in": "query",
+                        "required": false
+                    },
+                    {
+                        "type": "string",
+                        "description": "asc or desc, asc by default",
+                        "name": "sort",
+                        "in": "query",
+                        "required": false
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "members found with distances",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.GeoCacheSearchContract"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key or member was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/geo/{update-key}": {
+            "post": {
+                "description": "adds new members or moves the existing ones like GEOADD, nothing is changed if any position is invalid, \"create\" option creates the missing key",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "adds members to geo cache entry",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "update-key",
+                        "name": "update-key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.AddGeoCacheMembersContract"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "expected entry version",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": false
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "number of members added and moved",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.GeoCacheAddContract"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "412": {
+                        "description": "entry version does not match",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/geo/{update-key}/{member}": {
+            "delete": {
+                "description": "removes the member from geo cache entry",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "removes member from geo cache entry",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "update-key",
+                        "name": "update-key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "member",
+                        "name": "member",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "expected entry version",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": false
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "no content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "member was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "412": {
+                        "description": "entry version does not match",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/hyperloglog": {
+            "get": {
+                "description": "gets all HyperLogLog cache keys",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "gets all HyperLogLog cache keys",
+                "responses": {
+                    "200": {
+                        "description": "HyperLogLog cache keys",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.CacheKeysContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/hyperloglog-ttl/{key}": {
+            "get": {
+                "description": "gets remaining ttl and expiration time of HyperLogLog cache entry by key",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "gets ttl of HyperLogLog cache entry by key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "key",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "remaining ttl, expiration time and sliding flag",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.CacheTTLContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/hyperloglog-ttl/{update-key}": {
+            "put": {
+                "description": "sets new ttl of HyperLogLog cache entry by key keeping its value, sliding ttl is renewed each time the entry is read or updated",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "sets ttl of HyperLogLog cache entry by key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "update-key",
+                        "name": "update-key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.UpdateCacheTTLContract"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "no content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "removes ttl of HyperLogLog cache entry by key, so it never expires",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "removes ttl of HyperLogLog cache entry by key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "update-key",
+                        "name": "update-key",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "no content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/hyperloglog/": {
+            "post": {
+                "description": "posts new HyperLogLog with optional values",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "posts new HyperLogLog",
+                "parameters": [
+                    {
+                        "description": "body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.NewHyperLogLogCacheValuesContract"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "created",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/hyperloglog/{deleted-key}": {
+            "delete": {
+                "description": "deletes HyperLogLog cache entry by key",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "deletes HyperLogLog cache entry by key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "deleted-key",
+                        "name": "deleted-key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "expected entry version",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": false
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "no content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "412": {
+                        "description": "entry version does not match",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/hyperloglog/{key}": {
+            "get": {
+                "description": "gets the estimated number of distinct values of HyperLogLog by key like PFCOUNT, with \"with\" query parameter gets the estimate of the union of the HyperLogLogs and missing keys are skipped, with meta=true gets contracts.CacheEntryMetadataContract with timestamps, ttl, persisted flag, size and version instead",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "gets the estimated count of HyperLogLog by key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "key",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma-separated keys of other HyperLogLogs",
+                        "name": "with",
+                        "in": "query",
+                        "required": false
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "return entry metadata instead of value",
+                        "name": "meta",
+                        "in": "query",
+                        "required": false
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "key and estimated count",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.HyperLogLogCacheCountContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/hyperloglog/{update-key}": {
+            "post": {
+                "description": "adds values to HyperLogLog like PFADD, changed is true if the estimate could change, \"create\" option creates the missing key",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "adds values to HyperLogLog",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "update-key",
+                        "name": "update-key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.AddHyperLogLogCacheValuesContract"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "expected entry version",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": false
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "changed flag and estimated count",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.HyperLogLogCacheAddContract"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "412": {
+                        "description": "entry version does not match",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/hyperloglog/{update-key}/merge": {
+            "post": {
+                "description": "merges the HyperLogLogs specified into the one by key like PFMERGE, the key is created if missing and missing sources are skipped",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "merges HyperLogLogs",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "update-key",
+                        "name": "update-key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.MergeHyperLogLogCacheContract"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "expected entry version",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": false
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "estimated count of the merged HyperLogLog",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.HyperLogLogCacheCountContract"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "412": {
+                        "description": "entry version does not match",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/list": {
+            "get": {
+                "description": "gets all list cache keys",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "gets all list cache keys",
+                "responses": {
+                    "200": {
+                        "description": "string cache keys",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.CacheKeysContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/list-ttl/{key}": {
+            "get": {
+                "description": "gets remaining ttl and expiration time of list cache entry by key",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "gets ttl of list cache entry by key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "key",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "remaining ttl, expiration time and sliding flag",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.CacheTTLContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/list-ttl/{update-key}": {
+            "put": {
+                "description": "sets new ttl of list cache entry by key keeping its value, sliding ttl is renewed each time the entry is read or updated",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "sets ttl of list cache entry by key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "update-key",
+                        "name": "update-key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.UpdateCacheTTLContract"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "no content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "removes ttl of list cache entry by key, so it never expires",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "removes ttl of list cache entry by key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "update-key",
+                        "name": "update-key",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "no content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/list/": {
+            "post": {
+                "description": "posts new list value",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "posts new list value",
+                "parameters": [
+                    {
+                        "description": "body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.NewListCacheValuesContract"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "created",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/list/{deleted-key}": {
+            "delete": {
+                "description": "deletes list cache entry by key",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "deletes list cache entry by key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "deleted-key",
+                        "name": "deleted-key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "expected entry version",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": false
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "no content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "412": {
+                        "description": "entry version does not match",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/list/{key}": {
+            "get": {
+                "description": "gets list cache entry by key, with meta=true gets contracts.CacheEntryMetadataContract with timestamps, ttl, persisted flag, size and version instead",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "gets list cache entry by key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "key",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "return entry metadata instead of value",
+                        "name": "meta",
+                        "in": "query",
+                        "required": false
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "key and corresponding list value",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ListCacheValueContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/list/{key}/index": {
+            "get": {
+                "description": "gets list value by zero-based index, negative indexes are counted from the end",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "gets list value by zero-based index, negative indexes are counted from the end",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "key",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "int",
+                        "description": "index",
+                        "name": "index",
+                        "in": "query",
+                        "required": false
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "key, index and value",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ListCacheIndexValueContract"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found or index is out of range",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/list/{key}/insert": {
+            "post": {
+                "description": "inserts the value before the index, the index equal to the length appends the value",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "inserts the value before the index, the index equal to the length appends the value",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "key",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.UpdateListCacheIndexContract"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "expected entry version",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": false
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "new length of the list",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ListCacheLengthContract"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request or index is out of range",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "412": {
+                        "description": "entry version does not match",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/list/{key}/lpop": {
+            "post": {
+                "description": "removes the values from the head of the list like LPOP, with timeout specified waits for the values like BLPOP",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "removes the values from the head of the list like LPOP, with timeout specified waits for the values like BLPOP",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "key",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body",
+                        "name": "body",
+                        "in": "body",
+                        "required": false,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.PopListCacheValuesContract"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "expected entry version",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": false
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "removed values",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ListCacheValueContract"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found, empty or timeout expired",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "412": {
+                        "description": "entry version does not match",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/list/{key}/lpush": {
+            "post": {
+                "description": "adds the values to the head of the list like LPUSH, with create=true the missing list is created",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "adds the values to the head of the list like LPUSH, with create=true the missing list is created",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "key",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.PushListCacheValuesContract"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "expected entry version",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": false
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "new length of the list",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ListCacheLengthContract"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "412": {
+                        "description": "entry version does not match",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/list/{key}/range": {
+            "get": {
+                "description": "gets list values from start to stop indexes inclusive like LRANGE, the reply contains the length of the list for pagination",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "gets list values from start to stop indexes inclusive like LRANGE, the reply contains the length of the list for pagination",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "key",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "int",
+                        "description": "start index, 0 by default",
+                        "name": "start",
+                        "in": "query",
+                        "required": false
+                    },
+                    {
+                        "type": "int",
+                        "description": "stop index, -1 by default",
+                        "name": "stop",
+                        "in": "query",
+                        "required": false
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "key, values, start index and length of the list",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ListCacheRangeContract"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/list/{key}/remove": {
+            "post": {
+                "description": "removes list value by its index, duplicates of the value are kept",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "removes list value by its index, duplicates of the value are kept",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "key",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.RemoveListCacheIndexContract"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "expected entry version",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": false
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "removed value",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ListCacheIndexValueContract"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request or index is out of range",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "412": {
+                        "description": "entry version does not match",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/list/{key}/rpop": {
+            "post": {
+                "description": "removes the values from the tail of the list like RPOP, with timeout specified waits for the values like BRPOP",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "removes the values from the tail of the list like RPOP, with timeout specified waits for the values like BRPOP",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "key",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body",
+                        "name": "body",
+                        "in": "body",
+                        "required": false,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.PopListCacheValuesContract"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "expected entry version",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": false
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "removed values",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ListCacheValueContract"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found, empty or timeout expired",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "412": {
+                        "description": "entry version does not match",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/list/{key}/rpush": {
+            "post": {
+                "description": "adds the values to the tail of the list like RPUSH, with create=true the missing list is created",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "adds the values to the tail of the list like RPUSH, with create=true the missing list is created",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "key",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.PushListCacheValuesContract"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "expected entry version",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": false
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "new length of the list",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ListCacheLengthContract"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "412": {
+                        "description": "entry version does not match",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/list/{key}/set": {
+            "post": {
+                "description": "replaces list value by its index, duplicates of the value are kept",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "replaces list value by its index, duplicates of the value are kept",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "key",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.UpdateListCacheIndexContract"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "expected entry version",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": false
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "no content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request or index is out of range",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "412": {
+                        "description": "entry version does not match",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/list/{key}/trim": {
+            "post": {
+                "description": "keeps only the values from start to stop indexes inclusive like LTRIM",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "keeps only the values from start to stop indexes inclusive like LTRIM",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "key",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.TrimListCacheContract"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "expected entry version",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": false
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "number of values removed and new length of the list",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ListCacheTrimContract"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "412": {
+                        "description": "entry version does not match",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/list/{update-key}": {
+            "post": {
+                "description": "updates existing list value by the key and new added value specified in body",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "updates existing list value by the key and new added value specified in body",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "update-key",
+                        "name": "update-key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.UpdateListCacheValueContract"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "expected entry version",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": false
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "created",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "412": {
+                        "description": "entry version does not match",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/list/{update-key}/{delete-value}": {
+            "delete": {
+                "description": "updates existing list value by the key and deleting the value specified",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "updates existing list value by the key and deleting the value specified",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "update-key",
+                        "name": "update-key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "delete-value",
+                        "name": "delete-value",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "expected entry version",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": false
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "no content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "412": {
+                        "description": "entry version does not match",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/list/{update-key}/{update-value}": {
+            "put": {
+                "description": "updates existing string value in the list by the key and new value specified in body",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "updates existing string value in the list by the key and new value specified in body",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "update-key",
+                        "name": "update-key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "update-value",
+                        "name": "update-value",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.UpdateListCacheValueContract"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "expected entry version",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": false
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "no content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "412": {
+                        "description": "entry version does not match",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/lock": {
+            "get": {
+                "description": "gets keys of all held locks, the lock is removed when it is released or its lease ends",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "gets all lock keys",
+                "responses": {
+                    "200": {
+                        "description": "lock keys",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.CacheKeysContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/lock/{deleted-key}": {
+            "delete": {
+                "description": "releases the lock if it is held by the owner",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "releases lock",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "deleted-key",
+                        "name": "deleted-key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "owner token",
+                        "name": "owner",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "no content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "404": {
+                        "description": "lock is not held",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "409": {
+                        "description": "lock is held by another owner",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/lock/{key}": {
+            "get": {
+                "description": "gets the fencing token, the acquisition time and the remaining lease of the held lock, the owner token is never returned. With meta=true gets contracts.CacheEntryMetadataContract with timestamps, ttl, persisted flag, size and version instead",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "gets held lock by key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "key",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "return entry metadata instead of value",
+                        "name": "meta",
+                        "in": "query",
+                        "required": false
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "fencing token and remaining lease",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.LockInfoContract"
+                        }
+                    },
+                    "404": {
+                        "description": "lock is not held",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/lock/{update-key}": {
+            "put": {
+                "description": "extends the lease of the lock held by the owner, the fencing token is not changed",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "renews lock",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "update-key",
+                        "name": "update-key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.RenewLockContract"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "fencing token and lease",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.LockContract"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "404": {
+                        "description": "lock is not held",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "409": {
+                        "description": "lock is held by another owner",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "acquires the lock for the owner with the lease, the owner token is generated if it is not specified. The fencing token increases with each acquisition of the lock, so the storage guarded by the lock can reject writes with an older token. The owner which already holds the lock gets its lease renewed with the same fencing token. The lock held by another owner is not acquired and the lease replied is the time remaining until it expires",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "acquires lock",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "update-key",
+                        "name": "update-key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.AcquireLockContract"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "acquired flag, owner and fencing token",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.LockContract"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/queue": {
+            "get": {
+                "description": "gets all queue cache keys",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "gets all queue cache keys",
+                "responses": {
+                    "200": {
+                        "description": "queue cache keys",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.CacheKeysContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/queue-ttl/{key}": {
+            "get": {
+                "description": "gets remaining ttl and expiration time of queue cache entry by key",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "gets ttl of queue cache entry by key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "key",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "remaining ttl, expiration time and sliding flag",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.CacheTTLContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/queue-ttl/{update-key}": {
+            "put": {
+                "description": "sets new ttl of queue cache entry by key keeping its value, sliding ttl is renewed each time the entry is read or updated",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "sets ttl of queue cache entry by key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "update-key",
+                        "name": "update-key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.UpdateCacheTTLContract"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "no content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "removes ttl of queue cache entry by key, so it never expires",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "removes ttl of queue cache entry by key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "update-key",
+                        "name": "update-key",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "no content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/queue/": {
+            "post": {
+                "description": "posts new queue cache entry with the items specified, visibility is the default time the delivered items stay in flight (30s if empty), zero maxAttempts means that the items are never moved to the dead letters",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "posts new queue cache entry",
+                "parameters": [
+                    {
+                        "description": "body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.NewQueueCacheContract"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "created",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/queue/{deleted-key}": {
+            "delete": {
+                "description": "deletes queue cache entry by key with its in-flight items and dead letters",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "deletes queue cache entry by key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "deleted-key",
+                        "name": "deleted-key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "expected entry version",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": false
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "no content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "412": {
+                        "description": "entry version does not match",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/queue/{key}": {
+            "get": {
+                "description": "gets the number of ready, delayed, in-flight and dead items of queue cache entry with its visibility timeout and limit of delivery attempts, with meta=true gets contracts.CacheEntryMetadataContract with timestamps, ttl, persisted flag, size and version instead",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "gets queue cache entry by key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "key",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "return entry metadata instead of value",
+                        "name": "meta",
+                        "in": "query",
+                        "required": false
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "number of items",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.QueueCacheInfoContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/queue/{key}/dead": {
+            "get": {
+                "description": "gets up to count dead letters of the queue in the order they died, zero count means all of them",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "gets dead letters of queue cache entry",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "key",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "int",
+                        "description": "maximum number of items",
+                        "name": "count",
+                        "in": "query",
+                        "required": false
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "dead letters",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.QueueCacheItemsContract"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/queue/{key}/peek": {
+            "get": {
+                "description": "gets up to count (1 by default) items which would be delivered next without delivering them",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "peeks items of queue cache entry",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "key",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "int",
+                        "description": "maximum number of items",
+                        "name": "count",
+                        "in": "query",
+                        "required": false
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "items which would be delivered next",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.QueueCacheItemsContract"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/queue/{update-key}": {
+            "post": {
+                "description": "pushes the items with their priorities and delays, delay is a duration or the time the item can be delivered after, \"create\" option creates the missing key",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "pushes items to queue cache entry",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "update-key",
+                        "name": "update-key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.PushQueueCacheContract"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "expected entry version",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": false
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "IDs of the items pushed",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.QueueCachePushContract"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "412": {
+                        "description": "entry version does not match",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/queue/{update-key}/ack": {
+            "post": {
+                "description": "removes the delivered items by their receipts, the receipts of the items which returned to the queue after the visibility timeout are ignored",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "acknowledges items of queue cache entry",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "update-key",
+                        "name": "update-key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.AckQueueCacheContract"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "number of items acknowledged",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.QueueCacheAckContract"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/queue/{update-key}/nack": {
+            "post": {
+                "description": "returns the delivered item to the queue to be delivered again after the delay, the item is moved to the dead letters if it was delivered maxAttempts times",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "returns item to queue cache entry",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "update-key",
+                        "name": "update-key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.NackQueueCacheContract"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "dead flag",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.QueueCacheNackContract"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key or receipt was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/queue/{update-key}/pop": {
+            "post": {
+                "description": "delivers up to count (1 by default) items with the highest priority which can be delivered now, the items stay in flight until they are acknowledged by their receipts or the visibility timeout passes",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "pops items from queue cache entry",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "update-key",
+                        "name": "update-key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.PopQueueCacheContract"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "items delivered with their receipts",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.QueueCacheItemsContract"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/queue/{update-key}/redrive": {
+            "post": {
+                "description": "moves up to count dead letters back to the queue resetting their attempts, zero count means all of them",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "redrives dead letters of queue cache entry",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "update-key",
+                        "name": "update-key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.RedriveQueueCacheContract"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "number of items redriven",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.QueueCacheRedriveContract"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/ratelimit": {
+            "get": {
+                "description": "gets all rate limiter keys, the rate limiter is removed when it is full again",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "gets all rate limiter keys",
+                "responses": {
+                    "200": {
+                        "description": "rate limiter keys",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.CacheKeysContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/ratelimit/{deleted-key}": {
+            "delete": {
+                "description": "deletes the rate limiter by key, so it is full again",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "resets rate limiter by key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "deleted-key",
+                        "name": "deleted-key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "expected entry version",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": false
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "no content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "412": {
+                        "description": "entry version does not match",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/ratelimit/{key}": {
+            "get": {
+                "description": "gets the limits, the remaining capacity and the time after which the rate limiter is full again without taking anything, with meta=true gets contracts.CacheEntryMetadataContract with timestamps, ttl, persisted flag, size and version instead",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "gets rate limiter by key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "key",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "return entry metadata instead of value",
+                        "name": "meta",
+                        "in": "query",
+                        "required": false
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "limits and remaining capacity",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.RateLimitInfoContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/ratelimit/{update-key}": {
+            "post": {
+                "description": "takes the cost (1 by default) from the rate limiter if it is allowed, the missing rate limiter is created full. Algorithm is token-bucket (default) refilled at refillRate units per second or sliding-log which allows capacity units within the window (capacity / refillRate by default). The cost which is not allowed is not taken, retryAfter is the time after which it would be allowed. The limits of the existing rate limiter are changed if they differ",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "takes cost from rate limiter",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "update-key",
+                        "name": "update-key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.TakeRateLimitContract"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "allowed flag, remaining capacity and retry time",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.RateLimitContract"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/set": {
+            "get": {
+                "description": "gets all set cache keys",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "gets all set cache keys",
+                "responses": {
+                    "200": {
+                        "description": "set cache keys",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.CacheKeysContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/set-ttl/{key}": {
+            "get": {
+                "description": "gets remaining ttl and expiration time of set cache entry by key",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "gets ttl of set cache entry by key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "key",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "remaining ttl, expiration time and sliding flag",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.CacheTTLContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/set-ttl/{update-key}": {
+            "put": {
+                "description": "sets new ttl of set cache entry by key keeping its value, sliding ttl is renewed each time the entry is read or updated",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "sets ttl of set cache entry by key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "update-key",
+                        "name": "update-key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.UpdateCacheTTLContract"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "no content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "removes ttl of set cache entry by key, so it never expires",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "removes ttl of set cache entry by key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "update-key",
+                        "name": "update-key",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "no content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/set/": {
+            "post": {
+                "description": "posts new set value, duplicate members are ignored",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "posts new set value",
+                "parameters": [
+                    {
+                        "description": "body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.NewSetCacheValuesContract"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "created",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/set/{deleted-key}": {
+            "delete": {
+                "description": "deletes set cache entry by key",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "deletes set cache entry by key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "deleted-key",
+                        "name": "deleted-key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "expected entry version",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": false
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "no content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "412": {
+                        "description": "entry version does not match",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/set/{key}": {
+            "get": {
+                "description": "gets sorted members of set cache entry by key, with meta=true gets contracts.CacheEntryMetadataContract with timestamps, ttl, persisted flag, size and version instead",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "gets set cache entry by key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "key",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "return entry metadata instead of value",
+                        "name": "meta",
+                        "in": "query",
+                        "required": false
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "key and corresponding set members",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.SetCacheValuesContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/set/{key}/cardinality": {
+            "get": {
+                "description": "gets the number of members of set value by the key",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "gets the number of set members",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "key",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "number of members",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.SetCacheCountContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/set/{key}/contains": {
+            "get": {
+                "description": "checks if set value by the key contains the member",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "checks if set contains the member",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "key",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "member",
+                        "name": "value",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "key, member and contains flag",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.SetCacheContainsContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/set/{key}/difference": {
+            "get": {
+                "description": "gets sorted members which are in the set by the key but not in any of the other sets, missing keys are treated as empty sets",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "gets difference of sets",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "key",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "other set keys, comma separated or repeated",
+                        "name": "with",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "keys and resulting members",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.SetCacheAlgebraContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/set/{key}/intersection": {
+            "get": {
+                "description": "gets sorted members which are in the set by the key and in all the other sets, missing keys are treated as empty sets",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "gets intersection of sets",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "key",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "other set keys, comma separated or repeated",
+                        "name": "with",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "keys and resulting members",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.SetCacheAlgebraContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/set/{key}/random": {
+            "get": {
+                "description": "gets up to count distinct random members of set value by the key, the members are not removed",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "gets random set members",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "key",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "number of members, 1 by default",
+                        "name": "count",
+                        "in": "query",
+                        "required": false
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "random members",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.SetCacheValuesContract"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/set/{key}/union": {
+            "get": {
+                "description": "gets sorted members which are in the set by the key or in any of the other sets, missing keys are treated as empty sets",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "gets union of sets",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "key",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "other set keys, comma separated or repeated",
+                        "name": "with",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "keys and resulting members",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.SetCacheAlgebraContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/set/{update-key}": {
+            "post": {
+                "description": "adds members to existing set value by the key, members which are already in the set are ignored",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "adds members to existing set value by the key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "update-key",
+                        "name": "update-key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.UpdateSetCacheValuesContract"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "expected entry version",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": false
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "number of members added",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.SetCacheCountContract"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "412": {
+                        "description": "entry version does not match",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/set/{update-key}/{delete-value}": {
+            "delete": {
+                "description": "removes the member from existing set value by the key",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "removes the member from existing set value by the key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "update-key",
+                        "name": "update-key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "delete-value",
+                        "name": "delete-value",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "expected entry version",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": false
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "no content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "412": {
+                        "description": "entry version does not match",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/sortedset": {
+            "get": {
+                "description": "gets all sorted set cache keys",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "gets all sorted set cache keys",
+                "responses": {
+                    "200": {
+                        "description": "sorted set cache keys",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.CacheKeysContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/sortedset-ttl/{key}": {
+            "get": {
+                "description": "gets remaining ttl and expiration time of sorted set cache entry by key",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "gets ttl of sorted set cache entry by key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "key",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "remaining ttl, expiration time and sliding flag",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.CacheTTLContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/sortedset-ttl/{update-key}": {
+            "put": {
+                "description": "sets new ttl of sorted set cache entry by key keeping its value, sliding ttl is renewed each time the entry is read or updated",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "sets ttl of sorted set cache entry by key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "update-key",
+                        "name": "update-key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.UpdateCacheTTLContract"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "no content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "removes ttl of sorted set cache entry by key, so it never expires",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "removes ttl of sorted set cache entry by key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "update-key",
+                        "name": "update-key",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "no content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/sortedset/": {
+            "post": {
+                "description": "posts new sorted set value, the last score wins for duplicate members",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "posts new sorted set value",
+                "parameters": [
+                    {
+                        "description": "body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.NewSortedSetCacheValuesContract"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "created",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/sortedset/{deleted-key}": {
+            "delete": {
+                "description": "deletes sorted set cache entry by key",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "deletes sorted set cache entry by key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "deleted-key",
+                        "name": "deleted-key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "expected entry version",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": false
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "no content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "412": {
+                        "description": "entry version does not match",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/sortedset/{key}": {
+            "get": {
+                "description": "gets members of sorted set cache entry by key in ascending order of scores, with meta=true gets contracts.CacheEntryMetadataContract with timestamps, ttl, persisted flag, size and version instead",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "gets sorted set cache entry by key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "key",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "return entry metadata instead of value",
+                        "name": "meta",
+                        "in": "query",
+                        "required": false
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "key and corresponding members with scores",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.SortedSetCacheValuesContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/sortedset/{key}/range": {
+            "get": {
+                "description": "gets members of sorted set value by the range of zero-based ranks like ZRANGE, negative ranks are counted from the end",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "gets sorted set members by rank range",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "key",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "first rank, 0 by default",
+                        "name": "start",
+                        "in": "query",
+                        "required": false
+                    },
+                    {
+                        "type": "integer",
+                        "description": "last rank inclusive, -1 by default",
+                        "name": "stop",
+                        "in": "query",
+                        "required": false
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "count ranks from the highest score",
+                        "name": "reverse",
+                        "in": "query",
+                        "required": false
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "members with scores",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.SortedSetCacheValuesContract"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/sortedset/{key}/rangebyscore": {
+            "get": {
+                "description": "gets members of sorted set value by the range of scores like ZRANGEBYSCORE, \"(\" prefix makes the bound exclusive",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "gets sorted set members by score range",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "key",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "minimal score, -inf by default",
+                        "name": "min",
+                        "in": "query",
+                        "required": false
+                    },
+                    {
+                        "type": "string",
+                        "description": "maximal score, +inf by default",
+                        "name": "max",
+                        "in": "query",
+                        "required": false
+                    },
+                    {
+                        "type": "integer",
+                        "description": "number of members to skip",
+                        "name": "offset",
+                        "in": "query",
+                        "required": false
+                    },
+                    {
+                        "type": "integer",
+                        "description": "maximal number of members, all by default",
+                        "name": "count",
+                        "in": "query",
+                        "required": false
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "return members in descending order",
+                        "name": "reverse",
+                        "in": "query",
+                        "required": false
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "members with scores",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.SortedSetCacheValuesContract"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/sortedset/{key}/rank": {
+            "get": {
+                "description": "gets zero-based rank and score of the sorted set member like ZRANK and ZREVRANK",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "gets rank of sorted set member",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "key",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "member",
+                        "name": "member",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "count rank from the highest score",
+                        "name": "reverse",
+                        "in": "query",
+                        "required": false
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "rank and score of the member",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.SortedSetCacheRankContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/sortedset/{update-key}": {
+            "post": {
+                "description": "adds members to existing sorted set value by the key or updates scores of existing members like ZADD",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "adds members to existing sorted set value by the key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "update-key",
+                        "name": "update-key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.UpdateSortedSetCacheValuesContract"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "expected entry version",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": false
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "number of members added",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.SortedSetCacheCountContract"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "412": {
+                        "description": "entry version does not match",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/sortedset/{update-key}/incr": {
+            "post": {
+                "description": "adds delta to the score of the member like ZINCRBY, missing member is added with delta as its score",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "increments score of sorted set member",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "update-key",
+                        "name": "update-key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.IncrementSortedSetCacheScoreContract"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "expected entry version",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": false
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "new score of the member",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.SortedSetCacheScoreContract"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "412": {
+                        "description": "entry version does not match",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/sortedset/{update-key}/{delete-member}": {
+            "delete": {
+                "description": "removes the member from existing sorted set value by the key like ZREM",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "removes the member from existing sorted set value by the key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "update-key",
+                        "name": "update-key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "delete-member",
+                        "name": "delete-member",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "expected entry version",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": false
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "no content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "412": {
+                        "description": "entry version does not match",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/stats": {
+            "get": {
+                "description": "gets number of keys, their size, number of evicted and reaped expired keys for each cache type",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "gets cache usage counters",
+                "responses": {
+                    "200": {
+                        "description": "cache usage counters",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.CacheStatsContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/stream": {
+            "get": {
+                "description": "gets all stream cache keys",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "gets all stream cache keys",
+                "responses": {
+                    "200": {
+                        "description": "stream cache keys",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.CacheKeysContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/stream-ttl/{key}": {
+            "get": {
+                "description": "gets remaining ttl and expiration time of stream cache entry by key",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "gets ttl of stream cache entry by key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "key",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "remaining ttl, expiration time and sliding flag",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.CacheTTLContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/stream-ttl/{update-key}": {
+            "put": {
+                "description": "sets new ttl of stream cache entry by key keeping its value, sliding ttl is renewed each time the entry is read or updated",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "sets ttl of stream cache entry by key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "update-key",
+                        "name": "update-key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.UpdateCacheTTLContract"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "no content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "removes ttl of stream cache entry by key, so it never expires",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "removes ttl of stream cache entry by key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "update-key",
+                        "name": "update-key",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "no content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/stream/": {
+            "post": {
+                "description": "posts new empty stream, positive maxLen caps the number of entries and the oldest entries are removed when new ones are appended",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "posts new stream",
+                "parameters": [
+                    {
+                        "description": "body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.NewStreamCacheContract"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "created",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/stream/{deleted-key}": {
+            "delete": {
+                "description": "deletes stream cache entry by key",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "deletes stream cache entry by key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "deleted-key",
+                        "name": "deleted-key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "expected entry version",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": false
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "no content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "412": {
+                        "description": "entry version does not match",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/stream/{key}": {
+            "get": {
+                "description": "gets the length, the capped length, the first and the last IDs and the number of consumer groups of stream by key like XINFO STREAM, with meta=true gets contracts.CacheEntryMetadataContract with timestamps, ttl, persisted flag, size and version instead",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "gets stream info by key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "key",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "return entry metadata instead of value",
+                        "name": "meta",
+                        "in": "query",
+                        "required": false
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "stream info",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.StreamCacheInfoContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/stream/{key}/groups": {
+            "get": {
+                "description": "gets the consumer groups of stream with the last delivered id and the number of pending entries and consumers like XINFO GROUPS",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "gets consumer groups of stream",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "key",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "consumer groups",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.StreamCacheGroupsContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/stream/{key}/groups/{group}/pending": {
+            "get": {
+                "description": "gets up to count entries delivered to the consumers of the group and not acknowledged yet with their idle time and number of deliveries like XPENDING",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "gets pending entries of consumer group",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "key",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "consumer group",
+                        "name": "group",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "consumer, all consumers by default",
+                        "name": "consumer",
+                        "in": "query",
+                        "required": false
+                    },
+                    {
+                        "type": "integer",
+                        "description": "maximum number of entries, 0 means all",
+                        "name": "count",
+                        "in": "query",
+                        "required": false
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "pending entries",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.StreamCachePendingContract"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key or group was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/stream/{key}/range": {
+            "get": {
+                "description": "gets up to count entries with ids from start to end inclusive like XRANGE, \"-\" and \"+\" are the smallest and the greatest ids, reverse=true starts from the end like XREVRANGE",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "gets stream entries by range of ids",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "key",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "start id, - by default",
+                        "name": "start",
+                        "in": "query",
+                        "required": false
+                    },
+                    {
+                        "type": "string",
+                        "description": "end id, + by default",
+                        "name": "end",
+                        "in": "query",
+                        "required": false
+                    },
+                    {
+                        "type": "integer",
+                        "description": "maximum number of entries, 0 means all",
+                        "name": "count",
+                        "in": "query",
+                        "required": false
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "start from the end",
+                        "name": "reverse",
+                        "in": "query",
+                        "required": false
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "stream entries",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.StreamCacheEntriesContract"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/stream/{update-key}": {
+            "post": {
+                "description": "appends the entry to stream like XADD, empty or \"*\" id is generated from the current time, \"ms-*\" id generates the sequence number, explicit id must be greater than the last one, maxLen trims the stream after the entry is appended, \"create\" option creates the missing key",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "appends entry to stream",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "update-key",
+                        "name": "update-key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.AppendStreamCacheEntryContract"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "expected entry version",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": false
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "id of the entry and new length of the stream",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.StreamCacheAppendContract"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "412": {
+                        "description": "entry version does not match",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/stream/{update-key}/groups": {
+            "post": {
+                "description": "adds the consumer group to stream like XGROUP CREATE, the group delivers the entries after start id, \"$\" or empty start means the last id of the stream, \"create\" option creates the missing key",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "creates consumer group",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "update-key",
+                        "name": "update-key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.NewStreamGroupContract"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "created",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/stream/{update-key}/groups/{group}": {
+            "delete": {
+                "description": "removes the consumer group of stream with its pending entries like XGROUP DESTROY",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "deletes consumer group",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "update-key",
+                        "name": "update-key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "consumer group",
+                        "name": "group",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "no content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "key or group was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/stream/{update-key}/groups/{group}/ack": {
+            "post": {
+                "description": "acknowledges the pending entries of the consumer group like XACK, unknown ids are skipped",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "acknowledges stream entries",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "update-key",
+                        "name": "update-key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "consumer group",
+                        "name": "group",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.AckStreamGroupContract"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "number of entries acknowledged",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.StreamCacheAckContract"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key or group was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/stream/{update-key}/groups/{group}/read": {
+            "post": {
+                "description": "delivers up to count entries to the consumer of the group like XREADGROUP, empty or \">\" id reads the entries never delivered to the group and adds them to the pending entries of the consumer, other ids read the entries pending for the consumer after the id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "reads stream entries by consumer group",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "update-key",
+                        "name": "update-key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "consumer group",
+                        "name": "group",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ReadStreamGroupContract"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "entries delivered to the consumer",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.StreamCacheEntriesContract"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key or group was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/stream/{update-key}/trim": {
+            "post": {
+                "description": "removes the oldest entries of stream so that at most maxLen entries are left like XTRIM, pending entries of the consumer groups are kept",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "trims stream",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "update-key",
+                        "name": "update-key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.TrimStreamCacheContract"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "expected entry version",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": false
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "number of entries removed and new length of the stream",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.StreamCacheTrimContract"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "412": {
+                        "description": "entry version does not match",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/string": {
+            "get": {
+                "description": "gets all string cache keys",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "gets all string cache keys",
+                "responses": {
+                    "200": {
+                        "description": "string cache keys",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.CacheKeysContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/string-ttl/{key}": {
+            "get": {
+                "description": "gets remaining ttl and expiration time of string cache entry by key",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "gets ttl of string cache entry by key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "key",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "remaining ttl, expiration time and sliding flag",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.CacheTTLContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/string-ttl/{update-key}": {
+            "put": {
+                "description": "sets new ttl of string cache entry by key keeping its value, sliding ttl is renewed each time the entry is read or updated",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "sets ttl of string cache entry by key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "update-key",
+                        "name": "update-key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.UpdateCacheTTLContract"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "no content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "removes ttl of string cache entry by key, so it never expires",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "removes ttl of string cache entry by key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "update-key",
+                        "name": "update-key",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "no content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/string/": {
+            "post": {
+                "description": "posts new string value, body of any other content type than JSON or form is stored as is with its content type",
+                "consumes": [
+                    "application/json",
+                    "application/octet-stream"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "posts new string value",
+                "parameters": [
+                    {
+                        "description": "body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.NewStringCacheValueContract"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "key of the raw value",
+                        "name": "key",
+                        "in": "query",
+                        "required": false
+                    },
+                    {
+                        "type": "string",
+                        "description": "ttl of the raw value",
+                        "name": "ttl",
+                        "in": "query",
+                        "required": false
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "sliding ttl of the raw value",
+                        "name": "sliding",
+                        "in": "query",
+                        "required": false
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "created",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/string/{deleted-key}": {
+            "delete": {
+                "description": "deletes string cache entry by key",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "deletes string cache entry by key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "deleted-key",
+                        "name": "deleted-key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "expected entry version",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": false
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "no content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "412": {
+                        "description": "entry version does not match",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/string/{key}": {
+            "get": {
+                "description": "gets string cache entry by key, with meta=true gets contracts.CacheEntryMetadataContract with timestamps, ttl, persisted flag, size and version instead, values posted as raw body are returned as is with their content type",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/octet-stream"
+                ],
+                "summary": "gets string cache entry by key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "key",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "return entry metadata instead of value",
+                        "name": "meta",
+                        "in": "query",
+                        "required": false
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "key and corresponding value",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.StringCacheValueContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/string/{key}/bit": {
+            "get": {
+                "description": "gets the bit of string value by offset like GETBIT, bit 0 is the most significant bit of the first byte",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "gets the bit of string value",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "key",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "int",
+                        "description": "bit offset, 0 by default",
+                        "name": "offset",
+                        "in": "query",
+                        "required": false
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "bit",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.StringCacheBitContract"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "sets the bit of string value like SETBIT padding the value with zero bytes, \"create\" option creates the missing key",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "sets the bit of string value",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "key",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.SetBitStringCacheContract"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "expected entry version",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": false
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "previous bit",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.StringCacheBitContract"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "412": {
+                        "description": "entry version does not match",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/string/{key}/bitcount": {
+            "get": {
+                "description": "counts the bits set in string value like BITCOUNT, negative indexes are counted from the end",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "counts the bits set in string value",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "key",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "int",
+                        "description": "start index, 0 by default",
+                        "name": "start",
+                        "in": "query",
+                        "required": false
+                    },
+                    {
+                        "type": "int",
+                        "description": "end index inclusive, -1 by default",
+                        "name": "end",
+                        "in": "query",
+                        "required": false
+                    },
+                    {
+                        "type": "string",
+                        "description": "byte or bit, byte by default",
+                        "name": "unit",
+                        "in": "query",
+                        "required": false
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "number of bits set",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.StringCacheBitCountContract"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/string/{key}/bitfield": {
+            "post": {
+                "description": "gets, sets and increments integers of arbitrary width in string value like BITFIELD, null result means fail overflow",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "applies bitfield operations to string value",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "key",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.BitFieldStringCacheContract"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "expected entry version",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": false
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "results of the operations",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.StringCacheBitFieldContract"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "412": {
+                        "description": "entry version does not match",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/string/{key}/bitop": {
+            "post": {
+                "description": "stores the result of and, or, xor or not operation on the values of the keys like BITOP, missing keys are empty values and the empty result deletes the key",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "stores the result of bitwise operation on string values",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "key",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.BitOpStringCacheContract"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "expected entry version",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": false
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "length of the value stored",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.StringCacheBitOpContract"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "412": {
+                        "description": "entry version does not match",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/string/{key}/bitpos": {
+            "get": {
+                "description": "finds the first bit set to 0 or 1 in string value like BITPOS, -1 means that the bit was not found",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "finds the first bit set to 0 or 1 in string value",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "key",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "int",
+                        "description": "0 or 1",
+                        "name": "bit",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "int",
+                        "description": "start index, 0 by default",
+                        "name": "start",
+                        "in": "query",
+                        "required": false
+                    },
+                    {
+                        "type": "int",
+                        "description": "end index inclusive, the end of the value by default",
+                        "name": "end",
+                        "in": "query",
+                        "required": false
+                    },
+                    {
+                        "type": "string",
+                        "description": "byte or bit, byte by default",
+                        "name": "unit",
+                        "in": "query",
+                        "required": false
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "position of the bit",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.StringCacheBitPosContract"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/string/{key}/decr": {
+            "post": {
+                "description": "atomically decrements numeric string value by the key, delta is 1 by default",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "atomically decrements numeric string value by the key, delta is 1 by default",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "key",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body",
+                        "name": "body",
+                        "in": "body",
+                        "required": false,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.IncrementCacheValueContract"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "expected entry version",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": false
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "key and new value",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.StringCacheValueContract"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "412": {
+                        "description": "entry version does not match",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/string/{key}/incr": {
+            "post": {
+                "description": "atomically increments numeric string value by the key, delta is 1 by default",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "atomically increments numeric string value by the key, delta is 1 by default",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "key",
+                        "name": "key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body",
+                        "name": "body",
+                        "in": "body",
+                        "required": false,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.IncrementCacheValueContract"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "expected entry version",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": false
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "key and new value",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.StringCacheValueContract"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "404": {
+                        "description": "key was not found",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "412": {
+                        "description": "entry version does not match",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/string/{update-key}": {
+            "put": {
+                "description": "body of any other content type than JSON or form is stored as is with its content type, If-Match header is required then",
+                "consumes": [
+                    "application/json",
+                    "application/octet-stream"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "updates existing string value by the key and new value specified",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "update-key",
+                        "name": "update-key",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.UpdateStringCacheValueContract"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "expected entry version",
+                        "name": "If-Match",
+                        "in": "header",
+                        "required": false
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "no content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "bad request",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "412": {
+                        "description": "entry version does not match",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    },
+                    "500": {
+                        "description": "server error",
+                        "schema": {
+                            "type": "object",
+                            "$ref": "#/definitions/contracts.ErrorContract"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "contracts.AckQueueCacheContract": {
+            "type": "object",
+            "properties": {
+                "Receipts": {
+                    "type": "array"
+                }
+            }
+        },
+        "contracts.AckStreamGroupContract": {
+            "type": "object",
+            "properties": {
+                "IDs": {
+                    "type": "array"
+                }
+            }
+        },
+        "contracts.AcquireLockContract": {
+            "type": "object",
+            "properties": {
+                "Lease": {
+                    "type": "string"
+                },
+                "Owner": {
+                    "type": "string"
+                }
+            }
+        },
+        "contracts.AddBloomFilterCacheValuesContract": {
+            "type": "object",
+            "properties": {
+                "Capacity": {
+                    "type": "integer"
+                },
+                "Create": {
+                    "type": "boolean"
+                },
+                "ErrorRate": {
+                    "type": "number"
+                },
+                "Values": {
+                    "type": "array"
+                },
+                "Version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "contracts.AddDictionaryCacheValueContract": {
+            "type": "object",
+            "properties": {
+                "TTL": {
+                    "type": "string"
+                },
+                "Value": {
                     "type": "DictionaryKeyValueContract"
+                },
+                "Version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "contracts.AddGeoCacheMembersContract": {
+            "type": "object",
+            "properties": {
+                "Create": {
+                    "type": "boolean"
+                },
+                "Members": {
+                    "type": "array"
+                },
+                "Version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "contracts.AddHyperLogLogCacheValuesContract": {
+            "type": "object",
+            "properties": {
+                "Create": {
+                    "type": "boolean"
+                },
+                "Values": {
+                    "type": "array"
+                },
+                "Version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "contracts.AppendDocumentCacheValuesContract": {
+            "type": "object",
+            "properties": {
+                "Path": {
+                    "type": "string"
+                },
+                "Values": {
+                    "type": "array"
+                },
+                "Version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "contracts.AppendStreamCacheEntryContract": {
+            "type": "object",
+            "properties": {
+                "Create": {
+                    "type": "boolean"
+                },
+                "Fields": {
+                    "type": "array"
+                },
+                "ID": {
+                    "type": "string"
+                },
+                "MaxLen": {
+                    "type": "integer"
+                },
+                "Version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "contracts.BitFieldStringCacheContract": {
+            "type": "object",
+            "properties": {
+                "Create": {
+                    "type": "boolean"
+                },
+                "Operations": {
+                    "type": "array"
+                },
+                "Sliding": {
+                    "type": "boolean"
+                },
+                "TTL": {
+                    "type": "string"
+                },
+                "Version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "contracts.BitOpStringCacheContract": {
+            "type": "object",
+            "properties": {
+                "Keys": {
+                    "type": "array"
+                },
+                "Op": {
+                    "type": "string"
+                },
+                "Version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "contracts.BloomFilterCacheInfoContract": {
+            "type": "object",
+            "properties": {
+                "Bits": {
+                    "type": "integer"
+                },
+                "Capacity": {
+                    "type": "integer"
+                },
+                "Count": {
+                    "type": "integer"
+                },
+                "ErrorRate": {
+                    "type": "number"
+                },
+                "Hashes": {
+                    "type": "integer"
+                },
+                "Key": {
+                    "type": "string"
+                },
+                "Version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "contracts.BloomFilterCacheResultsContract": {
+            "type": "object",
+            "properties": {
+                "Key": {
+                    "type": "string"
+                },
+                "Results": {
+                    "type": "array"
+                },
+                "Values": {
+                    "type": "array"
+                },
+                "Version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "contracts.CacheKeysContract": {
+            "type": "object",
+            "properties": {
+                "Keys": {
+                    "type": "array"
+                }
+            }
+        },
+        "contracts.CacheStatsContract": {
+            "type": "object",
+            "properties": {
+                "BloomFilter": {
+                    "type": "CacheTypeStatsContract"
+                },
+                "Dictionary": {
+                    "type": "CacheTypeStatsContract"
+                },
+                "Document": {
+                    "type": "CacheTypeStatsContract"
+                },
+                "Geo": {
+                    "type": "CacheTypeStatsContract"
+                },
+                "HyperLogLog": {
+                    "type": "CacheTypeStatsContract"
+                },
+                "List": {
+                    "type": "CacheTypeStatsContract"
+                },
+                "Lock": {
+                    "type": "CacheTypeStatsContract"
+                },
+                "Queue": {
+                    "type": "CacheTypeStatsContract"
+                },
+                "RateLimit": {
+                    "type": "CacheTypeStatsContract"
+                },
+                "Set": {
+                    "type": "CacheTypeStatsContract"
+                },
+                "SortedSet": {
+                    "type": "CacheTypeStatsContract"
+                },
+                "Stream": {
+                    "type": "CacheTypeStatsContract"
+                },
+                "String": {
+                    "type": "CacheTypeStatsContract"
+                }
+            }
+        },
+        "contracts.CacheTTLContract": {
+            "type": "object",
+            "properties": {
+                "Expires": {
+                    "type": "string"
+                },
+                "Key": {
+                    "type": "string"
+                },
+                "Sliding": {
+                    "type": "boolean"
+                },
+                "TTL": {
+                    "type": "string"
+                }
+            }
+        },
+        "contracts.DictionaryCacheExistsContract": {
+            "type": "object",
+            "properties": {
+                "Exists": {
+                    "type": "map[string]bool"
+                },
+                "Key": {
+                    "type": "string"
+                }
+            }
+        },
+        "contracts.DictionaryCacheSetResultContract": {
+            "type": "object",
+            "properties": {
+                "Added": {
+                    "type": "integer"
+                },
+                "Key": {
+                    "type": "string"
+                },
+                "Updated": {
+                    "type": "integer"
+                },
+                "Version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "contracts.DictionaryCacheSubKeyValueContract": {
+            "type": "object",
+            "properties": {
+                "Key": {
+                    "type": "string"
+                },
+                "SubKey": {
+                    "type": "string"
+                },
+                "Value": {
+                    "type": "string"
+                },
+                "Version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "contracts.DictionaryCacheSubKeysContract": {
+            "type": "object",
+            "properties": {
+                "Key": {
+                    "type": "string"
+                },
+                "SubKeys": {
+                    "type": "array"
+                },
+                "Version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "contracts.DictionaryCacheValueContract": {
+            "type": "object",
+            "properties": {
+                "Key": {
+                    "type": "string"
+                },
+                "Values": {
+                    "type": "array"
+                },
+                "Version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "contracts.DocumentCacheLengthContract": {
+            "type": "object",
+            "properties": {
+                "Key": {
+                    "type": "string"
+                },
+                "Length": {
+                    "type": "integer"
+                },
+                "Path": {
+                    "type": "string"
+                },
+                "Version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "contracts.DocumentCacheValueContract": {
+            "type": "object",
+            "properties": {
+                "Key": {
+                    "type": "string"
+                },
+                "Path": {
+                    "type": "string"
+                },
+                "Value": {
+                    "type": "object"
+                },
+                "Version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "contracts.DocumentPatchOperationContract": {
+            "type": "object",
+            "properties": {
+                "From": {
+                    "type": "string"
+                },
+                "Op": {
+                    "type": "string"
+                },
+                "Path": {
+                    "type": "string"
+                },
+                "Value": {
+                    "type": "object"
+                }
+            }
+        },
+        "contracts.ErrorContract": {
+            "type": "object",
+            "properties": {
+                "Status": {
+                    "type": "string"
+                }
+            }
+        },
+        "contracts.GeoCacheAddContract": {
+            "type": "object",
+            "properties": {
+                "Added": {
+                    "type": "integer"
+                },
+                "Key": {
+                    "type": "string"
+                },
+                "Updated": {
+                    "type": "integer"
+                },
+                "Version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "contracts.GeoCacheDistanceContract": {
+            "type": "object",
+            "properties": {
+                "Distance": {
+                    "type": "number"
+                },
+                "From": {
+                    "type": "string"
+                },
+                "Key": {
+                    "type": "string"
+                },
+                "To": {
+                    "type": "string"
+                },
+                "Unit": {
+                    "type": "string"
+                }
+            }
+        },
+        "contracts.GeoCacheMembersContract": {
+            "type": "object",
+            "properties": {
+                "Key": {
+                    "type": "string"
+                },
+                "Members": {
+                    "type": "array"
+                },
+                "Version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "contracts.GeoCachePositionsContract": {
+            "type": "object",
+            "properties": {
+                "Key": {
+                    "type": "string"
+                },
+                "Missing": {
+                    "type": "array"
+                },
+                "Positions": {
+                    "type": "array"
+                }
+            }
+        },
+        "contracts.GeoCacheSearchContract": {
+            "type": "object",
+            "properties": {
+                "Key": {
+                    "type": "string"
+                },
+                "Results": {
+                    "type": "array"
+                },
+                "Unit": {
+                    "type": "string"
+                },
+                "Version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "contracts.HyperLogLogCacheAddContract": {
+            "type": "object",
+            "properties": {
+                "Changed": {
+                    "type": "boolean"
+                },
+                "Count": {
+                    "type": "integer"
+                },
+                "Key": {
+                    "type": "string"
+                },
+                "Version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "contracts.HyperLogLogCacheCountContract": {
+            "type": "object",
+            "properties": {
+                "Count": {
+                    "type": "integer"
+                },
+                "Key": {
+                    "type": "string"
+                },
+                "Keys": {
+                    "type": "array"
+                },
+                "Version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "contracts.IncrementCacheValueContract": {
+            "type": "object",
+            "properties": {
+                "Create": {
+                    "type": "boolean"
+                },
+                "Delta": {
+                    "type": "json.Number"
+                },
+                "Float": {
+                    "type": "boolean"
+                },
+                "Sliding": {
+                    "type": "boolean"
+                },
+                "TTL": {
+                    "type": "string"
+                },
+                "Version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "contracts.IncrementSortedSetCacheScoreContract": {
+            "type": "object",
+            "properties": {
+                "Delta": {
+                    "type": "number"
+                },
+                "Member": {
+                    "type": "string"
+                },
+                "Version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "contracts.ListCacheIndexValueContract": {
+            "type": "object",
+            "properties": {
+                "Index": {
+                    "type": "integer"
+                },
+                "Key": {
+                    "type": "string"
+                },
+                "Value": {
+                    "type": "string"
+                },
+                "Version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "contracts.ListCacheLengthContract": {
+            "type": "object",
+            "properties": {
+                "Key": {
+                    "type": "string"
+                },
+                "Length": {
+                    "type": "integer"
+                },
+                "Version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "contracts.ListCacheRangeContract": {
+            "type": "object",
+            "properties": {
+                "Key": {
+                    "type": "string"
+                },
+                "Length": {
+                    "type": "integer"
+                },
+                "Start": {
+                    "type": "integer"
+                },
+                "Values": {
+                    "type": "array"
+                },
+                "Version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "contracts.ListCacheTrimContract": {
+            "type": "object",
+            "properties": {
+                "Key": {
+                    "type": "string"
+                },
+                "Length": {
+                    "type": "integer"
+                },
+                "Removed": {
+                    "type": "integer"
+                },
+                "Version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "contracts.ListCacheValueContract": {
+            "type": "object",
+            "properties": {
+                "Key": {
+                    "type": "string"
+                },
+                "Values": {
+                    "type": "array"
+                },
+                "Version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "contracts.LockContract": {
+            "type": "object",
+            "properties": {
+                "Acquired": {
+                    "type": "boolean"
+                },
+                "Fence": {
+                    "type": "integer"
+                },
+                "Key": {
+                    "type": "string"
+                },
+                "Lease": {
+                    "type": "string"
+                },
+                "Owner": {
+                    "type": "string"
+                },
+                "Version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "contracts.LockInfoContract": {
+            "type": "object",
+            "properties": {
+                "Acquired": {
+                    "type": "string"
+                },
+                "Fence": {
+                    "type": "integer"
+                },
+                "Key": {
+                    "type": "string"
+                },
+                "Lease": {
+                    "type": "string"
+                },
+                "Version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "contracts.MergeHyperLogLogCacheContract": {
+            "type": "object",
+            "properties": {
+                "Keys": {
+                    "type": "array"
+                },
+                "Version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "contracts.NackQueueCacheContract": {
+            "type": "object",
+            "properties": {
+                "Delay": {
+                    "type": "string"
+                },
+                "Receipt": {
+                    "type": "string"
+                }
+            }
+        },
+        "contracts.NewBloomFilterCacheContract": {
+            "type": "object",
+            "properties": {
+                "Capacity": {
+                    "type": "integer"
+                },
+                "ErrorRate": {
+                    "type": "number"
+                },
+                "Key": {
+                    "type": "string"
+                },
+                "Sliding": {
+                    "type": "boolean"
+                },
+                "TTL": {
+                    "type": "string"
+                }
+            }
+        },
+        "contracts.NewDictionaryCacheValuesContract": {
+            "type": "object",
+            "properties": {
+                "Key": {
+                    "type": "string"
+                },
+                "Sliding": {
+                    "type": "boolean"
+                },
+                "TTL": {
+                    "type": "string"
+                },
+                "Values": {
+                    "type": "array"
+                }
+            }
+        },
+        "contracts.NewDocumentCacheValueContract": {
+            "type": "object",
+            "properties": {
+                "Key": {
+                    "type": "string"
+                },
+                "Sliding": {
+                    "type": "boolean"
+                },
+                "TTL": {
+                    "type": "string"
+                },
+                "Value": {
+                    "type": "object"
+                }
+            }
+        },
+        "contracts.NewGeoCacheContract": {
+            "type": "object",
+            "properties": {
+                "Key": {
+                    "type": "string"
+                },
+                "Members": {
+                    "type": "array"
+                },
+                "Sliding": {
+                    "type": "boolean"
+                },
+                "TTL": {
+                    "type": "string"
+                }
+            }
+        },
+        "contracts.NewHyperLogLogCacheValuesContract": {
+            "type": "object",
+            "properties": {
+                "Key": {
+                    "type": "string"
+                },
+                "Sliding": {
+                    "type": "boolean"
+                },
+                "TTL": {
+                    "type": "string"
+                },
+                "Values": {
+                    "type": "array"
+                }
+            }
+        },
+        "contracts.NewListCacheValuesContract": {
+            "type": "object",
+            "properties": {
+                "Key": {
+                    "type": "string"
+                },
+                "Sliding": {
+                    "type": "boolean"
+                },
+                "TTL": {
+                    "type": "string"
+                },
+                "Values": {
+                    "type": "array"
+                }
+            }
+        },
+        "contracts.NewQueueCacheContract": {
+            "type": "object",
+            "properties": {
+                "Items": {
+                    "type": "array"
+                },
+                "Key": {
+                    "type": "string"
+                },
+                "MaxAttempts": {
+                    "type": "integer"
+                },
+                "Sliding": {
+                    "type": "boolean"
+                },
+                "TTL": {
+                    "type": "string"
+                },
+                "Visibility": {
+                    "type": "string"
+                }
+            }
+        },
+        "contracts.NewSetCacheValuesContract": {
+            "type": "object",
+            "properties": {
+                "Key": {
+                    "type": "string"
+                },
+                "Sliding": {
+                    "type": "boolean"
+                },
+                "TTL": {
+                    "type": "string"
+                },
+                "Values": {
+                    "type": "array"
+                }
+            }
+        },
+        "contracts.NewSortedSetCacheValuesContract": {
+            "type": "object",
+            "properties": {
+                "Key": {
+                    "type": "string"
+                },
+                "Members": {
+                    "type": "array"
+                },
+                "Sliding": {
+                    "type": "boolean"
+                },
+                "TTL": {
+                    "type": "string"
+                }
+            }
+        },
+        "contracts.NewStreamCacheContract": {
+            "type": "object",
+            "properties": {
+                "Key": {
+                    "type": "string"
+                },
+                "MaxLen": {
+                    "type": "integer"
+                },
+                "Sliding": {
+                    "type": "boolean"
+                },
+                "TTL": {
+                    "type": "string"
+                }
+            }
+        },
+        "contracts.NewStreamGroupContract": {
+            "type": "object",
+            "properties": {
+                "Create": {
+                    "type": "boolean"
+                },
+                "Group": {
+                    "type": "string"
+                },
+                "Start": {
+                    "type": "string"
+                }
+            }
+        },
+        "contracts.NewStringCacheValueContract": {
+            "type": "object",
+            "properties": {
+                "Key": {
+                    "type": "string"
+                },
+                "Sliding": {
+                    "type": "boolean"
+                },
+                "TTL": {
+                    "type": "string"
+                },
+                "Value": {
+                    "type": "string"
+                }
+            }
+        },
+        "contracts.PopListCacheValuesContract": {
+            "type": "object",
+            "properties": {
+                "Count": {
+                    "type": "integer"
+                },
+                "Timeout": {
+                    "type": "string"
+                },
+                "Version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "contracts.PopQueueCacheContract": {
+            "type": "object",
+            "properties": {
+                "Count": {
+                    "type": "integer"
+                },
+                "Visibility": {
+                    "type": "string"
+                }
+            }
+        },
+        "contracts.PushListCacheValuesContract": {
+            "type": "object",
+            "properties": {
+                "Create": {
+                    "type": "boolean"
+                },
+                "Values": {
+                    "type": "array"
+                },
+                "Version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "contracts.PushQueueCacheContract": {
+            "type": "object",
+            "properties": {
+                "Create": {
+                    "type": "boolean"
+                },
+                "Items": {
+                    "type": "array"
+                },
+                "Version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "contracts.QueueCacheAckContract": {
+            "type": "object",
+            "properties": {
+                "Acked": {
+                    "type": "integer"
+                },
+                "Key": {
+                    "type": "string"
+                },
+                "Version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "contracts.QueueCacheInfoContract": {
+            "type": "object",
+            "properties": {
+                "Dead": {
+                    "type": "integer"
+                },
+                "Delayed": {
+                    "type": "integer"
+                },
+                "InFlight": {
+                    "type": "integer"
+                },
+                "Key": {
+                    "type": "string"
+                },
+                "MaxAttempts": {
+                    "type": "integer"
+                },
+                "Ready": {
+                    "type": "integer"
+                },
+                "Version": {
+                    "type": "integer"
+                },
+                "Visibility": {
+                    "type": "string"
+                }
+            }
+        },
+        "contracts.QueueCacheItemsContract": {
+            "type": "object",
+            "properties": {
+                "Items": {
+                    "type": "array"
+                },
+                "Key": {
+                    "type": "string"
+                },
+                "Version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "contracts.QueueCacheNackContract": {
+            "type": "object",
+            "properties": {
+                "Dead": {
+                    "type": "boolean"
+                },
+                "Key": {
+                    "type": "string"
+                },
+                "Version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "contracts.QueueCachePushContract": {
+            "type": "object",
+            "properties": {
+                "IDs": {
+                    "type": "array"
+                },
+                "Key": {
+                    "type": "string"
+                },
+                "Version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "contracts.QueueCacheRedriveContract": {
+            "type": "object",
+            "properties": {
+                "Key": {
+                    "type": "string"
+                },
+                "Redriven": {
+                    "type": "integer"
+                },
+                "Version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "contracts.RateLimitContract": {
+            "type": "object",
+            "properties": {
+                "Allowed": {
+                    "type": "boolean"
+                },
+                "Key": {
+                    "type": "string"
+                },
+                "Limit": {
+                    "type": "integer"
+                },
+                "Remaining": {
+                    "type": "integer"
+                },
+                "ResetAfter": {
+                    "type": "string"
+                },
+                "RetryAfter": {
+                    "type": "string"
+                },
+                "Version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "contracts.RateLimitInfoContract": {
+            "type": "object",
+            "properties": {
+                "Algorithm": {
+                    "type": "string"
+                },
+                "Capacity": {
+                    "type": "integer"
+                },
+                "Key": {
+                    "type": "string"
+                },
+                "RefillRate": {
+                    "type": "number"
+                },
+                "Remaining": {
+                    "type": "integer"
+                },
+                "ResetAfter": {
+                    "type": "string"
+                },
+                "Version": {
+                    "type": "integer"
+                },
+                "Window": {
+                    "type": "string"
+                }
+            }
+        },
+        "contracts.ReadStreamGroupContract": {
+            "type": "object",
+            "properties": {
+                "Consumer": {
+                    "type": "string"
+                },
+                "Count": {
+                    "type": "integer"
+                },
+                "ID": {
+                    "type": "string"
+                }
+            }
+        },
+        "contracts.RedriveQueueCacheContract": {
+            "type": "object",
+            "properties": {
+                "Count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "contracts.RemoveListCacheIndexContract": {
+            "type": "object",
+            "properties": {
+                "Index": {
+                    "type": "integer"
+                },
+                "Version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "contracts.RenewLockContract": {
+            "type": "object",
+            "properties": {
+                "Lease": {
+                    "type": "string"
+                },
+                "Owner": {
+                    "type": "string"
                 }
             }
         },
-        "contracts.CacheKeysContract": {
+        "contracts.SetBitStringCacheContract": {
+            "type": "object",
+            "properties": {
+                "Bit": {
+                    "type": "integer"
+                },
+                "Create": {
+                    "type": "boolean"
+                },
+                "Offset": {
+                    "type": "integer"
+                },
+                "Sliding": {
+                    "type": "boolean"
+                },
+                "TTL": {
+                    "type": "string"
+                },
+                "Version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "contracts.SetCacheAlgebraContract": {
             "type": "object",
             "properties": {
                 "Keys": {
                     "type": "array"
+                },
+                "Values": {
+                    "type": "array"
                 }
             }
         },
-        "contracts.DictionaryCacheValueContract": {
+        "contracts.SetCacheContainsContract": {
+            "type": "object",
+            "properties": {
+                "Contains": {
+                    "type": "boolean"
+                },
+                "Key": {
+                    "type": "string"
+                },
+                "Value": {
+                    "type": "string"
+                }
+            }
+        },
+        "contracts.SetCacheCountContract": {
+            "type": "object",
+            "properties": {
+                "Count": {
+                    "type": "integer"
+                },
+                "Key": {
+                    "type": "string"
+                }
+            }
+        },
+        "contracts.SetCacheValuesContract": {
             "type": "object",
             "properties": {
                 "Key": {
@@ -906,67 +10325,277 @@ var doc = `{
                 },
                 "Values": {
                     "type": "array"
+                },
+                "Version": {
+                    "type": "integer"
                 }
             }
         },
-        "contracts.ErrorContract": {
+        "contracts.SetDictionaryCacheValuesContract": {
             "type": "object",
             "properties": {
-                "Status": {
+                "Create": {
+                    "type": "boolean"
+                },
+                "Sliding": {
+                    "type": "boolean"
+                },
+                "TTL": {
                     "type": "string"
+                },
+                "Values": {
+                    "type": "array"
+                },
+                "Version": {
+                    "type": "integer"
                 }
             }
         },
-        "contracts.ListCacheValueContract": {
+        "contracts.SortedSetCacheCountContract": {
+            "type": "object",
+            "properties": {
+                "Count": {
+                    "type": "integer"
+                },
+                "Key": {
+                    "type": "string"
+                }
+            }
+        },
+        "contracts.SortedSetCacheRankContract": {
             "type": "object",
             "properties": {
                 "Key": {
                     "type": "string"
                 },
-                "Values": {
+                "Member": {
+                    "type": "string"
+                },
+                "Rank": {
+                    "type": "integer"
+                },
+                "Score": {
+                    "type": "number"
+                }
+            }
+        },
+        "contracts.SortedSetCacheScoreContract": {
+            "type": "object",
+            "properties": {
+                "Key": {
+                    "type": "string"
+                },
+                "Member": {
+                    "type": "string"
+                },
+                "Score": {
+                    "type": "number"
+                }
+            }
+        },
+        "contracts.SortedSetCacheValuesContract": {
+            "type": "object",
+            "properties": {
+                "Key": {
+                    "type": "string"
+                },
+                "Members": {
                     "type": "array"
+                },
+                "Version": {
+                    "type": "integer"
                 }
             }
         },
-        "contracts.NewDictionaryCacheValuesContract": {
+        "contracts.StreamCacheAckContract": {
             "type": "object",
             "properties": {
+                "Acked": {
+                    "type": "integer"
+                },
+                "Group": {
+                    "type": "string"
+                },
                 "Key": {
                     "type": "string"
                 },
-                "TTL": {
+                "Version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "contracts.StreamCacheAppendContract": {
+            "type": "object",
+            "properties": {
+                "ID": {
                     "type": "string"
                 },
-                "Values": {
+                "Key": {
+                    "type": "string"
+                },
+                "Length": {
+                    "type": "integer"
+                },
+                "Version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "contracts.StreamCacheEntriesContract": {
+            "type": "object",
+            "properties": {
+                "Entries": {
                     "type": "array"
+                },
+                "Group": {
+                    "type": "string"
+                },
+                "Key": {
+                    "type": "string"
+                },
+                "Version": {
+                    "type": "integer"
                 }
             }
         },
-        "contracts.NewListCacheValuesContract": {
+        "contracts.StreamCacheGroupsContract": {
             "type": "object",
             "properties": {
+                "Groups": {
+                    "type": "array"
+                },
                 "Key": {
                     "type": "string"
                 },
-                "TTL": {
+                "Version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "contracts.StreamCacheInfoContract": {
+            "type": "object",
+            "properties": {
+                "FirstID": {
                     "type": "string"
                 },
-                "Values": {
+                "Groups": {
+                    "type": "integer"
+                },
+                "Key": {
+                    "type": "string"
+                },
+                "LastID": {
+                    "type": "string"
+                },
+                "Length": {
+                    "type": "integer"
+                },
+                "MaxLen": {
+                    "type": "integer"
+                },
+                "Version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "contracts.StreamCachePendingContract": {
+            "type": "object",
+            "properties": {
+                "Entries": {
                     "type": "array"
+                },
+                "Group": {
+                    "type": "string"
+                },
+                "Key": {
+                    "type": "string"
                 }
             }
         },
-        "contracts.NewStringCacheValueContract": {
+        "contracts.StreamCacheTrimContract": {
             "type": "object",
             "properties": {
                 "Key": {
                     "type": "string"
                 },
-                "TTL": {
+                "Length": {
+                    "type": "integer"
+                },
+                "Removed": {
+                    "type": "integer"
+                },
+                "Version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "contracts.StringCacheBitContract": {
+            "type": "object",
+            "properties": {
+                "Bit": {
+                    "type": "integer"
+                },
+                "Key": {
                     "type": "string"
                 },
-                "Value": {
+                "Version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "contracts.StringCacheBitCountContract": {
+            "type": "object",
+            "properties": {
+                "Count": {
+                    "type": "integer"
+                },
+                "Key": {
+                    "type": "string"
+                },
+                "Version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "contracts.StringCacheBitFieldContract": {
+            "type": "object",
+            "properties": {
+                "Key": {
+                    "type": "string"
+                },
+                "Results": {
+                    "type": "array"
+                },
+                "Version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "contracts.StringCacheBitOpContract": {
+            "type": "object",
+            "properties": {
+                "Key": {
                     "type": "string"
+                },
+                "Length": {
+                    "type": "integer"
+                },
+                "Version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "contracts.StringCacheBitPosContract": {
+            "type": "object",
+            "properties": {
+                "Key": {
+                    "type": "string"
+                },
+                "Position": {
+                    "type": "integer"
+                },
+                "Version": {
+                    "type": "integer"
                 }
             }
         },
@@ -978,6 +10607,65 @@ var doc = `{
                 },
                 "Value": {
                     "type": "string"
+                },
+                "Version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "contracts.TakeRateLimitContract": {
+            "type": "object",
+            "properties": {
+                "Algorithm": {
+                    "type": "string"
+                },
+                "Capacity": {
+                    "type": "integer"
+                },
+                "Cost": {
+                    "type": "integer"
+                },
+                "RefillRate": {
+                    "type": "number"
+                },
+                "Window": {
+                    "type": "string"
+                }
+            }
+        },
+        "contracts.TrimListCacheContract": {
+            "type": "object",
+            "properties": {
+                "Start": {
+                    "type": "integer"
+                },
+                "Stop": {
+                    "type": "integer"
+                },
+                "Version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "contracts.TrimStreamCacheContract": {
+            "type": "object",
+            "properties": {
+                "MaxLen": {
+                    "type": "integer"
+                },
+                "Version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "contracts.UpdateCacheTTLContract": {
+            "type": "object",
+            "properties": {
+                "Sliding": {
+                    "type": "boolean"
+                },
+                "TTL": {
+                    "type": "string"
                 }
             }
         },
@@ -989,6 +10677,37 @@ var doc = `{
                 },
                 "Value": {
                     "type": "string"
+                },
+                "Version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "contracts.UpdateDocumentCachePathContract": {
+            "type": "object",
+            "properties": {
+                "Path": {
+                    "type": "string"
+                },
+                "Value": {
+                    "type": "object"
+                },
+                "Version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "contracts.UpdateListCacheIndexContract": {
+            "type": "object",
+            "properties": {
+                "Index": {
+                    "type": "integer"
+                },
+                "Value": {
+                    "type": "string"
+                },
+                "Version": {
+                    "type": "integer"
                 }
             }
         },
@@ -997,6 +10716,31 @@ var doc = `{
             "properties": {
                 "Value": {
                     "type": "string"
+                },
+                "Version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "contracts.UpdateSetCacheValuesContract": {
+            "type": "object",
+            "properties": {
+                "Values": {
+                    "type": "array"
+                },
+                "Version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "contracts.UpdateSortedSetCacheValuesContract": {
+            "type": "object",
+            "properties": {
+                "Members": {
+                    "type": "array"
+                },
+                "Version": {
+                    "type": "integer"
                 }
             }
         },
@@ -1008,6 +10752,9 @@ var doc = `{
                 },
                 "OriginalValue": {
                     "type": "string"
+                },
+                "Version": {
+                    "type": "integer"
                 }
             }
         }
@@ -5,6 +5,9 @@ import (
 	"github.com/AsynkronIT/protoactor-go/remote"
 	"github.com/VitalKrasilnikau/memcache/api/controllers"
 	_ "github.com/VitalKrasilnikau/memcache/api/docs"
+	"github.com/VitalKrasilnikau/memcache/api/memcached"
+	"github.com/VitalKrasilnikau/memcache/api/resp"
+	"github.com/VitalKrasilnikau/memcache/api/rpc"
 	"github.com/VitalKrasilnikau/memcache/api/utils"
 	"github.com/VitalKrasilnikau/memcache/core/actors"
 	"github.com/gin-gonic/gin"
@@ -19,11 +22,12 @@ import (
 /* String handlers for swagger */
 
 // GetStringCacheKeyHandler .
-// @Description gets string cache entry by key
+// @Description gets string cache entry by key, with meta=true gets contracts.CacheEntryMetadataContract with timestamps, ttl, persisted flag, size and version instead, values posted as raw body are returned as is with their content type
 // @Summary gets string cache entry by key
 // @Accept   json
-// @Produce  json
+// @Produce  json,octet-stream
 // @Param    key	path	string	true	"key"
+// @Param    meta	query	boolean	false	"return entry metadata instead of value"
 // @Success 200 {object} contracts.StringCacheValueContract	"key and corresponding value"
 // @Failure 500 {object} contracts.ErrorContract "server error"
 // @Failure 404 {object} contracts.ErrorContract "key was not found"
@@ -38,9 +42,11 @@ func GetStringCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
 // @Accept   json
 // @Produce  json
 // @Param    deleted-key	path	string	true	"deleted-key"
+// @Param    If-Match	header	string	false	"expected entry version"
 // @Success 204 {string} string "no content"
 // @Failure 500 {object} contracts.ErrorContract "server error"
 // @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Failure 412 {object} contracts.ErrorContract "entry version does not match"
 // @Router /api/string/{deleted-key} [delete]
 func DeleteStringCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
 	return controllers.DeleteStringCacheKeyHandler(pid)
@@ -59,11 +65,14 @@ func GetCacheKeysHandler(pid *act.BroadcastStringKeysGroup) func(*gin.Context) {
 }
 
 // PostStringCacheKeyHandler .
-// @Description posts new string value
+// @Description posts new string value, body of any other content type than JSON or form is stored as is with its content type
 // @Summary posts new string value
-// @Accept   json
+// @Accept   json,octet-stream
 // @Produce  json
 // @Param    body	body	contracts.NewStringCacheValueContract	true	"body"
+// @Param    key	query	string	false	"key of the raw value"
+// @Param    ttl	query	string	false	"ttl of the raw value"
+// @Param    sliding	query	boolean	false	"sliding ttl of the raw value"
 // @Success 201 {string} string	"created"
 // @Failure 400 {object} contracts.ErrorContract "bad request"
 // @Failure 500 {object} contracts.ErrorContract "server error"
@@ -74,27 +83,173 @@ func PostStringCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
 
 // PutStringCacheKeyHandler .
 // @Description updates existing string value by the key and new value specified
+// @Description body of any other content type than JSON or form is stored as is with its content type, If-Match header is required then
 // @Summary updates existing string value by the key and new value specified
-// @Accept   json
+// @Accept   json,octet-stream
 // @Produce  json
 // @Param    update-key	path	string	true	"update-key"
 // @Param    body	body	contracts.UpdateStringCacheValueContract	true	"body"
+// @Param    If-Match	header	string	false	"expected entry version"
 // @Success 204 {string} string	"no content"
 // @Failure 400 {object} contracts.ErrorContract "bad request"
 // @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 412 {object} contracts.ErrorContract "entry version does not match"
 // @Router /api/string/{update-key} [put]
 func PutStringCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
 	return controllers.PutStringCacheKeyHandler(pid)
 }
 
+// IncrementStringCacheKeyHandler .
+// @Description atomically increments numeric string value by the key, delta is 1 by default
+// @Summary atomically increments numeric string value by the key, delta is 1 by default
+// @Accept   json
+// @Produce  json
+// @Param    key	path	string	true	"key"
+// @Param    body	body	contracts.IncrementCacheValueContract	false	"body"
+// @Param    If-Match	header	string	false	"expected entry version"
+// @Success 200 {object} contracts.StringCacheValueContract	"key and new value"
+// @Failure 400 {object} contracts.ErrorContract "bad request"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Failure 412 {object} contracts.ErrorContract "entry version does not match"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Router /api/string/{key}/incr [post]
+func IncrementStringCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.IncrementStringCacheKeyHandler(pid)
+}
+
+// DecrementStringCacheKeyHandler .
+// @Description atomically decrements numeric string value by the key, delta is 1 by default
+// @Summary atomically decrements numeric string value by the key, delta is 1 by default
+// @Accept   json
+// @Produce  json
+// @Param    key	path	string	true	"key"
+// @Param    body	body	contracts.IncrementCacheValueContract	false	"body"
+// @Param    If-Match	header	string	false	"expected entry version"
+// @Success 200 {object} contracts.StringCacheValueContract	"key and new value"
+// @Failure 400 {object} contracts.ErrorContract "bad request"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Failure 412 {object} contracts.ErrorContract "entry version does not match"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Router /api/string/{key}/decr [post]
+func DecrementStringCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.DecrementStringCacheKeyHandler(pid)
+}
+
+// GetBitStringCacheKeyHandler .
+// @Description gets the bit of string value by offset like GETBIT, bit 0 is the most significant bit of the first byte
+// @Summary gets the bit of string value
+// @Accept   json
+// @Produce  json
+// @Param    key	path	string	true	"key"
+// @Param    offset	query	int	false	"bit offset, 0 by default"
+// @Success 200 {object} contracts.StringCacheBitContract	"bit"
+// @Failure 400 {object} contracts.ErrorContract "bad request"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Router /api/string/{key}/bit [get]
+func GetBitStringCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.GetBitStringCacheKeyHandler(pid)
+}
+
+// SetBitStringCacheKeyHandler .
+// @Description sets the bit of string value like SETBIT padding the value with zero bytes, "create" option creates the missing key
+// @Summary sets the bit of string value
+// @Accept   json
+// @Produce  json
+// @Param    key	path	string	true	"key"
+// @Param    body	body	contracts.SetBitStringCacheContract	true	"body"
+// @Param    If-Match	header	string	false	"expected entry version"
+// @Success 200 {object} contracts.StringCacheBitContract	"previous bit"
+// @Failure 400 {object} contracts.ErrorContract "bad request"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Failure 412 {object} contracts.ErrorContract "entry version does not match"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Router /api/string/{key}/bit [post]
+func SetBitStringCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.SetBitStringCacheKeyHandler(pid)
+}
+
+// CountBitsStringCacheKeyHandler .
+// @Description counts the bits set in string value like BITCOUNT, negative indexes are counted from the end
+// @Summary counts the bits set in string value
+// @Accept   json
+// @Produce  json
+// @Param    key	path	string	true	"key"
+// @Param    start	query	int	false	"start index, 0 by default"
+// @Param    end	query	int	false	"end index inclusive, -1 by default"
+// @Param    unit	query	string	false	"byte or bit, byte by default"
+// @Success 200 {object} contracts.StringCacheBitCountContract	"number of bits set"
+// @Failure 400 {object} contracts.ErrorContract "bad request"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Router /api/string/{key}/bitcount [get]
+func CountBitsStringCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.CountBitsStringCacheKeyHandler(pid)
+}
+
+// GetBitPosStringCacheKeyHandler .
+// @Description finds the first bit set to 0 or 1 in string value like BITPOS, -1 means that the bit was not found
+// @Summary finds the first bit set to 0 or 1 in string value
+// @Accept   json
+// @Produce  json
+// @Param    key	path	string	true	"key"
+// @Param    bit	query	int	true	"0 or 1"
+// @Param    start	query	int	false	"start index, 0 by default"
+// @Param    end	query	int	false	"end index inclusive, the end of the value by default"
+// @Param    unit	query	string	false	"byte or bit, byte by default"
+// @Success 200 {object} contracts.StringCacheBitPosContract	"position of the bit"
+// @Failure 400 {object} contracts.ErrorContract "bad request"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Router /api/string/{key}/bitpos [get]
+func GetBitPosStringCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.GetBitPosStringCacheKeyHandler(pid)
+}
+
+// BitOpStringCacheKeyHandler .
+// @Description stores the result of and, or, xor or not operation on the values of the keys like BITOP, missing keys are empty values and the empty result deletes the key
+// @Summary stores the result of bitwise operation on string values
+// @Accept   json
+// @Produce  json
+// @Param    key	path	string	true	"key"
+// @Param    body	body	contracts.BitOpStringCacheContract	true	"body"
+// @Param    If-Match	header	string	false	"expected entry version"
+// @Success 200 {object} contracts.StringCacheBitOpContract	"length of the value stored"
+// @Failure 400 {object} contracts.ErrorContract "bad request"
+// @Failure 412 {object} contracts.ErrorContract "entry version does not match"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Router /api/string/{key}/bitop [post]
+func BitOpStringCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.BitOpStringCacheKeyHandler(pid)
+}
+
+// BitFieldStringCacheKeyHandler .
+// @Description gets, sets and increments integers of arbitrary width in string value like BITFIELD, null result means fail overflow
+// @Summary applies bitfield operations to string value
+// @Accept   json
+// @Produce  json
+// @Param    key	path	string	true	"key"
+// @Param    body	body	contracts.BitFieldStringCacheContract	true	"body"
+// @Param    If-Match	header	string	false	"expected entry version"
+// @Success 200 {object} contracts.StringCacheBitFieldContract	"results of the operations"
+// @Failure 400 {object} contracts.ErrorContract "bad request"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Failure 412 {object} contracts.ErrorContract "entry version does not match"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Router /api/string/{key}/bitfield [post]
+func BitFieldStringCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.BitFieldStringCacheKeyHandler(pid)
+}
+
 /* List handlers for swagger */
 
 // GetListCacheKeyHandler .
-// @Description gets list cache entry by key
+// @Description gets list cache entry by key, with meta=true gets contracts.CacheEntryMetadataContract with timestamps, ttl, persisted flag, size and version instead
 // @Summary gets list cache entry by key
 // @Accept   json
 // @Produce  json
 // @Param    key	path	string	true	"key"
+// @Param    meta	query	boolean	false	"return entry metadata instead of value"
 // @Success 200 {object} contracts.ListCacheValueContract	"key and corresponding list value"
 // @Failure 500 {object} contracts.ErrorContract "server error"
 // @Failure 404 {object} contracts.ErrorContract "key was not found"
@@ -109,9 +264,11 @@ func GetListCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
 // @Accept   json
 // @Produce  json
 // @Param    deleted-key	path	string	true	"deleted-key"
+// @Param    If-Match	header	string	false	"expected entry version"
 // @Success 204 {string} string "no content"
 // @Failure 500 {object} contracts.ErrorContract "server error"
 // @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Failure 412 {object} contracts.ErrorContract "entry version does not match"
 // @Router /api/list/{deleted-key} [delete]
 func DeleteListCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
 	return controllers.DeleteListCacheKeyHandler(pid)
@@ -151,9 +308,11 @@ func PostListCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
 // @Param    update-key	path	string	true	"update-key"
 // @Param    update-value	path	string	true	"update-value"
 // @Param    body	body	contracts.UpdateListCacheValueContract	true	"body"
+// @Param    If-Match	header	string	false	"expected entry version"
 // @Success 204 {string} string	"no content"
 // @Failure 400 {object} contracts.ErrorContract "bad request"
 // @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 412 {object} contracts.ErrorContract "entry version does not match"
 // @Router /api/list/{update-key}/{update-value} [put]
 func PutListCacheValueHandler(pid *actor.PID) func(*gin.Context) {
 	return controllers.PutListCacheValueHandler(pid)
@@ -166,9 +325,11 @@ func PutListCacheValueHandler(pid *actor.PID) func(*gin.Context) {
 // @Produce  json
 // @Param    update-key	path	string	true	"update-key"
 // @Param    body	body	contracts.UpdateListCacheValueContract	true	"body"
+// @Param    If-Match	header	string	false	"expected entry version"
 // @Success 201 {string} string	"created"
 // @Failure 400 {object} contracts.ErrorContract "bad request"
 // @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 412 {object} contracts.ErrorContract "entry version does not match"
 // @Router /api/list/{update-key} [post]
 func PostListCacheValueHandler(pid *actor.PID) func(*gin.Context) {
 	return controllers.PostListCacheValueHandler(pid)
@@ -181,22 +342,202 @@ func PostListCacheValueHandler(pid *actor.PID) func(*gin.Context) {
 // @Produce  json
 // @Param    update-key	path	string	true	"update-key"
 // @Param    delete-value	path	string	true	"delete-value"
+// @Param    If-Match	header	string	false	"expected entry version"
 // @Success 204 {string} string	"no content"
 // @Failure 400 {object} contracts.ErrorContract "bad request"
 // @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 412 {object} contracts.ErrorContract "entry version does not match"
 // @Router /api/list/{update-key}/{delete-value} [delete]
 func DeleteListCacheValueHandler(pid *actor.PID) func(*gin.Context) {
 	return controllers.DeleteListCacheValueHandler(pid)
 }
 
+// GetListCacheIndexHandler .
+// @Description gets list value by zero-based index, negative indexes are counted from the end
+// @Summary gets list value by zero-based index, negative indexes are counted from the end
+// @Accept   json
+// @Produce  json
+// @Param    key	path	string	true	"key"
+// @Param    index	query	int	false	"index"
+// @Success 200 {object} contracts.ListCacheIndexValueContract	"key, index and value"
+// @Failure 400 {object} contracts.ErrorContract "bad request"
+// @Failure 404 {object} contracts.ErrorContract "key was not found or index is out of range"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Router /api/list/{key}/index [get]
+func GetListCacheIndexHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.GetListCacheIndexHandler(pid)
+}
+
+// GetListCacheRangeHandler .
+// @Description gets list values from start to stop indexes inclusive like LRANGE, the reply contains the length of the list for pagination
+// @Summary gets list values from start to stop indexes inclusive like LRANGE, the reply contains the length of the list for pagination
+// @Accept   json
+// @Produce  json
+// @Param    key	path	string	true	"key"
+// @Param    start	query	int	false	"start index, 0 by default"
+// @Param    stop	query	int	false	"stop index, -1 by default"
+// @Success 200 {object} contracts.ListCacheRangeContract	"key, values, start index and length of the list"
+// @Failure 400 {object} contracts.ErrorContract "bad request"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Router /api/list/{key}/range [get]
+func GetListCacheRangeHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.GetListCacheRangeHandler(pid)
+}
+
+// SetListCacheIndexHandler .
+// @Description replaces list value by its index, duplicates of the value are kept
+// @Summary replaces list value by its index, duplicates of the value are kept
+// @Accept   json
+// @Produce  json
+// @Param    key	path	string	true	"key"
+// @Param    body	body	contracts.UpdateListCacheIndexContract	true	"body"
+// @Param    If-Match	header	string	false	"expected entry version"
+// @Success 204 {string} string	"no content"
+// @Failure 400 {object} contracts.ErrorContract "bad request or index is out of range"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Failure 412 {object} contracts.ErrorContract "entry version does not match"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Router /api/list/{key}/set [post]
+func SetListCacheIndexHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.SetListCacheIndexHandler(pid)
+}
+
+// InsertListCacheValueHandler .
+// @Description inserts the value before the index, the index equal to the length appends the value
+// @Summary inserts the value before the index, the index equal to the length appends the value
+// @Accept   json
+// @Produce  json
+// @Param    key	path	string	true	"key"
+// @Param    body	body	contracts.UpdateListCacheIndexContract	true	"body"
+// @Param    If-Match	header	string	false	"expected entry version"
+// @Success 200 {object} contracts.ListCacheLengthContract	"new length of the list"
+// @Failure 400 {object} contracts.ErrorContract "bad request or index is out of range"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Failure 412 {object} contracts.ErrorContract "entry version does not match"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Router /api/list/{key}/insert [post]
+func InsertListCacheValueHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.InsertListCacheValueHandler(pid)
+}
+
+// RemoveListCacheIndexHandler .
+// @Description removes list value by its index, duplicates of the value are kept
+// @Summary removes list value by its index, duplicates of the value are kept
+// @Accept   json
+// @Produce  json
+// @Param    key	path	string	true	"key"
+// @Param    body	body	contracts.RemoveListCacheIndexContract	true	"body"
+// @Param    If-Match	header	string	false	"expected entry version"
+// @Success 200 {object} contracts.ListCacheIndexValueContract	"removed value"
+// @Failure 400 {object} contracts.ErrorContract "bad request or index is out of range"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Failure 412 {object} contracts.ErrorContract "entry version does not match"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Router /api/list/{key}/remove [post]
+func RemoveListCacheIndexHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.RemoveListCacheIndexHandler(pid)
+}
+
+// PushLeftListCacheValuesHandler .
+// @Description adds the values to the head of the list like LPUSH, with create=true the missing list is created
+// @Summary adds the values to the head of the list like LPUSH, with create=true the missing list is created
+// @Accept   json
+// @Produce  json
+// @Param    key	path	string	true	"key"
+// @Param    body	body	contracts.PushListCacheValuesContract	true	"body"
+// @Param    If-Match	header	string	false	"expected entry version"
+// @Success 200 {object} contracts.ListCacheLengthContract	"new length of the list"
+// @Failure 400 {object} contracts.ErrorContract "bad request"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Failure 412 {object} contracts.ErrorContract "entry version does not match"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Router /api/list/{key}/lpush [post]
+func PushLeftListCacheValuesHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.PushLeftListCacheValuesHandler(pid)
+}
+
+// PushRightListCacheValuesHandler .
+// @Description adds the values to the tail of the list like RPUSH, with create=true the missing list is created
+// @Summary adds the values to the tail of the list like RPUSH, with create=true the missing list is created
+// @Accept   json
+// @Produce  json
+// @Param    key	path	string	true	"key"
+// @Param    body	body	contracts.PushListCacheValuesContract	true	"body"
+// @Param    If-Match	header	string	false	"expected entry version"
+// @Success 200 {object} contracts.ListCacheLengthContract	"new length of the list"
+// @Failure 400 {object} contracts.ErrorContract "bad request"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Failure 412 {object} contracts.ErrorContract "entry version does not match"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Router /api/list/{key}/rpush [post]
+func PushRightListCacheValuesHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.PushRightListCacheValuesHandler(pid)
+}
+
+// PopLeftListCacheValuesHandler .
+// @Description removes the values from the head of the list like LPOP, with timeout specified waits for the values like BLPOP
+// @Summary removes the values from the head of the list like LPOP, with timeout specified waits for the values like BLPOP
+// @Accept   json
+// @Produce  json
+// @Param    key	path	string	true	"key"
+// @Param    body	body	contracts.PopListCacheValuesContract	false	"body"
+// @Param    If-Match	header	string	false	"expected entry version"
+// @Success 200 {object} contracts.ListCacheValueContract	"removed values"
+// @Failure 400 {object} contracts.ErrorContract "bad request"
+// @Failure 404 {object} contracts.ErrorContract "key was not found, empty or timeout expired"
+// @Failure 412 {object} contracts.ErrorContract "entry version does not match"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Router /api/list/{key}/lpop [post]
+func PopLeftListCacheValuesHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.PopLeftListCacheValuesHandler(pid)
+}
+
+// PopRightListCacheValuesHandler .
+// @Description removes the values from the tail of the list like RPOP, with timeout specified waits for the values like BRPOP
+// @Summary removes the values from the tail of the list like RPOP, with timeout specified waits for the values like BRPOP
+// @Accept   json
+// @Produce  json
+// @Param    key	path	string	true	"key"
+// @Param    body	body	contracts.PopListCacheValuesContract	false	"body"
+// @Param    If-Match	header	string	false	"expected entry version"
+// @Success 200 {object} contracts.ListCacheValueContract	"removed values"
+// @Failure 400 {object} contracts.ErrorContract "bad request"
+// @Failure 404 {object} contracts.ErrorContract "key was not found, empty or timeout expired"
+// @Failure 412 {object} contracts.ErrorContract "entry version does not match"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Router /api/list/{key}/rpop [post]
+func PopRightListCacheValuesHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.PopRightListCacheValuesHandler(pid)
+}
+
+// TrimListCacheKeyHandler .
+// @Description keeps only the values from start to stop indexes inclusive like LTRIM
+// @Summary keeps only the values from start to stop indexes inclusive like LTRIM
+// @Accept   json
+// @Produce  json
+// @Param    key	path	string	true	"key"
+// @Param    body	body	contracts.TrimListCacheContract	true	"body"
+// @Param    If-Match	header	string	false	"expected entry version"
+// @Success 200 {object} contracts.ListCacheTrimContract	"number of values removed and new length of the list"
+// @Failure 400 {object} contracts.ErrorContract "bad request"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Failure 412 {object} contracts.ErrorContract "entry version does not match"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Router /api/list/{key}/trim [post]
+func TrimListCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.TrimListCacheKeyHandler(pid)
+}
+
 /* Dictionary handlers for swagger */
 
 // GetDictionaryCacheKeyHandler .
-// @Description gets dictionary cache entry by key
+// @Description gets dictionary cache entry by key, with meta=true gets contracts.CacheEntryMetadataContract with timestamps, ttl, persisted flag, size and version instead
 // @Summary gets dictionary cache entry by key
 // @Accept   json
 // @Produce  json
 // @Param    key	path	string	true	"key"
+// @Param    meta	query	boolean	false	"return entry metadata instead of value"
 // @Success 200 {object} contracts.DictionaryCacheValueContract	"key and corresponding list value"
 // @Failure 500 {object} contracts.ErrorContract "server error"
 // @Failure 404 {object} contracts.ErrorContract "key was not found"
@@ -206,14 +547,18 @@ func GetDictionaryCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
 }
 
 // DeleteDictionaryCacheKeyHandler .
-// @Description deletes dictionary cache entry by key
-// @Summary deletes dictionary cache entry by key
+// @Description deletes dictionary cache entry by key, if "subkey" is specified deletes only the sub-keys atomically and replies with the values deleted, missing sub-keys are skipped
+// @Summary deletes dictionary cache entry or its sub-keys by key
 // @Accept   json
 // @Produce  json
 // @Param    deleted-key	path	string	true	"deleted-key"
+// @Param    subkey	query	string	false	"sub-key, can be repeated or comma-separated"
+// @Param    If-Match	header	string	false	"expected entry version"
+// @Success 200 {object} contracts.DictionaryCacheValueContract	"sub-key values deleted"
 // @Success 204 {string} string "no content"
 // @Failure 500 {object} contracts.ErrorContract "server error"
 // @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Failure 412 {object} contracts.ErrorContract "entry version does not match"
 // @Router /api/dictionary/{deleted-key} [delete]
 func DeleteDictionaryCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
 	return controllers.DeleteDictionaryCacheKeyHandler(pid)
@@ -253,9 +598,11 @@ func PostDictionaryCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
 // @Param    update-key	path	string	true	"update-key"
 // @Param    update-sub-key	path	string	true	"update-sub-key"
 // @Param    body	body	contracts.UpdateDictionaryCacheValueContract	true	"body"
+// @Param    If-Match	header	string	false	"expected entry version"
 // @Success 204 {string} string	"no content"
 // @Failure 400 {object} contracts.ErrorContract "bad request"
 // @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 412 {object} contracts.ErrorContract "entry version does not match"
 // @Router /api/dictionary/{update-key}/{update-sub-key} [put]
 func PutDictionaryCacheValueHandler(pid *actor.PID) func(*gin.Context) {
 	return controllers.PutDictionaryCacheValueHandler(pid)
@@ -268,9 +615,11 @@ func PutDictionaryCacheValueHandler(pid *actor.PID) func(*gin.Context) {
 // @Produce  json
 // @Param    update-key	path	string	true	"update-key"
 // @Param    body	body	contracts.AddDictionaryCacheValueContract	true	"body"
+// @Param    If-Match	header	string	false	"expected entry version"
 // @Success 201 {string} string	"created"
 // @Failure 400 {object} contracts.ErrorContract "bad request"
 // @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 412 {object} contracts.ErrorContract "entry version does not match"
 // @Router /api/dictionary/{update-key} [post]
 func PostDictionaryCacheValueHandler(pid *actor.PID) func(*gin.Context) {
 	return controllers.PostDictionaryCacheValueHandler(pid)
@@ -283,57 +632,2247 @@ func PostDictionaryCacheValueHandler(pid *actor.PID) func(*gin.Context) {
 // @Produce  json
 // @Param    update-key	path	string	true	"update-key"
 // @Param    delete-sub-key	path	string	true	"delete-sub-key"
+// @Param    If-Match	header	string	false	"expected entry version"
 // @Success 204 {string} string	"no content"
 // @Failure 400 {object} contracts.ErrorContract "bad request"
 // @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 412 {object} contracts.ErrorContract "entry version does not match"
 // @Router /api/dictionary/{update-key}/{delete-sub-key} [delete]
 func DeleteDictionaryCacheValueHandler(pid *actor.PID) func(*gin.Context) {
 	return controllers.DeleteDictionaryCacheValueHandler(pid)
 }
 
-// @title Memory cache based on Go Swagger API
-// @version 1.0
-// @description This is a memory cache based on Go.
-func main() {
-	args := api.NewCommandArgs()
-	if args.IsRemote {
-		remote.Start("127.0.0.1:50000")
-	}
-	pid, bpid, cpid := act.NewStringCacheActorCluster("memcache", args.ActorNumber, args.UsePersistence, args.IsRemote)
-	lpid, lbpid, lcpid := act.NewListCacheActorCluster("memcache", args.ActorNumber, args.UsePersistence, args.IsRemote)
-	dpid, dbpid, dcpid := act.NewDictionaryCacheActorCluster("memcache", args.ActorNumber, args.UsePersistence, args.IsRemote)
-	log.Printf("Started with %d actors per cache", args.ActorNumber)
-	router := gin.Default()
-	api := router.Group("/api")
-	{
-		str := api.Group("/string")
-		{
-			str.GET("/", GetCacheKeysHandler(cpid))
-			str.GET("/:key", GetStringCacheKeyHandler(pid))
-			str.POST("/", PostStringCacheKeyHandler(pid))
-			str.PUT("/:key", PutStringCacheKeyHandler(pid))
-			str.DELETE("/:key", DeleteStringCacheKeyHandler(pid))
-		}
-		list := api.Group("/list")
-		{
-			list.GET("/", GetListKeysHandler(lcpid))
-			list.GET("/:key", GetListCacheKeyHandler(lpid))
-			list.POST("/", PostListCacheKeyHandler(lpid))
-			list.POST("/:key", PostListCacheValueHandler(lpid))
-			list.PUT("/:key/:value", PutListCacheValueHandler(lpid))
-			list.DELETE("/:key", DeleteListCacheKeyHandler(lpid))
-			list.DELETE("/:key/:value", DeleteListCacheValueHandler(lpid))
-		}
-		d := api.Group("/dictionary")
-		{
-			d.GET("/", GetDictionaryKeysHandler(dcpid))
-			d.GET("/:key", GetDictionaryCacheKeyHandler(dpid))
-			d.POST("/", PostDictionaryCacheKeyHandler(dpid))
-			d.POST("/:key", PostDictionaryCacheValueHandler(dpid))
-			d.PUT("/:key/:subkey", PutDictionaryCacheValueHandler(dpid))
-			d.DELETE("/:key", DeleteDictionaryCacheKeyHandler(dpid))
-			d.DELETE("/:key/:subkey", DeleteDictionaryCacheValueHandler(dpid))
+// IncrementDictionaryCacheValueHandler .
+// @Description atomically increments numeric value of the dictionary sub-key, missing sub-key is treated as zero
+// @Summary atomically increments numeric value of the dictionary sub-key, missing sub-key is treated as zero
+// @Accept   json
+// @Produce  json
+// @Param    key	path	string	true	"key"
+// @Param    subkey	path	string	true	"subkey"
+// @Param    body	body	contracts.IncrementCacheValueContract	false	"body"
+// @Param    If-Match	header	string	false	"expected entry version"
+// @Success 200 {object} contracts.DictionaryCacheSubKeyValueContract	"key, sub-key and new value"
+// @Failure 400 {object} contracts.ErrorContract "bad request"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Failure 412 {object} contracts.ErrorContract "entry version does not match"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Router /api/dictionary/{key}/{subkey}/incr [post]
+func IncrementDictionaryCacheValueHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.IncrementDictionaryCacheValueHandler(pid)
+}
+
+// DecrementDictionaryCacheValueHandler .
+// @Description atomically decrements numeric value of the dictionary sub-key, missing sub-key is treated as zero
+// @Summary atomically decrements numeric value of the dictionary sub-key, missing sub-key is treated as zero
+// @Accept   json
+// @Produce  json
+// @Param    key	path	string	true	"key"
+// @Param    subkey	path	string	true	"subkey"
+// @Param    body	body	contracts.IncrementCacheValueContract	false	"body"
+// @Param    If-Match	header	string	false	"expected entry version"
+// @Success 200 {object} contracts.DictionaryCacheSubKeyValueContract	"key, sub-key and new value"
+// @Failure 400 {object} contracts.ErrorContract "bad request"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Failure 412 {object} contracts.ErrorContract "entry version does not match"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Router /api/dictionary/{key}/{subkey}/decr [post]
+func DecrementDictionaryCacheValueHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.DecrementDictionaryCacheValueHandler(pid)
+}
+
+// GetDictionaryCacheValuesHandler .
+// @Description gets the values of the selected dictionary sub-keys, missing sub-keys are skipped
+// @Summary gets the values of the selected dictionary sub-keys, missing sub-keys are skipped
+// @Accept   json
+// @Produce  json
+// @Param    key	path	string	true	"key"
+// @Param    subkey	query	string	true	"sub-key, can be repeated or comma-separated"
+// @Success 200 {object} contracts.DictionaryCacheValueContract	"key and values of the sub-keys found"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Router /api/dictionary/{key}/values [get]
+func GetDictionaryCacheValuesHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.GetDictionaryCacheValuesHandler(pid)
+}
+
+// GetDictionaryCacheSubKeysHandler .
+// @Description gets the sorted dictionary sub-keys without their values
+// @Summary gets the sorted dictionary sub-keys without their values
+// @Accept   json
+// @Produce  json
+// @Param    key	path	string	true	"key"
+// @Success 200 {object} contracts.DictionaryCacheSubKeysContract	"key and sub-keys"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Router /api/dictionary/{key}/subkeys [get]
+func GetDictionaryCacheSubKeysHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.GetDictionaryCacheSubKeysHandler(pid)
+}
+
+// ContainsDictionaryCacheValuesHandler .
+// @Description checks if the dictionary contains the sub-keys
+// @Summary checks if the dictionary contains the sub-keys
+// @Accept   json
+// @Produce  json
+// @Param    key	path	string	true	"key"
+// @Param    subkey	query	string	true	"sub-key, can be repeated or comma-separated"
+// @Success 200 {object} contracts.DictionaryCacheExistsContract	"existence of each sub-key"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Router /api/dictionary/{key}/exists [get]
+func ContainsDictionaryCacheValuesHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.ContainsDictionaryCacheValuesHandler(pid)
+}
+
+// PatchDictionaryCacheValuesHandler .
+// @Description adds missing dictionary sub-keys and updates existing ones atomically
+// @Summary adds missing dictionary sub-keys and updates existing ones atomically
+// @Accept   json
+// @Produce  json
+// @Param    key	path	string	true	"key"
+// @Param    body	body	contracts.SetDictionaryCacheValuesContract	true	"body"
+// @Param    If-Match	header	string	false	"expected entry version"
+// @Success 200 {object} contracts.DictionaryCacheSetResultContract	"number of sub-keys added and updated"
+// @Failure 400 {object} contracts.ErrorContract "bad request"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Failure 412 {object} contracts.ErrorContract "entry version does not match"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Router /api/dictionary/{key} [patch]
+func PatchDictionaryCacheValuesHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.PatchDictionaryCacheValuesHandler(pid)
+}
+
+// DeleteDictionaryCacheValuesHandler is documented with DeleteDictionaryCacheKeyHandler, it handles the same route when "subkey" query parameter is specified.
+func DeleteDictionaryCacheValuesHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.DeleteDictionaryCacheValuesHandler(pid)
+}
+
+/* Set handlers for swagger */
+
+// GetSetCacheKeyHandler .
+// @Description gets sorted members of set cache entry by key, with meta=true gets contracts.CacheEntryMetadataContract with timestamps, ttl, persisted flag, size and version instead
+// @Summary gets set cache entry by key
+// @Accept   json
+// @Produce  json
+// @Param    key	path	string	true	"key"
+// @Param    meta	query	boolean	false	"return entry metadata instead of value"
+// @Success 200 {object} contracts.SetCacheValuesContract	"key and corresponding set members"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Router /api/set/{key} [get]
+func GetSetCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.GetSetCacheKeyHandler(pid)
+}
+
+// DeleteSetCacheKeyHandler .
+// @Description deletes set cache entry by key
+// @Summary deletes set cache entry by key
+// @Accept   json
+// @Produce  json
+// @Param    deleted-key	path	string	true	"deleted-key"
+// @Param    If-Match	header	string	false	"expected entry version"
+// @Success 204 {string} string "no content"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Failure 412 {object} contracts.ErrorContract "entry version does not match"
+// @Router /api/set/{deleted-key} [delete]
+func DeleteSetCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.DeleteSetCacheKeyHandler(pid)
+}
+
+// GetSetKeysHandler .
+// @Description gets all set cache keys
+// @Summary gets all set cache keys
+// @Accept   json
+// @Produce  json
+// @Success 200 {object} contracts.CacheKeysContract	"set cache keys"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Router /api/set [get]
+func GetSetKeysHandler(pid *act.BroadcastStringKeysGroup) func(*gin.Context) {
+	return controllers.GetCacheKeysHandler(pid)
+}
+
+// PostSetCacheKeyHandler .
+// @Description posts new set value, duplicate members are ignored
+// @Summary posts new set value
+// @Accept   json
+// @Produce  json
+// @Param    body	body	contracts.NewSetCacheValuesContract	true	"body"
+// @Success 201 {string} string	"created"
+// @Failure 400 {object} contracts.ErrorContract "bad request"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Router /api/set/ [post]
+func PostSetCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.PostSetCacheKeyHandler(pid)
+}
+
+// PostSetCacheValuesHandler .
+// @Description adds members to existing set value by the key, members which are already in the set are ignored
+// @Summary adds members to existing set value by the key
+// @Accept   json
+// @Produce  json
+// @Param    update-key	path	string	true	"update-key"
+// @Param    body	body	contracts.UpdateSetCacheValuesContract	true	"body"
+// @Param    If-Match	header	string	false	"expected entry version"
+// @Success 200 {object} contracts.SetCacheCountContract	"number of members added"
+// @Failure 400 {object} contracts.ErrorContract "bad request"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Failure 412 {object} contracts.ErrorContract "entry version does not match"
+// @Router /api/set/{update-key} [post]
+func PostSetCacheValuesHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.PostSetCacheValuesHandler(pid)
+}
+
+// DeleteSetCacheValueHandler .
+// @Description removes the member from existing set value by the key
+// @Summary removes the member from existing set value by the key
+// @Accept   json
+// @Produce  json
+// @Param    update-key	path	string	true	"update-key"
+// @Param    delete-value	path	string	true	"delete-value"
+// @Param    If-Match	header	string	false	"expected entry version"
+// @Success 204 {string} string	"no content"
+// @Failure 400 {object} contracts.ErrorContract "bad request"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Failure 412 {object} contracts.ErrorContract "entry version does not match"
+// @Router /api/set/{update-key}/{delete-value} [delete]
+func DeleteSetCacheValueHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.DeleteSetCacheValueHandler(pid)
+}
+
+// ContainsSetCacheValueHandler .
+// @Description checks if set value by the key contains the member
+// @Summary checks if set contains the member
+// @Accept   json
+// @Produce  json
+// @Param    key	path	string	true	"key"
+// @Param    value	query	string	true	"member"
+// @Success 200 {object} contracts.SetCacheContainsContract	"key, member and contains flag"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Router /api/set/{key}/contains [get]
+func ContainsSetCacheValueHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.ContainsSetCacheValueHandler(pid)
+}
+
+// GetSetCacheCardinalityHandler .
+// @Description gets the number of members of set value by the key
+// @Summary gets the number of set members
+// @Accept   json
+// @Produce  json
+// @Param    key	path	string	true	"key"
+// @Success 200 {object} contracts.SetCacheCountContract	"number of members"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Router /api/set/{key}/cardinality [get]
+func GetSetCacheCardinalityHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.GetSetCacheCardinalityHandler(pid)
+}
+
+// GetSetCacheRandomValuesHandler .
+// @Description gets up to count distinct random members of set value by the key, the members are not removed
+// @Summary gets random set members
+// @Accept   json
+// @Produce  json
+// @Param    key	path	string	true	"key"
+// @Param    count	query	integer	false	"number of members, 1 by default"
+// @Success 200 {object} contracts.SetCacheValuesContract	"random members"
+// @Failure 400 {object} contracts.ErrorContract "bad request"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Router /api/set/{key}/random [get]
+func GetSetCacheRandomValuesHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.GetSetCacheRandomValuesHandler(pid)
+}
+
+// GetSetCacheUnionHandler .
+// @Description gets sorted members which are in the set by the key or in any of the other sets, missing keys are treated as empty sets
+// @Summary gets union of sets
+// @Accept   json
+// @Produce  json
+// @Param    key	path	string	true	"key"
+// @Param    with	query	string	true	"other set keys, comma separated or repeated"
+// @Success 200 {object} contracts.SetCacheAlgebraContract	"keys and resulting members"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Router /api/set/{key}/union [get]
+func GetSetCacheUnionHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.GetSetCacheUnionHandler(pid)
+}
+
+// GetSetCacheIntersectionHandler .
+// @Description gets sorted members which are in the set by the key and in all the other sets, missing keys are treated as empty sets
+// @Summary gets intersection of sets
+// @Accept   json
+// @Produce  json
+// @Param    key	path	string	true	"key"
+// @Param    with	query	string	true	"other set keys, comma separated or repeated"
+// @Success 200 {object} contracts.SetCacheAlgebraContract	"keys and resulting members"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Router /api/set/{key}/intersection [get]
+func GetSetCacheIntersectionHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.GetSetCacheIntersectionHandler(pid)
+}
+
+// GetSetCacheDifferenceHandler .
+// @Description gets sorted members which are in the set by the key but not in any of the other sets, missing keys are treated as empty sets
+// @Summary gets difference of sets
+// @Accept   json
+// @Produce  json
+// @Param    key	path	string	true	"key"
+// @Param    with	query	string	true	"other set keys, comma separated or repeated"
+// @Success 200 {object} contracts.SetCacheAlgebraContract	"keys and resulting members"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Router /api/set/{key}/difference [get]
+func GetSetCacheDifferenceHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.GetSetCacheDifferenceHandler(pid)
+}
+
+/* Sorted set handlers for swagger */
+
+// GetSortedSetCacheKeyHandler .
+// @Description gets members of sorted set cache entry by key in ascending order of scores, with meta=true gets contracts.CacheEntryMetadataContract with timestamps, ttl, persisted flag, size and version instead
+// @Summary gets sorted set cache entry by key
+// @Accept   json
+// @Produce  json
+// @Param    key	path	string	true	"key"
+// @Param    meta	query	boolean	false	"return entry metadata instead of value"
+// @Success 200 {object} contracts.SortedSetCacheValuesContract	"key and corresponding members with scores"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Router /api/sortedset/{key} [get]
+func GetSortedSetCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.GetSortedSetCacheKeyHandler(pid)
+}
+
+// DeleteSortedSetCacheKeyHandler .
+// @Description deletes sorted set cache entry by key
+// @Summary deletes sorted set cache entry by key
+// @Accept   json
+// @Produce  json
+// @Param    deleted-key	path	string	true	"deleted-key"
+// @Param    If-Match	header	string	false	"expected entry version"
+// @Success 204 {string} string "no content"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Failure 412 {object} contracts.ErrorContract "entry version does not match"
+// @Router /api/sortedset/{deleted-key} [delete]
+func DeleteSortedSetCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.DeleteSortedSetCacheKeyHandler(pid)
+}
+
+// GetSortedSetKeysHandler .
+// @Description gets all sorted set cache keys
+// @Summary gets all sorted set cache keys
+// @Accept   json
+// @Produce  json
+// @Success 200 {object} contracts.CacheKeysContract	"sorted set cache keys"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Router /api/sortedset [get]
+func GetSortedSetKeysHandler(pid *act.BroadcastStringKeysGroup) func(*gin.Context) {
+	return controllers.GetCacheKeysHandler(pid)
+}
+
+// PostSortedSetCacheKeyHandler .
+// @Description posts new sorted set value, the last score wins for duplicate members
+// @Summary posts new sorted set value
+// @Accept   json
+// @Produce  json
+// @Param    body	body	contracts.NewSortedSetCacheValuesContract	true	"body"
+// @Success 201 {string} string	"created"
+// @Failure 400 {object} contracts.ErrorContract "bad request"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Router /api/sortedset/ [post]
+func PostSortedSetCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.PostSortedSetCacheKeyHandler(pid)
+}
+
+// PostSortedSetCacheValuesHandler .
+// @Description adds members to existing sorted set value by the key or updates scores of existing members like ZADD
+// @Summary adds members to existing sorted set value by the key
+// @Accept   json
+// @Produce  json
+// @Param    update-key	path	string	true	"update-key"
+// @Param    body	body	contracts.UpdateSortedSetCacheValuesContract	true	"body"
+// @Param    If-Match	header	string	false	"expected entry version"
+// @Success 200 {object} contracts.SortedSetCacheCountContract	"number of members added"
+// @Failure 400 {object} contracts.ErrorContract "bad request"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Failure 412 {object} contracts.ErrorContract "entry version does not match"
+// @Router /api/sortedset/{update-key} [post]
+func PostSortedSetCacheValuesHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.PostSortedSetCacheValuesHandler(pid)
+}
+
+// IncrementSortedSetCacheScoreHandler .
+// @Description adds delta to the score of the member like ZINCRBY, missing member is added with delta as its score
+// @Summary increments score of sorted set member
+// @Accept   json
+// @Produce  json
+// @Param    update-key	path	string	true	"update-key"
+// @Param    body	body	contracts.IncrementSortedSetCacheScoreContract	true	"body"
+// @Param    If-Match	header	string	false	"expected entry version"
+// @Success 200 {object} contracts.SortedSetCacheScoreContract	"new score of the member"
+// @Failure 400 {object} contracts.ErrorContract "bad request"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Failure 412 {object} contracts.ErrorContract "entry version does not match"
+// @Router /api/sortedset/{update-key}/incr [post]
+func IncrementSortedSetCacheScoreHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.IncrementSortedSetCacheScoreHandler(pid)
+}
+
+// DeleteSortedSetCacheValueHandler .
+// @Description removes the member from existing sorted set value by the key like ZREM
+// @Summary removes the member from existing sorted set value by the key
+// @Accept   json
+// @Produce  json
+// @Param    update-key	path	string	true	"update-key"
+// @Param    delete-member	path	string	true	"delete-member"
+// @Param    If-Match	header	string	false	"expected entry version"
+// @Success 204 {string} string	"no content"
+// @Failure 400 {object} contracts.ErrorContract "bad request"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Failure 412 {object} contracts.ErrorContract "entry version does not match"
+// @Router /api/sortedset/{update-key}/{delete-member} [delete]
+func DeleteSortedSetCacheValueHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.DeleteSortedSetCacheValueHandler(pid)
+}
+
+// GetSortedSetCacheRangeHandler .
+// @Description gets members of sorted set value by the range of zero-based ranks like ZRANGE, negative ranks are counted from the end
+// @Summary gets sorted set members by rank range
+// @Accept   json
+// @Produce  json
+// @Param    key	path	string	true	"key"
+// @Param    start	query	integer	false	"first rank, 0 by default"
+// @Param    stop	query	integer	false	"last rank inclusive, -1 by default"
+// @Param    reverse	query	boolean	false	"count ranks from the highest score"
+// @Success 200 {object} contracts.SortedSetCacheValuesContract	"members with scores"
+// @Failure 400 {object} contracts.ErrorContract "bad request"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Router /api/sortedset/{key}/range [get]
+func GetSortedSetCacheRangeHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.GetSortedSetCacheRangeHandler(pid)
+}
+
+// GetSortedSetCacheRangeByScoreHandler .
+// @Description gets members of sorted set value by the range of scores like ZRANGEBYSCORE, "(" prefix makes the bound exclusive
+// @Summary gets sorted set members by score range
+// @Accept   json
+// @Produce  json
+// @Param    key	path	string	true	"key"
+// @Param    min	query	string	false	"minimal score, -inf by default"
+// @Param    max	query	string	false	"maximal score, +inf by default"
+// @Param    offset	query	integer	false	"number of members to skip"
+// @Param    count	query	integer	false	"maximal number of members, all by default"
+// @Param    reverse	query	boolean	false	"return members in descending order"
+// @Success 200 {object} contracts.SortedSetCacheValuesContract	"members with scores"
+// @Failure 400 {object} contracts.ErrorContract "bad request"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Router /api/sortedset/{key}/rangebyscore [get]
+func GetSortedSetCacheRangeByScoreHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.GetSortedSetCacheRangeByScoreHandler(pid)
+}
+
+// GetSortedSetCacheRankHandler .
+// @Description gets zero-based rank and score of the sorted set member like ZRANK and ZREVRANK
+// @Summary gets rank of sorted set member
+// @Accept   json
+// @Produce  json
+// @Param    key	path	string	true	"key"
+// @Param    member	query	string	true	"member"
+// @Param    reverse	query	boolean	false	"count rank from the highest score"
+// @Success 200 {object} contracts.SortedSetCacheRankContract	"rank and score of the member"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Router /api/sortedset/{key}/rank [get]
+func GetSortedSetCacheRankHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.GetSortedSetCacheRankHandler(pid)
+}
+
+/* Document handlers for swagger */
+
+// GetDocumentCacheKeyHandler .
+// @Description gets JSON document by key, with path gets the value at JSON Pointer ("/a/0") or dot notation ("$.a[0]") path, with meta=true gets contracts.CacheEntryMetadataContract with timestamps, ttl, persisted flag, size and version instead
+// @Summary gets document cache entry by key
+// @Accept   json
+// @Produce  json
+// @Param    key	path	string	true	"key"
+// @Param    path	query	string	false	"path inside the document"
+// @Param    meta	query	boolean	false	"return entry metadata instead of value"
+// @Success 200 {object} contracts.DocumentCacheValueContract	"key and corresponding document or value at the path"
+// @Failure 400 {object} contracts.ErrorContract "bad request"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key or path was not found"
+// @Router /api/document/{key} [get]
+func GetDocumentCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.GetDocumentCacheKeyHandler(pid)
+}
+
+// DeleteDocumentCacheKeyHandler .
+// @Description deletes document cache entry by key, with path deletes only the value at the path
+// @Summary deletes document cache entry by key
+// @Accept   json
+// @Produce  json
+// @Param    deleted-key	path	string	true	"deleted-key"
+// @Param    path	query	string	false	"path inside the document"
+// @Param    If-Match	header	string	false	"expected entry version"
+// @Success 204 {string} string "no content"
+// @Failure 400 {object} contracts.ErrorContract "bad request"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key or path was not found"
+// @Failure 412 {object} contracts.ErrorContract "entry version does not match"
+// @Router /api/document/{deleted-key} [delete]
+func DeleteDocumentCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.DeleteDocumentCacheKeyHandler(pid)
+}
+
+// GetDocumentKeysHandler .
+// @Description gets all document cache keys
+// @Summary gets all document cache keys
+// @Accept   json
+// @Produce  json
+// @Success 200 {object} contracts.CacheKeysContract	"document cache keys"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Router /api/document [get]
+func GetDocumentKeysHandler(pid *act.BroadcastStringKeysGroup) func(*gin.Context) {
+	return controllers.GetCacheKeysHandler(pid)
+}
+
+// PostDocumentCacheKeyHandler .
+// @Description posts new JSON document
+// @Summary posts new JSON document
+// @Accept   json
+// @Produce  json
+// @Param    body	body	contracts.NewDocumentCacheValueContract	true	"body"
+// @Success 201 {string} string	"created"
+// @Failure 400 {object} contracts.ErrorContract "bad request"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Router /api/document/ [post]
+func PostDocumentCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.PostDocumentCacheKeyHandler(pid)
+}
+
+// PutDocumentCachePathHandler .
+// @Description adds or replaces the value at the path of the document, the parent object or array must exist, "-" as the last array index appends the value and empty path replaces the whole document
+// @Summary sets the value at the path of the document
+// @Accept   json
+// @Produce  json
+// @Param    update-key	path	string	true	"update-key"
+// @Param    body	body	contracts.UpdateDocumentCachePathContract	true	"body"
+// @Param    If-Match	header	string	false	"expected entry version"
+// @Success 204 {string} string	"no content"
+// @Failure 400 {object} contracts.ErrorContract "bad request"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key or path was not found"
+// @Failure 412 {object} contracts.ErrorContract "entry version does not match"
+// @Router /api/document/{update-key} [put]
+func PutDocumentCachePathHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.PutDocumentCachePathHandler(pid)
+}
+
+// PatchDocumentCacheHandler .
+// @Description applies JSON Patch (RFC 6902) operations with application/json-patch+json or JSON Merge Patch (RFC 7396) with application/merge-patch+json content type, with application/json an array is treated as JSON Patch; JSON Patch is applied all or nothing
+// @Summary patches the document
+// @Accept   json
+// @Produce  json
+// @Param    update-key	path	string	true	"update-key"
+// @Param    body	body	contracts.DocumentPatchOperationContract	true	"array of operations or merge patch"
+// @Param    If-Match	header	string	false	"expected entry version"
+// @Success 200 {object} contracts.DocumentCacheValueContract	"patched document"
+// @Failure 400 {object} contracts.ErrorContract "bad request"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Failure 412 {object} contracts.ErrorContract "entry version does not match"
+// @Router /api/document/{update-key} [patch]
+func PatchDocumentCacheHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.PatchDocumentCacheHandler(pid)
+}
+
+// AppendDocumentCacheValuesHandler .
+// @Description appends values to the array at the path of the document
+// @Summary appends values to the array inside the document
+// @Accept   json
+// @Produce  json
+// @Param    update-key	path	string	true	"update-key"
+// @Param    body	body	contracts.AppendDocumentCacheValuesContract	true	"body"
+// @Param    If-Match	header	string	false	"expected entry version"
+// @Success 200 {object} contracts.DocumentCacheLengthContract	"new length of the array"
+// @Failure 400 {object} contracts.ErrorContract "bad request"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key or path was not found"
+// @Failure 412 {object} contracts.ErrorContract "entry version does not match"
+// @Router /api/document/{update-key}/append [post]
+func AppendDocumentCacheValuesHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.AppendDocumentCacheValuesHandler(pid)
+}
+
+/* HyperLogLog handlers for swagger */
+
+// GetHyperLogLogCacheKeyHandler .
+// @Description gets the estimated number of distinct values of HyperLogLog by key like PFCOUNT, with "with" query parameter gets the estimate of the union of the HyperLogLogs and missing keys are skipped, with meta=true gets contracts.CacheEntryMetadataContract with timestamps, ttl, persisted flag, size and version instead
+// @Summary gets the estimated count of HyperLogLog by key
+// @Accept   json
+// @Produce  json
+// @Param    key	path	string	true	"key"
+// @Param    with	query	string	false	"comma-separated keys of other HyperLogLogs"
+// @Param    meta	query	boolean	false	"return entry metadata instead of value"
+// @Success 200 {object} contracts.HyperLogLogCacheCountContract	"key and estimated count"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Router /api/hyperloglog/{key} [get]
+func GetHyperLogLogCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.GetHyperLogLogCacheKeyHandler(pid)
+}
+
+// DeleteHyperLogLogCacheKeyHandler .
+// @Description deletes HyperLogLog cache entry by key
+// @Summary deletes HyperLogLog cache entry by key
+// @Accept   json
+// @Produce  json
+// @Param    deleted-key	path	string	true	"deleted-key"
+// @Param    If-Match	header	string	false	"expected entry version"
+// @Success 204 {string} string "no content"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Failure 412 {object} contracts.ErrorContract "entry version does not match"
+// @Router /api/hyperloglog/{deleted-key} [delete]
+func DeleteHyperLogLogCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.DeleteHyperLogLogCacheKeyHandler(pid)
+}
+
+// GetHyperLogLogKeysHandler .
+// @Description gets all HyperLogLog cache keys
+// @Summary gets all HyperLogLog cache keys
+// @Accept   json
+// @Produce  json
+// @Success 200 {object} contracts.CacheKeysContract	"HyperLogLog cache keys"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Router /api/hyperloglog [get]
+func GetHyperLogLogKeysHandler(pid *act.BroadcastStringKeysGroup) func(*gin.Context) {
+	return controllers.GetCacheKeysHandler(pid)
+}
+
+// PostHyperLogLogCacheKeyHandler .
+// @Description posts new HyperLogLog with optional values
+// @Summary posts new HyperLogLog
+// @Accept   json
+// @Produce  json
+// @Param    body	body	contracts.NewHyperLogLogCacheValuesContract	true	"body"
+// @Success 201 {string} string	"created"
+// @Failure 400 {object} contracts.ErrorContract "bad request"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Router /api/hyperloglog/ [post]
+func PostHyperLogLogCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.PostHyperLogLogCacheKeyHandler(pid)
+}
+
+// AddHyperLogLogCacheValuesHandler .
+// @Description adds values to HyperLogLog like PFADD, changed is true if the estimate could change, "create" option creates the missing key
+// @Summary adds values to HyperLogLog
+// @Accept   json
+// @Produce  json
+// @Param    update-key	path	string	true	"update-key"
+// @Param    body	body	contracts.AddHyperLogLogCacheValuesContract	true	"body"
+// @Param    If-Match	header	string	false	"expected entry version"
+// @Success 200 {object} contracts.HyperLogLogCacheAddContract	"changed flag and estimated count"
+// @Failure 400 {object} contracts.ErrorContract "bad request"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Failure 412 {object} contracts.ErrorContract "entry version does not match"
+// @Router /api/hyperloglog/{update-key} [post]
+func AddHyperLogLogCacheValuesHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.AddHyperLogLogCacheValuesHandler(pid)
+}
+
+// MergeHyperLogLogCacheHandler .
+// @Description merges the HyperLogLogs specified into the one by key like PFMERGE, the key is created if missing and missing sources are skipped
+// @Summary merges HyperLogLogs
+// @Accept   json
+// @Produce  json
+// @Param    update-key	path	string	true	"update-key"
+// @Param    body	body	contracts.MergeHyperLogLogCacheContract	true	"body"
+// @Param    If-Match	header	string	false	"expected entry version"
+// @Success 200 {object} contracts.HyperLogLogCacheCountContract	"estimated count of the merged HyperLogLog"
+// @Failure 400 {object} contracts.ErrorContract "bad request"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 412 {object} contracts.ErrorContract "entry version does not match"
+// @Router /api/hyperloglog/{update-key}/merge [post]
+func MergeHyperLogLogCacheHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.MergeHyperLogLogCacheHandler(pid)
+}
+
+/* Bloom filter handlers for swagger */
+
+// GetBloomFilterCacheKeyHandler .
+// @Description gets capacity, error rate, number of bits and hash functions and number of values added to Bloom filter by key, with meta=true gets contracts.CacheEntryMetadataContract with timestamps, ttl, persisted flag, size and version instead
+// @Summary gets Bloom filter info by key
+// @Accept   json
+// @Produce  json
+// @Param    key	path	string	true	"key"
+// @Param    meta	query	boolean	false	"return entry metadata instead of value"
+// @Success 200 {object} contracts.BloomFilterCacheInfoContract	"Bloom filter options and number of values"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Router /api/bloom/{key} [get]
+func GetBloomFilterCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.GetBloomFilterCacheKeyHandler(pid)
+}
+
+// DeleteBloomFilterCacheKeyHandler .
+// @Description deletes Bloom filter cache entry by key
+// @Summary deletes Bloom filter cache entry by key
+// @Accept   json
+// @Produce  json
+// @Param    deleted-key	path	string	true	"deleted-key"
+// @Param    If-Match	header	string	false	"expected entry version"
+// @Success 204 {string} string "no content"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Failure 412 {object} contracts.ErrorContract "entry version does not match"
+// @Router /api/bloom/{deleted-key} [delete]
+func DeleteBloomFilterCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.DeleteBloomFilterCacheKeyHandler(pid)
+}
+
+// GetBloomFilterKeysHandler .
+// @Description gets all Bloom filter cache keys
+// @Summary gets all Bloom filter cache keys
+// @Accept   json
+// @Produce  json
+// @Success 200 {object} contracts.CacheKeysContract	"Bloom filter cache keys"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Router /api/bloom [get]
+func GetBloomFilterKeysHandler(pid *act.BroadcastStringKeysGroup) func(*gin.Context) {
+	return controllers.GetCacheKeysHandler(pid)
+}
+
+// PostBloomFilterCacheKeyHandler .
+// @Description reserves new Bloom filter for the capacity and the false positive rate specified like BF.RESERVE, capacity is 100 and error rate is 0.01 by default
+// @Summary reserves new Bloom filter
+// @Accept   json
+// @Produce  json
+// @Param    body	body	contracts.NewBloomFilterCacheContract	true	"body"
+// @Success 201 {string} string	"created"
+// @Failure 400 {object} contracts.ErrorContract "bad request"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Router /api/bloom/ [post]
+func PostBloomFilterCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.PostBloomFilterCacheKeyHandler(pid)
+}
+
+// AddBloomFilterCacheValuesHandler .
+// @Description adds values to Bloom filter like BF.MADD, the result for each value is true if it was not in the filter before, "create" option creates the missing key with the capacity and the error rate specified
+// @Summary adds values to Bloom filter
+// @Accept   json
+// @Produce  json
+// @Param    update-key	path	string	true	"update-key"
+// @Param    body	body	contracts.AddBloomFilterCacheValuesContract	true	"body"
+// @Param    If-Match	header	string	false	"expected entry version"
+// @Success 200 {object} contracts.BloomFilterCacheResultsContract	"result for each value"
+// @Failure 400 {object} contracts.ErrorContract "bad request"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Failure 412 {object} contracts.ErrorContract "entry version does not match"
+// @Router /api/bloom/{update-key} [post]
+func AddBloomFilterCacheValuesHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.AddBloomFilterCacheValuesHandler(pid)
+}
+
+// ExistsBloomFilterCacheValuesHandler .
+// @Description checks the values in Bloom filter like BF.MEXISTS, the result for each value is true if it was probably added and false if it definitely was not
+// @Summary checks values in Bloom filter
+// @Accept   json
+// @Produce  json
+// @Param    key	path	string	true	"key"
+// @Param    value	query	string	true	"value, can be repeated"
+// @Success 200 {object} contracts.BloomFilterCacheResultsContract	"result for each value"
+// @Failure 400 {object} contracts.ErrorContract "bad request"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Router /api/bloom/{key}/exists [get]
+func ExistsBloomFilterCacheValuesHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.ExistsBloomFilterCacheValuesHandler(pid)
+}
+
+/* Stream handlers for swagger */
+
+// GetStreamCacheKeyHandler .
+// @Description gets the length, the capped length, the first and the last IDs and the number of consumer groups of stream by key like XINFO STREAM, with meta=true gets contracts.CacheEntryMetadataContract with timestamps, ttl, persisted flag, size and version instead
+// @Summary gets stream info by key
+// @Accept   json
+// @Produce  json
+// @Param    key	path	string	true	"key"
+// @Param    meta	query	boolean	false	"return entry metadata instead of value"
+// @Success 200 {object} contracts.StreamCacheInfoContract	"stream info"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Router /api/stream/{key} [get]
+func GetStreamCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.GetStreamCacheKeyHandler(pid)
+}
+
+// DeleteStreamCacheKeyHandler .
+// @Description deletes stream cache entry by key
+// @Summary deletes stream cache entry by key
+// @Accept   json
+// @Produce  json
+// @Param    deleted-key	path	string	true	"deleted-key"
+// @Param    If-Match	header	string	false	"expected entry version"
+// @Success 204 {string} string "no content"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Failure 412 {object} contracts.ErrorContract "entry version does not match"
+// @Router /api/stream/{deleted-key} [delete]
+func DeleteStreamCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.DeleteStreamCacheKeyHandler(pid)
+}
+
+// GetStreamKeysHandler .
+// @Description gets all stream cache keys
+// @Summary gets all stream cache keys
+// @Accept   json
+// @Produce  json
+// @Success 200 {object} contracts.CacheKeysContract	"stream cache keys"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Router /api/stream [get]
+func GetStreamKeysHandler(pid *act.BroadcastStringKeysGroup) func(*gin.Context) {
+	return controllers.GetCacheKeysHandler(pid)
+}
+
+// PostStreamCacheKeyHandler .
+// @Description posts new empty stream, positive maxLen caps the number of entries and the oldest entries are removed when new ones are appended
+// @Summary posts new stream
+// @Accept   json
+// @Produce  json
+// @Param    body	body	contracts.NewStreamCacheContract	true	"body"
+// @Success 201 {string} string	"created"
+// @Failure 400 {object} contracts.ErrorContract "bad request"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Router /api/stream/ [post]
+func PostStreamCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.PostStreamCacheKeyHandler(pid)
+}
+
+// AppendStreamCacheEntryHandler .
+// @Description appends the entry to stream like XADD, empty or "*" id is generated from the current time, "ms-*" id generates the sequence number, explicit id must be greater than the last one, maxLen trims the stream after the entry is appended, "create" option creates the missing key
+// @Summary appends entry to stream
+// @Accept   json
+// @Produce  json
+// @Param    update-key	path	string	true	"update-key"
+// @Param    body	body	contracts.AppendStreamCacheEntryContract	true	"body"
+// @Param    If-Match	header	string	false	"expected entry version"
+// @Success 200 {object} contracts.StreamCacheAppendContract	"id of the entry and new length of the stream"
+// @Failure 400 {object} contracts.ErrorContract "bad request"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Failure 412 {object} contracts.ErrorContract "entry version does not match"
+// @Router /api/stream/{update-key} [post]
+func AppendStreamCacheEntryHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.AppendStreamCacheEntryHandler(pid)
+}
+
+// GetStreamCacheRangeHandler .
+// @Description gets up to count entries with ids from start to end inclusive like XRANGE, "-" and "+" are the smallest and the greatest ids, reverse=true starts from the end like XREVRANGE
+// @Summary gets stream entries by range of ids
+// @Accept   json
+// @Produce  json
+// @Param    key	path	string	true	"key"
+// @Param    start	query	string	false	"start id, - by default"
+// @Param    end	query	string	false	"end id, + by default"
+// @Param    count	query	integer	false	"maximum number of entries, 0 means all"
+// @Param    reverse	query	boolean	false	"start from the end"
+// @Success 200 {object} contracts.StreamCacheEntriesContract	"stream entries"
+// @Failure 400 {object} contracts.ErrorContract "bad request"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Router /api/stream/{key}/range [get]
+func GetStreamCacheRangeHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.GetStreamCacheRangeHandler(pid)
+}
+
+// TrimStreamCacheKeyHandler .
+// @Description removes the oldest entries of stream so that at most maxLen entries are left like XTRIM, pending entries of the consumer groups are kept
+// @Summary trims stream
+// @Accept   json
+// @Produce  json
+// @Param    update-key	path	string	true	"update-key"
+// @Param    body	body	contracts.TrimStreamCacheContract	true	"body"
+// @Param    If-Match	header	string	false	"expected entry version"
+// @Success 200 {object} contracts.StreamCacheTrimContract	"number of entries removed and new length of the stream"
+// @Failure 400 {object} contracts.ErrorContract "bad request"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Failure 412 {object} contracts.ErrorContract "entry version does not match"
+// @Router /api/stream/{update-key}/trim [post]
+func TrimStreamCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.TrimStreamCacheKeyHandler(pid)
+}
+
+// GetStreamCacheGroupsHandler .
+// @Description gets the consumer groups of stream with the last delivered id and the number of pending entries and consumers like XINFO GROUPS
+// @Summary gets consumer groups of stream
+// @Accept   json
+// @Produce  json
+// @Param    key	path	string	true	"key"
+// @Success 200 {object} contracts.StreamCacheGroupsContract	"consumer groups"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Router /api/stream/{key}/groups [get]
+func GetStreamCacheGroupsHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.GetStreamCacheGroupsHandler(pid)
+}
+
+// CreateStreamCacheGroupHandler .
+// @Description adds the consumer group to stream like XGROUP CREATE, the group delivers the entries after start id, "$" or empty start means the last id of the stream, "create" option creates the missing key
+// @Summary creates consumer group
+// @Accept   json
+// @Produce  json
+// @Param    update-key	path	string	true	"update-key"
+// @Param    body	body	contracts.NewStreamGroupContract	true	"body"
+// @Success 201 {string} string	"created"
+// @Failure 400 {object} contracts.ErrorContract "bad request"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Router /api/stream/{update-key}/groups [post]
+func CreateStreamCacheGroupHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.CreateStreamCacheGroupHandler(pid)
+}
+
+// DeleteStreamCacheGroupHandler .
+// @Description removes the consumer group of stream with its pending entries like XGROUP DESTROY
+// @Summary deletes consumer group
+// @Accept   json
+// @Produce  json
+// @Param    update-key	path	string	true	"update-key"
+// @Param    group	path	string	true	"consumer group"
+// @Success 204 {string} string "no content"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key or group was not found"
+// @Router /api/stream/{update-key}/groups/{group} [delete]
+func DeleteStreamCacheGroupHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.DeleteStreamCacheGroupHandler(pid)
+}
+
+// ReadStreamCacheGroupHandler .
+// @Description delivers up to count entries to the consumer of the group like XREADGROUP, empty or ">" id reads the entries never delivered to the group and adds them to the pending entries of the consumer, other ids read the entries pending for the consumer after the id
+// @Summary reads stream entries by consumer group
+// @Accept   json
+// @Produce  json
+// @Param    update-key	path	string	true	"update-key"
+// @Param    group	path	string	true	"consumer group"
+// @Param    body	body	contracts.ReadStreamGroupContract	true	"body"
+// @Success 200 {object} contracts.StreamCacheEntriesContract	"entries delivered to the consumer"
+// @Failure 400 {object} contracts.ErrorContract "bad request"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key or group was not found"
+// @Router /api/stream/{update-key}/groups/{group}/read [post]
+func ReadStreamCacheGroupHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.ReadStreamCacheGroupHandler(pid)
+}
+
+// AckStreamCacheGroupHandler .
+// @Description acknowledges the pending entries of the consumer group like XACK, unknown ids are skipped
+// @Summary acknowledges stream entries
+// @Accept   json
+// @Produce  json
+// @Param    update-key	path	string	true	"update-key"
+// @Param    group	path	string	true	"consumer group"
+// @Param    body	body	contracts.AckStreamGroupContract	true	"body"
+// @Success 200 {object} contracts.StreamCacheAckContract	"number of entries acknowledged"
+// @Failure 400 {object} contracts.ErrorContract "bad request"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key or group was not found"
+// @Router /api/stream/{update-key}/groups/{group}/ack [post]
+func AckStreamCacheGroupHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.AckStreamCacheGroupHandler(pid)
+}
+
+// GetStreamCachePendingHandler .
+// @Description gets up to count entries delivered to the consumers of the group and not acknowledged yet with their idle time and number of deliveries like XPENDING
+// @Summary gets pending entries of consumer group
+// @Accept   json
+// @Produce  json
+// @Param    key	path	string	true	"key"
+// @Param    group	path	string	true	"consumer group"
+// @Param    consumer	query	string	false	"consumer, all consumers by default"
+// @Param    count	query	integer	false	"maximum number of entries, 0 means all"
+// @Success 200 {object} contracts.StreamCachePendingContract	"pending entries"
+// @Failure 400 {object} contracts.ErrorContract "bad request"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key or group was not found"
+// @Router /api/stream/{key}/groups/{group}/pending [get]
+func GetStreamCachePendingHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.GetStreamCachePendingHandler(pid)
+}
+
+/* Geo handlers for swagger */
+
+// GetGeoCacheKeyHandler .
+// @Description gets all the members of geo cache entry with their positions and geohashes sorted by member, with meta=true gets contracts.CacheEntryMetadataContract with timestamps, ttl, persisted flag, size and version instead
+// @Summary gets geo cache entry by key
+// @Accept   json
+// @Produce  json
+// @Param    key	path	string	true	"key"
+// @Param    meta	query	boolean	false	"return entry metadata instead of value"
+// @Success 200 {object} contracts.GeoCacheMembersContract	"members with positions"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Router /api/geo/{key} [get]
+func GetGeoCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.GetGeoCacheKeyHandler(pid)
+}
+
+// DeleteGeoCacheKeyHandler .
+// @Description deletes geo cache entry by key
+// @Summary deletes geo cache entry by key
+// @Accept   json
+// @Produce  json
+// @Param    deleted-key	path	string	true	"deleted-key"
+// @Param    If-Match	header	string	false	"expected entry version"
+// @Success 204 {string} string "no content"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Failure 412 {object} contracts.ErrorContract "entry version does not match"
+// @Router /api/geo/{deleted-key} [delete]
+func DeleteGeoCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.DeleteGeoCacheKeyHandler(pid)
+}
+
+// GetGeoKeysHandler .
+// @Description gets all geo cache keys
+// @Summary gets all geo cache keys
+// @Accept   json
+// @Produce  json
+// @Success 200 {object} contracts.CacheKeysContract	"geo cache keys"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Router /api/geo [get]
+func GetGeoKeysHandler(pid *act.BroadcastStringKeysGroup) func(*gin.Context) {
+	return controllers.GetCacheKeysHandler(pid)
+}
+
+// PostGeoCacheKeyHandler .
+// @Description posts new geo cache entry with the members specified, longitude must be within [-180, 180] and latitude within [-85.05112878, 85.05112878]
+// @Summary posts new geo cache entry
+// @Accept   json
+// @Produce  json
+// @Param    body	body	contracts.NewGeoCacheContract	true	"body"
+// @Success 201 {string} string	"created"
+// @Failure 400 {object} contracts.ErrorContract "bad request"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Router /api/geo/ [post]
+func PostGeoCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.PostGeoCacheKeyHandler(pid)
+}
+
+// AddGeoCacheMembersHandler .
+// @Description adds new members or moves the existing ones like GEOADD, nothing is changed if any position is invalid, "create" option creates the missing key
+// @Summary adds members to geo cache entry
+// @Accept   json
+// @Produce  json
+// @Param    update-key	path	string	true	"update-key"
+// @Param    body	body	contracts.AddGeoCacheMembersContract	true	"body"
+// @Param    If-Match	header	string	false	"expected entry version"
+// @Success 200 {object} contracts.GeoCacheAddContract	"number of members added and moved"
+// @Failure 400 {object} contracts.ErrorContract "bad request"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Failure 412 {object} contracts.ErrorContract "entry version does not match"
+// @Router /api/geo/{update-key} [post]
+func AddGeoCacheMembersHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.AddGeoCacheMembersHandler(pid)
+}
+
+// RemoveGeoCacheMemberHandler .
+// @Description removes the member from geo cache entry
+// @Summary removes member from geo cache entry
+// @Accept   json
+// @Produce  json
+// @Param    update-key	path	string	true	"update-key"
+// @Param    member	path	string	true	"member"
+// @Param    If-Match	header	string	false	"expected entry version"
+// @Success 204 {string} string "no content"
+// @Failure 400 {object} contracts.ErrorContract "member was not found"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Failure 412 {object} contracts.ErrorContract "entry version does not match"
+// @Router /api/geo/{update-key}/{member} [delete]
+func RemoveGeoCacheMemberHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.RemoveGeoCacheMemberHandler(pid)
+}
+
+// GetGeoCachePositionsHandler .
+// @Description gets the positions and geohashes of the members like GEOPOS, the members which were not found are listed separately
+// @Summary gets positions of geo members
+// @Accept   json
+// @Produce  json
+// @Param    key	path	string	true	"key"
+// @Param    members	query	string	true	"comma-separated members"
+// @Success 200 {object} contracts.GeoCachePositionsContract	"positions of the members"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Router /api/geo/{key}/positions [get]
+func GetGeoCachePositionsHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.GetGeoCachePositionsHandler(pid)
+}
+
+// GetGeoCacheDistanceHandler .
+// @Description gets the great-circle distance between two members like GEODIST in m, km, mi or ft
+// @Summary gets distance between geo members
+// @Accept   json
+// @Produce  json
+// @Param    key	path	string	true	"key"
+// @Param    from	query	string	true	"first member"
+// @Param    to	query	string	true	"second member"
+// @Param    unit	query	string	false	"m, km, mi or ft, m by default"
+// @Success 200 {object} contracts.GeoCacheDistanceContract	"distance"
+// @Failure 400 {object} contracts.ErrorContract "bad request"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key or member was not found"
+// @Router /api/geo/{key}/distance [get]
+func GetGeoCacheDistanceHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.GetGeoCacheDistanceHandler(pid)
+}
+
+// SearchGeoCacheHandler .
+// @Description finds the members within the radius or the width x height box around the member or the position like GEOSEARCH, the results are sorted by distance, nearest first unless sort=desc
+// @Summary searches geo members by radius or box
+// @Accept   json
+// @Produce  json
+// @Param    key	path	string	true	"key"
+// @Param    member	query	string	false	"member used as the center"
+// @Param    longitude	query	number	false	"longitude of the center"
+// @Param    latitude	query	number	false	"latitude of the center"
+// @Param    radius	query	number	false	"radius"
+// @Param    width	query	number	false	"width of the box"
+// @Param    height	query	number	false	"height of the box"
+// @Param    unit	query	string	false	"m, km, mi or ft, m by default"
+// @Param    count	query	integer	false	"maximum number of members, 0 means all"
+// @Param    sort	query	string	false	"asc or desc, asc by default"
+// @Success 200 {object} contracts.GeoCacheSearchContract	"members found with distances"
+// @Failure 400 {object} contracts.ErrorContract "bad request"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key or member was not found"
+// @Router /api/geo/{key}/search [get]
+func SearchGeoCacheHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.SearchGeoCacheHandler(pid)
+}
+
+/* Queue handlers for swagger */
+
+// GetQueueCacheKeyHandler .
+// @Description gets the number of ready, delayed, in-flight and dead items of queue cache entry with its visibility timeout and limit of delivery attempts, with meta=true gets contracts.CacheEntryMetadataContract with timestamps, ttl, persisted flag, size and version instead
+// @Summary gets queue cache entry by key
+// @Accept   json
+// @Produce  json
+// @Param    key	path	string	true	"key"
+// @Param    meta	query	boolean	false	"return entry metadata instead of value"
+// @Success 200 {object} contracts.QueueCacheInfoContract	"number of items"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Router /api/queue/{key} [get]
+func GetQueueCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.GetQueueCacheKeyHandler(pid)
+}
+
+// DeleteQueueCacheKeyHandler .
+// @Description deletes queue cache entry by key with its in-flight items and dead letters
+// @Summary deletes queue cache entry by key
+// @Accept   json
+// @Produce  json
+// @Param    deleted-key	path	string	true	"deleted-key"
+// @Param    If-Match	header	string	false	"expected entry version"
+// @Success 204 {string} string "no content"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Failure 412 {object} contracts.ErrorContract "entry version does not match"
+// @Router /api/queue/{deleted-key} [delete]
+func DeleteQueueCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.DeleteQueueCacheKeyHandler(pid)
+}
+
+// GetQueueKeysHandler .
+// @Description gets all queue cache keys
+// @Summary gets all queue cache keys
+// @Accept   json
+// @Produce  json
+// @Success 200 {object} contracts.CacheKeysContract	"queue cache keys"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Router /api/queue [get]
+func GetQueueKeysHandler(pid *act.BroadcastStringKeysGroup) func(*gin.Context) {
+	return controllers.GetCacheKeysHandler(pid)
+}
+
+// PostQueueCacheKeyHandler .
+// @Description posts new queue cache entry with the items specified, visibility is the default time the delivered items stay in flight (30s if empty), zero maxAttempts means that the items are never moved to the dead letters
+// @Summary posts new queue cache entry
+// @Accept   json
+// @Produce  json
+// @Param    body	body	contracts.NewQueueCacheContract	true	"body"
+// @Success 201 {string} string	"created"
+// @Failure 400 {object} contracts.ErrorContract "bad request"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Router /api/queue/ [post]
+func PostQueueCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.PostQueueCacheKeyHandler(pid)
+}
+
+// PushQueueCacheItemsHandler .
+// @Description pushes the items with their priorities and delays, delay is a duration or the time the item can be delivered after, "create" option creates the missing key
+// @Summary pushes items to queue cache entry
+// @Accept   json
+// @Produce  json
+// @Param    update-key	path	string	true	"update-key"
+// @Param    body	body	contracts.PushQueueCacheContract	true	"body"
+// @Param    If-Match	header	string	false	"expected entry version"
+// @Success 200 {object} contracts.QueueCachePushContract	"IDs of the items pushed"
+// @Failure 400 {object} contracts.ErrorContract "bad request"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Failure 412 {object} contracts.ErrorContract "entry version does not match"
+// @Router /api/queue/{update-key} [post]
+func PushQueueCacheItemsHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.PushQueueCacheItemsHandler(pid)
+}
+
+// PopQueueCacheItemsHandler .
+// @Description delivers up to count (1 by default) items with the highest priority which can be delivered now, the items stay in flight until they are acknowledged by their receipts or the visibility timeout passes
+// @Summary pops items from queue cache entry
+// @Accept   json
+// @Produce  json
+// @Param    update-key	path	string	true	"update-key"
+// @Param    body	body	contracts.PopQueueCacheContract	true	"body"
+// @Success 200 {object} contracts.QueueCacheItemsContract	"items delivered with their receipts"
+// @Failure 400 {object} contracts.ErrorContract "bad request"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Router /api/queue/{update-key}/pop [post]
+func PopQueueCacheItemsHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.PopQueueCacheItemsHandler(pid)
+}
+
+// PeekQueueCacheItemsHandler .
+// @Description gets up to count (1 by default) items which would be delivered next without delivering them
+// @Summary peeks items of queue cache entry
+// @Accept   json
+// @Produce  json
+// @Param    key	path	string	true	"key"
+// @Param    count	query	int	false	"maximum number of items"
+// @Success 200 {object} contracts.QueueCacheItemsContract	"items which would be delivered next"
+// @Failure 400 {object} contracts.ErrorContract "bad request"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Router /api/queue/{key}/peek [get]
+func PeekQueueCacheItemsHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.PeekQueueCacheItemsHandler(pid)
+}
+
+// AckQueueCacheItemsHandler .
+// @Description removes the delivered items by their receipts, the receipts of the items which returned to the queue after the visibility timeout are ignored
+// @Summary acknowledges items of queue cache entry
+// @Accept   json
+// @Produce  json
+// @Param    update-key	path	string	true	"update-key"
+// @Param    body	body	contracts.AckQueueCacheContract	true	"body"
+// @Success 200 {object} contracts.QueueCacheAckContract	"number of items acknowledged"
+// @Failure 400 {object} contracts.ErrorContract "bad request"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Router /api/queue/{update-key}/ack [post]
+func AckQueueCacheItemsHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.AckQueueCacheItemsHandler(pid)
+}
+
+// NackQueueCacheItemHandler .
+// @Description returns the delivered item to the queue to be delivered again after the delay, the item is moved to the dead letters if it was delivered maxAttempts times
+// @Summary returns item to queue cache entry
+// @Accept   json
+// @Produce  json
+// @Param    update-key	path	string	true	"update-key"
+// @Param    body	body	contracts.NackQueueCacheContract	true	"body"
+// @Success 200 {object} contracts.QueueCacheNackContract	"dead flag"
+// @Failure 400 {object} contracts.ErrorContract "bad request"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key or receipt was not found"
+// @Router /api/queue/{update-key}/nack [post]
+func NackQueueCacheItemHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.NackQueueCacheItemHandler(pid)
+}
+
+// GetQueueCacheDeadHandler .
+// @Description gets up to count dead letters of the queue in the order they died, zero count means all of them
+// @Summary gets dead letters of queue cache entry
+// @Accept   json
+// @Produce  json
+// @Param    key	path	string	true	"key"
+// @Param    count	query	int	false	"maximum number of items"
+// @Success 200 {object} contracts.QueueCacheItemsContract	"dead letters"
+// @Failure 400 {object} contracts.ErrorContract "bad request"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Router /api/queue/{key}/dead [get]
+func GetQueueCacheDeadHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.GetQueueCacheDeadHandler(pid)
+}
+
+// RedriveQueueCacheDeadHandler .
+// @Description moves up to count dead letters back to the queue resetting their attempts, zero count means all of them
+// @Summary redrives dead letters of queue cache entry
+// @Accept   json
+// @Produce  json
+// @Param    update-key	path	string	true	"update-key"
+// @Param    body	body	contracts.RedriveQueueCacheContract	true	"body"
+// @Success 200 {object} contracts.QueueCacheRedriveContract	"number of items redriven"
+// @Failure 400 {object} contracts.ErrorContract "bad request"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Router /api/queue/{update-key}/redrive [post]
+func RedriveQueueCacheDeadHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.RedriveQueueCacheDeadHandler(pid)
+}
+
+/* Rate limit handlers for swagger */
+
+// GetRateLimitCacheKeyHandler .
+// @Description gets the limits, the remaining capacity and the time after which the rate limiter is full again without taking anything, with meta=true gets contracts.CacheEntryMetadataContract with timestamps, ttl, persisted flag, size and version instead
+// @Summary gets rate limiter by key
+// @Accept   json
+// @Produce  json
+// @Param    key	path	string	true	"key"
+// @Param    meta	query	boolean	false	"return entry metadata instead of value"
+// @Success 200 {object} contracts.RateLimitInfoContract	"limits and remaining capacity"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Router /api/ratelimit/{key} [get]
+func GetRateLimitCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.GetRateLimitCacheKeyHandler(pid)
+}
+
+// DeleteRateLimitCacheKeyHandler .
+// @Description deletes the rate limiter by key, so it is full again
+// @Summary resets rate limiter by key
+// @Accept   json
+// @Produce  json
+// @Param    deleted-key	path	string	true	"deleted-key"
+// @Param    If-Match	header	string	false	"expected entry version"
+// @Success 204 {string} string "no content"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Failure 412 {object} contracts.ErrorContract "entry version does not match"
+// @Router /api/ratelimit/{deleted-key} [delete]
+func DeleteRateLimitCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.DeleteRateLimitCacheKeyHandler(pid)
+}
+
+// GetRateLimitKeysHandler .
+// @Description gets all rate limiter keys, the rate limiter is removed when it is full again
+// @Summary gets all rate limiter keys
+// @Accept   json
+// @Produce  json
+// @Success 200 {object} contracts.CacheKeysContract	"rate limiter keys"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Router /api/ratelimit [get]
+func GetRateLimitKeysHandler(pid *act.BroadcastStringKeysGroup) func(*gin.Context) {
+	return controllers.GetCacheKeysHandler(pid)
+}
+
+// TakeRateLimitCacheKeyHandler .
+// @Description takes the cost (1 by default) from the rate limiter if it is allowed, the missing rate limiter is created full. Algorithm is token-bucket (default) refilled at refillRate units per second or sliding-log which allows capacity units within the window (capacity / refillRate by default). The cost which is not allowed is not taken, retryAfter is the time after which it would be allowed. The limits of the existing rate limiter are changed if they differ
+// @Summary takes cost from rate limiter
+// @Accept   json
+// @Produce  json
+// @Param    update-key	path	string	true	"update-key"
+// @Param    body	body	contracts.TakeRateLimitContract	true	"body"
+// @Success 200 {object} contracts.RateLimitContract	"allowed flag, remaining capacity and retry time"
+// @Failure 400 {object} contracts.ErrorContract "bad request"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Router /api/ratelimit/{update-key} [post]
+func TakeRateLimitCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.TakeRateLimitCacheKeyHandler(pid)
+}
+
+/* Lock handlers for swagger */
+
+// GetLockCacheKeyHandler .
+// @Description gets the fencing token, the acquisition time and the remaining lease of the held lock, the owner token is never returned. With meta=true gets contracts.CacheEntryMetadataContract with timestamps, ttl, persisted flag, size and version instead
+// @Summary gets held lock by key
+// @Accept   json
+// @Produce  json
+// @Param    key	path	string	true	"key"
+// @Param    meta	query	boolean	false	"return entry metadata instead of value"
+// @Success 200 {object} contracts.LockInfoContract	"fencing token and remaining lease"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "lock is not held"
+// @Router /api/lock/{key} [get]
+func GetLockCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.GetLockCacheKeyHandler(pid)
+}
+
+// GetLockKeysHandler .
+// @Description gets keys of all held locks, the lock is removed when it is released or its lease ends
+// @Summary gets all lock keys
+// @Accept   json
+// @Produce  json
+// @Success 200 {object} contracts.CacheKeysContract	"lock keys"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Router /api/lock [get]
+func GetLockKeysHandler(pid *act.BroadcastStringKeysGroup) func(*gin.Context) {
+	return controllers.GetCacheKeysHandler(pid)
+}
+
+// AcquireLockCacheKeyHandler .
+// @Description acquires the lock for the owner with the lease, the owner token is generated if it is not specified. The fencing token increases with each acquisition of the lock, so the storage guarded by the lock can reject writes with an older token. The owner which already holds the lock gets its lease renewed with the same fencing token. The lock held by another owner is not acquired and the lease replied is the time remaining until it expires
+// @Summary acquires lock
+// @Accept   json
+// @Produce  json
+// @Param    update-key	path	string	true	"update-key"
+// @Param    body	body	contracts.AcquireLockContract	true	"body"
+// @Success 200 {object} contracts.LockContract	"acquired flag, owner and fencing token"
+// @Failure 400 {object} contracts.ErrorContract "bad request"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Router /api/lock/{update-key} [post]
+func AcquireLockCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.AcquireLockCacheKeyHandler(pid)
+}
+
+// RenewLockCacheKeyHandler .
+// @Description extends the lease of the lock held by the owner, the fencing token is not changed
+// @Summary renews lock
+// @Accept   json
+// @Produce  json
+// @Param    update-key	path	string	true	"update-key"
+// @Param    body	body	contracts.RenewLockContract	true	"body"
+// @Success 200 {object} contracts.LockContract	"fencing token and lease"
+// @Failure 400 {object} contracts.ErrorContract "bad request"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "lock is not held"
+// @Failure 409 {object} contracts.ErrorContract "lock is held by another owner"
+// @Router /api/lock/{update-key} [put]
+func RenewLockCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.RenewLockCacheKeyHandler(pid)
+}
+
+// ReleaseLockCacheKeyHandler .
+// @Description releases the lock if it is held by the owner
+// @Summary releases lock
+// @Accept   json
+// @Produce  json
+// @Param    deleted-key	path	string	true	"deleted-key"
+// @Param    owner	query	string	true	"owner token"
+// @Success 204 {string} string "no content"
+// @Failure 400 {object} contracts.ErrorContract "bad request"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "lock is not held"
+// @Failure 409 {object} contracts.ErrorContract "lock is held by another owner"
+// @Router /api/lock/{deleted-key} [delete]
+func ReleaseLockCacheKeyHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.ReleaseLockCacheKeyHandler(pid)
+}
+
+/* TTL handlers for swagger */
+
+// GetStringCacheTTLHandler .
+// @Description gets remaining ttl and expiration time of string cache entry by key
+// @Summary gets ttl of string cache entry by key
+// @Accept   json
+// @Produce  json
+// @Param    key	path	string	true	"key"
+// @Success 200 {object} contracts.CacheTTLContract	"remaining ttl, expiration time and sliding flag"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Router /api/string-ttl/{key} [get]
+func GetStringCacheTTLHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.GetCacheTTLHandler(pid)
+}
+
+// PutStringCacheTTLHandler .
+// @Description sets new ttl of string cache entry by key keeping its value, sliding ttl is renewed each time the entry is read or updated
+// @Summary sets ttl of string cache entry by key
+// @Accept   json
+// @Produce  json
+// @Param    update-key	path	string	true	"update-key"
+// @Param    body	body	contracts.UpdateCacheTTLContract	true	"body"
+// @Success 204 {string} string	"no content"
+// @Failure 400 {object} contracts.ErrorContract "bad request"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Router /api/string-ttl/{update-key} [put]
+func PutStringCacheTTLHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.PutCacheTTLHandler(pid)
+}
+
+// DeleteStringCacheTTLHandler .
+// @Description removes ttl of string cache entry by key, so it never expires
+// @Summary removes ttl of string cache entry by key
+// @Accept   json
+// @Produce  json
+// @Param    update-key	path	string	true	"update-key"
+// @Success 204 {string} string	"no content"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Router /api/string-ttl/{update-key} [delete]
+func DeleteStringCacheTTLHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.DeleteCacheTTLHandler(pid)
+}
+
+// GetListCacheTTLHandler .
+// @Description gets remaining ttl and expiration time of list cache entry by key
+// @Summary gets ttl of list cache entry by key
+// @Accept   json
+// @Produce  json
+// @Param    key	path	string	true	"key"
+// @Success 200 {object} contracts.CacheTTLContract	"remaining ttl, expiration time and sliding flag"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Router /api/list-ttl/{key} [get]
+func GetListCacheTTLHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.GetCacheTTLHandler(pid)
+}
+
+// PutListCacheTTLHandler .
+// @Description sets new ttl of list cache entry by key keeping its value, sliding ttl is renewed each time the entry is read or updated
+// @Summary sets ttl of list cache entry by key
+// @Accept   json
+// @Produce  json
+// @Param    update-key	path	string	true	"update-key"
+// @Param    body	body	contracts.UpdateCacheTTLContract	true	"body"
+// @Success 204 {string} string	"no content"
+// @Failure 400 {object} contracts.ErrorContract "bad request"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Router /api/list-ttl/{update-key} [put]
+func PutListCacheTTLHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.PutCacheTTLHandler(pid)
+}
+
+// DeleteListCacheTTLHandler .
+// @Description removes ttl of list cache entry by key, so it never expires
+// @Summary removes ttl of list cache entry by key
+// @Accept   json
+// @Produce  json
+// @Param    update-key	path	string	true	"update-key"
+// @Success 204 {string} string	"no content"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Router /api/list-ttl/{update-key} [delete]
+func DeleteListCacheTTLHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.DeleteCacheTTLHandler(pid)
+}
+
+// GetDictionaryCacheTTLHandler .
+// @Description gets remaining ttl and expiration time of dictionary cache entry by key
+// @Summary gets ttl of dictionary cache entry by key
+// @Accept   json
+// @Produce  json
+// @Param    key	path	string	true	"key"
+// @Success 200 {object} contracts.CacheTTLContract	"remaining ttl, expiration time and sliding flag"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Router /api/dictionary-ttl/{key} [get]
+func GetDictionaryCacheTTLHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.GetCacheTTLHandler(pid)
+}
+
+// PutDictionaryCacheTTLHandler .
+// @Description sets new ttl of dictionary cache entry by key keeping its value, sliding ttl is renewed each time the entry is read or updated
+// @Summary sets ttl of dictionary cache entry by key
+// @Accept   json
+// @Produce  json
+// @Param    update-key	path	string	true	"update-key"
+// @Param    body	body	contracts.UpdateCacheTTLContract	true	"body"
+// @Success 204 {string} string	"no content"
+// @Failure 400 {object} contracts.ErrorContract "bad request"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Router /api/dictionary-ttl/{update-key} [put]
+func PutDictionaryCacheTTLHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.PutCacheTTLHandler(pid)
+}
+
+// DeleteDictionaryCacheTTLHandler .
+// @Description removes ttl of dictionary cache entry by key, so it never expires
+// @Summary removes ttl of dictionary cache entry by key
+// @Accept   json
+// @Produce  json
+// @Param    update-key	path	string	true	"update-key"
+// @Success 204 {string} string	"no content"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Router /api/dictionary-ttl/{update-key} [delete]
+func DeleteDictionaryCacheTTLHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.DeleteCacheTTLHandler(pid)
+}
+
+// GetSetCacheTTLHandler .
+// @Description gets remaining ttl and expiration time of set cache entry by key
+// @Summary gets ttl of set cache entry by key
+// @Accept   json
+// @Produce  json
+// @Param    key	path	string	true	"key"
+// @Success 200 {object} contracts.CacheTTLContract	"remaining ttl, expiration time and sliding flag"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Router /api/set-ttl/{key} [get]
+func GetSetCacheTTLHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.GetCacheTTLHandler(pid)
+}
+
+// PutSetCacheTTLHandler .
+// @Description sets new ttl of set cache entry by key keeping its value, sliding ttl is renewed each time the entry is read or updated
+// @Summary sets ttl of set cache entry by key
+// @Accept   json
+// @Produce  json
+// @Param    update-key	path	string	true	"update-key"
+// @Param    body	body	contracts.UpdateCacheTTLContract	true	"body"
+// @Success 204 {string} string	"no content"
+// @Failure 400 {object} contracts.ErrorContract "bad request"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Router /api/set-ttl/{update-key} [put]
+func PutSetCacheTTLHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.PutCacheTTLHandler(pid)
+}
+
+// DeleteSetCacheTTLHandler .
+// @Description removes ttl of set cache entry by key, so it never expires
+// @Summary removes ttl of set cache entry by key
+// @Accept   json
+// @Produce  json
+// @Param    update-key	path	string	true	"update-key"
+// @Success 204 {string} string	"no content"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Router /api/set-ttl/{update-key} [delete]
+func DeleteSetCacheTTLHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.DeleteCacheTTLHandler(pid)
+}
+
+// GetSortedSetCacheTTLHandler .
+// @Description gets remaining ttl and expiration time of sorted set cache entry by key
+// @Summary gets ttl of sorted set cache entry by key
+// @Accept   json
+// @Produce  json
+// @Param    key	path	string	true	"key"
+// @Success 200 {object} contracts.CacheTTLContract	"remaining ttl, expiration time and sliding flag"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Router /api/sortedset-ttl/{key} [get]
+func GetSortedSetCacheTTLHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.GetCacheTTLHandler(pid)
+}
+
+// PutSortedSetCacheTTLHandler .
+// @Description sets new ttl of sorted set cache entry by key keeping its value, sliding ttl is renewed each time the entry is read or updated
+// @Summary sets ttl of sorted set cache entry by key
+// @Accept   json
+// @Produce  json
+// @Param    update-key	path	string	true	"update-key"
+// @Param    body	body	contracts.UpdateCacheTTLContract	true	"body"
+// @Success 204 {string} string	"no content"
+// @Failure 400 {object} contracts.ErrorContract "bad request"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Router /api/sortedset-ttl/{update-key} [put]
+func PutSortedSetCacheTTLHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.PutCacheTTLHandler(pid)
+}
+
+// DeleteSortedSetCacheTTLHandler .
+// @Description removes ttl of sorted set cache entry by key, so it never expires
+// @Summary removes ttl of sorted set cache entry by key
+// @Accept   json
+// @Produce  json
+// @Param    update-key	path	string	true	"update-key"
+// @Success 204 {string} string	"no content"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Router /api/sortedset-ttl/{update-key} [delete]
+func DeleteSortedSetCacheTTLHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.DeleteCacheTTLHandler(pid)
+}
+
+// GetDocumentCacheTTLHandler .
+// @Description gets remaining ttl and expiration time of document cache entry by key
+// @Summary gets ttl of document cache entry by key
+// @Accept   json
+// @Produce  json
+// @Param    key	path	string	true	"key"
+// @Success 200 {object} contracts.CacheTTLContract	"remaining ttl, expiration time and sliding flag"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Router /api/document-ttl/{key} [get]
+func GetDocumentCacheTTLHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.GetCacheTTLHandler(pid)
+}
+
+// PutDocumentCacheTTLHandler .
+// @Description sets new ttl of document cache entry by key keeping its value, sliding ttl is renewed each time the entry is read or updated
+// @Summary sets ttl of document cache entry by key
+// @Accept   json
+// @Produce  json
+// @Param    update-key	path	string	true	"update-key"
+// @Param    body	body	contracts.UpdateCacheTTLContract	true	"body"
+// @Success 204 {string} string	"no content"
+// @Failure 400 {object} contracts.ErrorContract "bad request"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Router /api/document-ttl/{update-key} [put]
+func PutDocumentCacheTTLHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.PutCacheTTLHandler(pid)
+}
+
+// DeleteDocumentCacheTTLHandler .
+// @Description removes ttl of document cache entry by key, so it never expires
+// @Summary removes ttl of document cache entry by key
+// @Accept   json
+// @Produce  json
+// @Param    update-key	path	string	true	"update-key"
+// @Success 204 {string} string	"no content"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Router /api/document-ttl/{update-key} [delete]
+func DeleteDocumentCacheTTLHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.DeleteCacheTTLHandler(pid)
+}
+
+// GetHyperLogLogCacheTTLHandler .
+// @Description gets remaining ttl and expiration time of HyperLogLog cache entry by key
+// @Summary gets ttl of HyperLogLog cache entry by key
+// @Accept   json
+// @Produce  json
+// @Param    key	path	string	true	"key"
+// @Success 200 {object} contracts.CacheTTLContract	"remaining ttl, expiration time and sliding flag"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Router /api/hyperloglog-ttl/{key} [get]
+func GetHyperLogLogCacheTTLHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.GetCacheTTLHandler(pid)
+}
+
+// PutHyperLogLogCacheTTLHandler .
+// @Description sets new ttl of HyperLogLog cache entry by key keeping its value, sliding ttl is renewed each time the entry is read or updated
+// @Summary sets ttl of HyperLogLog cache entry by key
+// @Accept   json
+// @Produce  json
+// @Param    update-key	path	string	true	"update-key"
+// @Param    body	body	contracts.UpdateCacheTTLContract	true	"body"
+// @Success 204 {string} string	"no content"
+// @Failure 400 {object} contracts.ErrorContract "bad request"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Router /api/hyperloglog-ttl/{update-key} [put]
+func PutHyperLogLogCacheTTLHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.PutCacheTTLHandler(pid)
+}
+
+// DeleteHyperLogLogCacheTTLHandler .
+// @Description removes ttl of HyperLogLog cache entry by key, so it never expires
+// @Summary removes ttl of HyperLogLog cache entry by key
+// @Accept   json
+// @Produce  json
+// @Param    update-key	path	string	true	"update-key"
+// @Success 204 {string} string	"no content"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Router /api/hyperloglog-ttl/{update-key} [delete]
+func DeleteHyperLogLogCacheTTLHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.DeleteCacheTTLHandler(pid)
+}
+
+// GetBloomFilterCacheTTLHandler .
+// @Description gets remaining ttl and expiration time of Bloom filter cache entry by key
+// @Summary gets ttl of Bloom filter cache entry by key
+// @Accept   json
+// @Produce  json
+// @Param    key	path	string	true	"key"
+// @Success 200 {object} contracts.CacheTTLContract	"remaining ttl, expiration time and sliding flag"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Router /api/bloom-ttl/{key} [get]
+func GetBloomFilterCacheTTLHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.GetCacheTTLHandler(pid)
+}
+
+// PutBloomFilterCacheTTLHandler .
+// @Description sets new ttl of Bloom filter cache entry by key keeping its value, sliding ttl is renewed each time the entry is read or updated
+// @Summary sets ttl of Bloom filter cache entry by key
+// @Accept   json
+// @Produce  json
+// @Param    update-key	path	string	true	"update-key"
+// @Param    body	body	contracts.UpdateCacheTTLContract	true	"body"
+// @Success 204 {string} string	"no content"
+// @Failure 400 {object} contracts.ErrorContract "bad request"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Router /api/bloom-ttl/{update-key} [put]
+func PutBloomFilterCacheTTLHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.PutCacheTTLHandler(pid)
+}
+
+// DeleteBloomFilterCacheTTLHandler .
+// @Description removes ttl of Bloom filter cache entry by key, so it never expires
+// @Summary removes ttl of Bloom filter cache entry by key
+// @Accept   json
+// @Produce  json
+// @Param    update-key	path	string	true	"update-key"
+// @Success 204 {string} string	"no content"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Router /api/bloom-ttl/{update-key} [delete]
+func DeleteBloomFilterCacheTTLHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.DeleteCacheTTLHandler(pid)
+}
+
+// GetStreamCacheTTLHandler .
+// @Description gets remaining ttl and expiration time of stream cache entry by key
+// @Summary gets ttl of stream cache entry by key
+// @Accept   json
+// @Produce  json
+// @Param    key	path	string	true	"key"
+// @Success 200 {object} contracts.CacheTTLContract	"remaining ttl, expiration time and sliding flag"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Router /api/stream-ttl/{key} [get]
+func GetStreamCacheTTLHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.GetCacheTTLHandler(pid)
+}
+
+// PutStreamCacheTTLHandler .
+// @Description sets new ttl of stream cache entry by key keeping its value, sliding ttl is renewed each time the entry is read or updated
+// @Summary sets ttl of stream cache entry by key
+// @Accept   json
+// @Produce  json
+// @Param    update-key	path	string	true	"update-key"
+// @Param    body	body	contracts.UpdateCacheTTLContract	true	"body"
+// @Success 204 {string} string	"no content"
+// @Failure 400 {object} contracts.ErrorContract "bad request"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Router /api/stream-ttl/{update-key} [put]
+func PutStreamCacheTTLHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.PutCacheTTLHandler(pid)
+}
+
+// DeleteStreamCacheTTLHandler .
+// @Description removes ttl of stream cache entry by key, so it never expires
+// @Summary removes ttl of stream cache entry by key
+// @Accept   json
+// @Produce  json
+// @Param    update-key	path	string	true	"update-key"
+// @Success 204 {string} string	"no content"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Router /api/stream-ttl/{update-key} [delete]
+func DeleteStreamCacheTTLHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.DeleteCacheTTLHandler(pid)
+}
+
+// GetGeoCacheTTLHandler .
+// @Description gets remaining ttl and expiration time of geo cache entry by key
+// @Summary gets ttl of geo cache entry by key
+// @Accept   json
+// @Produce  json
+// @Param    key	path	string	true	"key"
+// @Success 200 {object} contracts.CacheTTLContract	"remaining ttl, expiration time and sliding flag"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Router /api/geo-ttl/{key} [get]
+func GetGeoCacheTTLHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.GetCacheTTLHandler(pid)
+}
+
+// PutGeoCacheTTLHandler .
+// @Description sets new ttl of geo cache entry by key keeping its value, sliding ttl is renewed each time the entry is read or updated
+// @Summary sets ttl of geo cache entry by key
+// @Accept   json
+// @Produce  json
+// @Param    update-key	path	string	true	"update-key"
+// @Param    body	body	contracts.UpdateCacheTTLContract	true	"body"
+// @Success 204 {string} string	"no content"
+// @Failure 400 {object} contracts.ErrorContract "bad request"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Router /api/geo-ttl/{update-key} [put]
+func PutGeoCacheTTLHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.PutCacheTTLHandler(pid)
+}
+
+// DeleteGeoCacheTTLHandler .
+// @Description removes ttl of geo cache entry by key, so it never expires
+// @Summary removes ttl of geo cache entry by key
+// @Accept   json
+// @Produce  json
+// @Param    update-key	path	string	true	"update-key"
+// @Success 204 {string} string	"no content"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Router /api/geo-ttl/{update-key} [delete]
+func DeleteGeoCacheTTLHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.DeleteCacheTTLHandler(pid)
+}
+
+// GetQueueCacheTTLHandler .
+// @Description gets remaining ttl and expiration time of queue cache entry by key
+// @Summary gets ttl of queue cache entry by key
+// @Accept   json
+// @Produce  json
+// @Param    key	path	string	true	"key"
+// @Success 200 {object} contracts.CacheTTLContract	"remaining ttl, expiration time and sliding flag"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Router /api/queue-ttl/{key} [get]
+func GetQueueCacheTTLHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.GetCacheTTLHandler(pid)
+}
+
+// PutQueueCacheTTLHandler .
+// @Description sets new ttl of queue cache entry by key keeping its value, sliding ttl is renewed each time the entry is read or updated
+// @Summary sets ttl of queue cache entry by key
+// @Accept   json
+// @Produce  json
+// @Param    update-key	path	string	true	"update-key"
+// @Param    body	body	contracts.UpdateCacheTTLContract	true	"body"
+// @Success 204 {string} string	"no content"
+// @Failure 400 {object} contracts.ErrorContract "bad request"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Router /api/queue-ttl/{update-key} [put]
+func PutQueueCacheTTLHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.PutCacheTTLHandler(pid)
+}
+
+// DeleteQueueCacheTTLHandler .
+// @Description removes ttl of queue cache entry by key, so it never expires
+// @Summary removes ttl of queue cache entry by key
+// @Accept   json
+// @Produce  json
+// @Param    update-key	path	string	true	"update-key"
+// @Success 204 {string} string	"no content"
+// @Failure 500 {object} contracts.ErrorContract "server error"
+// @Failure 404 {object} contracts.ErrorContract "key was not found"
+// @Router /api/queue-ttl/{update-key} [delete]
+func DeleteQueueCacheTTLHandler(pid *actor.PID) func(*gin.Context) {
+	return controllers.DeleteCacheTTLHandler(pid)
+}
+
+/* Stats handlers for swagger */
+
+// GetCacheStatsHandler .
+// @Description gets number of keys, their size, number of evicted and reaped expired keys for each cache type
+// @Summary gets cache usage counters
+// @Accept   json
+// @Produce  json
+// @Success 200 {object} contracts.CacheStatsContract	"cache usage counters"
+// @Router /api/stats [get]
+func GetCacheStatsHandler(groups controllers.CacheStatsGroups) func(*gin.Context) {
+	return controllers.GetCacheStatsHandler(groups)
+}
+
+// serveProtocol starts the listener in background unless its port is 0.
+// The errors are only logged, so the Web API keeps working if e.g. Redis already uses the port.
+func serveProtocol(name string, port string, listenAndServe func(address string) error) {
+	if port == "0" {
+		log.Printf("[%s] Listener is turned off", name)
+		return
+	}
+	go func() {
+		if err := listenAndServe(":" + port); err != nil {
+			log.Printf("[%s] Listener stopped: %v", name, err)
+		}
+	}()
+}
+
+// @title Memory cache based on Go Swagger API
+// @version 1.0
+// @description This is a memory cache based on Go.
+func main() {
+	args := api.NewCommandArgs()
+	if args.IsRemote {
+		remote.Start("127.0.0.1:50000")
+	}
+	pid, bpid, cpid := act.NewStringCacheActorCluster("memcache", args.ActorNumber, args.UsePersistence, args.IsRemote, args.CacheOptions)
+	lpid, lbpid, lcpid := act.NewListCacheActorCluster("memcache", args.ActorNumber, args.UsePersistence, args.IsRemote, args.CacheOptions)
+	dpid, dbpid, dcpid := act.NewDictionaryCacheActorCluster("memcache", args.ActorNumber, args.UsePersistence, args.IsRemote, args.CacheOptions)
+	spid, sbpid, scpid := act.NewSetCacheActorCluster("memcache", args.ActorNumber, args.UsePersistence, args.IsRemote, args.CacheOptions)
+	zpid, zbpid, zcpid := act.NewSortedSetCacheActorCluster("memcache", args.ActorNumber, args.UsePersistence, args.IsRemote, args.CacheOptions)
+	jpid, jbpid, jcpid := act.NewDocumentCacheActorCluster("memcache", args.ActorNumber, args.UsePersistence, args.IsRemote, args.CacheOptions)
+	hpid, hbpid, hcpid := act.NewHyperLogLogCacheActorCluster("memcache", args.ActorNumber, args.UsePersistence, args.IsRemote, args.CacheOptions)
+	fpid, fbpid, fcpid := act.NewBloomFilterCacheActorCluster("memcache", args.ActorNumber, args.UsePersistence, args.IsRemote, args.CacheOptions)
+	tpid, tbpid, tcpid := act.NewStreamCacheActorCluster("memcache", args.ActorNumber, args.UsePersistence, args.IsRemote, args.CacheOptions)
+	gpid, gbpid, gcpid := act.NewGeoCacheActorCluster("memcache", args.ActorNumber, args.UsePersistence, args.IsRemote, args.CacheOptions)
+	qpid, qbpid, qcpid := act.NewQueueCacheActorCluster("memcache", args.ActorNumber, args.UsePersistence, args.IsRemote, args.CacheOptions)
+	rpid, rbpid, rcpid := act.NewRateLimitCacheActorCluster("memcache", args.ActorNumber, args.UsePersistence, args.IsRemote, args.CacheOptions)
+	kpid, kbpid, kcpid := act.NewLockCacheActorCluster("memcache", args.ActorNumber, args.UsePersistence, args.IsRemote, args.CacheOptions)
+	log.Printf("Started with %d actors per cache", args.ActorNumber)
+	serveProtocol("RESP", args.RESPPort, resp.NewServer(pid, cpid, lpid, dpid).ListenAndServe)
+	serveProtocol("memcached", args.MemcachedPort, memcached.NewServer(pid).ListenAndServe)
+	grpcServer := &rpc.Server{
+		Strings:         pid,
+		StringKeys:      cpid,
+		Lists:           lpid,
+		ListKeys:        lcpid,
+		Dictionaries:    dpid,
+		DictionaryKeys:  dcpid,
+		Sets:            spid,
+		SetKeys:         scpid,
+		SortedSets:      zpid,
+		SortedSetKeys:   zcpid,
+		Documents:       jpid,
+		DocumentKeys:    jcpid,
+		HyperLogLogs:    hpid,
+		HyperLogLogKeys: hcpid,
+		BloomFilters:    fpid,
+		BloomFilterKeys: fcpid,
+		Streams:         tpid,
+		StreamKeys:      tcpid,
+		Geos:            gpid,
+		GeoKeys:         gcpid,
+		Queues:          qpid,
+		QueueKeys:       qcpid,
+		RateLimits:      rpid,
+		RateLimitKeys:   rcpid,
+		Locks:           kpid,
+		LockKeys:        kcpid}
+	serveProtocol("gRPC", args.GRPCPort, grpcServer.ListenAndServe)
+
+	router := gin.Default()
+	api := router.Group("/api")
+	{
+		str := api.Group("/string")
+		{
+			str.GET("/", GetCacheKeysHandler(cpid))
+			str.GET("/:key", GetStringCacheKeyHandler(pid))
+			str.POST("/", PostStringCacheKeyHandler(pid))
+			str.PUT("/:key", PutStringCacheKeyHandler(pid))
+			str.DELETE("/:key", DeleteStringCacheKeyHandler(pid))
+			str.POST("/:key/incr", IncrementStringCacheKeyHandler(pid))
+			str.POST("/:key/decr", DecrementStringCacheKeyHandler(pid))
+			str.GET("/:key/bit", GetBitStringCacheKeyHandler(pid))
+			str.POST("/:key/bit", SetBitStringCacheKeyHandler(pid))
+			str.GET("/:key/bitcount", CountBitsStringCacheKeyHandler(pid))
+			str.GET("/:key/bitpos", GetBitPosStringCacheKeyHandler(pid))
+			str.POST("/:key/bitop", BitOpStringCacheKeyHandler(pid))
+			str.POST("/:key/bitfield", BitFieldStringCacheKeyHandler(pid))
+		}
+		list := api.Group("/list")
+		{
+			list.GET("/", GetListKeysHandler(lcpid))
+			list.GET("/:key", GetListCacheKeyHandler(lpid))
+			list.POST("/", PostListCacheKeyHandler(lpid))
+			list.POST("/:key", PostListCacheValueHandler(lpid))
+			list.POST("/:key/:operation", controllers.WithOperationRoutes("operation", map[string]func(*gin.Context){
+				"set":    SetListCacheIndexHandler(lpid),
+				"insert": InsertListCacheValueHandler(lpid),
+				"remove": RemoveListCacheIndexHandler(lpid),
+				"lpush":  PushLeftListCacheValuesHandler(lpid),
+				"rpush":  PushRightListCacheValuesHandler(lpid),
+				"lpop":   PopLeftListCacheValuesHandler(lpid),
+				"rpop":   PopRightListCacheValuesHandler(lpid),
+				"trim":   TrimListCacheKeyHandler(lpid)}))
+			list.GET("/:key/:operation", controllers.WithOperationRoutes("operation", map[string]func(*gin.Context){
+				"index": GetListCacheIndexHandler(lpid),
+				"range": GetListCacheRangeHandler(lpid)}))
+			list.PUT("/:key/:value", PutListCacheValueHandler(lpid))
+			list.DELETE("/:key", DeleteListCacheKeyHandler(lpid))
+			list.DELETE("/:key/:value", DeleteListCacheValueHandler(lpid))
+		}
+		d := api.Group("/dictionary")
+		{
+			d.GET("/", GetDictionaryKeysHandler(dcpid))
+			d.GET("/:key", GetDictionaryCacheKeyHandler(dpid))
+			d.POST("/", PostDictionaryCacheKeyHandler(dpid))
+			d.POST("/:key", PostDictionaryCacheValueHandler(dpid))
+			d.POST("/:key/:subkey/incr", IncrementDictionaryCacheValueHandler(dpid))
+			d.POST("/:key/:subkey/decr", DecrementDictionaryCacheValueHandler(dpid))
+			d.GET("/:key/:operation", controllers.WithOperationRoutes("operation", map[string]func(*gin.Context){
+				"values":  GetDictionaryCacheValuesHandler(dpid),
+				"subkeys": GetDictionaryCacheSubKeysHandler(dpid),
+				"exists":  ContainsDictionaryCacheValuesHandler(dpid)}))
+			d.PATCH("/:key", PatchDictionaryCacheValuesHandler(dpid))
+			d.PUT("/:key/:subkey", PutDictionaryCacheValueHandler(dpid))
+			d.DELETE("/:key", controllers.WithQueryRoute("subkey", DeleteDictionaryCacheValuesHandler(dpid), DeleteDictionaryCacheKeyHandler(dpid)))
+			d.DELETE("/:key/:subkey", DeleteDictionaryCacheValueHandler(dpid))
+		}
+		set := api.Group("/set")
+		{
+			set.GET("/", GetSetKeysHandler(scpid))
+			set.GET("/:key", GetSetCacheKeyHandler(spid))
+			set.POST("/", PostSetCacheKeyHandler(spid))
+			set.POST("/:key", PostSetCacheValuesHandler(spid))
+			set.GET("/:key/:operation", controllers.WithOperationRoutes("operation", map[string]func(*gin.Context){
+				"contains":     ContainsSetCacheValueHandler(spid),
+				"cardinality":  GetSetCacheCardinalityHandler(spid),
+				"random":       GetSetCacheRandomValuesHandler(spid),
+				"union":        GetSetCacheUnionHandler(spid),
+				"intersection": GetSetCacheIntersectionHandler(spid),
+				"difference":   GetSetCacheDifferenceHandler(spid)}))
+			set.DELETE("/:key", DeleteSetCacheKeyHandler(spid))
+			set.DELETE("/:key/:value", DeleteSetCacheValueHandler(spid))
+		}
+		z := api.Group("/sortedset")
+		{
+			z.GET("/", GetSortedSetKeysHandler(zcpid))
+			z.GET("/:key", GetSortedSetCacheKeyHandler(zpid))
+			z.POST("/", PostSortedSetCacheKeyHandler(zpid))
+			z.POST("/:key", PostSortedSetCacheValuesHandler(zpid))
+			z.POST("/:key/:operation", controllers.WithOperationRoutes("operation", map[string]func(*gin.Context){
+				"incr": IncrementSortedSetCacheScoreHandler(zpid)}))
+			z.GET("/:key/:operation", controllers.WithOperationRoutes("operation", map[string]func(*gin.Context){
+				"range":        GetSortedSetCacheRangeHandler(zpid),
+				"rangebyscore": GetSortedSetCacheRangeByScoreHandler(zpid),
+				"rank":         GetSortedSetCacheRankHandler(zpid)}))
+			z.DELETE("/:key", DeleteSortedSetCacheKeyHandler(zpid))
+			z.DELETE("/:key/:member", DeleteSortedSetCacheValueHandler(zpid))
+		}
+		doc := api.Group("/document")
+		{
+			doc.GET("/", GetDocumentKeysHandler(jcpid))
+			doc.GET("/:key", GetDocumentCacheKeyHandler(jpid))
+			doc.POST("/", PostDocumentCacheKeyHandler(jpid))
+			doc.POST("/:key/append", AppendDocumentCacheValuesHandler(jpid))
+			doc.PUT("/:key", PutDocumentCachePathHandler(jpid))
+			doc.PATCH("/:key", PatchDocumentCacheHandler(jpid))
+			doc.DELETE("/:key", DeleteDocumentCacheKeyHandler(jpid))
+		}
+		hll := api.Group("/hyperloglog")
+		{
+			hll.GET("/", GetHyperLogLogKeysHandler(hcpid))
+			hll.GET("/:key", GetHyperLogLogCacheKeyHandler(hpid))
+			hll.POST("/", PostHyperLogLogCacheKeyHandler(hpid))
+			hll.POST("/:key", AddHyperLogLogCacheValuesHandler(hpid))
+			hll.POST("/:key/merge", MergeHyperLogLogCacheHandler(hpid))
+			hll.DELETE("/:key", DeleteHyperLogLogCacheKeyHandler(hpid))
+		}
+		bloom := api.Group("/bloom")
+		{
+			bloom.GET("/", GetBloomFilterKeysHandler(fcpid))
+			bloom.GET("/:key", GetBloomFilterCacheKeyHandler(fpid))
+			bloom.POST("/", PostBloomFilterCacheKeyHandler(fpid))
+			bloom.POST("/:key", AddBloomFilterCacheValuesHandler(fpid))
+			bloom.GET("/:key/:operation", controllers.WithOperationRoutes("operation", map[string]func(*gin.Context){
+				"exists": ExistsBloomFilterCacheValuesHandler(fpid)}))
+			bloom.DELETE("/:key", DeleteBloomFilterCacheKeyHandler(fpid))
+		}
+		stream := api.Group("/stream")
+		{
+			stream.GET("/", GetStreamKeysHandler(tcpid))
+			stream.GET("/:key", GetStreamCacheKeyHandler(tpid))
+			stream.GET("/:key/:operation", controllers.WithOperationRoutes("operation", map[string]func(*gin.Context){
+				"range":  GetStreamCacheRangeHandler(tpid),
+				"groups": GetStreamCacheGroupsHandler(tpid)}))
+			stream.GET("/:key/:operation/:group/:action", controllers.WithOperationRoutes("operation", map[string]func(*gin.Context){
+				"groups": controllers.WithOperationRoutes("action", map[string]func(*gin.Context){
+					"pending": GetStreamCachePendingHandler(tpid)})}))
+			stream.POST("/", PostStreamCacheKeyHandler(tpid))
+			stream.POST("/:key", AppendStreamCacheEntryHandler(tpid))
+			stream.POST("/:key/:operation", controllers.WithOperationRoutes("operation", map[string]func(*gin.Context){
+				"trim":   TrimStreamCacheKeyHandler(tpid),
+				"groups": CreateStreamCacheGroupHandler(tpid)}))
+			stream.POST("/:key/:operation/:group/:action", controllers.WithOperationRoutes("operation", map[string]func(*gin.Context){
+				"groups": controllers.WithOperationRoutes("action", map[string]func(*gin.Context){
+					"read": ReadStreamCacheGroupHandler(tpid),
+					"ack":  AckStreamCacheGroupHandler(tpid)})}))
+			stream.DELETE("/:key", DeleteStreamCacheKeyHandler(tpid))
+			stream.DELETE("/:key/:operation/:group", controllers.WithOperationRoutes("operation", map[string]func(*gin.Context){
+				"groups": DeleteStreamCacheGroupHandler(tpid)}))
+		}
+		geo := api.Group("/geo")
+		{
+			geo.GET("/", GetGeoKeysHandler(gcpid))
+			geo.GET("/:key", GetGeoCacheKeyHandler(gpid))
+			geo.GET("/:key/:operation", controllers.WithOperationRoutes("operation", map[string]func(*gin.Context){
+				"positions": GetGeoCachePositionsHandler(gpid),
+				"distance":  GetGeoCacheDistanceHandler(gpid),
+				"search":    SearchGeoCacheHandler(gpid)}))
+			geo.POST("/", PostGeoCacheKeyHandler(gpid))
+			geo.POST("/:key", AddGeoCacheMembersHandler(gpid))
+			geo.DELETE("/:key", DeleteGeoCacheKeyHandler(gpid))
+			geo.DELETE("/:key/:member", RemoveGeoCacheMemberHandler(gpid))
+		}
+		queue := api.Group("/queue")
+		{
+			queue.GET("/", GetQueueKeysHandler(qcpid))
+			queue.GET("/:key", GetQueueCacheKeyHandler(qpid))
+			queue.GET("/:key/:operation", controllers.WithOperationRoutes("operation", map[string]func(*gin.Context){
+				"peek": PeekQueueCacheItemsHandler(qpid),
+				"dead": GetQueueCacheDeadHandler(qpid)}))
+			queue.POST("/", PostQueueCacheKeyHandler(qpid))
+			queue.POST("/:key", PushQueueCacheItemsHandler(qpid))
+			queue.POST("/:key/:operation", controllers.WithOperationRoutes("operation", map[string]func(*gin.Context){
+				"pop":     PopQueueCacheItemsHandler(qpid),
+				"ack":     AckQueueCacheItemsHandler(qpid),
+				"nack":    NackQueueCacheItemHandler(qpid),
+				"redrive": RedriveQueueCacheDeadHandler(qpid)}))
+			queue.DELETE("/:key", DeleteQueueCacheKeyHandler(qpid))
+		}
+		api.GET("/string-ttl/:key", GetStringCacheTTLHandler(pid))
+		api.PUT("/string-ttl/:key", PutStringCacheTTLHandler(pid))
+		api.DELETE("/string-ttl/:key", DeleteStringCacheTTLHandler(pid))
+		api.GET("/list-ttl/:key", GetListCacheTTLHandler(lpid))
+		api.PUT("/list-ttl/:key", PutListCacheTTLHandler(lpid))
+		api.DELETE("/list-ttl/:key", DeleteListCacheTTLHandler(lpid))
+		api.GET("/dictionary-ttl/:key", GetDictionaryCacheTTLHandler(dpid))
+		api.PUT("/dictionary-ttl/:key", PutDictionaryCacheTTLHandler(dpid))
+		api.DELETE("/dictionary-ttl/:key", DeleteDictionaryCacheTTLHandler(dpid))
+		api.GET("/set-ttl/:key", GetSetCacheTTLHandler(spid))
+		api.PUT("/set-ttl/:key", PutSetCacheTTLHandler(spid))
+		api.DELETE("/set-ttl/:key", DeleteSetCacheTTLHandler(spid))
+		api.GET("/sortedset-ttl/:key", GetSortedSetCacheTTLHandler(zpid))
+		api.PUT("/sortedset-ttl/:key", PutSortedSetCacheTTLHandler(zpid))
+		api.DELETE("/sortedset-ttl/:key", DeleteSortedSetCacheTTLHandler(zpid))
+		api.GET("/document-ttl/:key", GetDocumentCacheTTLHandler(jpid))
+		api.PUT("/document-ttl/:key", PutDocumentCacheTTLHandler(jpid))
+		api.DELETE("/document-ttl/:key", DeleteDocumentCacheTTLHandler(jpid))
+		api.GET("/hyperloglog-ttl/:key", GetHyperLogLogCacheTTLHandler(hpid))
+		api.PUT("/hyperloglog-ttl/:key", PutHyperLogLogCacheTTLHandler(hpid))
+		api.DELETE("/hyperloglog-ttl/:key", DeleteHyperLogLogCacheTTLHandler(hpid))
+		api.GET("/bloom-ttl/:key", GetBloomFilterCacheTTLHandler(fpid))
+		api.PUT("/bloom-ttl/:key", PutBloomFilterCacheTTLHandler(fpid))
+		api.DELETE("/bloom-ttl/:key", DeleteBloomFilterCacheTTLHandler(fpid))
+		api.GET("/stream-ttl/:key", GetStreamCacheTTLHandler(tpid))
+		api.PUT("/stream-ttl/:key", PutStreamCacheTTLHandler(tpid))
+		api.DELETE("/stream-ttl/:key", DeleteStreamCacheTTLHandler(tpid))
+		api.GET("/geo-ttl/:key", GetGeoCacheTTLHandler(gpid))
+		api.PUT("/geo-ttl/:key", PutGeoCacheTTLHandler(gpid))
+		api.DELETE("/geo-ttl/:key", DeleteGeoCacheTTLHandler(gpid))
+		api.GET("/queue-ttl/:key", GetQueueCacheTTLHandler(qpid))
+		api.PUT("/queue-ttl/:key", PutQueueCacheTTLHandler(qpid))
+		api.DELETE("/queue-ttl/:key", DeleteQueueCacheTTLHandler(qpid))
+		ratelimit := api.Group("/ratelimit")
+		{
+			ratelimit.GET("/", GetRateLimitKeysHandler(rcpid))
+			ratelimit.GET("/:key", GetRateLimitCacheKeyHandler(rpid))
+			ratelimit.POST("/:key", TakeRateLimitCacheKeyHandler(rpid))
+			ratelimit.DELETE("/:key", DeleteRateLimitCacheKeyHandler(rpid))
+		}
+		lock := api.Group("/lock")
+		{
+			lock.GET("/", GetLockKeysHandler(kcpid))
+			lock.GET("/:key", GetLockCacheKeyHandler(kpid))
+			lock.POST("/:key", AcquireLockCacheKeyHandler(kpid))
+			lock.PUT("/:key", RenewLockCacheKeyHandler(kpid))
+			lock.DELETE("/:key", ReleaseLockCacheKeyHandler(kpid))
 		}
+		api.GET("/stats", GetCacheStatsHandler(controllers.CacheStatsGroups{
+			String:      cpid,
+			List:        lcpid,
+			Dictionary:  dcpid,
+			Set:         scpid,
+			SortedSet:   zcpid,
+			Document:    jcpid,
+			HyperLogLog: hcpid,
+			BloomFilter: fcpid,
+			Stream:      tcpid,
+			Geo:         gcpid,
+			Queue:       qcpid,
+			RateLimit:   rcpid,
+			Lock:        kcpid}))
 	}
 	router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 
@@ -345,6 +2884,16 @@ func main() {
 			bpid.Stop()
 			lbpid.Stop()
 			dbpid.Stop()
+			sbpid.Stop()
+			zbpid.Stop()
+			jbpid.Stop()
+			hbpid.Stop()
+			fbpid.Stop()
+			tbpid.Stop()
+			gbpid.Stop()
+			qbpid.Stop()
+			rbpid.Stop()
+			kbpid.Stop()
 			if args.UsePersistence {
 				time.Sleep(1 * time.Second)
 			}
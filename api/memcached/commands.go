@@ -0,0 +1,224 @@
+package memcached
+
+import (
+	"bufio"
+	"fmt"
+	"github.com/VitalKrasilnikau/memcache/core/actors"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"time"
+)
+
+const (
+	maxKeyLength = 250
+	// maxItemSize is the largest value accepted by the storage commands, like the default item size limit of memcached.
+	maxItemSize = 1024 * 1024
+	// exptime values greater than 30 days are absolute Unix times.
+	maxRelativeExpiration = 60 * 60 * 24 * 30
+)
+
+func (s *Server) get(w *bufio.Writer, args []string, withVersion bool) bool {
+	if len(args) == 0 {
+		w.WriteString("ERROR\r\n")
+		return true
+	}
+	for _, key := range args {
+		reply, _ := act.AwaitReply(s.Strings, &act.GetStringCacheKeyMessage{Key: key}).(*act.GetStringCacheKeyReply)
+		if !reply.Success {
+			continue
+		}
+		if withVersion {
+			fmt.Fprintf(w, "VALUE %s %d %d %d\r\n", key, reply.Flags, len(reply.Value), reply.Version)
+		} else {
+			fmt.Fprintf(w, "VALUE %s %d %d\r\n", key, reply.Flags, len(reply.Value))
+		}
+		w.WriteString(reply.Value)
+		w.WriteString("\r\n")
+	}
+	w.WriteString("END\r\n")
+	return true
+}
+
+// store handles "<command> <key> <flags> <exptime> <bytes> [<cas unique>] [noreply]" followed by the data block.
+func (s *Server) store(r *bufio.Reader, w *bufio.Writer, name string, args []string) bool {
+	n := 4
+	if name == "cas" {
+		n = 5
+	}
+	if len(args) < n || len(args) > n+1 {
+		w.WriteString("ERROR\r\n")
+		return true
+	}
+	key := args[0]
+	flags, err := strconv.ParseUint(args[1], 10, 32)
+	exptime, err2 := strconv.ParseInt(args[2], 10, 64)
+	size, err3 := strconv.Atoi(args[3])
+	if err != nil || err2 != nil || err3 != nil || size < 0 {
+		w.WriteString("CLIENT_ERROR bad command line format\r\n")
+		return true
+	}
+	var version int64
+	if name == "cas" {
+		if version, err = strconv.ParseInt(args[4], 10, 64); err != nil {
+			w.WriteString("CLIENT_ERROR bad command line format\r\n")
+			return true
+		}
+	}
+	noreply := len(args) == n+1 && args[n] == "noreply"
+	if size > maxItemSize {
+		// The data block is skipped without reading it to memory, so the next command is parsed correctly.
+		if _, err = io.CopyN(ioutil.Discard, r, int64(size)); err != nil {
+			return false
+		}
+		if _, err = io.CopyN(ioutil.Discard, r, 2); err != nil {
+			return false
+		}
+		w.WriteString("SERVER_ERROR object too large for cache\r\n")
+		return true
+	}
+	data := make([]byte, size+2)
+	if _, err = io.ReadFull(r, data); err != nil {
+		return false
+	}
+	if data[size] != '\r' || data[size+1] != '\n' {
+		w.WriteString("CLIENT_ERROR bad data chunk\r\n")
+		return true
+	}
+	if !isValidKey(key) {
+		w.WriteString("CLIENT_ERROR bad command line format\r\n")
+		return true
+	}
+	value := string(data[:size])
+	ttl, expired := expiration(exptime)
+	var res string
+	switch {
+	case expired:
+		res = s.storeExpired(name, key, version)
+	case name == "set":
+		act.AwaitReply(s.Strings, &act.SetStringCacheKeyMessage{Key: key, Value: value, Flags: uint32(flags), TTL: ttl})
+		res = "STORED"
+	case name == "add":
+		reply, _ := act.AwaitReply(s.Strings, &act.PostStringCacheKeyMessage{Key: key, Value: value, Flags: uint32(flags), TTL: ttl}).(*act.PostStringCacheKeyReply)
+		res = storeResult(reply.Success)
+	case name == "replace":
+		reply, _ := act.AwaitReply(s.Strings, &act.ReplaceStringCacheKeyMessage{Key: key, Value: value, Flags: uint32(flags), TTL: ttl}).(*act.ReplaceStringCacheKeyReply)
+		res = storeResult(reply.Success)
+	case name == "cas":
+		reply, _ := act.AwaitReply(s.Strings, &act.CompareAndSwapStringCacheKeyMessage{Key: key, Value: value, Flags: uint32(flags), TTL: ttl, Version: version}).(*act.CompareAndSwapStringCacheKeyReply)
+		if reply.Success {
+			res = "STORED"
+		} else if reply.Exists {
+			res = "EXISTS"
+		} else {
+			res = "NOT_FOUND"
+		}
+	}
+	if !noreply {
+		w.WriteString(res + "\r\n")
+	}
+	return true
+}
+
+// delete handles "delete <key> [noreply]".
+func (s *Server) delete(w *bufio.Writer, args []string) bool {
+	if len(args) == 0 || len(args) > 2 {
+		w.WriteString("ERROR\r\n")
+		return true
+	}
+	reply, _ := act.AwaitReply(s.Strings, &act.DeleteStringCacheKeyMessage{Key: args[0]}).(*act.DeleteStringCacheKeyReply)
+	if len(args) == 2 && args[1] == "noreply" {
+		return true
+	}
+	if reply.Success {
+		w.WriteString("DELETED\r\n")
+	} else {
+		w.WriteString("NOT_FOUND\r\n")
+	}
+	return true
+}
+
+// touch handles "touch <key> <exptime> [noreply]".
+func (s *Server) touch(w *bufio.Writer, args []string) bool {
+	if len(args) < 2 || len(args) > 3 {
+		w.WriteString("ERROR\r\n")
+		return true
+	}
+	exptime, err := strconv.ParseInt(args[1], 10, 64)
+	if err != nil {
+		w.WriteString("CLIENT_ERROR invalid exptime argument\r\n")
+		return true
+	}
+	ttl, expired := expiration(exptime)
+	var ok bool
+	if expired {
+		reply, _ := act.AwaitReply(s.Strings, &act.DeleteStringCacheKeyMessage{Key: args[0]}).(*act.DeleteStringCacheKeyReply)
+		ok = reply.Success
+	} else {
+		reply, _ := act.AwaitReply(s.Strings, &act.TouchStringCacheKeyMessage{Key: args[0], TTL: ttl}).(*act.TouchStringCacheKeyReply)
+		ok = reply.Success
+	}
+	if len(args) == 3 && args[2] == "noreply" {
+		return true
+	}
+	if ok {
+		w.WriteString("TOUCHED\r\n")
+	} else {
+		w.WriteString("NOT_FOUND\r\n")
+	}
+	return true
+}
+
+// storeExpired handles the storage command with exptime in the past, the item would expire at once,
+// so it is not stored and the existing item is deleted if the command would have replaced it.
+func (s *Server) storeExpired(name string, key string, version int64) string {
+	switch name {
+	case "add":
+		reply, _ := act.AwaitReply(s.Strings, &act.GetCacheTTLMessage{Key: key}).(*act.GetCacheTTLReply)
+		return storeResult(!reply.Success)
+	case "replace":
+		reply, _ := act.AwaitReply(s.Strings, &act.DeleteStringCacheKeyMessage{Key: key}).(*act.DeleteStringCacheKeyReply)
+		return storeResult(reply.Success)
+	case "cas":
+		reply, _ := act.AwaitReply(s.Strings, &act.DeleteStringCacheKeyMessage{Key: key, Version: version}).(*act.DeleteStringCacheKeyReply)
+		if reply.Success {
+			return "STORED"
+		} else if reply.Conflict {
+			return "EXISTS"
+		}
+		return "NOT_FOUND"
+	}
+	act.AwaitReply(s.Strings, &act.DeleteStringCacheKeyMessage{Key: key})
+	return "STORED"
+}
+
+// expiration converts memcached exptime to ttl, the second result is true if the item is already expired.
+func expiration(exptime int64) (time.Duration, bool) {
+	if exptime < 0 {
+		return 0, true
+	}
+	if exptime > maxRelativeExpiration {
+		ttl := time.Until(time.Unix(exptime, 0))
+		return ttl, ttl <= 0
+	}
+	return time.Duration(exptime) * time.Second, false
+}
+
+func storeResult(ok bool) string {
+	if ok {
+		return "STORED"
+	}
+	return "NOT_STORED"
+}
+
+func isValidKey(key string) bool {
+	if len(key) == 0 || len(key) > maxKeyLength {
+		return false
+	}
+	for i := 0; i < len(key); i++ {
+		if key[i] <= ' ' || key[i] == 0x7f {
+			return false
+		}
+	}
+	return true
+}
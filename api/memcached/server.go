@@ -0,0 +1,91 @@
+package memcached
+
+import (
+	"bufio"
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"io"
+	"log"
+	"net"
+	"strings"
+)
+
+// Server is a memcached text protocol front-end for the string cache actor cluster.
+type Server struct {
+	Strings *actor.PID
+}
+
+// NewServer creates new Server which routes commands to the string cache actor cluster.
+func NewServer(pid *actor.PID) *Server {
+	return &Server{Strings: pid}
+}
+
+// ListenAndServe accepts memcached connections on the TCP address specified.
+func (s *Server) ListenAndServe(address string) error {
+	l, err := net.Listen("tcp", address)
+	if err != nil {
+		return err
+	}
+	defer l.Close()
+	log.Printf("[memcached] Listening on %s", address)
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			return err
+		}
+		go s.serve(conn)
+	}
+}
+
+func (s *Server) serve(conn net.Conn) {
+	defer conn.Close()
+	defer func() {
+		if err := recover(); err != nil {
+			log.Printf("[memcached] %s: closed after panic: %v", conn.RemoteAddr(), err)
+		}
+	}()
+	r := bufio.NewReader(conn)
+	w := bufio.NewWriter(conn)
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			if err != io.EOF {
+				log.Printf("[memcached] %s: %v", conn.RemoteAddr(), err)
+			}
+			return
+		}
+		args := strings.Fields(line)
+		if len(args) == 0 {
+			w.WriteString("ERROR\r\n")
+		} else if !s.execute(r, w, args[0], args[1:]) {
+			w.Flush()
+			return
+		}
+		if r.Buffered() == 0 {
+			if err = w.Flush(); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// execute runs the command and writes its reply, returns false if the connection should be closed.
+func (s *Server) execute(r *bufio.Reader, w *bufio.Writer, name string, args []string) bool {
+	switch name {
+	case "get", "gets":
+		return s.get(w, args, name == "gets")
+	case "set", "add", "replace", "cas":
+		return s.store(r, w, name, args)
+	case "delete":
+		return s.delete(w, args)
+	case "touch":
+		return s.touch(w, args)
+	case "version":
+		w.WriteString("VERSION memcache\r\n")
+		return true
+	case "quit":
+		return false
+	default:
+		w.WriteString("ERROR\r\n")
+		return true
+	}
+}
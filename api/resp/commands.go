@@ -0,0 +1,422 @@
+package resp
+
+import (
+	"fmt"
+	"github.com/VitalKrasilnikau/memcache/core/actors"
+	"github.com/VitalKrasilnikau/memcache/core/cache"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxRetries limits optimistic concurrency loops built on top of original value updates.
+const maxRetries = 10
+
+type command struct {
+	minArgs int
+	maxArgs int
+	run     func(s *Server, w *writer, args []string)
+}
+
+var commands = map[string]command{
+	"ping":    {0, 1, (*Server).ping},
+	"echo":    {1, 1, (*Server).echo},
+	"hello":   {0, -1, (*Server).hello},
+	"quit":    {0, 0, (*Server).quit},
+	"select":  {1, 1, (*Server).selectDB},
+	"command": {0, -1, (*Server).command},
+	"get":     {1, 1, (*Server).get},
+	"set":     {2, 5, (*Server).set},
+	"del":     {1, -1, (*Server).del},
+	"keys":    {1, 1, (*Server).keys},
+	"lpush":   {2, -1, (*Server).lpush},
+	"rpush":   {2, -1, (*Server).rpush},
+	"lpop":    {1, 2, (*Server).lpop},
+	"rpop":    {1, 2, (*Server).rpop},
+	"lindex":  {2, 2, (*Server).lindex},
+	"llen":    {1, 1, (*Server).llen},
+	"lrange":  {3, 3, (*Server).lrange},
+	"ltrim":   {3, 3, (*Server).ltrim},
+	"lrem":    {3, 3, (*Server).lrem},
+	"lset":    {3, 3, (*Server).lset},
+	"hget":    {2, 2, (*Server).hget},
+	"hmget":   {2, -1, (*Server).hmget},
+	"hset":    {3, -1, (*Server).hset},
+	"hdel":    {2, -1, (*Server).hdel},
+	"hexists": {2, 2, (*Server).hexists},
+	"hkeys":   {1, 1, (*Server).hkeys},
+	"hlen":    {1, 1, (*Server).hlen},
+	"hgetall": {1, 1, (*Server).hgetall},
+}
+
+/* Connection commands */
+
+func (s *Server) ping(w *writer, args []string) {
+	if len(args) == 1 {
+		w.bulk(args[0])
+	} else {
+		w.simple("PONG")
+	}
+}
+
+func (s *Server) echo(w *writer, args []string) {
+	w.bulk(args[0])
+}
+
+func (s *Server) hello(w *writer, args []string) {
+	if len(args) > 0 {
+		v, err := strconv.Atoi(args[0])
+		if err != nil || (v != resp2 && v != resp3) {
+			w.error("NOPROTO unsupported protocol version")
+			return
+		}
+		w.proto = v
+	}
+	w.dictionary(4)
+	w.bulk("server")
+	w.bulk("memcache")
+	w.bulk("proto")
+	w.integer(w.proto)
+	w.bulk("mode")
+	w.bulk("standalone")
+	w.bulk("role")
+	w.bulk("master")
+}
+
+func (s *Server) quit(w *writer, args []string) {
+	w.simple("OK")
+}
+
+func (s *Server) selectDB(w *writer, args []string) {
+	if args[0] != "0" {
+		w.error("ERR DB index is out of range")
+		return
+	}
+	w.simple("OK")
+}
+
+func (s *Server) command(w *writer, args []string) {
+	w.array(0)
+}
+
+/* String commands */
+
+func (s *Server) get(w *writer, args []string) {
+	ok, v := s.getString(args[0])
+	if ok {
+		w.bulk(v)
+	} else {
+		w.null()
+	}
+}
+
+// set creates the key like POST /api/string or updates the value like PUT /api/string/{key}.
+// The TTL options replace the ttl of the existing key, without them the key becomes permanent.
+func (s *Server) set(w *writer, args []string) {
+	key, value := args[0], args[1]
+	var ttl time.Duration
+	var nx, xx bool
+	for i := 2; i < len(args); i++ {
+		switch strings.ToLower(args[i]) {
+		case "nx":
+			nx = true
+		case "xx":
+			xx = true
+		case "ex", "px":
+			if i+1 == len(args) {
+				w.error("ERR syntax error")
+				return
+			}
+			n, err := strconv.Atoi(args[i+1])
+			if err != nil || n <= 0 {
+				w.error("ERR invalid expire time in 'set' command")
+				return
+			}
+			if strings.ToLower(args[i]) == "ex" {
+				ttl = time.Duration(n) * time.Second
+			} else {
+				ttl = time.Duration(n) * time.Millisecond
+			}
+			i++
+		default:
+			w.error("ERR syntax error")
+			return
+		}
+	}
+	if nx && xx {
+		w.error("ERR syntax error")
+		return
+	}
+	for i := 0; i < maxRetries; i++ {
+		if !xx {
+			reply, _ := act.AwaitReply(s.Strings, &act.PostStringCacheKeyMessage{Key: key, Value: value, TTL: ttl}).(*act.PostStringCacheKeyReply)
+			if reply.Success {
+				w.simple("OK")
+				return
+			}
+			if nx {
+				w.null()
+				return
+			}
+		}
+		ok, original := s.getString(key)
+		if !ok {
+			if xx {
+				w.null()
+				return
+			}
+			continue
+		}
+		reply, _ := act.AwaitReply(s.Strings, &act.PutStringCacheKeyMessage{Key: key, NewValue: value, OriginalValue: original}).(*act.PutStringCacheKeyReply)
+		if reply.Success {
+			s.resetStringTTL(key, ttl)
+			w.simple("OK")
+			return
+		}
+	}
+	w.error(fmt.Sprintf("ERR key '%s' was changed concurrently", key))
+}
+
+// resetStringTTL applies the ttl of SET to the updated key, SET without EX or PX makes the key permanent.
+func (s *Server) resetStringTTL(key string, ttl time.Duration) {
+	if ttl > 0 {
+		act.AwaitReply(s.Strings, &act.SetCacheTTLMessage{Key: key, TTL: ttl})
+	} else {
+		act.AwaitReply(s.Strings, &act.PersistCacheKeyMessage{Key: key})
+	}
+}
+
+func (s *Server) del(w *writer, args []string) {
+	n := 0
+	for _, key := range args {
+		reply, _ := act.AwaitReply(s.Strings, &act.DeleteStringCacheKeyMessage{Key: key}).(*act.DeleteStringCacheKeyReply)
+		if reply.Success {
+			n++
+		}
+	}
+	w.integer(n)
+}
+
+func (s *Server) keys(w *writer, args []string) {
+	res, err := s.StringKeys.Request()
+	if err != nil {
+		w.error("ERR " + err.Error())
+		return
+	}
+	var keys []string
+	for _, k := range res.Keys {
+		if match(args[0], k) {
+			keys = append(keys, k)
+		}
+	}
+	w.bulkArray(keys)
+}
+
+func (s *Server) getString(key string) (bool, string) {
+	reply, _ := act.AwaitReply(s.Strings, &act.GetStringCacheKeyMessage{Key: key}).(*act.GetStringCacheKeyReply)
+	return reply.Success, reply.Value
+}
+
+/* List commands */
+
+func (s *Server) lpush(w *writer, args []string) {
+	s.push(w, args, true)
+}
+
+func (s *Server) rpush(w *writer, args []string) {
+	s.push(w, args, false)
+}
+
+// push adds the values to the head or the tail of the list, creating the list if it does not exist.
+func (s *Server) push(w *writer, args []string, left bool) {
+	reply, _ := act.AwaitReply(s.Lists, &act.PushListCacheValuesMessage{Key: args[0], Values: args[1:], Left: left, Create: true}).(*act.PushListCacheValuesReply)
+	if reply.Success {
+		w.integer(int(reply.Length))
+	} else {
+		w.error(fmt.Sprintf("ERR key '%s' was changed concurrently", args[0]))
+	}
+}
+
+func (s *Server) lpop(w *writer, args []string) {
+	s.pop(w, args, true)
+}
+
+func (s *Server) rpop(w *writer, args []string) {
+	s.pop(w, args, false)
+}
+
+// pop removes the values from the head or the tail of the list, a single value is returned if count is not specified.
+func (s *Server) pop(w *writer, args []string, left bool) {
+	count := 1
+	if len(args) > 1 {
+		n, err := strconv.Atoi(args[1])
+		if err != nil || n < 0 {
+			w.error("ERR value is out of range, must be positive")
+			return
+		}
+		count = n
+	}
+	if count == 0 {
+		w.array(0)
+		return
+	}
+	reply, _ := act.AwaitReply(s.Lists, &act.PopListCacheValuesMessage{Key: args[0], Count: int32(count), Left: left}).(*act.PopListCacheValuesReply)
+	if !reply.Success {
+		w.null()
+	} else if len(args) > 1 {
+		w.bulkArray(reply.Values)
+	} else {
+		w.bulk(reply.Values[0])
+	}
+}
+
+func (s *Server) lindex(w *writer, args []string) {
+	index, err := strconv.ParseInt(args[1], 10, 64)
+	if err != nil {
+		w.error("ERR value is not an integer or out of range")
+		return
+	}
+	reply, _ := act.AwaitReply(s.Lists, &act.GetListCacheIndexMessage{Key: args[0], Index: index}).(*act.GetListCacheIndexReply)
+	if reply.Success {
+		w.bulk(reply.Value)
+	} else {
+		w.null()
+	}
+}
+
+// llen requests an empty range since the reply of the range contains the length of the whole list.
+func (s *Server) llen(w *writer, args []string) {
+	reply, _ := act.AwaitReply(s.Lists, &act.GetListCacheRangeMessage{Key: args[0], Start: 1, Stop: 0}).(*act.GetListCacheRangeReply)
+	w.integer(int(reply.Length))
+}
+
+func (s *Server) lrange(w *writer, args []string) {
+	start, err := strconv.ParseInt(args[1], 10, 64)
+	stop, err2 := strconv.ParseInt(args[2], 10, 64)
+	if err != nil || err2 != nil {
+		w.error("ERR value is not an integer or out of range")
+		return
+	}
+	reply, _ := act.AwaitReply(s.Lists, &act.GetListCacheRangeMessage{Key: args[0], Start: start, Stop: stop}).(*act.GetListCacheRangeReply)
+	w.bulkArray(reply.Values)
+}
+
+func (s *Server) ltrim(w *writer, args []string) {
+	start, err := strconv.ParseInt(args[1], 10, 64)
+	stop, err2 := strconv.ParseInt(args[2], 10, 64)
+	if err != nil || err2 != nil {
+		w.error("ERR value is not an integer or out of range")
+		return
+	}
+	act.AwaitReply(s.Lists, &act.TrimListCacheKeyMessage{Key: args[0], Start: start, Stop: stop})
+	w.simple("OK")
+}
+
+// lrem removes count occurrences of the value from the head, or from the tail if count is negative, zero count removes all of them.
+func (s *Server) lrem(w *writer, args []string) {
+	count, err := strconv.ParseInt(args[1], 10, 64)
+	if err != nil {
+		w.error("ERR value is not an integer or out of range")
+		return
+	}
+	reply, _ := act.AwaitReply(s.Lists, &act.DeleteListCacheValueMessage{Key: args[0], Value: args[2], Count: count}).(*act.DeleteListCacheValueReply)
+	w.integer(int(reply.DeletedCount))
+}
+
+func (s *Server) lset(w *writer, args []string) {
+	index, err := strconv.ParseInt(args[1], 10, 64)
+	if err != nil {
+		w.error("ERR value is not an integer or out of range")
+		return
+	}
+	reply, _ := act.AwaitReply(s.Lists, &act.SetListCacheIndexMessage{Key: args[0], Index: index, Value: args[2]}).(*act.SetListCacheIndexReply)
+	if reply.Success {
+		w.simple("OK")
+	} else if reply.OutOfRange {
+		w.error("ERR index out of range")
+	} else {
+		w.error("ERR no such key")
+	}
+}
+
+/* Dictionary commands */
+
+func (s *Server) hget(w *writer, args []string) {
+	reply, _ := act.AwaitReply(s.Dictionaries, &act.GetDictionaryCacheValuesMessage{Key: args[0], SubKeys: args[1:]}).(*act.GetDictionaryCacheValuesReply)
+	if len(reply.Values) > 0 {
+		w.bulk(reply.Values[0].Value)
+	} else {
+		w.null()
+	}
+}
+
+// hmget replies with null for missing sub-keys, so the values are matched with the sub-keys requested.
+func (s *Server) hmget(w *writer, args []string) {
+	reply, _ := act.AwaitReply(s.Dictionaries, &act.GetDictionaryCacheValuesMessage{Key: args[0], SubKeys: args[1:]}).(*act.GetDictionaryCacheValuesReply)
+	values := cache.ToMap(reply.Values)
+	w.array(len(args) - 1)
+	for _, k := range args[1:] {
+		if v, ok := values[k]; ok {
+			w.bulk(v)
+		} else {
+			w.null()
+		}
+	}
+}
+
+// hset creates the dictionary if it does not exist, adds new sub-keys and updates existing ones in one step.
+func (s *Server) hset(w *writer, args []string) {
+	key := args[0]
+	if len(args)%2 != 1 {
+		w.error("ERR wrong number of arguments for 'hset' command")
+		return
+	}
+	var values []cache.KeyValue
+	for i := 1; i < len(args); i += 2 {
+		values = append(values, cache.KeyValue{Key: args[i], Value: args[i+1]})
+	}
+	reply, _ := act.AwaitReply(s.Dictionaries, &act.SetDictionaryCacheValuesMessage{Key: key, Values: values, Create: true}).(*act.SetDictionaryCacheValuesReply)
+	if reply.Success {
+		w.integer(int(reply.Added))
+	} else {
+		w.error(fmt.Sprintf("ERR key '%s' was changed concurrently", key))
+	}
+}
+
+func (s *Server) hdel(w *writer, args []string) {
+	reply, _ := act.AwaitReply(s.Dictionaries, &act.DeleteDictionaryCacheValuesMessage{Key: args[0], SubKeys: args[1:]}).(*act.DeleteDictionaryCacheValuesReply)
+	w.integer(len(reply.DeletedValues))
+}
+
+func (s *Server) hexists(w *writer, args []string) {
+	reply, _ := act.AwaitReply(s.Dictionaries, &act.ContainsDictionaryCacheValuesMessage{Key: args[0], SubKeys: args[1:]}).(*act.ContainsDictionaryCacheValuesReply)
+	if reply.Success && reply.Exists[0] {
+		w.integer(1)
+	} else {
+		w.integer(0)
+	}
+}
+
+func (s *Server) hkeys(w *writer, args []string) {
+	reply, _ := act.AwaitReply(s.Dictionaries, &act.GetDictionaryCacheSubKeysMessage{Key: args[0]}).(*act.GetDictionaryCacheSubKeysReply)
+	w.bulkArray(reply.SubKeys)
+}
+
+func (s *Server) hlen(w *writer, args []string) {
+	reply, _ := act.AwaitReply(s.Dictionaries, &act.GetDictionaryCacheSubKeysMessage{Key: args[0]}).(*act.GetDictionaryCacheSubKeysReply)
+	w.integer(len(reply.SubKeys))
+}
+
+func (s *Server) hgetall(w *writer, args []string) {
+	_, values := s.getDictionary(args[0])
+	w.dictionary(len(values))
+	for _, v := range values {
+		w.bulk(v.Key)
+		w.bulk(v.Value)
+	}
+}
+
+func (s *Server) getDictionary(key string) (bool, []cache.KeyValue) {
+	reply, _ := act.AwaitReply(s.Dictionaries, &act.GetDictionaryCacheKeyMessage{Key: key}).(*act.GetDictionaryCacheKeyReply)
+	return reply.Success, reply.Values
+}
@@ -0,0 +1,207 @@
+package resp
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+const (
+	resp2 = 2
+	resp3 = 3
+)
+
+const (
+	// maxArgs limits the number of arguments of one command.
+	maxArgs = 1024 * 1024
+	// maxBulkLength limits the size of one argument, the whole value is read to memory before the command runs.
+	maxBulkLength = 16 * 1024 * 1024
+	// maxLineLength limits inline commands and headers, the connection is closed if the line is longer.
+	maxLineLength = 64 * 1024
+)
+
+var (
+	errProtocol    = errors.New("Protocol error")
+	errLineTooLong = errors.New("Protocol error: too big inline request")
+)
+
+// readCommand reads the next command either as RESP array of bulk strings or as inline command.
+func readCommand(r *bufio.Reader) ([]string, error) {
+	line, err := readLine(r)
+	if err != nil {
+		return nil, err
+	}
+	if len(line) == 0 || line[0] != '*' {
+		return strings.Fields(line), nil
+	}
+	n, err := strconv.Atoi(line[1:])
+	if err != nil || n < -1 || n > maxArgs {
+		return nil, errProtocol
+	}
+	if n <= 0 {
+		return nil, nil // null or empty array is skipped
+	}
+	args := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		header, err := readLine(r)
+		if err != nil {
+			return nil, err
+		}
+		if len(header) == 0 || header[0] != '$' {
+			return nil, errProtocol
+		}
+		size, err := strconv.Atoi(header[1:])
+		if err != nil || size < 0 || size > maxBulkLength {
+			return nil, errProtocol
+		}
+		buf := make([]byte, size+2)
+		if _, err = io.ReadFull(r, buf); err != nil {
+			return nil, err
+		}
+		if buf[size] != '\r' || buf[size+1] != '\n' {
+			return nil, errProtocol
+		}
+		args = append(args, string(buf[:size]))
+	}
+	return args, nil
+}
+
+// readLine reads the line up to maxLineLength bytes without the line ending.
+func readLine(r *bufio.Reader) (string, error) {
+	var line []byte
+	for {
+		chunk, err := r.ReadSlice('\n')
+		if len(line)+len(chunk) > maxLineLength {
+			return "", errLineTooLong
+		}
+		line = append(line, chunk...)
+		if err == nil {
+			break
+		}
+		if err != bufio.ErrBufferFull {
+			return "", err
+		}
+	}
+	return strings.TrimRight(string(line), "\r\n"), nil
+}
+
+// writer serializes replies using the protocol version negotiated by HELLO.
+type writer struct {
+	*bufio.Writer
+	proto int
+}
+
+func (w *writer) simple(s string) {
+	fmt.Fprintf(w, "+%s\r\n", s)
+}
+
+func (w *writer) error(s string) {
+	fmt.Fprintf(w, "-%s\r\n", s)
+}
+
+func (w *writer) integer(n int) {
+	fmt.Fprintf(w, ":%d\r\n", n)
+}
+
+func (w *writer) bulk(s string) {
+	fmt.Fprintf(w, "$%d\r\n%s\r\n", len(s), s)
+}
+
+func (w *writer) null() {
+	if w.proto == resp3 {
+		w.WriteString("_\r\n")
+	} else {
+		w.WriteString("$-1\r\n")
+	}
+}
+
+func (w *writer) array(n int) {
+	fmt.Fprintf(w, "*%d\r\n", n)
+}
+
+// dictionary writes the header of a map reply, RESP2 clients get a flat array of keys and values.
+func (w *writer) dictionary(n int) {
+	if w.proto == resp3 {
+		fmt.Fprintf(w, "%%%d\r\n", n)
+	} else {
+		w.array(n * 2)
+	}
+}
+
+func (w *writer) bulkArray(values []string) {
+	w.array(len(values))
+	for _, v := range values {
+		w.bulk(v)
+	}
+}
+
+// match reports whether the key matches glob-style pattern used by KEYS command.
+func match(pattern string, key string) bool {
+	for len(pattern) > 0 {
+		switch pattern[0] {
+		case '*':
+			for len(pattern) > 1 && pattern[1] == '*' {
+				pattern = pattern[1:]
+			}
+			if len(pattern) == 1 {
+				return true
+			}
+			for i := 0; i <= len(key); i++ {
+				if match(pattern[1:], key[i:]) {
+					return true
+				}
+			}
+			return false
+		case '?':
+			if len(key) == 0 {
+				return false
+			}
+		case '[':
+			if len(key) == 0 {
+				return false
+			}
+			end := strings.IndexByte(pattern[1:], ']')
+			if end < 0 {
+				return pattern == key
+			}
+			class := pattern[1 : end+1]
+			negate := len(class) > 0 && class[0] == '^'
+			if negate {
+				class = class[1:]
+			}
+			if matchClass(class, key[0]) == negate {
+				return false
+			}
+			pattern = pattern[end+1:]
+		case '\\':
+			if len(pattern) > 1 {
+				pattern = pattern[1:]
+			}
+			fallthrough
+		default:
+			if len(key) == 0 || pattern[0] != key[0] {
+				return false
+			}
+		}
+		pattern = pattern[1:]
+		key = key[1:]
+	}
+	return len(key) == 0
+}
+
+func matchClass(class string, c byte) bool {
+	for i := 0; i < len(class); i++ {
+		if i+2 < len(class) && class[i+1] == '-' {
+			if class[i] <= c && c <= class[i+2] {
+				return true
+			}
+			i += 2
+		} else if class[i] == c {
+			return true
+		}
+	}
+	return false
+}
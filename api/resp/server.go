@@ -0,0 +1,89 @@
+package resp
+
+import (
+	"bufio"
+	"fmt"
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/VitalKrasilnikau/memcache/core/actors"
+	"io"
+	"log"
+	"net"
+	"strings"
+)
+
+// Server is a Redis protocol (RESP2/RESP3) front-end for the cache actor clusters.
+type Server struct {
+	Strings      *actor.PID
+	StringKeys   *act.BroadcastStringKeysGroup
+	Lists        *actor.PID
+	Dictionaries *actor.PID
+}
+
+// NewServer creates new Server which routes commands to the actor clusters specified.
+func NewServer(pid *actor.PID, cpid *act.BroadcastStringKeysGroup, lpid *actor.PID, dpid *actor.PID) *Server {
+	return &Server{Strings: pid, StringKeys: cpid, Lists: lpid, Dictionaries: dpid}
+}
+
+// ListenAndServe accepts RESP connections on the TCP address specified.
+func (s *Server) ListenAndServe(address string) error {
+	l, err := net.Listen("tcp", address)
+	if err != nil {
+		return err
+	}
+	defer l.Close()
+	log.Printf("[RESP] Listening on %s", address)
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			return err
+		}
+		go s.serve(conn)
+	}
+}
+
+func (s *Server) serve(conn net.Conn) {
+	defer conn.Close()
+	defer func() {
+		if err := recover(); err != nil {
+			log.Printf("[RESP] %s: closed after panic: %v", conn.RemoteAddr(), err)
+		}
+	}()
+	r := bufio.NewReader(conn)
+	w := &writer{Writer: bufio.NewWriter(conn), proto: resp2}
+	for {
+		args, err := readCommand(r)
+		if err != nil {
+			if err != io.EOF {
+				w.error("ERR " + err.Error())
+				w.Flush()
+				log.Printf("[RESP] %s: %v", conn.RemoteAddr(), err)
+			}
+			return
+		}
+		if len(args) == 0 {
+			continue
+		}
+		quit := s.execute(w, strings.ToLower(args[0]), args[1:])
+		// Flush once the pipelined commands already read are processed.
+		if r.Buffered() == 0 || quit {
+			if err = w.Flush(); err != nil || quit {
+				return
+			}
+		}
+	}
+}
+
+// execute runs the command and writes its reply, returns true if the connection should be closed.
+func (s *Server) execute(w *writer, name string, args []string) bool {
+	h, ok := commands[name]
+	if !ok {
+		w.error(fmt.Sprintf("ERR unknown command '%s'", name))
+		return false
+	}
+	if len(args) < h.minArgs || (h.maxArgs >= 0 && len(args) > h.maxArgs) {
+		w.error(fmt.Sprintf("ERR wrong number of arguments for '%s' command", name))
+		return false
+	}
+	h.run(s, w, args)
+	return name == "quit"
+}
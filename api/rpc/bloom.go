@@ -0,0 +1,42 @@
+package rpc
+
+import (
+	"github.com/VitalKrasilnikau/memcache/core/actors"
+	"github.com/VitalKrasilnikau/memcache/core/messages"
+	"golang.org/x/net/context"
+)
+
+// GetBloomFilterKeys streams all Bloom filter cache keys.
+func (s *Server) GetBloomFilterKeys(m *messages.GetCacheKeysMessage, stream messages.CacheService_GetBloomFilterKeysServer) error {
+	return sendKeys(s.BloomFilterKeys, stream)
+}
+
+// GetBloomFilter gets the options and the number of values of Bloom filter cache entry by key.
+func (s *Server) GetBloomFilter(ctx context.Context, m *messages.GetBloomFilterCacheKeyMessage) (*messages.GetBloomFilterCacheKeyReply, error) {
+	r, _ := act.AwaitReply(s.BloomFilters, m).(*messages.GetBloomFilterCacheKeyReply)
+	return r, nil
+}
+
+// PostBloomFilter reserves new Bloom filter cache entry.
+func (s *Server) PostBloomFilter(ctx context.Context, m *messages.PostBloomFilterCacheKeyMessage) (*messages.PostBloomFilterCacheKeyReply, error) {
+	r, _ := act.AwaitReply(s.BloomFilters, m).(*messages.PostBloomFilterCacheKeyReply)
+	return r, nil
+}
+
+// DeleteBloomFilter deletes Bloom filter cache entry by key.
+func (s *Server) DeleteBloomFilter(ctx context.Context, m *messages.DeleteBloomFilterCacheKeyMessage) (*messages.DeleteBloomFilterCacheKeyReply, error) {
+	r, _ := act.AwaitReply(s.BloomFilters, m).(*messages.DeleteBloomFilterCacheKeyReply)
+	return r, nil
+}
+
+// AddBloomFilterValues adds values to Bloom filter like BF.MADD.
+func (s *Server) AddBloomFilterValues(ctx context.Context, m *messages.AddBloomFilterCacheValuesMessage) (*messages.AddBloomFilterCacheValuesReply, error) {
+	r, _ := act.AwaitReply(s.BloomFilters, m).(*messages.AddBloomFilterCacheValuesReply)
+	return r, nil
+}
+
+// ExistsBloomFilterValues checks values in Bloom filter like BF.MEXISTS.
+func (s *Server) ExistsBloomFilterValues(ctx context.Context, m *messages.ExistsBloomFilterCacheValuesMessage) (*messages.ExistsBloomFilterCacheValuesReply, error) {
+	r, _ := act.AwaitReply(s.BloomFilters, m).(*messages.ExistsBloomFilterCacheValuesReply)
+	return r, nil
+}
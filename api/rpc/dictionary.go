@@ -0,0 +1,84 @@
+package rpc
+
+import (
+	"github.com/VitalKrasilnikau/memcache/core/actors"
+	"github.com/VitalKrasilnikau/memcache/core/messages"
+	"golang.org/x/net/context"
+)
+
+// GetDictionaryKeys streams all dictionary cache keys.
+func (s *Server) GetDictionaryKeys(m *messages.GetCacheKeysMessage, stream messages.CacheService_GetDictionaryKeysServer) error {
+	return sendKeys(s.DictionaryKeys, stream)
+}
+
+// GetDictionary gets dictionary cache entry by key.
+func (s *Server) GetDictionary(ctx context.Context, m *messages.GetDictionaryCacheKeyMessage) (*messages.GetDictionaryCacheKeyReply, error) {
+	r, _ := act.AwaitReply(s.Dictionaries, m).(*messages.GetDictionaryCacheKeyReply)
+	return r, nil
+}
+
+// PostDictionary adds new dictionary cache entry.
+func (s *Server) PostDictionary(ctx context.Context, m *messages.PostDictionaryCacheKeyMessage) (*messages.PostDictionaryCacheKeyReply, error) {
+	r, _ := act.AwaitReply(s.Dictionaries, m).(*messages.PostDictionaryCacheKeyReply)
+	return r, nil
+}
+
+// DeleteDictionary deletes dictionary cache entry by key.
+func (s *Server) DeleteDictionary(ctx context.Context, m *messages.DeleteDictionaryCacheKeyMessage) (*messages.DeleteDictionaryCacheKeyReply, error) {
+	r, _ := act.AwaitReply(s.Dictionaries, m).(*messages.DeleteDictionaryCacheKeyReply)
+	return r, nil
+}
+
+// PostDictionaryValue adds new sub-key to the existing dictionary.
+func (s *Server) PostDictionaryValue(ctx context.Context, m *messages.PostDictionaryCacheValueMessage) (*messages.PostDictionaryCacheValueReply, error) {
+	r, _ := act.AwaitReply(s.Dictionaries, m).(*messages.PostDictionaryCacheValueReply)
+	return r, nil
+}
+
+// PutDictionaryValue updates the sub-key value in the existing dictionary by its original value.
+func (s *Server) PutDictionaryValue(ctx context.Context, m *messages.PutDictionaryCacheValueMessage) (*messages.PutDictionaryCacheValueReply, error) {
+	r, _ := act.AwaitReply(s.Dictionaries, m).(*messages.PutDictionaryCacheValueReply)
+	return r, nil
+}
+
+// DeleteDictionaryValue deletes the sub-key from the existing dictionary.
+func (s *Server) DeleteDictionaryValue(ctx context.Context, m *messages.DeleteDictionaryCacheValueMessage) (*messages.DeleteDictionaryCacheValueReply, error) {
+	r, _ := act.AwaitReply(s.Dictionaries, m).(*messages.DeleteDictionaryCacheValueReply)
+	return r, nil
+}
+
+// IncrementDictionaryValue atomically increments numeric value of the dictionary sub-key.
+func (s *Server) IncrementDictionaryValue(ctx context.Context, m *messages.IncrementDictionaryCacheValueMessage) (*messages.IncrementDictionaryCacheValueReply, error) {
+	r, _ := act.AwaitReply(s.Dictionaries, m).(*messages.IncrementDictionaryCacheValueReply)
+	return r, nil
+}
+
+// GetDictionaryValues gets the values of the selected dictionary sub-keys.
+func (s *Server) GetDictionaryValues(ctx context.Context, m *messages.GetDictionaryCacheValuesMessage) (*messages.GetDictionaryCacheValuesReply, error) {
+	r, _ := act.AwaitReply(s.Dictionaries, m).(*messages.GetDictionaryCacheValuesReply)
+	return r, nil
+}
+
+// SetDictionaryValues adds or updates many dictionary sub-keys atomically.
+func (s *Server) SetDictionaryValues(ctx context.Context, m *messages.SetDictionaryCacheValuesMessage) (*messages.SetDictionaryCacheValuesReply, error) {
+	r, _ := act.AwaitReply(s.Dictionaries, m).(*messages.SetDictionaryCacheValuesReply)
+	return r, nil
+}
+
+// DeleteDictionaryValues deletes many dictionary sub-keys atomically.
+func (s *Server) DeleteDictionaryValues(ctx context.Context, m *messages.DeleteDictionaryCacheValuesMessage) (*messages.DeleteDictionaryCacheValuesReply, error) {
+	r, _ := act.AwaitReply(s.Dictionaries, m).(*messages.DeleteDictionaryCacheValuesReply)
+	return r, nil
+}
+
+// GetDictionarySubKeys gets dictionary sub-keys without their values.
+func (s *Server) GetDictionarySubKeys(ctx context.Context, m *messages.GetDictionaryCacheSubKeysMessage) (*messages.GetDictionaryCacheSubKeysReply, error) {
+	r, _ := act.AwaitReply(s.Dictionaries, m).(*messages.GetDictionaryCacheSubKeysReply)
+	return r, nil
+}
+
+// ContainsDictionaryValues checks if the dictionary sub-keys exist.
+func (s *Server) ContainsDictionaryValues(ctx context.Context, m *messages.ContainsDictionaryCacheValuesMessage) (*messages.ContainsDictionaryCacheValuesReply, error) {
+	r, _ := act.AwaitReply(s.Dictionaries, m).(*messages.ContainsDictionaryCacheValuesReply)
+	return r, nil
+}
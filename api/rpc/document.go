@@ -0,0 +1,66 @@
+package rpc
+
+import (
+	"github.com/VitalKrasilnikau/memcache/core/actors"
+	"github.com/VitalKrasilnikau/memcache/core/messages"
+	"golang.org/x/net/context"
+)
+
+// GetDocumentKeys streams all document cache keys.
+func (s *Server) GetDocumentKeys(m *messages.GetCacheKeysMessage, stream messages.CacheService_GetDocumentKeysServer) error {
+	return sendKeys(s.DocumentKeys, stream)
+}
+
+// GetDocument gets document cache entry by key.
+func (s *Server) GetDocument(ctx context.Context, m *messages.GetDocumentCacheKeyMessage) (*messages.GetDocumentCacheKeyReply, error) {
+	r, _ := act.AwaitReply(s.Documents, m).(*messages.GetDocumentCacheKeyReply)
+	return r, nil
+}
+
+// PostDocument adds new document cache entry.
+func (s *Server) PostDocument(ctx context.Context, m *messages.PostDocumentCacheKeyMessage) (*messages.PostDocumentCacheKeyReply, error) {
+	r, _ := act.AwaitReply(s.Documents, m).(*messages.PostDocumentCacheKeyReply)
+	return r, nil
+}
+
+// DeleteDocument deletes document cache entry by key.
+func (s *Server) DeleteDocument(ctx context.Context, m *messages.DeleteDocumentCacheKeyMessage) (*messages.DeleteDocumentCacheKeyReply, error) {
+	r, _ := act.AwaitReply(s.Documents, m).(*messages.DeleteDocumentCacheKeyReply)
+	return r, nil
+}
+
+// GetDocumentPath gets the value at the path of the document.
+func (s *Server) GetDocumentPath(ctx context.Context, m *messages.GetDocumentCachePathMessage) (*messages.GetDocumentCachePathReply, error) {
+	r, _ := act.AwaitReply(s.Documents, m).(*messages.GetDocumentCachePathReply)
+	return r, nil
+}
+
+// SetDocumentPath adds or replaces the value at the path of the document.
+func (s *Server) SetDocumentPath(ctx context.Context, m *messages.SetDocumentCachePathMessage) (*messages.SetDocumentCachePathReply, error) {
+	r, _ := act.AwaitReply(s.Documents, m).(*messages.SetDocumentCachePathReply)
+	return r, nil
+}
+
+// DeleteDocumentPath removes the value at the path of the document.
+func (s *Server) DeleteDocumentPath(ctx context.Context, m *messages.DeleteDocumentCachePathMessage) (*messages.DeleteDocumentCachePathReply, error) {
+	r, _ := act.AwaitReply(s.Documents, m).(*messages.DeleteDocumentCachePathReply)
+	return r, nil
+}
+
+// AppendDocumentValues appends values to the array at the path of the document.
+func (s *Server) AppendDocumentValues(ctx context.Context, m *messages.AppendDocumentCacheValuesMessage) (*messages.AppendDocumentCacheValuesReply, error) {
+	r, _ := act.AwaitReply(s.Documents, m).(*messages.AppendDocumentCacheValuesReply)
+	return r, nil
+}
+
+// MergeDocument applies JSON Merge Patch to the document.
+func (s *Server) MergeDocument(ctx context.Context, m *messages.MergeDocumentCacheMessage) (*messages.MergeDocumentCacheReply, error) {
+	r, _ := act.AwaitReply(s.Documents, m).(*messages.MergeDocumentCacheReply)
+	return r, nil
+}
+
+// PatchDocument applies JSON Patch operations to the document.
+func (s *Server) PatchDocument(ctx context.Context, m *messages.PatchDocumentCacheMessage) (*messages.PatchDocumentCacheReply, error) {
+	r, _ := act.AwaitReply(s.Documents, m).(*messages.PatchDocumentCacheReply)
+	return r, nil
+}
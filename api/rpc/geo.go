@@ -0,0 +1,60 @@
+package rpc
+
+import (
+	"github.com/VitalKrasilnikau/memcache/core/actors"
+	"github.com/VitalKrasilnikau/memcache/core/messages"
+	"golang.org/x/net/context"
+)
+
+// GetGeoKeys streams all geo cache keys.
+func (s *Server) GetGeoKeys(m *messages.GetCacheKeysMessage, stream messages.CacheService_GetGeoKeysServer) error {
+	return sendKeys(s.GeoKeys, stream)
+}
+
+// GetGeo gets all the members of geo cache entry with their positions by key.
+func (s *Server) GetGeo(ctx context.Context, m *messages.GetGeoCacheKeyMessage) (*messages.GetGeoCacheKeyReply, error) {
+	r, _ := act.AwaitReply(s.Geos, m).(*messages.GetGeoCacheKeyReply)
+	return r, nil
+}
+
+// PostGeo posts new geo cache entry.
+func (s *Server) PostGeo(ctx context.Context, m *messages.PostGeoCacheKeyMessage) (*messages.PostGeoCacheKeyReply, error) {
+	r, _ := act.AwaitReply(s.Geos, m).(*messages.PostGeoCacheKeyReply)
+	return r, nil
+}
+
+// DeleteGeo deletes geo cache entry by key.
+func (s *Server) DeleteGeo(ctx context.Context, m *messages.DeleteGeoCacheKeyMessage) (*messages.DeleteGeoCacheKeyReply, error) {
+	r, _ := act.AwaitReply(s.Geos, m).(*messages.DeleteGeoCacheKeyReply)
+	return r, nil
+}
+
+// AddGeoMembers adds or moves the members like GEOADD.
+func (s *Server) AddGeoMembers(ctx context.Context, m *messages.AddGeoCacheMembersMessage) (*messages.AddGeoCacheMembersReply, error) {
+	r, _ := act.AwaitReply(s.Geos, m).(*messages.AddGeoCacheMembersReply)
+	return r, nil
+}
+
+// RemoveGeoMembers removes the members from geo cache entry.
+func (s *Server) RemoveGeoMembers(ctx context.Context, m *messages.RemoveGeoCacheMembersMessage) (*messages.RemoveGeoCacheMembersReply, error) {
+	r, _ := act.AwaitReply(s.Geos, m).(*messages.RemoveGeoCacheMembersReply)
+	return r, nil
+}
+
+// GetGeoPositions gets the positions of the members like GEOPOS.
+func (s *Server) GetGeoPositions(ctx context.Context, m *messages.GetGeoCachePositionsMessage) (*messages.GetGeoCachePositionsReply, error) {
+	r, _ := act.AwaitReply(s.Geos, m).(*messages.GetGeoCachePositionsReply)
+	return r, nil
+}
+
+// GetGeoDistance gets the distance in meters between two members like GEODIST.
+func (s *Server) GetGeoDistance(ctx context.Context, m *messages.GetGeoCacheDistanceMessage) (*messages.GetGeoCacheDistanceReply, error) {
+	r, _ := act.AwaitReply(s.Geos, m).(*messages.GetGeoCacheDistanceReply)
+	return r, nil
+}
+
+// SearchGeo finds the members within the radius or the box sorted by distance like GEOSEARCH.
+func (s *Server) SearchGeo(ctx context.Context, m *messages.SearchGeoCacheMessage) (*messages.SearchGeoCacheReply, error) {
+	r, _ := act.AwaitReply(s.Geos, m).(*messages.SearchGeoCacheReply)
+	return r, nil
+}
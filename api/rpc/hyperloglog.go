@@ -0,0 +1,42 @@
+package rpc
+
+import (
+	"github.com/VitalKrasilnikau/memcache/core/actors"
+	"github.com/VitalKrasilnikau/memcache/core/messages"
+	"golang.org/x/net/context"
+)
+
+// GetHyperLogLogKeys streams all HyperLogLog cache keys.
+func (s *Server) GetHyperLogLogKeys(m *messages.GetCacheKeysMessage, stream messages.CacheService_GetHyperLogLogKeysServer) error {
+	return sendKeys(s.HyperLogLogKeys, stream)
+}
+
+// GetHyperLogLog gets the estimated count and the serialized registers of HyperLogLog cache entry by key.
+func (s *Server) GetHyperLogLog(ctx context.Context, m *messages.GetHyperLogLogCacheKeyMessage) (*messages.GetHyperLogLogCacheKeyReply, error) {
+	r, _ := act.AwaitReply(s.HyperLogLogs, m).(*messages.GetHyperLogLogCacheKeyReply)
+	return r, nil
+}
+
+// PostHyperLogLog adds new HyperLogLog cache entry.
+func (s *Server) PostHyperLogLog(ctx context.Context, m *messages.PostHyperLogLogCacheKeyMessage) (*messages.PostHyperLogLogCacheKeyReply, error) {
+	r, _ := act.AwaitReply(s.HyperLogLogs, m).(*messages.PostHyperLogLogCacheKeyReply)
+	return r, nil
+}
+
+// DeleteHyperLogLog deletes HyperLogLog cache entry by key.
+func (s *Server) DeleteHyperLogLog(ctx context.Context, m *messages.DeleteHyperLogLogCacheKeyMessage) (*messages.DeleteHyperLogLogCacheKeyReply, error) {
+	r, _ := act.AwaitReply(s.HyperLogLogs, m).(*messages.DeleteHyperLogLogCacheKeyReply)
+	return r, nil
+}
+
+// AddHyperLogLogValues adds values to HyperLogLog like PFADD.
+func (s *Server) AddHyperLogLogValues(ctx context.Context, m *messages.AddHyperLogLogCacheValuesMessage) (*messages.AddHyperLogLogCacheValuesReply, error) {
+	r, _ := act.AwaitReply(s.HyperLogLogs, m).(*messages.AddHyperLogLogCacheValuesReply)
+	return r, nil
+}
+
+// MergeHyperLogLog merges HyperLogLogs serialized by GetHyperLogLog into the cache entry like PFMERGE.
+func (s *Server) MergeHyperLogLog(ctx context.Context, m *messages.MergeHyperLogLogCacheMessage) (*messages.MergeHyperLogLogCacheReply, error) {
+	r, _ := act.AwaitReply(s.HyperLogLogs, m).(*messages.MergeHyperLogLogCacheReply)
+	return r, nil
+}
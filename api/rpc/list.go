@@ -0,0 +1,96 @@
+package rpc
+
+import (
+	"github.com/VitalKrasilnikau/memcache/core/actors"
+	"github.com/VitalKrasilnikau/memcache/core/messages"
+	"golang.org/x/net/context"
+)
+
+// GetListKeys streams all list cache keys.
+func (s *Server) GetListKeys(m *messages.GetCacheKeysMessage, stream messages.CacheService_GetListKeysServer) error {
+	return sendKeys(s.ListKeys, stream)
+}
+
+// GetList gets list cache entry by key.
+func (s *Server) GetList(ctx context.Context, m *messages.GetListCacheKeyMessage) (*messages.GetListCacheKeyReply, error) {
+	r, _ := act.AwaitReply(s.Lists, m).(*messages.GetListCacheKeyReply)
+	return r, nil
+}
+
+// PostList adds new list cache entry.
+func (s *Server) PostList(ctx context.Context, m *messages.PostListCacheKeyMessage) (*messages.PostListCacheKeyReply, error) {
+	r, _ := act.AwaitReply(s.Lists, m).(*messages.PostListCacheKeyReply)
+	return r, nil
+}
+
+// DeleteList deletes list cache entry by key.
+func (s *Server) DeleteList(ctx context.Context, m *messages.DeleteListCacheKeyMessage) (*messages.DeleteListCacheKeyReply, error) {
+	r, _ := act.AwaitReply(s.Lists, m).(*messages.DeleteListCacheKeyReply)
+	return r, nil
+}
+
+// PostListValue adds new value to the end of the existing list.
+func (s *Server) PostListValue(ctx context.Context, m *messages.PostListCacheValueMessage) (*messages.PostListCacheValueReply, error) {
+	r, _ := act.AwaitReply(s.Lists, m).(*messages.PostListCacheValueReply)
+	return r, nil
+}
+
+// PutListValue replaces the value in the existing list.
+func (s *Server) PutListValue(ctx context.Context, m *messages.PutListCacheValueMessage) (*messages.PutListCacheValueReply, error) {
+	r, _ := act.AwaitReply(s.Lists, m).(*messages.PutListCacheValueReply)
+	return r, nil
+}
+
+// DeleteListValue deletes the value from the existing list.
+func (s *Server) DeleteListValue(ctx context.Context, m *messages.DeleteListCacheValueMessage) (*messages.DeleteListCacheValueReply, error) {
+	r, _ := act.AwaitReply(s.Lists, m).(*messages.DeleteListCacheValueReply)
+	return r, nil
+}
+
+// GetListIndex gets list value by its index.
+func (s *Server) GetListIndex(ctx context.Context, m *messages.GetListCacheIndexMessage) (*messages.GetListCacheIndexReply, error) {
+	r, _ := act.AwaitReply(s.Lists, m).(*messages.GetListCacheIndexReply)
+	return r, nil
+}
+
+// SetListIndex replaces list value by its index.
+func (s *Server) SetListIndex(ctx context.Context, m *messages.SetListCacheIndexMessage) (*messages.SetListCacheIndexReply, error) {
+	r, _ := act.AwaitReply(s.Lists, m).(*messages.SetListCacheIndexReply)
+	return r, nil
+}
+
+// InsertListValue inserts the value to the list before the index.
+func (s *Server) InsertListValue(ctx context.Context, m *messages.InsertListCacheValueMessage) (*messages.InsertListCacheValueReply, error) {
+	r, _ := act.AwaitReply(s.Lists, m).(*messages.InsertListCacheValueReply)
+	return r, nil
+}
+
+// RemoveListIndex removes list value by its index.
+func (s *Server) RemoveListIndex(ctx context.Context, m *messages.RemoveListCacheIndexMessage) (*messages.RemoveListCacheIndexReply, error) {
+	r, _ := act.AwaitReply(s.Lists, m).(*messages.RemoveListCacheIndexReply)
+	return r, nil
+}
+
+// GetListRange gets the values of the list from start to stop indexes.
+func (s *Server) GetListRange(ctx context.Context, m *messages.GetListCacheRangeMessage) (*messages.GetListCacheRangeReply, error) {
+	r, _ := act.AwaitReply(s.Lists, m).(*messages.GetListCacheRangeReply)
+	return r, nil
+}
+
+// PushListValues adds the values to the head or the tail of the list.
+func (s *Server) PushListValues(ctx context.Context, m *messages.PushListCacheValuesMessage) (*messages.PushListCacheValuesReply, error) {
+	r, _ := act.AwaitReply(s.Lists, m).(*messages.PushListCacheValuesReply)
+	return r, nil
+}
+
+// PopListValues removes the values from the head or the tail of the list, waiting for them if timeout is set.
+func (s *Server) PopListValues(ctx context.Context, m *messages.PopListCacheValuesMessage) (*messages.PopListCacheValuesReply, error) {
+	r, _ := act.AwaitReply(s.Lists, m).(*messages.PopListCacheValuesReply)
+	return r, nil
+}
+
+// TrimList keeps only the values of the list from start to stop indexes.
+func (s *Server) TrimList(ctx context.Context, m *messages.TrimListCacheKeyMessage) (*messages.TrimListCacheKeyReply, error) {
+	r, _ := act.AwaitReply(s.Lists, m).(*messages.TrimListCacheKeyReply)
+	return r, nil
+}
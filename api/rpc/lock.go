@@ -0,0 +1,36 @@
+package rpc
+
+import (
+	"github.com/VitalKrasilnikau/memcache/core/actors"
+	"github.com/VitalKrasilnikau/memcache/core/messages"
+	"golang.org/x/net/context"
+)
+
+// GetLockKeys streams all keys of the held locks.
+func (s *Server) GetLockKeys(m *messages.GetCacheKeysMessage, stream messages.CacheService_GetLockKeysServer) error {
+	return sendKeys(s.LockKeys, stream)
+}
+
+// GetLock gets the fencing token and the remaining lease of the held lock by key.
+func (s *Server) GetLock(ctx context.Context, m *messages.GetLockCacheKeyMessage) (*messages.GetLockCacheKeyReply, error) {
+	r, _ := act.AwaitReply(s.Locks, m).(*messages.GetLockCacheKeyReply)
+	return r, nil
+}
+
+// AcquireLock acquires the lock for the owner with the lease if it is not held by another owner.
+func (s *Server) AcquireLock(ctx context.Context, m *messages.AcquireLockCacheKeyMessage) (*messages.AcquireLockCacheKeyReply, error) {
+	r, _ := act.AwaitReply(s.Locks, m).(*messages.AcquireLockCacheKeyReply)
+	return r, nil
+}
+
+// RenewLock extends the lease of the lock held by the owner.
+func (s *Server) RenewLock(ctx context.Context, m *messages.RenewLockCacheKeyMessage) (*messages.RenewLockCacheKeyReply, error) {
+	r, _ := act.AwaitReply(s.Locks, m).(*messages.RenewLockCacheKeyReply)
+	return r, nil
+}
+
+// ReleaseLock releases the lock held by the owner.
+func (s *Server) ReleaseLock(ctx context.Context, m *messages.ReleaseLockCacheKeyMessage) (*messages.ReleaseLockCacheKeyReply, error) {
+	r, _ := act.AwaitReply(s.Locks, m).(*messages.ReleaseLockCacheKeyReply)
+	return r, nil
+}
@@ -0,0 +1,72 @@
+package rpc
+
+import (
+	"github.com/VitalKrasilnikau/memcache/core/actors"
+	"github.com/VitalKrasilnikau/memcache/core/messages"
+	"golang.org/x/net/context"
+)
+
+// GetQueueKeys streams all queue cache keys.
+func (s *Server) GetQueueKeys(m *messages.GetCacheKeysMessage, stream messages.CacheService_GetQueueKeysServer) error {
+	return sendKeys(s.QueueKeys, stream)
+}
+
+// GetQueue gets the number of ready, delayed, in-flight and dead items of queue cache entry by key.
+func (s *Server) GetQueue(ctx context.Context, m *messages.GetQueueCacheKeyMessage) (*messages.GetQueueCacheKeyReply, error) {
+	r, _ := act.AwaitReply(s.Queues, m).(*messages.GetQueueCacheKeyReply)
+	return r, nil
+}
+
+// PostQueue posts new queue cache entry.
+func (s *Server) PostQueue(ctx context.Context, m *messages.PostQueueCacheKeyMessage) (*messages.PostQueueCacheKeyReply, error) {
+	r, _ := act.AwaitReply(s.Queues, m).(*messages.PostQueueCacheKeyReply)
+	return r, nil
+}
+
+// DeleteQueue deletes queue cache entry by key.
+func (s *Server) DeleteQueue(ctx context.Context, m *messages.DeleteQueueCacheKeyMessage) (*messages.DeleteQueueCacheKeyReply, error) {
+	r, _ := act.AwaitReply(s.Queues, m).(*messages.DeleteQueueCacheKeyReply)
+	return r, nil
+}
+
+// PushQueue pushes the items with their priorities and delays.
+func (s *Server) PushQueue(ctx context.Context, m *messages.PushQueueCacheItemsMessage) (*messages.PushQueueCacheItemsReply, error) {
+	r, _ := act.AwaitReply(s.Queues, m).(*messages.PushQueueCacheItemsReply)
+	return r, nil
+}
+
+// PopQueue delivers the items with the highest priority and keeps them in flight until the visibility timeout passes.
+func (s *Server) PopQueue(ctx context.Context, m *messages.PopQueueCacheItemsMessage) (*messages.PopQueueCacheItemsReply, error) {
+	r, _ := act.AwaitReply(s.Queues, m).(*messages.PopQueueCacheItemsReply)
+	return r, nil
+}
+
+// PeekQueue gets the items which would be delivered next.
+func (s *Server) PeekQueue(ctx context.Context, m *messages.PeekQueueCacheItemsMessage) (*messages.PeekQueueCacheItemsReply, error) {
+	r, _ := act.AwaitReply(s.Queues, m).(*messages.PeekQueueCacheItemsReply)
+	return r, nil
+}
+
+// AckQueue acknowledges the delivered items by their receipts.
+func (s *Server) AckQueue(ctx context.Context, m *messages.AckQueueCacheItemsMessage) (*messages.AckQueueCacheItemsReply, error) {
+	r, _ := act.AwaitReply(s.Queues, m).(*messages.AckQueueCacheItemsReply)
+	return r, nil
+}
+
+// NackQueue returns the delivered item to the queue or moves it to the dead letters.
+func (s *Server) NackQueue(ctx context.Context, m *messages.NackQueueCacheItemMessage) (*messages.NackQueueCacheItemReply, error) {
+	r, _ := act.AwaitReply(s.Queues, m).(*messages.NackQueueCacheItemReply)
+	return r, nil
+}
+
+// GetQueueDead gets the dead letters of the queue.
+func (s *Server) GetQueueDead(ctx context.Context, m *messages.GetQueueCacheDeadMessage) (*messages.GetQueueCacheDeadReply, error) {
+	r, _ := act.AwaitReply(s.Queues, m).(*messages.GetQueueCacheDeadReply)
+	return r, nil
+}
+
+// RedriveQueue moves the dead letters back to the queue.
+func (s *Server) RedriveQueue(ctx context.Context, m *messages.RedriveQueueCacheDeadMessage) (*messages.RedriveQueueCacheDeadReply, error) {
+	r, _ := act.AwaitReply(s.Queues, m).(*messages.RedriveQueueCacheDeadReply)
+	return r, nil
+}
@@ -0,0 +1,30 @@
+package rpc
+
+import (
+	"github.com/VitalKrasilnikau/memcache/core/actors"
+	"github.com/VitalKrasilnikau/memcache/core/messages"
+	"golang.org/x/net/context"
+)
+
+// GetRateLimitKeys streams all rate limiter keys.
+func (s *Server) GetRateLimitKeys(m *messages.GetCacheKeysMessage, stream messages.CacheService_GetRateLimitKeysServer) error {
+	return sendKeys(s.RateLimitKeys, stream)
+}
+
+// GetRateLimit gets the limits and the remaining capacity of the rate limiter by key.
+func (s *Server) GetRateLimit(ctx context.Context, m *messages.GetRateLimitCacheKeyMessage) (*messages.GetRateLimitCacheKeyReply, error) {
+	r, _ := act.AwaitReply(s.RateLimits, m).(*messages.GetRateLimitCacheKeyReply)
+	return r, nil
+}
+
+// DeleteRateLimit deletes the rate limiter by key, so it is full again.
+func (s *Server) DeleteRateLimit(ctx context.Context, m *messages.DeleteRateLimitCacheKeyMessage) (*messages.DeleteRateLimitCacheKeyReply, error) {
+	r, _ := act.AwaitReply(s.RateLimits, m).(*messages.DeleteRateLimitCacheKeyReply)
+	return r, nil
+}
+
+// TakeRateLimit takes the cost from the rate limiter if it is allowed, the missing rate limiter is created.
+func (s *Server) TakeRateLimit(ctx context.Context, m *messages.TakeRateLimitCacheKeyMessage) (*messages.TakeRateLimitCacheKeyReply, error) {
+	r, _ := act.AwaitReply(s.RateLimits, m).(*messages.TakeRateLimitCacheKeyReply)
+	return r, nil
+}
@@ -0,0 +1,70 @@
+package rpc
+
+import (
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/VitalKrasilnikau/memcache/core/actors"
+	"github.com/VitalKrasilnikau/memcache/core/messages"
+	"google.golang.org/grpc"
+	"log"
+	"net"
+)
+
+// Server is a gRPC CacheService implementation which routes calls to the cache actor clusters.
+type Server struct {
+	Strings         *actor.PID
+	StringKeys      *act.BroadcastStringKeysGroup
+	Lists           *actor.PID
+	ListKeys        *act.BroadcastStringKeysGroup
+	Dictionaries    *actor.PID
+	DictionaryKeys  *act.BroadcastStringKeysGroup
+	Sets            *actor.PID
+	SetKeys         *act.BroadcastStringKeysGroup
+	SortedSets      *actor.PID
+	SortedSetKeys   *act.BroadcastStringKeysGroup
+	Documents       *actor.PID
+	DocumentKeys    *act.BroadcastStringKeysGroup
+	HyperLogLogs    *actor.PID
+	HyperLogLogKeys *act.BroadcastStringKeysGroup
+	BloomFilters    *actor.PID
+	BloomFilterKeys *act.BroadcastStringKeysGroup
+	Streams         *actor.PID
+	StreamKeys      *act.BroadcastStringKeysGroup
+	Geos            *actor.PID
+	GeoKeys         *act.BroadcastStringKeysGroup
+	Queues          *actor.PID
+	QueueKeys       *act.BroadcastStringKeysGroup
+	RateLimits      *actor.PID
+	RateLimitKeys   *act.BroadcastStringKeysGroup
+	Locks           *actor.PID
+	LockKeys        *act.BroadcastStringKeysGroup
+}
+
+// ListenAndServe serves CacheService on the TCP address specified.
+func (s *Server) ListenAndServe(address string) error {
+	l, err := net.Listen("tcp", address)
+	if err != nil {
+		return err
+	}
+	g := grpc.NewServer()
+	messages.RegisterCacheServiceServer(g, s)
+	log.Printf("[gRPC] Listening on %s", address)
+	return g.Serve(l)
+}
+
+// keyStream is implemented by all the key listing server streams.
+type keyStream interface {
+	Send(*messages.CacheKey) error
+}
+
+func sendKeys(group *act.BroadcastStringKeysGroup, stream keyStream) error {
+	res, err := group.Request()
+	if err != nil {
+		return err
+	}
+	for _, k := range res.Keys {
+		if err = stream.Send(&messages.CacheKey{Key: k}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
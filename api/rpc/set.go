@@ -0,0 +1,60 @@
+package rpc
+
+import (
+	"github.com/VitalKrasilnikau/memcache/core/actors"
+	"github.com/VitalKrasilnikau/memcache/core/messages"
+	"golang.org/x/net/context"
+)
+
+// GetSetKeys streams all set cache keys.
+func (s *Server) GetSetKeys(m *messages.GetCacheKeysMessage, stream messages.CacheService_GetSetKeysServer) error {
+	return sendKeys(s.SetKeys, stream)
+}
+
+// GetSet gets set cache entry by key.
+func (s *Server) GetSet(ctx context.Context, m *messages.GetSetCacheKeyMessage) (*messages.GetSetCacheKeyReply, error) {
+	r, _ := act.AwaitReply(s.Sets, m).(*messages.GetSetCacheKeyReply)
+	return r, nil
+}
+
+// PostSet adds new set cache entry.
+func (s *Server) PostSet(ctx context.Context, m *messages.PostSetCacheKeyMessage) (*messages.PostSetCacheKeyReply, error) {
+	r, _ := act.AwaitReply(s.Sets, m).(*messages.PostSetCacheKeyReply)
+	return r, nil
+}
+
+// DeleteSet deletes set cache entry by key.
+func (s *Server) DeleteSet(ctx context.Context, m *messages.DeleteSetCacheKeyMessage) (*messages.DeleteSetCacheKeyReply, error) {
+	r, _ := act.AwaitReply(s.Sets, m).(*messages.DeleteSetCacheKeyReply)
+	return r, nil
+}
+
+// PostSetValues adds new members to the existing set.
+func (s *Server) PostSetValues(ctx context.Context, m *messages.PostSetCacheValuesMessage) (*messages.PostSetCacheValuesReply, error) {
+	r, _ := act.AwaitReply(s.Sets, m).(*messages.PostSetCacheValuesReply)
+	return r, nil
+}
+
+// DeleteSetValues removes the members from the existing set.
+func (s *Server) DeleteSetValues(ctx context.Context, m *messages.DeleteSetCacheValuesMessage) (*messages.DeleteSetCacheValuesReply, error) {
+	r, _ := act.AwaitReply(s.Sets, m).(*messages.DeleteSetCacheValuesReply)
+	return r, nil
+}
+
+// ContainsSetValue checks if the existing set contains the member.
+func (s *Server) ContainsSetValue(ctx context.Context, m *messages.ContainsSetCacheValueMessage) (*messages.ContainsSetCacheValueReply, error) {
+	r, _ := act.AwaitReply(s.Sets, m).(*messages.ContainsSetCacheValueReply)
+	return r, nil
+}
+
+// GetSetCardinality gets the number of members in the existing set.
+func (s *Server) GetSetCardinality(ctx context.Context, m *messages.GetSetCacheCardinalityMessage) (*messages.GetSetCacheCardinalityReply, error) {
+	r, _ := act.AwaitReply(s.Sets, m).(*messages.GetSetCacheCardinalityReply)
+	return r, nil
+}
+
+// GetSetRandomValues gets distinct random members of the existing set.
+func (s *Server) GetSetRandomValues(ctx context.Context, m *messages.GetSetCacheRandomValuesMessage) (*messages.GetSetCacheRandomValuesReply, error) {
+	r, _ := act.AwaitReply(s.Sets, m).(*messages.GetSetCacheRandomValuesReply)
+	return r, nil
+}
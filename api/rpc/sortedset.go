@@ -0,0 +1,66 @@
+package rpc
+
+import (
+	"github.com/VitalKrasilnikau/memcache/core/actors"
+	"github.com/VitalKrasilnikau/memcache/core/messages"
+	"golang.org/x/net/context"
+)
+
+// GetSortedSetKeys streams all sorted set cache keys.
+func (s *Server) GetSortedSetKeys(m *messages.GetCacheKeysMessage, stream messages.CacheService_GetSortedSetKeysServer) error {
+	return sendKeys(s.SortedSetKeys, stream)
+}
+
+// GetSortedSet gets sorted set cache entry by key.
+func (s *Server) GetSortedSet(ctx context.Context, m *messages.GetSortedSetCacheKeyMessage) (*messages.GetSortedSetCacheKeyReply, error) {
+	r, _ := act.AwaitReply(s.SortedSets, m).(*messages.GetSortedSetCacheKeyReply)
+	return r, nil
+}
+
+// PostSortedSet adds new sorted set cache entry.
+func (s *Server) PostSortedSet(ctx context.Context, m *messages.PostSortedSetCacheKeyMessage) (*messages.PostSortedSetCacheKeyReply, error) {
+	r, _ := act.AwaitReply(s.SortedSets, m).(*messages.PostSortedSetCacheKeyReply)
+	return r, nil
+}
+
+// DeleteSortedSet deletes sorted set cache entry by key.
+func (s *Server) DeleteSortedSet(ctx context.Context, m *messages.DeleteSortedSetCacheKeyMessage) (*messages.DeleteSortedSetCacheKeyReply, error) {
+	r, _ := act.AwaitReply(s.SortedSets, m).(*messages.DeleteSortedSetCacheKeyReply)
+	return r, nil
+}
+
+// PostSortedSetValues adds new members to the existing sorted set or updates their scores.
+func (s *Server) PostSortedSetValues(ctx context.Context, m *messages.PostSortedSetCacheValuesMessage) (*messages.PostSortedSetCacheValuesReply, error) {
+	r, _ := act.AwaitReply(s.SortedSets, m).(*messages.PostSortedSetCacheValuesReply)
+	return r, nil
+}
+
+// IncrementSortedSetScore adds delta to the score of the sorted set member.
+func (s *Server) IncrementSortedSetScore(ctx context.Context, m *messages.IncrementSortedSetCacheScoreMessage) (*messages.IncrementSortedSetCacheScoreReply, error) {
+	r, _ := act.AwaitReply(s.SortedSets, m).(*messages.IncrementSortedSetCacheScoreReply)
+	return r, nil
+}
+
+// DeleteSortedSetValues removes the members from the existing sorted set.
+func (s *Server) DeleteSortedSetValues(ctx context.Context, m *messages.DeleteSortedSetCacheValuesMessage) (*messages.DeleteSortedSetCacheValuesReply, error) {
+	r, _ := act.AwaitReply(s.SortedSets, m).(*messages.DeleteSortedSetCacheValuesReply)
+	return r, nil
+}
+
+// GetSortedSetRange gets the sorted set members by the range of ranks.
+func (s *Server) GetSortedSetRange(ctx context.Context, m *messages.GetSortedSetCacheRangeMessage) (*messages.GetSortedSetCacheRangeReply, error) {
+	r, _ := act.AwaitReply(s.SortedSets, m).(*messages.GetSortedSetCacheRangeReply)
+	return r, nil
+}
+
+// GetSortedSetRangeByScore gets the sorted set members by the range of scores.
+func (s *Server) GetSortedSetRangeByScore(ctx context.Context, m *messages.GetSortedSetCacheRangeByScoreMessage) (*messages.GetSortedSetCacheRangeByScoreReply, error) {
+	r, _ := act.AwaitReply(s.SortedSets, m).(*messages.GetSortedSetCacheRangeByScoreReply)
+	return r, nil
+}
+
+// GetSortedSetRank gets rank and score of the sorted set member.
+func (s *Server) GetSortedSetRank(ctx context.Context, m *messages.GetSortedSetCacheRankMessage) (*messages.GetSortedSetCacheRankReply, error) {
+	r, _ := act.AwaitReply(s.SortedSets, m).(*messages.GetSortedSetCacheRankReply)
+	return r, nil
+}
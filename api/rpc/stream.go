@@ -0,0 +1,84 @@
+package rpc
+
+import (
+	"github.com/VitalKrasilnikau/memcache/core/actors"
+	"github.com/VitalKrasilnikau/memcache/core/messages"
+	"golang.org/x/net/context"
+)
+
+// GetStreamKeys streams all stream cache keys.
+func (s *Server) GetStreamKeys(m *messages.GetCacheKeysMessage, stream messages.CacheService_GetStreamKeysServer) error {
+	return sendKeys(s.StreamKeys, stream)
+}
+
+// GetStream gets the length, the first and the last IDs of stream cache entry by key.
+func (s *Server) GetStream(ctx context.Context, m *messages.GetStreamCacheKeyMessage) (*messages.GetStreamCacheKeyReply, error) {
+	r, _ := act.AwaitReply(s.Streams, m).(*messages.GetStreamCacheKeyReply)
+	return r, nil
+}
+
+// PostStream posts new empty stream cache entry.
+func (s *Server) PostStream(ctx context.Context, m *messages.PostStreamCacheKeyMessage) (*messages.PostStreamCacheKeyReply, error) {
+	r, _ := act.AwaitReply(s.Streams, m).(*messages.PostStreamCacheKeyReply)
+	return r, nil
+}
+
+// DeleteStream deletes stream cache entry by key.
+func (s *Server) DeleteStream(ctx context.Context, m *messages.DeleteStreamCacheKeyMessage) (*messages.DeleteStreamCacheKeyReply, error) {
+	r, _ := act.AwaitReply(s.Streams, m).(*messages.DeleteStreamCacheKeyReply)
+	return r, nil
+}
+
+// AppendStreamEntry appends the entry to the stream like XADD.
+func (s *Server) AppendStreamEntry(ctx context.Context, m *messages.AppendStreamCacheEntryMessage) (*messages.AppendStreamCacheEntryReply, error) {
+	r, _ := act.AwaitReply(s.Streams, m).(*messages.AppendStreamCacheEntryReply)
+	return r, nil
+}
+
+// GetStreamRange gets the entries of the stream by the range of IDs like XRANGE.
+func (s *Server) GetStreamRange(ctx context.Context, m *messages.GetStreamCacheRangeMessage) (*messages.GetStreamCacheRangeReply, error) {
+	r, _ := act.AwaitReply(s.Streams, m).(*messages.GetStreamCacheRangeReply)
+	return r, nil
+}
+
+// TrimStream removes the oldest entries of the stream like XTRIM.
+func (s *Server) TrimStream(ctx context.Context, m *messages.TrimStreamCacheKeyMessage) (*messages.TrimStreamCacheKeyReply, error) {
+	r, _ := act.AwaitReply(s.Streams, m).(*messages.TrimStreamCacheKeyReply)
+	return r, nil
+}
+
+// GetStreamGroups gets the consumer groups of the stream.
+func (s *Server) GetStreamGroups(ctx context.Context, m *messages.GetStreamCacheGroupsMessage) (*messages.GetStreamCacheGroupsReply, error) {
+	r, _ := act.AwaitReply(s.Streams, m).(*messages.GetStreamCacheGroupsReply)
+	return r, nil
+}
+
+// CreateStreamGroup adds the consumer group to the stream like XGROUP CREATE.
+func (s *Server) CreateStreamGroup(ctx context.Context, m *messages.CreateStreamCacheGroupMessage) (*messages.CreateStreamCacheGroupReply, error) {
+	r, _ := act.AwaitReply(s.Streams, m).(*messages.CreateStreamCacheGroupReply)
+	return r, nil
+}
+
+// DeleteStreamGroup removes the consumer group of the stream like XGROUP DESTROY.
+func (s *Server) DeleteStreamGroup(ctx context.Context, m *messages.DeleteStreamCacheGroupMessage) (*messages.DeleteStreamCacheGroupReply, error) {
+	r, _ := act.AwaitReply(s.Streams, m).(*messages.DeleteStreamCacheGroupReply)
+	return r, nil
+}
+
+// ReadStreamGroup delivers the entries to the consumer of the group like XREADGROUP.
+func (s *Server) ReadStreamGroup(ctx context.Context, m *messages.ReadStreamCacheGroupMessage) (*messages.ReadStreamCacheGroupReply, error) {
+	r, _ := act.AwaitReply(s.Streams, m).(*messages.ReadStreamCacheGroupReply)
+	return r, nil
+}
+
+// AckStreamGroup acknowledges the pending entries of the group like XACK.
+func (s *Server) AckStreamGroup(ctx context.Context, m *messages.AckStreamCacheGroupMessage) (*messages.AckStreamCacheGroupReply, error) {
+	r, _ := act.AwaitReply(s.Streams, m).(*messages.AckStreamCacheGroupReply)
+	return r, nil
+}
+
+// GetStreamPending gets the pending entries of the group like XPENDING.
+func (s *Server) GetStreamPending(ctx context.Context, m *messages.GetStreamCachePendingMessage) (*messages.GetStreamCachePendingReply, error) {
+	r, _ := act.AwaitReply(s.Streams, m).(*messages.GetStreamCachePendingReply)
+	return r, nil
+}
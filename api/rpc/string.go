@@ -0,0 +1,102 @@
+package rpc
+
+import (
+	"github.com/VitalKrasilnikau/memcache/core/actors"
+	"github.com/VitalKrasilnikau/memcache/core/messages"
+	"golang.org/x/net/context"
+)
+
+// GetStringKeys streams all string cache keys.
+func (s *Server) GetStringKeys(m *messages.GetCacheKeysMessage, stream messages.CacheService_GetStringKeysServer) error {
+	return sendKeys(s.StringKeys, stream)
+}
+
+// GetString gets string cache entry by key.
+func (s *Server) GetString(ctx context.Context, m *messages.GetStringCacheKeyMessage) (*messages.GetStringCacheKeyReply, error) {
+	r, _ := act.AwaitReply(s.Strings, m).(*messages.GetStringCacheKeyReply)
+	return r, nil
+}
+
+// PostString adds new string cache entry.
+func (s *Server) PostString(ctx context.Context, m *messages.PostStringCacheKeyMessage) (*messages.PostStringCacheKeyReply, error) {
+	r, _ := act.AwaitReply(s.Strings, m).(*messages.PostStringCacheKeyReply)
+	return r, nil
+}
+
+// PutString updates existing string value by the key and original value specified.
+func (s *Server) PutString(ctx context.Context, m *messages.PutStringCacheKeyMessage) (*messages.PutStringCacheKeyReply, error) {
+	r, _ := act.AwaitReply(s.Strings, m).(*messages.PutStringCacheKeyReply)
+	return r, nil
+}
+
+// SetString adds new string cache entry or replaces the existing one.
+func (s *Server) SetString(ctx context.Context, m *messages.SetStringCacheKeyMessage) (*messages.SetStringCacheKeyReply, error) {
+	r, _ := act.AwaitReply(s.Strings, m).(*messages.SetStringCacheKeyReply)
+	return r, nil
+}
+
+// ReplaceString replaces the existing string cache entry.
+func (s *Server) ReplaceString(ctx context.Context, m *messages.ReplaceStringCacheKeyMessage) (*messages.ReplaceStringCacheKeyReply, error) {
+	r, _ := act.AwaitReply(s.Strings, m).(*messages.ReplaceStringCacheKeyReply)
+	return r, nil
+}
+
+// CompareAndSwapString replaces the existing string cache entry if its version was not changed.
+func (s *Server) CompareAndSwapString(ctx context.Context, m *messages.CompareAndSwapStringCacheKeyMessage) (*messages.CompareAndSwapStringCacheKeyReply, error) {
+	r, _ := act.AwaitReply(s.Strings, m).(*messages.CompareAndSwapStringCacheKeyReply)
+	return r, nil
+}
+
+// TouchString sets new TTL for the existing string cache entry.
+func (s *Server) TouchString(ctx context.Context, m *messages.TouchStringCacheKeyMessage) (*messages.TouchStringCacheKeyReply, error) {
+	r, _ := act.AwaitReply(s.Strings, m).(*messages.TouchStringCacheKeyReply)
+	return r, nil
+}
+
+// IncrementString atomically increments numeric string value by the key.
+func (s *Server) IncrementString(ctx context.Context, m *messages.IncrementStringCacheKeyMessage) (*messages.IncrementStringCacheKeyReply, error) {
+	r, _ := act.AwaitReply(s.Strings, m).(*messages.IncrementStringCacheKeyReply)
+	return r, nil
+}
+
+// SetBitString sets the bit of string value like SETBIT, the missing key is created if requested.
+func (s *Server) SetBitString(ctx context.Context, m *messages.SetBitStringCacheKeyMessage) (*messages.SetBitStringCacheKeyReply, error) {
+	r, _ := act.AwaitReply(s.Strings, m).(*messages.SetBitStringCacheKeyReply)
+	return r, nil
+}
+
+// GetBitString returns the bit of string value like GETBIT.
+func (s *Server) GetBitString(ctx context.Context, m *messages.GetBitStringCacheKeyMessage) (*messages.GetBitStringCacheKeyReply, error) {
+	r, _ := act.AwaitReply(s.Strings, m).(*messages.GetBitStringCacheKeyReply)
+	return r, nil
+}
+
+// CountBitsString counts the bits set in the range of string value like BITCOUNT.
+func (s *Server) CountBitsString(ctx context.Context, m *messages.CountBitsStringCacheKeyMessage) (*messages.CountBitsStringCacheKeyReply, error) {
+	r, _ := act.AwaitReply(s.Strings, m).(*messages.CountBitsStringCacheKeyReply)
+	return r, nil
+}
+
+// GetBitPosString returns the position of the first bit set to 0 or 1 in string value like BITPOS.
+func (s *Server) GetBitPosString(ctx context.Context, m *messages.GetBitPosStringCacheKeyMessage) (*messages.GetBitPosStringCacheKeyReply, error) {
+	r, _ := act.AwaitReply(s.Strings, m).(*messages.GetBitPosStringCacheKeyReply)
+	return r, nil
+}
+
+// BitOpString stores the result of bitwise operation on the values returned by GetString like BITOP.
+func (s *Server) BitOpString(ctx context.Context, m *messages.BitOpStringCacheKeyMessage) (*messages.BitOpStringCacheKeyReply, error) {
+	r, _ := act.AwaitReply(s.Strings, m).(*messages.BitOpStringCacheKeyReply)
+	return r, nil
+}
+
+// BitFieldString gets, sets and increments integers of arbitrary width in string value like BITFIELD.
+func (s *Server) BitFieldString(ctx context.Context, m *messages.BitFieldStringCacheKeyMessage) (*messages.BitFieldStringCacheKeyReply, error) {
+	r, _ := act.AwaitReply(s.Strings, m).(*messages.BitFieldStringCacheKeyReply)
+	return r, nil
+}
+
+// DeleteString deletes string cache entry by key.
+func (s *Server) DeleteString(ctx context.Context, m *messages.DeleteStringCacheKeyMessage) (*messages.DeleteStringCacheKeyReply, error) {
+	r, _ := act.AwaitReply(s.Strings, m).(*messages.DeleteStringCacheKeyReply)
+	return r, nil
+}
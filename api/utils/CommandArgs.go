@@ -1,14 +1,23 @@
 package api
 
 import (
+	"flag"
+	"github.com/VitalKrasilnikau/memcache/core/cache"
 	"os"
 	"strconv"
+	"strings"
+	"time"
 )
 
 const (
-	defaultPort = "8080"
-	noDb        = "no-db"
-	actorNumber = 10
+	defaultPort   = "8080"
+	noDb          = "no-db"
+	actorNumber   = 10
+	respPort      = "6379"
+	memcachedPort = "11211"
+	grpcPort      = "50051"
+	sweepInterval = time.Second
+	sweepBudget   = 10 * time.Millisecond
 )
 
 // CommandArgs is a structure holding parameters from console.
@@ -17,11 +26,31 @@ type CommandArgs struct {
 	Port           string
 	ActorNumber    int
 	IsRemote			 bool
+	RESPPort       string
+	MemcachedPort  string
+	GRPCPort       string
+	CacheOptions   cache.Options
 }
 
 // NewCommandArgs parses the console parameters.
+// The positional parameters may be followed by memory options of each cache actor:
+// -max-entries, -max-bytes, -eviction (lru, lfu, random, volatile-ttl or noeviction), -sweep-interval and -sweep-budget,
+// and by the ports of Redis, memcached and gRPC listeners -resp-port, -memcached-port and -grpc-port, 0 turns the listener off.
 func NewCommandArgs() CommandArgs {
-	args := os.Args[1:]
+	positional := os.Args[1:]
+	var options []string
+	for i, a := range positional {
+		if strings.HasPrefix(a, "-") {
+			positional, options = positional[:i], positional[i:]
+			break
+		}
+	}
+	args := parseCommandArgs(positional)
+	parseOptions(&args, options)
+	return args
+}
+
+func parseCommandArgs(args []string) CommandArgs {
 	switch len(args) {
 	case 1:
 		if args[0] == noDb {
@@ -56,3 +85,24 @@ func NewCommandArgs() CommandArgs {
 		return CommandArgs{Port: defaultPort, UsePersistence: true, ActorNumber: actorNumber}
 	}
 }
+
+func parseOptions(args *CommandArgs, options []string) {
+	o := &args.CacheOptions
+	var policy string
+	flags := flag.NewFlagSet(os.Args[0], flag.ExitOnError)
+	flags.StringVar(&args.RESPPort, "resp-port", respPort, "Redis protocol port, 0 turns the listener off")
+	flags.StringVar(&args.MemcachedPort, "memcached-port", memcachedPort, "memcached protocol port, 0 turns the listener off")
+	flags.StringVar(&args.GRPCPort, "grpc-port", grpcPort, "gRPC port, 0 turns the listener off")
+	flags.IntVar(&o.Eviction.MaxEntries, "max-entries", 0, "max number of keys in each cache actor, 0 means no limit")
+	flags.Int64Var(&o.Eviction.MaxBytes, "max-bytes", 0, "max size of keys and values in each cache actor, 0 means no limit")
+	flags.StringVar(&policy, "eviction", "lru", "eviction policy: lru, lfu, random, volatile-ttl or noeviction")
+	flags.DurationVar(&o.Sweep.Interval, "sweep-interval", sweepInterval, "how often expired keys are removed in background, 0 turns the sweep off")
+	flags.DurationVar(&o.Sweep.Budget, "sweep-budget", sweepBudget, "max time of one sweep in each cache actor, 0 means no limit")
+	flags.Parse(options)
+	p, err := cache.ParseEvictionPolicy(policy)
+	if err != nil {
+		flags.Usage()
+		os.Exit(2)
+	}
+	o.Eviction.Policy = p
+}
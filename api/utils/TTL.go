@@ -0,0 +1,101 @@
+package api
+
+import (
+	"errors"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrExpirationInPast is returned when absolute expiration time specified in ttl field had already passed.
+	ErrExpirationInPast = errors.New("expiration time is in the past")
+	// ErrInvalidTTL is returned when ttl field is not empty but has none of the supported formats or is too long.
+	ErrInvalidTTL = errors.New("ttl must be a positive duration or an expiration time")
+)
+
+var (
+	clockDuration = regexp.MustCompile(`^(\d+):(\d{1,2})(?::(\d{1,2}))?$`)
+	isoDuration   = regexp.MustCompile(`^P(?:(\d+)Y)?(?:(\d+)M)?(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+(?:[.,]\d+)?)S)?)?$`)
+	timeLayouts   = []string{time.RFC3339Nano, "2006-01-02T15:04Z07:00"}
+)
+
+// maxSeconds is the longest duration in seconds which time.Duration can hold.
+const maxSeconds = float64(math.MaxInt64) / float64(time.Second)
+
+// ParseTTL converts ttl field of the API contracts to go duration. The following formats are supported:
+// hh:mm or hh:mm:ss ("36:00"), Go duration ("250ms", "1h30m"), ISO-8601 duration ("P1DT12H", "PT0.25S")
+// and RFC3339 expiration time ("2026-12-31T00:00:00Z").
+// Empty value means no expiration, malformed value or expiration time in the past is an error.
+func ParseTTL(s string) (time.Duration, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, nil
+	}
+	if m := clockDuration.FindStringSubmatch(s); m != nil {
+		return parseClockDuration(m)
+	}
+	if m := isoDuration.FindStringSubmatch(strings.ToUpper(s)); m != nil {
+		return parseISODuration(m, time.Now())
+	}
+	for _, layout := range timeLayouts {
+		if t, e := time.Parse(layout, s); e == nil {
+			ttl := time.Until(t)
+			if ttl <= 0 {
+				return 0, ErrExpirationInPast
+			}
+			return ttl, nil
+		}
+	}
+	if d, e := time.ParseDuration(s); e == nil && d > 0 {
+		return d, nil
+	}
+	return 0, ErrInvalidTTL
+}
+
+func parseClockDuration(m []string) (time.Duration, error) {
+	h, err := strconv.Atoi(m[1])
+	min, _ := strconv.Atoi(m[2])
+	sec := 0
+	if m[3] != "" {
+		sec, _ = strconv.Atoi(m[3])
+	}
+	total := float64(h)*3600 + float64(min*60+sec)
+	if err != nil || min > 59 || sec > 59 || total <= 0 || total >= maxSeconds {
+		return 0, ErrInvalidTTL
+	}
+	return time.Duration(h)*time.Hour + time.Duration(min)*time.Minute + time.Duration(sec)*time.Second, nil
+}
+
+// parseISODuration converts ISO-8601 duration to go duration, years and months are counted from the time specified.
+// At least one component is required, "P" and "PT" alone are not durations.
+func parseISODuration(m []string, now time.Time) (time.Duration, error) {
+	if strings.Join(m[1:], "") == "" || strings.HasSuffix(m[0], "T") {
+		return 0, ErrInvalidTTL
+	}
+	var v [7]int
+	for i := 1; i < 7; i++ {
+		if m[i] == "" {
+			continue
+		}
+		n, err := strconv.Atoi(m[i])
+		if err != nil {
+			return 0, ErrInvalidTTL
+		}
+		v[i] = n
+	}
+	sec := 0.0
+	if m[7] != "" {
+		sec, _ = strconv.ParseFloat(strings.Replace(m[7], ",", ".", 1), 64)
+	}
+	// the longest year and month are used, so the date is not moved past the limit below
+	total := float64(v[1])*366*86400 + float64(v[2])*31*86400 + float64(7*v[3]+v[4])*86400 + float64(v[5])*3600 + float64(v[6])*60 + sec
+	d := now.AddDate(v[1], v[2], 7*v[3]+v[4]).Sub(now)
+	d += time.Duration(v[5])*time.Hour + time.Duration(v[6])*time.Minute + time.Duration(sec*float64(time.Second))
+	if total >= maxSeconds || d <= 0 {
+		return 0, ErrInvalidTTL
+	}
+	return d, nil
+}
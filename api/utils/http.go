@@ -25,6 +25,16 @@ func NotFound(c *gin.Context, message string) {
 	c.JSON(http.StatusNotFound, contracts.ErrorContract{Status: message})
 }
 
+// PreconditionFailed is 412 status response handler.
+func PreconditionFailed(c *gin.Context, message string) {
+	c.JSON(http.StatusPreconditionFailed, contracts.ErrorContract{Status: message})
+}
+
+// Conflict is 409 status response handler.
+func Conflict(c *gin.Context, message string) {
+	c.JSON(http.StatusConflict, contracts.ErrorContract{Status: message})
+}
+
 // NoContent is 204 status response handler.
 func NoContent(c *gin.Context) {
 	c.String(http.StatusNoContent, "")
@@ -40,43 +50,46 @@ func OK(c *gin.Context, obj interface{}) {
 	c.JSON(http.StatusOK, obj)
 }
 
-// DurationToString converts Go duration into string of format hh:mm:ss
+// Data is 200 status response handler for raw values.
+func Data(c *gin.Context, contentType string, data []byte) {
+	c.Data(http.StatusOK, contentType, data)
+}
+
+// DurationToString converts Go duration into ttl field value, zero duration means no expiration.
 func DurationToString(ttl time.Duration) string {
-	return fmt.Sprintf("%.0f:%.0f:%.0f", ttl.Hours(), ttl.Minutes(), ttl.Seconds())
+	if ttl <= 0 {
+		return ""
+	}
+	return ttl.String()
+}
+
+// SetVersion writes cache entry version to ETag header, zero version is skipped.
+func SetVersion(c *gin.Context, version int64) {
+	if version != 0 {
+		c.Header("ETag", fmt.Sprintf("\"%d\"", version))
+	}
+}
+
+// SetRateLimit writes the capacity and the remaining capacity of the rate limiter to X-RateLimit headers,
+// positive retryAfter is written to Retry-After header rounded up to seconds.
+func SetRateLimit(c *gin.Context, limit int64, remaining int64, retryAfter time.Duration) {
+	c.Header("X-RateLimit-Limit", strconv.FormatInt(limit, 10))
+	c.Header("X-RateLimit-Remaining", strconv.FormatInt(remaining, 10))
+	if retryAfter > 0 {
+		c.Header("Retry-After", strconv.FormatInt(int64((retryAfter+time.Second-1)/time.Second), 10))
+	}
 }
 
-// ParseDuration converts string values like "01:05:20" or "11:05" (hh:mm) to go duration.
-func ParseDuration(durationString string) time.Duration {
-	if durationString == "" {
-		return 0
+// IfMatchVersion returns cache entry version from If-Match header, zero means any version.
+// Both "12" and 12 are accepted, weak "W/" prefix is ignored.
+func IfMatchVersion(c *gin.Context) (int64, error) {
+	h := strings.TrimSpace(c.GetHeader("If-Match"))
+	if h == "" || h == "*" {
+		return 0, nil
 	}
-	parts := strings.Split(durationString, ":")
-	switch len(parts) {
-	case 2:
-		h, e := strconv.Atoi(parts[0])
-		if e != nil || h < 0 || h > 23 {
-			return 0
-		}
-		m, e := strconv.Atoi(parts[1])
-		if e != nil || m < 0 || m > 59 {
-			return 0
-		}
-		return time.Duration(h)*time.Hour + time.Duration(m)*time.Minute
-	case 3:
-		h, e := strconv.Atoi(parts[0])
-		if e != nil || h < 0 || h > 23 {
-			return 0
-		}
-		m, e := strconv.Atoi(parts[1])
-		if e != nil || m < 0 || m > 59 {
-			return 0
-		}
-		s, e := strconv.Atoi(parts[2])
-		if e != nil || s < 0 || s > 59 {
-			return 0
-		}
-		return time.Duration(h)*time.Hour + time.Duration(m)*time.Minute + time.Duration(s)*time.Second
-	default:
-		return 0
+	v, err := strconv.ParseInt(strings.Trim(strings.TrimPrefix(h, "W/"), "\""), 10, 64)
+	if err != nil || v <= 0 {
+		return 0, fmt.Errorf("malformed If-Match header '%s'", h)
 	}
+	return v, nil
 }
@@ -5,15 +5,32 @@ import (
 	"fmt"
 	"github.com/VitalKrasilnikau/memcache/api/contracts"
 	"github.com/VitalKrasilnikau/memcache/api/utils"
+	"github.com/VitalKrasilnikau/memcache/core/cache"
 	"gopkg.in/resty.v1"
 	"log"
+	"net/url"
+	"strconv"
+	"strings"
 	"time"
 )
 
 const (
-	stringEndpoint     = "string/"
-	listEndpoint       = "list/"
-	dictionaryEndpoint = "dictionary/"
+	stringEndpoint      = "string/"
+	listEndpoint        = "list/"
+	dictionaryEndpoint  = "dictionary/"
+	setEndpoint         = "set/"
+	sortedSetEndpoint   = "sortedset/"
+	documentEndpoint    = "document/"
+	hyperLogLogEndpoint = "hyperloglog/"
+	bloomFilterEndpoint = "bloom/"
+	streamEndpoint      = "stream/"
+	geoEndpoint         = "geo/"
+	queueEndpoint       = "queue/"
+	rateLimitEndpoint   = "ratelimit/"
+	lockEndpoint        = "lock/"
+	statsEndpoint       = "stats"
+	ttlSuffix           = "-ttl/"
+	metadataQuery       = "?meta=true"
 )
 
 // APIClient is a go client lib for accessing memory cache.
@@ -59,11 +76,114 @@ func (c APIClient) PutStringKey(key string, newValue string, originalValue strin
 	return c.processResponse(resp, err, 204)
 }
 
+// PutStringKeyVersion updates string key with new value in the cache if the key still has the version specified.
+func (c APIClient) PutStringKeyVersion(key string, newValue string, version int64) (bool, contracts.ErrorContract, error) {
+	req := contracts.UpdateStringCacheValueContract{NewValue: newValue, Version: version}
+	resp, err := resty.SetHTTPMode().R().
+		SetBody(req).
+		Put(c.buildURL(stringEndpoint + key))
+	return c.processResponse(resp, err, 204)
+}
+
+// GetStringBytes returns the raw value of the string key together with its content type.
+// Values posted as JSON are returned as JSON contract bytes with application/json content type.
+func (c APIClient) GetStringBytes(key string) (bool, []byte, string, error) {
+	resp, err := c.getKey(stringEndpoint + key)
+	if err != nil {
+		return false, nil, "", err
+	}
+	if resp.StatusCode() != 200 {
+		return false, nil, "", nil
+	}
+	return true, resp.Body(), resp.Header().Get("Content-Type"), nil
+}
+
+// PostStringBytes adds new string key with the raw value to the cache, empty content type means application/octet-stream.
+func (c APIClient) PostStringBytes(key string, data []byte, contentType string, ttl time.Duration) (bool, contracts.ErrorContract, error) {
+	resp, err := resty.SetHTTPMode().R().
+		SetHeader("Content-Type", rawContentType(contentType)).
+		SetQueryParams(map[string]string{"key": key, "ttl": api.DurationToString(ttl)}).
+		SetBody(data).
+		Post(c.buildURL(stringEndpoint))
+	return c.processResponse(resp, err, 201)
+}
+
+// PutStringBytesVersion updates string key with the raw value in the cache if the key still has the version specified.
+func (c APIClient) PutStringBytesVersion(key string, data []byte, contentType string, version int64) (bool, contracts.ErrorContract, error) {
+	resp, err := resty.SetHTTPMode().R().
+		SetHeader("Content-Type", rawContentType(contentType)).
+		SetHeader("If-Match", fmt.Sprintf("\"%d\"", version)).
+		SetBody(data).
+		Put(c.buildURL(stringEndpoint + key))
+	return c.processResponse(resp, err, 204)
+}
+
 // DeleteStringKey removes string key from the cache.
 func (c APIClient) DeleteStringKey(key string) (bool, contracts.ErrorContract, error) {
 	return c.deleteKey(stringEndpoint + key)
 }
 
+// DeleteStringKeyVersion removes string key from the cache if the key still has the version specified.
+func (c APIClient) DeleteStringKeyVersion(key string, version int64) (bool, contracts.ErrorContract, error) {
+	return c.deleteKeyVersion(stringEndpoint+key, version)
+}
+
+// IncrementStringKey atomically increments numeric cache string entry and returns its new value.
+func (c APIClient) IncrementStringKey(key string, req contracts.IncrementCacheValueContract) (bool, contracts.StringCacheValueContract, error) {
+	var reply contracts.StringCacheValueContract
+	ok, err := c.postIncrement(stringEndpoint+key+"/incr", req, &reply)
+	return ok, reply, err
+}
+
+// DecrementStringKey atomically decrements numeric cache string entry and returns its new value.
+func (c APIClient) DecrementStringKey(key string, req contracts.IncrementCacheValueContract) (bool, contracts.StringCacheValueContract, error) {
+	var reply contracts.StringCacheValueContract
+	ok, err := c.postIncrement(stringEndpoint+key+"/decr", req, &reply)
+	return ok, reply, err
+}
+
+// SetStringBit sets the bit of cache string entry and returns the previous bit.
+func (c APIClient) SetStringBit(key string, req contracts.SetBitStringCacheContract) (bool, contracts.StringCacheBitContract, error) {
+	var reply contracts.StringCacheBitContract
+	ok, err := c.postStringOperation(key, "/bit", req, &reply)
+	return ok, reply, err
+}
+
+// GetStringBit returns the bit of cache string entry by offset.
+func (c APIClient) GetStringBit(key string, offset int64) (bool, contracts.StringCacheBitContract, error) {
+	var reply contracts.StringCacheBitContract
+	ok, err := c.getStringOperation(key, "/bit", map[string]string{"offset": strconv.FormatInt(offset, 10)}, &reply)
+	return ok, reply, err
+}
+
+// CountStringBits returns the number of bits set in cache string entry from start to end bytes inclusive.
+func (c APIClient) CountStringBits(key string, start int64, end int64) (bool, contracts.StringCacheBitCountContract, error) {
+	var reply contracts.StringCacheBitCountContract
+	ok, err := c.getStringOperation(key, "/bitcount", map[string]string{"start": strconv.FormatInt(start, 10), "end": strconv.FormatInt(end, 10)}, &reply)
+	return ok, reply, err
+}
+
+// GetStringBitPos returns the position of the first bit set to 0 or 1 in cache string entry starting from the byte specified.
+func (c APIClient) GetStringBitPos(key string, bit int, start int64) (bool, contracts.StringCacheBitPosContract, error) {
+	var reply contracts.StringCacheBitPosContract
+	ok, err := c.getStringOperation(key, "/bitpos", map[string]string{"bit": strconv.Itoa(bit), "start": strconv.FormatInt(start, 10)}, &reply)
+	return ok, reply, err
+}
+
+// BitOpStringKeys stores the result of and, or, xor or not operation on the string entries specified to the key.
+func (c APIClient) BitOpStringKeys(key string, op string, sources ...string) (bool, contracts.StringCacheBitOpContract, error) {
+	var reply contracts.StringCacheBitOpContract
+	ok, err := c.postStringOperation(key, "/bitop", contracts.BitOpStringCacheContract{Op: op, Keys: sources}, &reply)
+	return ok, reply, err
+}
+
+// BitFieldStringKey applies bitfield operations to cache string entry and returns their results.
+func (c APIClient) BitFieldStringKey(key string, req contracts.BitFieldStringCacheContract) (bool, contracts.StringCacheBitFieldContract, error) {
+	var reply contracts.StringCacheBitFieldContract
+	ok, err := c.postStringOperation(key, "/bitfield", req, &reply)
+	return ok, reply, err
+}
+
 // GetListKeys returns all list keys in the cache.
 func (c APIClient) GetListKeys() ([]string, error) {
 	return c.getKeys(listEndpoint)
@@ -88,6 +208,11 @@ func (c APIClient) DeleteListKey(key string) (bool, contracts.ErrorContract, err
 	return c.deleteKey(listEndpoint + key)
 }
 
+// DeleteListKeyVersion removes list from the cache if the key still has the version specified.
+func (c APIClient) DeleteListKeyVersion(key string, version int64) (bool, contracts.ErrorContract, error) {
+	return c.deleteKeyVersion(listEndpoint+key, version)
+}
+
 // DeleteListValue removes list value from the cache.
 func (c APIClient) DeleteListValue(key string, value string) (bool, contracts.ErrorContract, error) {
 	return c.deleteKey(fmt.Sprintf("%s%s/%s", listEndpoint, key, value))
@@ -120,6 +245,80 @@ func (c APIClient) PutListValue(key string, newValue string, originalValue strin
 	return c.processResponse(resp, err, 204)
 }
 
+// GetListIndex returns the value of the cache list entry by zero-based index, negative indexes are counted from the end.
+func (c APIClient) GetListIndex(key string, index int) (bool, contracts.ListCacheIndexValueContract, error) {
+	var reply contracts.ListCacheIndexValueContract
+	ok, err := c.getListOperation(key, "/index", map[string]string{"index": strconv.Itoa(index)}, &reply)
+	return ok, reply, err
+}
+
+// GetListRange returns the values of the cache list entry from start to stop indexes inclusive and the length of the list.
+func (c APIClient) GetListRange(key string, start int, stop int) (bool, contracts.ListCacheRangeContract, error) {
+	var reply contracts.ListCacheRangeContract
+	ok, err := c.getListOperation(key, "/range", map[string]string{"start": strconv.Itoa(start), "stop": strconv.Itoa(stop)}, &reply)
+	return ok, reply, err
+}
+
+// SetListIndex replaces the value of the cache list entry by its index.
+func (c APIClient) SetListIndex(key string, index int, value string) (bool, contracts.ErrorContract, error) {
+	req := contracts.UpdateListCacheIndexContract{Index: int64(index), Value: value}
+	resp, err := resty.SetHTTPMode().R().
+		SetBody(req).
+		Post(c.buildURL(listEndpoint + key + "/set"))
+	return c.processResponse(resp, err, 204)
+}
+
+// InsertListValue inserts the value to the cache list entry before the index and returns the new length of the list.
+func (c APIClient) InsertListValue(key string, index int, value string) (bool, contracts.ListCacheLengthContract, error) {
+	var reply contracts.ListCacheLengthContract
+	ok, err := c.postListOperation(key, "/insert", contracts.UpdateListCacheIndexContract{Index: int64(index), Value: value}, &reply)
+	return ok, reply, err
+}
+
+// RemoveListIndex removes the value of the cache list entry by its index and returns it.
+func (c APIClient) RemoveListIndex(key string, index int) (bool, contracts.ListCacheIndexValueContract, error) {
+	var reply contracts.ListCacheIndexValueContract
+	ok, err := c.postListOperation(key, "/remove", contracts.RemoveListCacheIndexContract{Index: int64(index)}, &reply)
+	return ok, reply, err
+}
+
+// LPushListValues adds the values to the head of the cache list entry and returns the new length of the list.
+func (c APIClient) LPushListValues(key string, values []string, create bool) (bool, contracts.ListCacheLengthContract, error) {
+	var reply contracts.ListCacheLengthContract
+	ok, err := c.postListOperation(key, "/lpush", contracts.PushListCacheValuesContract{Values: values, Create: create}, &reply)
+	return ok, reply, err
+}
+
+// RPushListValues adds the values to the tail of the cache list entry and returns the new length of the list.
+func (c APIClient) RPushListValues(key string, values []string, create bool) (bool, contracts.ListCacheLengthContract, error) {
+	var reply contracts.ListCacheLengthContract
+	ok, err := c.postListOperation(key, "/rpush", contracts.PushListCacheValuesContract{Values: values, Create: create}, &reply)
+	return ok, reply, err
+}
+
+// LPopListValues removes up to count values from the head of the cache list entry,
+// with positive timeout waits for the values if the list is empty or missing.
+func (c APIClient) LPopListValues(key string, count int, timeout time.Duration) (bool, contracts.ListCacheValueContract, error) {
+	var reply contracts.ListCacheValueContract
+	ok, err := c.postListOperation(key, "/lpop", contracts.PopListCacheValuesContract{Count: int32(count), Timeout: api.DurationToString(timeout)}, &reply)
+	return ok, reply, err
+}
+
+// RPopListValues removes up to count values from the tail of the cache list entry,
+// with positive timeout waits for the values if the list is empty or missing.
+func (c APIClient) RPopListValues(key string, count int, timeout time.Duration) (bool, contracts.ListCacheValueContract, error) {
+	var reply contracts.ListCacheValueContract
+	ok, err := c.postListOperation(key, "/rpop", contracts.PopListCacheValuesContract{Count: int32(count), Timeout: api.DurationToString(timeout)}, &reply)
+	return ok, reply, err
+}
+
+// TrimList keeps only the values of the cache list entry from start to stop indexes inclusive.
+func (c APIClient) TrimList(key string, start int, stop int) (bool, contracts.ListCacheTrimContract, error) {
+	var reply contracts.ListCacheTrimContract
+	ok, err := c.postListOperation(key, "/trim", contracts.TrimListCacheContract{Start: int64(start), Stop: int64(stop)}, &reply)
+	return ok, reply, err
+}
+
 // GetDictionaryKeys returns all dictionary keys in the cache.
 func (c APIClient) GetDictionaryKeys() ([]string, error) {
 	return c.getKeys(dictionaryEndpoint)
@@ -144,6 +343,11 @@ func (c APIClient) DeleteDictionaryKey(key string) (bool, contracts.ErrorContrac
 	return c.deleteKey(dictionaryEndpoint + key)
 }
 
+// DeleteDictionaryKeyVersion removes dictionary from the cache if the key still has the version specified.
+func (c APIClient) DeleteDictionaryKeyVersion(key string, version int64) (bool, contracts.ErrorContract, error) {
+	return c.deleteKeyVersion(dictionaryEndpoint+key, version)
+}
+
 // DeleteDictionaryValue removes dictionary value from the cache.
 func (c APIClient) DeleteDictionaryValue(key string, value string) (bool, contracts.ErrorContract, error) {
 	return c.deleteKey(fmt.Sprintf("%s%s/%s", dictionaryEndpoint, key, value))
@@ -171,6 +375,17 @@ func (c APIClient) PostDictionaryValue(
 	return c.processResponse(resp, err, 201)
 }
 
+// PostDictionaryValueTTL adds new value to the dictionary in the cache, the value expires after ttl while the rest of the dictionary is kept.
+func (c APIClient) PostDictionaryValueTTL(
+	key string,
+	value contracts.DictionaryKeyValueContract,
+	ttl time.Duration) (bool, contracts.ErrorContract, error) {
+	resp, err := resty.SetHTTPMode().R().
+		SetBody(contracts.AddDictionaryCacheValueContract{Value: value, TTL: api.DurationToString(ttl)}).
+		Post(c.buildURL(dictionaryEndpoint + key))
+	return c.processResponse(resp, err, 201)
+}
+
 // PutDictionaryValue updates dictionary value in the cache dictionary entry.
 func (c APIClient) PutDictionaryValue(key string, subKey string, value contracts.UpdateDictionaryCacheValueContract) (bool, contracts.ErrorContract, error) {
 	resp, err := resty.SetHTTPMode().R().
@@ -179,53 +394,1326 @@ func (c APIClient) PutDictionaryValue(key string, subKey string, value contracts
 	return c.processResponse(resp, err, 204)
 }
 
-func (c APIClient) processResponse(resp *resty.Response, err error, expectedCode int) (bool, contracts.ErrorContract, error) {
+// IncrementDictionaryValue atomically increments numeric value of the cache dictionary sub-key and returns its new value.
+func (c APIClient) IncrementDictionaryValue(key string, subKey string, req contracts.IncrementCacheValueContract) (bool, contracts.DictionaryCacheSubKeyValueContract, error) {
+	var reply contracts.DictionaryCacheSubKeyValueContract
+	ok, err := c.postIncrement(fmt.Sprintf("%s%s/%s/incr", dictionaryEndpoint, key, subKey), req, &reply)
+	return ok, reply, err
+}
+
+// DecrementDictionaryValue atomically decrements numeric value of the cache dictionary sub-key and returns its new value.
+func (c APIClient) DecrementDictionaryValue(key string, subKey string, req contracts.IncrementCacheValueContract) (bool, contracts.DictionaryCacheSubKeyValueContract, error) {
+	var reply contracts.DictionaryCacheSubKeyValueContract
+	ok, err := c.postIncrement(fmt.Sprintf("%s%s/%s/decr", dictionaryEndpoint, key, subKey), req, &reply)
+	return ok, reply, err
+}
+
+// GetDictionaryValues returns the values of the selected sub-keys of the cache dictionary entry, missing sub-keys are skipped.
+func (c APIClient) GetDictionaryValues(key string, subKeys ...string) (bool, contracts.DictionaryCacheValueContract, error) {
+	var reply contracts.DictionaryCacheValueContract
+	resp, err := resty.SetHTTPMode().R().
+		SetMultiValueQueryParams(url.Values{"subkey": subKeys}).
+		Get(c.buildURL(dictionaryEndpoint + key + "/values"))
+	ok, err := c.processReply(resp, err, &reply)
+	return ok, reply, err
+}
+
+// GetDictionarySubKeys returns the sorted sub-keys of the cache dictionary entry without their values.
+func (c APIClient) GetDictionarySubKeys(key string) (bool, contracts.DictionaryCacheSubKeysContract, error) {
+	var reply contracts.DictionaryCacheSubKeysContract
+	resp, err := resty.SetHTTPMode().R().
+		Get(c.buildURL(dictionaryEndpoint + key + "/subkeys"))
+	ok, err := c.processReply(resp, err, &reply)
+	return ok, reply, err
+}
+
+// ContainsDictionaryValues checks if the cache dictionary entry contains the sub-keys.
+func (c APIClient) ContainsDictionaryValues(key string, subKeys ...string) (bool, contracts.DictionaryCacheExistsContract, error) {
+	var reply contracts.DictionaryCacheExistsContract
+	resp, err := resty.SetHTTPMode().R().
+		SetMultiValueQueryParams(url.Values{"subkey": subKeys}).
+		Get(c.buildURL(dictionaryEndpoint + key + "/exists"))
+	ok, err := c.processReply(resp, err, &reply)
+	return ok, reply, err
+}
+
+// SetDictionaryValues adds missing sub-keys and updates existing ones of the cache dictionary entry atomically.
+func (c APIClient) SetDictionaryValues(key string, req contracts.SetDictionaryCacheValuesContract) (bool, contracts.DictionaryCacheSetResultContract, error) {
+	var reply contracts.DictionaryCacheSetResultContract
+	resp, err := resty.SetHTTPMode().R().
+		SetBody(req).
+		Patch(c.buildURL(dictionaryEndpoint + key))
+	ok, err := c.processReply(resp, err, &reply)
+	return ok, reply, err
+}
+
+// DeleteDictionaryValues deletes the sub-keys of the cache dictionary entry atomically and returns the values deleted.
+func (c APIClient) DeleteDictionaryValues(key string, subKeys ...string) (bool, contracts.DictionaryCacheValueContract, error) {
+	var reply contracts.DictionaryCacheValueContract
+	if len(subKeys) == 0 {
+		subKeys = []string{""} // the request without "subkey" deletes the whole entry
+	}
+	resp, err := resty.SetHTTPMode().R().
+		SetMultiValueQueryParams(url.Values{"subkey": subKeys}).
+		Delete(c.buildURL(dictionaryEndpoint + key))
+	ok, err := c.processReply(resp, err, &reply)
+	return ok, reply, err
+}
+
+// GetSetKeys returns all set keys in the cache.
+func (c APIClient) GetSetKeys() ([]string, error) {
+	return c.getKeys(setEndpoint)
+}
+
+// GetSetKey returns sorted set members by key from the cache.
+func (c APIClient) GetSetKey(key string) (bool, contracts.SetCacheValuesContract, error) {
+	resp, err := c.getKey(setEndpoint + key)
 	if err != nil {
-		log.Fatal("get failed: " + err.Error())
-		return false, contracts.ErrorContract{}, err
+		return false, contracts.SetCacheValuesContract{}, err
 	}
-	if resp.StatusCode() != expectedCode {
-		log.Printf(fmt.Sprintf("status: %d, code: %s", resp.StatusCode(), resp.Status()))
-		var reply contracts.ErrorContract
+	var reply contracts.SetCacheValuesContract
+	if err = json.Unmarshal(resp.Body(), &reply); err != nil {
+		log.Fatal("unmarshal failed: " + err.Error())
+		return false, contracts.SetCacheValuesContract{}, err
+	}
+	return resp.StatusCode() == 200, reply, nil
+}
+
+// DeleteSetKey removes set from the cache.
+func (c APIClient) DeleteSetKey(key string) (bool, contracts.ErrorContract, error) {
+	return c.deleteKey(setEndpoint + key)
+}
+
+// DeleteSetKeyVersion removes set from the cache if the key still has the version specified.
+func (c APIClient) DeleteSetKeyVersion(key string, version int64) (bool, contracts.ErrorContract, error) {
+	return c.deleteKeyVersion(setEndpoint+key, version)
+}
+
+// DeleteSetValue removes the member from the cache set entry.
+func (c APIClient) DeleteSetValue(key string, value string) (bool, contracts.ErrorContract, error) {
+	return c.deleteKey(fmt.Sprintf("%s%s/%s", setEndpoint, key, value))
+}
+
+// PostSetKey adds new set key and members to the cache.
+func (c APIClient) PostSetKey(key string, values []string, ttl time.Duration) (bool, contracts.ErrorContract, error) {
+	req := contracts.NewSetCacheValuesContract{Key: key, Values: values, TTL: api.DurationToString(ttl)}
+	resp, err := resty.SetHTTPMode().R().
+		SetBody(req).
+		Post(c.buildURL(setEndpoint))
+	return c.processResponse(resp, err, 201)
+}
+
+// PostSetValues adds new members to the cache set entry and returns the number of members added.
+func (c APIClient) PostSetValues(key string, values []string) (bool, contracts.SetCacheCountContract, error) {
+	req := contracts.UpdateSetCacheValuesContract{Values: values}
+	resp, err := resty.SetHTTPMode().R().
+		SetBody(req).
+		Post(c.buildURL(setEndpoint + key))
+	if err != nil {
+		log.Fatal("post failed: " + err.Error())
+		return false, contracts.SetCacheCountContract{}, err
+	}
+	var reply contracts.SetCacheCountContract
+	if resp.StatusCode() == 200 {
 		if err = json.Unmarshal(resp.Body(), &reply); err != nil {
 			log.Fatal("unmarshal failed: " + err.Error())
-			return false, contracts.ErrorContract{}, err
+			return false, contracts.SetCacheCountContract{}, err
 		}
-		return false, reply, nil
 	}
-	return true, contracts.ErrorContract{}, nil
+	return resp.StatusCode() == 200, reply, nil
 }
 
-func (c APIClient) getKey(endpoint string) (*resty.Response, error) {
-	var resp *resty.Response
-	var err error
-	if resp, err = resty.SetHTTPMode().R().Get(c.buildURL(endpoint)); err != nil {
-		log.Fatal("get failed: " + err.Error())
-		return nil, err
+// ContainsSetValue checks if the cache set entry contains the member.
+func (c APIClient) ContainsSetValue(key string, value string) (bool, contracts.SetCacheContainsContract, error) {
+	var reply contracts.SetCacheContainsContract
+	ok, err := c.getSetOperation(key, "contains", map[string]string{"value": value}, &reply)
+	return ok, reply, err
+}
+
+// GetSetCardinality returns the number of members in the cache set entry.
+func (c APIClient) GetSetCardinality(key string) (bool, contracts.SetCacheCountContract, error) {
+	var reply contracts.SetCacheCountContract
+	ok, err := c.getSetOperation(key, "cardinality", map[string]string{}, &reply)
+	return ok, reply, err
+}
+
+// GetSetRandomValues returns up to count distinct random members of the cache set entry.
+func (c APIClient) GetSetRandomValues(key string, count int) (bool, contracts.SetCacheValuesContract, error) {
+	var reply contracts.SetCacheValuesContract
+	ok, err := c.getSetOperation(key, "random", map[string]string{"count": fmt.Sprintf("%d", count)}, &reply)
+	return ok, reply, err
+}
+
+// GetSetUnion returns members which are in any of the sets, missing keys are treated as empty sets.
+func (c APIClient) GetSetUnion(key string, others ...string) (contracts.SetCacheAlgebraContract, error) {
+	return c.getSetAlgebra(key, "union", others)
+}
+
+// GetSetIntersection returns members which are in all the sets, missing keys are treated as empty sets.
+func (c APIClient) GetSetIntersection(key string, others ...string) (contracts.SetCacheAlgebraContract, error) {
+	return c.getSetAlgebra(key, "intersection", others)
+}
+
+// GetSetDifference returns members of the first set which are not in any of the other sets.
+func (c APIClient) GetSetDifference(key string, others ...string) (contracts.SetCacheAlgebraContract, error) {
+	return c.getSetAlgebra(key, "difference", others)
+}
+
+// GetSortedSetKeys returns all sorted set keys in the cache.
+func (c APIClient) GetSortedSetKeys() ([]string, error) {
+	return c.getKeys(sortedSetEndpoint)
+}
+
+// GetSortedSetKey returns sorted set members in ascending order of scores by key from the cache.
+func (c APIClient) GetSortedSetKey(key string) (bool, contracts.SortedSetCacheValuesContract, error) {
+	var reply contracts.SortedSetCacheValuesContract
+	ok, err := c.getSortedSetOperation(key, "", map[string]string{}, &reply)
+	return ok, reply, err
+}
+
+// DeleteSortedSetKey removes sorted set from the cache.
+func (c APIClient) DeleteSortedSetKey(key string) (bool, contracts.ErrorContract, error) {
+	return c.deleteKey(sortedSetEndpoint + key)
+}
+
+// DeleteSortedSetKeyVersion removes sorted set from the cache if the key still has the version specified.
+func (c APIClient) DeleteSortedSetKeyVersion(key string, version int64) (bool, contracts.ErrorContract, error) {
+	return c.deleteKeyVersion(sortedSetEndpoint+key, version)
+}
+
+// DeleteSortedSetValue removes the member from the cache sorted set entry.
+func (c APIClient) DeleteSortedSetValue(key string, member string) (bool, contracts.ErrorContract, error) {
+	return c.deleteKey(fmt.Sprintf("%s%s/%s", sortedSetEndpoint, key, member))
+}
+
+// PostSortedSetKey adds new sorted set key and members with scores to the cache.
+func (c APIClient) PostSortedSetKey(key string, members []contracts.SortedSetMemberContract, ttl time.Duration) (bool, contracts.ErrorContract, error) {
+	req := contracts.NewSortedSetCacheValuesContract{Key: key, Members: members, TTL: api.DurationToString(ttl)}
+	resp, err := resty.SetHTTPMode().R().
+		SetBody(req).
+		Post(c.buildURL(sortedSetEndpoint))
+	return c.processResponse(resp, err, 201)
+}
+
+// PostSortedSetValues adds new members to the cache sorted set entry or updates scores of existing ones
+// and returns the number of members added.
+func (c APIClient) PostSortedSetValues(key string, members []contracts.SortedSetMemberContract) (bool, contracts.SortedSetCacheCountContract, error) {
+	var reply contracts.SortedSetCacheCountContract
+	ok, err := c.postSortedSetOperation(key, "", contracts.UpdateSortedSetCacheValuesContract{Members: members}, &reply)
+	return ok, reply, err
+}
+
+// IncrementSortedSetScore adds delta to the score of the member in the cache sorted set entry and returns the new score.
+func (c APIClient) IncrementSortedSetScore(key string, member string, delta float64) (bool, contracts.SortedSetCacheScoreContract, error) {
+	var reply contracts.SortedSetCacheScoreContract
+	ok, err := c.postSortedSetOperation(key, "/incr", contracts.IncrementSortedSetCacheScoreContract{Member: member, Delta: delta}, &reply)
+	return ok, reply, err
+}
+
+// GetSortedSetRange returns the members of the cache sorted set entry from start to stop zero-based ranks inclusive,
+// negative ranks are counted from the end.
+func (c APIClient) GetSortedSetRange(key string, start int, stop int, reverse bool) (bool, contracts.SortedSetCacheValuesContract, error) {
+	var reply contracts.SortedSetCacheValuesContract
+	query := map[string]string{"start": strconv.Itoa(start), "stop": strconv.Itoa(stop), "reverse": strconv.FormatBool(reverse)}
+	ok, err := c.getSortedSetOperation(key, "/range", query, &reply)
+	return ok, reply, err
+}
+
+// GetSortedSetRangeByScore returns the members of the cache sorted set entry with the scores in the range specified,
+// the first offset members are skipped and count limits the number of members returned if positive.
+func (c APIClient) GetSortedSetRangeByScore(key string, r cache.ScoreRange, offset int, count int, reverse bool) (bool, contracts.SortedSetCacheValuesContract, error) {
+	var reply contracts.SortedSetCacheValuesContract
+	query := map[string]string{
+		"min":     formatScoreBound(r.Min, r.MinExclusive),
+		"max":     formatScoreBound(r.Max, r.MaxExclusive),
+		"offset":  strconv.Itoa(offset),
+		"count":   strconv.Itoa(count),
+		"reverse": strconv.FormatBool(reverse)}
+	ok, err := c.getSortedSetOperation(key, "/rangebyscore", query, &reply)
+	return ok, reply, err
+}
+
+// GetSortedSetRank returns zero-based rank and score of the member in the cache sorted set entry.
+func (c APIClient) GetSortedSetRank(key string, member string, reverse bool) (bool, contracts.SortedSetCacheRankContract, error) {
+	var reply contracts.SortedSetCacheRankContract
+	ok, err := c.getSortedSetOperation(key, "/rank", map[string]string{"member": member, "reverse": strconv.FormatBool(reverse)}, &reply)
+	return ok, reply, err
+}
+
+// GetDocumentKeys returns all document keys in the cache.
+func (c APIClient) GetDocumentKeys() ([]string, error) {
+	return c.getKeys(documentEndpoint)
+}
+
+// GetDocumentKey returns JSON document by key from the cache.
+func (c APIClient) GetDocumentKey(key string) (bool, contracts.DocumentCacheValueContract, error) {
+	return c.GetDocumentPath(key, "")
+}
+
+// GetDocumentPath returns the value at JSON Pointer ("/a/0") or dot notation ("$.a[0]") path of the cache document entry,
+// empty path returns the whole document.
+func (c APIClient) GetDocumentPath(key string, path string) (bool, contracts.DocumentCacheValueContract, error) {
+	var reply contracts.DocumentCacheValueContract
+	req := resty.SetHTTPMode().R()
+	if path != "" {
+		req = req.SetQueryParam("path", path)
 	}
-	return resp, nil
+	resp, err := req.Get(c.buildURL(documentEndpoint + key))
+	ok, err := c.processReply(resp, err, &reply)
+	return ok, reply, err
 }
 
-func (c APIClient) getKeys(endpoint string) ([]string, error) {
-	var resp *resty.Response
-	var err error
-	if resp, err = resty.SetHTTPMode().R().Get(c.buildURL(endpoint)); err != nil {
-		log.Fatal("get failed: " + err.Error())
-		return nil, err
+// PostDocumentKey adds new JSON document to the cache, the value is marshalled to JSON.
+func (c APIClient) PostDocumentKey(key string, value interface{}, ttl time.Duration) (bool, contracts.ErrorContract, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return false, contracts.ErrorContract{}, err
 	}
-	var reply contracts.CacheKeysContract
-	if err := json.Unmarshal(resp.Body(), &reply); err != nil {
-		log.Fatal("unmarshal failed: " + err.Error())
-		return nil, err
+	req := contracts.NewDocumentCacheValueContract{Key: key, Value: data, TTL: api.DurationToString(ttl)}
+	resp, err := resty.SetHTTPMode().R().
+		SetBody(req).
+		Post(c.buildURL(documentEndpoint))
+	return c.processResponse(resp, err, 201)
+}
+
+// SetDocumentPath adds or replaces the value at the path of the cache document entry, empty path replaces the whole document.
+func (c APIClient) SetDocumentPath(key string, path string, value interface{}) (bool, contracts.ErrorContract, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return false, contracts.ErrorContract{}, err
 	}
-	return reply.Keys, nil
+	resp, err := resty.SetHTTPMode().R().
+		SetBody(contracts.UpdateDocumentCachePathContract{Path: path, Value: data}).
+		Put(c.buildURL(documentEndpoint + key))
+	return c.processResponse(resp, err, 204)
 }
 
-func (c APIClient) buildURL(endpoint string) string {
-	return fmt.Sprintf("%s:%d/api/%s", c.Host, c.Port, endpoint)
+// AppendDocumentValues appends the values to the array at the path of the cache document entry and returns the new length of the array.
+func (c APIClient) AppendDocumentValues(key string, path string, values ...interface{}) (bool, contracts.DocumentCacheLengthContract, error) {
+	var reply contracts.DocumentCacheLengthContract
+	req := contracts.AppendDocumentCacheValuesContract{Path: path, Values: make([]json.RawMessage, len(values))}
+	for i, v := range values {
+		data, err := json.Marshal(v)
+		if err != nil {
+			return false, reply, err
+		}
+		req.Values[i] = data
+	}
+	resp, err := resty.SetHTTPMode().R().
+		SetBody(req).
+		Post(c.buildURL(documentEndpoint + key + "/append"))
+	ok, err := c.processReply(resp, err, &reply)
+	return ok, reply, err
 }
 
-func (c APIClient) deleteKey(endpoint string) (bool, contracts.ErrorContract, error) {
-	resp, err := resty.SetHTTPMode().R().Delete(c.buildURL(endpoint))
+// MergeDocument applies JSON Merge Patch to the cache document entry and returns the result, null members of the patch are removed.
+func (c APIClient) MergeDocument(key string, patch interface{}) (bool, contracts.DocumentCacheValueContract, error) {
+	return c.patchDocument(key, "application/merge-patch+json", patch)
+}
+
+// PatchDocument applies JSON Patch operations to the cache document entry and returns the result,
+// the document is not changed if any of the operations fails.
+func (c APIClient) PatchDocument(key string, ops []contracts.DocumentPatchOperationContract) (bool, contracts.DocumentCacheValueContract, error) {
+	return c.patchDocument(key, "application/json-patch+json", ops)
+}
+
+// DeleteDocumentKey removes JSON document from the cache.
+func (c APIClient) DeleteDocumentKey(key string) (bool, contracts.ErrorContract, error) {
+	return c.deleteKey(documentEndpoint + key)
+}
+
+// DeleteDocumentKeyVersion removes JSON document from the cache if the key still has the version specified.
+func (c APIClient) DeleteDocumentKeyVersion(key string, version int64) (bool, contracts.ErrorContract, error) {
+	return c.deleteKeyVersion(documentEndpoint+key, version)
+}
+
+// DeleteDocumentPath removes the value at the path of the cache document entry.
+func (c APIClient) DeleteDocumentPath(key string, path string) (bool, contracts.ErrorContract, error) {
+	resp, err := resty.SetHTTPMode().R().
+		SetQueryParam("path", path).
+		Delete(c.buildURL(documentEndpoint + key))
+	return c.processResponse(resp, err, 204)
+}
+
+// GetHyperLogLogKeys returns all HyperLogLog keys in the cache.
+func (c APIClient) GetHyperLogLogKeys() ([]string, error) {
+	return c.getKeys(hyperLogLogEndpoint)
+}
+
+// PostHyperLogLogKey adds new HyperLogLog with optional values to the cache.
+func (c APIClient) PostHyperLogLogKey(key string, values []string, ttl time.Duration) (bool, contracts.ErrorContract, error) {
+	req := contracts.NewHyperLogLogCacheValuesContract{Key: key, Values: values, TTL: api.DurationToString(ttl)}
+	resp, err := resty.SetHTTPMode().R().
+		SetBody(req).
+		Post(c.buildURL(hyperLogLogEndpoint))
+	return c.processResponse(resp, err, 201)
+}
+
+// AddHyperLogLogValues adds the values to HyperLogLog cache entry, create option creates the missing key.
+func (c APIClient) AddHyperLogLogValues(key string, values []string, create bool) (bool, contracts.HyperLogLogCacheAddContract, error) {
+	var reply contracts.HyperLogLogCacheAddContract
+	resp, err := resty.SetHTTPMode().R().
+		SetBody(contracts.AddHyperLogLogCacheValuesContract{Values: values, Create: create}).
+		Post(c.buildURL(hyperLogLogEndpoint + key))
+	ok, err := c.processReply(resp, err, &reply)
+	return ok, reply, err
+}
+
+// CountHyperLogLog returns the estimated number of distinct values of HyperLogLog cache entry
+// or of the union with the other HyperLogLogs specified.
+func (c APIClient) CountHyperLogLog(key string, others ...string) (bool, contracts.HyperLogLogCacheCountContract, error) {
+	var reply contracts.HyperLogLogCacheCountContract
+	req := resty.SetHTTPMode().R()
+	if len(others) > 0 {
+		req = req.SetQueryParam("with", strings.Join(others, ","))
+	}
+	resp, err := req.Get(c.buildURL(hyperLogLogEndpoint + key))
+	ok, err := c.processReply(resp, err, &reply)
+	return ok, reply, err
+}
+
+// MergeHyperLogLog merges the HyperLogLogs specified into the cache entry by key, the key is created if missing.
+func (c APIClient) MergeHyperLogLog(key string, sources ...string) (bool, contracts.HyperLogLogCacheCountContract, error) {
+	var reply contracts.HyperLogLogCacheCountContract
+	resp, err := resty.SetHTTPMode().R().
+		SetBody(contracts.MergeHyperLogLogCacheContract{Keys: sources}).
+		Post(c.buildURL(hyperLogLogEndpoint + key + "/merge"))
+	ok, err := c.processReply(resp, err, &reply)
+	return ok, reply, err
+}
+
+// DeleteHyperLogLogKey removes HyperLogLog from the cache.
+func (c APIClient) DeleteHyperLogLogKey(key string) (bool, contracts.ErrorContract, error) {
+	return c.deleteKey(hyperLogLogEndpoint + key)
+}
+
+// DeleteHyperLogLogKeyVersion removes HyperLogLog from the cache if the key still has the version specified.
+func (c APIClient) DeleteHyperLogLogKeyVersion(key string, version int64) (bool, contracts.ErrorContract, error) {
+	return c.deleteKeyVersion(hyperLogLogEndpoint+key, version)
+}
+
+// GetBloomFilterKeys returns all Bloom filter keys in the cache.
+func (c APIClient) GetBloomFilterKeys() ([]string, error) {
+	return c.getKeys(bloomFilterEndpoint)
+}
+
+// GetBloomFilter returns capacity, error rate, number of bits and hash functions and number of values of Bloom filter cache entry.
+func (c APIClient) GetBloomFilter(key string) (bool, contracts.BloomFilterCacheInfoContract, error) {
+	var reply contracts.BloomFilterCacheInfoContract
+	resp, err := resty.SetHTTPMode().R().Get(c.buildURL(bloomFilterEndpoint + key))
+	ok, err := c.processReply(resp, err, &reply)
+	return ok, reply, err
+}
+
+// ReserveBloomFilter adds new Bloom filter for the capacity and the false positive rate specified to the cache,
+// zero capacity and error rate are replaced with the defaults.
+func (c APIClient) ReserveBloomFilter(key string, capacity int64, errorRate float64, ttl time.Duration) (bool, contracts.ErrorContract, error) {
+	req := contracts.NewBloomFilterCacheContract{Key: key, Capacity: capacity, ErrorRate: errorRate, TTL: api.DurationToString(ttl)}
+	resp, err := resty.SetHTTPMode().R().
+		SetBody(req).
+		Post(c.buildURL(bloomFilterEndpoint))
+	return c.processResponse(resp, err, 201)
+}
+
+// AddBloomFilterValues adds the values to Bloom filter cache entry, the result for each value is true if it was not in the filter before.
+// Create option creates the missing key with the default capacity and error rate.
+func (c APIClient) AddBloomFilterValues(key string, values []string, create bool) (bool, contracts.BloomFilterCacheResultsContract, error) {
+	var reply contracts.BloomFilterCacheResultsContract
+	resp, err := resty.SetHTTPMode().R().
+		SetBody(contracts.AddBloomFilterCacheValuesContract{Values: values, Create: create}).
+		Post(c.buildURL(bloomFilterEndpoint + key))
+	ok, err := c.processReply(resp, err, &reply)
+	return ok, reply, err
+}
+
+// ExistsBloomFilterValues checks the values in Bloom filter cache entry, the result for each value is true if it was probably added.
+func (c APIClient) ExistsBloomFilterValues(key string, values ...string) (bool, contracts.BloomFilterCacheResultsContract, error) {
+	var reply contracts.BloomFilterCacheResultsContract
+	resp, err := resty.SetHTTPMode().R().
+		SetMultiValueQueryParams(url.Values{"value": values}).
+		Get(c.buildURL(bloomFilterEndpoint + key + "/exists"))
+	ok, err := c.processReply(resp, err, &reply)
+	return ok, reply, err
+}
+
+// DeleteBloomFilterKey removes Bloom filter from the cache.
+func (c APIClient) DeleteBloomFilterKey(key string) (bool, contracts.ErrorContract, error) {
+	return c.deleteKey(bloomFilterEndpoint + key)
+}
+
+// DeleteBloomFilterKeyVersion removes Bloom filter from the cache if the key still has the version specified.
+func (c APIClient) DeleteBloomFilterKeyVersion(key string, version int64) (bool, contracts.ErrorContract, error) {
+	return c.deleteKeyVersion(bloomFilterEndpoint+key, version)
+}
+
+// GetStreamKeys returns all stream keys in the cache.
+func (c APIClient) GetStreamKeys() ([]string, error) {
+	return c.getKeys(streamEndpoint)
+}
+
+// GetStream returns the length, the first and the last IDs and the number of consumer groups of stream cache entry.
+func (c APIClient) GetStream(key string) (bool, contracts.StreamCacheInfoContract, error) {
+	var reply contracts.StreamCacheInfoContract
+	resp, err := resty.SetHTTPMode().R().Get(c.buildURL(streamEndpoint + key))
+	ok, err := c.processReply(resp, err, &reply)
+	return ok, reply, err
+}
+
+// PostStreamKey adds new empty stream to the cache, positive maxLen caps the number of entries.
+func (c APIClient) PostStreamKey(key string, maxLen int64, ttl time.Duration) (bool, contracts.ErrorContract, error) {
+	req := contracts.NewStreamCacheContract{Key: key, MaxLen: maxLen, TTL: api.DurationToString(ttl)}
+	resp, err := resty.SetHTTPMode().R().
+		SetBody(req).
+		Post(c.buildURL(streamEndpoint))
+	return c.processResponse(resp, err, 201)
+}
+
+// AppendStreamEntry appends the entry to stream cache entry and returns its ID, empty or "*" ID is generated from the current time.
+// Create option creates the missing key.
+func (c APIClient) AppendStreamEntry(key string, id string, fields []contracts.StreamFieldContract, create bool) (bool, contracts.StreamCacheAppendContract, error) {
+	var reply contracts.StreamCacheAppendContract
+	resp, err := resty.SetHTTPMode().R().
+		SetBody(contracts.AppendStreamCacheEntryContract{ID: id, Fields: fields, Create: create}).
+		Post(c.buildURL(streamEndpoint + key))
+	ok, err := c.processReply(resp, err, &reply)
+	return ok, reply, err
+}
+
+// GetStreamRange returns up to count entries with IDs from start to end inclusive, "-" and "+" are the smallest and the greatest IDs.
+// Zero count returns all the entries, reverse starts from the end.
+func (c APIClient) GetStreamRange(key string, start string, end string, count int, reverse bool) (bool, contracts.StreamCacheEntriesContract, error) {
+	var reply contracts.StreamCacheEntriesContract
+	resp, err := resty.SetHTTPMode().R().
+		SetQueryParams(map[string]string{"start": start, "end": end, "count": strconv.Itoa(count), "reverse": strconv.FormatBool(reverse)}).
+		Get(c.buildURL(streamEndpoint + key + "/range"))
+	ok, err := c.processReply(resp, err, &reply)
+	return ok, reply, err
+}
+
+// TrimStream removes the oldest entries of stream cache entry so that at most maxLen entries are left.
+func (c APIClient) TrimStream(key string, maxLen int64) (bool, contracts.StreamCacheTrimContract, error) {
+	var reply contracts.StreamCacheTrimContract
+	resp, err := resty.SetHTTPMode().R().
+		SetBody(contracts.TrimStreamCacheContract{MaxLen: maxLen}).
+		Post(c.buildURL(streamEndpoint + key + "/trim"))
+	ok, err := c.processReply(resp, err, &reply)
+	return ok, reply, err
+}
+
+// GetStreamGroups returns the consumer groups of stream cache entry.
+func (c APIClient) GetStreamGroups(key string) (bool, contracts.StreamCacheGroupsContract, error) {
+	var reply contracts.StreamCacheGroupsContract
+	resp, err := resty.SetHTTPMode().R().Get(c.buildURL(streamEndpoint + key + "/groups"))
+	ok, err := c.processReply(resp, err, &reply)
+	return ok, reply, err
+}
+
+// CreateStreamGroup adds the consumer group which delivers the entries after start ID, "$" start means the last ID of the stream.
+// Create option creates the missing key.
+func (c APIClient) CreateStreamGroup(key string, group string, start string, create bool) (bool, contracts.ErrorContract, error) {
+	resp, err := resty.SetHTTPMode().R().
+		SetBody(contracts.NewStreamGroupContract{Group: group, Start: start, Create: create}).
+		Post(c.buildURL(streamEndpoint + key + "/groups"))
+	return c.processResponse(resp, err, 201)
+}
+
+// DeleteStreamGroup removes the consumer group of stream cache entry with its pending entries.
+func (c APIClient) DeleteStreamGroup(key string, group string) (bool, contracts.ErrorContract, error) {
+	return c.deleteKey(streamEndpoint + key + "/groups/" + group)
+}
+
+// ReadStreamGroup delivers up to count entries to the consumer of the group, ">" ID reads new entries
+// and other IDs read the entries pending for the consumer after the ID.
+func (c APIClient) ReadStreamGroup(key string, group string, consumer string, id string, count int32) (bool, contracts.StreamCacheEntriesContract, error) {
+	var reply contracts.StreamCacheEntriesContract
+	resp, err := resty.SetHTTPMode().R().
+		SetBody(contracts.ReadStreamGroupContract{Consumer: consumer, ID: id, Count: count}).
+		Post(c.buildURL(streamEndpoint + key + "/groups/" + group + "/read"))
+	ok, err := c.processReply(resp, err, &reply)
+	return ok, reply, err
+}
+
+// AckStreamGroup acknowledges the pending entries of the group and returns the number of entries acknowledged.
+func (c APIClient) AckStreamGroup(key string, group string, ids ...string) (bool, contracts.StreamCacheAckContract, error) {
+	var reply contracts.StreamCacheAckContract
+	resp, err := resty.SetHTTPMode().R().
+		SetBody(contracts.AckStreamGroupContract{IDs: ids}).
+		Post(c.buildURL(streamEndpoint + key + "/groups/" + group + "/ack"))
+	ok, err := c.processReply(resp, err, &reply)
+	return ok, reply, err
+}
+
+// GetStreamPending returns up to count pending entries of the group, empty consumer means all the consumers.
+func (c APIClient) GetStreamPending(key string, group string, consumer string, count int) (bool, contracts.StreamCachePendingContract, error) {
+	var reply contracts.StreamCachePendingContract
+	req := resty.SetHTTPMode().R().SetQueryParam("count", strconv.Itoa(count))
+	if consumer != "" {
+		req = req.SetQueryParam("consumer", consumer)
+	}
+	resp, err := req.Get(c.buildURL(streamEndpoint + key + "/groups/" + group + "/pending"))
+	ok, err := c.processReply(resp, err, &reply)
+	return ok, reply, err
+}
+
+// DeleteStreamKey removes stream from the cache.
+func (c APIClient) DeleteStreamKey(key string) (bool, contracts.ErrorContract, error) {
+	return c.deleteKey(streamEndpoint + key)
+}
+
+// DeleteStreamKeyVersion removes stream from the cache if the key still has the version specified.
+func (c APIClient) DeleteStreamKeyVersion(key string, version int64) (bool, contracts.ErrorContract, error) {
+	return c.deleteKeyVersion(streamEndpoint+key, version)
+}
+
+// GetGeoKeys returns all geo keys in the cache.
+func (c APIClient) GetGeoKeys() ([]string, error) {
+	return c.getKeys(geoEndpoint)
+}
+
+// GetGeo returns all the members of geo cache entry with their positions.
+func (c APIClient) GetGeo(key string) (bool, contracts.GeoCacheMembersContract, error) {
+	var reply contracts.GeoCacheMembersContract
+	resp, err := resty.SetHTTPMode().R().Get(c.buildURL(geoEndpoint + key))
+	ok, err := c.processReply(resp, err, &reply)
+	return ok, reply, err
+}
+
+// PostGeoKey adds new geo cache entry with the members specified to the cache.
+func (c APIClient) PostGeoKey(key string, members []contracts.GeoMemberContract, ttl time.Duration) (bool, contracts.ErrorContract, error) {
+	req := contracts.NewGeoCacheContract{Key: key, Members: members, TTL: api.DurationToString(ttl)}
+	resp, err := resty.SetHTTPMode().R().
+		SetBody(req).
+		Post(c.buildURL(geoEndpoint))
+	return c.processResponse(resp, err, 201)
+}
+
+// AddGeoMembers adds new members or moves the existing ones and returns the number of members added and moved.
+// Create option creates the missing key.
+func (c APIClient) AddGeoMembers(key string, members []contracts.GeoMemberContract, create bool) (bool, contracts.GeoCacheAddContract, error) {
+	var reply contracts.GeoCacheAddContract
+	resp, err := resty.SetHTTPMode().R().
+		SetBody(contracts.AddGeoCacheMembersContract{Members: members, Create: create}).
+		Post(c.buildURL(geoEndpoint + key))
+	ok, err := c.processReply(resp, err, &reply)
+	return ok, reply, err
+}
+
+// RemoveGeoMember removes the member from geo cache entry.
+func (c APIClient) RemoveGeoMember(key string, member string) (bool, contracts.ErrorContract, error) {
+	return c.deleteKey(geoEndpoint + key + "/" + member)
+}
+
+// GetGeoPositions returns the positions of the members and the members which were not found.
+func (c APIClient) GetGeoPositions(key string, members ...string) (bool, contracts.GeoCachePositionsContract, error) {
+	var reply contracts.GeoCachePositionsContract
+	resp, err := resty.SetHTTPMode().R().
+		SetQueryParam("members", strings.Join(members, ",")).
+		Get(c.buildURL(geoEndpoint + key + "/positions"))
+	ok, err := c.processReply(resp, err, &reply)
+	return ok, reply, err
+}
+
+// GetGeoDistance returns the distance between two members in m, km, mi or ft.
+func (c APIClient) GetGeoDistance(key string, from string, to string, unit string) (bool, contracts.GeoCacheDistanceContract, error) {
+	var reply contracts.GeoCacheDistanceContract
+	resp, err := resty.SetHTTPMode().R().
+		SetQueryParams(map[string]string{"from": from, "to": to, "unit": unit}).
+		Get(c.buildURL(geoEndpoint + key + "/distance"))
+	ok, err := c.processReply(resp, err, &reply)
+	return ok, reply, err
+}
+
+// SearchGeoRadius returns up to count members within the radius around the position sorted by distance, nearest first.
+func (c APIClient) SearchGeoRadius(key string, longitude float64, latitude float64, radius float64, unit string, count int) (bool, contracts.GeoCacheSearchContract, error) {
+	return c.searchGeo(key, map[string]string{
+		"longitude": strconv.FormatFloat(longitude, 'f', -1, 64),
+		"latitude":  strconv.FormatFloat(latitude, 'f', -1, 64),
+		"radius":    strconv.FormatFloat(radius, 'f', -1, 64),
+		"unit":      unit,
+		"count":     strconv.Itoa(count)})
+}
+
+// SearchGeoMemberRadius returns up to count members within the radius around the member sorted by distance, nearest first.
+func (c APIClient) SearchGeoMemberRadius(key string, member string, radius float64, unit string, count int) (bool, contracts.GeoCacheSearchContract, error) {
+	return c.searchGeo(key, map[string]string{
+		"member": member,
+		"radius": strconv.FormatFloat(radius, 'f', -1, 64),
+		"unit":   unit,
+		"count":  strconv.Itoa(count)})
+}
+
+// SearchGeoBox returns up to count members within width x height box centered at the position sorted by distance, nearest first.
+func (c APIClient) SearchGeoBox(key string, longitude float64, latitude float64, width float64, height float64, unit string, count int) (bool, contracts.GeoCacheSearchContract, error) {
+	return c.searchGeo(key, map[string]string{
+		"longitude": strconv.FormatFloat(longitude, 'f', -1, 64),
+		"latitude":  strconv.FormatFloat(latitude, 'f', -1, 64),
+		"width":     strconv.FormatFloat(width, 'f', -1, 64),
+		"height":    strconv.FormatFloat(height, 'f', -1, 64),
+		"unit":      unit,
+		"count":     strconv.Itoa(count)})
+}
+
+// DeleteGeoKey removes geo cache entry from the cache.
+func (c APIClient) DeleteGeoKey(key string) (bool, contracts.ErrorContract, error) {
+	return c.deleteKey(geoEndpoint + key)
+}
+
+// DeleteGeoKeyVersion removes geo cache entry from the cache if the key still has the version specified.
+func (c APIClient) DeleteGeoKeyVersion(key string, version int64) (bool, contracts.ErrorContract, error) {
+	return c.deleteKeyVersion(geoEndpoint+key, version)
+}
+
+// GetQueueKeys returns all queue keys in the cache.
+func (c APIClient) GetQueueKeys() ([]string, error) {
+	return c.getKeys(queueEndpoint)
+}
+
+// GetQueue returns the number of ready, delayed, in-flight and dead items of queue cache entry.
+func (c APIClient) GetQueue(key string) (bool, contracts.QueueCacheInfoContract, error) {
+	var reply contracts.QueueCacheInfoContract
+	resp, err := resty.SetHTTPMode().R().Get(c.buildURL(queueEndpoint + key))
+	ok, err := c.processReply(resp, err, &reply)
+	return ok, reply, err
+}
+
+// PostQueueKey adds new empty queue to the cache, visibility is the default time the delivered items stay in flight
+// and positive maxAttempts moves the items delivered so many times to the dead letters.
+func (c APIClient) PostQueueKey(key string, visibility time.Duration, maxAttempts int64, ttl time.Duration) (bool, contracts.ErrorContract, error) {
+	req := contracts.NewQueueCacheContract{Key: key, Visibility: api.DurationToString(visibility), MaxAttempts: maxAttempts, TTL: api.DurationToString(ttl)}
+	resp, err := resty.SetHTTPMode().R().
+		SetBody(req).
+		Post(c.buildURL(queueEndpoint))
+	return c.processResponse(resp, err, 201)
+}
+
+// PushQueueItems pushes the items to queue cache entry and returns their IDs. Create option creates the missing key.
+func (c APIClient) PushQueueItems(key string, items []contracts.NewQueueItemContract, create bool) (bool, contracts.QueueCachePushContract, error) {
+	var reply contracts.QueueCachePushContract
+	resp, err := resty.SetHTTPMode().R().
+		SetBody(contracts.PushQueueCacheContract{Items: items, Create: create}).
+		Post(c.buildURL(queueEndpoint + key))
+	ok, err := c.processReply(resp, err, &reply)
+	return ok, reply, err
+}
+
+// PopQueueItems delivers up to count items with the highest priority and keeps them in flight for the visibility timeout,
+// zero visibility means the default timeout of the queue.
+func (c APIClient) PopQueueItems(key string, count int32, visibility time.Duration) (bool, contracts.QueueCacheItemsContract, error) {
+	var reply contracts.QueueCacheItemsContract
+	resp, err := resty.SetHTTPMode().R().
+		SetBody(contracts.PopQueueCacheContract{Count: count, Visibility: api.DurationToString(visibility)}).
+		Post(c.buildURL(queueEndpoint + key + "/pop"))
+	ok, err := c.processReply(resp, err, &reply)
+	return ok, reply, err
+}
+
+// PeekQueueItems returns up to count items which would be delivered next without delivering them.
+func (c APIClient) PeekQueueItems(key string, count int) (bool, contracts.QueueCacheItemsContract, error) {
+	var reply contracts.QueueCacheItemsContract
+	resp, err := resty.SetHTTPMode().R().
+		SetQueryParam("count", strconv.Itoa(count)).
+		Get(c.buildURL(queueEndpoint + key + "/peek"))
+	ok, err := c.processReply(resp, err, &reply)
+	return ok, reply, err
+}
+
+// AckQueueItems removes the delivered items by their receipts and returns the number of items acknowledged.
+func (c APIClient) AckQueueItems(key string, receipts ...string) (bool, contracts.QueueCacheAckContract, error) {
+	var reply contracts.QueueCacheAckContract
+	resp, err := resty.SetHTTPMode().R().
+		SetBody(contracts.AckQueueCacheContract{Receipts: receipts}).
+		Post(c.buildURL(queueEndpoint + key + "/ack"))
+	ok, err := c.processReply(resp, err, &reply)
+	return ok, reply, err
+}
+
+// NackQueueItem returns the delivered item to the queue to be delivered again after the delay
+// or moves it to the dead letters if it was delivered the maximum number of times.
+func (c APIClient) NackQueueItem(key string, receipt string, delay time.Duration) (bool, contracts.QueueCacheNackContract, error) {
+	var reply contracts.QueueCacheNackContract
+	resp, err := resty.SetHTTPMode().R().
+		SetBody(contracts.NackQueueCacheContract{Receipt: receipt, Delay: api.DurationToString(delay)}).
+		Post(c.buildURL(queueEndpoint + key + "/nack"))
+	ok, err := c.processReply(resp, err, &reply)
+	return ok, reply, err
+}
+
+// GetQueueDead returns up to count dead letters of queue cache entry, zero count returns all of them.
+func (c APIClient) GetQueueDead(key string, count int) (bool, contracts.QueueCacheItemsContract, error) {
+	var reply contracts.QueueCacheItemsContract
+	resp, err := resty.SetHTTPMode().R().
+		SetQueryParam("count", strconv.Itoa(count)).
+		Get(c.buildURL(queueEndpoint + key + "/dead"))
+	ok, err := c.processReply(resp, err, &reply)
+	return ok, reply, err
+}
+
+// RedriveQueueDead moves up to count dead letters back to the queue, zero count moves all of them.
+func (c APIClient) RedriveQueueDead(key string, count int32) (bool, contracts.QueueCacheRedriveContract, error) {
+	var reply contracts.QueueCacheRedriveContract
+	resp, err := resty.SetHTTPMode().R().
+		SetBody(contracts.RedriveQueueCacheContract{Count: count}).
+		Post(c.buildURL(queueEndpoint + key + "/redrive"))
+	ok, err := c.processReply(resp, err, &reply)
+	return ok, reply, err
+}
+
+// DeleteQueueKey removes queue from the cache.
+func (c APIClient) DeleteQueueKey(key string) (bool, contracts.ErrorContract, error) {
+	return c.deleteKey(queueEndpoint + key)
+}
+
+// DeleteQueueKeyVersion removes queue from the cache if the key still has the version specified.
+func (c APIClient) DeleteQueueKeyVersion(key string, version int64) (bool, contracts.ErrorContract, error) {
+	return c.deleteKeyVersion(queueEndpoint+key, version)
+}
+
+// GetRateLimitKeys returns all rate limiter keys in the cache.
+func (c APIClient) GetRateLimitKeys() ([]string, error) {
+	return c.getKeys(rateLimitEndpoint)
+}
+
+// GetRateLimit returns the limits and the remaining capacity of the rate limiter without taking anything.
+func (c APIClient) GetRateLimit(key string) (bool, contracts.RateLimitInfoContract, error) {
+	var reply contracts.RateLimitInfoContract
+	resp, err := resty.SetHTTPMode().R().Get(c.buildURL(rateLimitEndpoint + key))
+	ok, err := c.processReply(resp, err, &reply)
+	return ok, reply, err
+}
+
+// TakeTokenBucket takes the cost from the token bucket of capacity tokens refilled at refillRate tokens per second,
+// the reply tells whether the cost was allowed and when it can be retried.
+func (c APIClient) TakeTokenBucket(key string, capacity int64, refillRate float64, cost int64) (bool, contracts.RateLimitContract, error) {
+	return c.takeRateLimit(key, contracts.TakeRateLimitContract{Algorithm: "token-bucket", Capacity: capacity, RefillRate: refillRate, Cost: cost})
+}
+
+// TakeSlidingLog takes the cost from the sliding window log which allows capacity units within the window,
+// the reply tells whether the cost was allowed and when it can be retried.
+func (c APIClient) TakeSlidingLog(key string, capacity int64, window time.Duration, cost int64) (bool, contracts.RateLimitContract, error) {
+	return c.takeRateLimit(key, contracts.TakeRateLimitContract{Algorithm: "sliding-log", Capacity: capacity, Window: api.DurationToString(window), Cost: cost})
+}
+
+// ResetRateLimit removes the rate limiter from the cache, so it is full again.
+func (c APIClient) ResetRateLimit(key string) (bool, contracts.ErrorContract, error) {
+	return c.deleteKey(rateLimitEndpoint + key)
+}
+
+// GetLockKeys returns keys of all held locks in the cache.
+func (c APIClient) GetLockKeys() ([]string, error) {
+	return c.getKeys(lockEndpoint)
+}
+
+// GetLock returns the fencing token and the remaining lease of the held lock.
+func (c APIClient) GetLock(key string) (bool, contracts.LockInfoContract, error) {
+	var reply contracts.LockInfoContract
+	resp, err := resty.SetHTTPMode().R().Get(c.buildURL(lockEndpoint + key))
+	ok, err := c.processReply(resp, err, &reply)
+	return ok, reply, err
+}
+
+// AcquireLock acquires the lock for the owner with the lease, the owner token is generated if it is empty.
+// The reply tells whether the lock was acquired, the owner token to renew and release it with and the fencing token,
+// if the lock is held by another owner the lease replied is the time remaining until it expires.
+func (c APIClient) AcquireLock(key string, owner string, lease time.Duration) (bool, contracts.LockContract, error) {
+	var reply contracts.LockContract
+	resp, err := resty.SetHTTPMode().R().
+		SetBody(contracts.AcquireLockContract{Owner: owner, Lease: api.DurationToString(lease)}).
+		Post(c.buildURL(lockEndpoint + key))
+	ok, err := c.processReply(resp, err, &reply)
+	return ok, reply, err
+}
+
+// RenewLock extends the lease of the lock held by the owner, false is returned if the lock is not held by the owner anymore.
+func (c APIClient) RenewLock(key string, owner string, lease time.Duration) (bool, contracts.LockContract, error) {
+	var reply contracts.LockContract
+	resp, err := resty.SetHTTPMode().R().
+		SetBody(contracts.RenewLockContract{Owner: owner, Lease: api.DurationToString(lease)}).
+		Put(c.buildURL(lockEndpoint + key))
+	ok, err := c.processReply(resp, err, &reply)
+	return ok, reply, err
+}
+
+// ReleaseLock releases the lock if it is held by the owner.
+func (c APIClient) ReleaseLock(key string, owner string) (bool, contracts.ErrorContract, error) {
+	resp, err := resty.SetHTTPMode().R().
+		SetQueryParam("owner", owner).
+		Delete(c.buildURL(lockEndpoint + key))
+	return c.processResponse(resp, err, 204)
+}
+
+// GetStringKeyMetadata returns timestamps, ttl, persisted flag, size and version of string key from the cache.
+func (c APIClient) GetStringKeyMetadata(key string) (bool, contracts.CacheEntryMetadataContract, error) {
+	return c.getMetadata(stringEndpoint + key)
+}
+
+// GetListKeyMetadata returns timestamps, ttl, persisted flag, size and version of list key from the cache.
+func (c APIClient) GetListKeyMetadata(key string) (bool, contracts.CacheEntryMetadataContract, error) {
+	return c.getMetadata(listEndpoint + key)
+}
+
+// GetDictionaryKeyMetadata returns timestamps, ttl, persisted flag, size and version of dictionary key from the cache.
+func (c APIClient) GetDictionaryKeyMetadata(key string) (bool, contracts.CacheEntryMetadataContract, error) {
+	return c.getMetadata(dictionaryEndpoint + key)
+}
+
+// GetSetKeyMetadata returns timestamps, ttl, persisted flag, size and version of set key from the cache.
+func (c APIClient) GetSetKeyMetadata(key string) (bool, contracts.CacheEntryMetadataContract, error) {
+	return c.getMetadata(setEndpoint + key)
+}
+
+// GetSortedSetKeyMetadata returns timestamps, ttl, persisted flag, size and version of sorted set key from the cache.
+func (c APIClient) GetSortedSetKeyMetadata(key string) (bool, contracts.CacheEntryMetadataContract, error) {
+	return c.getMetadata(sortedSetEndpoint + key)
+}
+
+// GetDocumentKeyMetadata returns timestamps, ttl, persisted flag, size and version of document key from the cache.
+func (c APIClient) GetDocumentKeyMetadata(key string) (bool, contracts.CacheEntryMetadataContract, error) {
+	return c.getMetadata(documentEndpoint + key)
+}
+
+// GetHyperLogLogKeyMetadata returns timestamps, ttl, persisted flag, size and version of HyperLogLog key from the cache.
+func (c APIClient) GetHyperLogLogKeyMetadata(key string) (bool, contracts.CacheEntryMetadataContract, error) {
+	return c.getMetadata(hyperLogLogEndpoint + key)
+}
+
+// GetBloomFilterKeyMetadata returns timestamps, ttl, persisted flag, size and version of Bloom filter key from the cache.
+func (c APIClient) GetBloomFilterKeyMetadata(key string) (bool, contracts.CacheEntryMetadataContract, error) {
+	return c.getMetadata(bloomFilterEndpoint + key)
+}
+
+// GetStreamKeyMetadata returns timestamps, ttl, persisted flag, size and version of stream key from the cache.
+func (c APIClient) GetStreamKeyMetadata(key string) (bool, contracts.CacheEntryMetadataContract, error) {
+	return c.getMetadata(streamEndpoint + key)
+}
+
+// GetGeoKeyMetadata returns timestamps, ttl, persisted flag, size and version of geo key from the cache.
+func (c APIClient) GetGeoKeyMetadata(key string) (bool, contracts.CacheEntryMetadataContract, error) {
+	return c.getMetadata(geoEndpoint + key)
+}
+
+// GetQueueKeyMetadata returns timestamps, ttl, persisted flag, size and version of queue key from the cache.
+func (c APIClient) GetQueueKeyMetadata(key string) (bool, contracts.CacheEntryMetadataContract, error) {
+	return c.getMetadata(queueEndpoint + key)
+}
+
+// GetRateLimitKeyMetadata returns timestamps, ttl, persisted flag, size and version of rate limiter key from the cache.
+func (c APIClient) GetRateLimitKeyMetadata(key string) (bool, contracts.CacheEntryMetadataContract, error) {
+	return c.getMetadata(rateLimitEndpoint + key)
+}
+
+// GetLockKeyMetadata returns timestamps, ttl, persisted flag, size and version of lock key from the cache.
+func (c APIClient) GetLockKeyMetadata(key string) (bool, contracts.CacheEntryMetadataContract, error) {
+	return c.getMetadata(lockEndpoint + key)
+}
+
+// GetStringTTL returns remaining ttl and expiration time of string key from the cache.
+func (c APIClient) GetStringTTL(key string) (bool, contracts.CacheTTLContract, error) {
+	return c.getTTL(stringEndpoint, key)
+}
+
+// SetStringTTL sets new ttl of string key in the cache, sliding ttl is renewed each time the key is used.
+func (c APIClient) SetStringTTL(key string, ttl time.Duration, sliding bool) (bool, contracts.ErrorContract, error) {
+	return c.setTTL(stringEndpoint, key, ttl, sliding)
+}
+
+// PersistStringKey removes ttl of string key in the cache, so it never expires.
+func (c APIClient) PersistStringKey(key string) (bool, contracts.ErrorContract, error) {
+	return c.deleteKey(ttlEndpoint(stringEndpoint) + key)
+}
+
+// GetListTTL returns remaining ttl and expiration time of list key from the cache.
+func (c APIClient) GetListTTL(key string) (bool, contracts.CacheTTLContract, error) {
+	return c.getTTL(listEndpoint, key)
+}
+
+// SetListTTL sets new ttl of list key in the cache, sliding ttl is renewed each time the key is used.
+func (c APIClient) SetListTTL(key string, ttl time.Duration, sliding bool) (bool, contracts.ErrorContract, error) {
+	return c.setTTL(listEndpoint, key, ttl, sliding)
+}
+
+// PersistListKey removes ttl of list key in the cache, so it never expires.
+func (c APIClient) PersistListKey(key string) (bool, contracts.ErrorContract, error) {
+	return c.deleteKey(ttlEndpoint(listEndpoint) + key)
+}
+
+// GetDictionaryTTL returns remaining ttl and expiration time of dictionary key from the cache.
+func (c APIClient) GetDictionaryTTL(key string) (bool, contracts.CacheTTLContract, error) {
+	return c.getTTL(dictionaryEndpoint, key)
+}
+
+// SetDictionaryTTL sets new ttl of dictionary key in the cache, sliding ttl is renewed each time the key is used.
+func (c APIClient) SetDictionaryTTL(key string, ttl time.Duration, sliding bool) (bool, contracts.ErrorContract, error) {
+	return c.setTTL(dictionaryEndpoint, key, ttl, sliding)
+}
+
+// PersistDictionaryKey removes ttl of dictionary key in the cache, so it never expires.
+func (c APIClient) PersistDictionaryKey(key string) (bool, contracts.ErrorContract, error) {
+	return c.deleteKey(ttlEndpoint(dictionaryEndpoint) + key)
+}
+
+// GetSetTTL returns remaining ttl and expiration time of set key from the cache.
+func (c APIClient) GetSetTTL(key string) (bool, contracts.CacheTTLContract, error) {
+	return c.getTTL(setEndpoint, key)
+}
+
+// SetSetTTL sets new ttl of set key in the cache, sliding ttl is renewed each time the key is used.
+func (c APIClient) SetSetTTL(key string, ttl time.Duration, sliding bool) (bool, contracts.ErrorContract, error) {
+	return c.setTTL(setEndpoint, key, ttl, sliding)
+}
+
+// PersistSetKey removes ttl of set key in the cache, so it never expires.
+func (c APIClient) PersistSetKey(key string) (bool, contracts.ErrorContract, error) {
+	return c.deleteKey(ttlEndpoint(setEndpoint) + key)
+}
+
+// GetSortedSetTTL returns remaining ttl and expiration time of sorted set key from the cache.
+func (c APIClient) GetSortedSetTTL(key string) (bool, contracts.CacheTTLContract, error) {
+	return c.getTTL(sortedSetEndpoint, key)
+}
+
+// SetSortedSetTTL sets new ttl of sorted set key in the cache, sliding ttl is renewed each time the key is used.
+func (c APIClient) SetSortedSetTTL(key string, ttl time.Duration, sliding bool) (bool, contracts.ErrorContract, error) {
+	return c.setTTL(sortedSetEndpoint, key, ttl, sliding)
+}
+
+// PersistSortedSetKey removes ttl of sorted set key in the cache, so it never expires.
+func (c APIClient) PersistSortedSetKey(key string) (bool, contracts.ErrorContract, error) {
+	return c.deleteKey(ttlEndpoint(sortedSetEndpoint) + key)
+}
+
+// GetDocumentTTL returns remaining ttl and expiration time of document key from the cache.
+func (c APIClient) GetDocumentTTL(key string) (bool, contracts.CacheTTLContract, error) {
+	return c.getTTL(documentEndpoint, key)
+}
+
+// SetDocumentTTL sets new ttl of document key in the cache, sliding ttl is renewed each time the key is used.
+func (c APIClient) SetDocumentTTL(key string, ttl time.Duration, sliding bool) (bool, contracts.ErrorContract, error) {
+	return c.setTTL(documentEndpoint, key, ttl, sliding)
+}
+
+// PersistDocumentKey removes ttl of document key in the cache, so it never expires.
+func (c APIClient) PersistDocumentKey(key string) (bool, contracts.ErrorContract, error) {
+	return c.deleteKey(ttlEndpoint(documentEndpoint) + key)
+}
+
+// GetHyperLogLogTTL returns remaining ttl and expiration time of HyperLogLog key from the cache.
+func (c APIClient) GetHyperLogLogTTL(key string) (bool, contracts.CacheTTLContract, error) {
+	return c.getTTL(hyperLogLogEndpoint, key)
+}
+
+// SetHyperLogLogTTL sets new ttl of HyperLogLog key in the cache, sliding ttl is renewed each time the key is used.
+func (c APIClient) SetHyperLogLogTTL(key string, ttl time.Duration, sliding bool) (bool, contracts.ErrorContract, error) {
+	return c.setTTL(hyperLogLogEndpoint, key, ttl, sliding)
+}
+
+// PersistHyperLogLogKey removes ttl of HyperLogLog key in the cache, so it never expires.
+func (c APIClient) PersistHyperLogLogKey(key string) (bool, contracts.ErrorContract, error) {
+	return c.deleteKey(ttlEndpoint(hyperLogLogEndpoint) + key)
+}
+
+// GetBloomFilterTTL returns remaining ttl and expiration time of Bloom filter key from the cache.
+func (c APIClient) GetBloomFilterTTL(key string) (bool, contracts.CacheTTLContract, error) {
+	return c.getTTL(bloomFilterEndpoint, key)
+}
+
+// SetBloomFilterTTL sets new ttl of Bloom filter key in the cache, sliding ttl is renewed each time the key is used.
+func (c APIClient) SetBloomFilterTTL(key string, ttl time.Duration, sliding bool) (bool, contracts.ErrorContract, error) {
+	return c.setTTL(bloomFilterEndpoint, key, ttl, sliding)
+}
+
+// PersistBloomFilterKey removes ttl of Bloom filter key in the cache, so it never expires.
+func (c APIClient) PersistBloomFilterKey(key string) (bool, contracts.ErrorContract, error) {
+	return c.deleteKey(ttlEndpoint(bloomFilterEndpoint) + key)
+}
+
+// GetStreamTTL returns remaining ttl and expiration time of stream key from the cache.
+func (c APIClient) GetStreamTTL(key string) (bool, contracts.CacheTTLContract, error) {
+	return c.getTTL(streamEndpoint, key)
+}
+
+// SetStreamTTL sets new ttl of stream key in the cache, sliding ttl is renewed each time the key is used.
+func (c APIClient) SetStreamTTL(key string, ttl time.Duration, sliding bool) (bool, contracts.ErrorContract, error) {
+	return c.setTTL(streamEndpoint, key, ttl, sliding)
+}
+
+// PersistStreamKey removes ttl of stream key in the cache, so it never expires.
+func (c APIClient) PersistStreamKey(key string) (bool, contracts.ErrorContract, error) {
+	return c.deleteKey(ttlEndpoint(streamEndpoint) + key)
+}
+
+// GetGeoTTL returns remaining ttl and expiration time of geo key from the cache.
+func (c APIClient) GetGeoTTL(key string) (bool, contracts.CacheTTLContract, error) {
+	return c.getTTL(geoEndpoint, key)
+}
+
+// SetGeoTTL sets new ttl of geo key in the cache, sliding ttl is renewed each time the key is used.
+func (c APIClient) SetGeoTTL(key string, ttl time.Duration, sliding bool) (bool, contracts.ErrorContract, error) {
+	return c.setTTL(geoEndpoint, key, ttl, sliding)
+}
+
+// PersistGeoKey removes ttl of geo key in the cache, so it never expires.
+func (c APIClient) PersistGeoKey(key string) (bool, contracts.ErrorContract, error) {
+	return c.deleteKey(ttlEndpoint(geoEndpoint) + key)
+}
+
+// GetQueueTTL returns remaining ttl and expiration time of queue key from the cache.
+func (c APIClient) GetQueueTTL(key string) (bool, contracts.CacheTTLContract, error) {
+	return c.getTTL(queueEndpoint, key)
+}
+
+// SetQueueTTL sets new ttl of queue key in the cache, sliding ttl is renewed each time the key is used.
+func (c APIClient) SetQueueTTL(key string, ttl time.Duration, sliding bool) (bool, contracts.ErrorContract, error) {
+	return c.setTTL(queueEndpoint, key, ttl, sliding)
+}
+
+// PersistQueueKey removes ttl of queue key in the cache, so it never expires.
+func (c APIClient) PersistQueueKey(key string) (bool, contracts.ErrorContract, error) {
+	return c.deleteKey(ttlEndpoint(queueEndpoint) + key)
+}
+
+// GetStats returns number of keys, their size and number of evicted keys for each cache type.
+func (c APIClient) GetStats() (contracts.CacheStatsContract, error) {
+	resp, err := c.getKey(statsEndpoint)
+	if err != nil {
+		return contracts.CacheStatsContract{}, err
+	}
+	var reply contracts.CacheStatsContract
+	if err = json.Unmarshal(resp.Body(), &reply); err != nil {
+		log.Fatal("unmarshal failed: " + err.Error())
+		return contracts.CacheStatsContract{}, err
+	}
+	return reply, nil
+}
+
+func (c APIClient) getMetadata(endpoint string) (bool, contracts.CacheEntryMetadataContract, error) {
+	resp, err := c.getKey(endpoint + metadataQuery)
+	if err != nil {
+		return false, contracts.CacheEntryMetadataContract{}, err
+	}
+	var reply contracts.CacheEntryMetadataContract
+	if err = json.Unmarshal(resp.Body(), &reply); err != nil {
+		log.Fatal("unmarshal failed: " + err.Error())
+		return false, contracts.CacheEntryMetadataContract{}, err
+	}
+	return resp.StatusCode() == 200, reply, nil
+}
+
+func (c APIClient) getTTL(endpoint string, key string) (bool, contracts.CacheTTLContract, error) {
+	resp, err := c.getKey(ttlEndpoint(endpoint) + key)
+	if err != nil {
+		return false, contracts.CacheTTLContract{}, err
+	}
+	var reply contracts.CacheTTLContract
+	if err = json.Unmarshal(resp.Body(), &reply); err != nil {
+		log.Fatal("unmarshal failed: " + err.Error())
+		return false, contracts.CacheTTLContract{}, err
+	}
+	return resp.StatusCode() == 200, reply, nil
+}
+
+func (c APIClient) setTTL(endpoint string, key string, ttl time.Duration, sliding bool) (bool, contracts.ErrorContract, error) {
+	req := contracts.UpdateCacheTTLContract{TTL: api.DurationToString(ttl), Sliding: sliding}
+	resp, err := resty.SetHTTPMode().R().
+		SetBody(req).
+		Put(c.buildURL(ttlEndpoint(endpoint) + key))
+	return c.processResponse(resp, err, 204)
+}
+
+// ttlEndpoint returns TTL endpoint of the cache type, e.g. "string-ttl/" for "string/".
+func ttlEndpoint(endpoint string) string {
+	return strings.TrimSuffix(endpoint, "/") + ttlSuffix
+}
+
+func (c APIClient) getStringOperation(key string, route string, query map[string]string, reply interface{}) (bool, error) {
+	resp, err := resty.SetHTTPMode().R().
+		SetQueryParams(query).
+		Get(c.buildURL(stringEndpoint + key + route))
+	return c.processReply(resp, err, reply)
+}
+
+func (c APIClient) postStringOperation(key string, route string, req interface{}, reply interface{}) (bool, error) {
+	resp, err := resty.SetHTTPMode().R().
+		SetBody(req).
+		Post(c.buildURL(stringEndpoint + key + route))
+	return c.processReply(resp, err, reply)
+}
+
+func (c APIClient) getListOperation(key string, route string, query map[string]string, reply interface{}) (bool, error) {
+	resp, err := resty.SetHTTPMode().R().
+		SetQueryParams(query).
+		Get(c.buildURL(listEndpoint + key + route))
+	return c.processReply(resp, err, reply)
+}
+
+func (c APIClient) postListOperation(key string, route string, req interface{}, reply interface{}) (bool, error) {
+	resp, err := resty.SetHTTPMode().R().
+		SetBody(req).
+		Post(c.buildURL(listEndpoint + key + route))
+	return c.processReply(resp, err, reply)
+}
+
+func (c APIClient) getSetOperation(key string, operation string, query map[string]string, reply interface{}) (bool, error) {
+	resp, err := resty.SetHTTPMode().R().
+		SetQueryParams(query).
+		Get(c.buildURL(fmt.Sprintf("%s%s/%s", setEndpoint, key, operation)))
+	if err != nil {
+		log.Fatal("get failed: " + err.Error())
+		return false, err
+	}
+	if resp.StatusCode() != 200 {
+		return false, nil
+	}
+	if err = json.Unmarshal(resp.Body(), reply); err != nil {
+		log.Fatal("unmarshal failed: " + err.Error())
+		return false, err
+	}
+	return true, nil
+}
+
+func (c APIClient) getSetAlgebra(key string, operation string, others []string) (contracts.SetCacheAlgebraContract, error) {
+	var reply contracts.SetCacheAlgebraContract
+	_, err := c.getSetOperation(key, operation, map[string]string{"with": strings.Join(others, ",")}, &reply)
+	return reply, err
+}
+
+func (c APIClient) getSortedSetOperation(key string, route string, query map[string]string, reply interface{}) (bool, error) {
+	resp, err := resty.SetHTTPMode().R().
+		SetQueryParams(query).
+		Get(c.buildURL(sortedSetEndpoint + key + route))
+	return c.processReply(resp, err, reply)
+}
+
+func (c APIClient) postSortedSetOperation(key string, route string, req interface{}, reply interface{}) (bool, error) {
+	resp, err := resty.SetHTTPMode().R().
+		SetBody(req).
+		Post(c.buildURL(sortedSetEndpoint + key + route))
+	return c.processReply(resp, err, reply)
+}
+
+func (c APIClient) searchGeo(key string, query map[string]string) (bool, contracts.GeoCacheSearchContract, error) {
+	var reply contracts.GeoCacheSearchContract
+	resp, err := resty.SetHTTPMode().R().
+		SetQueryParams(query).
+		Get(c.buildURL(geoEndpoint + key + "/search"))
+	ok, err := c.processReply(resp, err, &reply)
+	return ok, reply, err
+}
+
+func (c APIClient) takeRateLimit(key string, req contracts.TakeRateLimitContract) (bool, contracts.RateLimitContract, error) {
+	var reply contracts.RateLimitContract
+	resp, err := resty.SetHTTPMode().R().
+		SetBody(req).
+		Post(c.buildURL(rateLimitEndpoint + key))
+	ok, err := c.processReply(resp, err, &reply)
+	return ok, reply, err
+}
+
+func (c APIClient) patchDocument(key string, contentType string, body interface{}) (bool, contracts.DocumentCacheValueContract, error) {
+	var reply contracts.DocumentCacheValueContract
+	data, err := json.Marshal(body)
+	if err != nil {
+		return false, reply, err
+	}
+	resp, err := resty.SetHTTPMode().R().
+		SetHeader("Content-Type", contentType).
+		SetBody(data).
+		Patch(c.buildURL(documentEndpoint + key))
+	ok, err := c.processReply(resp, err, &reply)
+	return ok, reply, err
+}
+
+func (c APIClient) processReply(resp *resty.Response, err error, reply interface{}) (bool, error) {
+	if err != nil {
+		log.Fatal("request failed: " + err.Error())
+		return false, err
+	}
+	if resp.StatusCode() != 200 {
+		return false, nil
+	}
+	if err = json.Unmarshal(resp.Body(), reply); err != nil {
+		log.Fatal("unmarshal failed: " + err.Error())
+		return false, err
+	}
+	return true, nil
+}
+
+func (c APIClient) postIncrement(route string, req contracts.IncrementCacheValueContract, reply interface{}) (bool, error) {
+	resp, err := resty.SetHTTPMode().R().
+		SetBody(req).
+		Post(c.buildURL(route))
+	return c.processReply(resp, err, reply)
+}
+
+// formatScoreBound formats score range bound for the query string, "(" prefix makes the bound exclusive.
+func formatScoreBound(score float64, exclusive bool) string {
+	s := strconv.FormatFloat(score, 'g', -1, 64)
+	if exclusive {
+		return "(" + s
+	}
+	return s
+}
+
+func (c APIClient) processResponse(resp *resty.Response, err error, expectedCode int) (bool, contracts.ErrorContract, error) {
+	if err != nil {
+		log.Fatal("get failed: " + err.Error())
+		return false, contracts.ErrorContract{}, err
+	}
+	if resp.StatusCode() != expectedCode {
+		log.Printf(fmt.Sprintf("status: %d, code: %s", resp.StatusCode(), resp.Status()))
+		var reply contracts.ErrorContract
+		if err = json.Unmarshal(resp.Body(), &reply); err != nil {
+			log.Fatal("unmarshal failed: " + err.Error())
+			return false, contracts.ErrorContract{}, err
+		}
+		return false, reply, nil
+	}
+	return true, contracts.ErrorContract{}, nil
+}
+
+func (c APIClient) getKey(endpoint string) (*resty.Response, error) {
+	var resp *resty.Response
+	var err error
+	if resp, err = resty.SetHTTPMode().R().Get(c.buildURL(endpoint)); err != nil {
+		log.Fatal("get failed: " + err.Error())
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c APIClient) getKeys(endpoint string) ([]string, error) {
+	var resp *resty.Response
+	var err error
+	if resp, err = resty.SetHTTPMode().R().Get(c.buildURL(endpoint)); err != nil {
+		log.Fatal("get failed: " + err.Error())
+		return nil, err
+	}
+	var reply contracts.CacheKeysContract
+	if err := json.Unmarshal(resp.Body(), &reply); err != nil {
+		log.Fatal("unmarshal failed: " + err.Error())
+		return nil, err
+	}
+	return reply.Keys, nil
+}
+
+func rawContentType(contentType string) string {
+	if contentType == "" {
+		return "application/octet-stream"
+	}
+	return contentType
+}
+
+func (c APIClient) buildURL(endpoint string) string {
+	return fmt.Sprintf("%s:%d/api/%s", c.Host, c.Port, endpoint)
+}
+
+func (c APIClient) deleteKey(endpoint string) (bool, contracts.ErrorContract, error) {
+	resp, err := resty.SetHTTPMode().R().Delete(c.buildURL(endpoint))
+	return c.processResponse(resp, err, 204)
+}
+
+func (c APIClient) deleteKeyVersion(endpoint string, version int64) (bool, contracts.ErrorContract, error) {
+	resp, err := resty.SetHTTPMode().R().
+		SetHeader("If-Match", fmt.Sprintf("\"%d\"", version)).
+		Delete(c.buildURL(endpoint))
 	return c.processResponse(resp, err, 204)
 }
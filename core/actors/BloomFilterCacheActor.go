@@ -0,0 +1,227 @@
+package act
+
+import (
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/VitalKrasilnikau/memcache/core/cache"
+	"github.com/VitalKrasilnikau/memcache/core/messages"
+	"github.com/VitalKrasilnikau/memcache/core/repository"
+	"log"
+	"time"
+)
+
+// GetBloomFilterCacheKeyMessage is used to get the options and the number of values of Bloom filter.
+type GetBloomFilterCacheKeyMessage = messages.GetBloomFilterCacheKeyMessage
+
+// GetBloomFilterCacheKeyReply is a reply message for GetBloomFilterCacheKeyMessage.
+type GetBloomFilterCacheKeyReply = messages.GetBloomFilterCacheKeyReply
+
+// DeleteBloomFilterCacheKeyMessage is used to request the cache item deletion.
+type DeleteBloomFilterCacheKeyMessage = messages.DeleteBloomFilterCacheKeyMessage
+
+// DeleteBloomFilterCacheKeyReply is a reply message for DeleteBloomFilterCacheKeyMessage.
+type DeleteBloomFilterCacheKeyReply = messages.DeleteBloomFilterCacheKeyReply
+
+// PostBloomFilterCacheKeyMessage is used to reserve new Bloom filter like BF.RESERVE.
+type PostBloomFilterCacheKeyMessage = messages.PostBloomFilterCacheKeyMessage
+
+// PostBloomFilterCacheKeyReply is a reply message for PostBloomFilterCacheKeyMessage.
+type PostBloomFilterCacheKeyReply = messages.PostBloomFilterCacheKeyReply
+
+// AddBloomFilterCacheValuesMessage is used to add values to Bloom filter like BF.MADD.
+type AddBloomFilterCacheValuesMessage = messages.AddBloomFilterCacheValuesMessage
+
+// AddBloomFilterCacheValuesReply is a reply message for AddBloomFilterCacheValuesMessage.
+type AddBloomFilterCacheValuesReply = messages.AddBloomFilterCacheValuesReply
+
+// ExistsBloomFilterCacheValuesMessage is used to check values in Bloom filter like BF.MEXISTS.
+type ExistsBloomFilterCacheValuesMessage = messages.ExistsBloomFilterCacheValuesMessage
+
+// ExistsBloomFilterCacheValuesReply is a reply message for ExistsBloomFilterCacheValuesMessage.
+type ExistsBloomFilterCacheValuesReply = messages.ExistsBloomFilterCacheValuesReply
+
+// BloomFilterCacheActor manages partitioned Bloom filter cache and its persistence.
+type BloomFilterCacheActor struct {
+	ClusterName    string
+	NodeName       string
+	Cache          cache.IBloomFilterCache
+	CachePersister cache.IBloomFilterCachePersistence
+	DB             repo.IBloomFilterCacheRepository
+	Sweep          cache.SweepOptions
+	sweepTimer     *time.Timer
+}
+
+// Receive is BloomFilterCacheActor messages handler.
+func (a *BloomFilterCacheActor) Receive(context actor.Context) {
+	switch msg := context.Message().(type) {
+	case *actor.Started:
+		a.sweepTimer = scheduleSweep(context.Self(), a.Sweep)
+		break
+	case *SweepExpiredMessage:
+		if n := a.Cache.RemoveExpired(a.Sweep.Budget); n > 0 {
+			log.Printf("[BloomFilterCacheActor] Reaped %d expired keys", n)
+		}
+		a.sweepTimer = scheduleSweep(context.Self(), a.Sweep)
+		break
+	case *GetBloomFilterCacheKeyMessage:
+		ok, v := a.Cache.TryGet(msg.Key)
+		reply := &GetBloomFilterCacheKeyReply{Key: msg.Key, Version: a.entryVersion(msg.Key), Success: ok}
+		if ok {
+			reply.Capacity = v.Capacity
+			reply.ErrorRate = v.ErrorRate
+			reply.Bits = v.Size
+			reply.Hashes = int32(v.Hashes)
+			reply.Count = v.Count
+		}
+		context.Respond(reply)
+		break
+	case *DeleteBloomFilterCacheKeyMessage:
+		if v := a.entryVersion(msg.Key); isVersionConflict(v, msg.Version) {
+			context.Respond(&DeleteBloomFilterCacheKeyReply{Key: msg.Key, Conflict: true})
+			break
+		}
+		ok := a.Cache.TryDelete(msg.Key)
+		context.Respond(&DeleteBloomFilterCacheKeyReply{Key: msg.Key, Success: ok})
+		log.Printf("[BloomFilterCacheActor] Deleted %s", msg.Key)
+		break
+	case *GetCacheKeysMessage:
+		context.Respond(&GetCacheKeysReply{Keys: a.Cache.GetKeys()})
+		break
+	case *GetCacheStatsMessage:
+		stats := a.Cache.GetStats()
+		context.Respond(&GetCacheStatsReply{Entries: stats.Entries, Bytes: stats.Bytes, Evicted: stats.Evicted, Reaped: stats.Reaped})
+		break
+	case *PostBloomFilterCacheKeyMessage:
+		filter, err := newBloomFilter(msg.Capacity, msg.ErrorRate)
+		if err != nil {
+			context.Respond(&PostBloomFilterCacheKeyReply{Key: msg.Key, Error: err.Error()})
+			break
+		}
+		ok := a.Cache.TryAdd(msg.Key, filter, msg.TTL)
+		if ok && msg.Sliding {
+			a.Cache.TrySetTTL(msg.Key, msg.TTL, true)
+		}
+		context.Respond(&PostBloomFilterCacheKeyReply{Key: msg.Key, Version: a.entryVersion(msg.Key), Success: ok})
+		log.Printf("[BloomFilterCacheActor] Created %s [%v]", msg.Key, msg.TTL)
+		break
+	case *AddBloomFilterCacheValuesMessage:
+		if v := a.entryVersion(msg.Key); isVersionConflict(v, msg.Version) {
+			context.Respond(&AddBloomFilterCacheValuesReply{Key: msg.Key, Version: v, Conflict: true})
+			break
+		}
+		ok, results := a.Cache.TryAddValues(msg.Key, msg.Values)
+		created := false
+		if !ok && msg.Create {
+			filter, err := newBloomFilter(msg.Capacity, msg.ErrorRate)
+			if err != nil {
+				context.Respond(&AddBloomFilterCacheValuesReply{Key: msg.Key, Error: err.Error()})
+				break
+			}
+			created = a.Cache.TryAdd(msg.Key, filter, 0)
+			if created {
+				ok, results = a.Cache.TryAddValues(msg.Key, msg.Values)
+			}
+		}
+		context.Respond(&AddBloomFilterCacheValuesReply{Key: msg.Key, Values: msg.Values, Results: results, Created: created, Version: a.entryVersion(msg.Key), Success: ok})
+		if ok {
+			log.Printf("[BloomFilterCacheActor] Added %d values to %s", len(msg.Values), msg.Key)
+		}
+		break
+	case *ExistsBloomFilterCacheValuesMessage:
+		ok, results := a.Cache.TryExists(msg.Key, msg.Values)
+		context.Respond(&ExistsBloomFilterCacheValuesReply{Key: msg.Key, Values: msg.Values, Results: results, Version: a.entryVersion(msg.Key), Success: ok})
+		break
+	case *GetCacheTTLMessage:
+		ok, v, _ := a.Cache.TryGetMetadata(msg.Key)
+		context.Respond(&GetCacheTTLReply{Key: msg.Key, ExpireAfter: v.ExpireAfter, Sliding: v.SlidingTTL(), Success: ok})
+		break
+	case *GetCacheMetadataMessage:
+		ok, v, size := a.Cache.TryGetMetadata(msg.Key)
+		context.Respond(newCacheMetadataReply(msg.Key, v, size, ok))
+		break
+	case *SetCacheTTLMessage:
+		ok := a.Cache.TrySetTTL(msg.Key, msg.TTL, msg.Sliding)
+		context.Respond(&SetCacheTTLReply{Key: msg.Key, Success: ok})
+		if ok {
+			log.Printf("[BloomFilterCacheActor] Set ttl of %s to %v", msg.Key, msg.TTL)
+		}
+		break
+	case *PersistCacheKeyMessage:
+		ok := a.Cache.TrySetTTL(msg.Key, 0, false)
+		context.Respond(&PersistCacheKeyReply{Key: msg.Key, Success: ok})
+		if ok {
+			log.Printf("[BloomFilterCacheActor] Removed ttl of %s", msg.Key)
+		}
+		break
+	case *actor.Stopping:
+		stopSweep(a.sweepTimer)
+		a.persistSnapshot()
+		break
+	}
+}
+
+// entryVersion returns the version of the entry, zero means that the key was not found.
+func (a *BloomFilterCacheActor) entryVersion(key string) int64 {
+	_, v, _ := a.Cache.TryGetMetadata(key)
+	return v.Version
+}
+
+func (a *BloomFilterCacheActor) restoreSnapshot() {
+	for _, entry := range a.DB.GetAll() {
+		filter, err := cache.UnmarshalBloomFilter(entry.Value)
+		if err != nil {
+			log.Printf("[BloomFilterCacheActor] Skipped malformed Bloom filter %s", entry.Key)
+			continue
+		}
+		mappedItem := cache.BloomFilterCacheEntry{
+			Value: filter,
+			CacheEntryData: cache.CacheEntryData{
+				Added:       entry.Added,
+				Updated:     entry.Updated,
+				ExpireAfter: entry.ExpireAfter,
+				Sliding:     entry.Sliding,
+				Version:     entry.Version,
+				Persisted:   true}}
+		a.CachePersister.TryAddFromSnapshot(entry.Key, mappedItem)
+	}
+}
+
+func (a *BloomFilterCacheActor) persistSnapshot() {
+	var newItems []repo.BloomFilterCacheDBEntry
+	var updatedItems []repo.BloomFilterCacheDBEntry
+	for _, k := range a.Cache.GetKeys() {
+		ok, v := a.CachePersister.TryGetSnapshot(k)
+		if ok {
+			mappedItem := repo.BloomFilterCacheDBEntry{
+				Key:         k,
+				Value:       v.Value.Marshal(),
+				Added:       v.Added,
+				Updated:     v.Updated,
+				ExpireAfter: v.ExpireAfter,
+				Sliding:     v.Sliding,
+				Version:     v.Version}
+			if v.Persisted {
+				updatedItems = append(updatedItems, mappedItem)
+			} else {
+				newItems = append(newItems, mappedItem)
+			}
+		}
+	}
+	if newItems == nil {
+		newItems = make([]repo.BloomFilterCacheDBEntry, 0)
+	}
+	if updatedItems == nil {
+		updatedItems = make([]repo.BloomFilterCacheDBEntry, 0)
+	}
+	a.DB.SaveAll(newItems, updatedItems)
+}
+
+// newBloomFilter creates Bloom filter, zero capacity and error rate are replaced with the defaults.
+func newBloomFilter(capacity int64, errorRate float64) (*cache.BloomFilter, error) {
+	if capacity == 0 {
+		capacity = cache.DefaultBloomFilterCapacity
+	}
+	if errorRate == 0 {
+		errorRate = cache.DefaultBloomFilterErrorRate
+	}
+	return cache.NewBloomFilter(capacity, errorRate)
+}
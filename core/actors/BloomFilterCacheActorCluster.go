@@ -0,0 +1,28 @@
+package act
+
+import (
+	"fmt"
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/AsynkronIT/protoactor-go/router"
+	"github.com/VitalKrasilnikau/memcache/core/cache"
+)
+
+// NewBloomFilterCacheActorCluster is a constructor function for the cluster of BloomFilterCacheActor.
+func NewBloomFilterCacheActorCluster(clusterName string, nodeNumber int, usePersistence bool, isRemote bool, options cache.Options) (*actor.PID, *BroadcastStopGroup, *BroadcastStringKeysGroup) {
+	var nodes = make([]*actor.PID, nodeNumber)
+	for i := 0; i < nodeNumber; i++ {
+		if isRemote {
+			nodes[i] = actor.NewPID(fmt.Sprintf("127.0.0.1:%d", i + 65000), fmt.Sprintf("blooms%d", i))
+		} else {
+			nodes[i] = factory.CreateBloomFilterCacheActor(clusterName, fmt.Sprintf("blooms%d", i), usePersistence, options)
+		}
+	}
+	return actor.Spawn(router.NewConsistentHashGroup(nodes...)),
+		NewBroadcastStopGroup(nodes),
+		NewBroadcastStringKeysGroup(nodes)
+}
+
+// NewBloomFilterCacheActor creates actor instance for remote connection.
+func NewBloomFilterCacheActor(clusterName string, nodeNumber int, usePersistence bool, options cache.Options) *actor.PID {
+		return factory.CreateBloomFilterCacheActor(clusterName, fmt.Sprintf("blooms%d", nodeNumber), usePersistence, options)
+}
@@ -28,8 +28,8 @@ func (g *BroadcastStringKeysGroup) Request() (GetCacheKeysReply, error) {
 				pid,
 				func(wg *sync.WaitGroup) *actor.PID {
 					return actor.Spawn(actor.FromFunc(func(ctx actor.Context) {
-						if s, ok := ctx.Message().(GetCacheKeysReply); ok {
-							resp := futureResult{reply: s}
+						if s, ok := ctx.Message().(*GetCacheKeysReply); ok {
+							resp := futureResult{reply: *s}
 							ch <- resp
 						}
 					}))
@@ -62,3 +62,24 @@ func (g *BroadcastStringKeysGroup) Request() (GetCacheKeysReply, error) {
 func NewBroadcastStringKeysGroup(routees []*actor.PID) *BroadcastStringKeysGroup {
 	return &BroadcastStringKeysGroup{Routee: routees}
 }
+
+// RequestStats sums cache usage counters of all actors in the group.
+func (g *BroadcastStringKeysGroup) RequestStats() GetCacheStatsReply {
+	ch := make(chan *GetCacheStatsReply, len(g.Routee))
+	for _, pid := range g.Routee {
+		go func(pid *actor.PID) {
+			s, _ := AwaitReply(pid, &GetCacheStatsMessage{}).(*GetCacheStatsReply)
+			ch <- s
+		}(pid)
+	}
+	var stats GetCacheStatsReply
+	for range g.Routee {
+		if s := <-ch; s != nil {
+			stats.Entries += s.Entries
+			stats.Bytes += s.Bytes
+			stats.Evicted += s.Evicted
+			stats.Reaped += s.Reaped
+		}
+	}
+	return stats
+}
@@ -10,9 +10,9 @@ import (
 type CacheActorFactory struct{}
 
 // CreateStringCacheActor is a constructor function for StringCacheActor.
-func (f CacheActorFactory) CreateStringCacheActor(clusterName string, nodeName string, usePersistence bool) *actor.PID {
-	a := StringCacheActor{ClusterName: clusterName, NodeName: nodeName}
-	stringCache := &cache.StringCache{Map: make(map[string]cache.StringCacheEntry)}
+func (f CacheActorFactory) CreateStringCacheActor(clusterName string, nodeName string, usePersistence bool, options cache.Options) *actor.PID {
+	a := StringCacheActor{ClusterName: clusterName, NodeName: nodeName, Sweep: options.Sweep}
+	stringCache := &cache.StringCache{Map: make(map[string]cache.StringCacheEntry), Evictor: cache.NewEvictor(options.Eviction)}
 	a.Cache = stringCache
 	a.CachePersister = stringCache
 	if usePersistence {
@@ -27,9 +27,9 @@ func (f CacheActorFactory) CreateStringCacheActor(clusterName string, nodeName s
 }
 
 // CreateListCacheActor is a constructor function for ListCacheActor.
-func (f CacheActorFactory) CreateListCacheActor(clusterName string, nodeName string, usePersistence bool) *actor.PID {
-	a := ListCacheActor{ClusterName: clusterName, NodeName: nodeName}
-	listCache := &cache.ListCache{Map: make(map[string]cache.ListCacheEntry)}
+func (f CacheActorFactory) CreateListCacheActor(clusterName string, nodeName string, usePersistence bool, options cache.Options) *actor.PID {
+	a := ListCacheActor{ClusterName: clusterName, NodeName: nodeName, Sweep: options.Sweep}
+	listCache := &cache.ListCache{Map: make(map[string]cache.ListCacheEntry), Evictor: cache.NewEvictor(options.Eviction)}
 	a.Cache = listCache
 	a.CachePersister = listCache
 	if usePersistence {
@@ -44,9 +44,9 @@ func (f CacheActorFactory) CreateListCacheActor(clusterName string, nodeName str
 }
 
 // CreateDictionaryCacheActor is a constructor function for DictionaryCacheActor.
-func (f CacheActorFactory) CreateDictionaryCacheActor(clusterName string, nodeName string, usePersistence bool) *actor.PID {
-	a := DictionaryCacheActor{ClusterName: clusterName, NodeName: nodeName}
-	dictionaryCache := &cache.DictionaryCache{Map: make(map[string]cache.DictionaryCacheEntry)}
+func (f CacheActorFactory) CreateDictionaryCacheActor(clusterName string, nodeName string, usePersistence bool, options cache.Options) *actor.PID {
+	a := DictionaryCacheActor{ClusterName: clusterName, NodeName: nodeName, Sweep: options.Sweep}
+	dictionaryCache := &cache.DictionaryCache{Map: make(map[string]cache.DictionaryCacheEntry), Evictor: cache.NewEvictor(options.Eviction)}
 	a.Cache = dictionaryCache
 	a.CachePersister = dictionaryCache
 	if usePersistence {
@@ -59,3 +59,173 @@ func (f CacheActorFactory) CreateDictionaryCacheActor(clusterName string, nodeNa
 	pid, _ := actor.SpawnNamed(props, nodeName)
 	return pid
 }
+
+// CreateSetCacheActor is a constructor function for SetCacheActor.
+func (f CacheActorFactory) CreateSetCacheActor(clusterName string, nodeName string, usePersistence bool, options cache.Options) *actor.PID {
+	a := SetCacheActor{ClusterName: clusterName, NodeName: nodeName, Sweep: options.Sweep}
+	setCache := &cache.SetCache{Map: make(map[string]cache.SetCacheEntry), Evictor: cache.NewEvictor(options.Eviction)}
+	a.Cache = setCache
+	a.CachePersister = setCache
+	if usePersistence {
+		a.DB = repo.SetCacheRepository{Host: "localhost", DBName: a.ClusterName, ColName: a.NodeName}
+	} else {
+		a.DB = repo.EmptySetCacheRepository{}
+	}
+	a.restoreSnapshot()
+	props := actor.FromInstance(&a)
+	pid, _ := actor.SpawnNamed(props, nodeName)
+	return pid
+}
+
+// CreateSortedSetCacheActor is a constructor function for SortedSetCacheActor.
+func (f CacheActorFactory) CreateSortedSetCacheActor(clusterName string, nodeName string, usePersistence bool, options cache.Options) *actor.PID {
+	a := SortedSetCacheActor{ClusterName: clusterName, NodeName: nodeName, Sweep: options.Sweep}
+	sortedSetCache := &cache.SortedSetCache{Map: make(map[string]cache.SortedSetCacheEntry), Evictor: cache.NewEvictor(options.Eviction)}
+	a.Cache = sortedSetCache
+	a.CachePersister = sortedSetCache
+	if usePersistence {
+		a.DB = repo.SortedSetCacheRepository{Host: "localhost", DBName: a.ClusterName, ColName: a.NodeName}
+	} else {
+		a.DB = repo.EmptySortedSetCacheRepository{}
+	}
+	a.restoreSnapshot()
+	props := actor.FromInstance(&a)
+	pid, _ := actor.SpawnNamed(props, nodeName)
+	return pid
+}
+
+// CreateDocumentCacheActor is a constructor function for DocumentCacheActor.
+func (f CacheActorFactory) CreateDocumentCacheActor(clusterName string, nodeName string, usePersistence bool, options cache.Options) *actor.PID {
+	a := DocumentCacheActor{ClusterName: clusterName, NodeName: nodeName, Sweep: options.Sweep}
+	documentCache := &cache.DocumentCache{Map: make(map[string]cache.DocumentCacheEntry), Evictor: cache.NewEvictor(options.Eviction)}
+	a.Cache = documentCache
+	a.CachePersister = documentCache
+	if usePersistence {
+		a.DB = repo.DocumentCacheRepository{Host: "localhost", DBName: a.ClusterName, ColName: a.NodeName}
+	} else {
+		a.DB = repo.EmptyDocumentCacheRepository{}
+	}
+	a.restoreSnapshot()
+	props := actor.FromInstance(&a)
+	pid, _ := actor.SpawnNamed(props, nodeName)
+	return pid
+}
+
+// CreateHyperLogLogCacheActor is a constructor function for HyperLogLogCacheActor.
+func (f CacheActorFactory) CreateHyperLogLogCacheActor(clusterName string, nodeName string, usePersistence bool, options cache.Options) *actor.PID {
+	a := HyperLogLogCacheActor{ClusterName: clusterName, NodeName: nodeName, Sweep: options.Sweep}
+	hyperLogLogCache := &cache.HyperLogLogCache{Map: make(map[string]cache.HyperLogLogCacheEntry), Evictor: cache.NewEvictor(options.Eviction)}
+	a.Cache = hyperLogLogCache
+	a.CachePersister = hyperLogLogCache
+	if usePersistence {
+		a.DB = repo.HyperLogLogCacheRepository{Host: "localhost", DBName: a.ClusterName, ColName: a.NodeName}
+	} else {
+		a.DB = repo.EmptyHyperLogLogCacheRepository{}
+	}
+	a.restoreSnapshot()
+	props := actor.FromInstance(&a)
+	pid, _ := actor.SpawnNamed(props, nodeName)
+	return pid
+}
+
+// CreateBloomFilterCacheActor is a constructor function for BloomFilterCacheActor.
+func (f CacheActorFactory) CreateBloomFilterCacheActor(clusterName string, nodeName string, usePersistence bool, options cache.Options) *actor.PID {
+	a := BloomFilterCacheActor{ClusterName: clusterName, NodeName: nodeName, Sweep: options.Sweep}
+	bloomFilterCache := &cache.BloomFilterCache{Map: make(map[string]cache.BloomFilterCacheEntry), Evictor: cache.NewEvictor(options.Eviction)}
+	a.Cache = bloomFilterCache
+	a.CachePersister = bloomFilterCache
+	if usePersistence {
+		a.DB = repo.BloomFilterCacheRepository{Host: "localhost", DBName: a.ClusterName, ColName: a.NodeName}
+	} else {
+		a.DB = repo.EmptyBloomFilterCacheRepository{}
+	}
+	a.restoreSnapshot()
+	props := actor.FromInstance(&a)
+	pid, _ := actor.SpawnNamed(props, nodeName)
+	return pid
+}
+
+// CreateStreamCacheActor is a constructor function for StreamCacheActor.
+func (f CacheActorFactory) CreateStreamCacheActor(clusterName string, nodeName string, usePersistence bool, options cache.Options) *actor.PID {
+	a := StreamCacheActor{ClusterName: clusterName, NodeName: nodeName, Sweep: options.Sweep}
+	streamCache := &cache.StreamCache{Map: make(map[string]cache.StreamCacheEntry), Evictor: cache.NewEvictor(options.Eviction)}
+	a.Cache = streamCache
+	a.CachePersister = streamCache
+	if usePersistence {
+		a.DB = repo.StreamCacheRepository{Host: "localhost", DBName: a.ClusterName, ColName: a.NodeName}
+	} else {
+		a.DB = repo.EmptyStreamCacheRepository{}
+	}
+	a.restoreSnapshot()
+	props := actor.FromInstance(&a)
+	pid, _ := actor.SpawnNamed(props, nodeName)
+	return pid
+}
+
+// CreateGeoCacheActor is a constructor function for GeoCacheActor.
+func (f CacheActorFactory) CreateGeoCacheActor(clusterName string, nodeName string, usePersistence bool, options cache.Options) *actor.PID {
+	a := GeoCacheActor{ClusterName: clusterName, NodeName: nodeName, Sweep: options.Sweep}
+	geoCache := &cache.GeoCache{Map: make(map[string]cache.GeoCacheEntry), Evictor: cache.NewEvictor(options.Eviction)}
+	a.Cache = geoCache
+	a.CachePersister = geoCache
+	if usePersistence {
+		a.DB = repo.GeoCacheRepository{Host: "localhost", DBName: a.ClusterName, ColName: a.NodeName}
+	} else {
+		a.DB = repo.EmptyGeoCacheRepository{}
+	}
+	a.restoreSnapshot()
+	props := actor.FromInstance(&a)
+	pid, _ := actor.SpawnNamed(props, nodeName)
+	return pid
+}
+
+// CreateQueueCacheActor is a constructor function for QueueCacheActor.
+func (f CacheActorFactory) CreateQueueCacheActor(clusterName string, nodeName string, usePersistence bool, options cache.Options) *actor.PID {
+	a := QueueCacheActor{ClusterName: clusterName, NodeName: nodeName, Sweep: options.Sweep}
+	queueCache := &cache.QueueCache{Map: make(map[string]cache.QueueCacheEntry), Evictor: cache.NewEvictor(options.Eviction.WithoutEviction())}
+	a.Cache = queueCache
+	a.CachePersister = queueCache
+	if usePersistence {
+		a.DB = repo.QueueCacheRepository{Host: "localhost", DBName: a.ClusterName, ColName: a.NodeName}
+	} else {
+		a.DB = repo.EmptyQueueCacheRepository{}
+	}
+	a.restoreSnapshot()
+	props := actor.FromInstance(&a)
+	pid, _ := actor.SpawnNamed(props, nodeName)
+	return pid
+}
+
+// CreateRateLimitCacheActor is a constructor function for RateLimitCacheActor.
+func (f CacheActorFactory) CreateRateLimitCacheActor(clusterName string, nodeName string, usePersistence bool, options cache.Options) *actor.PID {
+	a := RateLimitCacheActor{ClusterName: clusterName, NodeName: nodeName, Sweep: options.Sweep}
+	rateLimitCache := &cache.RateLimitCache{Map: make(map[string]cache.RateLimitCacheEntry), Evictor: cache.NewEvictor(options.Eviction.WithoutEviction())}
+	a.Cache = rateLimitCache
+	a.CachePersister = rateLimitCache
+	if usePersistence {
+		a.DB = repo.RateLimitCacheRepository{Host: "localhost", DBName: a.ClusterName, ColName: a.NodeName}
+	} else {
+		a.DB = repo.EmptyRateLimitCacheRepository{}
+	}
+	a.restoreSnapshot()
+	props := actor.FromInstance(&a)
+	pid, _ := actor.SpawnNamed(props, nodeName)
+	return pid
+}
+
+// CreateLockCacheActor is a constructor function for LockCacheActor.
+func (f CacheActorFactory) CreateLockCacheActor(clusterName string, nodeName string, usePersistence bool, options cache.Options) *actor.PID {
+	a := LockCacheActor{ClusterName: clusterName, NodeName: nodeName, Sweep: options.Sweep}
+	lockCache := &cache.LockCache{Map: make(map[string]cache.LockCacheEntry), Fences: make(map[string]cache.LockFence), Evictor: cache.NewEvictor(options.Eviction.WithoutEviction())}
+	a.Cache = lockCache
+	a.CachePersister = lockCache
+	if usePersistence {
+		a.DB = repo.LockCacheRepository{Host: "localhost", DBName: a.ClusterName, ColName: a.NodeName}
+	} else {
+		a.DB = repo.EmptyLockCacheRepository{}
+	}
+	a.restoreSnapshot()
+	props := actor.FromInstance(&a)
+	pid, _ := actor.SpawnNamed(props, nodeName)
+	return pid
+}
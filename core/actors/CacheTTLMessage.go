@@ -0,0 +1,43 @@
+package act
+
+import (
+	"github.com/VitalKrasilnikau/memcache/core/cache"
+	"github.com/VitalKrasilnikau/memcache/core/messages"
+)
+
+// GetCacheTTLMessage is used to get expiration of the cache entry.
+type GetCacheTTLMessage = messages.GetCacheTTLMessage
+
+// GetCacheTTLReply is a reply message for GetCacheTTLMessage.
+type GetCacheTTLReply = messages.GetCacheTTLReply
+
+// SetCacheTTLMessage is used to set new TTL for the cache entry.
+type SetCacheTTLMessage = messages.SetCacheTTLMessage
+
+// SetCacheTTLReply is a reply message for SetCacheTTLMessage.
+type SetCacheTTLReply = messages.SetCacheTTLReply
+
+// PersistCacheKeyMessage is used to remove TTL of the cache entry.
+type PersistCacheKeyMessage = messages.PersistCacheKeyMessage
+
+// PersistCacheKeyReply is a reply message for PersistCacheKeyMessage.
+type PersistCacheKeyReply = messages.PersistCacheKeyReply
+
+// GetCacheMetadataMessage is used to get timestamps, expiration, version and size of the cache entry.
+type GetCacheMetadataMessage = messages.GetCacheMetadataMessage
+
+// GetCacheMetadataReply is a reply message for GetCacheMetadataMessage.
+type GetCacheMetadataReply = messages.GetCacheMetadataReply
+
+func newCacheMetadataReply(key string, v cache.CacheEntryData, size int64, ok bool) *GetCacheMetadataReply {
+	return &GetCacheMetadataReply{
+		Key:         key,
+		Added:       v.Added,
+		Updated:     v.Updated,
+		ExpireAfter: v.ExpireAfter,
+		Sliding:     v.SlidingTTL(),
+		Version:     v.Version,
+		Bytes:       size,
+		Persisted:   v.Persisted,
+		Success:     ok}
+}
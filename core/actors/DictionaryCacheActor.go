@@ -10,125 +10,76 @@ import (
 )
 
 // GetDictionaryCacheKeyMessage is used to get the dictionary cache entry.
-type GetDictionaryCacheKeyMessage struct {
-	Key     string
-}
-
-// Hash is used for partitioning in actor cluster.
-func (m *GetDictionaryCacheKeyMessage) Hash() string {
-	return m.Key
-}
+type GetDictionaryCacheKeyMessage = messages.GetDictionaryCacheKeyMessage
 
 // GetDictionaryCacheKeyReply is a reply message for GetDictionaryCacheKeyMessage.
-type GetDictionaryCacheKeyReply struct {
-	Key     string
-	Values  []cache.KeyValue
-	Success bool
-}
-
-// Hash is used for partitioning in actor cluster.
-func (m *GetDictionaryCacheKeyReply) Hash() string {
-	return m.Key
-}
+type GetDictionaryCacheKeyReply = messages.GetDictionaryCacheKeyReply
 
 // DeleteDictionaryCacheKeyMessage is used to request the cache item deletion.
-type DeleteDictionaryCacheKeyMessage struct {
-	Key     string
-}
-
-// Hash is used for partitioning in actor cluster.
-func (m *DeleteDictionaryCacheKeyMessage) Hash() string {
-	return m.Key
-}
+type DeleteDictionaryCacheKeyMessage = messages.DeleteDictionaryCacheKeyMessage
 
 // DeleteDictionaryCacheKeyReply is a reply message for DeleteDictionaryCacheKeyMessage.
-type DeleteDictionaryCacheKeyReply struct {
-	Key           string
-	DeletedValues []cache.KeyValue
-	Success       bool
-}
+type DeleteDictionaryCacheKeyReply = messages.DeleteDictionaryCacheKeyReply
 
 // PostDictionaryCacheKeyMessage is used to add new dictionary cache entry.
-type PostDictionaryCacheKeyMessage struct {
-	Key     string
-	Values  []cache.KeyValue
-	TTL     time.Duration
-}
-
-// Hash is used for partitioning in actor cluster.
-func (m *PostDictionaryCacheKeyMessage) Hash() string {
-	return m.Key
-}
+type PostDictionaryCacheKeyMessage = messages.PostDictionaryCacheKeyMessage
 
 // PostDictionaryCacheKeyReply is a reply message for PostDictionaryCacheKeyMessage.
-type PostDictionaryCacheKeyReply struct {
-	Key     string
-	Success bool
-}
-
-// Hash is used for partitioning in actor cluster.
-func (m *PostDictionaryCacheKeyReply) Hash() string {
-	return m.Key
-}
+type PostDictionaryCacheKeyReply = messages.PostDictionaryCacheKeyReply
 
 // PutDictionaryCacheValueMessage is used to request cache entry update by original value.
-type PutDictionaryCacheValueMessage struct {
-	Key           string
-	SubKey        string
-	NewValue      string
-	OriginalValue string
-}
-
-// Hash is used for partitioning in actor cluster.
-func (m *PutDictionaryCacheValueMessage) Hash() string {
-	return m.Key
-}
+type PutDictionaryCacheValueMessage = messages.PutDictionaryCacheValueMessage
 
 // PutDictionaryCacheValueReply is a reply message for PutDictionaryCacheValueMessage.
-type PutDictionaryCacheValueReply struct {
-	Key           string
-	SubKey        string
-	Success       bool
-	NewValue      string
-	OriginalValue string
-}
+type PutDictionaryCacheValueReply = messages.PutDictionaryCacheValueReply
 
 // DeleteDictionaryCacheValueMessage is used to request cache entry update by deleting the original value.
-type DeleteDictionaryCacheValueMessage struct {
-	Key     string
-	SubKey  string
-}
-
-// Hash is used for partitioning in actor cluster.
-func (m *DeleteDictionaryCacheValueMessage) Hash() string {
-	return m.Key
-}
+type DeleteDictionaryCacheValueMessage = messages.DeleteDictionaryCacheValueMessage
 
 // DeleteDictionaryCacheValueReply is a reply message for DeleteDictionaryCacheValueMessage.
-type DeleteDictionaryCacheValueReply struct {
-	Key          string
-	SubKey       string
-	DeletedValue cache.KeyValue
-	Success      bool
-}
+type DeleteDictionaryCacheValueReply = messages.DeleteDictionaryCacheValueReply
 
 // PostDictionaryCacheValueMessage is used to request cache entry update by original value.
-type PostDictionaryCacheValueMessage struct {
-	Key      string
-	NewValue cache.KeyValue
-}
-
-// Hash is used for partitioning in actor cluster.
-func (m *PostDictionaryCacheValueMessage) Hash() string {
-	return m.Key
-}
+type PostDictionaryCacheValueMessage = messages.PostDictionaryCacheValueMessage
 
 // PostDictionaryCacheValueReply is a reply message for PostDictionaryCacheValueMessage.
-type PostDictionaryCacheValueReply struct {
-	Key        string
-	Success    bool
-	AddedValue cache.KeyValue
-}
+type PostDictionaryCacheValueReply = messages.PostDictionaryCacheValueReply
+
+// IncrementDictionaryCacheValueMessage is used to change the numeric value of the sub-key atomically.
+type IncrementDictionaryCacheValueMessage = messages.IncrementDictionaryCacheValueMessage
+
+// IncrementDictionaryCacheValueReply is a reply message for IncrementDictionaryCacheValueMessage.
+type IncrementDictionaryCacheValueReply = messages.IncrementDictionaryCacheValueReply
+
+// GetDictionaryCacheValuesMessage is used to get the values of the selected sub-keys.
+type GetDictionaryCacheValuesMessage = messages.GetDictionaryCacheValuesMessage
+
+// GetDictionaryCacheValuesReply is a reply message for GetDictionaryCacheValuesMessage.
+type GetDictionaryCacheValuesReply = messages.GetDictionaryCacheValuesReply
+
+// SetDictionaryCacheValuesMessage is used to add or update many sub-keys atomically.
+type SetDictionaryCacheValuesMessage = messages.SetDictionaryCacheValuesMessage
+
+// SetDictionaryCacheValuesReply is a reply message for SetDictionaryCacheValuesMessage.
+type SetDictionaryCacheValuesReply = messages.SetDictionaryCacheValuesReply
+
+// DeleteDictionaryCacheValuesMessage is used to delete many sub-keys atomically.
+type DeleteDictionaryCacheValuesMessage = messages.DeleteDictionaryCacheValuesMessage
+
+// DeleteDictionaryCacheValuesReply is a reply message for DeleteDictionaryCacheValuesMessage.
+type DeleteDictionaryCacheValuesReply = messages.DeleteDictionaryCacheValuesReply
+
+// GetDictionaryCacheSubKeysMessage is used to get the sub-keys without their values.
+type GetDictionaryCacheSubKeysMessage = messages.GetDictionaryCacheSubKeysMessage
+
+// GetDictionaryCacheSubKeysReply is a reply message for GetDictionaryCacheSubKeysMessage.
+type GetDictionaryCacheSubKeysReply = messages.GetDictionaryCacheSubKeysReply
+
+// ContainsDictionaryCacheValuesMessage is used to check if the sub-keys exist.
+type ContainsDictionaryCacheValuesMessage = messages.ContainsDictionaryCacheValuesMessage
+
+// ContainsDictionaryCacheValuesReply is a reply message for ContainsDictionaryCacheValuesMessage.
+type ContainsDictionaryCacheValuesReply = messages.ContainsDictionaryCacheValuesReply
 
 // DictionaryCacheActor manages partitioned dictionary cache and its persistence.
 type DictionaryCacheActor struct {
@@ -137,106 +88,208 @@ type DictionaryCacheActor struct {
 	Cache          cache.IDictionaryCache
 	CachePersister cache.IDictionaryCachePersistence
 	DB             repo.IDictionaryCacheRepository
+	Sweep          cache.SweepOptions
+	sweepTimer     *time.Timer
 }
 
 // Receive is DictionaryCacheActor messages handler.
 func (a *DictionaryCacheActor) Receive(context actor.Context) {
 	switch msg := context.Message().(type) {
+	case *actor.Started:
+		a.sweepTimer = scheduleSweep(context.Self(), a.Sweep)
+		break
+	case *SweepExpiredMessage:
+		if n := a.Cache.RemoveExpired(a.Sweep.Budget); n > 0 {
+			log.Printf("[DictionaryCacheActor] Reaped %d expired keys", n)
+		}
+		a.sweepTimer = scheduleSweep(context.Self(), a.Sweep)
+		break
 	
 	// Local messaging
 	case *GetDictionaryCacheKeyMessage:
 		ok, v := a.Cache.TryGet(msg.Key)
-		context.Respond(GetDictionaryCacheKeyReply{Key: msg.Key, Values: v, Success: ok})
+		context.Respond(&GetDictionaryCacheKeyReply{Key: msg.Key, Values: v, Version: a.entryVersion(msg.Key), Success: ok})
 		break
 	case *DeleteDictionaryCacheKeyMessage:
+		if v := a.entryVersion(msg.Key); isVersionConflict(v, msg.Version) {
+			context.Respond(&DeleteDictionaryCacheKeyReply{Key: msg.Key, Conflict: true})
+			break
+		}
 		ok, v := a.Cache.TryDelete(msg.Key)
-		context.Respond(DeleteDictionaryCacheKeyReply{Key: msg.Key, DeletedValues: v, Success: ok})
+		context.Respond(&DeleteDictionaryCacheKeyReply{Key: msg.Key, DeletedValues: v, Success: ok})
 		log.Printf("[DictionaryCacheActor] Deleted %s", msg.Key)
 		break
 	case *GetCacheKeysMessage:
-		context.Respond(GetCacheKeysReply{Keys: a.Cache.GetKeys()})
+		context.Respond(&GetCacheKeysReply{Keys: a.Cache.GetKeys()})
+		break
+	case *GetCacheStatsMessage:
+		stats := a.Cache.GetStats()
+		context.Respond(&GetCacheStatsReply{Entries: stats.Entries, Bytes: stats.Bytes, Evicted: stats.Evicted, Reaped: stats.Reaped})
 		break
 	case *PostDictionaryCacheKeyMessage:
 		ok := a.Cache.TryAdd(msg.Key, msg.Values, msg.TTL)
-		context.Respond(PostDictionaryCacheKeyReply{Key: msg.Key, Success: ok})
+		if ok && msg.Sliding {
+			a.Cache.TrySetTTL(msg.Key, msg.TTL, true)
+		}
+		context.Respond(&PostDictionaryCacheKeyReply{Key: msg.Key, Version: a.entryVersion(msg.Key), Success: ok})
 		log.Printf("[DictionaryCacheActor] Created %s [%v]", msg.Key, msg.TTL)
 		break
 	case *PostDictionaryCacheValueMessage:
-		ok, _ := a.Cache.TryAddValue(msg.Key, msg.NewValue)
-		context.Respond(PostDictionaryCacheValueReply{Key: msg.Key, Success: ok, AddedValue: msg.NewValue})
+		if v := a.entryVersion(msg.Key); isVersionConflict(v, msg.Version) {
+			context.Respond(&PostDictionaryCacheValueReply{Key: msg.Key, Version: v, Conflict: true})
+			break
+		}
+		ok, _ := a.Cache.TryAddValue(msg.Key, msg.NewValue, msg.TTL)
+		context.Respond(&PostDictionaryCacheValueReply{Key: msg.Key, Version: a.entryVersion(msg.Key), Success: ok, AddedValue: msg.NewValue})
 		if ok {
-			log.Printf("[DictionaryCacheActor] Added value %s to list %s", msg.NewValue, msg.Key)
+			log.Printf("[DictionaryCacheActor] Added value %s to list %s [%v]", msg.NewValue, msg.Key, msg.TTL)
 		}
 		break
 	case *PutDictionaryCacheValueMessage:
-		ok, _ := a.Cache.TryUpdateValue(msg.Key, msg.SubKey, msg.NewValue, msg.OriginalValue)
-		context.Respond(PutDictionaryCacheValueReply{Key: msg.Key, Success: ok, NewValue: msg.NewValue, OriginalValue: msg.OriginalValue, SubKey: msg.SubKey})
+		if v := a.entryVersion(msg.Key); isVersionConflict(v, msg.Version) {
+			context.Respond(&PutDictionaryCacheValueReply{Key: msg.Key, SubKey: msg.SubKey, Version: v, Conflict: true})
+			break
+		}
+		original := msg.OriginalValue
+		if original == "" && msg.Version != 0 {
+			_, values := a.Cache.TryGet(msg.Key)
+			original = cache.ToMap(values)[msg.SubKey]
+		}
+		ok, _ := a.Cache.TryUpdateValue(msg.Key, msg.SubKey, msg.NewValue, original)
+		context.Respond(&PutDictionaryCacheValueReply{Key: msg.Key, Version: a.entryVersion(msg.Key), Success: ok, NewValue: msg.NewValue, OriginalValue: msg.OriginalValue, SubKey: msg.SubKey})
 		if ok {
 			log.Printf("[DictionaryCacheActor] Updated value %s to %s in list %s", msg.OriginalValue, msg.NewValue, msg.Key)
 		}
 		break
 	case *DeleteDictionaryCacheValueMessage:
+		if v := a.entryVersion(msg.Key); isVersionConflict(v, msg.Version) {
+			context.Respond(&DeleteDictionaryCacheValueReply{Key: msg.Key, SubKey: msg.SubKey, Version: v, Conflict: true})
+			break
+		}
 		ok, del := a.Cache.TryDeleteValue(msg.Key, msg.SubKey)
-		context.Respond(DeleteDictionaryCacheValueReply{Key: msg.Key, DeletedValue: del, Success: ok, SubKey: msg.SubKey})
+		context.Respond(&DeleteDictionaryCacheValueReply{Key: msg.Key, Version: a.entryVersion(msg.Key), DeletedValue: del, Success: ok, SubKey: msg.SubKey})
 		if ok {
 			log.Printf("[DictionaryCacheActor] Deleted subkey %s in dictionary %s", msg.SubKey, msg.Key)
 		}
 		break
 
-  // gRPC messaging
-	case *messages.GetDictionaryCacheKeyMessage:
-		ok, v := a.Cache.TryGet(msg.Key)
-		context.Respond(messages.GetDictionaryCacheKeyReply{Key: msg.Key, Values: v, Success: ok})
-		break
-	case *messages.DeleteDictionaryCacheKeyMessage:
-		ok, v := a.Cache.TryDelete(msg.Key)
-		context.Respond(messages.DeleteDictionaryCacheKeyReply{Key: msg.Key, DeletedValues: v, Success: ok})
-		log.Printf("[DictionaryCacheActor] Deleted %s", msg.Key)
+	case *IncrementDictionaryCacheValueMessage:
+		if v := a.entryVersion(msg.Key); isVersionConflict(v, msg.Version) {
+			context.Respond(&IncrementDictionaryCacheValueReply{Key: msg.Key, SubKey: msg.SubKey, Version: v, Conflict: true})
+			break
+		}
+		inc := cache.Increment{Delta: msg.Delta, FloatDelta: msg.FloatDelta, Float: msg.Float}
+		ok, value, err := a.Cache.TryIncrementValue(msg.Key, msg.SubKey, inc)
+		created := false
+		if !ok && msg.Create {
+			if value, err = inc.Apply("", false); err == nil {
+				created = a.Cache.TryAdd(msg.Key, []cache.KeyValue{{Key: msg.SubKey, Value: value}}, msg.TTL)
+				if created && msg.Sliding {
+					a.Cache.TrySetTTL(msg.Key, msg.TTL, true)
+				}
+				ok = created
+			}
+		}
+		reply := &IncrementDictionaryCacheValueReply{Key: msg.Key, SubKey: msg.SubKey, Value: value, Created: created, Version: a.entryVersion(msg.Key), Success: ok && err == nil}
+		if err != nil {
+			reply.Error = err.Error()
+		}
+		context.Respond(reply)
+		if reply.Success {
+			log.Printf("[DictionaryCacheActor] Incremented %s of dictionary %s to %s", msg.SubKey, msg.Key, value)
+		}
 		break
-	/*case *messages.GetCacheKeysMessage:
-		context.Respond(messages.GetCacheKeysReply{Keys: a.Cache.GetKeys()})
-		break*/
-	case *messages.PostDictionaryCacheKeyMessage:
-		ok := a.Cache.TryAdd(msg.Key, msg.Values, msg.TTL)
-		context.Respond(messages.PostDictionaryCacheKeyReply{Key: msg.Key, Success: ok})
-		log.Printf("[DictionaryCacheActor] Created %s [%v]", msg.Key, msg.TTL)
+	case *GetDictionaryCacheValuesMessage:
+		ok, v := a.Cache.TryGetValues(msg.Key, msg.SubKeys)
+		context.Respond(&GetDictionaryCacheValuesReply{Key: msg.Key, Values: v, Version: a.entryVersion(msg.Key), Success: ok})
 		break
-	case *messages.PostDictionaryCacheValueMessage:
-		ok, _ := a.Cache.TryAddValue(msg.Key, msg.NewValue)
-		context.Respond(messages.PostDictionaryCacheValueReply{Key: msg.Key, Success: ok, AddedValue: msg.NewValue})
+	case *SetDictionaryCacheValuesMessage:
+		if v := a.entryVersion(msg.Key); isVersionConflict(v, msg.Version) {
+			context.Respond(&SetDictionaryCacheValuesReply{Key: msg.Key, Version: v, Conflict: true})
+			break
+		}
+		ok, added, updated := a.Cache.TrySetValues(msg.Key, msg.Values)
+		created := false
+		if !ok && msg.Create {
+			created = a.Cache.TryAdd(msg.Key, make([]cache.KeyValue, 0), msg.TTL)
+			if created && msg.Sliding {
+				a.Cache.TrySetTTL(msg.Key, msg.TTL, true)
+			}
+			if created {
+				ok, added, updated = a.Cache.TrySetValues(msg.Key, msg.Values)
+			}
+		}
+		context.Respond(&SetDictionaryCacheValuesReply{Key: msg.Key, Added: int32(added), Updated: int32(updated), Created: created, Version: a.entryVersion(msg.Key), Success: ok})
 		if ok {
-			log.Printf("[DictionaryCacheActor] Added value %s to list %s", msg.NewValue, msg.Key)
+			log.Printf("[DictionaryCacheActor] Set %d values of %s", len(msg.Values), msg.Key)
+		}
+		break
+	case *DeleteDictionaryCacheValuesMessage:
+		if v := a.entryVersion(msg.Key); isVersionConflict(v, msg.Version) {
+			context.Respond(&DeleteDictionaryCacheValuesReply{Key: msg.Key, Version: v, Conflict: true})
+			break
+		}
+		ok, v := a.Cache.TryDeleteValues(msg.Key, msg.SubKeys)
+		context.Respond(&DeleteDictionaryCacheValuesReply{Key: msg.Key, DeletedValues: v, Version: a.entryVersion(msg.Key), Success: ok})
+		if len(v) > 0 {
+			log.Printf("[DictionaryCacheActor] Deleted %d values of %s", len(v), msg.Key)
 		}
 		break
-	case *messages.PutDictionaryCacheValueMessage:
-		ok, _ := a.Cache.TryUpdateValue(msg.Key, msg.SubKey, msg.NewValue, msg.OriginalValue)
-		context.Respond(messages.PutDictionaryCacheValueReply{Key: msg.Key, Success: ok, NewValue: msg.NewValue, OriginalValue: msg.OriginalValue, SubKey: msg.SubKey})
+	case *GetDictionaryCacheSubKeysMessage:
+		ok, v := a.Cache.TryGetSubKeys(msg.Key)
+		context.Respond(&GetDictionaryCacheSubKeysReply{Key: msg.Key, SubKeys: v, Version: a.entryVersion(msg.Key), Success: ok})
+		break
+	case *ContainsDictionaryCacheValuesMessage:
+		ok, v := a.Cache.TryContainsValues(msg.Key, msg.SubKeys)
+		context.Respond(&ContainsDictionaryCacheValuesReply{Key: msg.Key, SubKeys: msg.SubKeys, Exists: v, Success: ok})
+		break
+	case *GetCacheTTLMessage:
+		ok, v, _ := a.Cache.TryGetMetadata(msg.Key)
+		context.Respond(&GetCacheTTLReply{Key: msg.Key, ExpireAfter: v.ExpireAfter, Sliding: v.SlidingTTL(), Success: ok})
+		break
+	case *GetCacheMetadataMessage:
+		ok, v, size := a.Cache.TryGetMetadata(msg.Key)
+		context.Respond(newCacheMetadataReply(msg.Key, v, size, ok))
+		break
+	case *SetCacheTTLMessage:
+		ok := a.Cache.TrySetTTL(msg.Key, msg.TTL, msg.Sliding)
+		context.Respond(&SetCacheTTLReply{Key: msg.Key, Success: ok})
 		if ok {
-			log.Printf("[DictionaryCacheActor] Updated value %s to %s in list %s", msg.OriginalValue, msg.NewValue, msg.Key)
+			log.Printf("[DictionaryCacheActor] Set ttl of %s to %v", msg.Key, msg.TTL)
 		}
 		break
-	case *messages.DeleteDictionaryCacheValueMessage:
-		ok, del := a.Cache.TryDeleteValue(msg.Key, msg.SubKey)
-		context.Respond(messages.DeleteDictionaryCacheValueReply{Key: msg.Key, DeletedValue: del, Success: ok, SubKey: msg.SubKey})
+	case *PersistCacheKeyMessage:
+		ok := a.Cache.TrySetTTL(msg.Key, 0, false)
+		context.Respond(&PersistCacheKeyReply{Key: msg.Key, Success: ok})
 		if ok {
-			log.Printf("[DictionaryCacheActor] Deleted subkey %s in dictionary %s", msg.SubKey, msg.Key)
+			log.Printf("[DictionaryCacheActor] Removed ttl of %s", msg.Key)
 		}
 		break
-
 	case *actor.Stopping:
+		stopSweep(a.sweepTimer)
 		a.persistSnapshot()
 		break
 	}
 }
 
+// entryVersion returns the version of the entry, zero means that the key was not found.
+func (a *DictionaryCacheActor) entryVersion(key string) int64 {
+	_, v, _ := a.Cache.TryGetMetadata(key)
+	return v.Version
+}
+
 func (a *DictionaryCacheActor) restoreSnapshot() {
 	for _, entry := range a.DB.GetAll() {
+		m, expiry := fromDB(entry.Values)
 		mappedItem := cache.DictionaryCacheEntry{
-			Map: cache.ToMap(fromDB(entry.Values)),
+			Map:    m,
+			Expiry: expiry,
 			CacheEntryData: cache.CacheEntryData{
 				Added:       entry.Added,
 				Updated:     entry.Updated,
 				ExpireAfter: entry.ExpireAfter,
+				Sliding:     entry.Sliding,
+				Version:     entry.Version,
 				Persisted:   true}}
 		a.CachePersister.TryAddFromSnapshot(entry.Key, mappedItem)
 	}
@@ -250,10 +303,12 @@ func (a *DictionaryCacheActor) persistSnapshot() {
 		if ok {
 			mappedItem := repo.DictionaryCacheDBEntry{
 				Key:         k,
-				Values:      toDB(cache.FromMap(v.Map)),
+				Values:      toDB(v),
 				Added:       v.Added,
 				Updated:     v.Updated,
-				ExpireAfter: v.ExpireAfter}
+				ExpireAfter: v.ExpireAfter,
+				Sliding:     v.Sliding,
+				Version:     v.Version}
 			if v.Persisted {
 				updatedItems = append(updatedItems, mappedItem)
 			} else {
@@ -270,18 +325,25 @@ func (a *DictionaryCacheActor) persistSnapshot() {
 	a.DB.SaveAll(newItems, updatedItems)
 }
 
-func toDB(values []cache.KeyValue) []repo.DictionaryValueDBEntry {
-	var a = make([]repo.DictionaryValueDBEntry, len(values))
-	for i, v := range values {
-		a[i] = repo.DictionaryValueDBEntry{Key: v.Key, Value: v.Value}
+func toDB(entry cache.DictionaryCacheEntry) []repo.DictionaryValueDBEntry {
+	var a = make([]repo.DictionaryValueDBEntry, 0, len(entry.Map))
+	for k, v := range entry.Map {
+		a = append(a, repo.DictionaryValueDBEntry{Key: k, Value: v, ExpireAfter: entry.Expiry[k]})
 	}
 	return a
 }
 
-func fromDB(values []repo.DictionaryValueDBEntry) []cache.KeyValue {
-	var a = make([]cache.KeyValue, len(values))
-	for i, v := range values {
-		a[i] = cache.KeyValue{Key: v.Key, Value: v.Value}
+func fromDB(values []repo.DictionaryValueDBEntry) (map[string]string, map[string]int64) {
+	var m = make(map[string]string, len(values))
+	var expiry map[string]int64
+	for _, v := range values {
+		m[v.Key] = v.Value
+		if v.ExpireAfter != 0 {
+			if expiry == nil {
+				expiry = make(map[string]int64)
+			}
+			expiry[v.Key] = v.ExpireAfter
+		}
 	}
-	return a
+	return m, expiry
 }
@@ -4,16 +4,17 @@ import (
 	"fmt"
 	"github.com/AsynkronIT/protoactor-go/actor"
 	"github.com/AsynkronIT/protoactor-go/router"
+	"github.com/VitalKrasilnikau/memcache/core/cache"
 )
 
 // NewDictionaryCacheActorCluster is a constructor function for the cluster of DictionaryCacheActor.
-func NewDictionaryCacheActorCluster(clusterName string, nodeNumber int, usePersistence bool, isRemote bool) (*actor.PID, *BroadcastStopGroup, *BroadcastStringKeysGroup) {
+func NewDictionaryCacheActorCluster(clusterName string, nodeNumber int, usePersistence bool, isRemote bool, options cache.Options) (*actor.PID, *BroadcastStopGroup, *BroadcastStringKeysGroup) {
 	var nodes = make([]*actor.PID, nodeNumber)
 	for i := 0; i < nodeNumber; i++ {
 		if isRemote {
-			nodes[i] = actor.NewPID(fmt.Sprintf("127.0.0.1:%d", i + 60000), fmt.Sprintf("strings%d", i))
+			nodes[i] = actor.NewPID(fmt.Sprintf("127.0.0.1:%d", i + 60000), fmt.Sprintf("dictionaries%d", i))
 		} else {
-			nodes[i] = factory.CreateDictionaryCacheActor(clusterName, fmt.Sprintf("dictionaries%d", i), usePersistence)
+			nodes[i] = factory.CreateDictionaryCacheActor(clusterName, fmt.Sprintf("dictionaries%d", i), usePersistence, options)
 		}
 	}
 	return actor.Spawn(router.NewConsistentHashGroup(nodes...)),
@@ -22,7 +23,7 @@ func NewDictionaryCacheActorCluster(clusterName string, nodeNumber int, usePersi
 }
 
 // NewDictionaryCacheActor creates actor instance for remote connection.
-func NewDictionaryCacheActor(clusterName string, nodeNumber int, usePersistence bool) *actor.PID {
-		return factory.CreateDictionaryCacheActor(clusterName, fmt.Sprintf("dictionaries%d", nodeNumber), usePersistence)
+func NewDictionaryCacheActor(clusterName string, nodeNumber int, usePersistence bool, options cache.Options) *actor.PID {
+		return factory.CreateDictionaryCacheActor(clusterName, fmt.Sprintf("dictionaries%d", nodeNumber), usePersistence, options)
 }
 
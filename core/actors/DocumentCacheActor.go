@@ -0,0 +1,337 @@
+package act
+
+import (
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/VitalKrasilnikau/memcache/core/cache"
+	"github.com/VitalKrasilnikau/memcache/core/messages"
+	"github.com/VitalKrasilnikau/memcache/core/repository"
+	"log"
+	"time"
+)
+
+// DocumentPatchOperation is JSON Patch operation, the value is JSON text.
+type DocumentPatchOperation = messages.DocumentPatchOperation
+
+// GetDocumentCacheKeyMessage is used to get the document cache entry.
+type GetDocumentCacheKeyMessage = messages.GetDocumentCacheKeyMessage
+
+// GetDocumentCacheKeyReply is a reply message for GetDocumentCacheKeyMessage.
+type GetDocumentCacheKeyReply = messages.GetDocumentCacheKeyReply
+
+// DeleteDocumentCacheKeyMessage is used to request the cache item deletion.
+type DeleteDocumentCacheKeyMessage = messages.DeleteDocumentCacheKeyMessage
+
+// DeleteDocumentCacheKeyReply is a reply message for DeleteDocumentCacheKeyMessage.
+type DeleteDocumentCacheKeyReply = messages.DeleteDocumentCacheKeyReply
+
+// PostDocumentCacheKeyMessage is used to add new cache entry.
+type PostDocumentCacheKeyMessage = messages.PostDocumentCacheKeyMessage
+
+// PostDocumentCacheKeyReply is a reply message for PostDocumentCacheKeyMessage.
+type PostDocumentCacheKeyReply = messages.PostDocumentCacheKeyReply
+
+// GetDocumentCachePathMessage is used to get the value at the path of the document.
+type GetDocumentCachePathMessage = messages.GetDocumentCachePathMessage
+
+// GetDocumentCachePathReply is a reply message for GetDocumentCachePathMessage.
+type GetDocumentCachePathReply = messages.GetDocumentCachePathReply
+
+// SetDocumentCachePathMessage is used to add or replace the value at the path of the document.
+type SetDocumentCachePathMessage = messages.SetDocumentCachePathMessage
+
+// SetDocumentCachePathReply is a reply message for SetDocumentCachePathMessage.
+type SetDocumentCachePathReply = messages.SetDocumentCachePathReply
+
+// DeleteDocumentCachePathMessage is used to remove the value at the path of the document.
+type DeleteDocumentCachePathMessage = messages.DeleteDocumentCachePathMessage
+
+// DeleteDocumentCachePathReply is a reply message for DeleteDocumentCachePathMessage.
+type DeleteDocumentCachePathReply = messages.DeleteDocumentCachePathReply
+
+// AppendDocumentCacheValuesMessage is used to append values to the array at the path of the document.
+type AppendDocumentCacheValuesMessage = messages.AppendDocumentCacheValuesMessage
+
+// AppendDocumentCacheValuesReply is a reply message for AppendDocumentCacheValuesMessage.
+type AppendDocumentCacheValuesReply = messages.AppendDocumentCacheValuesReply
+
+// MergeDocumentCacheMessage is used to apply JSON Merge Patch to the document.
+type MergeDocumentCacheMessage = messages.MergeDocumentCacheMessage
+
+// MergeDocumentCacheReply is a reply message for MergeDocumentCacheMessage.
+type MergeDocumentCacheReply = messages.MergeDocumentCacheReply
+
+// PatchDocumentCacheMessage is used to apply JSON Patch operations to the document.
+type PatchDocumentCacheMessage = messages.PatchDocumentCacheMessage
+
+// PatchDocumentCacheReply is a reply message for PatchDocumentCacheMessage.
+type PatchDocumentCacheReply = messages.PatchDocumentCacheReply
+
+// DocumentCacheActor manages partitioned JSON document cache and its persistence.
+type DocumentCacheActor struct {
+	ClusterName    string
+	NodeName       string
+	Cache          cache.IDocumentCache
+	CachePersister cache.IDocumentCachePersistence
+	DB             repo.IDocumentCacheRepository
+	Sweep          cache.SweepOptions
+	sweepTimer     *time.Timer
+}
+
+// Receive is DocumentCacheActor messages handler.
+func (a *DocumentCacheActor) Receive(context actor.Context) {
+	switch msg := context.Message().(type) {
+	case *actor.Started:
+		a.sweepTimer = scheduleSweep(context.Self(), a.Sweep)
+		break
+	case *SweepExpiredMessage:
+		if n := a.Cache.RemoveExpired(a.Sweep.Budget); n > 0 {
+			log.Printf("[DocumentCacheActor] Reaped %d expired keys", n)
+		}
+		a.sweepTimer = scheduleSweep(context.Self(), a.Sweep)
+		break
+	case *GetDocumentCacheKeyMessage:
+		ok, v := a.Cache.TryGet(msg.Key)
+		reply := &GetDocumentCacheKeyReply{Key: msg.Key, Version: a.entryVersion(msg.Key), Success: ok}
+		if ok {
+			reply.Value = cache.FormatDocument(v)
+		}
+		context.Respond(reply)
+		break
+	case *DeleteDocumentCacheKeyMessage:
+		if v := a.entryVersion(msg.Key); isVersionConflict(v, msg.Version) {
+			context.Respond(&DeleteDocumentCacheKeyReply{Key: msg.Key, Conflict: true})
+			break
+		}
+		ok, v := a.Cache.TryDelete(msg.Key)
+		reply := &DeleteDocumentCacheKeyReply{Key: msg.Key, Success: ok}
+		if ok {
+			reply.DeletedValue = cache.FormatDocument(v)
+		}
+		context.Respond(reply)
+		log.Printf("[DocumentCacheActor] Deleted %s", msg.Key)
+		break
+	case *GetCacheKeysMessage:
+		context.Respond(&GetCacheKeysReply{Keys: a.Cache.GetKeys()})
+		break
+	case *GetCacheStatsMessage:
+		stats := a.Cache.GetStats()
+		context.Respond(&GetCacheStatsReply{Entries: stats.Entries, Bytes: stats.Bytes, Evicted: stats.Evicted, Reaped: stats.Reaped})
+		break
+	case *PostDocumentCacheKeyMessage:
+		doc, err := cache.ParseDocument(msg.Value)
+		if err != nil {
+			context.Respond(&PostDocumentCacheKeyReply{Key: msg.Key, Error: err.Error()})
+			break
+		}
+		ok := a.Cache.TryAdd(msg.Key, doc, msg.TTL)
+		if ok && msg.Sliding {
+			a.Cache.TrySetTTL(msg.Key, msg.TTL, true)
+		}
+		context.Respond(&PostDocumentCacheKeyReply{Key: msg.Key, Version: a.entryVersion(msg.Key), Success: ok})
+		log.Printf("[DocumentCacheActor] Created %s [%v]", msg.Key, msg.TTL)
+		break
+	case *GetDocumentCachePathMessage:
+		ok, v, err := a.Cache.TryGetPath(msg.Key, msg.Path)
+		reply := &GetDocumentCachePathReply{Key: msg.Key, Path: msg.Path, Version: a.entryVersion(msg.Key), Success: ok}
+		if err == nil && ok {
+			reply.Value = cache.FormatDocument(v)
+			reply.Found = true
+		} else if err != nil && err != cache.ErrPathNotFound {
+			reply.Error = err.Error()
+		}
+		context.Respond(reply)
+		break
+	case *SetDocumentCachePathMessage:
+		if v := a.entryVersion(msg.Key); isVersionConflict(v, msg.Version) {
+			context.Respond(&SetDocumentCachePathReply{Key: msg.Key, Path: msg.Path, Version: v, Conflict: true})
+			break
+		}
+		value, err := cache.ParseDocument(msg.Value)
+		if err != nil {
+			context.Respond(&SetDocumentCachePathReply{Key: msg.Key, Path: msg.Path, Error: err.Error()})
+			break
+		}
+		ok, err := a.Cache.TrySetPath(msg.Key, msg.Path, value)
+		context.Respond(&SetDocumentCachePathReply{Key: msg.Key, Path: msg.Path, Version: a.entryVersion(msg.Key), Success: ok, Error: errorText(err)})
+		if ok && err == nil {
+			log.Printf("[DocumentCacheActor] Set %s of document %s", msg.Path, msg.Key)
+		}
+		break
+	case *DeleteDocumentCachePathMessage:
+		if v := a.entryVersion(msg.Key); isVersionConflict(v, msg.Version) {
+			context.Respond(&DeleteDocumentCachePathReply{Key: msg.Key, Path: msg.Path, Version: v, Conflict: true})
+			break
+		}
+		ok, v, err := a.Cache.TryDeletePath(msg.Key, msg.Path)
+		reply := &DeleteDocumentCachePathReply{Key: msg.Key, Path: msg.Path, Version: a.entryVersion(msg.Key), Success: ok, Error: errorText(err)}
+		if ok && err == nil {
+			reply.DeletedValue = cache.FormatDocument(v)
+			log.Printf("[DocumentCacheActor] Deleted %s of document %s", msg.Path, msg.Key)
+		}
+		context.Respond(reply)
+		break
+	case *AppendDocumentCacheValuesMessage:
+		if v := a.entryVersion(msg.Key); isVersionConflict(v, msg.Version) {
+			context.Respond(&AppendDocumentCacheValuesReply{Key: msg.Key, Path: msg.Path, Version: v, Conflict: true})
+			break
+		}
+		values := make([]interface{}, len(msg.Values))
+		var err error
+		for i, s := range msg.Values {
+			if values[i], err = cache.ParseDocument(s); err != nil {
+				break
+			}
+		}
+		if err != nil {
+			context.Respond(&AppendDocumentCacheValuesReply{Key: msg.Key, Path: msg.Path, Error: err.Error()})
+			break
+		}
+		ok, n, err := a.Cache.TryAppend(msg.Key, msg.Path, values)
+		context.Respond(&AppendDocumentCacheValuesReply{Key: msg.Key, Path: msg.Path, Length: int64(n), Version: a.entryVersion(msg.Key), Success: ok, Error: errorText(err)})
+		if ok && err == nil {
+			log.Printf("[DocumentCacheActor] Appended %d values to %s of document %s", len(values), msg.Path, msg.Key)
+		}
+		break
+	case *MergeDocumentCacheMessage:
+		if v := a.entryVersion(msg.Key); isVersionConflict(v, msg.Version) {
+			context.Respond(&MergeDocumentCacheReply{Key: msg.Key, Version: v, Conflict: true})
+			break
+		}
+		patch, err := cache.ParseDocument(msg.Patch)
+		if err != nil {
+			context.Respond(&MergeDocumentCacheReply{Key: msg.Key, Error: err.Error()})
+			break
+		}
+		ok, v := a.Cache.TryMerge(msg.Key, patch)
+		reply := &MergeDocumentCacheReply{Key: msg.Key, Version: a.entryVersion(msg.Key), Success: ok}
+		if ok {
+			reply.Value = cache.FormatDocument(v)
+			log.Printf("[DocumentCacheActor] Merged patch into document %s", msg.Key)
+		}
+		context.Respond(reply)
+		break
+	case *PatchDocumentCacheMessage:
+		if v := a.entryVersion(msg.Key); isVersionConflict(v, msg.Version) {
+			context.Respond(&PatchDocumentCacheReply{Key: msg.Key, Version: v, Conflict: true})
+			break
+		}
+		ops, err := toPatchOperations(msg.Operations)
+		if err != nil {
+			context.Respond(&PatchDocumentCacheReply{Key: msg.Key, Error: err.Error()})
+			break
+		}
+		ok, v, err := a.Cache.TryPatch(msg.Key, ops)
+		reply := &PatchDocumentCacheReply{Key: msg.Key, Version: a.entryVersion(msg.Key), Success: ok, Error: errorText(err)}
+		if ok && err == nil {
+			reply.Value = cache.FormatDocument(v)
+			log.Printf("[DocumentCacheActor] Applied %d patch operations to document %s", len(ops), msg.Key)
+		}
+		context.Respond(reply)
+		break
+	case *GetCacheTTLMessage:
+		ok, v, _ := a.Cache.TryGetMetadata(msg.Key)
+		context.Respond(&GetCacheTTLReply{Key: msg.Key, ExpireAfter: v.ExpireAfter, Sliding: v.SlidingTTL(), Success: ok})
+		break
+	case *GetCacheMetadataMessage:
+		ok, v, size := a.Cache.TryGetMetadata(msg.Key)
+		context.Respond(newCacheMetadataReply(msg.Key, v, size, ok))
+		break
+	case *SetCacheTTLMessage:
+		ok := a.Cache.TrySetTTL(msg.Key, msg.TTL, msg.Sliding)
+		context.Respond(&SetCacheTTLReply{Key: msg.Key, Success: ok})
+		if ok {
+			log.Printf("[DocumentCacheActor] Set ttl of %s to %v", msg.Key, msg.TTL)
+		}
+		break
+	case *PersistCacheKeyMessage:
+		ok := a.Cache.TrySetTTL(msg.Key, 0, false)
+		context.Respond(&PersistCacheKeyReply{Key: msg.Key, Success: ok})
+		if ok {
+			log.Printf("[DocumentCacheActor] Removed ttl of %s", msg.Key)
+		}
+		break
+	case *actor.Stopping:
+		stopSweep(a.sweepTimer)
+		a.persistSnapshot()
+		break
+	}
+}
+
+// entryVersion returns the version of the entry, zero means that the key was not found.
+func (a *DocumentCacheActor) entryVersion(key string) int64 {
+	_, v, _ := a.Cache.TryGetMetadata(key)
+	return v.Version
+}
+
+func (a *DocumentCacheActor) restoreSnapshot() {
+	for _, entry := range a.DB.GetAll() {
+		doc, err := cache.ParseDocument(entry.Value)
+		if err != nil {
+			log.Printf("[DocumentCacheActor] Skipped malformed document %s", entry.Key)
+			continue
+		}
+		mappedItem := cache.DocumentCacheEntry{
+			Value: doc,
+			CacheEntryData: cache.CacheEntryData{
+				Added:       entry.Added,
+				Updated:     entry.Updated,
+				ExpireAfter: entry.ExpireAfter,
+				Sliding:     entry.Sliding,
+				Version:     entry.Version,
+				Persisted:   true}}
+		a.CachePersister.TryAddFromSnapshot(entry.Key, mappedItem)
+	}
+}
+
+func (a *DocumentCacheActor) persistSnapshot() {
+	var newItems []repo.DocumentCacheDBEntry
+	var updatedItems []repo.DocumentCacheDBEntry
+	for _, k := range a.Cache.GetKeys() {
+		ok, v := a.CachePersister.TryGetSnapshot(k)
+		if ok {
+			mappedItem := repo.DocumentCacheDBEntry{
+				Key:         k,
+				Value:       cache.FormatDocument(v.Value),
+				Added:       v.Added,
+				Updated:     v.Updated,
+				ExpireAfter: v.ExpireAfter,
+				Sliding:     v.Sliding,
+				Version:     v.Version}
+			if v.Persisted {
+				updatedItems = append(updatedItems, mappedItem)
+			} else {
+				newItems = append(newItems, mappedItem)
+			}
+		}
+	}
+	if newItems == nil {
+		newItems = make([]repo.DocumentCacheDBEntry, 0)
+	}
+	if updatedItems == nil {
+		updatedItems = make([]repo.DocumentCacheDBEntry, 0)
+	}
+	a.DB.SaveAll(newItems, updatedItems)
+}
+
+// toPatchOperations parses the values of add, replace and test operations.
+func toPatchOperations(ops []DocumentPatchOperation) ([]cache.PatchOperation, error) {
+	res := make([]cache.PatchOperation, len(ops))
+	for i, op := range ops {
+		res[i] = cache.PatchOperation{Op: op.Op, Path: op.Path, From: op.From}
+		if op.Op == "add" || op.Op == "replace" || op.Op == "test" {
+			v, err := cache.ParseDocument(op.Value)
+			if err != nil {
+				return nil, err
+			}
+			res[i].Value = v
+		}
+	}
+	return res, nil
+}
+
+func errorText(err error) string {
+	if err != nil {
+		return err.Error()
+	}
+	return ""
+}
@@ -0,0 +1,28 @@
+package act
+
+import (
+	"fmt"
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/AsynkronIT/protoactor-go/router"
+	"github.com/VitalKrasilnikau/memcache/core/cache"
+)
+
+// NewDocumentCacheActorCluster is a constructor function for the cluster of DocumentCacheActor.
+func NewDocumentCacheActorCluster(clusterName string, nodeNumber int, usePersistence bool, isRemote bool, options cache.Options) (*actor.PID, *BroadcastStopGroup, *BroadcastStringKeysGroup) {
+	var nodes = make([]*actor.PID, nodeNumber)
+	for i := 0; i < nodeNumber; i++ {
+		if isRemote {
+			nodes[i] = actor.NewPID(fmt.Sprintf("127.0.0.1:%d", i + 63000), fmt.Sprintf("documents%d", i))
+		} else {
+			nodes[i] = factory.CreateDocumentCacheActor(clusterName, fmt.Sprintf("documents%d", i), usePersistence, options)
+		}
+	}
+	return actor.Spawn(router.NewConsistentHashGroup(nodes...)),
+		NewBroadcastStopGroup(nodes),
+		NewBroadcastStringKeysGroup(nodes)
+}
+
+// NewDocumentCacheActor creates actor instance for remote connection.
+func NewDocumentCacheActor(clusterName string, nodeNumber int, usePersistence bool, options cache.Options) *actor.PID {
+		return factory.CreateDocumentCacheActor(clusterName, fmt.Sprintf("documents%d", nodeNumber), usePersistence, options)
+}
@@ -0,0 +1,7 @@
+package act
+
+// isVersionConflict returns true if the version is expected and the existing entry has another one.
+// Zero current version means that the entry does not exist, so the operation itself reports that the key was not found.
+func isVersionConflict(current int64, expected int64) bool {
+	return current != 0 && expected != 0 && current != expected
+}
@@ -0,0 +1,280 @@
+package act
+
+import (
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/VitalKrasilnikau/memcache/core/cache"
+	"github.com/VitalKrasilnikau/memcache/core/messages"
+	"github.com/VitalKrasilnikau/memcache/core/repository"
+	"log"
+	"time"
+)
+
+// GeoMember is a member with its longitude and latitude.
+type GeoMember = messages.GeoMember
+
+// GeoResult is a member with its position decoded from the geohash and the distance in meters from the center of the search.
+type GeoResult = messages.GeoResult
+
+// GetGeoCacheKeyMessage is used to get all the members with their positions.
+type GetGeoCacheKeyMessage = messages.GetGeoCacheKeyMessage
+
+// GetGeoCacheKeyReply is a reply message for GetGeoCacheKeyMessage.
+type GetGeoCacheKeyReply = messages.GetGeoCacheKeyReply
+
+// DeleteGeoCacheKeyMessage is used to request the cache item deletion.
+type DeleteGeoCacheKeyMessage = messages.DeleteGeoCacheKeyMessage
+
+// DeleteGeoCacheKeyReply is a reply message for DeleteGeoCacheKeyMessage.
+type DeleteGeoCacheKeyReply = messages.DeleteGeoCacheKeyReply
+
+// PostGeoCacheKeyMessage is used to add new cache entry.
+type PostGeoCacheKeyMessage = messages.PostGeoCacheKeyMessage
+
+// PostGeoCacheKeyReply is a reply message for PostGeoCacheKeyMessage.
+type PostGeoCacheKeyReply = messages.PostGeoCacheKeyReply
+
+// AddGeoCacheMembersMessage is used to add or move the members like GEOADD.
+type AddGeoCacheMembersMessage = messages.AddGeoCacheMembersMessage
+
+// AddGeoCacheMembersReply is a reply message for AddGeoCacheMembersMessage.
+type AddGeoCacheMembersReply = messages.AddGeoCacheMembersReply
+
+// RemoveGeoCacheMembersMessage is used to remove the members.
+type RemoveGeoCacheMembersMessage = messages.RemoveGeoCacheMembersMessage
+
+// RemoveGeoCacheMembersReply is a reply message for RemoveGeoCacheMembersMessage.
+type RemoveGeoCacheMembersReply = messages.RemoveGeoCacheMembersReply
+
+// GetGeoCachePositionsMessage is used to get the positions of the members like GEOPOS.
+type GetGeoCachePositionsMessage = messages.GetGeoCachePositionsMessage
+
+// GetGeoCachePositionsReply is a reply message for GetGeoCachePositionsMessage.
+type GetGeoCachePositionsReply = messages.GetGeoCachePositionsReply
+
+// GetGeoCacheDistanceMessage is used to get the distance in meters between two members like GEODIST.
+type GetGeoCacheDistanceMessage = messages.GetGeoCacheDistanceMessage
+
+// GetGeoCacheDistanceReply is a reply message for GetGeoCacheDistanceMessage.
+type GetGeoCacheDistanceReply = messages.GetGeoCacheDistanceReply
+
+// SearchGeoCacheMessage is used to find the members within the radius or the box like GEOSEARCH.
+type SearchGeoCacheMessage = messages.SearchGeoCacheMessage
+
+// SearchGeoCacheReply is a reply message for SearchGeoCacheMessage.
+type SearchGeoCacheReply = messages.SearchGeoCacheReply
+
+// GeoCacheActor manages partitioned geospatial cache and its persistence.
+type GeoCacheActor struct {
+	ClusterName    string
+	NodeName       string
+	Cache          cache.IGeoCache
+	CachePersister cache.IGeoCachePersistence
+	DB             repo.IGeoCacheRepository
+	Sweep          cache.SweepOptions
+	sweepTimer     *time.Timer
+}
+
+// Receive is GeoCacheActor messages handler.
+func (a *GeoCacheActor) Receive(context actor.Context) {
+	switch msg := context.Message().(type) {
+	case *actor.Started:
+		a.sweepTimer = scheduleSweep(context.Self(), a.Sweep)
+		break
+	case *SweepExpiredMessage:
+		if n := a.Cache.RemoveExpired(a.Sweep.Budget); n > 0 {
+			log.Printf("[GeoCacheActor] Reaped %d expired keys", n)
+		}
+		a.sweepTimer = scheduleSweep(context.Self(), a.Sweep)
+		break
+	case *GetGeoCacheKeyMessage:
+		ok, v := a.Cache.TryGet(msg.Key)
+		context.Respond(&GetGeoCacheKeyReply{Key: msg.Key, Members: fromGeoResults(v), Version: a.entryVersion(msg.Key), Success: ok})
+		break
+	case *DeleteGeoCacheKeyMessage:
+		if v := a.entryVersion(msg.Key); isVersionConflict(v, msg.Version) {
+			context.Respond(&DeleteGeoCacheKeyReply{Key: msg.Key, Conflict: true})
+			break
+		}
+		ok := a.Cache.TryDelete(msg.Key)
+		context.Respond(&DeleteGeoCacheKeyReply{Key: msg.Key, Success: ok})
+		log.Printf("[GeoCacheActor] Deleted %s", msg.Key)
+		break
+	case *GetCacheKeysMessage:
+		context.Respond(&GetCacheKeysReply{Keys: a.Cache.GetKeys()})
+		break
+	case *GetCacheStatsMessage:
+		stats := a.Cache.GetStats()
+		context.Respond(&GetCacheStatsReply{Entries: stats.Entries, Bytes: stats.Bytes, Evicted: stats.Evicted, Reaped: stats.Reaped})
+		break
+	case *PostGeoCacheKeyMessage:
+		members, err := cache.ToGeoMembers(toGeoPositions(msg.Members))
+		if err != nil {
+			context.Respond(&PostGeoCacheKeyReply{Key: msg.Key, Error: err.Error()})
+			break
+		}
+		ok := a.Cache.TryAdd(msg.Key, members, msg.TTL)
+		if ok && msg.Sliding {
+			a.Cache.TrySetTTL(msg.Key, msg.TTL, true)
+		}
+		context.Respond(&PostGeoCacheKeyReply{Key: msg.Key, Version: a.entryVersion(msg.Key), Success: ok})
+		log.Printf("[GeoCacheActor] Created %s [%v]", msg.Key, msg.TTL)
+		break
+	case *AddGeoCacheMembersMessage:
+		if v := a.entryVersion(msg.Key); isVersionConflict(v, msg.Version) {
+			context.Respond(&AddGeoCacheMembersReply{Key: msg.Key, Version: v, Conflict: true})
+			break
+		}
+		positions := toGeoPositions(msg.Members)
+		ok, added, updated, err := a.Cache.TryAddMembers(msg.Key, positions)
+		created := false
+		if !ok && msg.Create {
+			var members map[string]uint64
+			if members, err = cache.ToGeoMembers(positions); err == nil {
+				created = a.Cache.TryAdd(msg.Key, members, 0)
+				ok, added = created, len(members)
+			}
+		}
+		reply := &AddGeoCacheMembersReply{Key: msg.Key, Added: int32(added), Updated: int32(updated), Created: created, Version: a.entryVersion(msg.Key), Success: ok && err == nil}
+		if err != nil {
+			reply.Error = err.Error()
+		} else if ok {
+			log.Printf("[GeoCacheActor] Added %d and moved %d members of %s", added, updated, msg.Key)
+		}
+		context.Respond(reply)
+		break
+	case *RemoveGeoCacheMembersMessage:
+		if v := a.entryVersion(msg.Key); isVersionConflict(v, msg.Version) {
+			context.Respond(&RemoveGeoCacheMembersReply{Key: msg.Key, Version: v, Conflict: true})
+			break
+		}
+		ok, n := a.Cache.TryRemoveMembers(msg.Key, msg.Members)
+		context.Respond(&RemoveGeoCacheMembersReply{Key: msg.Key, Removed: int32(n), Version: a.entryVersion(msg.Key), Success: ok})
+		if ok {
+			log.Printf("[GeoCacheActor] Removed %d members from %s", n, msg.Key)
+		}
+		break
+	case *GetGeoCachePositionsMessage:
+		ok, found, missing := a.Cache.TryGetPositions(msg.Key, msg.Members)
+		context.Respond(&GetGeoCachePositionsReply{Key: msg.Key, Positions: fromGeoResults(found), Missing: missing, Version: a.entryVersion(msg.Key), Success: ok})
+		break
+	case *GetGeoCacheDistanceMessage:
+		ok, d, found := a.Cache.TryGetDistance(msg.Key, msg.From, msg.To)
+		context.Respond(&GetGeoCacheDistanceReply{Key: msg.Key, Distance: d, Found: found, Version: a.entryVersion(msg.Key), Success: ok})
+		break
+	case *SearchGeoCacheMessage:
+		ok, results, err := a.Cache.TrySearch(msg.Key, cache.GeoQuery{
+			Member:     msg.Member,
+			Longitude:  msg.Longitude,
+			Latitude:   msg.Latitude,
+			Radius:     msg.Radius,
+			Width:      msg.Width,
+			Height:     msg.Height,
+			Count:      int(msg.Count),
+			Descending: msg.Descending})
+		reply := &SearchGeoCacheReply{Key: msg.Key, Results: fromGeoResults(results), Version: a.entryVersion(msg.Key), Success: ok && err == nil}
+		if err != nil {
+			reply.Error = err.Error()
+		}
+		context.Respond(reply)
+		break
+	case *GetCacheTTLMessage:
+		ok, v, _ := a.Cache.TryGetMetadata(msg.Key)
+		context.Respond(&GetCacheTTLReply{Key: msg.Key, ExpireAfter: v.ExpireAfter, Sliding: v.SlidingTTL(), Success: ok})
+		break
+	case *GetCacheMetadataMessage:
+		ok, v, size := a.Cache.TryGetMetadata(msg.Key)
+		context.Respond(newCacheMetadataReply(msg.Key, v, size, ok))
+		break
+	case *SetCacheTTLMessage:
+		ok := a.Cache.TrySetTTL(msg.Key, msg.TTL, msg.Sliding)
+		context.Respond(&SetCacheTTLReply{Key: msg.Key, Success: ok})
+		if ok {
+			log.Printf("[GeoCacheActor] Set ttl of %s to %v", msg.Key, msg.TTL)
+		}
+		break
+	case *PersistCacheKeyMessage:
+		ok := a.Cache.TrySetTTL(msg.Key, 0, false)
+		context.Respond(&PersistCacheKeyReply{Key: msg.Key, Success: ok})
+		if ok {
+			log.Printf("[GeoCacheActor] Removed ttl of %s", msg.Key)
+		}
+		break
+	case *actor.Stopping:
+		stopSweep(a.sweepTimer)
+		a.persistSnapshot()
+		break
+	}
+}
+
+// entryVersion returns the version of the entry, zero means that the key was not found.
+func (a *GeoCacheActor) entryVersion(key string) int64 {
+	_, v, _ := a.Cache.TryGetMetadata(key)
+	return v.Version
+}
+
+func toGeoPositions(members []GeoMember) []cache.GeoPosition {
+	res := make([]cache.GeoPosition, len(members))
+	for i, m := range members {
+		res[i] = cache.GeoPosition{Member: m.Member, Longitude: m.Longitude, Latitude: m.Latitude}
+	}
+	return res
+}
+
+func fromGeoResults(results []cache.GeoResult) []GeoResult {
+	res := make([]GeoResult, len(results))
+	for i, r := range results {
+		res[i] = GeoResult{Member: r.Member, Longitude: r.Longitude, Latitude: r.Latitude, Distance: r.Distance, Geohash: cache.GeohashString(r.Hash)}
+	}
+	return res
+}
+
+func (a *GeoCacheActor) restoreSnapshot() {
+	for _, entry := range a.DB.GetAll() {
+		members := make(map[string]uint64, len(entry.Members))
+		for _, m := range entry.Members {
+			members[m.Member] = uint64(m.Hash)
+		}
+		mappedItem := cache.NewGeoCacheEntry(members, cache.CacheEntryData{
+			Added:       entry.Added,
+			Updated:     entry.Updated,
+			ExpireAfter: entry.ExpireAfter,
+			Sliding:     entry.Sliding,
+			Version:     entry.Version,
+			Persisted:   true})
+		a.CachePersister.TryAddFromSnapshot(entry.Key, mappedItem)
+	}
+}
+
+func (a *GeoCacheActor) persistSnapshot() {
+	var newItems []repo.GeoCacheDBEntry
+	var updatedItems []repo.GeoCacheDBEntry
+	for _, k := range a.Cache.GetKeys() {
+		ok, v := a.CachePersister.TryGetSnapshot(k)
+		if ok {
+			members := make([]repo.GeoMemberDBEntry, 0, len(v.Members))
+			for m, hash := range v.Members {
+				members = append(members, repo.GeoMemberDBEntry{Member: m, Hash: int64(hash)})
+			}
+			mappedItem := repo.GeoCacheDBEntry{
+				Key:         k,
+				Members:     members,
+				Added:       v.Added,
+				Updated:     v.Updated,
+				ExpireAfter: v.ExpireAfter,
+				Sliding:     v.Sliding,
+				Version:     v.Version}
+			if v.Persisted {
+				updatedItems = append(updatedItems, mappedItem)
+			} else {
+				newItems = append(newItems, mappedItem)
+			}
+		}
+	}
+	if newItems == nil {
+		newItems = make([]repo.GeoCacheDBEntry, 0)
+	}
+	if updatedItems == nil {
+		updatedItems = make([]repo.GeoCacheDBEntry, 0)
+	}
+	a.DB.SaveAll(newItems, updatedItems)
+}
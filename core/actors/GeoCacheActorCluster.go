@@ -0,0 +1,28 @@
+package act
+
+import (
+	"fmt"
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/AsynkronIT/protoactor-go/router"
+	"github.com/VitalKrasilnikau/memcache/core/cache"
+)
+
+// NewGeoCacheActorCluster is a constructor function for the cluster of GeoCacheActor.
+func NewGeoCacheActorCluster(clusterName string, nodeNumber int, usePersistence bool, isRemote bool, options cache.Options) (*actor.PID, *BroadcastStopGroup, *BroadcastStringKeysGroup) {
+	var nodes = make([]*actor.PID, nodeNumber)
+	for i := 0; i < nodeNumber; i++ {
+		if isRemote {
+			nodes[i] = actor.NewPID(fmt.Sprintf("127.0.0.1:%d", i + 56000), fmt.Sprintf("geos%d", i))
+		} else {
+			nodes[i] = factory.CreateGeoCacheActor(clusterName, fmt.Sprintf("geos%d", i), usePersistence, options)
+		}
+	}
+	return actor.Spawn(router.NewConsistentHashGroup(nodes...)),
+		NewBroadcastStopGroup(nodes),
+		NewBroadcastStringKeysGroup(nodes)
+}
+
+// NewGeoCacheActor creates actor instance for remote connection.
+func NewGeoCacheActor(clusterName string, nodeNumber int, usePersistence bool, options cache.Options) *actor.PID {
+		return factory.CreateGeoCacheActor(clusterName, fmt.Sprintf("geos%d", nodeNumber), usePersistence, options)
+}
@@ -1,9 +1,9 @@
 package act
 
+import "github.com/VitalKrasilnikau/memcache/core/messages"
+
 // GetCacheKeysMessage is used to request all cache keys.
-type GetCacheKeysMessage struct {}
+type GetCacheKeysMessage = messages.GetCacheKeysMessage
 
 // GetCacheKeysReply is a reply message for GetCacheKeysMessage.
-type GetCacheKeysReply struct {
-	Keys []string
-}
+type GetCacheKeysReply = messages.GetCacheKeysReply
@@ -0,0 +1,9 @@
+package act
+
+import "github.com/VitalKrasilnikau/memcache/core/messages"
+
+// GetCacheStatsMessage is used to request cache usage counters.
+type GetCacheStatsMessage = messages.GetCacheStatsMessage
+
+// GetCacheStatsReply is a reply message for GetCacheStatsMessage.
+type GetCacheStatsReply = messages.GetCacheStatsReply
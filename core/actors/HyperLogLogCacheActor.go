@@ -0,0 +1,220 @@
+package act
+
+import (
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/VitalKrasilnikau/memcache/core/cache"
+	"github.com/VitalKrasilnikau/memcache/core/messages"
+	"github.com/VitalKrasilnikau/memcache/core/repository"
+	"log"
+	"time"
+)
+
+// GetHyperLogLogCacheKeyMessage is used to get the estimated count and the registers of HyperLogLog.
+type GetHyperLogLogCacheKeyMessage = messages.GetHyperLogLogCacheKeyMessage
+
+// GetHyperLogLogCacheKeyReply is a reply message for GetHyperLogLogCacheKeyMessage.
+type GetHyperLogLogCacheKeyReply = messages.GetHyperLogLogCacheKeyReply
+
+// DeleteHyperLogLogCacheKeyMessage is used to request the cache item deletion.
+type DeleteHyperLogLogCacheKeyMessage = messages.DeleteHyperLogLogCacheKeyMessage
+
+// DeleteHyperLogLogCacheKeyReply is a reply message for DeleteHyperLogLogCacheKeyMessage.
+type DeleteHyperLogLogCacheKeyReply = messages.DeleteHyperLogLogCacheKeyReply
+
+// PostHyperLogLogCacheKeyMessage is used to add new cache entry.
+type PostHyperLogLogCacheKeyMessage = messages.PostHyperLogLogCacheKeyMessage
+
+// PostHyperLogLogCacheKeyReply is a reply message for PostHyperLogLogCacheKeyMessage.
+type PostHyperLogLogCacheKeyReply = messages.PostHyperLogLogCacheKeyReply
+
+// AddHyperLogLogCacheValuesMessage is used to add values to HyperLogLog like PFADD.
+type AddHyperLogLogCacheValuesMessage = messages.AddHyperLogLogCacheValuesMessage
+
+// AddHyperLogLogCacheValuesReply is a reply message for AddHyperLogLogCacheValuesMessage.
+type AddHyperLogLogCacheValuesReply = messages.AddHyperLogLogCacheValuesReply
+
+// MergeHyperLogLogCacheMessage is used to merge serialized HyperLogLogs into the cache entry like PFMERGE.
+type MergeHyperLogLogCacheMessage = messages.MergeHyperLogLogCacheMessage
+
+// MergeHyperLogLogCacheReply is a reply message for MergeHyperLogLogCacheMessage.
+type MergeHyperLogLogCacheReply = messages.MergeHyperLogLogCacheReply
+
+// HyperLogLogCacheActor manages partitioned HyperLogLog cache and its persistence.
+type HyperLogLogCacheActor struct {
+	ClusterName    string
+	NodeName       string
+	Cache          cache.IHyperLogLogCache
+	CachePersister cache.IHyperLogLogCachePersistence
+	DB             repo.IHyperLogLogCacheRepository
+	Sweep          cache.SweepOptions
+	sweepTimer     *time.Timer
+}
+
+// Receive is HyperLogLogCacheActor messages handler.
+func (a *HyperLogLogCacheActor) Receive(context actor.Context) {
+	switch msg := context.Message().(type) {
+	case *actor.Started:
+		a.sweepTimer = scheduleSweep(context.Self(), a.Sweep)
+		break
+	case *SweepExpiredMessage:
+		if n := a.Cache.RemoveExpired(a.Sweep.Budget); n > 0 {
+			log.Printf("[HyperLogLogCacheActor] Reaped %d expired keys", n)
+		}
+		a.sweepTimer = scheduleSweep(context.Self(), a.Sweep)
+		break
+	case *GetHyperLogLogCacheKeyMessage:
+		ok, v := a.Cache.TryGet(msg.Key)
+		reply := &GetHyperLogLogCacheKeyReply{Key: msg.Key, Version: a.entryVersion(msg.Key), Success: ok}
+		if ok {
+			reply.Count = v.Count()
+			reply.Data = v.Marshal()
+		}
+		context.Respond(reply)
+		break
+	case *DeleteHyperLogLogCacheKeyMessage:
+		if v := a.entryVersion(msg.Key); isVersionConflict(v, msg.Version) {
+			context.Respond(&DeleteHyperLogLogCacheKeyReply{Key: msg.Key, Conflict: true})
+			break
+		}
+		ok := a.Cache.TryDelete(msg.Key)
+		context.Respond(&DeleteHyperLogLogCacheKeyReply{Key: msg.Key, Success: ok})
+		log.Printf("[HyperLogLogCacheActor] Deleted %s", msg.Key)
+		break
+	case *GetCacheKeysMessage:
+		context.Respond(&GetCacheKeysReply{Keys: a.Cache.GetKeys()})
+		break
+	case *GetCacheStatsMessage:
+		stats := a.Cache.GetStats()
+		context.Respond(&GetCacheStatsReply{Entries: stats.Entries, Bytes: stats.Bytes, Evicted: stats.Evicted, Reaped: stats.Reaped})
+		break
+	case *PostHyperLogLogCacheKeyMessage:
+		ok := a.Cache.TryAdd(msg.Key, msg.Values, msg.TTL)
+		if ok && msg.Sliding {
+			a.Cache.TrySetTTL(msg.Key, msg.TTL, true)
+		}
+		context.Respond(&PostHyperLogLogCacheKeyReply{Key: msg.Key, Version: a.entryVersion(msg.Key), Success: ok})
+		log.Printf("[HyperLogLogCacheActor] Created %s [%v]", msg.Key, msg.TTL)
+		break
+	case *AddHyperLogLogCacheValuesMessage:
+		if v := a.entryVersion(msg.Key); isVersionConflict(v, msg.Version) {
+			context.Respond(&AddHyperLogLogCacheValuesReply{Key: msg.Key, Version: v, Conflict: true})
+			break
+		}
+		ok, changed := a.Cache.TryAddValues(msg.Key, msg.Values)
+		created := false
+		if !ok && msg.Create {
+			created = a.Cache.TryAdd(msg.Key, msg.Values, 0)
+			ok, changed = created, created
+		}
+		reply := &AddHyperLogLogCacheValuesReply{Key: msg.Key, Changed: changed, Created: created, Version: a.entryVersion(msg.Key), Success: ok}
+		if ok {
+			_, reply.Count = a.Cache.TryCount(msg.Key)
+			log.Printf("[HyperLogLogCacheActor] Added %d values to %s", len(msg.Values), msg.Key)
+		}
+		context.Respond(reply)
+		break
+	case *MergeHyperLogLogCacheMessage:
+		if v := a.entryVersion(msg.Key); isVersionConflict(v, msg.Version) {
+			context.Respond(&MergeHyperLogLogCacheReply{Key: msg.Key, Version: v, Conflict: true})
+			break
+		}
+		sources := make([]*cache.HyperLogLog, len(msg.Sources))
+		var err error
+		for i, data := range msg.Sources {
+			if sources[i], err = cache.UnmarshalHyperLogLog(data); err != nil {
+				break
+			}
+		}
+		if err != nil {
+			context.Respond(&MergeHyperLogLogCacheReply{Key: msg.Key, Error: err.Error()})
+			break
+		}
+		created := a.Cache.TryAdd(msg.Key, nil, 0)
+		ok, n := a.Cache.TryMerge(msg.Key, sources)
+		context.Respond(&MergeHyperLogLogCacheReply{Key: msg.Key, Count: n, Created: created, Version: a.entryVersion(msg.Key), Success: ok})
+		log.Printf("[HyperLogLogCacheActor] Merged %d sources into %s", len(sources), msg.Key)
+		break
+	case *GetCacheTTLMessage:
+		ok, v, _ := a.Cache.TryGetMetadata(msg.Key)
+		context.Respond(&GetCacheTTLReply{Key: msg.Key, ExpireAfter: v.ExpireAfter, Sliding: v.SlidingTTL(), Success: ok})
+		break
+	case *GetCacheMetadataMessage:
+		ok, v, size := a.Cache.TryGetMetadata(msg.Key)
+		context.Respond(newCacheMetadataReply(msg.Key, v, size, ok))
+		break
+	case *SetCacheTTLMessage:
+		ok := a.Cache.TrySetTTL(msg.Key, msg.TTL, msg.Sliding)
+		context.Respond(&SetCacheTTLReply{Key: msg.Key, Success: ok})
+		if ok {
+			log.Printf("[HyperLogLogCacheActor] Set ttl of %s to %v", msg.Key, msg.TTL)
+		}
+		break
+	case *PersistCacheKeyMessage:
+		ok := a.Cache.TrySetTTL(msg.Key, 0, false)
+		context.Respond(&PersistCacheKeyReply{Key: msg.Key, Success: ok})
+		if ok {
+			log.Printf("[HyperLogLogCacheActor] Removed ttl of %s", msg.Key)
+		}
+		break
+	case *actor.Stopping:
+		stopSweep(a.sweepTimer)
+		a.persistSnapshot()
+		break
+	}
+}
+
+// entryVersion returns the version of the entry, zero means that the key was not found.
+func (a *HyperLogLogCacheActor) entryVersion(key string) int64 {
+	_, v, _ := a.Cache.TryGetMetadata(key)
+	return v.Version
+}
+
+func (a *HyperLogLogCacheActor) restoreSnapshot() {
+	for _, entry := range a.DB.GetAll() {
+		h, err := cache.UnmarshalHyperLogLog(entry.Value)
+		if err != nil {
+			log.Printf("[HyperLogLogCacheActor] Skipped malformed HyperLogLog %s", entry.Key)
+			continue
+		}
+		mappedItem := cache.HyperLogLogCacheEntry{
+			Value: h,
+			CacheEntryData: cache.CacheEntryData{
+				Added:       entry.Added,
+				Updated:     entry.Updated,
+				ExpireAfter: entry.ExpireAfter,
+				Sliding:     entry.Sliding,
+				Version:     entry.Version,
+				Persisted:   true}}
+		a.CachePersister.TryAddFromSnapshot(entry.Key, mappedItem)
+	}
+}
+
+func (a *HyperLogLogCacheActor) persistSnapshot() {
+	var newItems []repo.HyperLogLogCacheDBEntry
+	var updatedItems []repo.HyperLogLogCacheDBEntry
+	for _, k := range a.Cache.GetKeys() {
+		ok, v := a.CachePersister.TryGetSnapshot(k)
+		if ok {
+			mappedItem := repo.HyperLogLogCacheDBEntry{
+				Key:         k,
+				Value:       v.Value.Marshal(),
+				Added:       v.Added,
+				Updated:     v.Updated,
+				ExpireAfter: v.ExpireAfter,
+				Sliding:     v.Sliding,
+				Version:     v.Version}
+			if v.Persisted {
+				updatedItems = append(updatedItems, mappedItem)
+			} else {
+				newItems = append(newItems, mappedItem)
+			}
+		}
+	}
+	if newItems == nil {
+		newItems = make([]repo.HyperLogLogCacheDBEntry, 0)
+	}
+	if updatedItems == nil {
+		updatedItems = make([]repo.HyperLogLogCacheDBEntry, 0)
+	}
+	a.DB.SaveAll(newItems, updatedItems)
+}
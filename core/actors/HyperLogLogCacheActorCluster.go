@@ -0,0 +1,28 @@
+package act
+
+import (
+	"fmt"
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/AsynkronIT/protoactor-go/router"
+	"github.com/VitalKrasilnikau/memcache/core/cache"
+)
+
+// NewHyperLogLogCacheActorCluster is a constructor function for the cluster of HyperLogLogCacheActor.
+func NewHyperLogLogCacheActorCluster(clusterName string, nodeNumber int, usePersistence bool, isRemote bool, options cache.Options) (*actor.PID, *BroadcastStopGroup, *BroadcastStringKeysGroup) {
+	var nodes = make([]*actor.PID, nodeNumber)
+	for i := 0; i < nodeNumber; i++ {
+		if isRemote {
+			nodes[i] = actor.NewPID(fmt.Sprintf("127.0.0.1:%d", i + 64000), fmt.Sprintf("hyperloglogs%d", i))
+		} else {
+			nodes[i] = factory.CreateHyperLogLogCacheActor(clusterName, fmt.Sprintf("hyperloglogs%d", i), usePersistence, options)
+		}
+	}
+	return actor.Spawn(router.NewConsistentHashGroup(nodes...)),
+		NewBroadcastStopGroup(nodes),
+		NewBroadcastStringKeysGroup(nodes)
+}
+
+// NewHyperLogLogCacheActor creates actor instance for remote connection.
+func NewHyperLogLogCacheActor(clusterName string, nodeNumber int, usePersistence bool, options cache.Options) *actor.PID {
+		return factory.CreateHyperLogLogCacheActor(clusterName, fmt.Sprintf("hyperloglogs%d", nodeNumber), usePersistence, options)
+}
@@ -3,127 +3,109 @@ package act
 import (
 	"github.com/AsynkronIT/protoactor-go/actor"
 	"github.com/VitalKrasilnikau/memcache/core/cache"
+	"github.com/VitalKrasilnikau/memcache/core/messages"
 	"github.com/VitalKrasilnikau/memcache/core/repository"
 	"log"
 	"time"
 )
 
 // GetListCacheKeyMessage is used to get the list cache entry.
-type GetListCacheKeyMessage struct {
-	Key     string
-}
-
-// Hash is used for partitioning in actor cluster.
-func (m *GetListCacheKeyMessage) Hash() string {
-	return m.Key
-}
+type GetListCacheKeyMessage = messages.GetListCacheKeyMessage
 
 // GetListCacheKeyReply is a reply message for GetListCacheKeyMessage.
-type GetListCacheKeyReply struct {
-	Key     string
-	Values  []string
-	Success bool
-}
-
-// Hash is used for partitioning in actor cluster.
-func (m *GetListCacheKeyReply) Hash() string {
-	return m.Key
-}
+type GetListCacheKeyReply = messages.GetListCacheKeyReply
 
 // DeleteListCacheKeyMessage is used to request the cache item deletion.
-type DeleteListCacheKeyMessage struct {
-	Key     string
-}
-
-// Hash is used for partitioning in actor cluster.
-func (m *DeleteListCacheKeyMessage) Hash() string {
-	return m.Key
-}
+type DeleteListCacheKeyMessage = messages.DeleteListCacheKeyMessage
 
 // DeleteListCacheKeyReply is a reply message for DeleteListCacheKeyMessage.
-type DeleteListCacheKeyReply struct {
-	Key           string
-	DeletedValues []string
-	Success       bool
-}
+type DeleteListCacheKeyReply = messages.DeleteListCacheKeyReply
 
 // PostListCacheKeyMessage is used to add new cache entry.
-type PostListCacheKeyMessage struct {
-	Key     string
-	Values  []string
-	TTL     time.Duration
-}
-
-// Hash is used for partitioning in actor cluster.
-func (m *PostListCacheKeyMessage) Hash() string {
-	return m.Key
-}
+type PostListCacheKeyMessage = messages.PostListCacheKeyMessage
 
 // PostListCacheKeyReply is a reply message for PostListCacheKeyMessage.
-type PostListCacheKeyReply struct {
-	Key     string
-	Success bool
-}
-
-// Hash is used for partitioning in actor cluster.
-func (m *PostListCacheKeyReply) Hash() string {
-	return m.Key
-}
+type PostListCacheKeyReply = messages.PostListCacheKeyReply
 
 // PutListCacheValueMessage is used to request cache entry update by original value.
-type PutListCacheValueMessage struct {
-	Key           string
-	NewValue      string
-	OriginalValue string
-}
-
-// Hash is used for partitioning in actor cluster.
-func (m *PutListCacheValueMessage) Hash() string {
-	return m.Key
-}
+type PutListCacheValueMessage = messages.PutListCacheValueMessage
 
 // PutListCacheValueReply is a reply message for PutListCacheValueMessage.
-type PutListCacheValueReply struct {
-	Key           string
-	Success       bool
-	NewValue      string
-	OriginalValue string
-}
+type PutListCacheValueReply = messages.PutListCacheValueReply
 
 // DeleteListCacheValueMessage is used to request cache entry update by deleting the original value.
-type DeleteListCacheValueMessage struct {
-	Key     string
-	Value   string
-}
-
-// Hash is used for partitioning in actor cluster.
-func (m *DeleteListCacheValueMessage) Hash() string {
-	return m.Key
-}
+type DeleteListCacheValueMessage = messages.DeleteListCacheValueMessage
 
 // DeleteListCacheValueReply is a reply message for DeleteListCacheValueMessage.
-type DeleteListCacheValueReply struct {
-	Key          string
-	DeletedValue string
-	Success      bool
-}
+type DeleteListCacheValueReply = messages.DeleteListCacheValueReply
 
 // PostListCacheValueMessage is used to request cache entry update by original value.
-type PostListCacheValueMessage struct {
-	Key      string
-	NewValue string
-}
+type PostListCacheValueMessage = messages.PostListCacheValueMessage
+
+// PostListCacheValueReply is a reply message for PostListCacheValueMessage.
+type PostListCacheValueReply = messages.PostListCacheValueReply
 
-// Hash is used for partitioning in actor cluster.
-func (m *PostListCacheValueMessage) Hash() string {
-	return m.Key
+// GetListCacheIndexMessage is used to get the list value by its index.
+type GetListCacheIndexMessage = messages.GetListCacheIndexMessage
+
+// GetListCacheIndexReply is a reply message for GetListCacheIndexMessage.
+type GetListCacheIndexReply = messages.GetListCacheIndexReply
+
+// SetListCacheIndexMessage is used to replace the list value by its index.
+type SetListCacheIndexMessage = messages.SetListCacheIndexMessage
+
+// SetListCacheIndexReply is a reply message for SetListCacheIndexMessage.
+type SetListCacheIndexReply = messages.SetListCacheIndexReply
+
+// InsertListCacheValueMessage is used to insert the value to the list before the index.
+type InsertListCacheValueMessage = messages.InsertListCacheValueMessage
+
+// InsertListCacheValueReply is a reply message for InsertListCacheValueMessage.
+type InsertListCacheValueReply = messages.InsertListCacheValueReply
+
+// RemoveListCacheIndexMessage is used to remove the list value by its index.
+type RemoveListCacheIndexMessage = messages.RemoveListCacheIndexMessage
+
+// RemoveListCacheIndexReply is a reply message for RemoveListCacheIndexMessage.
+type RemoveListCacheIndexReply = messages.RemoveListCacheIndexReply
+
+// GetListCacheRangeMessage is used to get the slice of the list.
+type GetListCacheRangeMessage = messages.GetListCacheRangeMessage
+
+// GetListCacheRangeReply is a reply message for GetListCacheRangeMessage.
+type GetListCacheRangeReply = messages.GetListCacheRangeReply
+
+// PushListCacheValuesMessage is used to add the values to the head or the tail of the list.
+type PushListCacheValuesMessage = messages.PushListCacheValuesMessage
+
+// PushListCacheValuesReply is a reply message for PushListCacheValuesMessage.
+type PushListCacheValuesReply = messages.PushListCacheValuesReply
+
+// PopListCacheValuesMessage is used to remove the values from the head or the tail of the list, waiting for them if timeout is set.
+type PopListCacheValuesMessage = messages.PopListCacheValuesMessage
+
+// PopListCacheValuesReply is a reply message for PopListCacheValuesMessage.
+type PopListCacheValuesReply = messages.PopListCacheValuesReply
+
+// TrimListCacheKeyMessage is used to keep only the range of the list values.
+type TrimListCacheKeyMessage = messages.TrimListCacheKeyMessage
+
+// TrimListCacheKeyReply is a reply message for TrimListCacheKeyMessage.
+type TrimListCacheKeyReply = messages.TrimListCacheKeyReply
+
+// PopListCacheTimeoutMessage is sent by the actor to itself when the blocking pop request times out.
+type PopListCacheTimeoutMessage struct {
+	Key string
+	ID  int64
 }
 
-// PostListCacheValueReply is a reply message for PostListCacheValueMessage.
-type PostListCacheValueReply struct {
-	Key        string
-	Success    bool
-	AddedValue string
+// listWaiter is a blocking pop request waiting for the values to be pushed to the list.
+type listWaiter struct {
+	ID     int64
+	Sender *actor.PID
+	Count  int
+	Left   bool
+	Timer  *time.Timer
 }
 
 // ListCacheActor manages partitioned string cache and its persistence.
@@ -133,55 +115,281 @@ type ListCacheActor struct {
 	Cache          cache.IListCache
 	CachePersister cache.IListCachePersistence
 	DB             repo.IListCacheRepository
+	Sweep          cache.SweepOptions
+	sweepTimer     *time.Timer
+	waiters        map[string][]*listWaiter
+	lastWaiterID   int64
 }
 
 // Receive is ListCacheActor messages handler.
 func (a *ListCacheActor) Receive(context actor.Context) {
 	switch msg := context.Message().(type) {
+	case *actor.Started:
+		a.sweepTimer = scheduleSweep(context.Self(), a.Sweep)
+		break
+	case *SweepExpiredMessage:
+		if n := a.Cache.RemoveExpired(a.Sweep.Budget); n > 0 {
+			log.Printf("[ListCacheActor] Reaped %d expired keys", n)
+		}
+		a.sweepTimer = scheduleSweep(context.Self(), a.Sweep)
+		break
 	case *GetListCacheKeyMessage:
 		ok, v := a.Cache.TryGet(msg.Key)
-		context.Respond(GetListCacheKeyReply{Key: msg.Key, Values: v, Success: ok})
+		context.Respond(&GetListCacheKeyReply{Key: msg.Key, Values: v, Version: a.entryVersion(msg.Key), Success: ok})
 		break
 	case *DeleteListCacheKeyMessage:
+		if v := a.entryVersion(msg.Key); isVersionConflict(v, msg.Version) {
+			context.Respond(&DeleteListCacheKeyReply{Key: msg.Key, Conflict: true})
+			break
+		}
 		ok, v := a.Cache.TryDelete(msg.Key)
-		context.Respond(DeleteListCacheKeyReply{Key: msg.Key, DeletedValues: v, Success: ok})
+		context.Respond(&DeleteListCacheKeyReply{Key: msg.Key, DeletedValues: v, Success: ok})
 		log.Printf("[ListCacheActor] Deleted %s", msg.Key)
 		break
 	case *GetCacheKeysMessage:
-		context.Respond(GetCacheKeysReply{Keys: a.Cache.GetKeys()})
+		context.Respond(&GetCacheKeysReply{Keys: a.Cache.GetKeys()})
+		break
+	case *GetCacheStatsMessage:
+		stats := a.Cache.GetStats()
+		context.Respond(&GetCacheStatsReply{Entries: stats.Entries, Bytes: stats.Bytes, Evicted: stats.Evicted, Reaped: stats.Reaped})
 		break
 	case *PostListCacheKeyMessage:
 		ok := a.Cache.TryAdd(msg.Key, msg.Values, msg.TTL)
-		context.Respond(PostListCacheKeyReply{Key: msg.Key, Success: ok})
+		if ok && msg.Sliding {
+			a.Cache.TrySetTTL(msg.Key, msg.TTL, true)
+		}
+		context.Respond(&PostListCacheKeyReply{Key: msg.Key, Version: a.entryVersion(msg.Key), Success: ok})
 		log.Printf("[ListCacheActor] Created %s [%v]", msg.Key, msg.TTL)
+		a.serveWaiters(msg.Key)
 		break
 	case *PostListCacheValueMessage:
+		if v := a.entryVersion(msg.Key); isVersionConflict(v, msg.Version) {
+			context.Respond(&PostListCacheValueReply{Key: msg.Key, Version: v, Conflict: true})
+			break
+		}
 		ok, _ := a.Cache.TryAddValue(msg.Key, msg.NewValue)
-		context.Respond(PostListCacheValueReply{Key: msg.Key, Success: ok, AddedValue: msg.NewValue})
+		context.Respond(&PostListCacheValueReply{Key: msg.Key, Version: a.entryVersion(msg.Key), Success: ok, AddedValue: msg.NewValue})
 		if ok {
 			log.Printf("[ListCacheActor] Added value %s to list %s", msg.NewValue, msg.Key)
+			a.serveWaiters(msg.Key)
 		}
 		break
 	case *PutListCacheValueMessage:
+		if v := a.entryVersion(msg.Key); isVersionConflict(v, msg.Version) {
+			context.Respond(&PutListCacheValueReply{Key: msg.Key, OriginalValue: msg.OriginalValue, Version: v, Conflict: true})
+			break
+		}
 		ok, _ := a.Cache.TryUpdateValue(msg.Key, msg.NewValue, msg.OriginalValue)
-		context.Respond(PutListCacheValueReply{Key: msg.Key, Success: ok, NewValue: msg.NewValue, OriginalValue: msg.OriginalValue})
+		context.Respond(&PutListCacheValueReply{Key: msg.Key, Version: a.entryVersion(msg.Key), Success: ok, NewValue: msg.NewValue, OriginalValue: msg.OriginalValue})
 		if ok {
 			log.Printf("[ListCacheActor] Updated value %s to %s in list %s", msg.OriginalValue, msg.NewValue, msg.Key)
 		}
 		break
 	case *DeleteListCacheValueMessage:
-		ok, _ := a.Cache.TryDeleteValue(msg.Key, msg.Value)
-		context.Respond(DeleteListCacheValueReply{Key: msg.Key, DeletedValue: msg.Value, Success: ok})
+		if v := a.entryVersion(msg.Key); isVersionConflict(v, msg.Version) {
+			context.Respond(&DeleteListCacheValueReply{Key: msg.Key, DeletedValue: msg.Value, Version: v, Conflict: true})
+			break
+		}
+		ok, n := a.Cache.TryDeleteValue(msg.Key, msg.Value, int(msg.Count))
+		context.Respond(&DeleteListCacheValueReply{Key: msg.Key, Version: a.entryVersion(msg.Key), DeletedValue: msg.Value, DeletedCount: int32(n), Success: ok})
 		if ok {
 			log.Printf("[ListCacheActor] Deleted value %s in list %s", msg.Value, msg.Key)
 		}
 		break
+	case *GetListCacheIndexMessage:
+		ok, v, inRange := a.Cache.TryGetIndex(msg.Key, int(msg.Index))
+		context.Respond(&GetListCacheIndexReply{Key: msg.Key, Index: msg.Index, Value: v, Version: a.entryVersion(msg.Key), Success: ok && inRange, OutOfRange: ok && !inRange})
+		break
+	case *SetListCacheIndexMessage:
+		if v := a.entryVersion(msg.Key); isVersionConflict(v, msg.Version) {
+			context.Respond(&SetListCacheIndexReply{Key: msg.Key, Index: msg.Index, Version: v, Conflict: true})
+			break
+		}
+		ok, inRange := a.Cache.TrySetIndex(msg.Key, int(msg.Index), msg.Value)
+		context.Respond(&SetListCacheIndexReply{Key: msg.Key, Index: msg.Index, Version: a.entryVersion(msg.Key), Success: ok && inRange, OutOfRange: ok && !inRange})
+		if ok && inRange {
+			log.Printf("[ListCacheActor] Set value at %d to %s in list %s", msg.Index, msg.Value, msg.Key)
+		}
+		break
+	case *InsertListCacheValueMessage:
+		if v := a.entryVersion(msg.Key); isVersionConflict(v, msg.Version) {
+			context.Respond(&InsertListCacheValueReply{Key: msg.Key, Index: msg.Index, Version: v, Conflict: true})
+			break
+		}
+		ok, inRange, n := a.Cache.TryInsert(msg.Key, int(msg.Index), msg.Value)
+		context.Respond(&InsertListCacheValueReply{Key: msg.Key, Index: msg.Index, Length: int64(n), Version: a.entryVersion(msg.Key), Success: ok && inRange, OutOfRange: ok && !inRange})
+		if ok && inRange {
+			log.Printf("[ListCacheActor] Inserted value %s at %d to list %s", msg.Value, msg.Index, msg.Key)
+			a.serveWaiters(msg.Key)
+		}
+		break
+	case *RemoveListCacheIndexMessage:
+		if v := a.entryVersion(msg.Key); isVersionConflict(v, msg.Version) {
+			context.Respond(&RemoveListCacheIndexReply{Key: msg.Key, Index: msg.Index, Version: v, Conflict: true})
+			break
+		}
+		ok, v, inRange := a.Cache.TryRemoveIndex(msg.Key, int(msg.Index))
+		context.Respond(&RemoveListCacheIndexReply{Key: msg.Key, Index: msg.Index, RemovedValue: v, Version: a.entryVersion(msg.Key), Success: ok && inRange, OutOfRange: ok && !inRange})
+		if ok && inRange {
+			log.Printf("[ListCacheActor] Removed value at %d in list %s", msg.Index, msg.Key)
+		}
+		break
+	case *GetListCacheRangeMessage:
+		ok, v, start, n := a.Cache.TryGetRange(msg.Key, int(msg.Start), int(msg.Stop))
+		context.Respond(&GetListCacheRangeReply{Key: msg.Key, Values: v, Start: int64(start), Length: int64(n), Version: a.entryVersion(msg.Key), Success: ok})
+		break
+	case *PushListCacheValuesMessage:
+		if v := a.entryVersion(msg.Key); isVersionConflict(v, msg.Version) {
+			context.Respond(&PushListCacheValuesReply{Key: msg.Key, Version: v, Conflict: true})
+			break
+		}
+		ok, n := a.Cache.TryPush(msg.Key, msg.Values, msg.Left)
+		created := false
+		if !ok && msg.Create {
+			created = a.Cache.TryAdd(msg.Key, make([]string, 0), 0)
+			if created {
+				ok, n = a.Cache.TryPush(msg.Key, msg.Values, msg.Left)
+			}
+		}
+		context.Respond(&PushListCacheValuesReply{Key: msg.Key, Length: int64(n), Created: created, Version: a.entryVersion(msg.Key), Success: ok})
+		if ok {
+			log.Printf("[ListCacheActor] Pushed %d values to list %s", len(msg.Values), msg.Key)
+			a.serveWaiters(msg.Key)
+		}
+		break
+	case *PopListCacheValuesMessage:
+		if v := a.entryVersion(msg.Key); isVersionConflict(v, msg.Version) {
+			context.Respond(&PopListCacheValuesReply{Key: msg.Key, Version: v, Conflict: true})
+			break
+		}
+		count := int(msg.Count)
+		if count <= 0 {
+			count = 1
+		}
+		ok, v := a.Cache.TryPop(msg.Key, count, msg.Left)
+		if len(v) == 0 && msg.Timeout > 0 {
+			a.addWaiter(context, msg.Key, count, msg.Left, msg.Timeout)
+			break
+		}
+		context.Respond(&PopListCacheValuesReply{Key: msg.Key, Values: v, Version: a.entryVersion(msg.Key), Success: ok && len(v) > 0})
+		if len(v) > 0 {
+			log.Printf("[ListCacheActor] Popped %d values from list %s", len(v), msg.Key)
+		}
+		break
+	case *PopListCacheTimeoutMessage:
+		if w := a.removeWaiter(msg.Key, msg.ID); w != nil {
+			w.Sender.Tell(&PopListCacheValuesReply{Key: msg.Key, Values: make([]string, 0), Version: a.entryVersion(msg.Key), TimedOut: true})
+		}
+		break
+	case *TrimListCacheKeyMessage:
+		if v := a.entryVersion(msg.Key); isVersionConflict(v, msg.Version) {
+			context.Respond(&TrimListCacheKeyReply{Key: msg.Key, Version: v, Conflict: true})
+			break
+		}
+		ok, removed, n := a.Cache.TryTrim(msg.Key, int(msg.Start), int(msg.Stop))
+		context.Respond(&TrimListCacheKeyReply{Key: msg.Key, Removed: int64(removed), Length: int64(n), Version: a.entryVersion(msg.Key), Success: ok})
+		if removed > 0 {
+			log.Printf("[ListCacheActor] Trimmed %d values in list %s", removed, msg.Key)
+		}
+		break
+	case *GetCacheTTLMessage:
+		ok, v, _ := a.Cache.TryGetMetadata(msg.Key)
+		context.Respond(&GetCacheTTLReply{Key: msg.Key, ExpireAfter: v.ExpireAfter, Sliding: v.SlidingTTL(), Success: ok})
+		break
+	case *GetCacheMetadataMessage:
+		ok, v, size := a.Cache.TryGetMetadata(msg.Key)
+		context.Respond(newCacheMetadataReply(msg.Key, v, size, ok))
+		break
+	case *SetCacheTTLMessage:
+		ok := a.Cache.TrySetTTL(msg.Key, msg.TTL, msg.Sliding)
+		context.Respond(&SetCacheTTLReply{Key: msg.Key, Success: ok})
+		if ok {
+			log.Printf("[ListCacheActor] Set ttl of %s to %v", msg.Key, msg.TTL)
+		}
+		break
+	case *PersistCacheKeyMessage:
+		ok := a.Cache.TrySetTTL(msg.Key, 0, false)
+		context.Respond(&PersistCacheKeyReply{Key: msg.Key, Success: ok})
+		if ok {
+			log.Printf("[ListCacheActor] Removed ttl of %s", msg.Key)
+		}
+		break
 	case *actor.Stopping:
+		stopSweep(a.sweepTimer)
+		a.releaseWaiters()
 		a.persistSnapshot()
 		break
 	}
 }
 
+// addWaiter parks the blocking pop request until the values are pushed to the list or the timeout expires.
+func (a *ListCacheActor) addWaiter(context actor.Context, key string, count int, left bool, timeout time.Duration) {
+	if a.waiters == nil {
+		a.waiters = make(map[string][]*listWaiter)
+	}
+	a.lastWaiterID++
+	id, self := a.lastWaiterID, context.Self()
+	w := &listWaiter{ID: id, Sender: context.Sender(), Count: count, Left: left}
+	w.Timer = time.AfterFunc(timeout, func() {
+		self.Tell(&PopListCacheTimeoutMessage{Key: key, ID: id})
+	})
+	a.waiters[key] = append(a.waiters[key], w)
+}
+
+// removeWaiter returns the waiter by its id and forgets it, nil is returned if the waiter was already served.
+func (a *ListCacheActor) removeWaiter(key string, id int64) *listWaiter {
+	waiters := a.waiters[key]
+	for i, w := range waiters {
+		if w.ID == id {
+			a.setWaiters(key, append(waiters[:i:i], waiters[i+1:]...))
+			return w
+		}
+	}
+	return nil
+}
+
+func (a *ListCacheActor) setWaiters(key string, waiters []*listWaiter) {
+	if len(waiters) == 0 {
+		delete(a.waiters, key)
+	} else {
+		a.waiters[key] = waiters
+	}
+}
+
+// serveWaiters pops the values for the blocking requests in the order they came while the list is not empty.
+func (a *ListCacheActor) serveWaiters(key string) {
+	waiters := a.waiters[key]
+	for len(waiters) > 0 {
+		w := waiters[0]
+		ok, v := a.Cache.TryPop(key, w.Count, w.Left)
+		if !ok || len(v) == 0 {
+			break
+		}
+		w.Timer.Stop()
+		waiters = waiters[1:]
+		w.Sender.Tell(&PopListCacheValuesReply{Key: key, Values: v, Version: a.entryVersion(key), Success: true})
+		log.Printf("[ListCacheActor] Popped %d values from list %s for blocking request", len(v), key)
+	}
+	a.setWaiters(key, waiters)
+}
+
+// releaseWaiters replies to all the blocking requests as timed out, so the callers are not blocked when the actor stops.
+func (a *ListCacheActor) releaseWaiters() {
+	for key, waiters := range a.waiters {
+		for _, w := range waiters {
+			w.Timer.Stop()
+			w.Sender.Tell(&PopListCacheValuesReply{Key: key, Values: make([]string, 0), TimedOut: true})
+		}
+	}
+	a.waiters = nil
+}
+
+// entryVersion returns the version of the entry, zero means that the key was not found.
+func (a *ListCacheActor) entryVersion(key string) int64 {
+	_, v, _ := a.Cache.TryGetMetadata(key)
+	return v.Version
+}
+
 func (a *ListCacheActor) restoreSnapshot() {
 	for _, entry := range a.DB.GetAll() {
 		mappedItem := cache.ListCacheEntry{
@@ -190,6 +398,8 @@ func (a *ListCacheActor) restoreSnapshot() {
 				Added:       entry.Added,
 				Updated:     entry.Updated,
 				ExpireAfter: entry.ExpireAfter,
+				Sliding:     entry.Sliding,
+				Version:     entry.Version,
 				Persisted:   true}}
 		a.CachePersister.TryAddFromSnapshot(entry.Key, mappedItem)
 	}
@@ -206,6 +416,8 @@ func (a *ListCacheActor) persistSnapshot() {
 				Added:       v.Added,
 				Updated:     v.Updated,
 				ExpireAfter: v.ExpireAfter,
+				Sliding:     v.Sliding,
+				Version:     v.Version,
 				Values:      v.Values}
 			if v.Persisted {
 				updatedItems = append(updatedItems, mappedItem)
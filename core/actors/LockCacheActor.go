@@ -0,0 +1,177 @@
+package act
+
+import (
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/VitalKrasilnikau/memcache/core/cache"
+	"github.com/VitalKrasilnikau/memcache/core/messages"
+	"github.com/VitalKrasilnikau/memcache/core/repository"
+	"log"
+	"time"
+)
+
+// GetLockCacheKeyMessage is used to get the fencing token and the remaining lease of the held lock.
+type GetLockCacheKeyMessage = messages.GetLockCacheKeyMessage
+
+// GetLockCacheKeyReply is a reply message for GetLockCacheKeyMessage.
+type GetLockCacheKeyReply = messages.GetLockCacheKeyReply
+
+// AcquireLockCacheKeyMessage is used to acquire the lock for the owner with the lease.
+type AcquireLockCacheKeyMessage = messages.AcquireLockCacheKeyMessage
+
+// AcquireLockCacheKeyReply is a reply message for AcquireLockCacheKeyMessage.
+type AcquireLockCacheKeyReply = messages.AcquireLockCacheKeyReply
+
+// RenewLockCacheKeyMessage is used to extend the lease of the lock held by the owner.
+type RenewLockCacheKeyMessage = messages.RenewLockCacheKeyMessage
+
+// RenewLockCacheKeyReply is a reply message for RenewLockCacheKeyMessage.
+type RenewLockCacheKeyReply = messages.RenewLockCacheKeyReply
+
+// ReleaseLockCacheKeyMessage is used to release the lock held by the owner.
+type ReleaseLockCacheKeyMessage = messages.ReleaseLockCacheKeyMessage
+
+// ReleaseLockCacheKeyReply is a reply message for ReleaseLockCacheKeyMessage.
+type ReleaseLockCacheKeyReply = messages.ReleaseLockCacheKeyReply
+
+// LockCacheActor manages partitioned lock cache and its persistence.
+type LockCacheActor struct {
+	ClusterName    string
+	NodeName       string
+	Cache          cache.ILockCache
+	CachePersister cache.ILockCachePersistence
+	DB             repo.ILockCacheRepository
+	Sweep          cache.SweepOptions
+	sweepTimer     *time.Timer
+}
+
+// Receive is LockCacheActor messages handler.
+func (a *LockCacheActor) Receive(context actor.Context) {
+	switch msg := context.Message().(type) {
+	case *actor.Started:
+		a.sweepTimer = scheduleSweep(context.Self(), a.Sweep)
+		break
+	case *SweepExpiredMessage:
+		if n := a.Cache.RemoveExpired(a.Sweep.Budget); n > 0 {
+			log.Printf("[LockCacheActor] Reaped %d expired keys", n)
+		}
+		a.sweepTimer = scheduleSweep(context.Self(), a.Sweep)
+		break
+	case *GetLockCacheKeyMessage:
+		ok, v, lease := a.Cache.TryGet(msg.Key)
+		reply := &GetLockCacheKeyReply{Key: msg.Key, Version: a.entryVersion(msg.Key), Success: ok}
+		if ok {
+			reply.Fence = v.Fence
+			reply.Acquired = v.Acquired
+			reply.Lease = lease
+		}
+		context.Respond(reply)
+		break
+	case *AcquireLockCacheKeyMessage:
+		ok, v, lease, err := a.Cache.TryAcquire(msg.Key, msg.Owner, msg.Lease)
+		if err != nil {
+			context.Respond(&AcquireLockCacheKeyReply{Key: msg.Key, Error: err.Error()})
+			break
+		}
+		reply := &AcquireLockCacheKeyReply{Key: msg.Key, Acquired: ok, Fence: v.Fence, Lease: lease, Version: a.entryVersion(msg.Key), Success: true}
+		if ok {
+			reply.Owner = v.Owner
+			log.Printf("[LockCacheActor] Acquired %s with fence %d", msg.Key, v.Fence)
+		}
+		context.Respond(reply)
+		break
+	case *RenewLockCacheKeyMessage:
+		found, ok, v, err := a.Cache.TryRenew(msg.Key, msg.Owner, msg.Lease)
+		if err != nil {
+			context.Respond(&RenewLockCacheKeyReply{Key: msg.Key, Error: err.Error()})
+			break
+		}
+		reply := &RenewLockCacheKeyReply{Key: msg.Key, Renewed: ok, Success: found}
+		if ok {
+			reply.Fence = v.Fence
+			reply.Lease = msg.Lease
+			reply.Version = a.entryVersion(msg.Key)
+		}
+		context.Respond(reply)
+		break
+	case *ReleaseLockCacheKeyMessage:
+		found, ok := a.Cache.TryRelease(msg.Key, msg.Owner)
+		context.Respond(&ReleaseLockCacheKeyReply{Key: msg.Key, Released: ok, Success: found})
+		if ok {
+			log.Printf("[LockCacheActor] Released %s", msg.Key)
+		}
+		break
+	case *GetCacheKeysMessage:
+		context.Respond(&GetCacheKeysReply{Keys: a.Cache.GetKeys()})
+		break
+	case *GetCacheStatsMessage:
+		stats := a.Cache.GetStats()
+		context.Respond(&GetCacheStatsReply{Entries: stats.Entries, Bytes: stats.Bytes, Evicted: stats.Evicted, Reaped: stats.Reaped})
+		break
+	case *GetCacheMetadataMessage:
+		ok, v, size := a.Cache.TryGetMetadata(msg.Key)
+		context.Respond(newCacheMetadataReply(msg.Key, v, size, ok))
+		break
+	case *actor.Stopping:
+		stopSweep(a.sweepTimer)
+		a.persistSnapshot()
+		break
+	}
+}
+
+// entryVersion returns the version of the entry, zero means that the key was not found.
+func (a *LockCacheActor) entryVersion(key string) int64 {
+	_, v, _ := a.Cache.TryGetMetadata(key)
+	return v.Version
+}
+
+func (a *LockCacheActor) restoreSnapshot() {
+	for _, entry := range a.DB.GetAll() {
+		a.CachePersister.TryAddFenceFromSnapshot(entry.Key, entry.Fence)
+		if entry.Owner == "" {
+			continue // released or expired lock which keeps only its fence
+		}
+		mappedItem := cache.LockCacheEntry{
+			Value: &cache.Lock{
+				Owner:    entry.Owner,
+				Fence:    entry.Fence,
+				Acquired: entry.Acquired},
+			CacheEntryData: cache.CacheEntryData{
+				Added:       entry.Added,
+				Updated:     entry.Updated,
+				ExpireAfter: entry.ExpireAfter,
+				Sliding:     entry.Sliding,
+				Version:     entry.Version,
+				Persisted:   true}}
+		a.CachePersister.TryAddFromSnapshot(entry.Key, mappedItem)
+	}
+}
+
+// persistSnapshot saves the held locks and the fences of the released ones, so the fencing tokens never go back after restart.
+func (a *LockCacheActor) persistSnapshot() {
+	var newItems []repo.LockCacheDBEntry
+	var updatedItems []repo.LockCacheDBEntry
+	for k, f := range a.CachePersister.GetFences() {
+		mappedItem := repo.LockCacheDBEntry{Key: k, Fence: f.Fence}
+		if ok, v := a.CachePersister.TryGetSnapshot(k); ok {
+			mappedItem.Owner = v.Value.Owner
+			mappedItem.Acquired = v.Value.Acquired
+			mappedItem.Added = v.Added
+			mappedItem.Updated = v.Updated
+			mappedItem.ExpireAfter = v.ExpireAfter
+			mappedItem.Sliding = v.Sliding
+			mappedItem.Version = v.Version
+		}
+		if f.Persisted {
+			updatedItems = append(updatedItems, mappedItem)
+		} else {
+			newItems = append(newItems, mappedItem)
+		}
+	}
+	if newItems == nil {
+		newItems = make([]repo.LockCacheDBEntry, 0)
+	}
+	if updatedItems == nil {
+		updatedItems = make([]repo.LockCacheDBEntry, 0)
+	}
+	a.DB.SaveAll(newItems, updatedItems)
+}
@@ -0,0 +1,28 @@
+package act
+
+import (
+	"fmt"
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/AsynkronIT/protoactor-go/router"
+	"github.com/VitalKrasilnikau/memcache/core/cache"
+)
+
+// NewLockCacheActorCluster is a constructor function for the cluster of LockCacheActor.
+func NewLockCacheActorCluster(clusterName string, nodeNumber int, usePersistence bool, isRemote bool, options cache.Options) (*actor.PID, *BroadcastStopGroup, *BroadcastStringKeysGroup) {
+	var nodes = make([]*actor.PID, nodeNumber)
+	for i := 0; i < nodeNumber; i++ {
+		if isRemote {
+			nodes[i] = actor.NewPID(fmt.Sprintf("127.0.0.1:%d", i + 53000), fmt.Sprintf("locks%d", i))
+		} else {
+			nodes[i] = factory.CreateLockCacheActor(clusterName, fmt.Sprintf("locks%d", i), usePersistence, options)
+		}
+	}
+	return actor.Spawn(router.NewConsistentHashGroup(nodes...)),
+		NewBroadcastStopGroup(nodes),
+		NewBroadcastStringKeysGroup(nodes)
+}
+
+// NewLockCacheActor creates actor instance for remote connection.
+func NewLockCacheActor(clusterName string, nodeNumber int, usePersistence bool, options cache.Options) *actor.PID {
+		return factory.CreateLockCacheActor(clusterName, fmt.Sprintf("locks%d", nodeNumber), usePersistence, options)
+}
@@ -0,0 +1,355 @@
+package act
+
+import (
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/VitalKrasilnikau/memcache/core/cache"
+	"github.com/VitalKrasilnikau/memcache/core/messages"
+	"github.com/VitalKrasilnikau/memcache/core/repository"
+	"log"
+	"time"
+)
+
+// NewQueueItem is an item to be pushed with its priority and delivery delay.
+type NewQueueItem = messages.NewQueueItem
+
+// QueueItem is an item of the priority queue with its receipt, delivery attempts and times in nanoseconds.
+type QueueItem = messages.QueueItem
+
+// GetQueueCacheKeyMessage is used to get the number of ready, delayed, in-flight and dead items of the queue.
+type GetQueueCacheKeyMessage = messages.GetQueueCacheKeyMessage
+
+// GetQueueCacheKeyReply is a reply message for GetQueueCacheKeyMessage.
+type GetQueueCacheKeyReply = messages.GetQueueCacheKeyReply
+
+// DeleteQueueCacheKeyMessage is used to request the cache item deletion.
+type DeleteQueueCacheKeyMessage = messages.DeleteQueueCacheKeyMessage
+
+// DeleteQueueCacheKeyReply is a reply message for DeleteQueueCacheKeyMessage.
+type DeleteQueueCacheKeyReply = messages.DeleteQueueCacheKeyReply
+
+// PostQueueCacheKeyMessage is used to add new cache entry.
+type PostQueueCacheKeyMessage = messages.PostQueueCacheKeyMessage
+
+// PostQueueCacheKeyReply is a reply message for PostQueueCacheKeyMessage.
+type PostQueueCacheKeyReply = messages.PostQueueCacheKeyReply
+
+// PushQueueCacheItemsMessage is used to add items to the queue.
+type PushQueueCacheItemsMessage = messages.PushQueueCacheItemsMessage
+
+// PushQueueCacheItemsReply is a reply message for PushQueueCacheItemsMessage.
+type PushQueueCacheItemsReply = messages.PushQueueCacheItemsReply
+
+// PopQueueCacheItemsMessage is used to deliver the items with the highest priority which can be delivered now.
+type PopQueueCacheItemsMessage = messages.PopQueueCacheItemsMessage
+
+// PopQueueCacheItemsReply is a reply message for PopQueueCacheItemsMessage.
+type PopQueueCacheItemsReply = messages.PopQueueCacheItemsReply
+
+// PeekQueueCacheItemsMessage is used to get the items which would be delivered next without delivering them.
+type PeekQueueCacheItemsMessage = messages.PeekQueueCacheItemsMessage
+
+// PeekQueueCacheItemsReply is a reply message for PeekQueueCacheItemsMessage.
+type PeekQueueCacheItemsReply = messages.PeekQueueCacheItemsReply
+
+// AckQueueCacheItemsMessage is used to remove the delivered items by their receipts.
+type AckQueueCacheItemsMessage = messages.AckQueueCacheItemsMessage
+
+// AckQueueCacheItemsReply is a reply message for AckQueueCacheItemsMessage.
+type AckQueueCacheItemsReply = messages.AckQueueCacheItemsReply
+
+// NackQueueCacheItemMessage is used to return the delivered item to the queue or to the dead letters.
+type NackQueueCacheItemMessage = messages.NackQueueCacheItemMessage
+
+// NackQueueCacheItemReply is a reply message for NackQueueCacheItemMessage.
+type NackQueueCacheItemReply = messages.NackQueueCacheItemReply
+
+// GetQueueCacheDeadMessage is used to get the dead letters of the queue.
+type GetQueueCacheDeadMessage = messages.GetQueueCacheDeadMessage
+
+// GetQueueCacheDeadReply is a reply message for GetQueueCacheDeadMessage.
+type GetQueueCacheDeadReply = messages.GetQueueCacheDeadReply
+
+// RedriveQueueCacheDeadMessage is used to move the dead letters back to the queue.
+type RedriveQueueCacheDeadMessage = messages.RedriveQueueCacheDeadMessage
+
+// RedriveQueueCacheDeadReply is a reply message for RedriveQueueCacheDeadMessage.
+type RedriveQueueCacheDeadReply = messages.RedriveQueueCacheDeadReply
+
+// QueueCacheActor manages partitioned priority queue cache and its persistence.
+type QueueCacheActor struct {
+	ClusterName    string
+	NodeName       string
+	Cache          cache.IQueueCache
+	CachePersister cache.IQueueCachePersistence
+	DB             repo.IQueueCacheRepository
+	Sweep          cache.SweepOptions
+	sweepTimer     *time.Timer
+}
+
+// Receive is QueueCacheActor messages handler.
+func (a *QueueCacheActor) Receive(context actor.Context) {
+	switch msg := context.Message().(type) {
+	case *actor.Started:
+		a.sweepTimer = scheduleSweep(context.Self(), a.Sweep)
+		break
+	case *SweepExpiredMessage:
+		if n := a.Cache.RemoveExpired(a.Sweep.Budget); n > 0 {
+			log.Printf("[QueueCacheActor] Reaped %d expired keys", n)
+		}
+		a.sweepTimer = scheduleSweep(context.Self(), a.Sweep)
+		break
+	case *GetQueueCacheKeyMessage:
+		ok, v := a.Cache.TryGet(msg.Key)
+		reply := &GetQueueCacheKeyReply{Key: msg.Key, Version: a.entryVersion(msg.Key), Success: ok}
+		if ok {
+			ready, delayed := v.Count(time.Now())
+			reply.Ready = int64(ready)
+			reply.Delayed = int64(delayed)
+			reply.InFlight = int64(len(v.InFlight))
+			reply.Dead = int64(len(v.Dead))
+			reply.Visibility = v.Visibility
+			reply.MaxAttempts = v.MaxAttempts
+		}
+		context.Respond(reply)
+		break
+	case *DeleteQueueCacheKeyMessage:
+		if v := a.entryVersion(msg.Key); isVersionConflict(v, msg.Version) {
+			context.Respond(&DeleteQueueCacheKeyReply{Key: msg.Key, Conflict: true})
+			break
+		}
+		ok := a.Cache.TryDelete(msg.Key)
+		context.Respond(&DeleteQueueCacheKeyReply{Key: msg.Key, Success: ok})
+		log.Printf("[QueueCacheActor] Deleted %s", msg.Key)
+		break
+	case *GetCacheKeysMessage:
+		context.Respond(&GetCacheKeysReply{Keys: a.Cache.GetKeys()})
+		break
+	case *GetCacheStatsMessage:
+		stats := a.Cache.GetStats()
+		context.Respond(&GetCacheStatsReply{Entries: stats.Entries, Bytes: stats.Bytes, Evicted: stats.Evicted, Reaped: stats.Reaped})
+		break
+	case *PostQueueCacheKeyMessage:
+		ok := a.Cache.TryAdd(msg.Key, msg.Visibility, msg.MaxAttempts, msg.TTL)
+		var ids []uint64
+		if ok {
+			if msg.Sliding {
+				a.Cache.TrySetTTL(msg.Key, msg.TTL, true)
+			}
+			_, ids = a.Cache.TryPush(msg.Key, toQueueItems(msg.Items))
+		}
+		context.Respond(&PostQueueCacheKeyReply{Key: msg.Key, IDs: ids, Version: a.entryVersion(msg.Key), Success: ok})
+		log.Printf("[QueueCacheActor] Created %s [%v]", msg.Key, msg.TTL)
+		break
+	case *PushQueueCacheItemsMessage:
+		if v := a.entryVersion(msg.Key); isVersionConflict(v, msg.Version) {
+			context.Respond(&PushQueueCacheItemsReply{Key: msg.Key, Version: v, Conflict: true})
+			break
+		}
+		created := false
+		if msg.Create {
+			created = a.Cache.TryAdd(msg.Key, 0, 0, 0)
+		}
+		ok, ids := a.Cache.TryPush(msg.Key, toQueueItems(msg.Items))
+		context.Respond(&PushQueueCacheItemsReply{Key: msg.Key, IDs: ids, Created: created, Version: a.entryVersion(msg.Key), Success: ok})
+		if ok {
+			log.Printf("[QueueCacheActor] Pushed %d items to %s", len(ids), msg.Key)
+		}
+		break
+	case *PopQueueCacheItemsMessage:
+		ok, items := a.Cache.TryPop(msg.Key, deliveryCount(msg.Count), msg.Visibility)
+		context.Respond(&PopQueueCacheItemsReply{Key: msg.Key, Items: fromQueueItems(items), Version: a.entryVersion(msg.Key), Success: ok})
+		if len(items) > 0 {
+			log.Printf("[QueueCacheActor] Delivered %d items of %s", len(items), msg.Key)
+		}
+		break
+	case *PeekQueueCacheItemsMessage:
+		ok, items := a.Cache.TryPeek(msg.Key, deliveryCount(msg.Count))
+		context.Respond(&PeekQueueCacheItemsReply{Key: msg.Key, Items: fromQueueItems(items), Version: a.entryVersion(msg.Key), Success: ok})
+		break
+	case *AckQueueCacheItemsMessage:
+		ok, n, err := a.Cache.TryAck(msg.Key, msg.Receipts)
+		reply := &AckQueueCacheItemsReply{Key: msg.Key, Acked: int32(n), Version: a.entryVersion(msg.Key), Success: ok && err == nil}
+		if err != nil {
+			reply.Error = err.Error()
+		}
+		context.Respond(reply)
+		break
+	case *NackQueueCacheItemMessage:
+		ok, found, dead, err := a.Cache.TryNack(msg.Key, msg.Receipt, msg.Delay)
+		reply := &NackQueueCacheItemReply{Key: msg.Key, Found: found, Dead: dead, Version: a.entryVersion(msg.Key), Success: ok && err == nil}
+		if err != nil {
+			reply.Error = err.Error()
+		}
+		context.Respond(reply)
+		if dead {
+			log.Printf("[QueueCacheActor] Moved %s of %s to dead letters", msg.Receipt, msg.Key)
+		}
+		break
+	case *GetQueueCacheDeadMessage:
+		ok, items := a.Cache.TryGetDead(msg.Key, int(msg.Count))
+		context.Respond(&GetQueueCacheDeadReply{Key: msg.Key, Items: fromQueueItems(items), Version: a.entryVersion(msg.Key), Success: ok})
+		break
+	case *RedriveQueueCacheDeadMessage:
+		ok, n := a.Cache.TryRedrive(msg.Key, int(msg.Count))
+		context.Respond(&RedriveQueueCacheDeadReply{Key: msg.Key, Redriven: int32(n), Version: a.entryVersion(msg.Key), Success: ok})
+		if n > 0 {
+			log.Printf("[QueueCacheActor] Redrove %d dead letters of %s", n, msg.Key)
+		}
+		break
+	case *GetCacheTTLMessage:
+		ok, v, _ := a.Cache.TryGetMetadata(msg.Key)
+		context.Respond(&GetCacheTTLReply{Key: msg.Key, ExpireAfter: v.ExpireAfter, Sliding: v.SlidingTTL(), Success: ok})
+		break
+	case *GetCacheMetadataMessage:
+		ok, v, size := a.Cache.TryGetMetadata(msg.Key)
+		context.Respond(newCacheMetadataReply(msg.Key, v, size, ok))
+		break
+	case *SetCacheTTLMessage:
+		ok := a.Cache.TrySetTTL(msg.Key, msg.TTL, msg.Sliding)
+		context.Respond(&SetCacheTTLReply{Key: msg.Key, Success: ok})
+		if ok {
+			log.Printf("[QueueCacheActor] Set ttl of %s to %v", msg.Key, msg.TTL)
+		}
+		break
+	case *PersistCacheKeyMessage:
+		ok := a.Cache.TrySetTTL(msg.Key, 0, false)
+		context.Respond(&PersistCacheKeyReply{Key: msg.Key, Success: ok})
+		if ok {
+			log.Printf("[QueueCacheActor] Removed ttl of %s", msg.Key)
+		}
+		break
+	case *actor.Stopping:
+		stopSweep(a.sweepTimer)
+		a.persistSnapshot()
+		break
+	}
+}
+
+// entryVersion returns the version of the entry, zero means that the key was not found.
+func (a *QueueCacheActor) entryVersion(key string) int64 {
+	_, v, _ := a.Cache.TryGetMetadata(key)
+	return v.Version
+}
+
+// deliveryCount applies the default count of pop and peek, one item is returned if the count is not positive.
+// Zero count of dead letters means all of them, so it is passed as is.
+func deliveryCount(count int32) int {
+	if count <= 0 {
+		return 1
+	}
+	return int(count)
+}
+
+// toQueueItems converts the delays of new items to the times they can be delivered after.
+func toQueueItems(items []NewQueueItem) []cache.QueueItem {
+	now := time.Now()
+	res := make([]cache.QueueItem, len(items))
+	for i, item := range items {
+		res[i] = cache.QueueItem{Value: item.Value, Priority: item.Priority}
+		if item.Delay > 0 {
+			res[i].NotBefore = now.Add(item.Delay).UnixNano()
+		}
+	}
+	return res
+}
+
+func fromQueueItems(items []cache.QueueItem) []QueueItem {
+	res := make([]QueueItem, len(items))
+	for i, item := range items {
+		res[i] = QueueItem{
+			ID:        item.ID,
+			Receipt:   item.Receipt(),
+			Value:     item.Value,
+			Priority:  item.Priority,
+			Attempts:  item.Attempts,
+			NotBefore: item.NotBefore,
+			Enqueued:  item.Enqueued,
+			Deadline:  item.Deadline}
+	}
+	return res
+}
+
+func toQueueItemDBEntries(items []cache.QueueItem) []repo.QueueItemDBEntry {
+	res := make([]repo.QueueItemDBEntry, len(items))
+	for i, item := range items {
+		res[i] = repo.QueueItemDBEntry{
+			ID:        int64(item.ID),
+			Value:     item.Value,
+			Priority:  item.Priority,
+			NotBefore: item.NotBefore,
+			Enqueued:  item.Enqueued,
+			Attempts:  item.Attempts,
+			Deadline:  item.Deadline}
+	}
+	return res
+}
+
+func fromQueueItemDBEntries(entries []repo.QueueItemDBEntry) []cache.QueueItem {
+	res := make([]cache.QueueItem, len(entries))
+	for i, e := range entries {
+		res[i] = cache.QueueItem{
+			ID:        uint64(e.ID),
+			Value:     e.Value,
+			Priority:  e.Priority,
+			NotBefore: e.NotBefore,
+			Enqueued:  e.Enqueued,
+			Attempts:  e.Attempts,
+			Deadline:  e.Deadline}
+	}
+	return res
+}
+
+func (a *QueueCacheActor) restoreSnapshot() {
+	for _, entry := range a.DB.GetAll() {
+		q := cache.NewQueue(time.Duration(entry.Visibility), entry.MaxAttempts)
+		q.Items = fromQueueItemDBEntries(entry.Items)
+		q.InFlight = fromQueueItemDBEntries(entry.InFlight)
+		q.Dead = fromQueueItemDBEntries(entry.Dead)
+		q.NextID = uint64(entry.NextID)
+		mappedItem := cache.QueueCacheEntry{
+			Value: q,
+			CacheEntryData: cache.CacheEntryData{
+				Added:       entry.Added,
+				Updated:     entry.Updated,
+				ExpireAfter: entry.ExpireAfter,
+				Sliding:     entry.Sliding,
+				Version:     entry.Version,
+				Persisted:   true}}
+		a.CachePersister.TryAddFromSnapshot(entry.Key, mappedItem)
+	}
+}
+
+func (a *QueueCacheActor) persistSnapshot() {
+	var newItems []repo.QueueCacheDBEntry
+	var updatedItems []repo.QueueCacheDBEntry
+	for _, k := range a.Cache.GetKeys() {
+		ok, v := a.CachePersister.TryGetSnapshot(k)
+		if ok {
+			mappedItem := repo.QueueCacheDBEntry{
+				Key:         k,
+				Items:       toQueueItemDBEntries(v.Value.Items),
+				InFlight:    toQueueItemDBEntries(v.Value.InFlight),
+				Dead:        toQueueItemDBEntries(v.Value.Dead),
+				NextID:      int64(v.Value.NextID),
+				Visibility:  int64(v.Value.Visibility),
+				MaxAttempts: v.Value.MaxAttempts,
+				Added:       v.Added,
+				Updated:     v.Updated,
+				ExpireAfter: v.ExpireAfter,
+				Sliding:     v.Sliding,
+				Version:     v.Version}
+			if v.Persisted {
+				updatedItems = append(updatedItems, mappedItem)
+			} else {
+				newItems = append(newItems, mappedItem)
+			}
+		}
+	}
+	if newItems == nil {
+		newItems = make([]repo.QueueCacheDBEntry, 0)
+	}
+	if updatedItems == nil {
+		updatedItems = make([]repo.QueueCacheDBEntry, 0)
+	}
+	a.DB.SaveAll(newItems, updatedItems)
+}
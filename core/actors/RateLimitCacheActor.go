@@ -0,0 +1,179 @@
+package act
+
+import (
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/VitalKrasilnikau/memcache/core/cache"
+	"github.com/VitalKrasilnikau/memcache/core/messages"
+	"github.com/VitalKrasilnikau/memcache/core/repository"
+	"log"
+	"time"
+)
+
+// GetRateLimitCacheKeyMessage is used to get the limits and the remaining capacity of the rate limiter.
+type GetRateLimitCacheKeyMessage = messages.GetRateLimitCacheKeyMessage
+
+// GetRateLimitCacheKeyReply is a reply message for GetRateLimitCacheKeyMessage.
+type GetRateLimitCacheKeyReply = messages.GetRateLimitCacheKeyReply
+
+// DeleteRateLimitCacheKeyMessage is used to request the cache item deletion, so the rate limiter is full again.
+type DeleteRateLimitCacheKeyMessage = messages.DeleteRateLimitCacheKeyMessage
+
+// DeleteRateLimitCacheKeyReply is a reply message for DeleteRateLimitCacheKeyMessage.
+type DeleteRateLimitCacheKeyReply = messages.DeleteRateLimitCacheKeyReply
+
+// TakeRateLimitCacheKeyMessage is used to take the cost from the rate limiter if it is allowed.
+type TakeRateLimitCacheKeyMessage = messages.TakeRateLimitCacheKeyMessage
+
+// TakeRateLimitCacheKeyReply is a reply message for TakeRateLimitCacheKeyMessage.
+type TakeRateLimitCacheKeyReply = messages.TakeRateLimitCacheKeyReply
+
+// RateLimitCacheActor manages partitioned rate limiter cache and its persistence.
+type RateLimitCacheActor struct {
+	ClusterName    string
+	NodeName       string
+	Cache          cache.IRateLimitCache
+	CachePersister cache.IRateLimitCachePersistence
+	DB             repo.IRateLimitCacheRepository
+	Sweep          cache.SweepOptions
+	sweepTimer     *time.Timer
+}
+
+// Receive is RateLimitCacheActor messages handler.
+func (a *RateLimitCacheActor) Receive(context actor.Context) {
+	switch msg := context.Message().(type) {
+	case *actor.Started:
+		a.sweepTimer = scheduleSweep(context.Self(), a.Sweep)
+		break
+	case *SweepExpiredMessage:
+		if n := a.Cache.RemoveExpired(a.Sweep.Budget); n > 0 {
+			log.Printf("[RateLimitCacheActor] Reaped %d expired keys", n)
+		}
+		a.sweepTimer = scheduleSweep(context.Self(), a.Sweep)
+		break
+	case *GetRateLimitCacheKeyMessage:
+		ok, v, state := a.Cache.TryGet(msg.Key)
+		reply := &GetRateLimitCacheKeyReply{Key: msg.Key, Version: a.entryVersion(msg.Key), Success: ok}
+		if ok {
+			reply.Algorithm = v.Algorithm
+			reply.Capacity = v.Capacity
+			reply.RefillRate = v.RefillRate
+			reply.Window = v.Window
+			reply.Remaining = state.Remaining
+			reply.ResetAfter = state.ResetAfter
+		}
+		context.Respond(reply)
+		break
+	case *DeleteRateLimitCacheKeyMessage:
+		if v := a.entryVersion(msg.Key); isVersionConflict(v, msg.Version) {
+			context.Respond(&DeleteRateLimitCacheKeyReply{Key: msg.Key, Conflict: true})
+			break
+		}
+		ok := a.Cache.TryDelete(msg.Key)
+		context.Respond(&DeleteRateLimitCacheKeyReply{Key: msg.Key, Success: ok})
+		log.Printf("[RateLimitCacheActor] Deleted %s", msg.Key)
+		break
+	case *GetCacheKeysMessage:
+		context.Respond(&GetCacheKeysReply{Keys: a.Cache.GetKeys()})
+		break
+	case *GetCacheStatsMessage:
+		stats := a.Cache.GetStats()
+		context.Respond(&GetCacheStatsReply{Entries: stats.Entries, Bytes: stats.Bytes, Evicted: stats.Evicted, Reaped: stats.Reaped})
+		break
+	case *TakeRateLimitCacheKeyMessage:
+		res, v, err := a.Cache.TryTake(msg.Key, msg.Algorithm, msg.Capacity, msg.RefillRate, msg.Window, msg.Cost)
+		if err != nil {
+			context.Respond(&TakeRateLimitCacheKeyReply{Key: msg.Key, Error: err.Error()})
+			break
+		}
+		context.Respond(&TakeRateLimitCacheKeyReply{
+			Key:        msg.Key,
+			Allowed:    res.Allowed,
+			Limit:      v.Capacity,
+			Remaining:  res.Remaining,
+			RetryAfter: res.RetryAfter,
+			ResetAfter: res.ResetAfter,
+			Version:    a.entryVersion(msg.Key),
+			Success:    true})
+		break
+	case *GetCacheMetadataMessage:
+		ok, v, size := a.Cache.TryGetMetadata(msg.Key)
+		context.Respond(newCacheMetadataReply(msg.Key, v, size, ok))
+		break
+	case *actor.Stopping:
+		stopSweep(a.sweepTimer)
+		a.persistSnapshot()
+		break
+	}
+}
+
+// entryVersion returns the version of the entry, zero means that the key was not found.
+func (a *RateLimitCacheActor) entryVersion(key string) int64 {
+	_, v, _ := a.Cache.TryGetMetadata(key)
+	return v.Version
+}
+
+func (a *RateLimitCacheActor) restoreSnapshot() {
+	for _, entry := range a.DB.GetAll() {
+		hits := make([]cache.RateLimitHit, len(entry.Log))
+		for i, h := range entry.Log {
+			hits[i] = cache.RateLimitHit{Time: h.Time, Cost: h.Cost}
+		}
+		mappedItem := cache.RateLimitCacheEntry{
+			Value: &cache.RateLimiter{
+				Algorithm:  entry.Algorithm,
+				Capacity:   entry.Capacity,
+				RefillRate: entry.RefillRate,
+				Window:     time.Duration(entry.Window),
+				Tokens:     entry.Tokens,
+				Refilled:   entry.Refilled,
+				Log:        hits},
+			CacheEntryData: cache.CacheEntryData{
+				Added:       entry.Added,
+				Updated:     entry.Updated,
+				ExpireAfter: entry.ExpireAfter,
+				Sliding:     entry.Sliding,
+				Version:     entry.Version,
+				Persisted:   true}}
+		a.CachePersister.TryAddFromSnapshot(entry.Key, mappedItem)
+	}
+}
+
+func (a *RateLimitCacheActor) persistSnapshot() {
+	var newItems []repo.RateLimitCacheDBEntry
+	var updatedItems []repo.RateLimitCacheDBEntry
+	for _, k := range a.Cache.GetKeys() {
+		ok, v := a.CachePersister.TryGetSnapshot(k)
+		if ok {
+			hits := make([]repo.RateLimitHitDBEntry, len(v.Value.Log))
+			for i, h := range v.Value.Log {
+				hits[i] = repo.RateLimitHitDBEntry{Time: h.Time, Cost: h.Cost}
+			}
+			mappedItem := repo.RateLimitCacheDBEntry{
+				Key:         k,
+				Algorithm:   v.Value.Algorithm,
+				Capacity:    v.Value.Capacity,
+				RefillRate:  v.Value.RefillRate,
+				Window:      int64(v.Value.Window),
+				Tokens:      v.Value.Tokens,
+				Refilled:    v.Value.Refilled,
+				Log:         hits,
+				Added:       v.Added,
+				Updated:     v.Updated,
+				ExpireAfter: v.ExpireAfter,
+				Sliding:     v.Sliding,
+				Version:     v.Version}
+			if v.Persisted {
+				updatedItems = append(updatedItems, mappedItem)
+			} else {
+				newItems = append(newItems, mappedItem)
+			}
+		}
+	}
+	if newItems == nil {
+		newItems = make([]repo.RateLimitCacheDBEntry, 0)
+	}
+	if updatedItems == nil {
+		updatedItems = make([]repo.RateLimitCacheDBEntry, 0)
+	}
+	a.DB.SaveAll(newItems, updatedItems)
+}
@@ -0,0 +1,28 @@
+package act
+
+import (
+	"fmt"
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/AsynkronIT/protoactor-go/router"
+	"github.com/VitalKrasilnikau/memcache/core/cache"
+)
+
+// NewRateLimitCacheActorCluster is a constructor function for the cluster of RateLimitCacheActor.
+func NewRateLimitCacheActorCluster(clusterName string, nodeNumber int, usePersistence bool, isRemote bool, options cache.Options) (*actor.PID, *BroadcastStopGroup, *BroadcastStringKeysGroup) {
+	var nodes = make([]*actor.PID, nodeNumber)
+	for i := 0; i < nodeNumber; i++ {
+		if isRemote {
+			nodes[i] = actor.NewPID(fmt.Sprintf("127.0.0.1:%d", i + 54000), fmt.Sprintf("ratelimits%d", i))
+		} else {
+			nodes[i] = factory.CreateRateLimitCacheActor(clusterName, fmt.Sprintf("ratelimits%d", i), usePersistence, options)
+		}
+	}
+	return actor.Spawn(router.NewConsistentHashGroup(nodes...)),
+		NewBroadcastStopGroup(nodes),
+		NewBroadcastStringKeysGroup(nodes)
+}
+
+// NewRateLimitCacheActor creates actor instance for remote connection.
+func NewRateLimitCacheActor(clusterName string, nodeNumber int, usePersistence bool, options cache.Options) *actor.PID {
+		return factory.CreateRateLimitCacheActor(clusterName, fmt.Sprintf("ratelimits%d", nodeNumber), usePersistence, options)
+}
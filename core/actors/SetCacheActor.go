@@ -0,0 +1,223 @@
+package act
+
+import (
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/VitalKrasilnikau/memcache/core/cache"
+	"github.com/VitalKrasilnikau/memcache/core/messages"
+	"github.com/VitalKrasilnikau/memcache/core/repository"
+	"log"
+	"time"
+)
+
+// GetSetCacheKeyMessage is used to get the set cache entry.
+type GetSetCacheKeyMessage = messages.GetSetCacheKeyMessage
+
+// GetSetCacheKeyReply is a reply message for GetSetCacheKeyMessage.
+type GetSetCacheKeyReply = messages.GetSetCacheKeyReply
+
+// DeleteSetCacheKeyMessage is used to request the cache item deletion.
+type DeleteSetCacheKeyMessage = messages.DeleteSetCacheKeyMessage
+
+// DeleteSetCacheKeyReply is a reply message for DeleteSetCacheKeyMessage.
+type DeleteSetCacheKeyReply = messages.DeleteSetCacheKeyReply
+
+// PostSetCacheKeyMessage is used to add new cache entry.
+type PostSetCacheKeyMessage = messages.PostSetCacheKeyMessage
+
+// PostSetCacheKeyReply is a reply message for PostSetCacheKeyMessage.
+type PostSetCacheKeyReply = messages.PostSetCacheKeyReply
+
+// PostSetCacheValuesMessage is used to add new members to the set.
+type PostSetCacheValuesMessage = messages.PostSetCacheValuesMessage
+
+// PostSetCacheValuesReply is a reply message for PostSetCacheValuesMessage.
+type PostSetCacheValuesReply = messages.PostSetCacheValuesReply
+
+// DeleteSetCacheValuesMessage is used to remove members from the set.
+type DeleteSetCacheValuesMessage = messages.DeleteSetCacheValuesMessage
+
+// DeleteSetCacheValuesReply is a reply message for DeleteSetCacheValuesMessage.
+type DeleteSetCacheValuesReply = messages.DeleteSetCacheValuesReply
+
+// ContainsSetCacheValueMessage is used to check if the set contains the member.
+type ContainsSetCacheValueMessage = messages.ContainsSetCacheValueMessage
+
+// ContainsSetCacheValueReply is a reply message for ContainsSetCacheValueMessage.
+type ContainsSetCacheValueReply = messages.ContainsSetCacheValueReply
+
+// GetSetCacheCardinalityMessage is used to get the number of members in the set.
+type GetSetCacheCardinalityMessage = messages.GetSetCacheCardinalityMessage
+
+// GetSetCacheCardinalityReply is a reply message for GetSetCacheCardinalityMessage.
+type GetSetCacheCardinalityReply = messages.GetSetCacheCardinalityReply
+
+// GetSetCacheRandomValuesMessage is used to get random members of the set.
+type GetSetCacheRandomValuesMessage = messages.GetSetCacheRandomValuesMessage
+
+// GetSetCacheRandomValuesReply is a reply message for GetSetCacheRandomValuesMessage.
+type GetSetCacheRandomValuesReply = messages.GetSetCacheRandomValuesReply
+
+// SetCacheActor manages partitioned set cache and its persistence.
+type SetCacheActor struct {
+	ClusterName    string
+	NodeName       string
+	Cache          cache.ISetCache
+	CachePersister cache.ISetCachePersistence
+	DB             repo.ISetCacheRepository
+	Sweep          cache.SweepOptions
+	sweepTimer     *time.Timer
+}
+
+// Receive is SetCacheActor messages handler.
+func (a *SetCacheActor) Receive(context actor.Context) {
+	switch msg := context.Message().(type) {
+	case *actor.Started:
+		a.sweepTimer = scheduleSweep(context.Self(), a.Sweep)
+		break
+	case *SweepExpiredMessage:
+		if n := a.Cache.RemoveExpired(a.Sweep.Budget); n > 0 {
+			log.Printf("[SetCacheActor] Reaped %d expired keys", n)
+		}
+		a.sweepTimer = scheduleSweep(context.Self(), a.Sweep)
+		break
+	case *GetSetCacheKeyMessage:
+		ok, v := a.Cache.TryGet(msg.Key)
+		context.Respond(&GetSetCacheKeyReply{Key: msg.Key, Values: v, Version: a.entryVersion(msg.Key), Success: ok})
+		break
+	case *DeleteSetCacheKeyMessage:
+		if v := a.entryVersion(msg.Key); isVersionConflict(v, msg.Version) {
+			context.Respond(&DeleteSetCacheKeyReply{Key: msg.Key, Conflict: true})
+			break
+		}
+		ok, v := a.Cache.TryDelete(msg.Key)
+		context.Respond(&DeleteSetCacheKeyReply{Key: msg.Key, DeletedValues: v, Success: ok})
+		log.Printf("[SetCacheActor] Deleted %s", msg.Key)
+		break
+	case *GetCacheKeysMessage:
+		context.Respond(&GetCacheKeysReply{Keys: a.Cache.GetKeys()})
+		break
+	case *GetCacheStatsMessage:
+		stats := a.Cache.GetStats()
+		context.Respond(&GetCacheStatsReply{Entries: stats.Entries, Bytes: stats.Bytes, Evicted: stats.Evicted, Reaped: stats.Reaped})
+		break
+	case *PostSetCacheKeyMessage:
+		ok := a.Cache.TryAdd(msg.Key, msg.Values, msg.TTL)
+		if ok && msg.Sliding {
+			a.Cache.TrySetTTL(msg.Key, msg.TTL, true)
+		}
+		context.Respond(&PostSetCacheKeyReply{Key: msg.Key, Version: a.entryVersion(msg.Key), Success: ok})
+		log.Printf("[SetCacheActor] Created %s [%v]", msg.Key, msg.TTL)
+		break
+	case *PostSetCacheValuesMessage:
+		if v := a.entryVersion(msg.Key); isVersionConflict(v, msg.Version) {
+			context.Respond(&PostSetCacheValuesReply{Key: msg.Key, Version: v, Conflict: true})
+			break
+		}
+		ok, n := a.Cache.TryAddMembers(msg.Key, msg.Values)
+		context.Respond(&PostSetCacheValuesReply{Key: msg.Key, AddedCount: int32(n), Version: a.entryVersion(msg.Key), Success: ok})
+		if n > 0 {
+			log.Printf("[SetCacheActor] Added %d members to set %s", n, msg.Key)
+		}
+		break
+	case *DeleteSetCacheValuesMessage:
+		if v := a.entryVersion(msg.Key); isVersionConflict(v, msg.Version) {
+			context.Respond(&DeleteSetCacheValuesReply{Key: msg.Key, Version: v, Conflict: true})
+			break
+		}
+		ok, n := a.Cache.TryRemoveMembers(msg.Key, msg.Values)
+		context.Respond(&DeleteSetCacheValuesReply{Key: msg.Key, DeletedCount: int32(n), Version: a.entryVersion(msg.Key), Success: ok})
+		if n > 0 {
+			log.Printf("[SetCacheActor] Removed %d members from set %s", n, msg.Key)
+		}
+		break
+	case *ContainsSetCacheValueMessage:
+		ok, contains := a.Cache.TryContains(msg.Key, msg.Value)
+		context.Respond(&ContainsSetCacheValueReply{Key: msg.Key, Value: msg.Value, Contains: contains, Success: ok})
+		break
+	case *GetSetCacheCardinalityMessage:
+		ok, n := a.Cache.TryGetCardinality(msg.Key)
+		context.Respond(&GetSetCacheCardinalityReply{Key: msg.Key, Cardinality: int32(n), Success: ok})
+		break
+	case *GetSetCacheRandomValuesMessage:
+		ok, v := a.Cache.TryGetRandomMembers(msg.Key, int(msg.Count))
+		context.Respond(&GetSetCacheRandomValuesReply{Key: msg.Key, Values: v, Success: ok})
+		break
+	case *GetCacheTTLMessage:
+		ok, v, _ := a.Cache.TryGetMetadata(msg.Key)
+		context.Respond(&GetCacheTTLReply{Key: msg.Key, ExpireAfter: v.ExpireAfter, Sliding: v.SlidingTTL(), Success: ok})
+		break
+	case *GetCacheMetadataMessage:
+		ok, v, size := a.Cache.TryGetMetadata(msg.Key)
+		context.Respond(newCacheMetadataReply(msg.Key, v, size, ok))
+		break
+	case *SetCacheTTLMessage:
+		ok := a.Cache.TrySetTTL(msg.Key, msg.TTL, msg.Sliding)
+		context.Respond(&SetCacheTTLReply{Key: msg.Key, Success: ok})
+		if ok {
+			log.Printf("[SetCacheActor] Set ttl of %s to %v", msg.Key, msg.TTL)
+		}
+		break
+	case *PersistCacheKeyMessage:
+		ok := a.Cache.TrySetTTL(msg.Key, 0, false)
+		context.Respond(&PersistCacheKeyReply{Key: msg.Key, Success: ok})
+		if ok {
+			log.Printf("[SetCacheActor] Removed ttl of %s", msg.Key)
+		}
+		break
+	case *actor.Stopping:
+		stopSweep(a.sweepTimer)
+		a.persistSnapshot()
+		break
+	}
+}
+
+// entryVersion returns the version of the entry, zero means that the key was not found.
+func (a *SetCacheActor) entryVersion(key string) int64 {
+	_, v, _ := a.Cache.TryGetMetadata(key)
+	return v.Version
+}
+
+func (a *SetCacheActor) restoreSnapshot() {
+	for _, entry := range a.DB.GetAll() {
+		mappedItem := cache.SetCacheEntry{
+			Members: cache.ToSet(entry.Values),
+			CacheEntryData: cache.CacheEntryData{
+				Added:       entry.Added,
+				Updated:     entry.Updated,
+				ExpireAfter: entry.ExpireAfter,
+				Sliding:     entry.Sliding,
+				Version:     entry.Version,
+				Persisted:   true}}
+		a.CachePersister.TryAddFromSnapshot(entry.Key, mappedItem)
+	}
+}
+
+func (a *SetCacheActor) persistSnapshot() {
+	var newItems []repo.SetCacheDBEntry
+	var updatedItems []repo.SetCacheDBEntry
+	for _, k := range a.Cache.GetKeys() {
+		ok, v := a.CachePersister.TryGetSnapshot(k)
+		if ok {
+			mappedItem := repo.SetCacheDBEntry{
+				Key:         k,
+				Added:       v.Added,
+				Updated:     v.Updated,
+				ExpireAfter: v.ExpireAfter,
+				Sliding:     v.Sliding,
+				Version:     v.Version,
+				Values:      cache.FromSet(v.Members)}
+			if v.Persisted {
+				updatedItems = append(updatedItems, mappedItem)
+			} else {
+				newItems = append(newItems, mappedItem)
+			}
+		}
+	}
+	if newItems == nil {
+		newItems = make([]repo.SetCacheDBEntry, 0)
+	}
+	if updatedItems == nil {
+		updatedItems = make([]repo.SetCacheDBEntry, 0)
+	}
+	a.DB.SaveAll(newItems, updatedItems)
+}
@@ -0,0 +1,278 @@
+package act
+
+import (
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/VitalKrasilnikau/memcache/core/cache"
+	"github.com/VitalKrasilnikau/memcache/core/messages"
+	"github.com/VitalKrasilnikau/memcache/core/repository"
+	"log"
+	"time"
+)
+
+// SortedSetMember is a member of the sorted set with its score.
+type SortedSetMember = messages.SortedSetMember
+
+// GetSortedSetCacheKeyMessage is used to get the sorted set cache entry.
+type GetSortedSetCacheKeyMessage = messages.GetSortedSetCacheKeyMessage
+
+// GetSortedSetCacheKeyReply is a reply message for GetSortedSetCacheKeyMessage.
+type GetSortedSetCacheKeyReply = messages.GetSortedSetCacheKeyReply
+
+// DeleteSortedSetCacheKeyMessage is used to request the cache item deletion.
+type DeleteSortedSetCacheKeyMessage = messages.DeleteSortedSetCacheKeyMessage
+
+// DeleteSortedSetCacheKeyReply is a reply message for DeleteSortedSetCacheKeyMessage.
+type DeleteSortedSetCacheKeyReply = messages.DeleteSortedSetCacheKeyReply
+
+// PostSortedSetCacheKeyMessage is used to add new cache entry.
+type PostSortedSetCacheKeyMessage = messages.PostSortedSetCacheKeyMessage
+
+// PostSortedSetCacheKeyReply is a reply message for PostSortedSetCacheKeyMessage.
+type PostSortedSetCacheKeyReply = messages.PostSortedSetCacheKeyReply
+
+// PostSortedSetCacheValuesMessage is used to add new members or update scores of existing ones.
+type PostSortedSetCacheValuesMessage = messages.PostSortedSetCacheValuesMessage
+
+// PostSortedSetCacheValuesReply is a reply message for PostSortedSetCacheValuesMessage.
+type PostSortedSetCacheValuesReply = messages.PostSortedSetCacheValuesReply
+
+// IncrementSortedSetCacheScoreMessage is used to add delta to the score of the member.
+type IncrementSortedSetCacheScoreMessage = messages.IncrementSortedSetCacheScoreMessage
+
+// IncrementSortedSetCacheScoreReply is a reply message for IncrementSortedSetCacheScoreMessage.
+type IncrementSortedSetCacheScoreReply = messages.IncrementSortedSetCacheScoreReply
+
+// DeleteSortedSetCacheValuesMessage is used to remove members from the sorted set.
+type DeleteSortedSetCacheValuesMessage = messages.DeleteSortedSetCacheValuesMessage
+
+// DeleteSortedSetCacheValuesReply is a reply message for DeleteSortedSetCacheValuesMessage.
+type DeleteSortedSetCacheValuesReply = messages.DeleteSortedSetCacheValuesReply
+
+// GetSortedSetCacheRangeMessage is used to get the members by the range of ranks.
+type GetSortedSetCacheRangeMessage = messages.GetSortedSetCacheRangeMessage
+
+// GetSortedSetCacheRangeReply is a reply message for GetSortedSetCacheRangeMessage.
+type GetSortedSetCacheRangeReply = messages.GetSortedSetCacheRangeReply
+
+// GetSortedSetCacheRangeByScoreMessage is used to get the members by the range of scores.
+type GetSortedSetCacheRangeByScoreMessage = messages.GetSortedSetCacheRangeByScoreMessage
+
+// GetSortedSetCacheRangeByScoreReply is a reply message for GetSortedSetCacheRangeByScoreMessage.
+type GetSortedSetCacheRangeByScoreReply = messages.GetSortedSetCacheRangeByScoreReply
+
+// GetSortedSetCacheRankMessage is used to get the rank and the score of the member.
+type GetSortedSetCacheRankMessage = messages.GetSortedSetCacheRankMessage
+
+// GetSortedSetCacheRankReply is a reply message for GetSortedSetCacheRankMessage.
+type GetSortedSetCacheRankReply = messages.GetSortedSetCacheRankReply
+
+// SortedSetCacheActor manages partitioned sorted set cache and its persistence.
+type SortedSetCacheActor struct {
+	ClusterName    string
+	NodeName       string
+	Cache          cache.ISortedSetCache
+	CachePersister cache.ISortedSetCachePersistence
+	DB             repo.ISortedSetCacheRepository
+	Sweep          cache.SweepOptions
+	sweepTimer     *time.Timer
+}
+
+// Receive is SortedSetCacheActor messages handler.
+func (a *SortedSetCacheActor) Receive(context actor.Context) {
+	switch msg := context.Message().(type) {
+	case *actor.Started:
+		a.sweepTimer = scheduleSweep(context.Self(), a.Sweep)
+		break
+	case *SweepExpiredMessage:
+		if n := a.Cache.RemoveExpired(a.Sweep.Budget); n > 0 {
+			log.Printf("[SortedSetCacheActor] Reaped %d expired keys", n)
+		}
+		a.sweepTimer = scheduleSweep(context.Self(), a.Sweep)
+		break
+	case *GetSortedSetCacheKeyMessage:
+		ok, v := a.Cache.TryGet(msg.Key)
+		context.Respond(&GetSortedSetCacheKeyReply{Key: msg.Key, Members: fromScoredMembers(v), Version: a.entryVersion(msg.Key), Success: ok})
+		break
+	case *DeleteSortedSetCacheKeyMessage:
+		if v := a.entryVersion(msg.Key); isVersionConflict(v, msg.Version) {
+			context.Respond(&DeleteSortedSetCacheKeyReply{Key: msg.Key, Conflict: true})
+			break
+		}
+		ok, v := a.Cache.TryDelete(msg.Key)
+		context.Respond(&DeleteSortedSetCacheKeyReply{Key: msg.Key, DeletedMembers: fromScoredMembers(v), Success: ok})
+		log.Printf("[SortedSetCacheActor] Deleted %s", msg.Key)
+		break
+	case *GetCacheKeysMessage:
+		context.Respond(&GetCacheKeysReply{Keys: a.Cache.GetKeys()})
+		break
+	case *GetCacheStatsMessage:
+		stats := a.Cache.GetStats()
+		context.Respond(&GetCacheStatsReply{Entries: stats.Entries, Bytes: stats.Bytes, Evicted: stats.Evicted, Reaped: stats.Reaped})
+		break
+	case *PostSortedSetCacheKeyMessage:
+		ok, err := a.Cache.TryAdd(msg.Key, toScoredMembers(msg.Members), msg.TTL)
+		if err != nil {
+			context.Respond(&PostSortedSetCacheKeyReply{Key: msg.Key, Error: err.Error()})
+			break
+		}
+		if ok && msg.Sliding {
+			a.Cache.TrySetTTL(msg.Key, msg.TTL, true)
+		}
+		context.Respond(&PostSortedSetCacheKeyReply{Key: msg.Key, Version: a.entryVersion(msg.Key), Success: ok})
+		log.Printf("[SortedSetCacheActor] Created %s [%v]", msg.Key, msg.TTL)
+		break
+	case *PostSortedSetCacheValuesMessage:
+		if v := a.entryVersion(msg.Key); isVersionConflict(v, msg.Version) {
+			context.Respond(&PostSortedSetCacheValuesReply{Key: msg.Key, Version: v, Conflict: true})
+			break
+		}
+		ok, n, err := a.Cache.TryAddMembers(msg.Key, toScoredMembers(msg.Members))
+		if err != nil {
+			context.Respond(&PostSortedSetCacheValuesReply{Key: msg.Key, Version: a.entryVersion(msg.Key), Error: err.Error()})
+			break
+		}
+		context.Respond(&PostSortedSetCacheValuesReply{Key: msg.Key, AddedCount: int32(n), Version: a.entryVersion(msg.Key), Success: ok})
+		if ok {
+			log.Printf("[SortedSetCacheActor] Added %d members to sorted set %s", n, msg.Key)
+		}
+		break
+	case *IncrementSortedSetCacheScoreMessage:
+		if v := a.entryVersion(msg.Key); isVersionConflict(v, msg.Version) {
+			context.Respond(&IncrementSortedSetCacheScoreReply{Key: msg.Key, Member: msg.Member, Version: v, Conflict: true})
+			break
+		}
+		ok, score, err := a.Cache.TryIncrementScore(msg.Key, msg.Member, msg.Delta)
+		if err != nil {
+			context.Respond(&IncrementSortedSetCacheScoreReply{Key: msg.Key, Member: msg.Member, Score: score, Version: a.entryVersion(msg.Key), Error: err.Error()})
+			break
+		}
+		context.Respond(&IncrementSortedSetCacheScoreReply{Key: msg.Key, Member: msg.Member, Score: score, Version: a.entryVersion(msg.Key), Success: ok})
+		if ok {
+			log.Printf("[SortedSetCacheActor] Incremented score of %s in sorted set %s by %v", msg.Member, msg.Key, msg.Delta)
+		}
+		break
+	case *DeleteSortedSetCacheValuesMessage:
+		if v := a.entryVersion(msg.Key); isVersionConflict(v, msg.Version) {
+			context.Respond(&DeleteSortedSetCacheValuesReply{Key: msg.Key, Version: v, Conflict: true})
+			break
+		}
+		ok, n := a.Cache.TryRemoveMembers(msg.Key, msg.Members)
+		context.Respond(&DeleteSortedSetCacheValuesReply{Key: msg.Key, DeletedCount: int32(n), Version: a.entryVersion(msg.Key), Success: ok})
+		if n > 0 {
+			log.Printf("[SortedSetCacheActor] Removed %d members from sorted set %s", n, msg.Key)
+		}
+		break
+	case *GetSortedSetCacheRangeMessage:
+		ok, v := a.Cache.TryGetRange(msg.Key, int(msg.Start), int(msg.Stop), msg.Reverse)
+		context.Respond(&GetSortedSetCacheRangeReply{Key: msg.Key, Members: fromScoredMembers(v), Success: ok})
+		break
+	case *GetSortedSetCacheRangeByScoreMessage:
+		r := cache.ScoreRange{Min: msg.Min, Max: msg.Max, MinExclusive: msg.MinExclusive, MaxExclusive: msg.MaxExclusive}
+		ok, v := a.Cache.TryGetRangeByScore(msg.Key, r, int(msg.Offset), int(msg.Count), msg.Reverse)
+		context.Respond(&GetSortedSetCacheRangeByScoreReply{Key: msg.Key, Members: fromScoredMembers(v), Success: ok})
+		break
+	case *GetSortedSetCacheRankMessage:
+		ok, rank, score := a.Cache.TryGetRank(msg.Key, msg.Member, msg.Reverse)
+		context.Respond(&GetSortedSetCacheRankReply{Key: msg.Key, Member: msg.Member, Rank: int32(rank), Score: score, Found: rank >= 0, Success: ok})
+		break
+	case *GetCacheTTLMessage:
+		ok, v, _ := a.Cache.TryGetMetadata(msg.Key)
+		context.Respond(&GetCacheTTLReply{Key: msg.Key, ExpireAfter: v.ExpireAfter, Sliding: v.SlidingTTL(), Success: ok})
+		break
+	case *GetCacheMetadataMessage:
+		ok, v, size := a.Cache.TryGetMetadata(msg.Key)
+		context.Respond(newCacheMetadataReply(msg.Key, v, size, ok))
+		break
+	case *SetCacheTTLMessage:
+		ok := a.Cache.TrySetTTL(msg.Key, msg.TTL, msg.Sliding)
+		context.Respond(&SetCacheTTLReply{Key: msg.Key, Success: ok})
+		if ok {
+			log.Printf("[SortedSetCacheActor] Set ttl of %s to %v", msg.Key, msg.TTL)
+		}
+		break
+	case *PersistCacheKeyMessage:
+		ok := a.Cache.TrySetTTL(msg.Key, 0, false)
+		context.Respond(&PersistCacheKeyReply{Key: msg.Key, Success: ok})
+		if ok {
+			log.Printf("[SortedSetCacheActor] Removed ttl of %s", msg.Key)
+		}
+		break
+	case *actor.Stopping:
+		stopSweep(a.sweepTimer)
+		a.persistSnapshot()
+		break
+	}
+}
+
+// entryVersion returns the version of the entry, zero means that the key was not found.
+func (a *SortedSetCacheActor) entryVersion(key string) int64 {
+	_, v, _ := a.Cache.TryGetMetadata(key)
+	return v.Version
+}
+
+func (a *SortedSetCacheActor) restoreSnapshot() {
+	for _, entry := range a.DB.GetAll() {
+		members := make([]cache.ScoredMember, len(entry.Members))
+		for i, m := range entry.Members {
+			members[i] = cache.ScoredMember{Member: m.Member, Score: m.Score}
+		}
+		mappedItem := cache.NewSortedSetCacheEntry(members, cache.CacheEntryData{
+			Added:       entry.Added,
+			Updated:     entry.Updated,
+			ExpireAfter: entry.ExpireAfter,
+			Sliding:     entry.Sliding,
+			Version:     entry.Version,
+			Persisted:   true})
+		a.CachePersister.TryAddFromSnapshot(entry.Key, mappedItem)
+	}
+}
+
+func (a *SortedSetCacheActor) persistSnapshot() {
+	var newItems []repo.SortedSetCacheDBEntry
+	var updatedItems []repo.SortedSetCacheDBEntry
+	for _, k := range a.Cache.GetKeys() {
+		ok, v := a.CachePersister.TryGetSnapshot(k)
+		if ok {
+			members := v.Index.Members()
+			mappedItem := repo.SortedSetCacheDBEntry{
+				Key:         k,
+				Added:       v.Added,
+				Updated:     v.Updated,
+				ExpireAfter: v.ExpireAfter,
+				Sliding:     v.Sliding,
+				Version:     v.Version,
+				Members:     make([]repo.SortedSetMemberDBEntry, len(members))}
+			for i, m := range members {
+				mappedItem.Members[i] = repo.SortedSetMemberDBEntry{Member: m.Member, Score: m.Score}
+			}
+			if v.Persisted {
+				updatedItems = append(updatedItems, mappedItem)
+			} else {
+				newItems = append(newItems, mappedItem)
+			}
+		}
+	}
+	if newItems == nil {
+		newItems = make([]repo.SortedSetCacheDBEntry, 0)
+	}
+	if updatedItems == nil {
+		updatedItems = make([]repo.SortedSetCacheDBEntry, 0)
+	}
+	a.DB.SaveAll(newItems, updatedItems)
+}
+
+func toScoredMembers(members []SortedSetMember) []cache.ScoredMember {
+	res := make([]cache.ScoredMember, len(members))
+	for i, m := range members {
+		res[i] = cache.ScoredMember{Member: m.Member, Score: m.Score}
+	}
+	return res
+}
+
+func fromScoredMembers(members []cache.ScoredMember) []SortedSetMember {
+	res := make([]SortedSetMember, len(members))
+	for i, m := range members {
+		res[i] = SortedSetMember{Member: m.Member, Score: m.Score}
+	}
+	return res
+}
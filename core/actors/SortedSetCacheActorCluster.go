@@ -0,0 +1,28 @@
+package act
+
+import (
+	"fmt"
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/AsynkronIT/protoactor-go/router"
+	"github.com/VitalKrasilnikau/memcache/core/cache"
+)
+
+// NewSortedSetCacheActorCluster is a constructor function for the cluster of SortedSetCacheActor.
+func NewSortedSetCacheActorCluster(clusterName string, nodeNumber int, usePersistence bool, isRemote bool, options cache.Options) (*actor.PID, *BroadcastStopGroup, *BroadcastStringKeysGroup) {
+	var nodes = make([]*actor.PID, nodeNumber)
+	for i := 0; i < nodeNumber; i++ {
+		if isRemote {
+			nodes[i] = actor.NewPID(fmt.Sprintf("127.0.0.1:%d", i + 62000), fmt.Sprintf("sortedsets%d", i))
+		} else {
+			nodes[i] = factory.CreateSortedSetCacheActor(clusterName, fmt.Sprintf("sortedsets%d", i), usePersistence, options)
+		}
+	}
+	return actor.Spawn(router.NewConsistentHashGroup(nodes...)),
+		NewBroadcastStopGroup(nodes),
+		NewBroadcastStringKeysGroup(nodes)
+}
+
+// NewSortedSetCacheActor creates actor instance for remote connection.
+func NewSortedSetCacheActor(clusterName string, nodeNumber int, usePersistence bool, options cache.Options) *actor.PID {
+		return factory.CreateSortedSetCacheActor(clusterName, fmt.Sprintf("sortedsets%d", nodeNumber), usePersistence, options)
+}
@@ -0,0 +1,421 @@
+package act
+
+import (
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/VitalKrasilnikau/memcache/core/cache"
+	"github.com/VitalKrasilnikau/memcache/core/messages"
+	"github.com/VitalKrasilnikau/memcache/core/repository"
+	"log"
+	"time"
+)
+
+// StreamEntry is an entry of the stream with its ID and fields.
+type StreamEntry = messages.StreamEntry
+
+// StreamGroupInfo is a summary of the consumer group of the stream.
+type StreamGroupInfo = messages.StreamGroupInfo
+
+// StreamPendingEntry is an entry delivered to the consumer which was not acknowledged yet.
+type StreamPendingEntry = messages.StreamPendingEntry
+
+// GetStreamCacheKeyMessage is used to get the length, the first and the last IDs of the stream.
+type GetStreamCacheKeyMessage = messages.GetStreamCacheKeyMessage
+
+// GetStreamCacheKeyReply is a reply message for GetStreamCacheKeyMessage.
+type GetStreamCacheKeyReply = messages.GetStreamCacheKeyReply
+
+// DeleteStreamCacheKeyMessage is used to request the cache item deletion.
+type DeleteStreamCacheKeyMessage = messages.DeleteStreamCacheKeyMessage
+
+// DeleteStreamCacheKeyReply is a reply message for DeleteStreamCacheKeyMessage.
+type DeleteStreamCacheKeyReply = messages.DeleteStreamCacheKeyReply
+
+// PostStreamCacheKeyMessage is used to add new empty stream.
+type PostStreamCacheKeyMessage = messages.PostStreamCacheKeyMessage
+
+// PostStreamCacheKeyReply is a reply message for PostStreamCacheKeyMessage.
+type PostStreamCacheKeyReply = messages.PostStreamCacheKeyReply
+
+// AppendStreamCacheEntryMessage is used to append the entry to the stream like XADD.
+type AppendStreamCacheEntryMessage = messages.AppendStreamCacheEntryMessage
+
+// AppendStreamCacheEntryReply is a reply message for AppendStreamCacheEntryMessage.
+type AppendStreamCacheEntryReply = messages.AppendStreamCacheEntryReply
+
+// GetStreamCacheRangeMessage is used to read the entries by the range of IDs like XRANGE.
+type GetStreamCacheRangeMessage = messages.GetStreamCacheRangeMessage
+
+// GetStreamCacheRangeReply is a reply message for GetStreamCacheRangeMessage.
+type GetStreamCacheRangeReply = messages.GetStreamCacheRangeReply
+
+// TrimStreamCacheKeyMessage is used to remove the oldest entries of the stream like XTRIM.
+type TrimStreamCacheKeyMessage = messages.TrimStreamCacheKeyMessage
+
+// TrimStreamCacheKeyReply is a reply message for TrimStreamCacheKeyMessage.
+type TrimStreamCacheKeyReply = messages.TrimStreamCacheKeyReply
+
+// GetStreamCacheGroupsMessage is used to get the consumer groups of the stream.
+type GetStreamCacheGroupsMessage = messages.GetStreamCacheGroupsMessage
+
+// GetStreamCacheGroupsReply is a reply message for GetStreamCacheGroupsMessage.
+type GetStreamCacheGroupsReply = messages.GetStreamCacheGroupsReply
+
+// CreateStreamCacheGroupMessage is used to add the consumer group like XGROUP CREATE.
+type CreateStreamCacheGroupMessage = messages.CreateStreamCacheGroupMessage
+
+// CreateStreamCacheGroupReply is a reply message for CreateStreamCacheGroupMessage.
+type CreateStreamCacheGroupReply = messages.CreateStreamCacheGroupReply
+
+// DeleteStreamCacheGroupMessage is used to remove the consumer group like XGROUP DESTROY.
+type DeleteStreamCacheGroupMessage = messages.DeleteStreamCacheGroupMessage
+
+// DeleteStreamCacheGroupReply is a reply message for DeleteStreamCacheGroupMessage.
+type DeleteStreamCacheGroupReply = messages.DeleteStreamCacheGroupReply
+
+// ReadStreamCacheGroupMessage is used to deliver entries to the consumer of the group like XREADGROUP.
+type ReadStreamCacheGroupMessage = messages.ReadStreamCacheGroupMessage
+
+// ReadStreamCacheGroupReply is a reply message for ReadStreamCacheGroupMessage.
+type ReadStreamCacheGroupReply = messages.ReadStreamCacheGroupReply
+
+// AckStreamCacheGroupMessage is used to acknowledge the pending entries of the group like XACK.
+type AckStreamCacheGroupMessage = messages.AckStreamCacheGroupMessage
+
+// AckStreamCacheGroupReply is a reply message for AckStreamCacheGroupMessage.
+type AckStreamCacheGroupReply = messages.AckStreamCacheGroupReply
+
+// GetStreamCachePendingMessage is used to get the pending entries of the group like XPENDING.
+type GetStreamCachePendingMessage = messages.GetStreamCachePendingMessage
+
+// GetStreamCachePendingReply is a reply message for GetStreamCachePendingMessage.
+type GetStreamCachePendingReply = messages.GetStreamCachePendingReply
+
+// StreamCacheActor manages partitioned stream cache and its persistence.
+type StreamCacheActor struct {
+	ClusterName    string
+	NodeName       string
+	Cache          cache.IStreamCache
+	CachePersister cache.IStreamCachePersistence
+	DB             repo.IStreamCacheRepository
+	Sweep          cache.SweepOptions
+	sweepTimer     *time.Timer
+}
+
+// Receive is StreamCacheActor messages handler.
+func (a *StreamCacheActor) Receive(context actor.Context) {
+	switch msg := context.Message().(type) {
+	case *actor.Started:
+		a.sweepTimer = scheduleSweep(context.Self(), a.Sweep)
+		break
+	case *SweepExpiredMessage:
+		if n := a.Cache.RemoveExpired(a.Sweep.Budget); n > 0 {
+			log.Printf("[StreamCacheActor] Reaped %d expired keys", n)
+		}
+		a.sweepTimer = scheduleSweep(context.Self(), a.Sweep)
+		break
+	case *GetStreamCacheKeyMessage:
+		ok, v := a.Cache.TryGet(msg.Key)
+		reply := &GetStreamCacheKeyReply{Key: msg.Key, Version: a.entryVersion(msg.Key), Success: ok}
+		if ok {
+			reply.Length = int64(len(v.Entries))
+			reply.MaxLen = v.MaxLen
+			reply.LastID = v.LastID.String()
+			reply.Groups = int32(len(v.Groups))
+			if len(v.Entries) > 0 {
+				reply.FirstID = v.Entries[0].ID.String()
+			}
+		}
+		context.Respond(reply)
+		break
+	case *DeleteStreamCacheKeyMessage:
+		if v := a.entryVersion(msg.Key); isVersionConflict(v, msg.Version) {
+			context.Respond(&DeleteStreamCacheKeyReply{Key: msg.Key, Conflict: true})
+			break
+		}
+		ok := a.Cache.TryDelete(msg.Key)
+		context.Respond(&DeleteStreamCacheKeyReply{Key: msg.Key, Success: ok})
+		log.Printf("[StreamCacheActor] Deleted %s", msg.Key)
+		break
+	case *GetCacheKeysMessage:
+		context.Respond(&GetCacheKeysReply{Keys: a.Cache.GetKeys()})
+		break
+	case *GetCacheStatsMessage:
+		stats := a.Cache.GetStats()
+		context.Respond(&GetCacheStatsReply{Entries: stats.Entries, Bytes: stats.Bytes, Evicted: stats.Evicted, Reaped: stats.Reaped})
+		break
+	case *PostStreamCacheKeyMessage:
+		ok := a.Cache.TryAdd(msg.Key, msg.MaxLen, msg.TTL)
+		if ok && msg.Sliding {
+			a.Cache.TrySetTTL(msg.Key, msg.TTL, true)
+		}
+		context.Respond(&PostStreamCacheKeyReply{Key: msg.Key, Version: a.entryVersion(msg.Key), Success: ok})
+		log.Printf("[StreamCacheActor] Created %s [%v]", msg.Key, msg.TTL)
+		break
+	case *AppendStreamCacheEntryMessage:
+		if v := a.entryVersion(msg.Key); isVersionConflict(v, msg.Version) {
+			context.Respond(&AppendStreamCacheEntryReply{Key: msg.Key, Version: v, Conflict: true})
+			break
+		}
+		created := false
+		if msg.Create {
+			created = a.Cache.TryAdd(msg.Key, 0, 0)
+		}
+		ok, id, n, err := a.Cache.TryAppend(msg.Key, msg.ID, msg.Fields, msg.MaxLen)
+		reply := &AppendStreamCacheEntryReply{Key: msg.Key, Length: int64(n), Created: created, Version: a.entryVersion(msg.Key), Success: ok && err == nil}
+		if err != nil {
+			reply.Error = err.Error()
+		} else if ok {
+			reply.ID = id.String()
+			log.Printf("[StreamCacheActor] Appended %s to %s", reply.ID, msg.Key)
+		}
+		context.Respond(reply)
+		break
+	case *GetStreamCacheRangeMessage:
+		ok, entries, err := a.Cache.TryGetRange(msg.Key, msg.Start, msg.End, int(msg.Count), msg.Reverse)
+		reply := &GetStreamCacheRangeReply{Key: msg.Key, Entries: fromStreamEntries(entries), Version: a.entryVersion(msg.Key), Success: ok && err == nil}
+		if err != nil {
+			reply.Error = err.Error()
+		}
+		context.Respond(reply)
+		break
+	case *TrimStreamCacheKeyMessage:
+		if v := a.entryVersion(msg.Key); isVersionConflict(v, msg.Version) {
+			context.Respond(&TrimStreamCacheKeyReply{Key: msg.Key, Version: v, Conflict: true})
+			break
+		}
+		ok, removed, n := a.Cache.TryTrim(msg.Key, msg.MaxLen)
+		context.Respond(&TrimStreamCacheKeyReply{Key: msg.Key, Removed: int64(removed), Length: int64(n), Version: a.entryVersion(msg.Key), Success: ok})
+		if removed > 0 {
+			log.Printf("[StreamCacheActor] Trimmed %d entries of %s", removed, msg.Key)
+		}
+		break
+	case *GetStreamCacheGroupsMessage:
+		ok, v := a.Cache.TryGet(msg.Key)
+		reply := &GetStreamCacheGroupsReply{Key: msg.Key, Version: a.entryVersion(msg.Key), Success: ok}
+		if ok {
+			for _, g := range v.GetGroups() {
+				reply.Groups = append(reply.Groups, StreamGroupInfo{Name: g.Name, LastDeliveredID: g.LastDelivered.String(), Pending: int64(g.Pending), Consumers: int32(g.Consumers)})
+			}
+		}
+		context.Respond(reply)
+		break
+	case *CreateStreamCacheGroupMessage:
+		created := false
+		if msg.Create {
+			created = a.Cache.TryAdd(msg.Key, 0, 0)
+		}
+		ok, err := a.Cache.TryCreateGroup(msg.Key, msg.Group, msg.Start)
+		reply := &CreateStreamCacheGroupReply{Key: msg.Key, Group: msg.Group, Created: created, Version: a.entryVersion(msg.Key), Success: ok && err == nil}
+		if err != nil {
+			reply.Error = err.Error()
+		} else if ok {
+			log.Printf("[StreamCacheActor] Created group %s of %s", msg.Group, msg.Key)
+		}
+		context.Respond(reply)
+		break
+	case *DeleteStreamCacheGroupMessage:
+		ok, deleted := a.Cache.TryDeleteGroup(msg.Key, msg.Group)
+		context.Respond(&DeleteStreamCacheGroupReply{Key: msg.Key, Group: msg.Group, Deleted: deleted, Version: a.entryVersion(msg.Key), Success: ok})
+		if deleted {
+			log.Printf("[StreamCacheActor] Deleted group %s of %s", msg.Group, msg.Key)
+		}
+		break
+	case *ReadStreamCacheGroupMessage:
+		ok, entries, err := a.Cache.TryReadGroup(msg.Key, msg.Group, msg.Consumer, msg.ID, int(msg.Count))
+		reply := &ReadStreamCacheGroupReply{Key: msg.Key, Group: msg.Group, Entries: fromStreamEntries(entries), Version: a.entryVersion(msg.Key), Success: ok && err == nil}
+		if err != nil {
+			reply.Error = err.Error()
+		}
+		context.Respond(reply)
+		break
+	case *AckStreamCacheGroupMessage:
+		ids := make([]cache.StreamID, len(msg.IDs))
+		var err error
+		for i, s := range msg.IDs {
+			if ids[i], err = cache.ParseStreamID(s, 0); err != nil {
+				break
+			}
+		}
+		if err != nil {
+			context.Respond(&AckStreamCacheGroupReply{Key: msg.Key, Group: msg.Group, Error: err.Error()})
+			break
+		}
+		ok, n, err := a.Cache.TryAck(msg.Key, msg.Group, ids)
+		reply := &AckStreamCacheGroupReply{Key: msg.Key, Group: msg.Group, Acked: int32(n), Version: a.entryVersion(msg.Key), Success: ok && err == nil}
+		if err != nil {
+			reply.Error = err.Error()
+		}
+		context.Respond(reply)
+		break
+	case *GetStreamCachePendingMessage:
+		ok, entries, err := a.Cache.TryGetPending(msg.Key, msg.Group, msg.Consumer, int(msg.Count))
+		reply := &GetStreamCachePendingReply{Key: msg.Key, Group: msg.Group, Success: ok && err == nil}
+		if err != nil {
+			reply.Error = err.Error()
+		}
+		now := time.Now().UnixNano()
+		for _, p := range entries {
+			reply.Entries = append(reply.Entries, StreamPendingEntry{ID: p.ID.String(), Consumer: p.Consumer, Idle: time.Duration(now - p.Delivered), Deliveries: p.Deliveries})
+		}
+		context.Respond(reply)
+		break
+	case *GetCacheTTLMessage:
+		ok, v, _ := a.Cache.TryGetMetadata(msg.Key)
+		context.Respond(&GetCacheTTLReply{Key: msg.Key, ExpireAfter: v.ExpireAfter, Sliding: v.SlidingTTL(), Success: ok})
+		break
+	case *GetCacheMetadataMessage:
+		ok, v, size := a.Cache.TryGetMetadata(msg.Key)
+		context.Respond(newCacheMetadataReply(msg.Key, v, size, ok))
+		break
+	case *SetCacheTTLMessage:
+		ok := a.Cache.TrySetTTL(msg.Key, msg.TTL, msg.Sliding)
+		context.Respond(&SetCacheTTLReply{Key: msg.Key, Success: ok})
+		if ok {
+			log.Printf("[StreamCacheActor] Set ttl of %s to %v", msg.Key, msg.TTL)
+		}
+		break
+	case *PersistCacheKeyMessage:
+		ok := a.Cache.TrySetTTL(msg.Key, 0, false)
+		context.Respond(&PersistCacheKeyReply{Key: msg.Key, Success: ok})
+		if ok {
+			log.Printf("[StreamCacheActor] Removed ttl of %s", msg.Key)
+		}
+		break
+	case *actor.Stopping:
+		stopSweep(a.sweepTimer)
+		a.persistSnapshot()
+		break
+	}
+}
+
+// entryVersion returns the version of the entry, zero means that the key was not found.
+func (a *StreamCacheActor) entryVersion(key string) int64 {
+	_, v, _ := a.Cache.TryGetMetadata(key)
+	return v.Version
+}
+
+func (a *StreamCacheActor) restoreSnapshot() {
+	for _, entry := range a.DB.GetAll() {
+		s, err := toStream(entry)
+		if err != nil {
+			log.Printf("[StreamCacheActor] Skipped malformed stream %s", entry.Key)
+			continue
+		}
+		mappedItem := cache.StreamCacheEntry{
+			Value: s,
+			CacheEntryData: cache.CacheEntryData{
+				Added:       entry.Added,
+				Updated:     entry.Updated,
+				ExpireAfter: entry.ExpireAfter,
+				Sliding:     entry.Sliding,
+				Version:     entry.Version,
+				Persisted:   true}}
+		a.CachePersister.TryAddFromSnapshot(entry.Key, mappedItem)
+	}
+}
+
+func (a *StreamCacheActor) persistSnapshot() {
+	var newItems []repo.StreamCacheDBEntry
+	var updatedItems []repo.StreamCacheDBEntry
+	for _, k := range a.Cache.GetKeys() {
+		ok, v := a.CachePersister.TryGetSnapshot(k)
+		if ok {
+			mappedItem := fromStream(v.Value)
+			mappedItem.Key = k
+			mappedItem.Added = v.Added
+			mappedItem.Updated = v.Updated
+			mappedItem.ExpireAfter = v.ExpireAfter
+			mappedItem.Sliding = v.Sliding
+			mappedItem.Version = v.Version
+			if v.Persisted {
+				updatedItems = append(updatedItems, mappedItem)
+			} else {
+				newItems = append(newItems, mappedItem)
+			}
+		}
+	}
+	if newItems == nil {
+		newItems = make([]repo.StreamCacheDBEntry, 0)
+	}
+	if updatedItems == nil {
+		updatedItems = make([]repo.StreamCacheDBEntry, 0)
+	}
+	a.DB.SaveAll(newItems, updatedItems)
+}
+
+func fromStreamEntries(entries []cache.StreamEntry) []StreamEntry {
+	res := make([]StreamEntry, len(entries))
+	for i, e := range entries {
+		res[i] = StreamEntry{ID: e.ID.String(), Fields: e.Fields}
+	}
+	return res
+}
+
+// fromStream maps the stream to MongoDB entry, the caller sets the key and the timestamps.
+func fromStream(s *cache.Stream) repo.StreamCacheDBEntry {
+	entry := repo.StreamCacheDBEntry{
+		Entries: make([]repo.StreamEntryDBEntry, len(s.Entries)),
+		LastID:  s.LastID.String(),
+		MaxLen:  s.MaxLen,
+		Groups:  make([]repo.StreamGroupDBEntry, 0, len(s.Groups))}
+	for i, e := range s.Entries {
+		fields := make([]repo.StreamFieldDBEntry, len(e.Fields))
+		for j, f := range e.Fields {
+			fields[j] = repo.StreamFieldDBEntry{Key: f.Key, Value: f.Value}
+		}
+		entry.Entries[i] = repo.StreamEntryDBEntry{ID: e.ID.String(), Fields: fields}
+	}
+	for _, g := range s.Groups {
+		group := repo.StreamGroupDBEntry{
+			Name:          g.Name,
+			LastDelivered: g.LastDelivered.String(),
+			Pending:       make([]repo.StreamPendingDBEntry, len(g.Pending)),
+			Consumers:     make([]repo.StreamConsumerDBEntry, 0, len(g.Consumers))}
+		for i, p := range g.Pending {
+			group.Pending[i] = repo.StreamPendingDBEntry{ID: p.ID.String(), Consumer: p.Consumer, Delivered: p.Delivered, Deliveries: p.Deliveries}
+		}
+		for c, seen := range g.Consumers {
+			group.Consumers = append(group.Consumers, repo.StreamConsumerDBEntry{Name: c, Seen: seen})
+		}
+		entry.Groups = append(entry.Groups, group)
+	}
+	return entry
+}
+
+// toStream restores the stream from MongoDB entry.
+func toStream(entry repo.StreamCacheDBEntry) (*cache.Stream, error) {
+	s := cache.NewStream(entry.MaxLen)
+	var err error
+	if s.LastID, err = cache.ParseStreamID(entry.LastID, 0); err != nil {
+		return nil, err
+	}
+	s.Entries = make([]cache.StreamEntry, len(entry.Entries))
+	for i, e := range entry.Entries {
+		if s.Entries[i].ID, err = cache.ParseStreamID(e.ID, 0); err != nil {
+			return nil, err
+		}
+		s.Entries[i].Fields = make([]cache.KeyValue, len(e.Fields))
+		for j, f := range e.Fields {
+			s.Entries[i].Fields[j] = cache.KeyValue{Key: f.Key, Value: f.Value}
+		}
+	}
+	for _, g := range entry.Groups {
+		group := &cache.StreamGroup{Name: g.Name, Pending: make([]cache.StreamPendingEntry, len(g.Pending)), Consumers: make(map[string]int64)}
+		if group.LastDelivered, err = cache.ParseStreamID(g.LastDelivered, 0); err != nil {
+			return nil, err
+		}
+		for i, p := range g.Pending {
+			if group.Pending[i].ID, err = cache.ParseStreamID(p.ID, 0); err != nil {
+				return nil, err
+			}
+			group.Pending[i].Consumer = p.Consumer
+			group.Pending[i].Delivered = p.Delivered
+			group.Pending[i].Deliveries = p.Deliveries
+		}
+		for _, c := range g.Consumers {
+			group.Consumers[c.Name] = c.Seen
+		}
+		s.Groups[g.Name] = group
+	}
+	return s, nil
+}
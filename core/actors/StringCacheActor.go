@@ -3,91 +3,108 @@ package act
 import (
 	"github.com/AsynkronIT/protoactor-go/actor"
 	"github.com/VitalKrasilnikau/memcache/core/cache"
+	"github.com/VitalKrasilnikau/memcache/core/messages"
 	"github.com/VitalKrasilnikau/memcache/core/repository"
 	"log"
 	"time"
+	"unicode/utf8"
 )
 
 // GetStringCacheKeyMessage is used to get the string cache entry.
-type GetStringCacheKeyMessage struct {
-	Key     string
-}
-
-// Hash is used for partitioning in actor cluster.
-func (m *GetStringCacheKeyMessage) Hash() string {
-	return m.Key
-}
+type GetStringCacheKeyMessage = messages.GetStringCacheKeyMessage
 
 // GetStringCacheKeyReply is a reply message for GetStringCacheKeyMessage.
-type GetStringCacheKeyReply struct {
-	Key     string
-	Value   string
-	Success bool
-}
-
-// Hash is used for partitioning in actor cluster.
-func (m *GetStringCacheKeyReply) Hash() string {
-	return m.Key
-}
+type GetStringCacheKeyReply = messages.GetStringCacheKeyReply
 
 // DeleteStringCacheKeyMessage is used to request the cache item deletion.
-type DeleteStringCacheKeyMessage struct {
-	Key     string
-}
-
-// Hash is used for partitioning in actor cluster.
-func (m *DeleteStringCacheKeyMessage) Hash() string {
-	return m.Key
-}
+type DeleteStringCacheKeyMessage = messages.DeleteStringCacheKeyMessage
 
 // DeleteStringCacheKeyReply is a reply message for DeleteStringCacheKeyMessage.
-type DeleteStringCacheKeyReply struct {
-	Key          string
-	DeletedValue string
-	Success      bool
-}
+type DeleteStringCacheKeyReply = messages.DeleteStringCacheKeyReply
 
 // PostStringCacheKeyMessage is used to add new cache entry.
-type PostStringCacheKeyMessage struct {
-	Key     string
-	Value   string
-	TTL     time.Duration
-}
-
-// Hash is used for partitioning in actor cluster.
-func (m *PostStringCacheKeyMessage) Hash() string {
-	return m.Key
-}
+type PostStringCacheKeyMessage = messages.PostStringCacheKeyMessage
 
 // PostStringCacheKeyReply is a reply message for PostStringCacheKeyMessage.
-type PostStringCacheKeyReply struct {
-	Key     string
-	Success bool
-}
-
-// Hash is used for partitioning in actor cluster.
-func (m *PostStringCacheKeyReply) Hash() string {
-	return m.Key
-}
+type PostStringCacheKeyReply = messages.PostStringCacheKeyReply
 
 // PutStringCacheKeyMessage is used to request cache entry update by original value.
-type PutStringCacheKeyMessage struct {
-	Key           string
-	NewValue      string
-	OriginalValue string
-}
-
-// Hash is used for partitioning in actor cluster.
-func (m *PutStringCacheKeyMessage) Hash() string {
-	return m.Key
-}
+type PutStringCacheKeyMessage = messages.PutStringCacheKeyMessage
 
 // PutStringCacheKeyReply is a reply message for PutStringCacheKeyMessage.
-type PutStringCacheKeyReply struct {
-	Key           string
-	OriginalValue string
-	Success       bool
-}
+type PutStringCacheKeyReply = messages.PutStringCacheKeyReply
+
+// SetStringCacheKeyMessage is used to add new cache entry or to replace the existing one.
+type SetStringCacheKeyMessage = messages.SetStringCacheKeyMessage
+
+// SetStringCacheKeyReply is a reply message for SetStringCacheKeyMessage.
+type SetStringCacheKeyReply = messages.SetStringCacheKeyReply
+
+// ReplaceStringCacheKeyMessage is used to replace the existing cache entry.
+type ReplaceStringCacheKeyMessage = messages.ReplaceStringCacheKeyMessage
+
+// ReplaceStringCacheKeyReply is a reply message for ReplaceStringCacheKeyMessage.
+type ReplaceStringCacheKeyReply = messages.ReplaceStringCacheKeyReply
+
+// CompareAndSwapStringCacheKeyMessage is used to replace the existing cache entry by its version.
+type CompareAndSwapStringCacheKeyMessage = messages.CompareAndSwapStringCacheKeyMessage
+
+// CompareAndSwapStringCacheKeyReply is a reply message for CompareAndSwapStringCacheKeyMessage.
+type CompareAndSwapStringCacheKeyReply = messages.CompareAndSwapStringCacheKeyReply
+
+// TouchStringCacheKeyMessage is used to set new TTL for the existing cache entry.
+type TouchStringCacheKeyMessage = messages.TouchStringCacheKeyMessage
+
+// TouchStringCacheKeyReply is a reply message for TouchStringCacheKeyMessage.
+type TouchStringCacheKeyReply = messages.TouchStringCacheKeyReply
+
+// IncrementStringCacheKeyMessage is used to change the numeric value atomically.
+type IncrementStringCacheKeyMessage = messages.IncrementStringCacheKeyMessage
+
+// IncrementStringCacheKeyReply is a reply message for IncrementStringCacheKeyMessage.
+type IncrementStringCacheKeyReply = messages.IncrementStringCacheKeyReply
+
+// SetBitStringCacheKeyMessage is used to set the bit of the value like SETBIT.
+type SetBitStringCacheKeyMessage = messages.SetBitStringCacheKeyMessage
+
+// SetBitStringCacheKeyReply is a reply message for SetBitStringCacheKeyMessage.
+type SetBitStringCacheKeyReply = messages.SetBitStringCacheKeyReply
+
+// GetBitStringCacheKeyMessage is used to get the bit of the value like GETBIT.
+type GetBitStringCacheKeyMessage = messages.GetBitStringCacheKeyMessage
+
+// GetBitStringCacheKeyReply is a reply message for GetBitStringCacheKeyMessage.
+type GetBitStringCacheKeyReply = messages.GetBitStringCacheKeyReply
+
+// CountBitsStringCacheKeyMessage is used to count the bits set in the value like BITCOUNT.
+type CountBitsStringCacheKeyMessage = messages.CountBitsStringCacheKeyMessage
+
+// CountBitsStringCacheKeyReply is a reply message for CountBitsStringCacheKeyMessage.
+type CountBitsStringCacheKeyReply = messages.CountBitsStringCacheKeyReply
+
+// GetBitPosStringCacheKeyMessage is used to find the first bit set to 0 or 1 in the value like BITPOS.
+type GetBitPosStringCacheKeyMessage = messages.GetBitPosStringCacheKeyMessage
+
+// GetBitPosStringCacheKeyReply is a reply message for GetBitPosStringCacheKeyMessage.
+type GetBitPosStringCacheKeyReply = messages.GetBitPosStringCacheKeyReply
+
+// BitOpStringCacheKeyMessage is used to store the result of bitwise operation on the source values like BITOP.
+type BitOpStringCacheKeyMessage = messages.BitOpStringCacheKeyMessage
+
+// BitOpStringCacheKeyReply is a reply message for BitOpStringCacheKeyMessage.
+type BitOpStringCacheKeyReply = messages.BitOpStringCacheKeyReply
+
+// BitFieldOperation is a get, set or incrby subcommand of BitFieldStringCacheKeyMessage.
+type BitFieldOperation = messages.BitFieldOperation
+
+// BitFieldResult is a result of BitFieldOperation, Nil is set if the operation failed due to fail overflow.
+type BitFieldResult = messages.BitFieldResult
+
+// BitFieldStringCacheKeyMessage is used to get, set and increment integers of arbitrary width in the value like BITFIELD.
+type BitFieldStringCacheKeyMessage = messages.BitFieldStringCacheKeyMessage
+
+// BitFieldStringCacheKeyReply is a reply message for BitFieldStringCacheKeyMessage.
+type BitFieldStringCacheKeyReply = messages.BitFieldStringCacheKeyReply
 
 // StringCacheActor manages partitioned string cache and its persistence.
 type StringCacheActor struct {
@@ -96,47 +113,272 @@ type StringCacheActor struct {
 	Cache          cache.IStringCache
 	CachePersister cache.IStringCachePersistence
 	DB             repo.IStringCacheRepository
+	Sweep          cache.SweepOptions
+	sweepTimer     *time.Timer
 }
 
 // Receive is StringCacheActor messages handler.
 func (a *StringCacheActor) Receive(context actor.Context) {
 	switch msg := context.Message().(type) {
+	case *actor.Started:
+		a.sweepTimer = scheduleSweep(context.Self(), a.Sweep)
+		break
+	case *SweepExpiredMessage:
+		if n := a.Cache.RemoveExpired(a.Sweep.Budget); n > 0 {
+			log.Printf("[StringCacheActor] Reaped %d expired keys", n)
+		}
+		a.sweepTimer = scheduleSweep(context.Self(), a.Sweep)
+		break
 	case *GetStringCacheKeyMessage:
-		ok, v := a.Cache.TryGet(msg.Key)
-		context.Respond(GetStringCacheKeyReply{Key: msg.Key, Value: v, Success: ok})
+		ok, v := a.Cache.TryGetEntry(msg.Key)
+		context.Respond(&GetStringCacheKeyReply{Key: msg.Key, Value: v.Value, Flags: v.Flags, ContentType: v.ContentType, Version: v.Version, Success: ok})
 		break
 	case *DeleteStringCacheKeyMessage:
+		if v := a.entryVersion(msg.Key); isVersionConflict(v, msg.Version) {
+			context.Respond(&DeleteStringCacheKeyReply{Key: msg.Key, Conflict: true})
+			break
+		}
 		ok, v := a.Cache.TryDelete(msg.Key)
-		context.Respond(DeleteStringCacheKeyReply{Key: msg.Key, DeletedValue: v, Success: ok})
+		context.Respond(&DeleteStringCacheKeyReply{Key: msg.Key, DeletedValue: v, Success: ok})
 		log.Printf("[StringCacheActor] Deleted %s", msg.Key)
 		break
 	case *GetCacheKeysMessage:
-		context.Respond(GetCacheKeysReply{Keys: a.Cache.GetKeys()})
+		context.Respond(&GetCacheKeysReply{Keys: a.Cache.GetKeys()})
+		break
+	case *GetCacheStatsMessage:
+		stats := a.Cache.GetStats()
+		context.Respond(&GetCacheStatsReply{Entries: stats.Entries, Bytes: stats.Bytes, Evicted: stats.Evicted, Reaped: stats.Reaped})
 		break
 	case *PostStringCacheKeyMessage:
-		ok := a.Cache.TryAdd(msg.Key, msg.Value, msg.TTL)
-		context.Respond(PostStringCacheKeyReply{Key: msg.Key, Success: ok})
+		ok := a.Cache.TryAdd(msg.Key, msg.Value, msg.Flags, msg.ContentType, msg.TTL)
+		if ok && msg.Sliding {
+			a.Cache.TrySetTTL(msg.Key, msg.TTL, true)
+		}
+		context.Respond(&PostStringCacheKeyReply{Key: msg.Key, Version: a.entryVersion(msg.Key), Success: ok})
 		log.Printf("[StringCacheActor] Created %s [%v]", msg.Key, msg.TTL)
 		break
 	case *PutStringCacheKeyMessage:
-		ok, v := a.Cache.TryUpdate(msg.Key, msg.NewValue, msg.OriginalValue)
-		context.Respond(PutStringCacheKeyReply{Key: msg.Key, OriginalValue: v, Success: ok})
-		log.Printf("[StringCacheActor] Updated %s to %s", msg.Key, msg.NewValue)
+		if v := a.entryVersion(msg.Key); isVersionConflict(v, msg.Version) {
+			context.Respond(&PutStringCacheKeyReply{Key: msg.Key, Version: v, Conflict: true})
+			break
+		}
+		original := msg.OriginalValue
+		if original == "" && msg.Version != 0 {
+			_, original = a.Cache.TryGet(msg.Key)
+		}
+		ok, v := a.Cache.TryUpdate(msg.Key, msg.NewValue, original, msg.ContentType)
+		context.Respond(&PutStringCacheKeyReply{Key: msg.Key, OriginalValue: v, Version: a.entryVersion(msg.Key), Success: ok})
+		if msg.ContentType != "" {
+			log.Printf("[StringCacheActor] Updated %s to %d bytes of %s", msg.Key, len(msg.NewValue), msg.ContentType)
+		} else {
+			log.Printf("[StringCacheActor] Updated %s to %s", msg.Key, msg.NewValue)
+		}
+		break
+	case *SetStringCacheKeyMessage:
+		version := a.Cache.Set(msg.Key, msg.Value, msg.Flags, msg.TTL)
+		context.Respond(&SetStringCacheKeyReply{Key: msg.Key, Version: version, Success: true})
+		log.Printf("[StringCacheActor] Set %s [%v]", msg.Key, msg.TTL)
+		break
+	case *ReplaceStringCacheKeyMessage:
+		ok := a.Cache.TryReplace(msg.Key, msg.Value, msg.Flags, msg.TTL)
+		context.Respond(&ReplaceStringCacheKeyReply{Key: msg.Key, Success: ok})
+		if ok {
+			log.Printf("[StringCacheActor] Replaced %s [%v]", msg.Key, msg.TTL)
+		}
+		break
+	case *CompareAndSwapStringCacheKeyMessage:
+		ok, exists := a.Cache.TryCompareAndSwap(msg.Key, msg.Value, msg.Flags, msg.TTL, msg.Version)
+		context.Respond(&CompareAndSwapStringCacheKeyReply{Key: msg.Key, Exists: exists, Success: ok})
+		if ok {
+			log.Printf("[StringCacheActor] Swapped %s version %d [%v]", msg.Key, msg.Version, msg.TTL)
+		}
+		break
+	case *TouchStringCacheKeyMessage:
+		ok := a.Cache.TryTouch(msg.Key, msg.TTL)
+		context.Respond(&TouchStringCacheKeyReply{Key: msg.Key, Success: ok})
+		break
+	case *IncrementStringCacheKeyMessage:
+		if v := a.entryVersion(msg.Key); isVersionConflict(v, msg.Version) {
+			context.Respond(&IncrementStringCacheKeyReply{Key: msg.Key, Version: v, Conflict: true})
+			break
+		}
+		inc := cache.Increment{Delta: msg.Delta, FloatDelta: msg.FloatDelta, Float: msg.Float}
+		ok, value, err := a.Cache.TryIncrement(msg.Key, inc)
+		created := false
+		if !ok && msg.Create {
+			if value, err = inc.Apply("", false); err == nil {
+				created = a.createEntry(msg.Key, value, msg.TTL, msg.Sliding)
+				ok = created
+			}
+		}
+		reply := &IncrementStringCacheKeyReply{Key: msg.Key, Value: value, Created: created, Version: a.entryVersion(msg.Key), Success: ok && err == nil}
+		if err != nil {
+			reply.Error = err.Error()
+		}
+		context.Respond(reply)
+		if reply.Success {
+			log.Printf("[StringCacheActor] Incremented %s to %s", msg.Key, value)
+		}
+		break
+	case *SetBitStringCacheKeyMessage:
+		if v := a.entryVersion(msg.Key); isVersionConflict(v, msg.Version) {
+			context.Respond(&SetBitStringCacheKeyReply{Key: msg.Key, Version: v, Conflict: true})
+			break
+		}
+		ok, old, err := a.Cache.TrySetBit(msg.Key, msg.Offset, int(msg.Bit))
+		created := false
+		if !ok && msg.Create {
+			var value string
+			if value, old, err = cache.SetBit("", msg.Offset, int(msg.Bit)); err == nil {
+				created = a.createEntry(msg.Key, value, msg.TTL, msg.Sliding)
+				ok = created
+			}
+		}
+		reply := &SetBitStringCacheKeyReply{Key: msg.Key, Previous: int32(old), Created: created, Version: a.entryVersion(msg.Key), Success: ok && err == nil}
+		if err != nil {
+			reply.Error = err.Error()
+		}
+		context.Respond(reply)
+		if reply.Success {
+			log.Printf("[StringCacheActor] Set bit %d of %s to %d", msg.Offset, msg.Key, msg.Bit)
+		}
+		break
+	case *GetBitStringCacheKeyMessage:
+		ok, bit, err := a.Cache.TryGetBit(msg.Key, msg.Offset)
+		reply := &GetBitStringCacheKeyReply{Key: msg.Key, Bit: int32(bit), Version: a.entryVersion(msg.Key), Success: ok && err == nil}
+		if err != nil {
+			reply.Error = err.Error()
+		}
+		context.Respond(reply)
+		break
+	case *CountBitsStringCacheKeyMessage:
+		ok, n := a.Cache.TryCountBits(msg.Key, msg.Start, msg.End, msg.BitUnit)
+		context.Respond(&CountBitsStringCacheKeyReply{Key: msg.Key, Count: n, Version: a.entryVersion(msg.Key), Success: ok})
+		break
+	case *GetBitPosStringCacheKeyMessage:
+		ok, pos, err := a.Cache.TryGetBitPos(msg.Key, int(msg.Bit), msg.Start, msg.End, msg.HasEnd, msg.BitUnit)
+		reply := &GetBitPosStringCacheKeyReply{Key: msg.Key, Position: pos, Version: a.entryVersion(msg.Key), Success: ok && err == nil}
+		if err != nil {
+			reply.Error = err.Error()
+		}
+		context.Respond(reply)
+		break
+	case *BitOpStringCacheKeyMessage:
+		if v := a.entryVersion(msg.Key); isVersionConflict(v, msg.Version) {
+			context.Respond(&BitOpStringCacheKeyReply{Key: msg.Key, Version: v, Conflict: true})
+			break
+		}
+		sources := make([]string, len(msg.Sources))
+		for i, data := range msg.Sources {
+			sources[i] = string(data)
+		}
+		value, err := cache.BitOp(msg.Op, sources)
+		if err != nil {
+			context.Respond(&BitOpStringCacheKeyReply{Key: msg.Key, Error: err.Error()})
+			break
+		}
+		// the empty result deletes the destination like BITOP does
+		if value == "" {
+			a.Cache.TryDelete(msg.Key)
+		} else {
+			a.Cache.Set(msg.Key, value, 0, 0)
+		}
+		context.Respond(&BitOpStringCacheKeyReply{Key: msg.Key, Length: int64(len(value)), Version: a.entryVersion(msg.Key), Success: true})
+		log.Printf("[StringCacheActor] Stored %s of %d sources to %s", msg.Op, len(sources), msg.Key)
+		break
+	case *BitFieldStringCacheKeyMessage:
+		if v := a.entryVersion(msg.Key); isVersionConflict(v, msg.Version) {
+			context.Respond(&BitFieldStringCacheKeyReply{Key: msg.Key, Version: v, Conflict: true})
+			break
+		}
+		ops := make([]cache.BitFieldOp, len(msg.Operations))
+		for i, op := range msg.Operations {
+			ops[i] = cache.BitFieldOp{Op: op.Op, Type: op.Type, Offset: op.Offset, Value: op.Value, Overflow: op.Overflow}
+		}
+		ok, res, err := a.Cache.TryBitField(msg.Key, ops)
+		created := false
+		if !ok && msg.Create {
+			var value string
+			var changed bool
+			if value, res, changed, err = cache.BitField("", ops); err == nil && changed {
+				created = a.createEntry(msg.Key, value, msg.TTL, msg.Sliding)
+				ok = created
+			} else if err == nil {
+				ok = true // only get subcommands or failed ones, nothing to create
+			}
+		}
+		reply := &BitFieldStringCacheKeyReply{Key: msg.Key, Created: created, Version: a.entryVersion(msg.Key), Success: ok && err == nil}
+		for _, r := range res {
+			reply.Results = append(reply.Results, BitFieldResult{Value: r.Value, Nil: r.Nil})
+		}
+		if err != nil {
+			reply.Error = err.Error()
+		}
+		context.Respond(reply)
+		if reply.Success {
+			log.Printf("[StringCacheActor] Applied %d bitfield operations to %s", len(ops), msg.Key)
+		}
+		break
+	case *GetCacheTTLMessage:
+		ok, v, _ := a.Cache.TryGetMetadata(msg.Key)
+		context.Respond(&GetCacheTTLReply{Key: msg.Key, ExpireAfter: v.ExpireAfter, Sliding: v.SlidingTTL(), Success: ok})
+		break
+	case *GetCacheMetadataMessage:
+		ok, v, size := a.Cache.TryGetMetadata(msg.Key)
+		context.Respond(newCacheMetadataReply(msg.Key, v, size, ok))
+		break
+	case *SetCacheTTLMessage:
+		ok := a.Cache.TrySetTTL(msg.Key, msg.TTL, msg.Sliding)
+		context.Respond(&SetCacheTTLReply{Key: msg.Key, Success: ok})
+		if ok {
+			log.Printf("[StringCacheActor] Set ttl of %s to %v", msg.Key, msg.TTL)
+		}
+		break
+	case *PersistCacheKeyMessage:
+		ok := a.Cache.TrySetTTL(msg.Key, 0, false)
+		context.Respond(&PersistCacheKeyReply{Key: msg.Key, Success: ok})
+		if ok {
+			log.Printf("[StringCacheActor] Removed ttl of %s", msg.Key)
+		}
 		break
 	case *actor.Stopping:
+		stopSweep(a.sweepTimer)
 		a.persistSnapshot()
 		break
 	}
 }
 
+// entryVersion returns the version of the entry, zero means that the key was not found.
+func (a *StringCacheActor) entryVersion(key string) int64 {
+	_, v, _ := a.Cache.TryGetMetadata(key)
+	return v.Version
+}
+
+// createEntry adds the value computed for the missing key with the ttl requested.
+func (a *StringCacheActor) createEntry(key string, value string, ttl time.Duration, sliding bool) bool {
+	created := a.Cache.TryAdd(key, value, 0, "", ttl)
+	if created && sliding {
+		a.Cache.TrySetTTL(key, ttl, true)
+	}
+	return created
+}
+
 func (a *StringCacheActor) restoreSnapshot() {
 	for _, entry := range a.DB.GetAll() {
+		value := entry.Value
+		if len(entry.Data) > 0 {
+			value = string(entry.Data)
+		}
 		mappedItem := cache.StringCacheEntry{
-			Value: entry.Value,
+			Value:       value,
+			Flags:       entry.Flags,
+			ContentType: entry.ContentType,
 			CacheEntryData: cache.CacheEntryData{
 				Added:       entry.Added,
 				Updated:     entry.Updated,
 				ExpireAfter: entry.ExpireAfter,
+				Sliding:     entry.Sliding,
+				Version:     entry.Version,
 				Persisted:   true}}
 		a.CachePersister.TryAddFromSnapshot(entry.Key, mappedItem)
 	}
@@ -153,7 +395,15 @@ func (a *StringCacheActor) persistSnapshot() {
 				Added:       v.Added,
 				Updated:     v.Updated,
 				ExpireAfter: v.ExpireAfter,
-				Value:       v.Value}
+				Sliding:     v.Sliding,
+				Version:     v.Version,
+				Flags:       v.Flags,
+				ContentType: v.ContentType}
+			if v.ContentType != "" || !utf8.ValidString(v.Value) {
+				mappedItem.Data = []byte(v.Value)
+			} else {
+				mappedItem.Value = v.Value
+			}
 			if v.Persisted {
 				updatedItems = append(updatedItems, mappedItem)
 			} else {
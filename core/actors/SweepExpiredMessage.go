@@ -0,0 +1,26 @@
+package act
+
+import (
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/VitalKrasilnikau/memcache/core/cache"
+	"time"
+)
+
+// SweepExpiredMessage is sent by the cache actor to itself to remove expired entries in background.
+type SweepExpiredMessage struct{}
+
+// scheduleSweep sends SweepExpiredMessage to the actor after the sweep interval, nil is returned if the sweep is off.
+func scheduleSweep(pid *actor.PID, options cache.SweepOptions) *time.Timer {
+	if options.Interval <= 0 {
+		return nil
+	}
+	return time.AfterFunc(options.Interval, func() {
+		pid.Tell(&SweepExpiredMessage{})
+	})
+}
+
+func stopSweep(timer *time.Timer) {
+	if timer != nil {
+		timer.Stop()
+	}
+}
@@ -20,3 +20,28 @@ func Await(pid *actor.PID, getReply ReplyProducer, getMessage MessageProducer) {
 	wg.Wait()
 	replyPid.Stop()
 }
+
+// AwaitReply sends the message to the actor and returns the first non-system message it replies with.
+func AwaitReply(pid *actor.PID, message interface{}) interface{} {
+	var reply interface{}
+	var once sync.Once
+	Await(
+		pid,
+		func(wg *sync.WaitGroup) *actor.PID {
+			return actor.Spawn(actor.FromFunc(func(ctx actor.Context) {
+				switch msg := ctx.Message().(type) {
+				case *actor.Started, *actor.Stopping, *actor.Stopped, *actor.Restarting:
+					break
+				default:
+					once.Do(func() {
+						reply = msg
+						wg.Done()
+					})
+				}
+			}))
+		},
+		func() interface{} {
+			return message
+		})
+	return reply
+}
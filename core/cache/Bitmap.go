@@ -0,0 +1,415 @@
+package cache
+
+import (
+	"errors"
+	"math/bits"
+	"strconv"
+	"strings"
+)
+
+// BitMaxOffset is the greatest bit offset which can be set, so the value grows up to 512 MB like in Redis.
+const BitMaxOffset = 1<<32 - 1
+
+var (
+	// ErrBitOffset is returned when the bit offset is negative or greater than BitMaxOffset.
+	ErrBitOffset = errors.New("bit offset is not an integer or out of range")
+	// ErrBitValue is returned when the bit is not 0 or 1.
+	ErrBitValue = errors.New("bit is not 0 or 1")
+	// ErrBitOp is returned when the bitwise operation is not and, or, xor or not.
+	ErrBitOp = errors.New("operation must be and, or, xor or not")
+	// ErrBitOpNot is returned when not operation is applied to other number of sources than one.
+	ErrBitOpNot = errors.New("not operation requires exactly one source")
+	// ErrBitFieldType is returned when the bitfield type is not i1-i64 or u1-u63.
+	ErrBitFieldType = errors.New("invalid bitfield type, use i1-i64 for signed and u1-u63 for unsigned integers")
+	// ErrBitFieldOp is returned when the bitfield operation is not get, set or incrby or the overflow is not wrap, sat or fail.
+	ErrBitFieldOp = errors.New("bitfield operation must be get, set or incrby and overflow must be wrap, sat or fail")
+)
+
+// BitFieldOp is a BITFIELD subcommand, the offset is in bits or prefixed with "#" to be multiplied by the type width.
+// Value is the new value for set and the increment for incrby. Overflow is wrap, sat or fail, wrap by default.
+type BitFieldOp struct {
+	Op       string
+	Type     string
+	Offset   string
+	Value    int64
+	Overflow string
+}
+
+// BitFieldResult is the result of BITFIELD subcommand, Nil is set if the operation failed due to fail overflow.
+type BitFieldResult struct {
+	Value int64
+	Nil   bool
+}
+
+// SetBit sets the bit at the offset like SETBIT and returns the new value and the previous bit.
+// The value is padded with zero bytes if the offset is beyond its end. Bit 0 is the most significant bit of the first byte.
+func SetBit(value string, offset int64, bit int) (string, int, error) {
+	if offset < 0 || offset > BitMaxOffset {
+		return value, 0, ErrBitOffset
+	}
+	if bit != 0 && bit != 1 {
+		return value, 0, ErrBitValue
+	}
+	old := bitAt(value, offset)
+	if old == bit && offset < 8*int64(len(value)) {
+		return value, old, nil // nothing to change, the value is not copied
+	}
+	b := growBytes(value, uint64(offset)+1)
+	if bit == 1 {
+		b[offset>>3] |= 0x80 >> uint(offset&7)
+	} else {
+		b[offset>>3] &^= 0x80 >> uint(offset&7)
+	}
+	return string(b), old, nil
+}
+
+// GetBit returns the bit at the offset like GETBIT, the bits beyond the end of the value are 0.
+func GetBit(value string, offset int64) (int, error) {
+	if offset < 0 || offset > BitMaxOffset {
+		return 0, ErrBitOffset
+	}
+	return bitAt(value, offset), nil
+}
+
+// CountBits returns the number of bits set like BITCOUNT, start and end are inclusive and negative ones are counted from the end.
+// The range is in bytes unless bitUnit is set.
+func CountBits(value string, start int64, end int64, bitUnit bool) int64 {
+	from, to, ok := bitRange(len(value), start, end, bitUnit)
+	if !ok {
+		return 0
+	}
+	var n int64
+	for i := from >> 3; i <= to>>3; i++ {
+		n += int64(bits.OnesCount8(value[i] & edgeMask(i, from, to)))
+	}
+	return n
+}
+
+// BitPos returns the position of the first bit set to 0 or 1 like BITPOS, -1 means that the bit was not found.
+// If clear bit is searched and hasEnd is false, the value is treated as padded with zeros on the right.
+func BitPos(value string, bit int, start int64, end int64, hasEnd bool, bitUnit bool) (int64, error) {
+	if bit != 0 && bit != 1 {
+		return 0, ErrBitValue
+	}
+	from, to, ok := bitRange(len(value), start, end, bitUnit)
+	if !ok {
+		return -1, nil
+	}
+	for i := from >> 3; i <= to>>3; i++ {
+		v := value[i]
+		if bit == 0 {
+			v = ^v
+		}
+		if v &= edgeMask(i, from, to); v != 0 {
+			return 8*i + int64(bits.LeadingZeros8(v)), nil
+		}
+	}
+	if bit == 0 && !hasEnd {
+		return to + 1, nil
+	}
+	return -1, nil
+}
+
+// BitOp applies and, or, xor or not to the sources like BITOP, shorter sources are padded with zero bytes.
+func BitOp(op string, sources []string) (string, error) {
+	op = strings.ToLower(op)
+	switch op {
+	case "and", "or", "xor":
+	case "not":
+		if len(sources) != 1 {
+			return "", ErrBitOpNot
+		}
+	default:
+		return "", ErrBitOp
+	}
+	n := 0
+	for _, s := range sources {
+		if len(s) > n {
+			n = len(s)
+		}
+	}
+	res := make([]byte, n)
+	for i := range res {
+		for j, s := range sources {
+			var v byte
+			if i < len(s) {
+				v = s[i]
+			}
+			switch {
+			case op == "not":
+				res[i] = ^v
+			case j == 0:
+				res[i] = v
+			case op == "and":
+				res[i] &= v
+			case op == "or":
+				res[i] |= v
+			case op == "xor":
+				res[i] ^= v
+			}
+		}
+	}
+	return string(res), nil
+}
+
+// BitField applies BITFIELD subcommands in order and returns the new value, the results and true if the value was changed.
+// All the subcommands are validated before any of them is applied.
+func BitField(value string, ops []BitFieldOp) (string, []BitFieldResult, bool, error) {
+	type field struct {
+		signed bool
+		bits   uint
+		offset uint64
+	}
+	fields := make([]field, len(ops))
+	var size uint64
+	for i, op := range ops {
+		switch strings.ToLower(op.Op) {
+		case "get", "set", "incrby":
+		default:
+			return value, nil, false, ErrBitFieldOp
+		}
+		switch strings.ToLower(op.Overflow) {
+		case "", "wrap", "sat", "fail":
+		default:
+			return value, nil, false, ErrBitFieldOp
+		}
+		signed, bits, err := parseBitFieldType(op.Type)
+		if err != nil {
+			return value, nil, false, err
+		}
+		offset, err := parseBitFieldOffset(op.Offset, bits)
+		if err != nil {
+			return value, nil, false, err
+		}
+		fields[i] = field{signed: signed, bits: bits, offset: offset}
+		if !strings.EqualFold(op.Op, "get") && offset+uint64(bits) > size {
+			size = offset + uint64(bits)
+		}
+	}
+	b := growBytes(value, size)
+	res := make([]BitFieldResult, len(ops))
+	changed := false
+	for i, op := range ops {
+		f := fields[i]
+		raw := getBits(b, f.offset, f.bits)
+		overflow := strings.ToLower(op.Overflow)
+		var next uint64
+		var ok bool
+		switch strings.ToLower(op.Op) {
+		case "get":
+			res[i] = BitFieldResult{Value: bitFieldValue(raw, f.signed, f.bits)}
+			continue
+		case "set":
+			res[i] = BitFieldResult{Value: bitFieldValue(raw, f.signed, f.bits)}
+			if f.signed {
+				var v int64
+				v, ok = bitFieldSigned(op.Value, 0, f.bits, overflow)
+				next = uint64(v)
+			} else {
+				next, ok = bitFieldUnsigned(uint64(op.Value), 0, f.bits, overflow)
+			}
+		case "incrby":
+			if f.signed {
+				var v int64
+				v, ok = bitFieldSigned(bitFieldValue(raw, true, f.bits), op.Value, f.bits, overflow)
+				next = uint64(v)
+				res[i] = BitFieldResult{Value: v}
+			} else {
+				next, ok = bitFieldUnsigned(raw, op.Value, f.bits, overflow)
+				res[i] = BitFieldResult{Value: int64(next)}
+			}
+		}
+		if !ok {
+			res[i] = BitFieldResult{Nil: true}
+			continue
+		}
+		setBits(b, f.offset, f.bits, next)
+		changed = true
+	}
+	if !changed {
+		return value, res, false, nil
+	}
+	return string(b), res, true, nil
+}
+
+// bitRange converts inclusive start and end in bytes or bits to the range of bits like BITCOUNT and BITPOS do.
+func bitRange(length int, start int64, end int64, bitUnit bool) (int64, int64, bool) {
+	n := int64(length)
+	if bitUnit {
+		n *= 8
+	}
+	if start < 0 {
+		start += n
+	}
+	if end < 0 {
+		end += n
+	}
+	if start < 0 {
+		start = 0
+	}
+	if end < 0 {
+		end = 0
+	}
+	if end >= n {
+		end = n - 1
+	}
+	if start > end {
+		return 0, 0, false
+	}
+	if bitUnit {
+		return start, end, true
+	}
+	return start * 8, end*8 + 7, true
+}
+
+func parseBitFieldType(t string) (bool, uint, error) {
+	if len(t) < 2 {
+		return false, 0, ErrBitFieldType
+	}
+	bits, err := strconv.Atoi(t[1:])
+	switch {
+	case err != nil:
+		return false, 0, ErrBitFieldType
+	case (t[0] == 'i' || t[0] == 'I') && bits >= 1 && bits <= 64:
+		return true, uint(bits), nil
+	case (t[0] == 'u' || t[0] == 'U') && bits >= 1 && bits <= 63:
+		return false, uint(bits), nil
+	}
+	return false, 0, ErrBitFieldType
+}
+
+func parseBitFieldOffset(s string, bits uint) (uint64, error) {
+	multiply := strings.HasPrefix(s, "#")
+	offset, err := strconv.ParseInt(strings.TrimPrefix(s, "#"), 10, 64)
+	if err != nil || offset < 0 {
+		return 0, ErrBitOffset
+	}
+	if multiply {
+		offset *= int64(bits)
+	}
+	if offset < 0 || offset+int64(bits)-1 > BitMaxOffset {
+		return 0, ErrBitOffset
+	}
+	return uint64(offset), nil
+}
+
+// bitFieldSigned returns value+incr checked for the overflow of signed integer of the width specified.
+// False is returned if the overflow is fail and the result does not fit.
+func bitFieldSigned(value int64, incr int64, bits uint, overflow string) (int64, bool) {
+	max := int64(uint64(1)<<(bits-1) - 1)
+	min := -max - 1
+	sum := value + incr
+	dir := 0
+	if (incr > 0 && sum < value) || sum > max {
+		dir = 1
+	} else if (incr < 0 && sum > value) || sum < min {
+		dir = -1
+	}
+	if dir == 0 {
+		return sum, true
+	}
+	switch overflow {
+	case "sat":
+		if dir > 0 {
+			return max, true
+		}
+		return min, true
+	case "fail":
+		return 0, false
+	}
+	return bitFieldValue(uint64(value)+uint64(incr), true, bits), true
+}
+
+// bitFieldUnsigned returns value+incr checked for the overflow of unsigned integer of the width specified.
+// False is returned if the overflow is fail and the result does not fit.
+func bitFieldUnsigned(value uint64, incr int64, bits uint, overflow string) (uint64, bool) {
+	max := uint64(1)<<bits - 1
+	dir := 0
+	if value > max || (incr >= 0 && uint64(incr) > max-value) {
+		dir = 1
+	} else if incr < 0 && uint64(-(incr+1))+1 > value {
+		dir = -1
+	}
+	if dir == 0 {
+		return value + uint64(incr), true
+	}
+	switch overflow {
+	case "sat":
+		if dir > 0 {
+			return max, true
+		}
+		return 0, true
+	case "fail":
+		return 0, false
+	}
+	return (value + uint64(incr)) & max, true
+}
+
+// bitFieldValue converts the bits read to signed or unsigned integer.
+func bitFieldValue(raw uint64, signed bool, bits uint) int64 {
+	if !signed || bits == 64 {
+		return int64(raw)
+	}
+	mask := uint64(1)<<bits - 1
+	raw &= mask
+	if raw&(uint64(1)<<(bits-1)) != 0 {
+		raw |= ^mask
+	}
+	return int64(raw)
+}
+
+// bitAt returns the bit at the offset of the value, the bits beyond the end are 0.
+func bitAt(value string, offset int64) int {
+	if i := offset >> 3; i < int64(len(value)) {
+		return int(value[i]>>uint(7-offset&7)) & 1
+	}
+	return 0
+}
+
+// edgeMask returns the mask of the bits of the byte at the index which are in the range of bits from and to inclusive,
+// so only the first and the last bytes of the range are partially masked.
+func edgeMask(index int64, from int64, to int64) byte {
+	m := byte(0xFF)
+	if index == from>>3 {
+		m &= 0xFF >> uint(from&7)
+	}
+	if index == to>>3 {
+		m &= 0xFF << uint(7-to&7)
+	}
+	return m
+}
+
+// growBytes copies the value padding it with zero bytes to hold the number of bits specified.
+func growBytes(value string, bits uint64) []byte {
+	n := int((bits + 7) / 8)
+	if n < len(value) {
+		n = len(value)
+	}
+	b := make([]byte, n)
+	copy(b, value)
+	return b
+}
+
+// getBits reads the bits starting from the offset as big-endian unsigned integer, the bits beyond the end are 0.
+func getBits(b []byte, offset uint64, bits uint) uint64 {
+	var v uint64
+	for i := uint64(0); i < uint64(bits); i++ {
+		v <<= 1
+		if idx := (offset + i) >> 3; idx < uint64(len(b)) {
+			v |= uint64(b[idx]>>(7-(offset+i)&7)) & 1
+		}
+	}
+	return v
+}
+
+// setBits writes the lowest bits of the value starting from the offset, the slice must be long enough.
+func setBits(b []byte, offset uint64, bits uint, value uint64) {
+	for i := uint64(0); i < uint64(bits); i++ {
+		idx, shift := (offset+i)>>3, 7-(offset+i)&7
+		if value>>(uint64(bits)-1-i)&1 == 1 {
+			b[idx] |= 1 << shift
+		} else {
+			b[idx] &^= 1 << shift
+		}
+	}
+}
@@ -0,0 +1,132 @@
+package cache
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+)
+
+const (
+	// DefaultBloomFilterCapacity is the capacity of Bloom filter created without reservation.
+	DefaultBloomFilterCapacity = 100
+	// DefaultBloomFilterErrorRate is the false positive rate of Bloom filter created without reservation.
+	DefaultBloomFilterErrorRate = 0.01
+	maxBloomFilterBits          = 1 << 32
+	bloomFilterHeaderSize       = 1 + 8 + 8 + 4 + 8 + 8
+	bloomFilterFormat           = byte(1)
+)
+
+var (
+	// ErrInvalidBloomFilter is returned when the serialized Bloom filter cannot be read.
+	ErrInvalidBloomFilter = errors.New("invalid Bloom filter data")
+	// ErrInvalidBloomFilterOptions is returned when the capacity is not positive or the error rate is not between 0 and 1.
+	ErrInvalidBloomFilterOptions = errors.New("capacity must be positive and error rate must be between 0 and 1")
+	// ErrBloomFilterTooBig is returned when the filter for the capacity and the error rate needs more than 512 MB.
+	ErrBloomFilterTooBig = errors.New("Bloom filter is too big")
+)
+
+// BloomFilter tells if the value was probably added or definitely was not.
+// The error rate grows above the one reserved when more values than the capacity are added.
+type BloomFilter struct {
+	Capacity  int64
+	ErrorRate float64
+	Hashes    int
+	Size      uint64
+	Count     int64
+	Bits      []uint64
+}
+
+// NewBloomFilter creates Bloom filter with the optimal number of bits and hash functions
+// for the capacity and the false positive rate specified.
+func NewBloomFilter(capacity int64, errorRate float64) (*BloomFilter, error) {
+	if capacity <= 0 || errorRate <= 0 || errorRate >= 1 {
+		return nil, ErrInvalidBloomFilterOptions
+	}
+	m := math.Ceil(-float64(capacity) * math.Log(errorRate) / (math.Ln2 * math.Ln2))
+	if m > maxBloomFilterBits {
+		return nil, ErrBloomFilterTooBig
+	}
+	size := uint64(m)
+	hashes := int(math.Round(m / float64(capacity) * math.Ln2))
+	if hashes < 1 {
+		hashes = 1
+	}
+	return &BloomFilter{
+		Capacity:  capacity,
+		ErrorRate: errorRate,
+		Hashes:    hashes,
+		Size:      size,
+		Bits:      make([]uint64, (size+63)/64)}, nil
+}
+
+// Add adds the value and returns true if it was not in the filter, false means that it was probably added before.
+func (f *BloomFilter) Add(value string) bool {
+	added := false
+	h1, h2 := bloomFilterHashes(value)
+	for i := 0; i < f.Hashes; i++ {
+		bit := (h1 + uint64(i)*h2) % f.Size
+		if f.Bits[bit/64]&(1<<(bit%64)) == 0 {
+			f.Bits[bit/64] |= 1 << (bit % 64)
+			added = true
+		}
+	}
+	if added {
+		f.Count++
+	}
+	return added
+}
+
+// Exists returns true if the value was probably added and false if it definitely was not.
+func (f *BloomFilter) Exists(value string) bool {
+	h1, h2 := bloomFilterHashes(value)
+	for i := 0; i < f.Hashes; i++ {
+		bit := (h1 + uint64(i)*h2) % f.Size
+		if f.Bits[bit/64]&(1<<(bit%64)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Marshal returns binary representation of the Bloom filter, the header with the options is followed by the bits.
+func (f *BloomFilter) Marshal() []byte {
+	data := make([]byte, bloomFilterHeaderSize+8*len(f.Bits))
+	data[0] = bloomFilterFormat
+	binary.BigEndian.PutUint64(data[1:], uint64(f.Capacity))
+	binary.BigEndian.PutUint64(data[9:], math.Float64bits(f.ErrorRate))
+	binary.BigEndian.PutUint32(data[17:], uint32(f.Hashes))
+	binary.BigEndian.PutUint64(data[21:], f.Size)
+	binary.BigEndian.PutUint64(data[29:], uint64(f.Count))
+	for i, w := range f.Bits {
+		binary.BigEndian.PutUint64(data[bloomFilterHeaderSize+8*i:], w)
+	}
+	return data
+}
+
+// UnmarshalBloomFilter reads Bloom filter written by Marshal.
+func UnmarshalBloomFilter(data []byte) (*BloomFilter, error) {
+	if len(data) < bloomFilterHeaderSize || data[0] != bloomFilterFormat {
+		return nil, ErrInvalidBloomFilter
+	}
+	f := &BloomFilter{
+		Capacity:  int64(binary.BigEndian.Uint64(data[1:])),
+		ErrorRate: math.Float64frombits(binary.BigEndian.Uint64(data[9:])),
+		Hashes:    int(binary.BigEndian.Uint32(data[17:])),
+		Size:      binary.BigEndian.Uint64(data[21:]),
+		Count:     int64(binary.BigEndian.Uint64(data[29:]))}
+	words := (f.Size + 63) / 64
+	if f.Size == 0 || f.Size > maxBloomFilterBits || f.Hashes < 1 || uint64(len(data)-bloomFilterHeaderSize) != 8*words {
+		return nil, ErrInvalidBloomFilter
+	}
+	f.Bits = make([]uint64, words)
+	for i := range f.Bits {
+		f.Bits[i] = binary.BigEndian.Uint64(data[bloomFilterHeaderSize+8*i:])
+	}
+	return f, nil
+}
+
+// bloomFilterHashes returns two hashes which are combined to get the positions of the bits (Kirsch-Mitzenmacher).
+func bloomFilterHashes(value string) (uint64, uint64) {
+	h := hashValue(value)
+	return h, mix64(h^0x9e3779b97f4a7c15) | 1
+}
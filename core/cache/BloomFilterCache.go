@@ -0,0 +1,218 @@
+package cache
+
+import (
+	"log"
+	"time"
+)
+
+// BloomFilterCacheEntry is Bloom filter stored in the memory cache.
+type BloomFilterCacheEntry struct {
+	Value *BloomFilter
+	CacheEntryData
+}
+
+// IBloomFilterCache is an interface for BloomFilterCache.
+type IBloomFilterCache interface {
+	TryGet(key string) (bool, *BloomFilter)
+	TryAdd(key string, filter *BloomFilter, ttl time.Duration) bool
+	TryDelete(key string) bool
+	TryAddValues(key string, values []string) (bool, []bool)
+	TryExists(key string, values []string) (bool, []bool)
+	TryGetMetadata(key string) (bool, CacheEntryData, int64)
+	TrySetTTL(key string, ttl time.Duration, sliding bool) bool
+	GetKeys() []string
+	GetStats() CacheStats
+	RemoveExpired(budget time.Duration) int
+}
+
+// IBloomFilterCachePersistence is an interface for persisting BloomFilterCache.
+type IBloomFilterCachePersistence interface {
+	TryGetSnapshot(key string) (bool, BloomFilterCacheEntry)
+	TryAddFromSnapshot(key string, entry BloomFilterCacheEntry) bool
+}
+
+// BloomFilterCache is a single-thread in-memory cache based on map[string]BloomFilterCacheEntry.
+type BloomFilterCache struct {
+	Map     map[string]BloomFilterCacheEntry
+	Evictor *Evictor
+	Reaped  int64
+}
+
+// TryGet returns Bloom filter if contains the key specified, the value must not be changed by the caller.
+func (c *BloomFilterCache) TryGet(key string) (bool, *BloomFilter) {
+	v, ok := c.getValueWithExpiration(key)
+	return ok, v.Value
+}
+
+// TryGetSnapshot returns the value if contains the key specified.
+func (c *BloomFilterCache) TryGetSnapshot(key string) (bool, BloomFilterCacheEntry) {
+	v, ok := c.peek(key)
+	return ok, v
+}
+
+// TryGetMetadata returns timestamps, expiration, version and size in bytes of the entry if contains the key specified.
+// The entry is not marked as used and sliding ttl is not renewed.
+func (c *BloomFilterCache) TryGetMetadata(key string) (bool, CacheEntryData, int64) {
+	v, ok := c.peek(key)
+	return ok, v.CacheEntryData, v.size(key)
+}
+
+// TrySetTTL sets new ttl for the existing key keeping its value and version, zero ttl makes the key permanent.
+// Sliding ttl is renewed each time the key is used.
+func (c *BloomFilterCache) TrySetTTL(key string, ttl time.Duration, sliding bool) bool {
+	v, ok := c.peek(key)
+	if ok {
+		v.setTTL(ttl, sliding)
+		c.store(key, v)
+	}
+	return ok
+}
+
+// TryAdd add new Bloom filter to the cache by the key specified if the key is not already used like BF.RESERVE.
+func (c *BloomFilterCache) TryAdd(key string, filter *BloomFilter, ttl time.Duration) bool {
+	_, ok := c.getValueWithExpiration(key)
+	if !ok {
+		c.store(key, BloomFilterCacheEntry{Value: filter, CacheEntryData: NewCacheEntryData(ttl)})
+	}
+	return !ok
+}
+
+// TryAddFromSnapshot add new value to the cache by the key specified if the key is not already used.
+func (c *BloomFilterCache) TryAddFromSnapshot(key string, entry BloomFilterCacheEntry) bool {
+	_, ok := c.Map[key]
+	if !ok {
+		entry.ExpireAfter = normalizeExpiration(entry.ExpireAfter)
+		c.store(key, entry)
+		observeVersion(entry.Version)
+	}
+	return !ok
+}
+
+// TryDelete deletes Bloom filter by the key specified if the key is already used.
+func (c *BloomFilterCache) TryDelete(key string) bool {
+	_, ok := c.getValueWithExpiration(key)
+	if ok {
+		c.remove(key)
+	}
+	return ok
+}
+
+// TryAddValues adds the values like BF.MADD, the result for each value is true if it was not in the filter before.
+// The version is changed only if the filter was changed.
+func (c *BloomFilterCache) TryAddValues(key string, values []string) (bool, []bool) {
+	v, ok := c.getValueWithExpiration(key)
+	if !ok {
+		return false, nil
+	}
+	added := make([]bool, len(values))
+	changed := false
+	for i, s := range values {
+		added[i] = v.Value.Add(s)
+		changed = changed || added[i]
+	}
+	if changed {
+		c.store(key, BloomFilterCacheEntry{Value: v.Value, CacheEntryData: UpdateCacheEntryData(v.CacheEntryData)})
+	}
+	return true, added
+}
+
+// TryExists checks the values like BF.MEXISTS, the result for each value is true if it was probably added.
+func (c *BloomFilterCache) TryExists(key string, values []string) (bool, []bool) {
+	v, ok := c.getValueWithExpiration(key)
+	if !ok {
+		return false, nil
+	}
+	exists := make([]bool, len(values))
+	for i, s := range values {
+		exists[i] = v.Value.Exists(s)
+	}
+	return true, exists
+}
+
+// GetKeys returns all the keys in the map.
+func (c *BloomFilterCache) GetKeys() []string {
+	var keySlice []string
+	for key, v := range c.Map {
+		if !IsCacheEntryExpired(v.CacheEntryData) {
+			keySlice = append(keySlice, key)
+		}
+	}
+	if keySlice == nil {
+		return make([]string, 0)
+	}
+	return keySlice
+}
+
+// GetStats returns cache usage counters.
+func (c *BloomFilterCache) GetStats() CacheStats {
+	stats := c.Evictor.Stats()
+	stats.Reaped = c.Reaped
+	return stats
+}
+
+// RemoveExpired deletes expired entries until all the keys are checked or the time budget is spent.
+// Go maps are iterated in random order, so the next sweep starts from another key.
+func (c *BloomFilterCache) RemoveExpired(budget time.Duration) int {
+	started := time.Now()
+	n, checked := 0, 0
+	for key, v := range c.Map {
+		if IsCacheEntryExpired(v.CacheEntryData) {
+			c.remove(key)
+			n++
+		}
+		checked++
+		if isOverBudget(started, budget, checked) {
+			break
+		}
+	}
+	c.Reaped += int64(n)
+	return n
+}
+
+func (c *BloomFilterCache) getValueWithExpiration(key string) (BloomFilterCacheEntry, bool) {
+	v, ok := c.peek(key)
+	if ok {
+		c.Evictor.Touch(key)
+		if v.slide() {
+			c.store(key, v)
+		}
+	}
+	return v, ok
+}
+
+// peek returns the entry removing it if expired, the entry is not marked as used.
+func (c *BloomFilterCache) peek(key string) (BloomFilterCacheEntry, bool) {
+	v, ok := c.Map[key]
+	if ok {
+		if IsCacheEntryExpired(v.CacheEntryData) {
+			c.remove(key)
+			log.Printf("[BloomFilterCache] key %s had expired and was removed", key)
+			return v, false // expired
+		}
+		return v, ok // not expired
+	}
+	return v, ok
+}
+
+// store puts the entry to the map and evicts other keys if the cache is over its limits.
+func (c *BloomFilterCache) store(key string, entry BloomFilterCacheEntry) {
+	c.Map[key] = entry
+	c.Evictor.Track(key, entry.size(key), entry.ExpireAfter)
+	for _, k := range c.Evictor.Evict(key) {
+		delete(c.Map, k)
+		log.Printf("[BloomFilterCache] key %s was evicted", k)
+	}
+}
+
+func (c *BloomFilterCache) remove(key string) {
+	delete(c.Map, key)
+	c.Evictor.Remove(key)
+}
+
+// size returns approximate memory used by the entry.
+func (v BloomFilterCacheEntry) size(key string) int64 {
+	if v.Value == nil {
+		return int64(len(key))
+	}
+	return int64(len(key) + bloomFilterHeaderSize + 8*len(v.Value.Bits))
+}
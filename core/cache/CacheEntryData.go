@@ -1,29 +1,35 @@
 package cache
 
 import (
+	"sync/atomic"
 	"time"
 )
 
-// CacheEntryData holds basic info about cache item.
+// lastVersion is a version counter shared by all cache entries in the process.
+var lastVersion int64
+
+// maxUnixSeconds separates ExpireAfter values stored in Unix seconds by older versions from Unix milliseconds.
+const maxUnixSeconds = 100000000000
+
+// CacheEntryData holds basic info about cache item, ExpireAfter is Unix time in milliseconds.
+// Sliding is ttl in milliseconds which is renewed each time the entry is used, zero means fixed expiration.
 type CacheEntryData struct {
 	ExpireAfter int64
+	Sliding     int64
 	Added       int64
 	Updated     int64
+	Version     int64
 	Persisted   bool
 }
 
 // NewCacheEntryData creates new CacheEntryData structure.
 func NewCacheEntryData(ttl time.Duration) CacheEntryData {
-	nowTime := time.Now()
-	now := nowTime.Unix()
-	var expireAfter int64
-	if ttl > 0 {
-		expireAfter = nowTime.Add(ttl).Unix()
-	}
+	now := time.Now().Unix()
 	return CacheEntryData{
-		ExpireAfter: expireAfter,
+		ExpireAfter: ExpirationTime(ttl),
 		Added:       now,
 		Updated:     now,
+		Version:     nextVersion(),
 		Persisted:   false}
 }
 
@@ -32,11 +38,87 @@ func UpdateCacheEntryData(v CacheEntryData) CacheEntryData {
 	return CacheEntryData{
 		Added:       v.Added,
 		ExpireAfter: v.ExpireAfter,
+		Sliding:     v.Sliding,
 		Persisted:   v.Persisted,
+		Version:     nextVersion(),
 		Updated:     time.Now().Unix()}
 }
 
 // IsCacheEntryExpired returns true if ttl defined for this entry had elapced.
 func IsCacheEntryExpired(v CacheEntryData) bool {
-	return v.ExpireAfter != 0 && time.Now().Unix() > v.ExpireAfter
+	return v.ExpireAfter != 0 && nowMillis() > v.ExpireAfter
+}
+
+// RemainingTTL returns time left before the entry expires, zero means no expiration.
+func (v CacheEntryData) RemainingTTL() time.Duration {
+	if v.ExpireAfter == 0 {
+		return 0
+	}
+	if ttl := time.Duration(v.ExpireAfter-nowMillis()) * time.Millisecond; ttl > 0 {
+		return ttl
+	}
+	return time.Millisecond
+}
+
+// SlidingTTL returns ttl which is renewed each time the entry is used.
+func (v CacheEntryData) SlidingTTL() time.Duration {
+	return time.Duration(v.Sliding) * time.Millisecond
+}
+
+// setTTL sets new expiration keeping the version, zero ttl makes the entry permanent.
+func (v *CacheEntryData) setTTL(ttl time.Duration, sliding bool) {
+	v.ExpireAfter = ExpirationTime(ttl)
+	v.Sliding = 0
+	if sliding && ttl > 0 {
+		v.Sliding = int64(ttl / time.Millisecond)
+	}
+	v.Updated = time.Now().Unix()
+}
+
+// slide renews the sliding expiration, returns false if the entry has fixed expiration.
+func (v *CacheEntryData) slide() bool {
+	if v.Sliding == 0 {
+		return false
+	}
+	v.ExpireAfter = nowMillis() + v.Sliding
+	return true
+}
+
+// ExpirationTime converts ttl to ExpireAfter value, zero ttl means no expiration.
+func ExpirationTime(ttl time.Duration) int64 {
+	if ttl > 0 {
+		return toMillis(time.Now().Add(ttl))
+	}
+	return 0
+}
+
+func nowMillis() int64 {
+	return toMillis(time.Now())
+}
+
+func toMillis(t time.Time) int64 {
+	return t.UnixNano() / int64(time.Millisecond)
+}
+
+// normalizeExpiration converts ExpireAfter restored from a snapshot which was stored in Unix seconds.
+func normalizeExpiration(v int64) int64 {
+	if v > 0 && v < maxUnixSeconds {
+		return v * 1000
+	}
+	return v
+}
+
+// nextVersion returns new version which is greater than any version seen before.
+func nextVersion() int64 {
+	return atomic.AddInt64(&lastVersion, 1)
+}
+
+// observeVersion moves the version counter forward, so entries restored from a snapshot keep increasing their versions.
+func observeVersion(v int64) {
+	for {
+		last := atomic.LoadInt64(&lastVersion)
+		if v <= last || atomic.CompareAndSwapInt64(&lastVersion, last, v) {
+			return
+		}
+	}
 }
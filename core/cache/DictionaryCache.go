@@ -1,21 +1,24 @@
 package cache
 
 import (
+	"github.com/VitalKrasilnikau/memcache/core/messages"
 	"log"
+	"sort"
 	"time"
 )
 
 // DictionaryCacheEntry is a string cache data item stored in the memory cache.
+// Expiry holds ExpireAfter of the sub-keys which have their own ttl, Unix time in milliseconds.
+// Bytes is the size of the sub-keys and their values, it is kept up to date by each change, so the dictionary is not walked on every write.
 type DictionaryCacheEntry struct {
-	Map map[string]string
+	Map    map[string]string
+	Expiry map[string]int64
+	Bytes  int64
 	CacheEntryData
 }
 
-// KeyValue is a dictionary cache item.
-type KeyValue struct {
-	Key   string
-	Value string
-}
+// KeyValue is a dictionary cache item, it is shared with remote actor messages.
+type KeyValue = messages.KeyValue
 
 // IDictionaryCache is an interface for DictionaryCache.
 type IDictionaryCache interface {
@@ -24,8 +27,18 @@ type IDictionaryCache interface {
 	TryDelete(key string) (bool, []KeyValue)
 	TryUpdateValue(key string, subKey string, newValue string, originalValue string) (bool, []KeyValue)
 	TryDeleteValue(key string, subKey string) (bool, KeyValue)
-	TryAddValue(key string, newValue KeyValue) (bool, []KeyValue)
+	TryAddValue(key string, newValue KeyValue, ttl time.Duration) (bool, []KeyValue)
+	TryIncrementValue(key string, subKey string, inc Increment) (bool, string, error)
+	TryGetValues(key string, subKeys []string) (bool, []KeyValue)
+	TrySetValues(key string, values []KeyValue) (bool, int, int)
+	TryDeleteValues(key string, subKeys []string) (bool, []KeyValue)
+	TryGetSubKeys(key string) (bool, []string)
+	TryContainsValues(key string, subKeys []string) (bool, []bool)
+	TryGetMetadata(key string) (bool, CacheEntryData, int64)
+	TrySetTTL(key string, ttl time.Duration, sliding bool) bool
 	GetKeys() []string
+	GetStats() CacheStats
+	RemoveExpired(budget time.Duration) int
 }
 
 // IDictionaryCachePersistence is an interface for persisting DictionaryCache.
@@ -36,7 +49,9 @@ type IDictionaryCachePersistence interface {
 
 // DictionaryCache is a single-thread in-memory cache based on map[string]DictionaryCacheEntry.
 type DictionaryCache struct {
-	Map map[string]DictionaryCacheEntry
+	Map     map[string]DictionaryCacheEntry
+	Evictor *Evictor
+	Reaped  int64
 }
 
 // TryGet returns the value if contains the key specified.
@@ -47,15 +62,35 @@ func (c *DictionaryCache) TryGet(key string) (bool, []KeyValue) {
 
 // TryGetSnapshot returns the value if contains the key specified.
 func (c *DictionaryCache) TryGetSnapshot(key string) (bool, DictionaryCacheEntry) {
-	v, ok := c.getValueWithExpiration(key)
+	v, ok := c.peek(key)
 	return ok, v
 }
 
+// TryGetMetadata returns timestamps, expiration, version and size in bytes of the entry if contains the key specified.
+// The entry is not marked as used and sliding ttl is not renewed.
+func (c *DictionaryCache) TryGetMetadata(key string) (bool, CacheEntryData, int64) {
+	v, ok := c.peek(key)
+	return ok, v.CacheEntryData, v.size(key)
+}
+
+// TrySetTTL sets new ttl for the existing key keeping its value and version, zero ttl makes the key permanent.
+// Sliding ttl is renewed each time the key is used.
+func (c *DictionaryCache) TrySetTTL(key string, ttl time.Duration, sliding bool) bool {
+	v, ok := c.peek(key)
+	if ok {
+		v.setTTL(ttl, sliding)
+		c.store(key, v)
+	}
+	return ok
+}
+
 // TryAdd add new value to the cache by the key specified if the key is not already used.
 func (c *DictionaryCache) TryAdd(key string, values []KeyValue, ttl time.Duration) bool {
 	_, ok := c.getValueWithExpiration(key)
 	if !ok {
-		c.Map[key] = DictionaryCacheEntry{Map: ToMap(values), CacheEntryData: NewCacheEntryData(ttl)}
+		v := DictionaryCacheEntry{Map: ToMap(values), CacheEntryData: NewCacheEntryData(ttl)}
+		v.countBytes()
+		c.store(key, v)
 	}
 	return !ok
 }
@@ -64,7 +99,11 @@ func (c *DictionaryCache) TryAdd(key string, values []KeyValue, ttl time.Duratio
 func (c *DictionaryCache) TryAddFromSnapshot(key string, entry DictionaryCacheEntry) bool {
 	_, ok := c.Map[key]
 	if !ok {
-		c.Map[key] = entry
+		entry.ExpireAfter = normalizeExpiration(entry.ExpireAfter)
+		entry.countBytes()
+		entry.removeExpiredValues()
+		c.store(key, entry)
+		observeVersion(entry.Version)
 	}
 	return !ok
 }
@@ -73,12 +112,12 @@ func (c *DictionaryCache) TryAddFromSnapshot(key string, entry DictionaryCacheEn
 func (c *DictionaryCache) TryDelete(key string) (bool, []KeyValue) {
 	v, ok := c.getValueWithExpiration(key)
 	if ok {
-		delete(c.Map, key)
+		c.remove(key)
 	}
 	return ok, FromMap(v.Map)
 }
 
-// TryUpdateValue updates the existing value in the list by the key.
+// TryUpdateValue updates the existing value in the list by the key, the new value has no ttl of its own.
 func (c *DictionaryCache) TryUpdateValue(key string, subKey string, newValue string, originalValue string) (bool, []KeyValue) {
 	v, ok := c.getValueWithExpiration(key)
 	if ok {
@@ -86,11 +125,10 @@ func (c *DictionaryCache) TryUpdateValue(key string, subKey string, newValue str
 		if exists {
 			sameOriginal := prevValue == originalValue
 			if sameOriginal {
-				v.Map[subKey] = newValue
-				entry := DictionaryCacheEntry{
-					Map:            v.Map,
-					CacheEntryData: UpdateCacheEntryData(v.CacheEntryData)}
-				c.Map[key] = entry
+				v.setValue(subKey, newValue)
+				v.clearExpiry(subKey)
+				v.CacheEntryData = UpdateCacheEntryData(v.CacheEntryData)
+				c.store(key, v)
 			}
 			return exists && sameOriginal, FromMap(v.Map)
 		}
@@ -104,11 +142,9 @@ func (c *DictionaryCache) TryDeleteValue(key string, subKey string) (bool, KeyVa
 	if ok {
 		del, exists := v.Map[subKey]
 		if exists {
-			delete(v.Map, subKey)
-			entry := DictionaryCacheEntry{
-				Map:            v.Map,
-				CacheEntryData: UpdateCacheEntryData(v.CacheEntryData)}
-			c.Map[key] = entry
+			v.deleteValue(subKey)
+			v.CacheEntryData = UpdateCacheEntryData(v.CacheEntryData)
+			c.store(key, v)
 			return exists, KeyValue{Key: subKey, Value: del}
 		}
 	}
@@ -116,21 +152,124 @@ func (c *DictionaryCache) TryDeleteValue(key string, subKey string) (bool, KeyVa
 }
 
 // TryAddValue adds the value to the list by the key.
-func (c *DictionaryCache) TryAddValue(key string, newValue KeyValue) (bool, []KeyValue) {
+// Positive ttl makes the sub-key expire on its own, the rest of the dictionary is kept.
+func (c *DictionaryCache) TryAddValue(key string, newValue KeyValue, ttl time.Duration) (bool, []KeyValue) {
 	v, ok := c.getValueWithExpiration(key)
 	if ok {
-		ok2 := addKeyToMap(v.Map, newValue)
-		if ok2 {
-			entry := DictionaryCacheEntry{
-				Map:            v.Map,
-				CacheEntryData: UpdateCacheEntryData(v.CacheEntryData)}
-			c.Map[key] = entry
-			return true, FromMap(entry.Map)
+		if _, exists := v.Map[newValue.Key]; !exists {
+			v.setValue(newValue.Key, newValue.Value)
+			if ttl > 0 {
+				v.setExpiry(newValue.Key, ExpirationTime(ttl))
+			}
+			v.CacheEntryData = UpdateCacheEntryData(v.CacheEntryData)
+			c.store(key, v)
+			return true, FromMap(v.Map)
 		}
 	}
 	return false, FromMap(v.Map)
 }
 
+// TryIncrementValue changes the numeric value of the sub-key, missing sub-key is added with zero value first.
+// The value is not changed if it is not a number or the result is out of range.
+func (c *DictionaryCache) TryIncrementValue(key string, subKey string, inc Increment) (bool, string, error) {
+	v, ok := c.getValueWithExpiration(key)
+	if !ok {
+		return false, "", nil
+	}
+	prevValue, exists := v.Map[subKey]
+	value, err := inc.Apply(prevValue, exists)
+	if err != nil {
+		return true, prevValue, err
+	}
+	v.setValue(subKey, value)
+	v.CacheEntryData = UpdateCacheEntryData(v.CacheEntryData)
+	c.store(key, v)
+	return true, value, nil
+}
+
+// TryGetValues returns the values of the sub-keys specified in the same order, missing sub-keys are skipped.
+func (c *DictionaryCache) TryGetValues(key string, subKeys []string) (bool, []KeyValue) {
+	v, ok := c.getValueWithExpiration(key)
+	values := make([]KeyValue, 0, len(subKeys))
+	if ok {
+		for _, k := range subKeys {
+			if value, exists := v.Map[k]; exists {
+				values = append(values, KeyValue{Key: k, Value: value})
+			}
+		}
+	}
+	return ok, values
+}
+
+// TrySetValues adds missing sub-keys and updates existing ones in one step, returns the number of sub-keys added and updated.
+// The values set have no ttl of their own. The version is changed only if the dictionary was changed.
+func (c *DictionaryCache) TrySetValues(key string, values []KeyValue) (bool, int, int) {
+	v, ok := c.getValueWithExpiration(key)
+	if !ok {
+		return false, 0, 0
+	}
+	added, updated := 0, 0
+	for _, kv := range values {
+		prevValue, exists := v.Map[kv.Key]
+		_, expires := v.Expiry[kv.Key]
+		if !exists {
+			added++
+		} else if prevValue != kv.Value || expires {
+			updated++
+		} else {
+			continue
+		}
+		v.setValue(kv.Key, kv.Value)
+		v.clearExpiry(kv.Key)
+	}
+	if added > 0 || updated > 0 {
+		v.CacheEntryData = UpdateCacheEntryData(v.CacheEntryData)
+		c.store(key, v)
+	}
+	return true, added, updated
+}
+
+// TryDeleteValues deletes the sub-keys specified in one step and returns the values deleted, missing sub-keys are skipped.
+func (c *DictionaryCache) TryDeleteValues(key string, subKeys []string) (bool, []KeyValue) {
+	v, ok := c.getValueWithExpiration(key)
+	deleted := make([]KeyValue, 0, len(subKeys))
+	if !ok {
+		return false, deleted
+	}
+	for _, k := range subKeys {
+		if value, exists := v.Map[k]; exists {
+			v.deleteValue(k)
+			deleted = append(deleted, KeyValue{Key: k, Value: value})
+		}
+	}
+	if len(deleted) > 0 {
+		v.CacheEntryData = UpdateCacheEntryData(v.CacheEntryData)
+		c.store(key, v)
+	}
+	return true, deleted
+}
+
+// TryGetSubKeys returns the sorted sub-keys without their values.
+func (c *DictionaryCache) TryGetSubKeys(key string) (bool, []string) {
+	v, ok := c.getValueWithExpiration(key)
+	subKeys := make([]string, 0, len(v.Map))
+	for k := range v.Map {
+		subKeys = append(subKeys, k)
+	}
+	sort.Strings(subKeys)
+	return ok, subKeys
+}
+
+// TryContainsValues checks if the dictionary contains each of the sub-keys specified.
+func (c *DictionaryCache) TryContainsValues(key string, subKeys []string) (bool, []bool) {
+	v, ok := c.getValueWithExpiration(key)
+	exists := make([]bool, len(subKeys))
+	for i, k := range subKeys {
+		_, exists[i] = v.Map[k]
+	}
+	return ok, exists
+}
+
 // GetKeys returns all the keys in the map.
 func (c *DictionaryCache) GetKeys() []string {
 	var keySlice []string
@@ -145,14 +284,57 @@ func (c *DictionaryCache) GetKeys() []string {
 	return keySlice
 }
 
+// GetStats returns cache usage counters.
+func (c *DictionaryCache) GetStats() CacheStats {
+	stats := c.Evictor.Stats()
+	stats.Reaped = c.Reaped
+	return stats
+}
+
+// RemoveExpired deletes expired entries and expired sub-keys until all the keys are checked or the time budget is spent.
+// Go maps are iterated in random order, so the next sweep starts from another key.
+func (c *DictionaryCache) RemoveExpired(budget time.Duration) int {
+	started := time.Now()
+	n, checked := 0, 0
+	for key, v := range c.Map {
+		if IsCacheEntryExpired(v.CacheEntryData) {
+			c.remove(key)
+			n++
+		} else if v.removeExpiredValues() > 0 {
+			c.track(key, v)
+		}
+		checked++
+		if isOverBudget(started, budget, checked) {
+			break
+		}
+	}
+	c.Reaped += int64(n)
+	return n
+}
+
 func (c *DictionaryCache) getValueWithExpiration(key string) (DictionaryCacheEntry, bool) {
+	v, ok := c.peek(key)
+	if ok {
+		c.Evictor.Touch(key)
+		if v.slide() {
+			c.store(key, v)
+		}
+	}
+	return v, ok
+}
+
+// peek returns the entry removing it if expired, the entry is not marked as used.
+func (c *DictionaryCache) peek(key string) (DictionaryCacheEntry, bool) {
 	v, ok := c.Map[key]
 	if ok {
 		if IsCacheEntryExpired(v.CacheEntryData) {
-			delete(c.Map, key)
+			c.remove(key)
 			log.Printf("[DictionaryCache] key %s had expired and was removed", key)
 			return v, false // expired
 		}
+		if v.removeExpiredValues() > 0 {
+			c.track(key, v)
+		}
 		return v, ok // not expired
 	}
 	return v, ok
@@ -184,8 +366,97 @@ func FromMap(aMap map[string]string) []KeyValue {
 	}
 	i := 0
 	for k, v := range aMap {
-		a[i] = KeyValue{k, v}
+		a[i] = KeyValue{Key: k, Value: v}
 		i++
 	}
 	return a
 }
+
+// store puts the entry to the map and evicts other keys if the cache is over its limits.
+func (c *DictionaryCache) store(key string, entry DictionaryCacheEntry) {
+	c.Map[key] = entry
+	c.Evictor.Track(key, entry.size(key), entry.ExpireAfter)
+	for _, k := range c.Evictor.Evict(key) {
+		delete(c.Map, k)
+		log.Printf("[DictionaryCache] key %s was evicted", k)
+	}
+}
+
+// track saves the entry and updates its size after its expired sub-keys were removed, the version is not changed.
+func (c *DictionaryCache) track(key string, entry DictionaryCacheEntry) {
+	c.Map[key] = entry
+	c.Evictor.Track(key, entry.size(key), entry.ExpireAfter)
+}
+
+func (c *DictionaryCache) remove(key string) {
+	delete(c.Map, key)
+	c.Evictor.Remove(key)
+}
+
+// size returns approximate memory used by the entry.
+func (v DictionaryCacheEntry) size(key string) int64 {
+	return int64(len(key)) + v.Bytes
+}
+
+// countBytes walks the sub-keys once when the entry is created or restored, later changes update Bytes.
+func (v *DictionaryCacheEntry) countBytes() {
+	n := 8 * len(v.Expiry)
+	for k, s := range v.Map {
+		n += len(k) + len(s)
+	}
+	v.Bytes = int64(n)
+}
+
+// setValue adds or updates the sub-key, the ttl of the sub-key is kept.
+func (v *DictionaryCacheEntry) setValue(subKey string, value string) {
+	if prevValue, exists := v.Map[subKey]; exists {
+		v.Bytes -= int64(len(subKey) + len(prevValue))
+	}
+	v.Map[subKey] = value
+	v.Bytes += int64(len(subKey) + len(value))
+}
+
+// setExpiry sets ExpireAfter of the sub-key, Unix time in milliseconds.
+func (v *DictionaryCacheEntry) setExpiry(subKey string, expireAfter int64) {
+	if v.Expiry == nil {
+		v.Expiry = make(map[string]int64)
+	}
+	if _, exists := v.Expiry[subKey]; !exists {
+		v.Bytes += 8
+	}
+	v.Expiry[subKey] = expireAfter
+}
+
+// clearExpiry removes the ttl of the sub-key, so it expires only with the whole dictionary.
+func (v *DictionaryCacheEntry) clearExpiry(subKey string) {
+	if _, exists := v.Expiry[subKey]; exists {
+		delete(v.Expiry, subKey)
+		v.Bytes -= 8
+	}
+}
+
+// deleteValue removes the sub-key with its ttl.
+func (v *DictionaryCacheEntry) deleteValue(subKey string) {
+	if value, exists := v.Map[subKey]; exists {
+		delete(v.Map, subKey)
+		v.Bytes -= int64(len(subKey) + len(value))
+	}
+	v.clearExpiry(subKey)
+}
+
+// removeExpiredValues deletes the sub-keys which ttl had elapsed and returns their number.
+// The version is not changed since the sub-keys are treated as missing from the moment they expired.
+func (v *DictionaryCacheEntry) removeExpiredValues() int {
+	if len(v.Expiry) == 0 {
+		return 0
+	}
+	n := 0
+	now := nowMillis()
+	for k, expireAfter := range v.Expiry {
+		if now > expireAfter {
+			v.deleteValue(k)
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,473 @@
+package cache
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+var (
+	// ErrInvalidDocument is returned when the value is not a valid JSON text.
+	ErrInvalidDocument = errors.New("invalid JSON document")
+	// ErrInvalidPath is returned when the path is malformed or cannot be used by the operation.
+	ErrInvalidPath = errors.New("invalid path")
+	// ErrPathNotFound is returned when there is no value at the path.
+	ErrPathNotFound = errors.New("path was not found")
+	// ErrNotArray is returned when the value at the path is expected to be an array.
+	ErrNotArray = errors.New("value at the path is not an array")
+	// ErrTestFailed is returned when JSON Patch test operation fails.
+	ErrTestFailed = errors.New("test operation failed")
+)
+
+// PatchOperation is JSON Patch (RFC 6902) operation, Value is the parsed JSON value of add, replace and test operations.
+type PatchOperation struct {
+	Op    string
+	Path  string
+	From  string
+	Value interface{}
+}
+
+// ParseDocument parses JSON text keeping the numbers as json.Number, so they are not rounded to float64.
+func ParseDocument(s string) (interface{}, error) {
+	d := json.NewDecoder(strings.NewReader(s))
+	d.UseNumber()
+	var v interface{}
+	if err := d.Decode(&v); err != nil {
+		return nil, ErrInvalidDocument
+	}
+	if _, err := d.Token(); err != io.EOF {
+		return nil, ErrInvalidDocument
+	}
+	return v, nil
+}
+
+// FormatDocument converts the document to JSON text.
+func FormatDocument(v interface{}) string {
+	var b bytes.Buffer
+	e := json.NewEncoder(&b)
+	e.SetEscapeHTML(false)
+	e.Encode(v)
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// ParseDocumentPath splits the path into reference tokens. Both JSON Pointer ("/items/0/name", RFC 6901)
+// and dot notation ("$.items[0].name", "items[0]['name']") are supported, empty path or "$" means the whole document.
+func ParseDocumentPath(path string) ([]string, error) {
+	if path == "" || path == "$" {
+		return []string{}, nil
+	}
+	if strings.HasPrefix(path, "/") {
+		tokens := strings.Split(path[1:], "/")
+		for i, t := range tokens {
+			tokens[i] = strings.Replace(strings.Replace(t, "~1", "/", -1), "~0", "~", -1)
+		}
+		return tokens, nil
+	}
+	return parseDotPath(strings.TrimPrefix(path, "$"))
+}
+
+func parseDotPath(path string) ([]string, error) {
+	tokens := []string{}
+	for i := 0; i < len(path); {
+		switch {
+		case path[i] == '[':
+			end := strings.IndexByte(path[i:], ']')
+			if end < 0 {
+				return nil, ErrInvalidPath
+			}
+			t := path[i+1 : i+end]
+			if len(t) >= 2 && (t[0] == '\'' || t[0] == '"') && t[len(t)-1] == t[0] {
+				t = t[1 : len(t)-1]
+			} else if _, err := strconv.Atoi(t); err != nil {
+				return nil, ErrInvalidPath
+			}
+			tokens = append(tokens, t)
+			i += end + 1
+		default:
+			if path[i] == '.' {
+				i++
+			} else if i > 0 {
+				return nil, ErrInvalidPath
+			}
+			end := strings.IndexAny(path[i:], ".[")
+			if end < 0 {
+				end = len(path) - i
+			}
+			if end == 0 {
+				return nil, ErrInvalidPath
+			}
+			tokens = append(tokens, path[i:i+end])
+			i += end
+		}
+	}
+	return tokens, nil
+}
+
+// getDocumentValue returns the value at the path.
+func getDocumentValue(doc interface{}, tokens []string) (interface{}, error) {
+	v := doc
+	for _, t := range tokens {
+		switch c := v.(type) {
+		case map[string]interface{}:
+			x, ok := c[t]
+			if !ok {
+				return nil, ErrPathNotFound
+			}
+			v = x
+		case []interface{}:
+			i, ok := arrayIndex(t, len(c))
+			if !ok {
+				return nil, ErrPathNotFound
+			}
+			v = c[i]
+		default:
+			return nil, ErrPathNotFound
+		}
+	}
+	return v, nil
+}
+
+// updateDocument calls f with the object or the array which contains the last token of the path and replaces it with the result.
+// The path must not be empty, the containers on the way are changed in place.
+func updateDocument(doc interface{}, tokens []string, f func(container interface{}, token string) (interface{}, error)) (interface{}, error) {
+	if len(tokens) == 1 {
+		return f(doc, tokens[0])
+	}
+	switch c := doc.(type) {
+	case map[string]interface{}:
+		child, ok := c[tokens[0]]
+		if !ok {
+			return nil, ErrPathNotFound
+		}
+		v, err := updateDocument(child, tokens[1:], f)
+		if err != nil {
+			return nil, err
+		}
+		c[tokens[0]] = v
+		return c, nil
+	case []interface{}:
+		i, ok := arrayIndex(tokens[0], len(c))
+		if !ok {
+			return nil, ErrPathNotFound
+		}
+		v, err := updateDocument(c[i], tokens[1:], f)
+		if err != nil {
+			return nil, err
+		}
+		c[i] = v
+		return c, nil
+	}
+	return nil, ErrPathNotFound
+}
+
+// setDocumentValue adds or replaces the object member, replaces the array element or appends it with "-" token.
+// With insert the value is inserted into the array before the index like JSON Patch add operation does.
+func setDocumentValue(doc interface{}, tokens []string, value interface{}, insert bool) (interface{}, error) {
+	if len(tokens) == 0 {
+		return value, nil
+	}
+	return updateDocument(doc, tokens, func(container interface{}, token string) (interface{}, error) {
+		switch c := container.(type) {
+		case map[string]interface{}:
+			c[token] = value
+			return c, nil
+		case []interface{}:
+			if token == "-" {
+				return append(c, value), nil
+			}
+			n := len(c)
+			if insert {
+				n++
+			}
+			i, ok := arrayIndex(token, n)
+			if !ok {
+				return nil, ErrPathNotFound
+			}
+			if !insert {
+				c[i] = value
+				return c, nil
+			}
+			res := make([]interface{}, 0, len(c)+1)
+			res = append(res, c[:i]...)
+			res = append(res, value)
+			return append(res, c[i:]...), nil
+		}
+		return nil, ErrPathNotFound
+	})
+}
+
+// replaceDocumentValue replaces the existing value at the path.
+func replaceDocumentValue(doc interface{}, tokens []string, value interface{}) (interface{}, error) {
+	if _, err := getDocumentValue(doc, tokens); err != nil {
+		return nil, err
+	}
+	return setDocumentValue(doc, tokens, value, false)
+}
+
+// removeDocumentValue removes the object member or the array element, returns the document and the value removed.
+func removeDocumentValue(doc interface{}, tokens []string) (interface{}, interface{}, error) {
+	if len(tokens) == 0 {
+		return nil, nil, ErrInvalidPath
+	}
+	var removed interface{}
+	res, err := updateDocument(doc, tokens, func(container interface{}, token string) (interface{}, error) {
+		switch c := container.(type) {
+		case map[string]interface{}:
+			v, ok := c[token]
+			if !ok {
+				return nil, ErrPathNotFound
+			}
+			removed = v
+			delete(c, token)
+			return c, nil
+		case []interface{}:
+			i, ok := arrayIndex(token, len(c))
+			if !ok {
+				return nil, ErrPathNotFound
+			}
+			removed = c[i]
+			res := make([]interface{}, 0, len(c)-1)
+			res = append(res, c[:i]...)
+			return append(res, c[i+1:]...), nil
+		}
+		return nil, ErrPathNotFound
+	})
+	return res, removed, err
+}
+
+// appendDocumentValues appends the values to the array at the path, returns the document and the new length of the array.
+func appendDocumentValues(doc interface{}, tokens []string, values []interface{}) (interface{}, int, error) {
+	n := 0
+	f := func(v interface{}) (interface{}, error) {
+		a, ok := v.([]interface{})
+		if !ok {
+			return nil, ErrNotArray
+		}
+		a = append(a, values...)
+		n = len(a)
+		return a, nil
+	}
+	if len(tokens) == 0 {
+		res, err := f(doc)
+		return res, n, err
+	}
+	if _, err := getDocumentValue(doc, tokens); err != nil {
+		return nil, 0, err
+	}
+	res, err := updateDocument(doc, tokens, func(container interface{}, token string) (interface{}, error) {
+		switch c := container.(type) {
+		case map[string]interface{}:
+			v, err := f(c[token])
+			if err != nil {
+				return nil, err
+			}
+			c[token] = v
+			return c, nil
+		case []interface{}:
+			i, _ := arrayIndex(token, len(c))
+			v, err := f(c[i])
+			if err != nil {
+				return nil, err
+			}
+			c[i] = v
+			return c, nil
+		}
+		return nil, ErrPathNotFound
+	})
+	return res, n, err
+}
+
+// mergeDocument applies JSON Merge Patch (RFC 7396), null members of the patch remove the members of the document.
+func mergeDocument(doc interface{}, patch interface{}) interface{} {
+	p, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+	d, ok := doc.(map[string]interface{})
+	if !ok {
+		d = make(map[string]interface{}, len(p))
+	}
+	for k, v := range p {
+		if v == nil {
+			delete(d, k)
+		} else {
+			d[k] = mergeDocument(d[k], v)
+		}
+	}
+	return d
+}
+
+// patchDocument applies JSON Patch (RFC 6902) operations to the copy of the document, so the document is not changed if any of them fails.
+func patchDocument(doc interface{}, ops []PatchOperation) (interface{}, error) {
+	res := copyDocument(doc)
+	for i, op := range ops {
+		var err error
+		if res, err = applyPatchOperation(res, op); err != nil {
+			return nil, fmt.Errorf("operation %d (%s %s): %v", i, op.Op, op.Path, err)
+		}
+	}
+	return res, nil
+}
+
+func applyPatchOperation(doc interface{}, op PatchOperation) (interface{}, error) {
+	tokens, err := ParseDocumentPath(op.Path)
+	if err != nil {
+		return nil, err
+	}
+	switch op.Op {
+	case "add":
+		return setDocumentValue(doc, tokens, op.Value, true)
+	case "remove":
+		res, _, err := removeDocumentValue(doc, tokens)
+		return res, err
+	case "replace":
+		return replaceDocumentValue(doc, tokens, op.Value)
+	case "move", "copy":
+		from, err := ParseDocumentPath(op.From)
+		if err != nil {
+			return nil, err
+		}
+		v, err := getDocumentValue(doc, from)
+		if err != nil {
+			return nil, err
+		}
+		if op.Op == "copy" {
+			return setDocumentValue(doc, tokens, copyDocument(v), true)
+		}
+		if isPathPrefix(from, tokens) {
+			if len(from) == len(tokens) {
+				return doc, nil
+			}
+			return nil, ErrInvalidPath
+		}
+		if doc, _, err = removeDocumentValue(doc, from); err != nil {
+			return nil, err
+		}
+		return setDocumentValue(doc, tokens, v, true)
+	case "test":
+		v, err := getDocumentValue(doc, tokens)
+		if err != nil {
+			return nil, err
+		}
+		if !equalDocuments(v, op.Value) {
+			return nil, ErrTestFailed
+		}
+		return doc, nil
+	}
+	return nil, fmt.Errorf("unknown operation '%s'", op.Op)
+}
+
+// copyDocument returns a deep copy of the document.
+func copyDocument(doc interface{}) interface{} {
+	switch c := doc.(type) {
+	case map[string]interface{}:
+		res := make(map[string]interface{}, len(c))
+		for k, v := range c {
+			res[k] = copyDocument(v)
+		}
+		return res
+	case []interface{}:
+		res := make([]interface{}, len(c))
+		for i, v := range c {
+			res[i] = copyDocument(v)
+		}
+		return res
+	}
+	return doc
+}
+
+// equalDocuments compares JSON values, numbers are compared by their values, so 1 is equal to 1.0.
+func equalDocuments(a interface{}, b interface{}) bool {
+	switch x := a.(type) {
+	case map[string]interface{}:
+		y, ok := b.(map[string]interface{})
+		if !ok || len(x) != len(y) {
+			return false
+		}
+		for k, v := range x {
+			w, ok := y[k]
+			if !ok || !equalDocuments(v, w) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		y, ok := b.([]interface{})
+		if !ok || len(x) != len(y) {
+			return false
+		}
+		for i := range x {
+			if !equalDocuments(x[i], y[i]) {
+				return false
+			}
+		}
+		return true
+	case json.Number:
+		y, ok := b.(json.Number)
+		if !ok {
+			return false
+		}
+		fx, okx := new(big.Float).SetString(string(x))
+		fy, oky := new(big.Float).SetString(string(y))
+		return okx && oky && fx.Cmp(fy) == 0
+	}
+	return a == b
+}
+
+// documentSize returns approximate memory used by the document.
+func documentSize(doc interface{}) int {
+	switch c := doc.(type) {
+	case map[string]interface{}:
+		n := 0
+		for k, v := range c {
+			n += len(k) + documentSize(v)
+		}
+		return n
+	case []interface{}:
+		n := 0
+		for _, v := range c {
+			n += documentSize(v)
+		}
+		return n
+	case string:
+		return len(c)
+	case json.Number:
+		return len(c)
+	}
+	return 8
+}
+
+// arrayIndex parses the array index token, the index must be less than n.
+// RFC 6901 allows only "0" or digits without leading zeros, so signs like "+1" or "-0" are rejected.
+func arrayIndex(token string, n int) (int, bool) {
+	if token == "" || (len(token) > 1 && token[0] == '0') {
+		return 0, false
+	}
+	for _, r := range token {
+		if r < '0' || r > '9' {
+			return 0, false
+		}
+	}
+	i, err := strconv.Atoi(token)
+	if err != nil || i < 0 || i >= n {
+		return 0, false
+	}
+	return i, true
+}
+
+func isPathPrefix(prefix []string, tokens []string) bool {
+	if len(prefix) > len(tokens) {
+		return false
+	}
+	for i, t := range prefix {
+		if tokens[i] != t {
+			return false
+		}
+	}
+	return true
+}
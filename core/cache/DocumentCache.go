@@ -0,0 +1,280 @@
+package cache
+
+import (
+	"log"
+	"time"
+)
+
+// DocumentCacheEntry is a parsed JSON document stored in the memory cache.
+// Objects are map[string]interface{}, arrays are []interface{} and numbers are json.Number.
+type DocumentCacheEntry struct {
+	Value interface{}
+	CacheEntryData
+}
+
+// IDocumentCache is an interface for DocumentCache.
+type IDocumentCache interface {
+	TryGet(key string) (bool, interface{})
+	TryAdd(key string, doc interface{}, ttl time.Duration) bool
+	TryDelete(key string) (bool, interface{})
+	TryGetPath(key string, path string) (bool, interface{}, error)
+	TrySetPath(key string, path string, value interface{}) (bool, error)
+	TryDeletePath(key string, path string) (bool, interface{}, error)
+	TryAppend(key string, path string, values []interface{}) (bool, int, error)
+	TryMerge(key string, patch interface{}) (bool, interface{})
+	TryPatch(key string, ops []PatchOperation) (bool, interface{}, error)
+	TryGetMetadata(key string) (bool, CacheEntryData, int64)
+	TrySetTTL(key string, ttl time.Duration, sliding bool) bool
+	GetKeys() []string
+	GetStats() CacheStats
+	RemoveExpired(budget time.Duration) int
+}
+
+// IDocumentCachePersistence is an interface for persisting DocumentCache.
+type IDocumentCachePersistence interface {
+	TryGetSnapshot(key string) (bool, DocumentCacheEntry)
+	TryAddFromSnapshot(key string, entry DocumentCacheEntry) bool
+}
+
+// DocumentCache is a single-thread in-memory cache based on map[string]DocumentCacheEntry.
+type DocumentCache struct {
+	Map     map[string]DocumentCacheEntry
+	Evictor *Evictor
+	Reaped  int64
+}
+
+// TryGet returns the document if contains the key specified.
+func (c *DocumentCache) TryGet(key string) (bool, interface{}) {
+	v, ok := c.getValueWithExpiration(key)
+	return ok, v.Value
+}
+
+// TryGetSnapshot returns the value if contains the key specified.
+func (c *DocumentCache) TryGetSnapshot(key string) (bool, DocumentCacheEntry) {
+	v, ok := c.peek(key)
+	return ok, v
+}
+
+// TryGetMetadata returns timestamps, expiration, version and size in bytes of the entry if contains the key specified.
+// The entry is not marked as used and sliding ttl is not renewed.
+func (c *DocumentCache) TryGetMetadata(key string) (bool, CacheEntryData, int64) {
+	v, ok := c.peek(key)
+	return ok, v.CacheEntryData, v.size(key)
+}
+
+// TrySetTTL sets new ttl for the existing key keeping its value and version, zero ttl makes the key permanent.
+// Sliding ttl is renewed each time the key is used.
+func (c *DocumentCache) TrySetTTL(key string, ttl time.Duration, sliding bool) bool {
+	v, ok := c.peek(key)
+	if ok {
+		v.setTTL(ttl, sliding)
+		c.store(key, v)
+	}
+	return ok
+}
+
+// TryAdd add new document to the cache by the key specified if the key is not already used.
+func (c *DocumentCache) TryAdd(key string, doc interface{}, ttl time.Duration) bool {
+	_, ok := c.getValueWithExpiration(key)
+	if !ok {
+		c.store(key, DocumentCacheEntry{Value: doc, CacheEntryData: NewCacheEntryData(ttl)})
+	}
+	return !ok
+}
+
+// TryAddFromSnapshot add new value to the cache by the key specified if the key is not already used.
+func (c *DocumentCache) TryAddFromSnapshot(key string, entry DocumentCacheEntry) bool {
+	_, ok := c.Map[key]
+	if !ok {
+		entry.ExpireAfter = normalizeExpiration(entry.ExpireAfter)
+		c.store(key, entry)
+		observeVersion(entry.Version)
+	}
+	return !ok
+}
+
+// TryDelete deletes the document by the key specified if the key is already used.
+func (c *DocumentCache) TryDelete(key string) (bool, interface{}) {
+	v, ok := c.getValueWithExpiration(key)
+	if ok {
+		c.remove(key)
+	}
+	return ok, v.Value
+}
+
+// TryGetPath returns the value at the path of the document, see ParseDocumentPath for the path formats supported.
+func (c *DocumentCache) TryGetPath(key string, path string) (bool, interface{}, error) {
+	tokens, err := ParseDocumentPath(path)
+	if err != nil {
+		return false, nil, err
+	}
+	v, ok := c.getValueWithExpiration(key)
+	if !ok {
+		return false, nil, nil
+	}
+	value, err := getDocumentValue(v.Value, tokens)
+	return true, value, err
+}
+
+// TrySetPath adds or replaces the value at the path, the parent object or array must exist.
+// "-" as the last array index appends the value, empty path replaces the whole document.
+func (c *DocumentCache) TrySetPath(key string, path string, value interface{}) (bool, error) {
+	tokens, err := ParseDocumentPath(path)
+	if err != nil {
+		return false, err
+	}
+	return c.update(key, func(doc interface{}) (interface{}, error) {
+		return setDocumentValue(doc, tokens, value, false)
+	})
+}
+
+// TryDeletePath removes the value at the path and returns it, the whole document cannot be removed this way.
+func (c *DocumentCache) TryDeletePath(key string, path string) (bool, interface{}, error) {
+	tokens, err := ParseDocumentPath(path)
+	if err != nil {
+		return false, nil, err
+	}
+	var removed interface{}
+	ok, err := c.update(key, func(doc interface{}) (interface{}, error) {
+		res, v, err := removeDocumentValue(doc, tokens)
+		removed = v
+		return res, err
+	})
+	return ok, removed, err
+}
+
+// TryAppend appends the values to the array at the path and returns the new length of the array.
+func (c *DocumentCache) TryAppend(key string, path string, values []interface{}) (bool, int, error) {
+	tokens, err := ParseDocumentPath(path)
+	if err != nil {
+		return false, 0, err
+	}
+	n := 0
+	ok, err := c.update(key, func(doc interface{}) (interface{}, error) {
+		res, length, err := appendDocumentValues(doc, tokens, values)
+		n = length
+		return res, err
+	})
+	return ok, n, err
+}
+
+// TryMerge applies JSON Merge Patch (RFC 7396) to the document and returns the result.
+func (c *DocumentCache) TryMerge(key string, patch interface{}) (bool, interface{}) {
+	var res interface{}
+	ok, _ := c.update(key, func(doc interface{}) (interface{}, error) {
+		res = mergeDocument(doc, patch)
+		return res, nil
+	})
+	return ok, res
+}
+
+// TryPatch applies JSON Patch (RFC 6902) operations to the document and returns the result.
+// The operations are applied all or nothing, the document is not changed if any of them fails.
+func (c *DocumentCache) TryPatch(key string, ops []PatchOperation) (bool, interface{}, error) {
+	var res interface{}
+	ok, err := c.update(key, func(doc interface{}) (interface{}, error) {
+		v, err := patchDocument(doc, ops)
+		res = v
+		return v, err
+	})
+	return ok, res, err
+}
+
+// GetKeys returns all the keys in the map.
+func (c *DocumentCache) GetKeys() []string {
+	var keySlice []string
+	for key, v := range c.Map {
+		if !IsCacheEntryExpired(v.CacheEntryData) {
+			keySlice = append(keySlice, key)
+		}
+	}
+	if keySlice == nil {
+		return make([]string, 0)
+	}
+	return keySlice
+}
+
+// GetStats returns cache usage counters.
+func (c *DocumentCache) GetStats() CacheStats {
+	stats := c.Evictor.Stats()
+	stats.Reaped = c.Reaped
+	return stats
+}
+
+// RemoveExpired deletes expired entries until all the keys are checked or the time budget is spent.
+// Go maps are iterated in random order, so the next sweep starts from another key.
+func (c *DocumentCache) RemoveExpired(budget time.Duration) int {
+	started := time.Now()
+	n, checked := 0, 0
+	for key, v := range c.Map {
+		if IsCacheEntryExpired(v.CacheEntryData) {
+			c.remove(key)
+			n++
+		}
+		checked++
+		if isOverBudget(started, budget, checked) {
+			break
+		}
+	}
+	c.Reaped += int64(n)
+	return n
+}
+
+// update replaces the document with the result of f, the version is not changed if f fails.
+func (c *DocumentCache) update(key string, f func(doc interface{}) (interface{}, error)) (bool, error) {
+	v, ok := c.getValueWithExpiration(key)
+	if !ok {
+		return false, nil
+	}
+	doc, err := f(v.Value)
+	if err != nil {
+		return true, err
+	}
+	c.store(key, DocumentCacheEntry{Value: doc, CacheEntryData: UpdateCacheEntryData(v.CacheEntryData)})
+	return true, nil
+}
+
+func (c *DocumentCache) getValueWithExpiration(key string) (DocumentCacheEntry, bool) {
+	v, ok := c.peek(key)
+	if ok {
+		c.Evictor.Touch(key)
+		if v.slide() {
+			c.store(key, v)
+		}
+	}
+	return v, ok
+}
+
+// peek returns the entry removing it if expired, the entry is not marked as used.
+func (c *DocumentCache) peek(key string) (DocumentCacheEntry, bool) {
+	v, ok := c.Map[key]
+	if ok {
+		if IsCacheEntryExpired(v.CacheEntryData) {
+			c.remove(key)
+			log.Printf("[DocumentCache] key %s had expired and was removed", key)
+			return v, false // expired
+		}
+		return v, ok // not expired
+	}
+	return v, ok
+}
+
+// store puts the entry to the map and evicts other keys if the cache is over its limits.
+func (c *DocumentCache) store(key string, entry DocumentCacheEntry) {
+	c.Map[key] = entry
+	c.Evictor.Track(key, entry.size(key), entry.ExpireAfter)
+	for _, k := range c.Evictor.Evict(key) {
+		delete(c.Map, k)
+		log.Printf("[DocumentCache] key %s was evicted", k)
+	}
+}
+
+func (c *DocumentCache) remove(key string) {
+	delete(c.Map, key)
+	c.Evictor.Remove(key)
+}
+
+// size returns approximate memory used by the entry.
+func (v DocumentCacheEntry) size(key string) int64 {
+	return int64(len(key) + documentSize(v.Value))
+}
@@ -0,0 +1,165 @@
+package cache
+
+import (
+	"testing"
+)
+
+// parseTestPatch converts JSON Patch text to the operations, unknown members of the operations are ignored.
+func parseTestPatch(t *testing.T, s string) []PatchOperation {
+	v, err := ParseDocument(s)
+	if err != nil {
+		t.Fatalf("ParseDocument(%s): %v", s, err)
+	}
+	var ops []PatchOperation
+	for _, o := range v.([]interface{}) {
+		m := o.(map[string]interface{})
+		op := PatchOperation{Value: m["value"]}
+		op.Op, _ = m["op"].(string)
+		op.Path, _ = m["path"].(string)
+		op.From, _ = m["from"].(string)
+		ops = append(ops, op)
+	}
+	return ops
+}
+
+func TestPatchDocument(t *testing.T) {
+	tests := []struct {
+		name  string
+		doc   string
+		patch string
+		want  string // empty means the patch must fail
+	}{
+		// RFC 6902 Appendix A
+		{"A.1 add object member", `{"foo":"bar"}`,
+			`[{"op":"add","path":"/baz","value":"qux"}]`,
+			`{"baz":"qux","foo":"bar"}`},
+		{"A.2 add array element", `{"foo":["bar","baz"]}`,
+			`[{"op":"add","path":"/foo/1","value":"qux"}]`,
+			`{"foo":["bar","qux","baz"]}`},
+		{"A.3 remove object member", `{"baz":"qux","foo":"bar"}`,
+			`[{"op":"remove","path":"/baz"}]`,
+			`{"foo":"bar"}`},
+		{"A.4 remove array element", `{"foo":["bar","qux","baz"]}`,
+			`[{"op":"remove","path":"/foo/1"}]`,
+			`{"foo":["bar","baz"]}`},
+		{"A.5 replace value", `{"baz":"qux","foo":"bar"}`,
+			`[{"op":"replace","path":"/baz","value":"boo"}]`,
+			`{"baz":"boo","foo":"bar"}`},
+		{"A.6 move value", `{"foo":{"bar":"baz","waldo":"fred"},"qux":{"corge":"grault"}}`,
+			`[{"op":"move","from":"/foo/waldo","path":"/qux/thud"}]`,
+			`{"foo":{"bar":"baz"},"qux":{"corge":"grault","thud":"fred"}}`},
+		{"A.7 move array element", `{"foo":["all","grass","cows","eat"]}`,
+			`[{"op":"move","from":"/foo/1","path":"/foo/3"}]`,
+			`{"foo":["all","cows","eat","grass"]}`},
+		{"A.8 test success", `{"baz":"qux","foo":["a",2,"c"]}`,
+			`[{"op":"test","path":"/baz","value":"qux"},{"op":"test","path":"/foo/1","value":2}]`,
+			`{"baz":"qux","foo":["a",2,"c"]}`},
+		{"A.9 test error", `{"baz":"qux"}`,
+			`[{"op":"test","path":"/baz","value":"bar"}]`,
+			``},
+		{"A.10 add nested member object", `{"foo":"bar"}`,
+			`[{"op":"add","path":"/child","value":{"grandchild":{}}}]`,
+			`{"foo":"bar","child":{"grandchild":{}}}`},
+		{"A.11 ignore unrecognized elements", `{"foo":"bar"}`,
+			`[{"op":"add","path":"/baz","value":"qux","xyz":123}]`,
+			`{"foo":"bar","baz":"qux"}`},
+		{"A.12 add to nonexistent target", `{"foo":"bar"}`,
+			`[{"op":"add","path":"/baz/bat","value":"qux"}]`,
+			``},
+		{"A.14 escape ordering", `{"/":9,"~1":10}`,
+			`[{"op":"test","path":"/~01","value":10}]`,
+			`{"/":9,"~1":10}`},
+		{"A.15 compare strings and numbers", `{"/":9,"~1":10}`,
+			`[{"op":"test","path":"/~01","value":"10"}]`,
+			``},
+		{"A.16 add array value", `{"foo":["bar"]}`,
+			`[{"op":"add","path":"/foo/-","value":["abc","def"]}]`,
+			`{"foo":["bar",["abc","def"]]}`},
+		// edge cases
+		{"add to the end by index", `{"foo":[1]}`,
+			`[{"op":"add","path":"/foo/1","value":2}]`,
+			`{"foo":[1,2]}`},
+		{"add past the end", `{"foo":[1]}`,
+			`[{"op":"add","path":"/foo/2","value":2}]`,
+			``},
+		{"index with leading zero", `{"foo":[1,2]}`,
+			`[{"op":"replace","path":"/foo/01","value":3}]`,
+			``},
+		{"index with sign", `{"foo":[1,2]}`,
+			`[{"op":"remove","path":"/foo/+1"}]`,
+			``},
+		{"replace missing member", `{"foo":"bar"}`,
+			`[{"op":"replace","path":"/baz","value":"qux"}]`,
+			``},
+		{"remove missing member", `{"foo":"bar"}`,
+			`[{"op":"remove","path":"/baz"}]`,
+			``},
+		{"replace whole document", `{"foo":"bar"}`,
+			`[{"op":"replace","path":"","value":[1]}]`,
+			`[1]`},
+		{"copy is deep", `{"foo":{"a":1}}`,
+			`[{"op":"copy","from":"/foo","path":"/bar"},{"op":"replace","path":"/bar/a","value":2}]`,
+			`{"foo":{"a":1},"bar":{"a":2}}`},
+		{"move to itself", `{"foo":{"a":1}}`,
+			`[{"op":"move","from":"/foo","path":"/foo"}]`,
+			`{"foo":{"a":1}}`},
+		{"move to own child", `{"foo":{"a":1}}`,
+			`[{"op":"move","from":"/foo","path":"/foo/b"}]`,
+			``},
+		{"test numbers by value", `{"foo":1}`,
+			`[{"op":"test","path":"/foo","value":1.0}]`,
+			`{"foo":1}`},
+		{"unknown operation", `{"foo":1}`,
+			`[{"op":"merge","path":"/foo","value":2}]`,
+			``},
+		{"failed operation undoes previous ones", `{"foo":1}`,
+			`[{"op":"add","path":"/bar","value":2},{"op":"test","path":"/foo","value":2}]`,
+			``},
+	}
+	for _, tt := range tests {
+		doc, err := ParseDocument(tt.doc)
+		if err != nil {
+			t.Fatalf("%s: ParseDocument: %v", tt.name, err)
+		}
+		got, err := patchDocument(doc, parseTestPatch(t, tt.patch))
+		if tt.want == "" {
+			if err == nil {
+				t.Errorf("%s: patch succeeded with %s, want error", tt.name, FormatDocument(got))
+			}
+		} else if want, _ := ParseDocument(tt.want); err != nil || !equalDocuments(got, want) {
+			t.Errorf("%s: got %s, %v, want %s", tt.name, FormatDocument(got), err, tt.want)
+		}
+		if original, _ := ParseDocument(tt.doc); !equalDocuments(doc, original) {
+			t.Errorf("%s: the original document was changed to %s", tt.name, FormatDocument(doc))
+		}
+	}
+}
+
+func TestParseDocumentPath(t *testing.T) {
+	tests := []struct {
+		path string
+		want []string
+	}{
+		{"", []string{}},
+		{"$", []string{}},
+		{"/", []string{""}},
+		{"/foo/0", []string{"foo", "0"}},
+		{"/a~1b/m~0n", []string{"a/b", "m~n"}},
+		{"/~01", []string{"~1"}},
+		{"$.items[0].name", []string{"items", "0", "name"}},
+		{"items[0]['name']", []string{"items", "0", "name"}},
+	}
+	for _, tt := range tests {
+		got, err := ParseDocumentPath(tt.path)
+		if err != nil || len(got) != len(tt.want) {
+			t.Errorf("ParseDocumentPath(%q) = %q, %v, want %q", tt.path, got, err, tt.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != tt.want[i] {
+				t.Errorf("ParseDocumentPath(%q) = %q, want %q", tt.path, got, tt.want)
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,193 @@
+package cache
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// EvictionPolicy defines which keys are evicted when the cache is over its limits.
+type EvictionPolicy int
+
+const (
+	// LRUEviction evicts the least recently used keys.
+	LRUEviction EvictionPolicy = iota
+	// LFUEviction evicts the least frequently used keys.
+	LFUEviction
+	// RandomEviction evicts random keys.
+	RandomEviction
+	// VolatileTTLEviction evicts only the keys with ttl, the ones which expire sooner go first.
+	VolatileTTLEviction
+	// NoEviction never evicts keys, so the limits can be exceeded.
+	NoEviction
+)
+
+// evictionSamples is the number of keys compared to choose the next key to evict.
+const evictionSamples = 16
+
+var evictionPolicyNames = map[EvictionPolicy]string{
+	LRUEviction:         "lru",
+	LFUEviction:         "lfu",
+	RandomEviction:      "random",
+	VolatileTTLEviction: "volatile-ttl",
+	NoEviction:          "noeviction",
+}
+
+func (p EvictionPolicy) String() string {
+	return evictionPolicyNames[p]
+}
+
+// ParseEvictionPolicy converts policy name (lru, lfu, random, volatile-ttl or noeviction) to EvictionPolicy.
+func ParseEvictionPolicy(s string) (EvictionPolicy, error) {
+	for p, name := range evictionPolicyNames {
+		if strings.EqualFold(s, name) {
+			return p, nil
+		}
+	}
+	return LRUEviction, fmt.Errorf("unknown eviction policy '%s'", s)
+}
+
+// EvictionOptions holds memory limits of a single cache actor, zero limit means no limit.
+type EvictionOptions struct {
+	MaxEntries int
+	MaxBytes   int64
+	Policy     EvictionPolicy
+}
+
+// WithoutEviction returns the same limits with NoEviction policy for the caches whose entries must not be lost while they are alive.
+func (o EvictionOptions) WithoutEviction() EvictionOptions {
+	o.Policy = NoEviction
+	return o
+}
+
+// CacheStats holds cache usage counters.
+type CacheStats struct {
+	Entries int64
+	Bytes   int64
+	Evicted int64
+	Reaped  int64
+}
+
+type evictionEntry struct {
+	size        int64
+	expireAfter int64
+	accessed    int64
+	hits        int64
+}
+
+// Evictor tracks cache keys usage and chooses the keys to evict when the cache is over its limits.
+// The keys are chosen from a small sample like Redis does, so the eviction is approximate.
+type Evictor struct {
+	Options EvictionOptions
+	Evicted int64
+	bytes   int64
+	entries map[string]*evictionEntry
+}
+
+// NewEvictor creates new Evictor with the limits specified.
+func NewEvictor(options EvictionOptions) *Evictor {
+	return &Evictor{Options: options, entries: make(map[string]*evictionEntry)}
+}
+
+// Touch marks the key as used.
+func (e *Evictor) Touch(key string) {
+	if e == nil {
+		return
+	}
+	if v, ok := e.entries[key]; ok {
+		v.accessed = time.Now().UnixNano()
+		v.hits++
+	}
+}
+
+// Track adds new key or updates the size and expiration of the existing one.
+func (e *Evictor) Track(key string, size int64, expireAfter int64) {
+	if e == nil {
+		return
+	}
+	v, ok := e.entries[key]
+	if !ok {
+		v = &evictionEntry{hits: 1}
+		e.entries[key] = v
+	}
+	e.bytes += size - v.size
+	v.size = size
+	v.expireAfter = expireAfter
+	v.accessed = time.Now().UnixNano()
+}
+
+// Remove stops tracking the key.
+func (e *Evictor) Remove(key string) {
+	if e == nil {
+		return
+	}
+	if v, ok := e.entries[key]; ok {
+		e.bytes -= v.size
+		delete(e.entries, key)
+	}
+}
+
+// Evict returns the keys which should be removed from the cache to fit its limits.
+// The key specified is never evicted, so the value which was just written stays in the cache.
+func (e *Evictor) Evict(key string) []string {
+	if e == nil {
+		return nil
+	}
+	var evicted []string
+	for e.isOverLimit() {
+		victim, ok := e.chooseVictim(key)
+		if !ok {
+			break
+		}
+		e.Remove(victim)
+		e.Evicted++
+		evicted = append(evicted, victim)
+	}
+	return evicted
+}
+
+// Stats returns the number of keys, their size in bytes and the number of evicted keys.
+func (e *Evictor) Stats() CacheStats {
+	if e == nil {
+		return CacheStats{}
+	}
+	return CacheStats{Entries: int64(len(e.entries)), Bytes: e.bytes, Evicted: e.Evicted}
+}
+
+func (e *Evictor) isOverLimit() bool {
+	return (e.Options.MaxEntries > 0 && len(e.entries) > e.Options.MaxEntries) ||
+		(e.Options.MaxBytes > 0 && e.bytes > e.Options.MaxBytes)
+}
+
+func (e *Evictor) chooseVictim(key string) (string, bool) {
+	if e.Options.Policy == NoEviction {
+		return "", false
+	}
+	var victim string
+	var best *evictionEntry
+	n := 0
+	for k, v := range e.entries {
+		if k == key || (e.Options.Policy == VolatileTTLEviction && v.expireAfter == 0) {
+			continue
+		}
+		if best == nil || e.isBetterVictim(v, best) {
+			victim, best = k, v
+		}
+		n++
+		if n == evictionSamples || e.Options.Policy == RandomEviction {
+			break
+		}
+	}
+	return victim, best != nil
+}
+
+func (e *Evictor) isBetterVictim(v *evictionEntry, best *evictionEntry) bool {
+	switch e.Options.Policy {
+	case LFUEviction:
+		return v.hits < best.hits || (v.hits == best.hits && v.accessed < best.accessed)
+	case VolatileTTLEviction:
+		return v.expireAfter < best.expireAfter
+	default:
+		return v.accessed < best.accessed
+	}
+}
@@ -0,0 +1,269 @@
+package cache
+
+import (
+	"errors"
+	"math"
+	"sort"
+)
+
+const (
+	// GeoLongitudeMin is the smallest longitude which can be indexed.
+	GeoLongitudeMin = -180.0
+	// GeoLongitudeMax is the greatest longitude which can be indexed.
+	GeoLongitudeMax = 180.0
+	// GeoLatitudeMin is the smallest latitude which can be indexed, the same as Web Mercator limits used by Redis.
+	GeoLatitudeMin = -85.05112878
+	// GeoLatitudeMax is the greatest latitude which can be indexed.
+	GeoLatitudeMax = 85.05112878
+
+	geoStep         = 26 // bits per coordinate, 52 bits in total fit into float64 score like Redis
+	geoEarthRadius  = 6372797.560856
+	geohashAlphabet = "0123456789bcdefghjkmnpqrstuvwxyz"
+)
+
+var (
+	// ErrInvalidGeoPosition is returned when the longitude or the latitude is out of the range which can be indexed.
+	ErrInvalidGeoPosition = errors.New("invalid longitude or latitude")
+	// ErrInvalidGeoUnit is returned when the distance unit is not m, km, mi or ft.
+	ErrInvalidGeoUnit = errors.New("unsupported unit, must be m, km, mi or ft")
+	// ErrGeoMemberNotFound is returned when the member used as the center of the search does not exist.
+	ErrGeoMemberNotFound = errors.New("member was not found")
+)
+
+// GeoPosition is a member with its longitude and latitude in degrees.
+type GeoPosition struct {
+	Member    string
+	Longitude float64
+	Latitude  float64
+}
+
+// GeoResult is a member found by the search with its position decoded from the geohash and the distance in meters.
+type GeoResult struct {
+	Member    string
+	Longitude float64
+	Latitude  float64
+	Distance  float64
+	Hash      uint64
+}
+
+// GeoQuery is a search by radius or by box, the center is the member or the position specified.
+// Width and height define the box if set, otherwise the radius is used. Distances are in meters.
+// Results are sorted by distance, zero count means all the members found.
+type GeoQuery struct {
+	Member     string
+	Longitude  float64
+	Latitude   float64
+	Radius     float64
+	Width      float64
+	Height     float64
+	Count      int
+	Descending bool
+}
+
+// GeoUnitFactor returns the number of meters in the unit, empty unit means meters.
+func GeoUnitFactor(unit string) (float64, error) {
+	switch unit {
+	case "", "m":
+		return 1, nil
+	case "km":
+		return 1000, nil
+	case "mi":
+		return 1609.34, nil
+	case "ft":
+		return 0.3048, nil
+	}
+	return 0, ErrInvalidGeoUnit
+}
+
+// EncodeGeohash interleaves 26 bits of the longitude and 26 bits of the latitude into 52-bit geohash.
+func EncodeGeohash(longitude float64, latitude float64) (uint64, error) {
+	if math.IsNaN(longitude) || math.IsNaN(latitude) ||
+		longitude < GeoLongitudeMin || longitude > GeoLongitudeMax ||
+		latitude < GeoLatitudeMin || latitude > GeoLatitudeMax {
+		return 0, ErrInvalidGeoPosition
+	}
+	lon := geoQuantize(longitude, GeoLongitudeMin, GeoLongitudeMax)
+	lat := geoQuantize(latitude, GeoLatitudeMin, GeoLatitudeMax)
+	return geoInterleave(lon, lat, geoStep), nil
+}
+
+// DecodeGeohash returns the longitude and the latitude of the center of the geohash cell.
+func DecodeGeohash(hash uint64) (float64, float64) {
+	var lon, lat uint64
+	for i := geoStep - 1; i >= 0; i-- {
+		lon = lon<<1 | hash>>uint(2*i+1)&1
+		lat = lat<<1 | hash>>uint(2*i)&1
+	}
+	return geoDequantize(lon, GeoLongitudeMin, GeoLongitudeMax), geoDequantize(lat, GeoLatitudeMin, GeoLatitudeMax)
+}
+
+// GeohashString returns 11-character standard geohash of the position like GEOHASH.
+// Standard geohash uses [-90, 90] latitude range, so it is computed from the decoded position.
+// Only 52 bits are stored, so the last character is always "0" like in Redis.
+func GeohashString(hash uint64) string {
+	longitude, latitude := DecodeGeohash(hash)
+	lonMin, lonMax, latMin, latMax := GeoLongitudeMin, GeoLongitudeMax, -90.0, 90.0
+	res := []byte("00000000000")
+	even := true
+	for i := 0; i < len(res)-1; i++ {
+		var idx byte
+		for b := 0; b < 5; b++ {
+			idx <<= 1
+			if even {
+				if mid := (lonMin + lonMax) / 2; longitude >= mid {
+					idx |= 1
+					lonMin = mid
+				} else {
+					lonMax = mid
+				}
+			} else {
+				if mid := (latMin + latMax) / 2; latitude >= mid {
+					idx |= 1
+					latMin = mid
+				} else {
+					latMax = mid
+				}
+			}
+			even = !even
+		}
+		res[i] = geohashAlphabet[idx]
+	}
+	return string(res)
+}
+
+// GeoDistance returns the great-circle distance in meters between two positions using haversine formula.
+func GeoDistance(lon1 float64, lat1 float64, lon2 float64, lat2 float64) float64 {
+	phi1, phi2 := lat1*math.Pi/180, lat2*math.Pi/180
+	u := math.Sin((phi2 - phi1) / 2)
+	v := math.Sin((lon2 - lon1) * math.Pi / 180 / 2)
+	a := u*u + math.Cos(phi1)*math.Cos(phi2)*v*v
+	return 2 * geoEarthRadius * math.Asin(math.Sqrt(a))
+}
+
+// SearchGeo returns the members within the radius or the box around the center sorted by distance.
+// The index keeps the members ordered by geohash like GEOSEARCH, so only the geohash cell of the center and its 8 neighbours
+// are scanned. The smallest cells which cover the area are used, the distances are computed from the positions decoded from the geohashes.
+func SearchGeo(index *SkipList, longitude float64, latitude float64, q GeoQuery) []GeoResult {
+	res := make([]GeoResult, 0)
+	box := q.Width > 0 || q.Height > 0
+	for _, r := range geoSearchRanges(longitude, latitude, q) {
+		for _, sm := range index.RangeByScore(r, 0, 0, false) {
+			hash := uint64(sm.Score)
+			lon, lat := DecodeGeohash(hash)
+			d := GeoDistance(longitude, latitude, lon, lat)
+			if box {
+				// distance along the meridian of the center and along the parallel of the member like GEOSEARCH BYBOX
+				if GeoDistance(longitude, latitude, longitude, lat) > q.Height/2 || GeoDistance(longitude, lat, lon, lat) > q.Width/2 {
+					continue
+				}
+			} else if d > q.Radius {
+				continue
+			}
+			res = append(res, GeoResult{Member: sm.Member, Longitude: lon, Latitude: lat, Distance: d, Hash: hash})
+		}
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Distance != res[j].Distance {
+			return (res[i].Distance < res[j].Distance) != q.Descending
+		}
+		return res[i].Member < res[j].Member
+	})
+	if q.Count > 0 && len(res) > q.Count {
+		res = res[:q.Count]
+	}
+	return res
+}
+
+// geoSearchRanges returns the geohash ranges of the center cell and its 8 neighbours, each cell is scanned once.
+// The step is decreased until 3x3 cells around the center cover the bounding box of the area, one bit per coordinate covers the whole map.
+func geoSearchRanges(longitude float64, latitude float64, q GeoQuery) []ScoreRange {
+	lonDelta, latDelta := geoSearchDeltas(latitude, q)
+	lonIdx := geoQuantize(longitude, GeoLongitudeMin, GeoLongitudeMax)
+	latIdx := geoQuantize(latitude, GeoLatitudeMin, GeoLatitudeMax)
+	step := uint(geoStep)
+	for ; step > 1; step-- {
+		if geoCellsCover(lonIdx>>(geoStep-step), longitude, lonDelta, GeoLongitudeMin, GeoLongitudeMax, step, false) &&
+			geoCellsCover(latIdx>>(geoStep-step), latitude, latDelta, GeoLatitudeMin, GeoLatitudeMax, step, true) {
+			break
+		}
+	}
+	cells := uint64(1) << step
+	shift := 2 * (geoStep - step)
+	seen := make(map[uint64]bool, 9)
+	ranges := make([]ScoreRange, 0, 9)
+	for dy := -1; dy <= 1; dy++ {
+		y := int64(latIdx>>(geoStep-step)) + int64(dy)
+		if y < 0 || y >= int64(cells) {
+			continue // no cells beyond the poles
+		}
+		for dx := -1; dx <= 1; dx++ {
+			x := (int64(lonIdx>>(geoStep-step)) + int64(dx) + int64(cells)) % int64(cells) // longitude wraps around
+			cell := geoInterleave(uint64(x), uint64(y), step)
+			if seen[cell] {
+				continue
+			}
+			seen[cell] = true
+			ranges = append(ranges, ScoreRange{Min: float64(cell << shift), Max: float64((cell+1)<<shift - 1)})
+		}
+	}
+	return ranges
+}
+
+// geoSearchDeltas returns the half-sizes in degrees of the bounding box of the area, 180 degrees of longitude means all the longitudes.
+func geoSearchDeltas(latitude float64, q GeoQuery) (float64, float64) {
+	if q.Width > 0 || q.Height > 0 {
+		latDelta := q.Height / 2 / geoEarthRadius * 180 / math.Pi
+		// the parallel distance is the smallest at the edge of the box which is the nearest to the pole
+		maxLatitude := math.Abs(latitude) + latDelta
+		if maxLatitude >= 90 || q.Width/4/geoEarthRadius >= math.Pi/2 {
+			return 180, latDelta
+		}
+		s := math.Sin(q.Width/4/geoEarthRadius) / math.Cos(maxLatitude*math.Pi/180)
+		if s >= 1 {
+			return 180, latDelta
+		}
+		return 2 * math.Asin(s) * 180 / math.Pi, latDelta
+	}
+	angle := q.Radius / geoEarthRadius
+	latDelta := angle * 180 / math.Pi
+	if math.Abs(latitude)+latDelta >= 90 {
+		return 180, latDelta // the circle contains the pole
+	}
+	return math.Asin(math.Sin(angle)/math.Cos(latitude*math.Pi/180)) * 180 / math.Pi, latDelta
+}
+
+// geoCellsCover returns true if the cell with its two neighbours covers the coordinate range [v - delta, v + delta].
+// Longitude cells wrap around, latitude cells end at the range limits where no members can be.
+func geoCellsCover(idx uint64, v float64, delta float64, min float64, max float64, step uint, bounded bool) bool {
+	cells := uint64(1) << step
+	if !bounded && cells <= 3 {
+		return true
+	}
+	size := (max - min) / float64(cells)
+	from := min + float64(idx)*size - size
+	to := from + 3*size
+	return (v-delta >= from || (bounded && idx == 0)) && (v+delta <= to || (bounded && idx == cells-1))
+}
+
+// geoInterleave interleaves step bits of the longitude and step bits of the latitude cell indexes like EncodeGeohash.
+func geoInterleave(lon uint64, lat uint64, step uint) uint64 {
+	var hash uint64
+	for i := int(step) - 1; i >= 0; i-- {
+		hash = hash<<2 | (lon>>uint(i)&1)<<1 | lat>>uint(i)&1
+	}
+	return hash
+}
+
+func geoQuantize(v float64, min float64, max float64) uint64 {
+	n := uint64((v - min) / (max - min) * (1 << geoStep))
+	if n >= 1<<geoStep {
+		n = 1<<geoStep - 1
+	}
+	return n
+}
+
+func geoDequantize(n uint64, min float64, max float64) float64 {
+	cell := (max - min) / (1 << geoStep)
+	v := min + (float64(n)+0.5)*cell
+	return math.Max(min, math.Min(max, v))
+}
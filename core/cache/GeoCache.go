@@ -0,0 +1,334 @@
+package cache
+
+import (
+	"log"
+	"sort"
+	"time"
+)
+
+// GeoCacheEntry is a set of members with their geohashes stored in the memory cache.
+// Index keeps the members ordered by geohash, so the search scans only the cells around the center.
+// Bytes is the size of the members, it is kept up to date by each change, so the members are not walked on every write.
+type GeoCacheEntry struct {
+	Members map[string]uint64
+	Index   *SkipList
+	Bytes   int64
+	CacheEntryData
+}
+
+// IGeoCache is an interface for GeoCache.
+type IGeoCache interface {
+	TryGet(key string) (bool, []GeoResult)
+	TryAdd(key string, members map[string]uint64, ttl time.Duration) bool
+	TryDelete(key string) bool
+	TryAddMembers(key string, positions []GeoPosition) (bool, int, int, error)
+	TryRemoveMembers(key string, members []string) (bool, int)
+	TryGetPositions(key string, members []string) (bool, []GeoResult, []string)
+	TryGetDistance(key string, from string, to string) (bool, float64, bool)
+	TrySearch(key string, q GeoQuery) (bool, []GeoResult, error)
+	TryGetMetadata(key string) (bool, CacheEntryData, int64)
+	TrySetTTL(key string, ttl time.Duration, sliding bool) bool
+	GetKeys() []string
+	GetStats() CacheStats
+	RemoveExpired(budget time.Duration) int
+}
+
+// IGeoCachePersistence is an interface for persisting GeoCache.
+type IGeoCachePersistence interface {
+	TryGetSnapshot(key string) (bool, GeoCacheEntry)
+	TryAddFromSnapshot(key string, entry GeoCacheEntry) bool
+}
+
+// GeoCache is a single-thread in-memory cache based on map[string]GeoCacheEntry.
+type GeoCache struct {
+	Map     map[string]GeoCacheEntry
+	Evictor *Evictor
+	Reaped  int64
+}
+
+// NewGeoCacheEntry creates new entry from the members encoded by ToGeoMembers.
+func NewGeoCacheEntry(members map[string]uint64, data CacheEntryData) GeoCacheEntry {
+	v := GeoCacheEntry{Members: make(map[string]uint64, len(members)), Index: NewSkipList(), CacheEntryData: data}
+	for m, hash := range members {
+		v.setHash(m, hash)
+	}
+	return v
+}
+
+// TryGet returns the members with their positions sorted by member if contains the key specified.
+func (c *GeoCache) TryGet(key string) (bool, []GeoResult) {
+	v, ok := c.getValueWithExpiration(key)
+	res := make([]GeoResult, 0, len(v.Members))
+	for m, hash := range v.Members {
+		res = append(res, toGeoResult(m, hash))
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Member < res[j].Member })
+	return ok, res
+}
+
+// TryGetSnapshot returns the value if contains the key specified.
+func (c *GeoCache) TryGetSnapshot(key string) (bool, GeoCacheEntry) {
+	v, ok := c.peek(key)
+	return ok, v
+}
+
+// TryGetMetadata returns timestamps, expiration, version and size in bytes of the entry if contains the key specified.
+// The entry is not marked as used and sliding ttl is not renewed.
+func (c *GeoCache) TryGetMetadata(key string) (bool, CacheEntryData, int64) {
+	v, ok := c.peek(key)
+	return ok, v.CacheEntryData, v.size(key)
+}
+
+// TrySetTTL sets new ttl for the existing key keeping its value and version, zero ttl makes the key permanent.
+// Sliding ttl is renewed each time the key is used.
+func (c *GeoCache) TrySetTTL(key string, ttl time.Duration, sliding bool) bool {
+	v, ok := c.peek(key)
+	if ok {
+		v.setTTL(ttl, sliding)
+		c.store(key, v)
+	}
+	return ok
+}
+
+// TryAdd add new set of members encoded by ToGeoMembers to the cache by the key specified if the key is not already used.
+func (c *GeoCache) TryAdd(key string, members map[string]uint64, ttl time.Duration) bool {
+	_, ok := c.getValueWithExpiration(key)
+	if !ok {
+		c.store(key, NewGeoCacheEntry(members, NewCacheEntryData(ttl)))
+	}
+	return !ok
+}
+
+// TryAddFromSnapshot add new value to the cache by the key specified if the key is not already used.
+func (c *GeoCache) TryAddFromSnapshot(key string, entry GeoCacheEntry) bool {
+	_, ok := c.Map[key]
+	if !ok {
+		entry.ExpireAfter = normalizeExpiration(entry.ExpireAfter)
+		c.store(key, entry)
+		observeVersion(entry.Version)
+	}
+	return !ok
+}
+
+// TryDelete deletes the members by the key specified if the key is already used.
+func (c *GeoCache) TryDelete(key string) bool {
+	_, ok := c.getValueWithExpiration(key)
+	if ok {
+		c.remove(key)
+	}
+	return ok
+}
+
+// TryAddMembers adds new members and moves the existing ones like GEOADD, returns the number of members added and moved.
+// Nothing is changed if any position is invalid. The version is changed only if the members were changed.
+func (c *GeoCache) TryAddMembers(key string, positions []GeoPosition) (bool, int, int, error) {
+	v, ok := c.getValueWithExpiration(key)
+	if !ok {
+		return false, 0, 0, nil
+	}
+	members, err := ToGeoMembers(positions)
+	if err != nil {
+		return true, 0, 0, err
+	}
+	added, updated := 0, 0
+	for m, hash := range members {
+		if old, exists := v.Members[m]; !exists {
+			added++
+		} else if old != hash {
+			updated++
+		} else {
+			continue
+		}
+		v.setHash(m, hash)
+	}
+	if added+updated > 0 {
+		v.CacheEntryData = UpdateCacheEntryData(v.CacheEntryData)
+		c.store(key, v)
+	}
+	return true, added, updated, nil
+}
+
+// TryRemoveMembers removes the members, returns the number of members removed.
+// The entry is kept even if it becomes empty.
+func (c *GeoCache) TryRemoveMembers(key string, members []string) (bool, int) {
+	v, ok := c.getValueWithExpiration(key)
+	if !ok {
+		return false, 0
+	}
+	n := 0
+	for _, m := range members {
+		if hash, exists := v.Members[m]; exists {
+			delete(v.Members, m)
+			v.Index.Delete(m, float64(hash))
+			v.Bytes -= int64(len(m) + 8)
+			n++
+		}
+	}
+	if n > 0 {
+		v.CacheEntryData = UpdateCacheEntryData(v.CacheEntryData)
+		c.store(key, v)
+	}
+	return true, n
+}
+
+// TryGetPositions returns the positions of the members like GEOPOS and the members which were not found.
+func (c *GeoCache) TryGetPositions(key string, members []string) (bool, []GeoResult, []string) {
+	v, ok := c.getValueWithExpiration(key)
+	found, missing := make([]GeoResult, 0, len(members)), make([]string, 0)
+	for _, m := range members {
+		if hash, exists := v.Members[m]; exists {
+			found = append(found, toGeoResult(m, hash))
+		} else {
+			missing = append(missing, m)
+		}
+	}
+	return ok, found, missing
+}
+
+// TryGetDistance returns the distance in meters between two members like GEODIST,
+// the second value is false if any of the members was not found.
+func (c *GeoCache) TryGetDistance(key string, from string, to string) (bool, float64, bool) {
+	v, ok := c.getValueWithExpiration(key)
+	h1, ok1 := v.Members[from]
+	h2, ok2 := v.Members[to]
+	if !ok1 || !ok2 {
+		return ok, 0, false
+	}
+	lon1, lat1 := DecodeGeohash(h1)
+	lon2, lat2 := DecodeGeohash(h2)
+	return ok, GeoDistance(lon1, lat1, lon2, lat2), true
+}
+
+// TrySearch returns the members within the radius or the box around the center sorted by distance like GEOSEARCH.
+// ErrGeoMemberNotFound is returned if the member used as the center does not exist.
+func (c *GeoCache) TrySearch(key string, q GeoQuery) (bool, []GeoResult, error) {
+	v, ok := c.getValueWithExpiration(key)
+	if !ok {
+		return false, nil, nil
+	}
+	lon, lat := q.Longitude, q.Latitude
+	if q.Member != "" {
+		hash, exists := v.Members[q.Member]
+		if !exists {
+			return true, nil, ErrGeoMemberNotFound
+		}
+		lon, lat = DecodeGeohash(hash)
+	} else if _, err := EncodeGeohash(lon, lat); err != nil {
+		return true, nil, err
+	}
+	return true, SearchGeo(v.Index, lon, lat, q), nil
+}
+
+// GetKeys returns all the keys in the map.
+func (c *GeoCache) GetKeys() []string {
+	var keySlice []string
+	for key, v := range c.Map {
+		if !IsCacheEntryExpired(v.CacheEntryData) {
+			keySlice = append(keySlice, key)
+		}
+	}
+	if keySlice == nil {
+		return make([]string, 0)
+	}
+	return keySlice
+}
+
+// GetStats returns cache usage counters.
+func (c *GeoCache) GetStats() CacheStats {
+	stats := c.Evictor.Stats()
+	stats.Reaped = c.Reaped
+	return stats
+}
+
+// RemoveExpired deletes expired entries until all the keys are checked or the time budget is spent.
+// Go maps are iterated in random order, so the next sweep starts from another key.
+func (c *GeoCache) RemoveExpired(budget time.Duration) int {
+	started := time.Now()
+	n, checked := 0, 0
+	for key, v := range c.Map {
+		if IsCacheEntryExpired(v.CacheEntryData) {
+			c.remove(key)
+			n++
+		}
+		checked++
+		if isOverBudget(started, budget, checked) {
+			break
+		}
+	}
+	c.Reaped += int64(n)
+	return n
+}
+
+func (c *GeoCache) getValueWithExpiration(key string) (GeoCacheEntry, bool) {
+	v, ok := c.peek(key)
+	if ok {
+		c.Evictor.Touch(key)
+		if v.slide() {
+			c.store(key, v)
+		}
+	}
+	return v, ok
+}
+
+// peek returns the entry removing it if expired, the entry is not marked as used.
+func (c *GeoCache) peek(key string) (GeoCacheEntry, bool) {
+	v, ok := c.Map[key]
+	if ok {
+		if IsCacheEntryExpired(v.CacheEntryData) {
+			c.remove(key)
+			log.Printf("[GeoCache] key %s had expired and was removed", key)
+			return v, false // expired
+		}
+		return v, ok // not expired
+	}
+	return v, ok
+}
+
+// store puts the entry to the map and evicts other keys if the cache is over its limits.
+func (c *GeoCache) store(key string, entry GeoCacheEntry) {
+	c.Map[key] = entry
+	c.Evictor.Track(key, entry.size(key), entry.ExpireAfter)
+	for _, k := range c.Evictor.Evict(key) {
+		delete(c.Map, k)
+		log.Printf("[GeoCache] key %s was evicted", k)
+	}
+}
+
+func (c *GeoCache) remove(key string) {
+	delete(c.Map, key)
+	c.Evictor.Remove(key)
+}
+
+// setHash adds the member or moves it to the new position in the index, 52-bit geohash is stored as float64 score exactly.
+func (v *GeoCacheEntry) setHash(member string, hash uint64) {
+	if old, exists := v.Members[member]; exists {
+		v.Index.Delete(member, float64(old))
+	} else {
+		v.Bytes += int64(len(member) + 8)
+	}
+	v.Members[member] = hash
+	v.Index.Insert(member, float64(hash))
+}
+
+// size returns approximate memory used by the entry.
+func (v GeoCacheEntry) size(key string) int64 {
+	return int64(len(key)) + v.Bytes
+}
+
+// ToGeoMembers encodes the positions of the members to geohashes, the last position of the duplicate member is used.
+func ToGeoMembers(positions []GeoPosition) (map[string]uint64, error) {
+	m := make(map[string]uint64, len(positions))
+	for _, p := range positions {
+		hash, err := EncodeGeohash(p.Longitude, p.Latitude)
+		if err != nil {
+			return nil, err
+		}
+		m[p.Member] = hash
+	}
+	return m, nil
+}
+
+func toGeoResult(member string, hash uint64) GeoResult {
+	lon, lat := DecodeGeohash(hash)
+	return GeoResult{Member: member, Longitude: lon, Latitude: lat, Hash: hash}
+}
@@ -0,0 +1,127 @@
+package cache
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func newTestGeoCache(t *testing.T, positions []GeoPosition) *GeoCache {
+	members := make(map[string]uint64, len(positions))
+	for _, p := range positions {
+		hash, err := EncodeGeohash(p.Longitude, p.Latitude)
+		if err != nil {
+			t.Fatalf("EncodeGeohash(%v, %v): %v", p.Longitude, p.Latitude, err)
+		}
+		members[p.Member] = hash
+	}
+	c := &GeoCache{Map: make(map[string]GeoCacheEntry), Evictor: NewEvictor(EvictionOptions{})}
+	c.TryAdd("geo", members, 0)
+	return c
+}
+
+func geoResultMembers(results []GeoResult) []string {
+	names := make([]string, 0, len(results))
+	for _, r := range results {
+		names = append(names, r.Member)
+	}
+	return names
+}
+
+func TestGeoSearchAcrossBoundaries(t *testing.T) {
+	c := newTestGeoCache(t, []GeoPosition{
+		{"east-of-antimeridian", 179.99, 0},
+		{"west-of-antimeridian", -179.99, 0},
+		{"far-west-of-antimeridian", -179.8, 0},
+		{"east-of-greenwich", 0.001, 51.5},
+		{"west-of-greenwich", -0.001, 51.5},
+		{"north-of-equator", 20, 0.001},
+		{"south-of-equator", 20, -0.001},
+		{"north-edge", 10, 85.05},
+		{"north-edge-opposite", -170, 85.05},
+		{"south-edge", 10, -85.05},
+	})
+	tests := []struct {
+		name string
+		q    GeoQuery
+		want []string
+	}{
+		{"radius across antimeridian from east", GeoQuery{Longitude: 179.995, Latitude: 0, Radius: 5000},
+			[]string{"east-of-antimeridian", "west-of-antimeridian"}},
+		{"radius across antimeridian from west", GeoQuery{Longitude: -179.995, Latitude: 0, Radius: 5000},
+			[]string{"west-of-antimeridian", "east-of-antimeridian"}},
+		{"radius near antimeridian", GeoQuery{Longitude: 179.999, Latitude: 0, Radius: 25000},
+			[]string{"east-of-antimeridian", "west-of-antimeridian", "far-west-of-antimeridian"}},
+		{"radius across greenwich", GeoQuery{Longitude: 0.0005, Latitude: 51.5, Radius: 500},
+			[]string{"east-of-greenwich", "west-of-greenwich"}},
+		{"radius across equator", GeoQuery{Longitude: 20, Latitude: 0.0002, Radius: 200},
+			[]string{"north-of-equator", "south-of-equator"}},
+		{"radius too small", GeoQuery{Longitude: 179.995, Latitude: 0, Radius: 100},
+			[]string{}},
+		{"radius over the pole", GeoQuery{Longitude: 10, Latitude: 85, Radius: 1200000},
+			[]string{"north-edge", "north-edge-opposite"}},
+		{"radius at the south edge", GeoQuery{Longitude: 10, Latitude: -85.05112878, Radius: 1000},
+			[]string{"south-edge"}},
+		{"box across antimeridian", GeoQuery{Longitude: 179.995, Latitude: 0, Width: 4000, Height: 1000},
+			[]string{"east-of-antimeridian", "west-of-antimeridian"}},
+		{"box too narrow", GeoQuery{Longitude: 179.995, Latitude: 0, Width: 500, Height: 10000},
+			[]string{}},
+		{"box across equator", GeoQuery{Longitude: 20, Latitude: 0, Width: 10, Height: 300},
+			[]string{"north-of-equator", "south-of-equator"}},
+		{"member center across antimeridian", GeoQuery{Member: "west-of-antimeridian", Radius: 25000},
+			[]string{"west-of-antimeridian", "east-of-antimeridian", "far-west-of-antimeridian"}},
+		{"count and descending order", GeoQuery{Member: "west-of-antimeridian", Radius: 25000, Count: 2, Descending: true},
+			[]string{"far-west-of-antimeridian", "east-of-antimeridian"}},
+	}
+	for _, tt := range tests {
+		ok, got, err := c.TrySearch("geo", tt.q)
+		if !ok || err != nil || !reflect.DeepEqual(geoResultMembers(got), tt.want) {
+			t.Errorf("%s: TrySearch = %v, %v, %v, want %v", tt.name, ok, geoResultMembers(got), err, tt.want)
+		}
+	}
+}
+
+// TestGeoSearchMatchesFullScan checks that scanning only the cells around the center finds the same members
+// as computing the distance to each member, the members are placed along the cell boundaries and the antimeridian.
+func TestGeoSearchMatchesFullScan(t *testing.T) {
+	var positions []GeoPosition
+	for _, lon := range []float64{-180, -179.9, -90.05, -45, -0.05, 0, 0.05, 45, 90.05, 179.9, 180} {
+		for _, lat := range []float64{-85, -60, -0.05, 0, 0.05, 42.5, 60, 85} {
+			for i := 0; i < 5; i++ {
+				d := float64(i) * 0.03
+				positions = append(positions, GeoPosition{fmt.Sprintf("%v/%v/%d", lon, lat, i), clampGeo(lon+d, 180), clampGeo(lat-d, 85)})
+			}
+		}
+	}
+	c := newTestGeoCache(t, positions)
+	entry := c.Map["geo"]
+	for _, center := range positions {
+		for _, radius := range []float64{1000, 10000, 100000, 1000000} {
+			q := GeoQuery{Longitude: center.Longitude, Latitude: center.Latitude, Radius: radius}
+			var want []string
+			for member, hash := range entry.Members {
+				lon, lat := DecodeGeohash(hash)
+				if GeoDistance(q.Longitude, q.Latitude, lon, lat) <= radius {
+					want = append(want, member)
+				}
+			}
+			got := geoResultMembers(SearchGeo(entry.Index, q.Longitude, q.Latitude, q))
+			sort.Strings(want)
+			sort.Strings(got)
+			if len(want) != len(got) || (len(want) > 0 && !reflect.DeepEqual(want, got)) {
+				t.Fatalf("SearchGeo(%v, %v, %v) found %d members, want %d", q.Longitude, q.Latitude, radius, len(got), len(want))
+			}
+		}
+	}
+}
+
+func clampGeo(v float64, limit float64) float64 {
+	if v > limit {
+		return limit
+	}
+	if v < -limit {
+		return -limit
+	}
+	return v
+}
@@ -0,0 +1,146 @@
+package cache
+
+import (
+	"encoding/binary"
+	"errors"
+	"hash/fnv"
+	"math"
+	"math/bits"
+)
+
+const (
+	hyperLogLogPrecision = 14
+	hyperLogLogRegisters = 1 << hyperLogLogPrecision
+	hyperLogLogSparse    = byte(1)
+	hyperLogLogDense     = byte(2)
+)
+
+// ErrInvalidHyperLogLog is returned when the serialized HyperLogLog cannot be read.
+var ErrInvalidHyperLogLog = errors.New("invalid HyperLogLog data")
+
+// HyperLogLog estimates the number of distinct values with about 0.81% standard error using 16384 6-bit registers.
+type HyperLogLog struct {
+	Registers []byte
+}
+
+// NewHyperLogLog creates empty HyperLogLog.
+func NewHyperLogLog() *HyperLogLog {
+	return &HyperLogLog{Registers: make([]byte, hyperLogLogRegisters)}
+}
+
+// Add adds the value and returns true if the estimate could change, i.e. one of the registers was increased.
+func (h *HyperLogLog) Add(value string) bool {
+	x := hashValue(value)
+	i := x >> (64 - hyperLogLogPrecision)
+	rho := byte(bits.LeadingZeros64(x<<hyperLogLogPrecision|1<<(hyperLogLogPrecision-1)) + 1)
+	if rho > h.Registers[i] {
+		h.Registers[i] = rho
+		return true
+	}
+	return false
+}
+
+// Count returns the estimated number of distinct values added.
+func (h *HyperLogLog) Count() int64 {
+	m := float64(hyperLogLogRegisters)
+	sum, zeros := 0.0, 0
+	for _, r := range h.Registers {
+		sum += 1 / float64(uint64(1)<<r)
+		if r == 0 {
+			zeros++
+		}
+	}
+	estimate := 0.7213 / (1 + 1.079/m) * m * m / sum
+	if estimate <= 2.5*m && zeros > 0 {
+		estimate = m * math.Log(m/float64(zeros)) // linear counting is more accurate for small cardinalities
+	}
+	return int64(estimate + 0.5)
+}
+
+// Merge sets each register to the maximum of both HyperLogLogs, so the result counts the union of the values.
+// Returns true if any register was changed.
+func (h *HyperLogLog) Merge(other *HyperLogLog) bool {
+	changed := false
+	for i, r := range other.Registers {
+		if r > h.Registers[i] {
+			h.Registers[i] = r
+			changed = true
+		}
+	}
+	return changed
+}
+
+// Copy returns independent copy of the HyperLogLog.
+func (h *HyperLogLog) Copy() *HyperLogLog {
+	registers := make([]byte, len(h.Registers))
+	copy(registers, h.Registers)
+	return &HyperLogLog{Registers: registers}
+}
+
+// Marshal returns compact binary representation of the HyperLogLog.
+// Registers which are not zero are written as index and value pairs until the dense form becomes smaller.
+func (h *HyperLogLog) Marshal() []byte {
+	n := 0
+	for _, r := range h.Registers {
+		if r != 0 {
+			n++
+		}
+	}
+	if 1+3*n >= 1+len(h.Registers) {
+		return append([]byte{hyperLogLogDense}, h.Registers...)
+	}
+	data := make([]byte, 1, 1+3*n)
+	data[0] = hyperLogLogSparse
+	for i, r := range h.Registers {
+		if r != 0 {
+			data = append(data, byte(i>>8), byte(i), r)
+		}
+	}
+	return data
+}
+
+// UnmarshalHyperLogLog reads HyperLogLog written by Marshal.
+func UnmarshalHyperLogLog(data []byte) (*HyperLogLog, error) {
+	if len(data) == 0 {
+		return nil, ErrInvalidHyperLogLog
+	}
+	h := NewHyperLogLog()
+	switch data[0] {
+	case hyperLogLogDense:
+		if len(data) != 1+hyperLogLogRegisters {
+			return nil, ErrInvalidHyperLogLog
+		}
+		copy(h.Registers, data[1:])
+	case hyperLogLogSparse:
+		if (len(data)-1)%3 != 0 {
+			return nil, ErrInvalidHyperLogLog
+		}
+		for p := 1; p < len(data); p += 3 {
+			i := int(binary.BigEndian.Uint16(data[p:]))
+			if i >= hyperLogLogRegisters {
+				return nil, ErrInvalidHyperLogLog
+			}
+			h.Registers[i] = data[p+2]
+		}
+	default:
+		return nil, ErrInvalidHyperLogLog
+	}
+	return h, nil
+}
+
+// hashValue returns well mixed 64-bit hash of the value, FNV-1a is finalized with MurmurHash3 mixer
+// since HyperLogLog and Bloom filter need all the bits to be uniformly distributed.
+func hashValue(value string) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte(value))
+	return mix64(h.Sum64())
+}
+
+func mix64(x uint64) uint64 {
+	x ^= x >> 33
+	x *= 0xff51afd7ed558ccd
+	x ^= x >> 33
+	x *= 0xc4ceb9fe1a85ec53
+	x ^= x >> 33
+	return x
+}
@@ -0,0 +1,237 @@
+package cache
+
+import (
+	"log"
+	"time"
+)
+
+// HyperLogLogCacheEntry is HyperLogLog stored in the memory cache.
+type HyperLogLogCacheEntry struct {
+	Value *HyperLogLog
+	CacheEntryData
+}
+
+// IHyperLogLogCache is an interface for HyperLogLogCache.
+type IHyperLogLogCache interface {
+	TryGet(key string) (bool, *HyperLogLog)
+	TryAdd(key string, values []string, ttl time.Duration) bool
+	TryDelete(key string) bool
+	TryAddValues(key string, values []string) (bool, bool)
+	TryCount(key string) (bool, int64)
+	TryMerge(key string, others []*HyperLogLog) (bool, int64)
+	TryGetMetadata(key string) (bool, CacheEntryData, int64)
+	TrySetTTL(key string, ttl time.Duration, sliding bool) bool
+	GetKeys() []string
+	GetStats() CacheStats
+	RemoveExpired(budget time.Duration) int
+}
+
+// IHyperLogLogCachePersistence is an interface for persisting HyperLogLogCache.
+type IHyperLogLogCachePersistence interface {
+	TryGetSnapshot(key string) (bool, HyperLogLogCacheEntry)
+	TryAddFromSnapshot(key string, entry HyperLogLogCacheEntry) bool
+}
+
+// HyperLogLogCache is a single-thread in-memory cache based on map[string]HyperLogLogCacheEntry.
+type HyperLogLogCache struct {
+	Map     map[string]HyperLogLogCacheEntry
+	Evictor *Evictor
+	Reaped  int64
+}
+
+// TryGet returns HyperLogLog if contains the key specified, the value must not be changed by the caller.
+func (c *HyperLogLogCache) TryGet(key string) (bool, *HyperLogLog) {
+	v, ok := c.getValueWithExpiration(key)
+	return ok, v.Value
+}
+
+// TryGetSnapshot returns the value if contains the key specified.
+func (c *HyperLogLogCache) TryGetSnapshot(key string) (bool, HyperLogLogCacheEntry) {
+	v, ok := c.peek(key)
+	return ok, v
+}
+
+// TryGetMetadata returns timestamps, expiration, version and size in bytes of the entry if contains the key specified.
+// The entry is not marked as used and sliding ttl is not renewed.
+func (c *HyperLogLogCache) TryGetMetadata(key string) (bool, CacheEntryData, int64) {
+	v, ok := c.peek(key)
+	return ok, v.CacheEntryData, v.size(key)
+}
+
+// TrySetTTL sets new ttl for the existing key keeping its value and version, zero ttl makes the key permanent.
+// Sliding ttl is renewed each time the key is used.
+func (c *HyperLogLogCache) TrySetTTL(key string, ttl time.Duration, sliding bool) bool {
+	v, ok := c.peek(key)
+	if ok {
+		v.setTTL(ttl, sliding)
+		c.store(key, v)
+	}
+	return ok
+}
+
+// TryAdd add new HyperLogLog with the values to the cache by the key specified if the key is not already used.
+func (c *HyperLogLogCache) TryAdd(key string, values []string, ttl time.Duration) bool {
+	_, ok := c.getValueWithExpiration(key)
+	if !ok {
+		h := NewHyperLogLog()
+		for _, s := range values {
+			h.Add(s)
+		}
+		c.store(key, HyperLogLogCacheEntry{Value: h, CacheEntryData: NewCacheEntryData(ttl)})
+	}
+	return !ok
+}
+
+// TryAddFromSnapshot add new value to the cache by the key specified if the key is not already used.
+func (c *HyperLogLogCache) TryAddFromSnapshot(key string, entry HyperLogLogCacheEntry) bool {
+	_, ok := c.Map[key]
+	if !ok {
+		entry.ExpireAfter = normalizeExpiration(entry.ExpireAfter)
+		c.store(key, entry)
+		observeVersion(entry.Version)
+	}
+	return !ok
+}
+
+// TryDelete deletes HyperLogLog by the key specified if the key is already used.
+func (c *HyperLogLogCache) TryDelete(key string) bool {
+	_, ok := c.getValueWithExpiration(key)
+	if ok {
+		c.remove(key)
+	}
+	return ok
+}
+
+// TryAddValues adds the values like PFADD and returns true as the second value if the estimate could change.
+// The version is changed only if HyperLogLog was changed.
+func (c *HyperLogLogCache) TryAddValues(key string, values []string) (bool, bool) {
+	v, ok := c.getValueWithExpiration(key)
+	if !ok {
+		return false, false
+	}
+	changed := false
+	for _, s := range values {
+		if v.Value.Add(s) {
+			changed = true
+		}
+	}
+	if changed {
+		c.store(key, HyperLogLogCacheEntry{Value: v.Value, CacheEntryData: UpdateCacheEntryData(v.CacheEntryData)})
+	}
+	return true, changed
+}
+
+// TryCount returns the estimated number of distinct values like PFCOUNT.
+func (c *HyperLogLogCache) TryCount(key string) (bool, int64) {
+	v, ok := c.getValueWithExpiration(key)
+	if !ok {
+		return false, 0
+	}
+	return true, v.Value.Count()
+}
+
+// TryMerge merges other HyperLogLogs into the one by the key specified like PFMERGE and returns the estimate of the union.
+func (c *HyperLogLogCache) TryMerge(key string, others []*HyperLogLog) (bool, int64) {
+	v, ok := c.getValueWithExpiration(key)
+	if !ok {
+		return false, 0
+	}
+	changed := false
+	for _, h := range others {
+		if v.Value.Merge(h) {
+			changed = true
+		}
+	}
+	if changed {
+		c.store(key, HyperLogLogCacheEntry{Value: v.Value, CacheEntryData: UpdateCacheEntryData(v.CacheEntryData)})
+	}
+	return true, v.Value.Count()
+}
+
+// GetKeys returns all the keys in the map.
+func (c *HyperLogLogCache) GetKeys() []string {
+	var keySlice []string
+	for key, v := range c.Map {
+		if !IsCacheEntryExpired(v.CacheEntryData) {
+			keySlice = append(keySlice, key)
+		}
+	}
+	if keySlice == nil {
+		return make([]string, 0)
+	}
+	return keySlice
+}
+
+// GetStats returns cache usage counters.
+func (c *HyperLogLogCache) GetStats() CacheStats {
+	stats := c.Evictor.Stats()
+	stats.Reaped = c.Reaped
+	return stats
+}
+
+// RemoveExpired deletes expired entries until all the keys are checked or the time budget is spent.
+// Go maps are iterated in random order, so the next sweep starts from another key.
+func (c *HyperLogLogCache) RemoveExpired(budget time.Duration) int {
+	started := time.Now()
+	n, checked := 0, 0
+	for key, v := range c.Map {
+		if IsCacheEntryExpired(v.CacheEntryData) {
+			c.remove(key)
+			n++
+		}
+		checked++
+		if isOverBudget(started, budget, checked) {
+			break
+		}
+	}
+	c.Reaped += int64(n)
+	return n
+}
+
+func (c *HyperLogLogCache) getValueWithExpiration(key string) (HyperLogLogCacheEntry, bool) {
+	v, ok := c.peek(key)
+	if ok {
+		c.Evictor.Touch(key)
+		if v.slide() {
+			c.store(key, v)
+		}
+	}
+	return v, ok
+}
+
+// peek returns the entry removing it if expired, the entry is not marked as used.
+func (c *HyperLogLogCache) peek(key string) (HyperLogLogCacheEntry, bool) {
+	v, ok := c.Map[key]
+	if ok {
+		if IsCacheEntryExpired(v.CacheEntryData) {
+			c.remove(key)
+			log.Printf("[HyperLogLogCache] key %s had expired and was removed", key)
+			return v, false // expired
+		}
+		return v, ok // not expired
+	}
+	return v, ok
+}
+
+// store puts the entry to the map and evicts other keys if the cache is over its limits.
+func (c *HyperLogLogCache) store(key string, entry HyperLogLogCacheEntry) {
+	c.Map[key] = entry
+	c.Evictor.Track(key, entry.size(key), entry.ExpireAfter)
+	for _, k := range c.Evictor.Evict(key) {
+		delete(c.Map, k)
+		log.Printf("[HyperLogLogCache] key %s was evicted", k)
+	}
+}
+
+func (c *HyperLogLogCache) remove(key string) {
+	delete(c.Map, key)
+	c.Evictor.Remove(key)
+}
+
+// size returns approximate memory used by the entry.
+func (v HyperLogLogCacheEntry) size(key string) int64 {
+	if v.Value == nil {
+		return int64(len(key))
+	}
+	return int64(len(key) + len(v.Value.Registers))
+}
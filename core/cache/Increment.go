@@ -0,0 +1,49 @@
+package cache
+
+import (
+	"errors"
+	"math"
+	"strconv"
+)
+
+// ErrNotInteger is returned when integer increment is applied to a value which is not an integer.
+var ErrNotInteger = errors.New("value is not an integer or out of range")
+
+// ErrNotFloat is returned when float increment is applied to a value which is not a number.
+var ErrNotFloat = errors.New("value is not a valid float")
+
+// ErrIncrementOverflow is returned when the result of the increment is out of range.
+var ErrIncrementOverflow = errors.New("increment or decrement would overflow")
+
+// Increment is a change of the numeric value stored as a string, Delta is used for integers and FloatDelta for floats.
+type Increment struct {
+	Delta      int64
+	FloatDelta float64
+	Float      bool
+}
+
+// Apply returns the value changed by the increment, missing values are treated as zero.
+func (i Increment) Apply(value string, exists bool) (string, error) {
+	if !exists {
+		value = "0"
+	}
+	if i.Float {
+		v, err := strconv.ParseFloat(value, 64)
+		if err != nil || math.IsNaN(v) || math.IsInf(v, 0) {
+			return value, ErrNotFloat
+		}
+		res := v + i.FloatDelta
+		if math.IsNaN(res) || math.IsInf(res, 0) {
+			return value, ErrIncrementOverflow
+		}
+		return strconv.FormatFloat(res, 'f', -1, 64), nil
+	}
+	v, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return value, ErrNotInteger
+	}
+	if (i.Delta > 0 && v > math.MaxInt64-i.Delta) || (i.Delta < 0 && v < math.MinInt64-i.Delta) {
+		return value, ErrIncrementOverflow
+	}
+	return strconv.FormatInt(v+i.Delta, 10), nil
+}
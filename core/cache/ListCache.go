@@ -6,8 +6,10 @@ import (
 )
 
 // ListCacheEntry is a string cache data item stored in the memory cache.
+// Bytes is the size of the values, it is kept up to date by each change, so the list is not walked on every write.
 type ListCacheEntry struct {
 	Values []string
+	Bytes  int64
 	CacheEntryData
 }
 
@@ -17,9 +19,21 @@ type IListCache interface {
 	TryAdd(key string, values []string, ttl time.Duration) bool
 	TryDelete(key string) (bool, []string)
 	TryUpdateValue(key string, newValue string, originalValue string) (bool, []string)
-	TryDeleteValue(key string, value string) (bool, []string)
+	TryDeleteValue(key string, value string, count int) (bool, int)
 	TryAddValue(key string, newValue string) (bool, []string)
+	TryGetIndex(key string, index int) (bool, string, bool)
+	TrySetIndex(key string, index int, value string) (bool, bool)
+	TryInsert(key string, index int, value string) (bool, bool, int)
+	TryRemoveIndex(key string, index int) (bool, string, bool)
+	TryGetRange(key string, start int, stop int) (bool, []string, int, int)
+	TryPush(key string, values []string, left bool) (bool, int)
+	TryPop(key string, count int, left bool) (bool, []string)
+	TryTrim(key string, start int, stop int) (bool, int, int)
+	TryGetMetadata(key string) (bool, CacheEntryData, int64)
+	TrySetTTL(key string, ttl time.Duration, sliding bool) bool
 	GetKeys() []string
+	GetStats() CacheStats
+	RemoveExpired(budget time.Duration) int
 }
 
 // IListCachePersistence is an interface for persisting ListCache.
@@ -30,7 +44,9 @@ type IListCachePersistence interface {
 
 // ListCache is a single-thread in-memory cache based on map[string]ListCacheEntry.
 type ListCache struct {
-	Map map[string]ListCacheEntry
+	Map     map[string]ListCacheEntry
+	Evictor *Evictor
+	Reaped  int64
 }
 
 // TryGet returns the value if contains the key specified.
@@ -41,15 +57,33 @@ func (c *ListCache) TryGet(key string) (bool, []string) {
 
 // TryGetSnapshot returns the value if contains the key specified.
 func (c *ListCache) TryGetSnapshot(key string) (bool, ListCacheEntry) {
-	v, ok := c.getValueWithExpiration(key)
+	v, ok := c.peek(key)
 	return ok, v
 }
 
+// TryGetMetadata returns timestamps, expiration, version and size in bytes of the entry if contains the key specified.
+// The entry is not marked as used and sliding ttl is not renewed.
+func (c *ListCache) TryGetMetadata(key string) (bool, CacheEntryData, int64) {
+	v, ok := c.peek(key)
+	return ok, v.CacheEntryData, v.size(key)
+}
+
+// TrySetTTL sets new ttl for the existing key keeping its value and version, zero ttl makes the key permanent.
+// Sliding ttl is renewed each time the key is used.
+func (c *ListCache) TrySetTTL(key string, ttl time.Duration, sliding bool) bool {
+	v, ok := c.peek(key)
+	if ok {
+		v.setTTL(ttl, sliding)
+		c.store(key, v)
+	}
+	return ok
+}
+
 // TryAdd add new value to the cache by the key specified if the key is not already used.
 func (c *ListCache) TryAdd(key string, values []string, ttl time.Duration) bool {
 	_, ok := c.getValueWithExpiration(key)
 	if !ok {
-		c.Map[key] = ListCacheEntry{Values: values, CacheEntryData: NewCacheEntryData(ttl)}
+		c.store(key, ListCacheEntry{Values: values, Bytes: valuesBytes(values), CacheEntryData: NewCacheEntryData(ttl)})
 	}
 	return !ok
 }
@@ -58,7 +92,10 @@ func (c *ListCache) TryAdd(key string, values []string, ttl time.Duration) bool
 func (c *ListCache) TryAddFromSnapshot(key string, entry ListCacheEntry) bool {
 	_, ok := c.Map[key]
 	if !ok {
-		c.Map[key] = entry
+		entry.ExpireAfter = normalizeExpiration(entry.ExpireAfter)
+		entry.Bytes = valuesBytes(entry.Values)
+		c.store(key, entry)
+		observeVersion(entry.Version)
 	}
 	return !ok
 }
@@ -67,7 +104,7 @@ func (c *ListCache) TryAddFromSnapshot(key string, entry ListCacheEntry) bool {
 func (c *ListCache) TryDelete(key string) (bool, []string) {
 	v, ok := c.getValueWithExpiration(key)
 	if ok {
-		delete(c.Map, key)
+		c.remove(key)
 	}
 	return ok, v.Values
 }
@@ -76,32 +113,35 @@ func (c *ListCache) TryDelete(key string) (bool, []string) {
 func (c *ListCache) TryUpdateValue(key string, newValue string, originalValue string) (bool, []string) {
 	v, ok := c.getValueWithExpiration(key)
 	if ok {
-		newValues, updated := c.replaceValueInArray(v.Values, newValue, originalValue, false)
-		if updated {
+		newValues, n := c.replaceValueInArray(v.Values, newValue, originalValue)
+		if n > 0 {
 			entry := ListCacheEntry{
 				Values:         newValues,
+				Bytes:          v.Bytes + int64(n*(len(newValue)-len(originalValue))),
 				CacheEntryData: UpdateCacheEntryData(v.CacheEntryData)}
-			c.Map[key] = entry
+			c.store(key, entry)
 		}
-		return updated, v.Values
+		return n > 0, v.Values
 	}
 	return false, v.Values
 }
 
-// TryDeleteValue deletes the existing value in the list by the key.
-func (c *ListCache) TryDeleteValue(key string, value string) (bool, []string) {
+// TryDeleteValue deletes the occurrences of the value in the list by the key, returns the number of values deleted.
+// Positive count deletes up to count values from the head, negative count deletes them from the tail, zero deletes all.
+func (c *ListCache) TryDeleteValue(key string, value string, count int) (bool, int) {
 	v, ok := c.getValueWithExpiration(key)
 	if ok {
-		newValues, deleted := c.replaceValueInArray(v.Values, "", value, true)
-		if deleted {
+		newValues, n := c.deleteValueInArray(v.Values, value, count)
+		if n > 0 {
 			entry := ListCacheEntry{
 				Values:         newValues,
+				Bytes:          v.Bytes - int64(n*len(value)),
 				CacheEntryData: UpdateCacheEntryData(v.CacheEntryData)}
-			c.Map[key] = entry
+			c.store(key, entry)
 		}
-		return deleted, v.Values
+		return n > 0, n
 	}
-	return false, v.Values
+	return false, 0
 }
 
 // TryAddValue adds the value to the list by the key.
@@ -110,13 +150,175 @@ func (c *ListCache) TryAddValue(key string, newValue string) (bool, []string) {
 	if ok {
 		entry := ListCacheEntry{
 			Values:         append(v.Values, newValue),
+			Bytes:          v.Bytes + int64(len(newValue)),
 			CacheEntryData: UpdateCacheEntryData(v.CacheEntryData)}
-		c.Map[key] = entry
+		c.store(key, entry)
 		return true, v.Values
 	}
 	return false, v.Values
 }
 
+// TryGetIndex returns the value at the zero-based index, negative indexes are counted from the end, -1 is the last value.
+// The last result is false if the index is out of range.
+func (c *ListCache) TryGetIndex(key string, index int) (bool, string, bool) {
+	v, ok := c.getValueWithExpiration(key)
+	if !ok {
+		return false, "", false
+	}
+	i, inRange := listIndex(index, len(v.Values))
+	if !inRange {
+		return true, "", false
+	}
+	return true, v.Values[i], true
+}
+
+// TrySetIndex replaces the value at the index, duplicates of the value are kept unlike TryUpdateValue.
+func (c *ListCache) TrySetIndex(key string, index int, value string) (bool, bool) {
+	v, ok := c.getValueWithExpiration(key)
+	if !ok {
+		return false, false
+	}
+	i, inRange := listIndex(index, len(v.Values))
+	if !inRange {
+		return true, false
+	}
+	values := make([]string, len(v.Values))
+	copy(values, v.Values)
+	values[i] = value
+	c.update(key, values, v.Bytes+int64(len(value)-len(v.Values[i])), v.CacheEntryData)
+	return true, true
+}
+
+// TryInsert inserts the value before the index and returns the new length of the list.
+// The index equal to the length appends the value, negative indexes are counted from the end.
+func (c *ListCache) TryInsert(key string, index int, value string) (bool, bool, int) {
+	v, ok := c.getValueWithExpiration(key)
+	if !ok {
+		return false, false, 0
+	}
+	n := len(v.Values)
+	if index < 0 {
+		index += n
+	}
+	if index < 0 || index > n {
+		return true, false, n
+	}
+	values := make([]string, 0, n+1)
+	values = append(values, v.Values[:index]...)
+	values = append(values, value)
+	values = append(values, v.Values[index:]...)
+	c.update(key, values, v.Bytes+int64(len(value)), v.CacheEntryData)
+	return true, true, len(values)
+}
+
+// TryRemoveIndex removes the value at the index and returns it, duplicates of the value are kept unlike TryDeleteValue.
+func (c *ListCache) TryRemoveIndex(key string, index int) (bool, string, bool) {
+	v, ok := c.getValueWithExpiration(key)
+	if !ok {
+		return false, "", false
+	}
+	i, inRange := listIndex(index, len(v.Values))
+	if !inRange {
+		return true, "", false
+	}
+	values := make([]string, 0, len(v.Values)-1)
+	values = append(values, v.Values[:i]...)
+	values = append(values, v.Values[i+1:]...)
+	c.update(key, values, v.Bytes-int64(len(v.Values[i])), v.CacheEntryData)
+	return true, v.Values[i], true
+}
+
+// TryGetRange returns the values from start to stop indexes inclusive like LRANGE, negative indexes are counted from the end.
+// The start index after normalization and the length of the list are returned for pagination.
+func (c *ListCache) TryGetRange(key string, start int, stop int) (bool, []string, int, int) {
+	v, ok := c.getValueWithExpiration(key)
+	if !ok {
+		return false, make([]string, 0), 0, 0
+	}
+	n := len(v.Values)
+	start, stop = listRange(start, stop, n)
+	if start > stop {
+		return true, make([]string, 0), start, n
+	}
+	values := make([]string, stop-start+1)
+	copy(values, v.Values[start:stop+1])
+	return true, values, start, n
+}
+
+// TryPush adds the values to the head of the list if left is true or to the tail otherwise, returns the new length of the list.
+// Values pushed to the head are inserted one by one like LPUSH, so they end up in reverse order.
+func (c *ListCache) TryPush(key string, values []string, left bool) (bool, int) {
+	v, ok := c.getValueWithExpiration(key)
+	if !ok {
+		return false, 0
+	}
+	if len(values) == 0 {
+		return true, len(v.Values)
+	}
+	newValues := make([]string, 0, len(v.Values)+len(values))
+	if left {
+		for i := len(values) - 1; i >= 0; i-- {
+			newValues = append(newValues, values[i])
+		}
+		newValues = append(newValues, v.Values...)
+	} else {
+		newValues = append(newValues, v.Values...)
+		newValues = append(newValues, values...)
+	}
+	c.update(key, newValues, v.Bytes+valuesBytes(values), v.CacheEntryData)
+	return true, len(newValues)
+}
+
+// TryPop removes up to count values from the head of the list if left is true or from the tail otherwise.
+// The values are returned in the order they were popped, the list is kept even if it becomes empty.
+func (c *ListCache) TryPop(key string, count int, left bool) (bool, []string) {
+	v, ok := c.getValueWithExpiration(key)
+	if !ok {
+		return false, make([]string, 0)
+	}
+	n := len(v.Values)
+	if count > n {
+		count = n
+	}
+	if count <= 0 {
+		return true, make([]string, 0)
+	}
+	popped := make([]string, count)
+	var values []string
+	if left {
+		copy(popped, v.Values[:count])
+		values = append(values, v.Values[count:]...)
+	} else {
+		for i := 0; i < count; i++ {
+			popped[i] = v.Values[n-1-i]
+		}
+		values = append(values, v.Values[:n-count]...)
+	}
+	c.update(key, values, v.Bytes-valuesBytes(popped), v.CacheEntryData)
+	return true, popped
+}
+
+// TryTrim keeps only the values from start to stop indexes inclusive like LTRIM, negative indexes are counted from the end.
+// Returns the number of values removed and the new length of the list.
+func (c *ListCache) TryTrim(key string, start int, stop int) (bool, int, int) {
+	v, ok := c.getValueWithExpiration(key)
+	if !ok {
+		return false, 0, 0
+	}
+	n := len(v.Values)
+	start, stop = listRange(start, stop, n)
+	var values []string
+	bytes := int64(0)
+	if start <= stop {
+		values = append(values, v.Values[start:stop+1]...)
+		bytes = v.Bytes - valuesBytes(v.Values[:start]) - valuesBytes(v.Values[stop+1:])
+	}
+	if len(values) < n {
+		c.update(key, values, bytes, v.CacheEntryData)
+	}
+	return true, n - len(values), len(values)
+}
+
 // GetKeys returns all the keys in the map.
 func (c *ListCache) GetKeys() []string {
 	var keySlice []string
@@ -131,11 +333,49 @@ func (c *ListCache) GetKeys() []string {
 	return keySlice
 }
 
+// GetStats returns cache usage counters.
+func (c *ListCache) GetStats() CacheStats {
+	stats := c.Evictor.Stats()
+	stats.Reaped = c.Reaped
+	return stats
+}
+
+// RemoveExpired deletes expired entries until all the keys are checked or the time budget is spent.
+// Go maps are iterated in random order, so the next sweep starts from another key.
+func (c *ListCache) RemoveExpired(budget time.Duration) int {
+	started := time.Now()
+	n, checked := 0, 0
+	for key, v := range c.Map {
+		if IsCacheEntryExpired(v.CacheEntryData) {
+			c.remove(key)
+			n++
+		}
+		checked++
+		if isOverBudget(started, budget, checked) {
+			break
+		}
+	}
+	c.Reaped += int64(n)
+	return n
+}
+
 func (c *ListCache) getValueWithExpiration(key string) (ListCacheEntry, bool) {
+	v, ok := c.peek(key)
+	if ok {
+		c.Evictor.Touch(key)
+		if v.slide() {
+			c.store(key, v)
+		}
+	}
+	return v, ok
+}
+
+// peek returns the entry removing it if expired, the entry is not marked as used.
+func (c *ListCache) peek(key string) (ListCacheEntry, bool) {
 	v, ok := c.Map[key]
 	if ok {
 		if IsCacheEntryExpired(v.CacheEntryData) {
-			delete(c.Map, key)
+			c.remove(key)
 			log.Printf("[ListCache] key %s had expired and was removed", key)
 			return v, false // expired
 		}
@@ -144,20 +384,110 @@ func (c *ListCache) getValueWithExpiration(key string) (ListCacheEntry, bool) {
 	return v, ok
 }
 
-func (c *ListCache) replaceValueInArray(a []string, newValue string, originalValue string, delete bool) ([]string, bool) {
+// update stores new values of the list and their size as a new version,
+// the slices are never changed in place since they can be shared with replies.
+func (c *ListCache) update(key string, values []string, bytes int64, data CacheEntryData) {
+	if values == nil {
+		values = make([]string, 0)
+	}
+	c.store(key, ListCacheEntry{Values: values, Bytes: bytes, CacheEntryData: UpdateCacheEntryData(data)})
+}
+
+// listIndex converts negative index to the index from the start of the list and checks its range.
+func listIndex(index int, n int) (int, bool) {
+	if index < 0 {
+		index += n
+	}
+	return index, index >= 0 && index < n
+}
+
+// listRange normalizes start and stop indexes like LRANGE, start is greater than stop if the range is empty.
+func listRange(start int, stop int, n int) (int, int) {
+	if start < 0 {
+		start += n
+	}
+	if stop < 0 {
+		stop += n
+	}
+	if start < 0 {
+		start = 0
+	}
+	if stop >= n {
+		stop = n - 1
+	}
+	return start, stop
+}
+
+// replaceValueInArray returns the copy of the values with originalValue replaced and the number of values replaced.
+func (c *ListCache) replaceValueInArray(a []string, newValue string, originalValue string) ([]string, int) {
 	var ret []string
-	updated := false
+	n := 0
 	if a != nil {
 		for _, i := range a {
 			if i == originalValue {
-				if !delete {
-					ret = append(ret, newValue)
-				}
-				updated = true
+				ret = append(ret, newValue)
+				n++
 			} else {
 				ret = append(ret, i)
 			}
 		}
 	}
-	return ret, updated
+	return ret, n
+}
+
+// deleteValueInArray returns the copy of the values without up to count occurrences of the value and the number of values deleted.
+// The occurrences are taken from the head for positive count and from the tail for negative one, zero count means all of them.
+func (c *ListCache) deleteValueInArray(a []string, value string, count int) ([]string, int) {
+	limit := count
+	if limit < 0 {
+		limit = -limit
+	}
+	skip := make([]bool, len(a))
+	n := 0
+	for k := range a {
+		i := k
+		if count < 0 {
+			i = len(a) - 1 - k
+		}
+		if a[i] == value && (limit == 0 || n < limit) {
+			skip[i] = true
+			n++
+		}
+	}
+	ret := make([]string, 0, len(a)-n)
+	for i, s := range a {
+		if !skip[i] {
+			ret = append(ret, s)
+		}
+	}
+	return ret, n
+}
+
+// store puts the entry to the map and evicts other keys if the cache is over its limits.
+func (c *ListCache) store(key string, entry ListCacheEntry) {
+	c.Map[key] = entry
+	c.Evictor.Track(key, entry.size(key), entry.ExpireAfter)
+	for _, k := range c.Evictor.Evict(key) {
+		delete(c.Map, k)
+		log.Printf("[ListCache] key %s was evicted", k)
+	}
+}
+
+func (c *ListCache) remove(key string) {
+	delete(c.Map, key)
+	c.Evictor.Remove(key)
+}
+
+// size returns approximate memory used by the entry.
+func (v ListCacheEntry) size(key string) int64 {
+	return int64(len(key)) + v.Bytes
+}
+
+// valuesBytes returns the size of the values, it is used only for the values added or removed and for new entries.
+func valuesBytes(values []string) int64 {
+	n := 0
+	for _, s := range values {
+		n += len(s)
+	}
+	return int64(n)
 }
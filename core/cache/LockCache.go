@@ -0,0 +1,256 @@
+package cache
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"log"
+	"time"
+)
+
+var (
+	// ErrLockLease is returned when the lease of the lock is not positive.
+	ErrLockLease = errors.New("lease must be positive")
+)
+
+// Lock is a lease held by the owner. Fence is the fencing token the lock got when it was acquired,
+// it increases with each acquisition of the lock even if the lock was released or expired meanwhile.
+type Lock struct {
+	Owner    string
+	Fence    int64
+	Acquired int64
+}
+
+// LockFence is the last fencing token issued for the key, it is kept after the lock is released or expired.
+type LockFence struct {
+	Fence     int64
+	Persisted bool
+}
+
+// LockCacheEntry is a lock stored in the memory cache, the entry expires when the lease ends.
+type LockCacheEntry struct {
+	Value *Lock
+	CacheEntryData
+}
+
+// ILockCache is an interface for LockCache.
+type ILockCache interface {
+	TryGet(key string) (bool, *Lock, time.Duration)
+	TryAcquire(key string, owner string, lease time.Duration) (bool, *Lock, time.Duration, error)
+	TryRenew(key string, owner string, lease time.Duration) (bool, bool, *Lock, error)
+	TryRelease(key string, owner string) (bool, bool)
+	TryGetMetadata(key string) (bool, CacheEntryData, int64)
+	GetKeys() []string
+	GetStats() CacheStats
+	RemoveExpired(budget time.Duration) int
+}
+
+// ILockCachePersistence is an interface for persisting LockCache.
+type ILockCachePersistence interface {
+	TryGetSnapshot(key string) (bool, LockCacheEntry)
+	TryAddFromSnapshot(key string, entry LockCacheEntry) bool
+	TryAddFenceFromSnapshot(key string, fence int64)
+	GetFences() map[string]LockFence
+}
+
+// LockCache is a single-thread in-memory cache based on map[string]LockCacheEntry.
+// Fences keep the last fencing token of every key which was ever locked, so the tokens never go back.
+type LockCache struct {
+	Map     map[string]LockCacheEntry
+	Fences  map[string]LockFence
+	Evictor *Evictor
+	Reaped  int64
+}
+
+// NewLockOwner generates random owner token for the caller which did not specify one.
+func NewLockOwner() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// TryGet returns the lock with its remaining lease if it is held, the value must not be changed by the caller.
+func (c *LockCache) TryGet(key string) (bool, *Lock, time.Duration) {
+	v, ok := c.peek(key)
+	if !ok {
+		return false, nil, 0
+	}
+	c.Evictor.Touch(key)
+	return true, v.Value, v.RemainingTTL()
+}
+
+// TryGetSnapshot returns the value if contains the key specified.
+func (c *LockCache) TryGetSnapshot(key string) (bool, LockCacheEntry) {
+	v, ok := c.peek(key)
+	return ok, v
+}
+
+// TryGetMetadata returns timestamps, expiration, version and size in bytes of the entry if contains the key specified.
+// The entry is not marked as used.
+func (c *LockCache) TryGetMetadata(key string) (bool, CacheEntryData, int64) {
+	v, ok := c.peek(key)
+	return ok, v.CacheEntryData, v.size(key)
+}
+
+// TryAddFromSnapshot add new value to the cache by the key specified if the key is not already used.
+func (c *LockCache) TryAddFromSnapshot(key string, entry LockCacheEntry) bool {
+	_, ok := c.Map[key]
+	if !ok {
+		entry.ExpireAfter = normalizeExpiration(entry.ExpireAfter)
+		c.store(key, entry)
+		observeVersion(entry.Version)
+		c.TryAddFenceFromSnapshot(key, entry.Value.Fence)
+	}
+	return !ok
+}
+
+// TryAddFenceFromSnapshot restores the last fencing token of the key unless a greater one is already known.
+func (c *LockCache) TryAddFenceFromSnapshot(key string, fence int64) {
+	if f := c.Fences[key]; f.Fence < fence {
+		c.Fences[key] = LockFence{Fence: fence, Persisted: true}
+	} else if !f.Persisted {
+		f.Persisted = true
+		c.Fences[key] = f
+	}
+}
+
+// GetFences returns the last fencing tokens of all the keys which were ever locked, the map must not be changed by the caller.
+func (c *LockCache) GetFences() map[string]LockFence {
+	return c.Fences
+}
+
+// TryAcquire acquires the lock for the owner with the lease if it is not held by another owner, empty owner is generated.
+// The owner which already holds the lock gets its lease renewed with the same fence, so the acquisition can be retried safely.
+// If the lock is held by another owner false is returned with the holder and its remaining lease.
+func (c *LockCache) TryAcquire(key string, owner string, lease time.Duration) (bool, *Lock, time.Duration, error) {
+	if lease <= 0 {
+		return false, nil, 0, ErrLockLease
+	}
+	v, ok := c.peek(key)
+	if ok && v.Value.Owner != owner {
+		c.Evictor.Touch(key)
+		return false, v.Value, v.RemainingTTL(), nil
+	}
+	if ok {
+		v.CacheEntryData = UpdateCacheEntryData(v.CacheEntryData)
+	} else {
+		if owner == "" {
+			owner = NewLockOwner()
+		}
+		f := c.Fences[key]
+		f.Fence++
+		c.Fences[key] = f
+		v = LockCacheEntry{Value: &Lock{Owner: owner, Fence: f.Fence, Acquired: time.Now().UnixNano()}, CacheEntryData: NewCacheEntryData(lease)}
+	}
+	v.setTTL(lease, false)
+	c.store(key, v)
+	return true, v.Value, lease, nil
+}
+
+// TryRenew extends the lease of the lock if it is held by the owner, the first value is false if the lock is not held.
+func (c *LockCache) TryRenew(key string, owner string, lease time.Duration) (bool, bool, *Lock, error) {
+	if lease <= 0 {
+		return false, false, nil, ErrLockLease
+	}
+	v, ok := c.peek(key)
+	if !ok {
+		return false, false, nil, nil
+	}
+	if v.Value.Owner != owner {
+		return true, false, v.Value, nil
+	}
+	v.CacheEntryData = UpdateCacheEntryData(v.CacheEntryData)
+	v.setTTL(lease, false)
+	c.store(key, v)
+	return true, true, v.Value, nil
+}
+
+// TryRelease releases the lock if it is held by the owner, the first value is false if the lock is not held.
+func (c *LockCache) TryRelease(key string, owner string) (bool, bool) {
+	v, ok := c.peek(key)
+	if !ok {
+		return false, false
+	}
+	if v.Value.Owner != owner {
+		return true, false
+	}
+	c.remove(key)
+	return true, true
+}
+
+// GetKeys returns all the keys in the map.
+func (c *LockCache) GetKeys() []string {
+	var keySlice []string
+	for key, v := range c.Map {
+		if !IsCacheEntryExpired(v.CacheEntryData) {
+			keySlice = append(keySlice, key)
+		}
+	}
+	if keySlice == nil {
+		return make([]string, 0)
+	}
+	return keySlice
+}
+
+// GetStats returns cache usage counters.
+func (c *LockCache) GetStats() CacheStats {
+	stats := c.Evictor.Stats()
+	stats.Reaped = c.Reaped
+	return stats
+}
+
+// RemoveExpired deletes expired entries until all the keys are checked or the time budget is spent.
+// Go maps are iterated in random order, so the next sweep starts from another key.
+func (c *LockCache) RemoveExpired(budget time.Duration) int {
+	started := time.Now()
+	n, checked := 0, 0
+	for key, v := range c.Map {
+		if IsCacheEntryExpired(v.CacheEntryData) {
+			c.remove(key)
+			n++
+		}
+		checked++
+		if isOverBudget(started, budget, checked) {
+			break
+		}
+	}
+	c.Reaped += int64(n)
+	return n
+}
+
+// peek returns the entry removing it if expired, the entry is not marked as used.
+func (c *LockCache) peek(key string) (LockCacheEntry, bool) {
+	v, ok := c.Map[key]
+	if ok {
+		if IsCacheEntryExpired(v.CacheEntryData) {
+			c.remove(key)
+			log.Printf("[LockCache] key %s had expired and was removed", key)
+			return v, false // expired
+		}
+		return v, ok // not expired
+	}
+	return v, ok
+}
+
+// store puts the entry to the map and evicts other keys if the cache is over its limits.
+func (c *LockCache) store(key string, entry LockCacheEntry) {
+	c.Map[key] = entry
+	c.Evictor.Track(key, entry.size(key), entry.ExpireAfter)
+	for _, k := range c.Evictor.Evict(key) {
+		delete(c.Map, k)
+		log.Printf("[LockCache] key %s was evicted", k)
+	}
+}
+
+func (c *LockCache) remove(key string) {
+	delete(c.Map, key)
+	c.Evictor.Remove(key)
+}
+
+// size returns approximate memory used by the entry.
+func (v LockCacheEntry) size(key string) int64 {
+	if v.Value == nil {
+		return int64(len(key))
+	}
+	return int64(len(key)+len(v.Value.Owner)) + 16
+}
@@ -0,0 +1,24 @@
+package cache
+
+import "time"
+
+// sweepBatch is the number of keys checked between the time budget checks during the sweep.
+const sweepBatch = 32
+
+// SweepOptions defines how often expired entries are removed in background and how long one sweep can take.
+// Zero interval turns the sweep off, zero budget means the whole cache is checked at once.
+type SweepOptions struct {
+	Interval time.Duration
+	Budget   time.Duration
+}
+
+// Options holds memory settings of a single cache actor.
+type Options struct {
+	Eviction EvictionOptions
+	Sweep    SweepOptions
+}
+
+// isOverBudget returns true if the sweep started at the time specified has to stop.
+func isOverBudget(started time.Time, budget time.Duration, checked int) bool {
+	return budget > 0 && checked%sweepBatch == 0 && time.Since(started) > budget
+}
@@ -0,0 +1,263 @@
+package cache
+
+import (
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultQueueVisibility is the visibility timeout of the queue created without one.
+const DefaultQueueVisibility = 30 * time.Second
+
+var (
+	// ErrInvalidQueueReceipt is returned when the receipt is not "id-attempt".
+	ErrInvalidQueueReceipt = errors.New("invalid receipt")
+)
+
+// QueueItem is an item of the priority queue. NotBefore is the time in nanoseconds the item can be delivered after,
+// Deadline is the time the in-flight item returns to the queue if it is not acknowledged.
+type QueueItem struct {
+	ID        uint64
+	Value     string
+	Priority  int64
+	NotBefore int64
+	Enqueued  int64
+	Attempts  int64
+	Deadline  int64
+}
+
+// Receipt identifies the delivery of the item as "id-attempt", so the consumer which lost the item
+// due to the visibility timeout cannot acknowledge it after it was delivered again.
+func (i QueueItem) Receipt() string {
+	return strconv.FormatUint(i.ID, 10) + "-" + strconv.FormatInt(i.Attempts, 10)
+}
+
+// ParseQueueReceipt reads "id-attempt" receipt returned by QueueItem.Receipt.
+func ParseQueueReceipt(s string) (uint64, int64, error) {
+	parts := strings.SplitN(s, "-", 2)
+	if len(parts) != 2 {
+		return 0, 0, ErrInvalidQueueReceipt
+	}
+	id, err := strconv.ParseUint(parts[0], 10, 64)
+	if err != nil {
+		return 0, 0, ErrInvalidQueueReceipt
+	}
+	attempt, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return 0, 0, ErrInvalidQueueReceipt
+	}
+	return id, attempt, nil
+}
+
+// Queue is a priority queue of items with delayed delivery. Items with higher priority are delivered first,
+// items with the same priority are delivered in the order they were pushed.
+// Delivered items stay in flight until they are acknowledged or their visibility timeout passes,
+// items which failed MaxAttempts times are moved to the dead letters. Zero MaxAttempts means no limit.
+// Items are added only by Push and removed only by Ack, so the size of the queue is updated there.
+type Queue struct {
+	Items       []QueueItem
+	InFlight    []QueueItem
+	Dead        []QueueItem
+	NextID      uint64
+	Visibility  time.Duration
+	MaxAttempts int64
+	bytes       int64
+}
+
+// NewQueue creates empty queue with the default visibility timeout and the limit of delivery attempts,
+// zero visibility means DefaultQueueVisibility.
+func NewQueue(visibility time.Duration, maxAttempts int64) *Queue {
+	if visibility <= 0 {
+		visibility = DefaultQueueVisibility
+	}
+	return &Queue{NextID: 1, Visibility: visibility, MaxAttempts: maxAttempts}
+}
+
+// Push adds the item and returns its ID, zero notBefore means that the item can be delivered immediately.
+func (q *Queue) Push(value string, priority int64, notBefore int64, now time.Time) uint64 {
+	id := q.NextID
+	q.NextID++
+	item := QueueItem{ID: id, Value: value, Priority: priority, NotBefore: notBefore, Enqueued: now.UnixNano()}
+	q.insert(item)
+	q.bytes += item.size()
+	return id
+}
+
+// Pop delivers up to count items which can be delivered now in priority order and keeps them in flight
+// until the visibility timeout passes, zero visibility means the default timeout of the queue.
+func (q *Queue) Pop(count int, visibility time.Duration, now time.Time) []QueueItem {
+	q.Release(now)
+	if visibility <= 0 {
+		visibility = q.Visibility
+	}
+	res := make([]QueueItem, 0)
+	rest := q.Items[:0]
+	for _, item := range q.Items {
+		if len(res) < count && item.NotBefore <= now.UnixNano() {
+			item.Attempts++
+			item.Deadline = now.Add(visibility).UnixNano()
+			q.InFlight = append(q.InFlight, item)
+			res = append(res, item)
+		} else {
+			rest = append(rest, item)
+		}
+	}
+	q.Items = rest
+	return res
+}
+
+// Peek returns up to count items which can be delivered now in priority order without delivering them.
+func (q *Queue) Peek(count int, now time.Time) []QueueItem {
+	res := make([]QueueItem, 0)
+	for _, item := range q.Items {
+		if len(res) >= count {
+			break
+		}
+		if item.NotBefore <= now.UnixNano() {
+			res = append(res, item)
+		}
+	}
+	return res
+}
+
+// Ack removes the in-flight items by their receipts and returns the number of items acknowledged.
+func (q *Queue) Ack(receipts []string) (int, error) {
+	n := 0
+	for _, r := range receipts {
+		id, attempt, err := ParseQueueReceipt(r)
+		if err != nil {
+			return n, err
+		}
+		if i := q.findInFlight(id, attempt); i >= 0 {
+			q.bytes -= q.InFlight[i].size()
+			q.InFlight = append(q.InFlight[:i], q.InFlight[i+1:]...)
+			n++
+		}
+	}
+	return n, nil
+}
+
+// Nack returns the in-flight item to the queue to be delivered after the delay like the visibility timeout does.
+// The item is moved to the dead letters if it was delivered MaxAttempts times.
+// The first value is false if the receipt was not found, the second one is true if the item is dead.
+func (q *Queue) Nack(receipt string, delay time.Duration, now time.Time) (bool, bool, error) {
+	id, attempt, err := ParseQueueReceipt(receipt)
+	if err != nil {
+		return false, false, err
+	}
+	i := q.findInFlight(id, attempt)
+	if i < 0 {
+		return false, false, nil
+	}
+	item := q.InFlight[i]
+	q.InFlight = append(q.InFlight[:i], q.InFlight[i+1:]...)
+	item.NotBefore = 0
+	if delay > 0 {
+		item.NotBefore = now.Add(delay).UnixNano()
+	}
+	return true, q.retry(item), nil
+}
+
+// Release returns the in-flight items with passed visibility timeout to the queue or moves them to the dead letters,
+// returns the number of items released.
+func (q *Queue) Release(now time.Time) int {
+	n := 0
+	rest := q.InFlight[:0]
+	for _, item := range q.InFlight {
+		if item.Deadline > now.UnixNano() {
+			rest = append(rest, item)
+			continue
+		}
+		q.retry(item)
+		n++
+	}
+	q.InFlight = rest
+	return n
+}
+
+// Redrive moves up to count dead letters back to the queue resetting their attempts, zero count means all of them.
+func (q *Queue) Redrive(count int) int {
+	n := len(q.Dead)
+	if count > 0 && count < n {
+		n = count
+	}
+	for _, item := range q.Dead[:n] {
+		item.Attempts = 0
+		item.NotBefore = 0
+		q.insert(item)
+	}
+	q.Dead = append([]QueueItem(nil), q.Dead[n:]...)
+	return n
+}
+
+// GetDead returns up to count dead letters in the order they died, zero count means all of them.
+func (q *Queue) GetDead(count int) []QueueItem {
+	n := len(q.Dead)
+	if count > 0 && count < n {
+		n = count
+	}
+	return append(make([]QueueItem, 0, n), q.Dead[:n]...)
+}
+
+// Count returns the number of items which can be delivered now and the number of delayed items.
+func (q *Queue) Count(now time.Time) (int, int) {
+	ready := 0
+	for _, item := range q.Items {
+		if item.NotBefore <= now.UnixNano() {
+			ready++
+		}
+	}
+	return ready, len(q.Items) - ready
+}
+
+// retry returns the item to the queue or moves it to the dead letters, returns true if the item is dead.
+func (q *Queue) retry(item QueueItem) bool {
+	item.Deadline = 0
+	if q.MaxAttempts > 0 && item.Attempts >= q.MaxAttempts {
+		q.Dead = append(q.Dead, item)
+		return true
+	}
+	q.insert(item)
+	return false
+}
+
+// insert puts the item keeping the items sorted by priority descending and then by ID.
+func (q *Queue) insert(item QueueItem) {
+	i := sort.Search(len(q.Items), func(k int) bool {
+		other := q.Items[k]
+		return other.Priority < item.Priority || (other.Priority == item.Priority && other.ID > item.ID)
+	})
+	q.Items = append(q.Items, QueueItem{})
+	copy(q.Items[i+1:], q.Items[i:])
+	q.Items[i] = item
+}
+
+func (q *Queue) findInFlight(id uint64, attempt int64) int {
+	for i, item := range q.InFlight {
+		if item.ID == id && item.Attempts == attempt {
+			return i
+		}
+	}
+	return -1
+}
+
+// size returns approximate memory used by the queue.
+func (q *Queue) size() int64 {
+	return q.bytes
+}
+
+// countBytes walks the items once when the queue is restored, later changes update its size.
+func (q *Queue) countBytes() {
+	q.bytes = 0
+	for _, items := range [][]QueueItem{q.Items, q.InFlight, q.Dead} {
+		for _, item := range items {
+			q.bytes += item.size()
+		}
+	}
+}
+
+func (i QueueItem) size() int64 {
+	return int64(len(i.Value) + 48)
+}
@@ -0,0 +1,302 @@
+package cache
+
+import (
+	"log"
+	"time"
+)
+
+// QueueCacheEntry is a priority queue stored in the memory cache.
+type QueueCacheEntry struct {
+	Value *Queue
+	CacheEntryData
+}
+
+// IQueueCache is an interface for QueueCache.
+type IQueueCache interface {
+	TryGet(key string) (bool, *Queue)
+	TryAdd(key string, visibility time.Duration, maxAttempts int64, ttl time.Duration) bool
+	TryDelete(key string) bool
+	TryPush(key string, items []QueueItem) (bool, []uint64)
+	TryPop(key string, count int, visibility time.Duration) (bool, []QueueItem)
+	TryPeek(key string, count int) (bool, []QueueItem)
+	TryAck(key string, receipts []string) (bool, int, error)
+	TryNack(key string, receipt string, delay time.Duration) (bool, bool, bool, error)
+	TryGetDead(key string, count int) (bool, []QueueItem)
+	TryRedrive(key string, count int) (bool, int)
+	TryGetMetadata(key string) (bool, CacheEntryData, int64)
+	TrySetTTL(key string, ttl time.Duration, sliding bool) bool
+	GetKeys() []string
+	GetStats() CacheStats
+	RemoveExpired(budget time.Duration) int
+}
+
+// IQueueCachePersistence is an interface for persisting QueueCache.
+type IQueueCachePersistence interface {
+	TryGetSnapshot(key string) (bool, QueueCacheEntry)
+	TryAddFromSnapshot(key string, entry QueueCacheEntry) bool
+}
+
+// QueueCache is a single-thread in-memory cache based on map[string]QueueCacheEntry.
+type QueueCache struct {
+	Map     map[string]QueueCacheEntry
+	Evictor *Evictor
+	Reaped  int64
+}
+
+// TryGet returns the queue if contains the key specified, the value must not be changed by the caller.
+func (c *QueueCache) TryGet(key string) (bool, *Queue) {
+	v, ok := c.getQueue(key, time.Now())
+	return ok, v.Value
+}
+
+// TryGetSnapshot returns the value if contains the key specified.
+func (c *QueueCache) TryGetSnapshot(key string) (bool, QueueCacheEntry) {
+	v, ok := c.peek(key)
+	return ok, v
+}
+
+// TryGetMetadata returns timestamps, expiration, version and size in bytes of the entry if contains the key specified.
+// The entry is not marked as used and sliding ttl is not renewed.
+func (c *QueueCache) TryGetMetadata(key string) (bool, CacheEntryData, int64) {
+	v, ok := c.peek(key)
+	return ok, v.CacheEntryData, v.size(key)
+}
+
+// TrySetTTL sets new ttl for the existing key keeping its value and version, zero ttl makes the key permanent.
+// Sliding ttl is renewed each time the key is used.
+func (c *QueueCache) TrySetTTL(key string, ttl time.Duration, sliding bool) bool {
+	v, ok := c.peek(key)
+	if ok {
+		v.setTTL(ttl, sliding)
+		c.store(key, v)
+	}
+	return ok
+}
+
+// TryAdd add new empty queue with the default visibility timeout and the limit of delivery attempts
+// to the cache by the key specified if the key is not already used.
+func (c *QueueCache) TryAdd(key string, visibility time.Duration, maxAttempts int64, ttl time.Duration) bool {
+	_, ok := c.getValueWithExpiration(key)
+	if !ok {
+		c.store(key, QueueCacheEntry{Value: NewQueue(visibility, maxAttempts), CacheEntryData: NewCacheEntryData(ttl)})
+	}
+	return !ok
+}
+
+// TryAddFromSnapshot add new value to the cache by the key specified if the key is not already used.
+func (c *QueueCache) TryAddFromSnapshot(key string, entry QueueCacheEntry) bool {
+	_, ok := c.Map[key]
+	if !ok {
+		entry.ExpireAfter = normalizeExpiration(entry.ExpireAfter)
+		if entry.Value != nil {
+			entry.Value.countBytes()
+		}
+		c.store(key, entry)
+		observeVersion(entry.Version)
+	}
+	return !ok
+}
+
+// TryDelete deletes the queue by the key specified if the key is already used.
+func (c *QueueCache) TryDelete(key string) bool {
+	_, ok := c.getValueWithExpiration(key)
+	if ok {
+		c.remove(key)
+	}
+	return ok
+}
+
+// TryPush adds the items with their values, priorities and delivery times and returns their IDs.
+func (c *QueueCache) TryPush(key string, items []QueueItem) (bool, []uint64) {
+	now := time.Now()
+	v, ok := c.getQueue(key, now)
+	if !ok {
+		return false, nil
+	}
+	ids := make([]uint64, len(items))
+	for i, item := range items {
+		ids[i] = v.Value.Push(item.Value, item.Priority, item.NotBefore, now)
+	}
+	if len(ids) > 0 {
+		c.update(key, v)
+	}
+	return true, ids
+}
+
+// TryPop delivers up to count items with the highest priority which can be delivered now,
+// zero visibility means the default visibility timeout of the queue.
+func (c *QueueCache) TryPop(key string, count int, visibility time.Duration) (bool, []QueueItem) {
+	now := time.Now()
+	v, ok := c.getQueue(key, now)
+	if !ok {
+		return false, nil
+	}
+	items := v.Value.Pop(count, visibility, now)
+	if len(items) > 0 {
+		c.update(key, v)
+	}
+	return true, items
+}
+
+// TryPeek returns up to count items which would be delivered next without delivering them.
+func (c *QueueCache) TryPeek(key string, count int) (bool, []QueueItem) {
+	now := time.Now()
+	v, ok := c.getQueue(key, now)
+	if !ok {
+		return false, nil
+	}
+	return true, v.Value.Peek(count, now)
+}
+
+// TryAck removes the in-flight items by their receipts and returns the number of items acknowledged.
+func (c *QueueCache) TryAck(key string, receipts []string) (bool, int, error) {
+	v, ok := c.getQueue(key, time.Now())
+	if !ok {
+		return false, 0, nil
+	}
+	n, err := v.Value.Ack(receipts)
+	if n > 0 {
+		c.update(key, v)
+	}
+	return true, n, err
+}
+
+// TryNack returns the in-flight item to the queue after the delay or moves it to the dead letters.
+// The second value is false if the receipt was not found, the third one is true if the item is dead.
+func (c *QueueCache) TryNack(key string, receipt string, delay time.Duration) (bool, bool, bool, error) {
+	now := time.Now()
+	v, ok := c.getQueue(key, now)
+	if !ok {
+		return false, false, false, nil
+	}
+	found, dead, err := v.Value.Nack(receipt, delay, now)
+	if found {
+		c.update(key, v)
+	}
+	return true, found, dead, err
+}
+
+// TryGetDead returns up to count dead letters in the order they died, zero count means all of them.
+func (c *QueueCache) TryGetDead(key string, count int) (bool, []QueueItem) {
+	v, ok := c.getQueue(key, time.Now())
+	if !ok {
+		return false, nil
+	}
+	return true, v.Value.GetDead(count)
+}
+
+// TryRedrive moves up to count dead letters back to the queue, zero count means all of them.
+func (c *QueueCache) TryRedrive(key string, count int) (bool, int) {
+	v, ok := c.getQueue(key, time.Now())
+	if !ok {
+		return false, 0
+	}
+	n := v.Value.Redrive(count)
+	if n > 0 {
+		c.update(key, v)
+	}
+	return true, n
+}
+
+// GetKeys returns all the keys in the map.
+func (c *QueueCache) GetKeys() []string {
+	var keySlice []string
+	for key, v := range c.Map {
+		if !IsCacheEntryExpired(v.CacheEntryData) {
+			keySlice = append(keySlice, key)
+		}
+	}
+	if keySlice == nil {
+		return make([]string, 0)
+	}
+	return keySlice
+}
+
+// GetStats returns cache usage counters.
+func (c *QueueCache) GetStats() CacheStats {
+	stats := c.Evictor.Stats()
+	stats.Reaped = c.Reaped
+	return stats
+}
+
+// RemoveExpired deletes expired entries until all the keys are checked or the time budget is spent.
+// Go maps are iterated in random order, so the next sweep starts from another key.
+func (c *QueueCache) RemoveExpired(budget time.Duration) int {
+	started := time.Now()
+	n, checked := 0, 0
+	for key, v := range c.Map {
+		if IsCacheEntryExpired(v.CacheEntryData) {
+			c.remove(key)
+			n++
+		}
+		checked++
+		if isOverBudget(started, budget, checked) {
+			break
+		}
+	}
+	c.Reaped += int64(n)
+	return n
+}
+
+func (c *QueueCache) getValueWithExpiration(key string) (QueueCacheEntry, bool) {
+	v, ok := c.peek(key)
+	if ok {
+		c.Evictor.Touch(key)
+		if v.slide() {
+			c.store(key, v)
+		}
+	}
+	return v, ok
+}
+
+// peek returns the entry removing it if expired, the entry is not marked as used.
+func (c *QueueCache) peek(key string) (QueueCacheEntry, bool) {
+	v, ok := c.Map[key]
+	if ok {
+		if IsCacheEntryExpired(v.CacheEntryData) {
+			c.remove(key)
+			log.Printf("[QueueCache] key %s had expired and was removed", key)
+			return v, false // expired
+		}
+		return v, ok // not expired
+	}
+	return v, ok
+}
+
+// getQueue returns the entry releasing the in-flight items with passed visibility timeout,
+// the version is changed if any item was released.
+func (c *QueueCache) getQueue(key string, now time.Time) (QueueCacheEntry, bool) {
+	v, ok := c.getValueWithExpiration(key)
+	if ok && v.Value.Release(now) > 0 {
+		c.update(key, v)
+	}
+	return v, ok
+}
+
+// update stores the changed queue with the new version.
+func (c *QueueCache) update(key string, v QueueCacheEntry) {
+	c.store(key, QueueCacheEntry{Value: v.Value, CacheEntryData: UpdateCacheEntryData(v.CacheEntryData)})
+}
+
+// store puts the entry to the map and evicts other keys if the cache is over its limits.
+func (c *QueueCache) store(key string, entry QueueCacheEntry) {
+	c.Map[key] = entry
+	c.Evictor.Track(key, entry.size(key), entry.ExpireAfter)
+	for _, k := range c.Evictor.Evict(key) {
+		delete(c.Map, k)
+		log.Printf("[QueueCache] key %s was evicted", k)
+	}
+}
+
+func (c *QueueCache) remove(key string) {
+	delete(c.Map, key)
+	c.Evictor.Remove(key)
+}
+
+// size returns approximate memory used by the entry.
+func (v QueueCacheEntry) size(key string) int64 {
+	if v.Value == nil {
+		return int64(len(key))
+	}
+	return int64(len(key)) + v.Value.size()
+}
@@ -0,0 +1,202 @@
+package cache
+
+import (
+	"errors"
+	"math"
+	"time"
+)
+
+const (
+	// RateLimitTokenBucket is the algorithm which refills the bucket of capacity tokens continuously at the refill rate.
+	RateLimitTokenBucket = "token-bucket"
+	// RateLimitSlidingLog is the algorithm which allows capacity units within any window, the window is capacity / refill rate by default.
+	RateLimitSlidingLog = "sliding-log"
+)
+
+var (
+	// ErrRateLimitAlgorithm is returned when the algorithm is not token-bucket or sliding-log.
+	ErrRateLimitAlgorithm = errors.New("algorithm must be token-bucket or sliding-log")
+	// ErrRateLimitCapacity is returned when the capacity is not positive.
+	ErrRateLimitCapacity = errors.New("capacity must be positive")
+	// ErrRateLimitRefillRate is returned when the refill rate is not positive and the window is not specified.
+	ErrRateLimitRefillRate = errors.New("refill rate must be positive")
+	// ErrRateLimitCost is returned when the cost is not positive or exceeds the capacity, so it could never be allowed.
+	ErrRateLimitCost = errors.New("cost must be positive and must not exceed capacity")
+)
+
+// RateLimitHit is the cost taken from the sliding log at the time in nanoseconds.
+type RateLimitHit struct {
+	Time int64
+	Cost int64
+}
+
+// RateLimitResult is the decision of the rate limiter. RetryAfter is the time after which the same cost would be allowed,
+// ResetAfter is the time after which the limiter is full again.
+type RateLimitResult struct {
+	Allowed    bool
+	Remaining  int64
+	RetryAfter time.Duration
+	ResetAfter time.Duration
+}
+
+// RateLimiter limits the rate of the costs taken with either token bucket or sliding window log algorithm.
+// RefillRate is in units per second. Tokens and Refilled are the state of the bucket,
+// Log keeps the costs taken within the window ordered by time.
+type RateLimiter struct {
+	Algorithm  string
+	Capacity   int64
+	RefillRate float64
+	Window     time.Duration
+	Tokens     float64
+	Refilled   int64
+	Log        []RateLimitHit
+}
+
+// NewRateLimiter creates full rate limiter, empty algorithm means token bucket.
+func NewRateLimiter(algorithm string, capacity int64, refillRate float64, window time.Duration, now time.Time) (*RateLimiter, error) {
+	l := &RateLimiter{}
+	if err := l.Configure(algorithm, capacity, refillRate, window, now); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// Configure changes the limits keeping the state unless the algorithm is changed, the tokens above the new capacity are dropped.
+// Zero window of sliding log is the time the refill rate needs to refill the capacity.
+func (l *RateLimiter) Configure(algorithm string, capacity int64, refillRate float64, window time.Duration, now time.Time) error {
+	if algorithm == "" {
+		algorithm = RateLimitTokenBucket
+	}
+	if algorithm != RateLimitTokenBucket && algorithm != RateLimitSlidingLog {
+		return ErrRateLimitAlgorithm
+	}
+	if capacity <= 0 {
+		return ErrRateLimitCapacity
+	}
+	if algorithm == RateLimitSlidingLog && window <= 0 && refillRate > 0 {
+		window = time.Duration(float64(capacity) / refillRate * float64(time.Second))
+	}
+	if (algorithm == RateLimitTokenBucket && refillRate <= 0) || (algorithm == RateLimitSlidingLog && window <= 0) {
+		return ErrRateLimitRefillRate
+	}
+	if algorithm != l.Algorithm {
+		*l = RateLimiter{Algorithm: algorithm, Tokens: float64(capacity), Refilled: now.UnixNano()}
+	}
+	if algorithm == RateLimitTokenBucket {
+		l.refill(now)
+		l.Tokens = math.Min(l.Tokens, float64(capacity))
+		window = 0
+	} else {
+		refillRate = float64(capacity) / window.Seconds()
+	}
+	l.Capacity = capacity
+	l.RefillRate = refillRate
+	l.Window = window
+	return nil
+}
+
+// Take takes the cost from the limiter if it is allowed now, the cost which is not allowed is not taken.
+func (l *RateLimiter) Take(cost int64, now time.Time) (RateLimitResult, error) {
+	if cost <= 0 || cost > l.Capacity {
+		return RateLimitResult{}, ErrRateLimitCost
+	}
+	if l.Algorithm == RateLimitSlidingLog {
+		return l.takeLog(cost, now), nil
+	}
+	return l.takeTokens(cost, now), nil
+}
+
+// State returns the remaining capacity and the time after which the limiter is full again without taking anything.
+func (l *RateLimiter) State(now time.Time) RateLimitResult {
+	if l.Algorithm == RateLimitSlidingLog {
+		used := l.trim(now)
+		return RateLimitResult{Allowed: used < l.Capacity, Remaining: l.Capacity - used, ResetAfter: l.logResetAfter(now)}
+	}
+	l.refill(now)
+	return RateLimitResult{Allowed: l.Tokens >= 1, Remaining: int64(l.Tokens), ResetAfter: l.tokensAfter(float64(l.Capacity))}
+}
+
+func (l *RateLimiter) takeTokens(cost int64, now time.Time) RateLimitResult {
+	l.refill(now)
+	res := RateLimitResult{}
+	if l.Tokens >= float64(cost) {
+		l.Tokens -= float64(cost)
+		res.Allowed = true
+	} else {
+		res.RetryAfter = l.tokensAfter(float64(cost))
+	}
+	res.Remaining = int64(l.Tokens)
+	res.ResetAfter = l.tokensAfter(float64(l.Capacity))
+	return res
+}
+
+func (l *RateLimiter) takeLog(cost int64, now time.Time) RateLimitResult {
+	used := l.trim(now)
+	res := RateLimitResult{}
+	if used+cost <= l.Capacity {
+		l.Log = append(l.Log, RateLimitHit{Time: now.UnixNano(), Cost: cost})
+		used += cost
+		res.Allowed = true
+	} else {
+		// the cost is allowed when enough of the oldest hits leave the window
+		need, freed := used+cost-l.Capacity, int64(0)
+		for _, h := range l.Log {
+			freed += h.Cost
+			if freed >= need {
+				res.RetryAfter = time.Duration(h.Time + int64(l.Window) - now.UnixNano())
+				break
+			}
+		}
+	}
+	res.Remaining = l.Capacity - used
+	res.ResetAfter = l.logResetAfter(now)
+	return res
+}
+
+// refill adds the tokens refilled since the last refill up to the capacity.
+func (l *RateLimiter) refill(now time.Time) {
+	if elapsed := now.UnixNano() - l.Refilled; elapsed > 0 {
+		l.Tokens = math.Min(float64(l.Capacity), l.Tokens+time.Duration(elapsed).Seconds()*l.RefillRate)
+	}
+	l.Refilled = now.UnixNano()
+}
+
+// tokensAfter returns the time after which the bucket has n tokens.
+func (l *RateLimiter) tokensAfter(n float64) time.Duration {
+	if l.Tokens >= n {
+		return 0
+	}
+	return time.Duration(math.Ceil((n - l.Tokens) / l.RefillRate * float64(time.Second)))
+}
+
+// trim removes the hits which left the window and returns the cost taken within the window.
+func (l *RateLimiter) trim(now time.Time) int64 {
+	start := now.UnixNano() - int64(l.Window)
+	i := 0
+	for i < len(l.Log) && l.Log[i].Time <= start {
+		i++
+	}
+	l.Log = l.Log[i:]
+	// the hits left in the window are copied only when most of the array is unused, so each hit is not copied on every call
+	if cap(l.Log) > 2*len(l.Log) {
+		l.Log = append(make([]RateLimitHit, 0, 2*len(l.Log)), l.Log...)
+	}
+	used := int64(0)
+	for _, h := range l.Log {
+		used += h.Cost
+	}
+	return used
+}
+
+// logResetAfter returns the time after which the last hit leaves the window.
+func (l *RateLimiter) logResetAfter(now time.Time) time.Duration {
+	if len(l.Log) == 0 {
+		return 0
+	}
+	return time.Duration(l.Log[len(l.Log)-1].Time + int64(l.Window) - now.UnixNano())
+}
+
+// size returns approximate memory used by the rate limiter.
+func (l *RateLimiter) size() int64 {
+	return int64(len(l.Algorithm) + 48 + 16*len(l.Log))
+}
@@ -0,0 +1,190 @@
+package cache
+
+import (
+	"log"
+	"time"
+)
+
+// RateLimitCacheEntry is a rate limiter stored in the memory cache.
+type RateLimitCacheEntry struct {
+	Value *RateLimiter
+	CacheEntryData
+}
+
+// IRateLimitCache is an interface for RateLimitCache.
+type IRateLimitCache interface {
+	TryGet(key string) (bool, *RateLimiter, RateLimitResult)
+	TryDelete(key string) bool
+	TryTake(key string, algorithm string, capacity int64, refillRate float64, window time.Duration, cost int64) (RateLimitResult, *RateLimiter, error)
+	TryGetMetadata(key string) (bool, CacheEntryData, int64)
+	GetKeys() []string
+	GetStats() CacheStats
+	RemoveExpired(budget time.Duration) int
+}
+
+// IRateLimitCachePersistence is an interface for persisting RateLimitCache.
+type IRateLimitCachePersistence interface {
+	TryGetSnapshot(key string) (bool, RateLimitCacheEntry)
+	TryAddFromSnapshot(key string, entry RateLimitCacheEntry) bool
+}
+
+// RateLimitCache is a single-thread in-memory cache based on map[string]RateLimitCacheEntry.
+// The entry expires when its limiter is full again, since it cannot be told from a new one.
+type RateLimitCache struct {
+	Map     map[string]RateLimitCacheEntry
+	Evictor *Evictor
+	Reaped  int64
+}
+
+// TryGet returns the rate limiter with its remaining capacity if contains the key specified, the value must not be changed by the caller.
+func (c *RateLimitCache) TryGet(key string) (bool, *RateLimiter, RateLimitResult) {
+	v, ok := c.peek(key)
+	if !ok {
+		return false, nil, RateLimitResult{}
+	}
+	c.Evictor.Touch(key)
+	return true, v.Value, v.Value.State(time.Now())
+}
+
+// TryGetSnapshot returns the value if contains the key specified.
+func (c *RateLimitCache) TryGetSnapshot(key string) (bool, RateLimitCacheEntry) {
+	v, ok := c.peek(key)
+	return ok, v
+}
+
+// TryGetMetadata returns timestamps, expiration, version and size in bytes of the entry if contains the key specified.
+// The entry is not marked as used.
+func (c *RateLimitCache) TryGetMetadata(key string) (bool, CacheEntryData, int64) {
+	v, ok := c.peek(key)
+	return ok, v.CacheEntryData, v.size(key)
+}
+
+// TryAddFromSnapshot add new value to the cache by the key specified if the key is not already used.
+func (c *RateLimitCache) TryAddFromSnapshot(key string, entry RateLimitCacheEntry) bool {
+	_, ok := c.Map[key]
+	if !ok {
+		entry.ExpireAfter = normalizeExpiration(entry.ExpireAfter)
+		c.store(key, entry)
+		observeVersion(entry.Version)
+	}
+	return !ok
+}
+
+// TryDelete deletes the rate limiter by the key specified if the key is already used, so the limiter is full again.
+func (c *RateLimitCache) TryDelete(key string) bool {
+	_, ok := c.peek(key)
+	if ok {
+		c.remove(key)
+	}
+	return ok
+}
+
+// TryTake takes the cost from the rate limiter creating the missing one, the limits of the existing one are changed if they differ.
+// The cost which is not allowed is not taken.
+func (c *RateLimitCache) TryTake(key string, algorithm string, capacity int64, refillRate float64, window time.Duration, cost int64) (RateLimitResult, *RateLimiter, error) {
+	now := time.Now()
+	v, ok := c.peek(key)
+	if ok {
+		limiter := *v.Value
+		if err := limiter.Configure(algorithm, capacity, refillRate, window, now); err != nil {
+			return RateLimitResult{}, nil, err
+		}
+		v.Value = &limiter
+		v.CacheEntryData = UpdateCacheEntryData(v.CacheEntryData)
+	} else {
+		limiter, err := NewRateLimiter(algorithm, capacity, refillRate, window, now)
+		if err != nil {
+			return RateLimitResult{}, nil, err
+		}
+		v = RateLimitCacheEntry{Value: limiter, CacheEntryData: NewCacheEntryData(0)}
+	}
+	res, err := v.Value.Take(cost, now)
+	if err != nil {
+		return res, nil, err
+	}
+	ttl := res.ResetAfter
+	if ttl < time.Millisecond {
+		ttl = time.Millisecond
+	}
+	v.setTTL(ttl, false)
+	c.Evictor.Touch(key)
+	c.store(key, v)
+	return res, v.Value, nil
+}
+
+// GetKeys returns all the keys in the map.
+func (c *RateLimitCache) GetKeys() []string {
+	var keySlice []string
+	for key, v := range c.Map {
+		if !IsCacheEntryExpired(v.CacheEntryData) {
+			keySlice = append(keySlice, key)
+		}
+	}
+	if keySlice == nil {
+		return make([]string, 0)
+	}
+	return keySlice
+}
+
+// GetStats returns cache usage counters.
+func (c *RateLimitCache) GetStats() CacheStats {
+	stats := c.Evictor.Stats()
+	stats.Reaped = c.Reaped
+	return stats
+}
+
+// RemoveExpired deletes expired entries until all the keys are checked or the time budget is spent.
+// Go maps are iterated in random order, so the next sweep starts from another key.
+func (c *RateLimitCache) RemoveExpired(budget time.Duration) int {
+	started := time.Now()
+	n, checked := 0, 0
+	for key, v := range c.Map {
+		if IsCacheEntryExpired(v.CacheEntryData) {
+			c.remove(key)
+			n++
+		}
+		checked++
+		if isOverBudget(started, budget, checked) {
+			break
+		}
+	}
+	c.Reaped += int64(n)
+	return n
+}
+
+// peek returns the entry removing it if expired, the entry is not marked as used.
+func (c *RateLimitCache) peek(key string) (RateLimitCacheEntry, bool) {
+	v, ok := c.Map[key]
+	if ok {
+		if IsCacheEntryExpired(v.CacheEntryData) {
+			c.remove(key)
+			log.Printf("[RateLimitCache] key %s had expired and was removed", key)
+			return v, false // expired
+		}
+		return v, ok // not expired
+	}
+	return v, ok
+}
+
+// store puts the entry to the map and evicts other keys if the cache is over its limits.
+func (c *RateLimitCache) store(key string, entry RateLimitCacheEntry) {
+	c.Map[key] = entry
+	c.Evictor.Track(key, entry.size(key), entry.ExpireAfter)
+	for _, k := range c.Evictor.Evict(key) {
+		delete(c.Map, k)
+		log.Printf("[RateLimitCache] key %s was evicted", k)
+	}
+}
+
+func (c *RateLimitCache) remove(key string) {
+	delete(c.Map, key)
+	c.Evictor.Remove(key)
+}
+
+// size returns approximate memory used by the entry.
+func (v RateLimitCacheEntry) size(key string) int64 {
+	if v.Value == nil {
+		return int64(len(key))
+	}
+	return int64(len(key)) + v.Value.size()
+}
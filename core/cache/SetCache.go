@@ -0,0 +1,339 @@
+package cache
+
+import (
+	"log"
+	"sort"
+	"time"
+)
+
+// SetCacheEntry is a set of unique strings stored in the memory cache.
+// Bytes is the size of the members, it is kept up to date by each change, so the set is not walked on every write.
+type SetCacheEntry struct {
+	Members map[string]struct{}
+	Bytes   int64
+	CacheEntryData
+}
+
+// ISetCache is an interface for SetCache.
+type ISetCache interface {
+	TryGet(key string) (bool, []string)
+	TryAdd(key string, members []string, ttl time.Duration) bool
+	TryDelete(key string) (bool, []string)
+	TryAddMembers(key string, members []string) (bool, int)
+	TryRemoveMembers(key string, members []string) (bool, int)
+	TryContains(key string, member string) (bool, bool)
+	TryGetCardinality(key string) (bool, int)
+	TryGetRandomMembers(key string, count int) (bool, []string)
+	TryGetMetadata(key string) (bool, CacheEntryData, int64)
+	TrySetTTL(key string, ttl time.Duration, sliding bool) bool
+	GetKeys() []string
+	GetStats() CacheStats
+	RemoveExpired(budget time.Duration) int
+}
+
+// ISetCachePersistence is an interface for persisting SetCache.
+type ISetCachePersistence interface {
+	TryGetSnapshot(key string) (bool, SetCacheEntry)
+	TryAddFromSnapshot(key string, entry SetCacheEntry) bool
+}
+
+// SetCache is a single-thread in-memory cache based on map[string]SetCacheEntry.
+type SetCache struct {
+	Map     map[string]SetCacheEntry
+	Evictor *Evictor
+	Reaped  int64
+}
+
+// TryGet returns sorted set members if contains the key specified.
+func (c *SetCache) TryGet(key string) (bool, []string) {
+	v, ok := c.getValueWithExpiration(key)
+	return ok, FromSet(v.Members)
+}
+
+// TryGetSnapshot returns the value if contains the key specified.
+func (c *SetCache) TryGetSnapshot(key string) (bool, SetCacheEntry) {
+	v, ok := c.peek(key)
+	return ok, v
+}
+
+// TryGetMetadata returns timestamps, expiration, version and size in bytes of the entry if contains the key specified.
+// The entry is not marked as used and sliding ttl is not renewed.
+func (c *SetCache) TryGetMetadata(key string) (bool, CacheEntryData, int64) {
+	v, ok := c.peek(key)
+	return ok, v.CacheEntryData, v.size(key)
+}
+
+// TrySetTTL sets new ttl for the existing key keeping its value and version, zero ttl makes the key permanent.
+// Sliding ttl is renewed each time the key is used.
+func (c *SetCache) TrySetTTL(key string, ttl time.Duration, sliding bool) bool {
+	v, ok := c.peek(key)
+	if ok {
+		v.setTTL(ttl, sliding)
+		c.store(key, v)
+	}
+	return ok
+}
+
+// TryAdd add new set to the cache by the key specified if the key is not already used, duplicate members are ignored.
+func (c *SetCache) TryAdd(key string, members []string, ttl time.Duration) bool {
+	_, ok := c.getValueWithExpiration(key)
+	if !ok {
+		v := SetCacheEntry{Members: ToSet(members), CacheEntryData: NewCacheEntryData(ttl)}
+		v.countBytes()
+		c.store(key, v)
+	}
+	return !ok
+}
+
+// TryAddFromSnapshot add new value to the cache by the key specified if the key is not already used.
+func (c *SetCache) TryAddFromSnapshot(key string, entry SetCacheEntry) bool {
+	_, ok := c.Map[key]
+	if !ok {
+		entry.ExpireAfter = normalizeExpiration(entry.ExpireAfter)
+		entry.countBytes()
+		c.store(key, entry)
+		observeVersion(entry.Version)
+	}
+	return !ok
+}
+
+// TryDelete deletes the set by the key specified if the key is already used.
+func (c *SetCache) TryDelete(key string) (bool, []string) {
+	v, ok := c.getValueWithExpiration(key)
+	if ok {
+		c.remove(key)
+	}
+	return ok, FromSet(v.Members)
+}
+
+// TryAddMembers adds the members which are not in the set yet, returns the number of members added.
+// The version is changed only if the set was changed.
+func (c *SetCache) TryAddMembers(key string, members []string) (bool, int) {
+	v, ok := c.getValueWithExpiration(key)
+	if !ok {
+		return false, 0
+	}
+	n := 0
+	for _, m := range members {
+		if _, exists := v.Members[m]; !exists {
+			v.Members[m] = struct{}{}
+			v.Bytes += int64(len(m))
+			n++
+		}
+	}
+	if n > 0 {
+		v.CacheEntryData = UpdateCacheEntryData(v.CacheEntryData)
+		c.store(key, v)
+	}
+	return true, n
+}
+
+// TryRemoveMembers removes the members from the set, returns the number of members removed.
+// The set is kept even if it becomes empty.
+func (c *SetCache) TryRemoveMembers(key string, members []string) (bool, int) {
+	v, ok := c.getValueWithExpiration(key)
+	if !ok {
+		return false, 0
+	}
+	n := 0
+	for _, m := range members {
+		if _, exists := v.Members[m]; exists {
+			delete(v.Members, m)
+			v.Bytes -= int64(len(m))
+			n++
+		}
+	}
+	if n > 0 {
+		v.CacheEntryData = UpdateCacheEntryData(v.CacheEntryData)
+		c.store(key, v)
+	}
+	return true, n
+}
+
+// TryContains returns true as the second value if the set by the key specified contains the member.
+func (c *SetCache) TryContains(key string, member string) (bool, bool) {
+	v, ok := c.getValueWithExpiration(key)
+	_, contains := v.Members[member]
+	return ok, contains
+}
+
+// TryGetCardinality returns the number of members in the set.
+func (c *SetCache) TryGetCardinality(key string) (bool, int) {
+	v, ok := c.getValueWithExpiration(key)
+	return ok, len(v.Members)
+}
+
+// TryGetRandomMembers returns up to count distinct random members of the set.
+func (c *SetCache) TryGetRandomMembers(key string, count int) (bool, []string) {
+	v, ok := c.getValueWithExpiration(key)
+	if count > len(v.Members) {
+		count = len(v.Members)
+	} else if count < 0 {
+		count = 0
+	}
+	members := make([]string, 0, count)
+	if ok {
+		// Go maps are iterated in random order
+		for m := range v.Members {
+			if len(members) >= count {
+				break
+			}
+			members = append(members, m)
+		}
+	}
+	return ok, members
+}
+
+// GetKeys returns all the keys in the map.
+func (c *SetCache) GetKeys() []string {
+	var keySlice []string
+	for key, v := range c.Map {
+		if !IsCacheEntryExpired(v.CacheEntryData) {
+			keySlice = append(keySlice, key)
+		}
+	}
+	if keySlice == nil {
+		return make([]string, 0)
+	}
+	return keySlice
+}
+
+// GetStats returns cache usage counters.
+func (c *SetCache) GetStats() CacheStats {
+	stats := c.Evictor.Stats()
+	stats.Reaped = c.Reaped
+	return stats
+}
+
+// RemoveExpired deletes expired entries until all the keys are checked or the time budget is spent.
+// Go maps are iterated in random order, so the next sweep starts from another key.
+func (c *SetCache) RemoveExpired(budget time.Duration) int {
+	started := time.Now()
+	n, checked := 0, 0
+	for key, v := range c.Map {
+		if IsCacheEntryExpired(v.CacheEntryData) {
+			c.remove(key)
+			n++
+		}
+		checked++
+		if isOverBudget(started, budget, checked) {
+			break
+		}
+	}
+	c.Reaped += int64(n)
+	return n
+}
+
+func (c *SetCache) getValueWithExpiration(key string) (SetCacheEntry, bool) {
+	v, ok := c.peek(key)
+	if ok {
+		c.Evictor.Touch(key)
+		if v.slide() {
+			c.store(key, v)
+		}
+	}
+	return v, ok
+}
+
+// peek returns the entry removing it if expired, the entry is not marked as used.
+func (c *SetCache) peek(key string) (SetCacheEntry, bool) {
+	v, ok := c.Map[key]
+	if ok {
+		if IsCacheEntryExpired(v.CacheEntryData) {
+			c.remove(key)
+			log.Printf("[SetCache] key %s had expired and was removed", key)
+			return v, false // expired
+		}
+		return v, ok // not expired
+	}
+	return v, ok
+}
+
+// store puts the entry to the map and evicts other keys if the cache is over its limits.
+func (c *SetCache) store(key string, entry SetCacheEntry) {
+	c.Map[key] = entry
+	c.Evictor.Track(key, entry.size(key), entry.ExpireAfter)
+	for _, k := range c.Evictor.Evict(key) {
+		delete(c.Map, k)
+		log.Printf("[SetCache] key %s was evicted", k)
+	}
+}
+
+func (c *SetCache) remove(key string) {
+	delete(c.Map, key)
+	c.Evictor.Remove(key)
+}
+
+// size returns approximate memory used by the entry.
+func (v SetCacheEntry) size(key string) int64 {
+	return int64(len(key)) + v.Bytes
+}
+
+// countBytes walks the members once when the entry is created or restored, later changes update Bytes.
+func (v *SetCacheEntry) countBytes() {
+	v.Bytes = 0
+	for m := range v.Members {
+		v.Bytes += int64(len(m))
+	}
+}
+
+// ToSet converts string array to set, duplicates are removed.
+func ToSet(members []string) map[string]struct{} {
+	var m = make(map[string]struct{}, len(members))
+	for _, s := range members {
+		m[s] = struct{}{}
+	}
+	return m
+}
+
+// FromSet converts set to sorted string array.
+func FromSet(set map[string]struct{}) []string {
+	var a = make([]string, 0, len(set))
+	for s := range set {
+		a = append(a, s)
+	}
+	sort.Strings(a)
+	return a
+}
+
+// Union returns sorted members which are in any of the sets.
+func Union(sets ...[]string) []string {
+	res := make(map[string]struct{})
+	for _, set := range sets {
+		for _, m := range set {
+			res[m] = struct{}{}
+		}
+	}
+	return FromSet(res)
+}
+
+// Intersection returns sorted members which are in all the sets.
+func Intersection(sets ...[]string) []string {
+	if len(sets) == 0 {
+		return make([]string, 0)
+	}
+	res := ToSet(sets[0])
+	for _, set := range sets[1:] {
+		other := ToSet(set)
+		for m := range res {
+			if _, ok := other[m]; !ok {
+				delete(res, m)
+			}
+		}
+	}
+	return FromSet(res)
+}
+
+// Difference returns sorted members of the first set which are not in any of the other sets.
+func Difference(sets ...[]string) []string {
+	if len(sets) == 0 {
+		return make([]string, 0)
+	}
+	res := ToSet(sets[0])
+	for _, set := range sets[1:] {
+		for _, m := range set {
+			delete(res, m)
+		}
+	}
+	return FromSet(res)
+}
@@ -0,0 +1,258 @@
+package cache
+
+import (
+	"math/rand"
+)
+
+const (
+	skipListMaxLevel    = 32
+	skipListProbability = 0.25
+)
+
+// ScoredMember is a sorted set member with its score.
+type ScoredMember struct {
+	Member string
+	Score  float64
+}
+
+// ScoreRange is an interval of scores, each bound can be inclusive or exclusive.
+type ScoreRange struct {
+	Min          float64
+	Max          float64
+	MinExclusive bool
+	MaxExclusive bool
+}
+
+// SkipList keeps members ordered by score and then by member like Redis sorted sets.
+// Each link stores the number of nodes it skips, so ranks are found in O(log n).
+type SkipList struct {
+	head   *skipListNode
+	tail   *skipListNode
+	length int
+	level  int
+}
+
+type skipListNode struct {
+	ScoredMember
+	backward *skipListNode
+	level    []skipListLevel
+}
+
+type skipListLevel struct {
+	forward *skipListNode
+	span    int
+}
+
+// NewSkipList creates new empty SkipList.
+func NewSkipList() *SkipList {
+	return &SkipList{head: &skipListNode{level: make([]skipListLevel, skipListMaxLevel)}, level: 1}
+}
+
+// Len returns the number of members in the list.
+func (s *SkipList) Len() int {
+	return s.length
+}
+
+// Insert adds the member with the score specified, the member must not be in the list.
+func (s *SkipList) Insert(member string, score float64) {
+	var update [skipListMaxLevel]*skipListNode
+	var rank [skipListMaxLevel]int
+	x := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		if i < s.level-1 {
+			rank[i] = rank[i+1]
+		}
+		for x.level[i].forward != nil && x.level[i].forward.less(score, member) {
+			rank[i] += x.level[i].span
+			x = x.level[i].forward
+		}
+		update[i] = x
+	}
+	level := randomSkipListLevel()
+	if level > s.level {
+		for i := s.level; i < level; i++ {
+			update[i] = s.head
+			update[i].level[i].span = s.length
+		}
+		s.level = level
+	}
+	x = &skipListNode{ScoredMember: ScoredMember{Member: member, Score: score}, level: make([]skipListLevel, level)}
+	for i := 0; i < level; i++ {
+		x.level[i].forward = update[i].level[i].forward
+		update[i].level[i].forward = x
+		x.level[i].span = update[i].level[i].span - (rank[0] - rank[i])
+		update[i].level[i].span = rank[0] - rank[i] + 1
+	}
+	for i := level; i < s.level; i++ {
+		update[i].level[i].span++
+	}
+	if update[0] != s.head {
+		x.backward = update[0]
+	}
+	if x.level[0].forward != nil {
+		x.level[0].forward.backward = x
+	} else {
+		s.tail = x
+	}
+	s.length++
+}
+
+// Delete removes the member with the score specified, returns false if there is no such member.
+func (s *SkipList) Delete(member string, score float64) bool {
+	var update [skipListMaxLevel]*skipListNode
+	x := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for x.level[i].forward != nil && x.level[i].forward.less(score, member) {
+			x = x.level[i].forward
+		}
+		update[i] = x
+	}
+	x = x.level[0].forward
+	if x == nil || x.Score != score || x.Member != member {
+		return false
+	}
+	for i := 0; i < s.level; i++ {
+		if update[i].level[i].forward == x {
+			update[i].level[i].span += x.level[i].span - 1
+			update[i].level[i].forward = x.level[i].forward
+		} else {
+			update[i].level[i].span--
+		}
+	}
+	if x.level[0].forward != nil {
+		x.level[0].forward.backward = x.backward
+	} else {
+		s.tail = x.backward
+	}
+	for s.level > 1 && s.head.level[s.level-1].forward == nil {
+		s.level--
+	}
+	s.length--
+	return true
+}
+
+// Rank returns zero-based rank of the member with the score specified or -1 if there is no such member.
+func (s *SkipList) Rank(member string, score float64) int {
+	rank := 0
+	x := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for x.level[i].forward != nil && !x.level[i].forward.greater(score, member) {
+			rank += x.level[i].span
+			x = x.level[i].forward
+		}
+		if x != s.head && x.Member == member {
+			return rank - 1
+		}
+	}
+	return -1
+}
+
+// Range returns members from start to stop zero-based ranks inclusive, in descending order if reverse is true.
+// The ranks must be valid, reverse ranks are counted from the member with the highest score.
+func (s *SkipList) Range(start int, stop int, reverse bool) []ScoredMember {
+	res := make([]ScoredMember, 0, stop-start+1)
+	var x *skipListNode
+	if reverse {
+		x = s.nodeByRank(s.length - start)
+	} else {
+		x = s.nodeByRank(start + 1)
+	}
+	for i := start; i <= stop && x != nil; i++ {
+		res = append(res, x.ScoredMember)
+		x = x.next(reverse)
+	}
+	return res
+}
+
+// RangeByScore returns members with the scores in the range specified skipping offset members first,
+// count limits the number of members returned if positive.
+func (s *SkipList) RangeByScore(r ScoreRange, offset int, count int, reverse bool) []ScoredMember {
+	res := make([]ScoredMember, 0)
+	x := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		if reverse {
+			for x.level[i].forward != nil && !r.isAboveMax(x.level[i].forward.Score) {
+				x = x.level[i].forward
+			}
+		} else {
+			for x.level[i].forward != nil && r.isBelowMin(x.level[i].forward.Score) {
+				x = x.level[i].forward
+			}
+		}
+	}
+	if reverse {
+		if x == s.head {
+			x = nil
+		}
+	} else {
+		x = x.level[0].forward
+	}
+	for ; x != nil && offset > 0 && r.Contains(x.Score); offset-- {
+		x = x.next(reverse)
+	}
+	for ; x != nil && r.Contains(x.Score) && (count <= 0 || len(res) < count); x = x.next(reverse) {
+		res = append(res, x.ScoredMember)
+	}
+	return res
+}
+
+// Members returns all the members in ascending order.
+func (s *SkipList) Members() []ScoredMember {
+	res := make([]ScoredMember, 0, s.length)
+	for x := s.head.level[0].forward; x != nil; x = x.level[0].forward {
+		res = append(res, x.ScoredMember)
+	}
+	return res
+}
+
+// Contains returns true if the score is in the range.
+func (r ScoreRange) Contains(score float64) bool {
+	return !r.isBelowMin(score) && !r.isAboveMax(score)
+}
+
+func (r ScoreRange) isBelowMin(score float64) bool {
+	return score < r.Min || (r.MinExclusive && score == r.Min)
+}
+
+func (r ScoreRange) isAboveMax(score float64) bool {
+	return score > r.Max || (r.MaxExclusive && score == r.Max)
+}
+
+// nodeByRank returns the node by one-based rank.
+func (s *SkipList) nodeByRank(rank int) *skipListNode {
+	traversed := 0
+	x := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for x.level[i].forward != nil && traversed+x.level[i].span <= rank {
+			traversed += x.level[i].span
+			x = x.level[i].forward
+		}
+		if traversed == rank && x != s.head {
+			return x
+		}
+	}
+	return nil
+}
+
+func (n *skipListNode) less(score float64, member string) bool {
+	return n.Score < score || (n.Score == score && n.Member < member)
+}
+
+func (n *skipListNode) greater(score float64, member string) bool {
+	return n.Score > score || (n.Score == score && n.Member > member)
+}
+
+func (n *skipListNode) next(reverse bool) *skipListNode {
+	if reverse {
+		return n.backward
+	}
+	return n.level[0].forward
+}
+
+func randomSkipListLevel() int {
+	level := 1
+	for level < skipListMaxLevel && rand.Float64() < skipListProbability {
+		level++
+	}
+	return level
+}
@@ -0,0 +1,372 @@
+package cache
+
+import (
+	"errors"
+	"log"
+	"math"
+	"time"
+)
+
+var (
+	// ErrInvalidScore is returned when the score or the increment of the score is NaN or infinite.
+	ErrInvalidScore = errors.New("score must be a finite number")
+)
+
+// SortedSetCacheEntry is a set of unique members ordered by their scores stored in the memory cache.
+// Bytes is the size of the members and their scores, it is kept up to date by each change, so the members are not walked on every write.
+type SortedSetCacheEntry struct {
+	Scores map[string]float64
+	Index  *SkipList
+	Bytes  int64
+	CacheEntryData
+}
+
+// ISortedSetCache is an interface for SortedSetCache.
+type ISortedSetCache interface {
+	TryGet(key string) (bool, []ScoredMember)
+	TryAdd(key string, members []ScoredMember, ttl time.Duration) (bool, error)
+	TryDelete(key string) (bool, []ScoredMember)
+	TryAddMembers(key string, members []ScoredMember) (bool, int, error)
+	TryIncrementScore(key string, member string, delta float64) (bool, float64, error)
+	TryRemoveMembers(key string, members []string) (bool, int)
+	TryGetRange(key string, start int, stop int, reverse bool) (bool, []ScoredMember)
+	TryGetRangeByScore(key string, r ScoreRange, offset int, count int, reverse bool) (bool, []ScoredMember)
+	TryGetRank(key string, member string, reverse bool) (bool, int, float64)
+	TryGetMetadata(key string) (bool, CacheEntryData, int64)
+	TrySetTTL(key string, ttl time.Duration, sliding bool) bool
+	GetKeys() []string
+	GetStats() CacheStats
+	RemoveExpired(budget time.Duration) int
+}
+
+// ISortedSetCachePersistence is an interface for persisting SortedSetCache.
+type ISortedSetCachePersistence interface {
+	TryGetSnapshot(key string) (bool, SortedSetCacheEntry)
+	TryAddFromSnapshot(key string, entry SortedSetCacheEntry) bool
+}
+
+// SortedSetCache is a single-thread in-memory cache based on map[string]SortedSetCacheEntry.
+type SortedSetCache struct {
+	Map     map[string]SortedSetCacheEntry
+	Evictor *Evictor
+	Reaped  int64
+}
+
+// NewSortedSetCacheEntry creates new entry from the members specified, the last score wins for duplicate members.
+func NewSortedSetCacheEntry(members []ScoredMember, data CacheEntryData) SortedSetCacheEntry {
+	v := SortedSetCacheEntry{Scores: make(map[string]float64, len(members)), Index: NewSkipList(), CacheEntryData: data}
+	for _, m := range members {
+		v.setScore(m.Member, m.Score)
+	}
+	return v
+}
+
+// TryGet returns the members in ascending order of scores if contains the key specified.
+func (c *SortedSetCache) TryGet(key string) (bool, []ScoredMember) {
+	v, ok := c.getValueWithExpiration(key)
+	return ok, v.members()
+}
+
+// TryGetSnapshot returns the value if contains the key specified.
+func (c *SortedSetCache) TryGetSnapshot(key string) (bool, SortedSetCacheEntry) {
+	v, ok := c.peek(key)
+	return ok, v
+}
+
+// TryGetMetadata returns timestamps, expiration, version and size in bytes of the entry if contains the key specified.
+// The entry is not marked as used and sliding ttl is not renewed.
+func (c *SortedSetCache) TryGetMetadata(key string) (bool, CacheEntryData, int64) {
+	v, ok := c.peek(key)
+	return ok, v.CacheEntryData, v.size(key)
+}
+
+// TrySetTTL sets new ttl for the existing key keeping its value and version, zero ttl makes the key permanent.
+// Sliding ttl is renewed each time the key is used.
+func (c *SortedSetCache) TrySetTTL(key string, ttl time.Duration, sliding bool) bool {
+	v, ok := c.peek(key)
+	if ok {
+		v.setTTL(ttl, sliding)
+		c.store(key, v)
+	}
+	return ok
+}
+
+// TryAdd add new sorted set to the cache by the key specified if the key is not already used.
+// Nothing is added if any score is not a finite number.
+func (c *SortedSetCache) TryAdd(key string, members []ScoredMember, ttl time.Duration) (bool, error) {
+	if err := validateScores(members); err != nil {
+		return false, err
+	}
+	_, ok := c.getValueWithExpiration(key)
+	if !ok {
+		c.store(key, NewSortedSetCacheEntry(members, NewCacheEntryData(ttl)))
+	}
+	return !ok, nil
+}
+
+// TryAddFromSnapshot add new value to the cache by the key specified if the key is not already used.
+func (c *SortedSetCache) TryAddFromSnapshot(key string, entry SortedSetCacheEntry) bool {
+	_, ok := c.Map[key]
+	if !ok {
+		entry.ExpireAfter = normalizeExpiration(entry.ExpireAfter)
+		c.store(key, entry)
+		observeVersion(entry.Version)
+	}
+	return !ok
+}
+
+// TryDelete deletes the sorted set by the key specified if the key is already used.
+func (c *SortedSetCache) TryDelete(key string) (bool, []ScoredMember) {
+	v, ok := c.getValueWithExpiration(key)
+	if ok {
+		c.remove(key)
+	}
+	return ok, v.members()
+}
+
+// TryAddMembers adds new members and updates the scores of existing ones, returns the number of new members.
+// The version is changed only if the sorted set was changed. Nothing is changed if any score is not a finite number.
+func (c *SortedSetCache) TryAddMembers(key string, members []ScoredMember) (bool, int, error) {
+	v, ok := c.getValueWithExpiration(key)
+	if !ok {
+		return false, 0, nil
+	}
+	if err := validateScores(members); err != nil {
+		return true, 0, err
+	}
+	n, changed := 0, false
+	for _, m := range members {
+		score, exists := v.Scores[m.Member]
+		if !exists {
+			n++
+		}
+		if !exists || score != m.Score {
+			v.setScore(m.Member, m.Score)
+			changed = true
+		}
+	}
+	if changed {
+		v.CacheEntryData = UpdateCacheEntryData(v.CacheEntryData)
+		c.store(key, v)
+	}
+	return true, n, nil
+}
+
+// TryIncrementScore adds delta to the score of the member, missing member is added with delta as its score.
+// The score is not changed if the delta or the new score is not a finite number.
+func (c *SortedSetCache) TryIncrementScore(key string, member string, delta float64) (bool, float64, error) {
+	v, ok := c.getValueWithExpiration(key)
+	if !ok {
+		return false, 0, nil
+	}
+	score := v.Scores[member] + delta
+	if !isFiniteScore(delta) || !isFiniteScore(score) {
+		return true, v.Scores[member], ErrInvalidScore
+	}
+	v.setScore(member, score)
+	v.CacheEntryData = UpdateCacheEntryData(v.CacheEntryData)
+	c.store(key, v)
+	return true, score, nil
+}
+
+// TryRemoveMembers removes the members from the sorted set, returns the number of members removed.
+// The sorted set is kept even if it becomes empty.
+func (c *SortedSetCache) TryRemoveMembers(key string, members []string) (bool, int) {
+	v, ok := c.getValueWithExpiration(key)
+	if !ok {
+		return false, 0
+	}
+	n := 0
+	for _, m := range members {
+		if v.deleteScore(m) {
+			n++
+		}
+	}
+	if n > 0 {
+		v.CacheEntryData = UpdateCacheEntryData(v.CacheEntryData)
+		c.store(key, v)
+	}
+	return true, n
+}
+
+// TryGetRange returns the members from start to stop zero-based ranks inclusive.
+// Negative ranks are counted from the end, -1 is the last member. Reverse ranks start from the highest score.
+func (c *SortedSetCache) TryGetRange(key string, start int, stop int, reverse bool) (bool, []ScoredMember) {
+	v, ok := c.getValueWithExpiration(key)
+	if !ok {
+		return false, make([]ScoredMember, 0)
+	}
+	n := v.Index.Len()
+	if start < 0 {
+		start += n
+	}
+	if stop < 0 {
+		stop += n
+	}
+	if start < 0 {
+		start = 0
+	}
+	if stop >= n {
+		stop = n - 1
+	}
+	if start > stop {
+		return true, make([]ScoredMember, 0)
+	}
+	return true, v.Index.Range(start, stop, reverse)
+}
+
+// TryGetRangeByScore returns the members with the scores in the range, in descending order if reverse is true.
+// The first offset members are skipped, count limits the number of members returned if positive.
+func (c *SortedSetCache) TryGetRangeByScore(key string, r ScoreRange, offset int, count int, reverse bool) (bool, []ScoredMember) {
+	v, ok := c.getValueWithExpiration(key)
+	if !ok {
+		return false, make([]ScoredMember, 0)
+	}
+	return true, v.Index.RangeByScore(r, offset, count, reverse)
+}
+
+// TryGetRank returns zero-based rank and score of the member, the rank is -1 if there is no such member.
+func (c *SortedSetCache) TryGetRank(key string, member string, reverse bool) (bool, int, float64) {
+	v, ok := c.getValueWithExpiration(key)
+	if !ok {
+		return false, -1, 0
+	}
+	score, exists := v.Scores[member]
+	if !exists {
+		return true, -1, 0
+	}
+	rank := v.Index.Rank(member, score)
+	if reverse {
+		rank = v.Index.Len() - 1 - rank
+	}
+	return true, rank, score
+}
+
+// GetKeys returns all the keys in the map.
+func (c *SortedSetCache) GetKeys() []string {
+	var keySlice []string
+	for key, v := range c.Map {
+		if !IsCacheEntryExpired(v.CacheEntryData) {
+			keySlice = append(keySlice, key)
+		}
+	}
+	if keySlice == nil {
+		return make([]string, 0)
+	}
+	return keySlice
+}
+
+// GetStats returns cache usage counters.
+func (c *SortedSetCache) GetStats() CacheStats {
+	stats := c.Evictor.Stats()
+	stats.Reaped = c.Reaped
+	return stats
+}
+
+// RemoveExpired deletes expired entries until all the keys are checked or the time budget is spent.
+// Go maps are iterated in random order, so the next sweep starts from another key.
+func (c *SortedSetCache) RemoveExpired(budget time.Duration) int {
+	started := time.Now()
+	n, checked := 0, 0
+	for key, v := range c.Map {
+		if IsCacheEntryExpired(v.CacheEntryData) {
+			c.remove(key)
+			n++
+		}
+		checked++
+		if isOverBudget(started, budget, checked) {
+			break
+		}
+	}
+	c.Reaped += int64(n)
+	return n
+}
+
+func (c *SortedSetCache) getValueWithExpiration(key string) (SortedSetCacheEntry, bool) {
+	v, ok := c.peek(key)
+	if ok {
+		c.Evictor.Touch(key)
+		if v.slide() {
+			c.store(key, v)
+		}
+	}
+	return v, ok
+}
+
+// peek returns the entry removing it if expired, the entry is not marked as used.
+func (c *SortedSetCache) peek(key string) (SortedSetCacheEntry, bool) {
+	v, ok := c.Map[key]
+	if ok {
+		if IsCacheEntryExpired(v.CacheEntryData) {
+			c.remove(key)
+			log.Printf("[SortedSetCache] key %s had expired and was removed", key)
+			return v, false // expired
+		}
+		return v, ok // not expired
+	}
+	return v, ok
+}
+
+// store puts the entry to the map and evicts other keys if the cache is over its limits.
+func (c *SortedSetCache) store(key string, entry SortedSetCacheEntry) {
+	c.Map[key] = entry
+	c.Evictor.Track(key, entry.size(key), entry.ExpireAfter)
+	for _, k := range c.Evictor.Evict(key) {
+		delete(c.Map, k)
+		log.Printf("[SortedSetCache] key %s was evicted", k)
+	}
+}
+
+func (c *SortedSetCache) remove(key string) {
+	delete(c.Map, key)
+	c.Evictor.Remove(key)
+}
+
+// validateScores returns ErrInvalidScore if any score is NaN or infinite, NaN breaks the order of the index.
+func validateScores(members []ScoredMember) error {
+	for _, m := range members {
+		if !isFiniteScore(m.Score) {
+			return ErrInvalidScore
+		}
+	}
+	return nil
+}
+
+func isFiniteScore(score float64) bool {
+	return !math.IsNaN(score) && !math.IsInf(score, 0)
+}
+
+// setScore adds the member or moves it to the new position in the index.
+func (v *SortedSetCacheEntry) setScore(member string, score float64) {
+	if old, exists := v.Scores[member]; exists {
+		v.Index.Delete(member, old)
+	} else {
+		v.Bytes += int64(len(member) + 8)
+	}
+	v.Scores[member] = score
+	v.Index.Insert(member, score)
+}
+
+// deleteScore removes the member from the scores and the index, returns false if there is no such member.
+func (v *SortedSetCacheEntry) deleteScore(member string) bool {
+	score, exists := v.Scores[member]
+	if exists {
+		v.Index.Delete(member, score)
+		delete(v.Scores, member)
+		v.Bytes -= int64(len(member) + 8)
+	}
+	return exists
+}
+
+// members returns all the members in ascending order of scores.
+func (v SortedSetCacheEntry) members() []ScoredMember {
+	if v.Index == nil {
+		return make([]ScoredMember, 0)
+	}
+	return v.Index.Members()
+}
+
+// size returns approximate memory used by the entry, each score takes 8 bytes.
+func (v SortedSetCacheEntry) size(key string) int64 {
+	return int64(len(key)) + v.Bytes
+}
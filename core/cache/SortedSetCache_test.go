@@ -0,0 +1,224 @@
+package cache
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func newTestSortedSetCache(members ...ScoredMember) *SortedSetCache {
+	c := &SortedSetCache{Map: make(map[string]SortedSetCacheEntry), Evictor: NewEvictor(EvictionOptions{})}
+	c.TryAdd("z", members, 0)
+	return c
+}
+
+// testScoredMembers are ordered by score and then by member, b and c have the same score.
+var testScoredMembers = []ScoredMember{{"a", 1}, {"b", 2}, {"c", 2}, {"d", 3}, {"e", 5}}
+
+func memberNames(members []ScoredMember) []string {
+	names := make([]string, 0, len(members))
+	for _, m := range members {
+		names = append(names, m.Member)
+	}
+	return names
+}
+
+func TestSortedSetCacheGetRange(t *testing.T) {
+	c := newTestSortedSetCache(testScoredMembers...)
+	tests := []struct {
+		start, stop int
+		reverse     bool
+		want        []string
+	}{
+		{0, -1, false, []string{"a", "b", "c", "d", "e"}},
+		{0, 0, false, []string{"a"}},
+		{4, 4, false, []string{"e"}},
+		{-1, -1, false, []string{"e"}},
+		{-2, -1, false, []string{"d", "e"}},
+		{1, 2, false, []string{"b", "c"}},
+		{-100, 1, false, []string{"a", "b"}},
+		{3, 100, false, []string{"d", "e"}},
+		{5, 10, false, []string{}},
+		{2, 1, false, []string{}},
+		{-1, -5, false, []string{}},
+		{-6, -6, false, []string{}},
+		{0, -1, true, []string{"e", "d", "c", "b", "a"}},
+		{0, 0, true, []string{"e"}},
+		{-1, -1, true, []string{"a"}},
+		{1, 2, true, []string{"d", "c"}},
+		{3, 100, true, []string{"b", "a"}},
+		{5, 5, true, []string{}},
+	}
+	for _, tt := range tests {
+		ok, got := c.TryGetRange("z", tt.start, tt.stop, tt.reverse)
+		if !ok || !reflect.DeepEqual(memberNames(got), tt.want) {
+			t.Errorf("TryGetRange(%d, %d, %v) = %v, %v, want %v", tt.start, tt.stop, tt.reverse, ok, memberNames(got), tt.want)
+		}
+	}
+	if ok, got := c.TryGetRange("missing", 0, -1, false); ok || len(got) != 0 {
+		t.Errorf("TryGetRange of missing key = %v, %v", ok, got)
+	}
+}
+
+func TestSortedSetCacheGetRangeByScore(t *testing.T) {
+	c := newTestSortedSetCache(testScoredMembers...)
+	inf := math.Inf(1)
+	tests := []struct {
+		r             ScoreRange
+		offset, count int
+		reverse       bool
+		want          []string
+	}{
+		{ScoreRange{Min: -inf, Max: inf}, 0, 0, false, []string{"a", "b", "c", "d", "e"}},
+		{ScoreRange{Min: 2, Max: 3}, 0, 0, false, []string{"b", "c", "d"}},
+		{ScoreRange{Min: 2, Max: 3, MinExclusive: true}, 0, 0, false, []string{"d"}},
+		{ScoreRange{Min: 2, Max: 3, MaxExclusive: true}, 0, 0, false, []string{"b", "c"}},
+		{ScoreRange{Min: 2, Max: 2, MinExclusive: true}, 0, 0, false, []string{}},
+		{ScoreRange{Min: 1, Max: 1}, 0, 0, false, []string{"a"}},
+		{ScoreRange{Min: 5, Max: inf}, 0, 0, false, []string{"e"}},
+		{ScoreRange{Min: 6, Max: inf}, 0, 0, false, []string{}},
+		{ScoreRange{Min: -inf, Max: 0}, 0, 0, false, []string{}},
+		{ScoreRange{Min: 4, Max: 3}, 0, 0, false, []string{}},
+		{ScoreRange{Min: -inf, Max: inf}, 1, 2, false, []string{"b", "c"}},
+		{ScoreRange{Min: -inf, Max: inf}, 4, 0, false, []string{"e"}},
+		{ScoreRange{Min: -inf, Max: inf}, 5, 0, false, []string{}},
+		{ScoreRange{Min: 2, Max: 5}, 0, 1, false, []string{"b"}},
+		{ScoreRange{Min: -inf, Max: inf}, 0, 0, true, []string{"e", "d", "c", "b", "a"}},
+		{ScoreRange{Min: 2, Max: 3}, 0, 0, true, []string{"d", "c", "b"}},
+		{ScoreRange{Min: 2, Max: 3, MaxExclusive: true}, 0, 0, true, []string{"c", "b"}},
+		{ScoreRange{Min: 2, Max: 3, MinExclusive: true}, 0, 0, true, []string{"d"}},
+		{ScoreRange{Min: -inf, Max: 0.5}, 0, 0, true, []string{}},
+		{ScoreRange{Min: 1, Max: 5}, 1, 2, true, []string{"d", "c"}},
+		{ScoreRange{Min: 1, Max: 5}, 4, 2, true, []string{"a"}},
+	}
+	for _, tt := range tests {
+		ok, got := c.TryGetRangeByScore("z", tt.r, tt.offset, tt.count, tt.reverse)
+		if !ok || !reflect.DeepEqual(memberNames(got), tt.want) {
+			t.Errorf("TryGetRangeByScore(%+v, %d, %d, %v) = %v, %v, want %v", tt.r, tt.offset, tt.count, tt.reverse, ok, memberNames(got), tt.want)
+		}
+	}
+}
+
+func TestSortedSetCacheGetRank(t *testing.T) {
+	c := newTestSortedSetCache(testScoredMembers...)
+	tests := []struct {
+		member  string
+		reverse bool
+		rank    int
+		score   float64
+	}{
+		{"a", false, 0, 1},
+		{"b", false, 1, 2},
+		{"c", false, 2, 2},
+		{"e", false, 4, 5},
+		{"a", true, 4, 1},
+		{"c", true, 2, 2},
+		{"e", true, 0, 5},
+		{"x", false, -1, 0},
+		{"x", true, -1, 0},
+	}
+	for _, tt := range tests {
+		ok, rank, score := c.TryGetRank("z", tt.member, tt.reverse)
+		if !ok || rank != tt.rank || score != tt.score {
+			t.Errorf("TryGetRank(%s, %v) = %v, %d, %v, want %d, %v", tt.member, tt.reverse, ok, rank, score, tt.rank, tt.score)
+		}
+	}
+}
+
+func TestSortedSetCacheUpdates(t *testing.T) {
+	c := newTestSortedSetCache(testScoredMembers...)
+	if ok, n, err := c.TryAddMembers("z", []ScoredMember{{"a", 4}, {"f", 0}}); !ok || n != 1 || err != nil {
+		t.Fatalf("TryAddMembers = %v, %d, %v", ok, n, err)
+	}
+	if ok, score, err := c.TryIncrementScore("z", "e", -5); !ok || score != 0 || err != nil {
+		t.Fatalf("TryIncrementScore = %v, %v, %v", ok, score, err)
+	}
+	if ok, n := c.TryRemoveMembers("z", []string{"c", "x"}); !ok || n != 1 {
+		t.Fatalf("TryRemoveMembers = %v, %d", ok, n)
+	}
+	want := []ScoredMember{{"e", 0}, {"f", 0}, {"b", 2}, {"d", 3}, {"a", 4}}
+	if _, got := c.TryGet("z"); !reflect.DeepEqual(got, want) {
+		t.Errorf("TryGet = %v, want %v", got, want)
+	}
+	for i, m := range want {
+		if _, rank, _ := c.TryGetRank("z", m.Member, false); rank != i {
+			t.Errorf("TryGetRank(%s) = %d, want %d", m.Member, rank, i)
+		}
+	}
+	if v := c.Map["z"]; v.Bytes != 5*(1+8) {
+		t.Errorf("Bytes = %d, want %d", v.Bytes, 5*(1+8))
+	}
+}
+
+func TestSortedSetCacheRejectsInvalidScores(t *testing.T) {
+	c := newTestSortedSetCache(testScoredMembers...)
+	for _, score := range []float64{math.NaN(), math.Inf(1), math.Inf(-1)} {
+		if ok, err := c.TryAdd("y", []ScoredMember{{"a", score}}, 0); ok || err != ErrInvalidScore {
+			t.Errorf("TryAdd(%v) = %v, %v", score, ok, err)
+		}
+		if ok, n, err := c.TryAddMembers("z", []ScoredMember{{"x", 1}, {"a", score}}); !ok || n != 0 || err != ErrInvalidScore {
+			t.Errorf("TryAddMembers(%v) = %v, %d, %v", score, ok, n, err)
+		}
+		if ok, old, err := c.TryIncrementScore("z", "a", score); !ok || old != 1 || err != ErrInvalidScore {
+			t.Errorf("TryIncrementScore(%v) = %v, %v, %v", score, ok, old, err)
+		}
+	}
+	if _, got := c.TryGet("z"); !reflect.DeepEqual(got, testScoredMembers) {
+		t.Errorf("TryGet = %v, want %v", got, testScoredMembers)
+	}
+	c = newTestSortedSetCache(ScoredMember{"max", math.MaxFloat64})
+	if ok, old, err := c.TryIncrementScore("z", "max", math.MaxFloat64); !ok || old != math.MaxFloat64 || err != ErrInvalidScore {
+		t.Errorf("TryIncrementScore overflow = %v, %v, %v", ok, old, err)
+	}
+}
+
+func TestSkipListMatchesSortedSlice(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	s := NewSkipList()
+	scores := make(map[string]float64)
+	for i := 0; i < 5000; i++ {
+		member := fmt.Sprintf("m%d", r.Intn(500))
+		if old, exists := scores[member]; exists {
+			if !s.Delete(member, old) {
+				t.Fatalf("Delete(%s, %v) = false", member, old)
+			}
+			delete(scores, member)
+		}
+		if r.Intn(3) > 0 {
+			score := float64(r.Intn(50))
+			s.Insert(member, score)
+			scores[member] = score
+		}
+	}
+	want := make([]ScoredMember, 0, len(scores))
+	for m, score := range scores {
+		want = append(want, ScoredMember{m, score})
+	}
+	sort.Slice(want, func(i, j int) bool {
+		return want[i].Score < want[j].Score || (want[i].Score == want[j].Score && want[i].Member < want[j].Member)
+	})
+	if s.Len() != len(want) || !reflect.DeepEqual(s.Members(), want) {
+		t.Fatalf("Members differ from the sorted slice of %d members", len(want))
+	}
+	for i, m := range want {
+		if rank := s.Rank(m.Member, m.Score); rank != i {
+			t.Fatalf("Rank(%s) = %d, want %d", m.Member, rank, i)
+		}
+	}
+	n := len(want)
+	for _, rr := range [][2]int{{0, 0}, {0, n - 1}, {n - 1, n - 1}, {n / 3, n / 2}} {
+		if got := s.Range(rr[0], rr[1], false); !reflect.DeepEqual(got, want[rr[0]:rr[1]+1]) {
+			t.Errorf("Range(%d, %d) differs", rr[0], rr[1])
+		}
+		got := s.Range(rr[0], rr[1], true)
+		for i, m := range got {
+			if m != want[n-1-rr[0]-i] {
+				t.Errorf("reverse Range(%d, %d) differs at %d", rr[0], rr[1], i)
+				break
+			}
+		}
+	}
+}
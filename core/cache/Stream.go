@@ -0,0 +1,371 @@
+package cache
+
+import (
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrInvalidStreamID is returned when the stream entry ID is not "ms-seq", "ms", "ms-*" or "*".
+	ErrInvalidStreamID = errors.New("invalid stream ID")
+	// ErrStreamIDTooSmall is returned when the entry ID specified is not greater than the last ID of the stream.
+	ErrStreamIDTooSmall = errors.New("stream ID must be greater than the last ID of the stream")
+	// ErrStreamGroupExists is returned when the consumer group name is already used.
+	ErrStreamGroupExists = errors.New("consumer group already exists")
+	// ErrStreamGroupNotFound is returned when the consumer group does not exist.
+	ErrStreamGroupNotFound = errors.New("consumer group was not found")
+)
+
+// StreamID identifies stream entry, IDs are ordered by time in milliseconds and then by sequence number.
+type StreamID struct {
+	Ms  uint64
+	Seq uint64
+}
+
+// String formats the ID as "ms-seq".
+func (id StreamID) String() string {
+	return strconv.FormatUint(id.Ms, 10) + "-" + strconv.FormatUint(id.Seq, 10)
+}
+
+// Less returns true if the ID goes before the other one.
+func (id StreamID) Less(other StreamID) bool {
+	return id.Ms < other.Ms || (id.Ms == other.Ms && id.Seq < other.Seq)
+}
+
+// IsZero returns true for "0-0" ID which is never assigned to entries.
+func (id StreamID) IsZero() bool {
+	return id.Ms == 0 && id.Seq == 0
+}
+
+// ParseStreamID reads "ms-seq" or "ms" ID, missing sequence number is replaced with the value specified.
+func ParseStreamID(s string, seq uint64) (StreamID, error) {
+	parts := strings.SplitN(s, "-", 2)
+	ms, err := strconv.ParseUint(parts[0], 10, 64)
+	if err != nil {
+		return StreamID{}, ErrInvalidStreamID
+	}
+	if len(parts) == 2 {
+		if seq, err = strconv.ParseUint(parts[1], 10, 64); err != nil {
+			return StreamID{}, ErrInvalidStreamID
+		}
+	}
+	return StreamID{Ms: ms, Seq: seq}, nil
+}
+
+// parseStreamRangeBound reads the range bound, "-" is the smallest ID and "+" is the greatest one.
+// Missing sequence number of the start is 0 and of the end is the greatest one, so "ms" includes all the entries of the millisecond.
+func parseStreamRangeBound(s string, end bool) (StreamID, error) {
+	switch s {
+	case "-":
+		return StreamID{}, nil
+	case "+":
+		return StreamID{Ms: ^uint64(0), Seq: ^uint64(0)}, nil
+	}
+	if end {
+		return ParseStreamID(s, ^uint64(0))
+	}
+	return ParseStreamID(s, 0)
+}
+
+// StreamEntry is an entry of the stream, the fields keep the order they were appended in.
+type StreamEntry struct {
+	ID     StreamID
+	Fields []KeyValue
+}
+
+// StreamPendingEntry is an entry delivered to the consumer of the group which was not acknowledged yet.
+type StreamPendingEntry struct {
+	ID         StreamID
+	Consumer   string
+	Delivered  int64
+	Deliveries int64
+}
+
+// StreamGroup is a consumer group which delivers each entry to one of its consumers.
+type StreamGroup struct {
+	Name          string
+	LastDelivered StreamID
+	Pending       []StreamPendingEntry
+	Consumers     map[string]int64
+}
+
+// StreamGroupInfo is a summary of the consumer group.
+type StreamGroupInfo struct {
+	Name          string
+	LastDelivered StreamID
+	Pending       int
+	Consumers     int
+}
+
+// Stream is an append-only log of entries ordered by ID like Redis stream.
+// MaxLen caps the number of entries, the oldest entries are removed when new ones are appended.
+// The size of the stream is updated by each change, so the entries are not walked on every write.
+type Stream struct {
+	Entries []StreamEntry
+	LastID  StreamID
+	MaxLen  int64
+	Groups  map[string]*StreamGroup
+	bytes   int64
+}
+
+// NewStream creates empty stream, zero maxLen means that the length is not capped.
+func NewStream(maxLen int64) *Stream {
+	return &Stream{MaxLen: maxLen, Groups: make(map[string]*StreamGroup)}
+}
+
+// Append adds the entry to the end of the stream and returns its ID.
+// "*" or empty ID generates time-based ID, "ms-*" generates the sequence number for the millisecond specified.
+func (s *Stream) Append(id string, fields []KeyValue, now time.Time) (StreamID, error) {
+	next, err := s.nextID(id, now)
+	if err != nil {
+		return StreamID{}, err
+	}
+	e := StreamEntry{ID: next, Fields: fields}
+	s.Entries = append(s.Entries, e)
+	s.bytes += e.size()
+	s.LastID = next
+	if s.MaxLen > 0 {
+		s.Trim(s.MaxLen)
+	}
+	return next, nil
+}
+
+func (s *Stream) nextID(id string, now time.Time) (StreamID, error) {
+	if id == "" || id == "*" {
+		ms := uint64(now.UnixNano() / int64(time.Millisecond))
+		if ms <= s.LastID.Ms {
+			return StreamID{Ms: s.LastID.Ms, Seq: s.LastID.Seq + 1}, nil
+		}
+		return StreamID{Ms: ms}, nil
+	}
+	var next StreamID
+	if strings.HasSuffix(id, "-*") {
+		ms, err := strconv.ParseUint(strings.TrimSuffix(id, "-*"), 10, 64)
+		if err != nil {
+			return StreamID{}, ErrInvalidStreamID
+		}
+		next = StreamID{Ms: ms}
+		if ms == s.LastID.Ms {
+			next.Seq = s.LastID.Seq + 1
+		}
+	} else {
+		var err error
+		if next, err = ParseStreamID(id, 0); err != nil {
+			return StreamID{}, err
+		}
+	}
+	if next.IsZero() || !s.LastID.Less(next) {
+		return StreamID{}, ErrStreamIDTooSmall
+	}
+	return next, nil
+}
+
+// Trim removes the oldest entries so that at most maxLen entries are left and returns the number of entries removed.
+// Pending entries of the groups are kept, so they can still be acknowledged.
+func (s *Stream) Trim(maxLen int64) int {
+	n := len(s.Entries) - int(maxLen)
+	if maxLen < 0 || n <= 0 {
+		return 0
+	}
+	for i := range s.Entries[:n] {
+		s.bytes -= s.Entries[i].size()
+		s.Entries[i] = StreamEntry{} // the removed fields are not kept alive by the array
+	}
+	s.Entries = s.Entries[n:]
+	// the array is copied only when most of its capacity is unused, so appending to the capped stream does not copy it each time
+	if cap(s.Entries) > 2*len(s.Entries) {
+		s.Entries = append(make([]StreamEntry, 0, 2*len(s.Entries)), s.Entries...)
+	}
+	return n
+}
+
+// Range returns up to count entries with IDs from start to end inclusive like XRANGE, zero count means all the entries.
+// Reverse starts from the end like XREVRANGE.
+func (s *Stream) Range(start string, end string, count int, reverse bool) ([]StreamEntry, error) {
+	from, err := parseStreamRangeBound(start, false)
+	if err != nil {
+		return nil, err
+	}
+	to, err := parseStreamRangeBound(end, true)
+	if err != nil {
+		return nil, err
+	}
+	i := s.search(from)
+	j := sort.Search(len(s.Entries), func(k int) bool { return to.Less(s.Entries[k].ID) })
+	res := make([]StreamEntry, 0)
+	if i >= j {
+		return res, nil
+	}
+	if !reverse {
+		for k := i; k < j && (count <= 0 || len(res) < count); k++ {
+			res = append(res, s.Entries[k])
+		}
+	} else {
+		for k := j - 1; k >= i && (count <= 0 || len(res) < count); k-- {
+			res = append(res, s.Entries[k])
+		}
+	}
+	return res, nil
+}
+
+// search returns the index of the first entry with ID greater than or equal to the one specified.
+func (s *Stream) search(id StreamID) int {
+	return sort.Search(len(s.Entries), func(k int) bool { return !s.Entries[k].ID.Less(id) })
+}
+
+// CreateGroup adds the consumer group which delivers entries after the start ID, "$" means the last ID of the stream.
+func (s *Stream) CreateGroup(name string, start string) error {
+	if _, ok := s.Groups[name]; ok {
+		return ErrStreamGroupExists
+	}
+	last := s.LastID
+	if start != "" && start != "$" {
+		var err error
+		if last, err = ParseStreamID(start, 0); err != nil {
+			return err
+		}
+	}
+	s.Groups[name] = &StreamGroup{Name: name, LastDelivered: last, Consumers: make(map[string]int64)}
+	s.bytes += int64(len(name))
+	return nil
+}
+
+// DeleteGroup removes the consumer group with its pending entries.
+func (s *Stream) DeleteGroup(name string) bool {
+	g, ok := s.Groups[name]
+	if ok {
+		s.bytes -= g.size()
+		delete(s.Groups, name)
+	}
+	return ok
+}
+
+// ReadGroup delivers up to count entries to the consumer of the group like XREADGROUP, zero count means all the entries.
+// ">" ID reads entries never delivered to the group and adds them to the pending entries of the consumer.
+// Other IDs read the pending entries of the consumer after the ID, so the consumer can recover after a failure.
+func (s *Stream) ReadGroup(group string, consumer string, id string, count int, now time.Time) ([]StreamEntry, error) {
+	g, ok := s.Groups[group]
+	if !ok {
+		return nil, ErrStreamGroupNotFound
+	}
+	if _, ok := g.Consumers[consumer]; !ok {
+		s.bytes += int64(len(consumer)) + 8
+	}
+	g.Consumers[consumer] = now.UnixNano()
+	res := make([]StreamEntry, 0)
+	if id == "" || id == ">" {
+		for k := s.search(StreamID{Ms: g.LastDelivered.Ms, Seq: g.LastDelivered.Seq + 1}); k < len(s.Entries) && (count <= 0 || len(res) < count); k++ {
+			e := s.Entries[k]
+			res = append(res, e)
+			g.LastDelivered = e.ID
+			g.Pending = append(g.Pending, StreamPendingEntry{ID: e.ID, Consumer: consumer, Delivered: now.UnixNano(), Deliveries: 1})
+			s.bytes += streamPendingEntrySize
+		}
+		return res, nil
+	}
+	after, err := ParseStreamID(id, 0)
+	if err != nil {
+		return nil, err
+	}
+	for i := range g.Pending {
+		p := &g.Pending[i]
+		if p.Consumer != consumer || !after.Less(p.ID) {
+			continue
+		}
+		if count > 0 && len(res) >= count {
+			break
+		}
+		if k := s.search(p.ID); k < len(s.Entries) && s.Entries[k].ID == p.ID {
+			res = append(res, s.Entries[k])
+		} else {
+			res = append(res, StreamEntry{ID: p.ID}) // trimmed
+		}
+		p.Delivered = now.UnixNano()
+		p.Deliveries++
+	}
+	return res, nil
+}
+
+// Ack removes the entries from the pending entries of the group like XACK and returns the number of entries acknowledged.
+func (s *Stream) Ack(group string, ids []StreamID) (int, error) {
+	g, ok := s.Groups[group]
+	if !ok {
+		return 0, ErrStreamGroupNotFound
+	}
+	n := 0
+	for _, id := range ids {
+		i := sort.Search(len(g.Pending), func(k int) bool { return !g.Pending[k].ID.Less(id) })
+		if i < len(g.Pending) && g.Pending[i].ID == id {
+			g.Pending = append(g.Pending[:i], g.Pending[i+1:]...)
+			s.bytes -= streamPendingEntrySize
+			n++
+		}
+	}
+	return n, nil
+}
+
+// GetPending returns up to count pending entries of the group like XPENDING, empty consumer means all the consumers.
+func (s *Stream) GetPending(group string, consumer string, count int) ([]StreamPendingEntry, error) {
+	g, ok := s.Groups[group]
+	if !ok {
+		return nil, ErrStreamGroupNotFound
+	}
+	res := make([]StreamPendingEntry, 0)
+	for _, p := range g.Pending {
+		if count > 0 && len(res) >= count {
+			break
+		}
+		if consumer == "" || p.Consumer == consumer {
+			res = append(res, p)
+		}
+	}
+	return res, nil
+}
+
+// GetGroups returns the summary of the consumer groups ordered by name.
+func (s *Stream) GetGroups() []StreamGroupInfo {
+	res := make([]StreamGroupInfo, 0, len(s.Groups))
+	for _, g := range s.Groups {
+		res = append(res, StreamGroupInfo{Name: g.Name, LastDelivered: g.LastDelivered, Pending: len(g.Pending), Consumers: len(g.Consumers)})
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Name < res[j].Name })
+	return res
+}
+
+// streamPendingEntrySize is approximate memory used by the pending entry.
+const streamPendingEntrySize = 32
+
+// size returns approximate memory used by the stream.
+func (s *Stream) size() int64 {
+	return s.bytes
+}
+
+// countBytes walks the stream once when it is restored, later changes update its size.
+func (s *Stream) countBytes() {
+	s.bytes = 0
+	for _, e := range s.Entries {
+		s.bytes += e.size()
+	}
+	for _, g := range s.Groups {
+		s.bytes += g.size()
+	}
+}
+
+func (e StreamEntry) size() int64 {
+	n := 16
+	for _, f := range e.Fields {
+		n += len(f.Key) + len(f.Value)
+	}
+	return int64(n)
+}
+
+func (g *StreamGroup) size() int64 {
+	n := len(g.Name) + streamPendingEntrySize*len(g.Pending)
+	for c := range g.Consumers {
+		n += len(c) + 8
+	}
+	return int64(n)
+}
@@ -0,0 +1,305 @@
+package cache
+
+import (
+	"log"
+	"time"
+)
+
+// StreamCacheEntry is a stream stored in the memory cache.
+type StreamCacheEntry struct {
+	Value *Stream
+	CacheEntryData
+}
+
+// IStreamCache is an interface for StreamCache.
+type IStreamCache interface {
+	TryGet(key string) (bool, *Stream)
+	TryAdd(key string, maxLen int64, ttl time.Duration) bool
+	TryDelete(key string) bool
+	TryAppend(key string, id string, fields []KeyValue, maxLen int64) (bool, StreamID, int, error)
+	TryGetRange(key string, start string, end string, count int, reverse bool) (bool, []StreamEntry, error)
+	TryTrim(key string, maxLen int64) (bool, int, int)
+	TryCreateGroup(key string, group string, start string) (bool, error)
+	TryDeleteGroup(key string, group string) (bool, bool)
+	TryReadGroup(key string, group string, consumer string, id string, count int) (bool, []StreamEntry, error)
+	TryAck(key string, group string, ids []StreamID) (bool, int, error)
+	TryGetPending(key string, group string, consumer string, count int) (bool, []StreamPendingEntry, error)
+	TryGetMetadata(key string) (bool, CacheEntryData, int64)
+	TrySetTTL(key string, ttl time.Duration, sliding bool) bool
+	GetKeys() []string
+	GetStats() CacheStats
+	RemoveExpired(budget time.Duration) int
+}
+
+// IStreamCachePersistence is an interface for persisting StreamCache.
+type IStreamCachePersistence interface {
+	TryGetSnapshot(key string) (bool, StreamCacheEntry)
+	TryAddFromSnapshot(key string, entry StreamCacheEntry) bool
+}
+
+// StreamCache is a single-thread in-memory cache based on map[string]StreamCacheEntry.
+type StreamCache struct {
+	Map     map[string]StreamCacheEntry
+	Evictor *Evictor
+	Reaped  int64
+}
+
+// TryGet returns the stream if contains the key specified, the value must not be changed by the caller.
+func (c *StreamCache) TryGet(key string) (bool, *Stream) {
+	v, ok := c.getValueWithExpiration(key)
+	return ok, v.Value
+}
+
+// TryGetSnapshot returns the value if contains the key specified.
+func (c *StreamCache) TryGetSnapshot(key string) (bool, StreamCacheEntry) {
+	v, ok := c.peek(key)
+	return ok, v
+}
+
+// TryGetMetadata returns timestamps, expiration, version and size in bytes of the entry if contains the key specified.
+// The entry is not marked as used and sliding ttl is not renewed.
+func (c *StreamCache) TryGetMetadata(key string) (bool, CacheEntryData, int64) {
+	v, ok := c.peek(key)
+	return ok, v.CacheEntryData, v.size(key)
+}
+
+// TrySetTTL sets new ttl for the existing key keeping its value and version, zero ttl makes the key permanent.
+// Sliding ttl is renewed each time the key is used.
+func (c *StreamCache) TrySetTTL(key string, ttl time.Duration, sliding bool) bool {
+	v, ok := c.peek(key)
+	if ok {
+		v.setTTL(ttl, sliding)
+		c.store(key, v)
+	}
+	return ok
+}
+
+// TryAdd add new empty stream capped to maxLen entries to the cache by the key specified if the key is not already used.
+func (c *StreamCache) TryAdd(key string, maxLen int64, ttl time.Duration) bool {
+	_, ok := c.getValueWithExpiration(key)
+	if !ok {
+		c.store(key, StreamCacheEntry{Value: NewStream(maxLen), CacheEntryData: NewCacheEntryData(ttl)})
+	}
+	return !ok
+}
+
+// TryAddFromSnapshot add new value to the cache by the key specified if the key is not already used.
+func (c *StreamCache) TryAddFromSnapshot(key string, entry StreamCacheEntry) bool {
+	_, ok := c.Map[key]
+	if !ok {
+		entry.ExpireAfter = normalizeExpiration(entry.ExpireAfter)
+		if entry.Value != nil {
+			entry.Value.countBytes()
+		}
+		c.store(key, entry)
+		observeVersion(entry.Version)
+	}
+	return !ok
+}
+
+// TryDelete deletes the stream by the key specified if the key is already used.
+func (c *StreamCache) TryDelete(key string) bool {
+	_, ok := c.getValueWithExpiration(key)
+	if ok {
+		c.remove(key)
+	}
+	return ok
+}
+
+// TryAppend appends the entry like XADD and returns its ID and the new length of the stream.
+// Positive maxLen trims the stream after the entry is appended like XADD MAXLEN.
+func (c *StreamCache) TryAppend(key string, id string, fields []KeyValue, maxLen int64) (bool, StreamID, int, error) {
+	v, ok := c.getValueWithExpiration(key)
+	if !ok {
+		return false, StreamID{}, 0, nil
+	}
+	next, err := v.Value.Append(id, fields, time.Now())
+	if err != nil {
+		return true, StreamID{}, len(v.Value.Entries), err
+	}
+	if maxLen > 0 {
+		v.Value.Trim(maxLen)
+	}
+	c.update(key, v)
+	return true, next, len(v.Value.Entries), nil
+}
+
+// TryGetRange returns up to count entries with IDs from start to end inclusive like XRANGE, "-" and "+" are the smallest and the greatest IDs.
+func (c *StreamCache) TryGetRange(key string, start string, end string, count int, reverse bool) (bool, []StreamEntry, error) {
+	v, ok := c.getValueWithExpiration(key)
+	if !ok {
+		return false, nil, nil
+	}
+	entries, err := v.Value.Range(start, end, count, reverse)
+	return true, entries, err
+}
+
+// TryTrim removes the oldest entries so that at most maxLen entries are left like XTRIM,
+// returns the number of entries removed and the new length of the stream.
+func (c *StreamCache) TryTrim(key string, maxLen int64) (bool, int, int) {
+	v, ok := c.getValueWithExpiration(key)
+	if !ok {
+		return false, 0, 0
+	}
+	n := v.Value.Trim(maxLen)
+	if n > 0 {
+		c.update(key, v)
+	}
+	return true, n, len(v.Value.Entries)
+}
+
+// TryCreateGroup adds the consumer group like XGROUP CREATE, "$" start delivers only the entries appended later.
+func (c *StreamCache) TryCreateGroup(key string, group string, start string) (bool, error) {
+	v, ok := c.getValueWithExpiration(key)
+	if !ok {
+		return false, nil
+	}
+	err := v.Value.CreateGroup(group, start)
+	if err == nil {
+		c.update(key, v)
+	}
+	return true, err
+}
+
+// TryDeleteGroup removes the consumer group with its pending entries like XGROUP DESTROY.
+func (c *StreamCache) TryDeleteGroup(key string, group string) (bool, bool) {
+	v, ok := c.getValueWithExpiration(key)
+	if !ok {
+		return false, false
+	}
+	deleted := v.Value.DeleteGroup(group)
+	if deleted {
+		c.update(key, v)
+	}
+	return true, deleted
+}
+
+// TryReadGroup delivers entries to the consumer of the group like XREADGROUP, ">" ID reads new entries
+// and other IDs read the entries pending for the consumer. The version is changed since the group state is changed.
+func (c *StreamCache) TryReadGroup(key string, group string, consumer string, id string, count int) (bool, []StreamEntry, error) {
+	v, ok := c.getValueWithExpiration(key)
+	if !ok {
+		return false, nil, nil
+	}
+	entries, err := v.Value.ReadGroup(group, consumer, id, count, time.Now())
+	if err == nil {
+		c.update(key, v)
+	}
+	return true, entries, err
+}
+
+// TryAck acknowledges the pending entries of the group like XACK and returns the number of entries acknowledged.
+func (c *StreamCache) TryAck(key string, group string, ids []StreamID) (bool, int, error) {
+	v, ok := c.getValueWithExpiration(key)
+	if !ok {
+		return false, 0, nil
+	}
+	n, err := v.Value.Ack(group, ids)
+	if n > 0 {
+		c.update(key, v)
+	}
+	return true, n, err
+}
+
+// TryGetPending returns up to count pending entries of the group like XPENDING, empty consumer means all the consumers.
+func (c *StreamCache) TryGetPending(key string, group string, consumer string, count int) (bool, []StreamPendingEntry, error) {
+	v, ok := c.getValueWithExpiration(key)
+	if !ok {
+		return false, nil, nil
+	}
+	entries, err := v.Value.GetPending(group, consumer, count)
+	return true, entries, err
+}
+
+// GetKeys returns all the keys in the map.
+func (c *StreamCache) GetKeys() []string {
+	var keySlice []string
+	for key, v := range c.Map {
+		if !IsCacheEntryExpired(v.CacheEntryData) {
+			keySlice = append(keySlice, key)
+		}
+	}
+	if keySlice == nil {
+		return make([]string, 0)
+	}
+	return keySlice
+}
+
+// GetStats returns cache usage counters.
+func (c *StreamCache) GetStats() CacheStats {
+	stats := c.Evictor.Stats()
+	stats.Reaped = c.Reaped
+	return stats
+}
+
+// RemoveExpired deletes expired entries until all the keys are checked or the time budget is spent.
+// Go maps are iterated in random order, so the next sweep starts from another key.
+func (c *StreamCache) RemoveExpired(budget time.Duration) int {
+	started := time.Now()
+	n, checked := 0, 0
+	for key, v := range c.Map {
+		if IsCacheEntryExpired(v.CacheEntryData) {
+			c.remove(key)
+			n++
+		}
+		checked++
+		if isOverBudget(started, budget, checked) {
+			break
+		}
+	}
+	c.Reaped += int64(n)
+	return n
+}
+
+func (c *StreamCache) getValueWithExpiration(key string) (StreamCacheEntry, bool) {
+	v, ok := c.peek(key)
+	if ok {
+		c.Evictor.Touch(key)
+		if v.slide() {
+			c.store(key, v)
+		}
+	}
+	return v, ok
+}
+
+// peek returns the entry removing it if expired, the entry is not marked as used.
+func (c *StreamCache) peek(key string) (StreamCacheEntry, bool) {
+	v, ok := c.Map[key]
+	if ok {
+		if IsCacheEntryExpired(v.CacheEntryData) {
+			c.remove(key)
+			log.Printf("[StreamCache] key %s had expired and was removed", key)
+			return v, false // expired
+		}
+		return v, ok // not expired
+	}
+	return v, ok
+}
+
+// update stores the changed stream with the new version.
+func (c *StreamCache) update(key string, v StreamCacheEntry) {
+	c.store(key, StreamCacheEntry{Value: v.Value, CacheEntryData: UpdateCacheEntryData(v.CacheEntryData)})
+}
+
+// store puts the entry to the map and evicts other keys if the cache is over its limits.
+func (c *StreamCache) store(key string, entry StreamCacheEntry) {
+	c.Map[key] = entry
+	c.Evictor.Track(key, entry.size(key), entry.ExpireAfter)
+	for _, k := range c.Evictor.Evict(key) {
+		delete(c.Map, k)
+		log.Printf("[StreamCache] key %s was evicted", k)
+	}
+}
+
+func (c *StreamCache) remove(key string) {
+	delete(c.Map, key)
+	c.Evictor.Remove(key)
+}
+
+// size returns approximate memory used by the entry.
+func (v StreamCacheEntry) size(key string) int64 {
+	if v.Value == nil {
+		return int64(len(key))
+	}
+	return int64(len(key)) + v.Value.size()
+}
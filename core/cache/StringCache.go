@@ -6,18 +6,36 @@ import (
 )
 
 // StringCacheEntry is a string cache data item stored in the memory cache.
+// Value may hold arbitrary bytes, ContentType is set for the values posted as raw request body.
 type StringCacheEntry struct {
-	Value string
+	Value       string
+	Flags       uint32
+	ContentType string
 	CacheEntryData
 }
 
 // IStringCache is an interface for StringCache.
 type IStringCache interface {
 	TryGet(key string) (bool, string)
-	TryAdd(key string, value string, ttl time.Duration) bool
+	TryGetEntry(key string) (bool, StringCacheEntry)
+	TryAdd(key string, value string, flags uint32, contentType string, ttl time.Duration) bool
+	Set(key string, value string, flags uint32, ttl time.Duration) int64
+	TryReplace(key string, value string, flags uint32, ttl time.Duration) bool
+	TryCompareAndSwap(key string, value string, flags uint32, ttl time.Duration, version int64) (bool, bool)
+	TryTouch(key string, ttl time.Duration) bool
 	TryDelete(key string) (bool, string)
-	TryUpdate(key string, newValue string, originalValue string) (bool, string)
+	TryUpdate(key string, newValue string, originalValue string, contentType string) (bool, string)
+	TryIncrement(key string, inc Increment) (bool, string, error)
+	TrySetBit(key string, offset int64, bit int) (bool, int, error)
+	TryGetBit(key string, offset int64) (bool, int, error)
+	TryCountBits(key string, start int64, end int64, bitUnit bool) (bool, int64)
+	TryGetBitPos(key string, bit int, start int64, end int64, hasEnd bool, bitUnit bool) (bool, int64, error)
+	TryBitField(key string, ops []BitFieldOp) (bool, []BitFieldResult, error)
+	TryGetMetadata(key string) (bool, CacheEntryData, int64)
+	TrySetTTL(key string, ttl time.Duration, sliding bool) bool
 	GetKeys() []string
+	GetStats() CacheStats
+	RemoveExpired(budget time.Duration) int
 }
 
 // IStringCachePersistence is an interface for persisting StringCache.
@@ -28,7 +46,9 @@ type IStringCachePersistence interface {
 
 // StringCache is a single-thread in-memory cache based on map[string]StringCacheEntry.
 type StringCache struct {
-	Map map[string]StringCacheEntry
+	Map     map[string]StringCacheEntry
+	Evictor *Evictor
+	Reaped  int64
 }
 
 // TryGet returns the value if contains the key specified.
@@ -37,26 +57,90 @@ func (c *StringCache) TryGet(key string) (bool, string) {
 	return ok, v.Value
 }
 
+// TryGetEntry returns the value with its flags and version if contains the key specified.
+func (c *StringCache) TryGetEntry(key string) (bool, StringCacheEntry) {
+	v, ok := c.getValueWithExpiration(key)
+	return ok, v
+}
+
 // TryGetSnapshot returns the value if contains the key specified.
 func (c *StringCache) TryGetSnapshot(key string) (bool, StringCacheEntry) {
-	v, ok := c.getValueWithExpiration(key)
+	v, ok := c.peek(key)
 	return ok, v
 }
 
+// TryGetMetadata returns timestamps, expiration, version and size in bytes of the entry if contains the key specified.
+// The entry is not marked as used and sliding ttl is not renewed.
+func (c *StringCache) TryGetMetadata(key string) (bool, CacheEntryData, int64) {
+	v, ok := c.peek(key)
+	return ok, v.CacheEntryData, v.size(key)
+}
+
+// TrySetTTL sets new ttl for the existing key keeping its value and version, zero ttl makes the key permanent.
+// Sliding ttl is renewed each time the key is used.
+func (c *StringCache) TrySetTTL(key string, ttl time.Duration, sliding bool) bool {
+	v, ok := c.peek(key)
+	if ok {
+		v.setTTL(ttl, sliding)
+		c.store(key, v)
+	}
+	return ok
+}
+
 // TryAdd add new value to the cache by the key specified if the key is not already used.
-func (c *StringCache) TryAdd(key string, value string, ttl time.Duration) bool {
+func (c *StringCache) TryAdd(key string, value string, flags uint32, contentType string, ttl time.Duration) bool {
 	_, ok := c.getValueWithExpiration(key)
 	if !ok {
-		c.Map[key] = StringCacheEntry{Value: value, CacheEntryData: NewCacheEntryData(ttl)}
+		c.store(key, StringCacheEntry{Value: value, Flags: flags, ContentType: contentType, CacheEntryData: NewCacheEntryData(ttl)})
 	}
 	return !ok
 }
 
+// Set adds new value or replaces the existing one together with its ttl, returns the new version.
+func (c *StringCache) Set(key string, value string, flags uint32, ttl time.Duration) int64 {
+	v, ok := c.getValueWithExpiration(key)
+	if !ok {
+		entry := StringCacheEntry{Value: value, Flags: flags, CacheEntryData: NewCacheEntryData(ttl)}
+		c.store(key, entry)
+		return entry.Version
+	}
+	entry := c.replaceEntry(v, value, flags, ttl)
+	c.store(key, entry)
+	return entry.Version
+}
+
+// TryReplace replaces the value and its ttl only if the key is already used.
+func (c *StringCache) TryReplace(key string, value string, flags uint32, ttl time.Duration) bool {
+	v, ok := c.getValueWithExpiration(key)
+	if ok {
+		c.store(key, c.replaceEntry(v, value, flags, ttl))
+	}
+	return ok
+}
+
+// TryCompareAndSwap replaces the value and its ttl only if the entry version was not changed.
+// The second result is false if the key was not found.
+func (c *StringCache) TryCompareAndSwap(key string, value string, flags uint32, ttl time.Duration, version int64) (bool, bool) {
+	v, ok := c.getValueWithExpiration(key)
+	if ok && v.Version == version {
+		c.store(key, c.replaceEntry(v, value, flags, ttl))
+		return true, true
+	}
+	return false, ok
+}
+
+// TryTouch sets new ttl for the existing key keeping its value and version.
+func (c *StringCache) TryTouch(key string, ttl time.Duration) bool {
+	return c.TrySetTTL(key, ttl, false)
+}
+
 // TryAddFromSnapshot add new value to the cache by the key specified if the key is not already used.
 func (c *StringCache) TryAddFromSnapshot(key string, entry StringCacheEntry) bool {
 	_, ok := c.Map[key]
 	if !ok {
-		c.Map[key] = entry
+		entry.ExpireAfter = normalizeExpiration(entry.ExpireAfter)
+		c.store(key, entry)
+		observeVersion(entry.Version)
 	}
 	return !ok
 }
@@ -65,25 +149,102 @@ func (c *StringCache) TryAddFromSnapshot(key string, entry StringCacheEntry) boo
 func (c *StringCache) TryDelete(key string) (bool, string) {
 	v, ok := c.getValueWithExpiration(key)
 	if ok {
-		delete(c.Map, key)
+		c.remove(key)
 	}
 	return ok, v.Value
 }
 
-// TryUpdate updates the value by the key only if the value is the same as the original value.
+// TryUpdate updates the value and its content type by the key only if the value is the same as the original value.
 // If not, you should repeat the update operation using optimistic concurrency loop
-func (c *StringCache) TryUpdate(key string, newValue string, originalValue string) (bool, string) {
+func (c *StringCache) TryUpdate(key string, newValue string, originalValue string, contentType string) (bool, string) {
 	v, ok := c.getValueWithExpiration(key)
 	if ok && v.Value == originalValue {
 		entry := StringCacheEntry{
 			Value:          newValue,
+			Flags:          v.Flags,
+			ContentType:    contentType,
 			CacheEntryData: UpdateCacheEntryData(v.CacheEntryData)}
-		c.Map[key] = entry
+		c.store(key, entry)
 		return true, v.Value
 	}
 	return false, v.Value
 }
 
+// TryIncrement changes the numeric value by the key keeping its flags and ttl, returns the new value.
+// The value is not changed if it is not a number or the result is out of range.
+func (c *StringCache) TryIncrement(key string, inc Increment) (bool, string, error) {
+	v, ok := c.getValueWithExpiration(key)
+	if !ok {
+		return false, "", nil
+	}
+	value, err := inc.Apply(v.Value, true)
+	if err != nil {
+		return true, v.Value, err
+	}
+	c.store(key, c.updateValue(v, value))
+	return true, value, nil
+}
+
+// TrySetBit sets the bit at the offset of the value like SETBIT and returns the previous bit.
+// The value is padded with zero bytes if needed, the version is changed only if the bit was changed.
+func (c *StringCache) TrySetBit(key string, offset int64, bit int) (bool, int, error) {
+	v, ok := c.getValueWithExpiration(key)
+	if !ok {
+		return false, 0, nil
+	}
+	value, old, err := SetBit(v.Value, offset, bit)
+	if err != nil || (old == bit && len(value) == len(v.Value)) {
+		return true, old, err
+	}
+	c.store(key, c.updateValue(v, value))
+	return true, old, nil
+}
+
+// TryGetBit returns the bit at the offset of the value like GETBIT.
+func (c *StringCache) TryGetBit(key string, offset int64) (bool, int, error) {
+	v, ok := c.getValueWithExpiration(key)
+	if !ok {
+		return false, 0, nil
+	}
+	bit, err := GetBit(v.Value, offset)
+	return true, bit, err
+}
+
+// TryCountBits returns the number of bits set in the range of the value like BITCOUNT.
+func (c *StringCache) TryCountBits(key string, start int64, end int64, bitUnit bool) (bool, int64) {
+	v, ok := c.getValueWithExpiration(key)
+	if !ok {
+		return false, 0
+	}
+	return true, CountBits(v.Value, start, end, bitUnit)
+}
+
+// TryGetBitPos returns the position of the first bit set to 0 or 1 in the range of the value like BITPOS.
+func (c *StringCache) TryGetBitPos(key string, bit int, start int64, end int64, hasEnd bool, bitUnit bool) (bool, int64, error) {
+	v, ok := c.getValueWithExpiration(key)
+	if !ok {
+		return false, 0, nil
+	}
+	pos, err := BitPos(v.Value, bit, start, end, hasEnd, bitUnit)
+	return true, pos, err
+}
+
+// TryBitField applies BITFIELD subcommands to the value, the version is changed only if the value was changed.
+func (c *StringCache) TryBitField(key string, ops []BitFieldOp) (bool, []BitFieldResult, error) {
+	v, ok := c.getValueWithExpiration(key)
+	if !ok {
+		return false, nil, nil
+	}
+	value, res, changed, err := BitField(v.Value, ops)
+	if err != nil {
+		return true, nil, err
+	}
+	if changed {
+		c.store(key, c.updateValue(v, value))
+	}
+	return true, res, nil
+}
+
 // GetKeys returns all the keys in the map.
 func (c *StringCache) GetKeys() []string {
 	var keySlice []string
@@ -98,11 +259,49 @@ func (c *StringCache) GetKeys() []string {
 	return keySlice
 }
 
+// GetStats returns cache usage counters.
+func (c *StringCache) GetStats() CacheStats {
+	stats := c.Evictor.Stats()
+	stats.Reaped = c.Reaped
+	return stats
+}
+
+// RemoveExpired deletes expired entries until all the keys are checked or the time budget is spent.
+// Go maps are iterated in random order, so the next sweep starts from another key.
+func (c *StringCache) RemoveExpired(budget time.Duration) int {
+	started := time.Now()
+	n, checked := 0, 0
+	for key, v := range c.Map {
+		if IsCacheEntryExpired(v.CacheEntryData) {
+			c.remove(key)
+			n++
+		}
+		checked++
+		if isOverBudget(started, budget, checked) {
+			break
+		}
+	}
+	c.Reaped += int64(n)
+	return n
+}
+
 func (c *StringCache) getValueWithExpiration(key string) (StringCacheEntry, bool) {
+	v, ok := c.peek(key)
+	if ok {
+		c.Evictor.Touch(key)
+		if v.slide() {
+			c.store(key, v)
+		}
+	}
+	return v, ok
+}
+
+// peek returns the entry removing it if expired, the entry is not marked as used.
+func (c *StringCache) peek(key string) (StringCacheEntry, bool) {
 	v, ok := c.Map[key]
 	if ok {
 		if IsCacheEntryExpired(v.CacheEntryData) {
-			delete(c.Map, key)
+			c.remove(key)
 			log.Printf("[StringCache] key %s had expired and was removed", key)
 			return v, false // expired
 		}
@@ -110,3 +309,38 @@ func (c *StringCache) getValueWithExpiration(key string) (StringCacheEntry, bool
 	}
 	return v, ok
 }
+
+func (c *StringCache) replaceEntry(v StringCacheEntry, value string, flags uint32, ttl time.Duration) StringCacheEntry {
+	data := UpdateCacheEntryData(v.CacheEntryData)
+	data.setTTL(ttl, false)
+	return StringCacheEntry{Value: value, Flags: flags, CacheEntryData: data}
+}
+
+// updateValue returns the entry with the new value keeping its flags, content type and ttl.
+func (c *StringCache) updateValue(v StringCacheEntry, value string) StringCacheEntry {
+	return StringCacheEntry{
+		Value:          value,
+		Flags:          v.Flags,
+		ContentType:    v.ContentType,
+		CacheEntryData: UpdateCacheEntryData(v.CacheEntryData)}
+}
+
+// store puts the entry to the map and evicts other keys if the cache is over its limits.
+func (c *StringCache) store(key string, entry StringCacheEntry) {
+	c.Map[key] = entry
+	c.Evictor.Track(key, entry.size(key), entry.ExpireAfter)
+	for _, k := range c.Evictor.Evict(key) {
+		delete(c.Map, k)
+		log.Printf("[StringCache] key %s was evicted", k)
+	}
+}
+
+func (c *StringCache) remove(key string) {
+	delete(c.Map, key)
+	c.Evictor.Remove(key)
+}
+
+// size returns approximate memory used by the entry.
+func (v StringCacheEntry) size(key string) int64 {
+	return int64(len(key) + len(v.Value) + len(v.ContentType))
+}
@@ -0,0 +1,3909 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: bloom.proto
+
+/*
+Package messages is a generated protocol buffer package.
+
+It is generated from these files:
+
+	bloom.proto
+	dictionary.proto
+	document.proto
+	geo.proto
+	hyperloglog.proto
+	keys.proto
+	KeyValue.proto
+	list.proto
+	lock.proto
+	queue.proto
+	ratelimit.proto
+	service.proto
+	set.proto
+	sortedset.proto
+	stream.proto
+	string.proto
+	ttl.proto
+
+It has these top-level messages:
+
+	GetBloomFilterCacheKeyMessage
+	GetBloomFilterCacheKeyReply
+	DeleteBloomFilterCacheKeyMessage
+	DeleteBloomFilterCacheKeyReply
+	PostBloomFilterCacheKeyMessage
+	PostBloomFilterCacheKeyReply
+	AddBloomFilterCacheValuesMessage
+	AddBloomFilterCacheValuesReply
+	ExistsBloomFilterCacheValuesMessage
+	ExistsBloomFilterCacheValuesReply
+	GetDictionaryCacheKeyMessage
+	GetDictionaryCacheKeyReply
+	DeleteDictionaryCacheKeyMessage
+	DeleteDictionaryCacheKeyReply
+	PostDictionaryCacheKeyMessage
+	PostDictionaryCacheKeyReply
+	PutDictionaryCacheValueMessage
+	PutDictionaryCacheValueReply
+	DeleteDictionaryCacheValueMessage
+	DeleteDictionaryCacheValueReply
+	PostDictionaryCacheValueMessage
+	PostDictionaryCacheValueReply
+	IncrementDictionaryCacheValueMessage
+	IncrementDictionaryCacheValueReply
+	GetDictionaryCacheValuesMessage
+	GetDictionaryCacheValuesReply
+	SetDictionaryCacheValuesMessage
+	SetDictionaryCacheValuesReply
+	DeleteDictionaryCacheValuesMessage
+	DeleteDictionaryCacheValuesReply
+	GetDictionaryCacheSubKeysMessage
+	GetDictionaryCacheSubKeysReply
+	ContainsDictionaryCacheValuesMessage
+	ContainsDictionaryCacheValuesReply
+	DocumentPatchOperation
+	GetDocumentCacheKeyMessage
+	GetDocumentCacheKeyReply
+	DeleteDocumentCacheKeyMessage
+	DeleteDocumentCacheKeyReply
+	PostDocumentCacheKeyMessage
+	PostDocumentCacheKeyReply
+	GetDocumentCachePathMessage
+	GetDocumentCachePathReply
+	SetDocumentCachePathMessage
+	SetDocumentCachePathReply
+	DeleteDocumentCachePathMessage
+	DeleteDocumentCachePathReply
+	AppendDocumentCacheValuesMessage
+	AppendDocumentCacheValuesReply
+	MergeDocumentCacheMessage
+	MergeDocumentCacheReply
+	PatchDocumentCacheMessage
+	PatchDocumentCacheReply
+	GeoMember
+	GeoResult
+	GetGeoCacheKeyMessage
+	GetGeoCacheKeyReply
+	DeleteGeoCacheKeyMessage
+	DeleteGeoCacheKeyReply
+	PostGeoCacheKeyMessage
+	PostGeoCacheKeyReply
+	AddGeoCacheMembersMessage
+	AddGeoCacheMembersReply
+	RemoveGeoCacheMembersMessage
+	RemoveGeoCacheMembersReply
+	GetGeoCachePositionsMessage
+	GetGeoCachePositionsReply
+	GetGeoCacheDistanceMessage
+	GetGeoCacheDistanceReply
+	SearchGeoCacheMessage
+	SearchGeoCacheReply
+	GetHyperLogLogCacheKeyMessage
+	GetHyperLogLogCacheKeyReply
+	DeleteHyperLogLogCacheKeyMessage
+	DeleteHyperLogLogCacheKeyReply
+	PostHyperLogLogCacheKeyMessage
+	PostHyperLogLogCacheKeyReply
+	AddHyperLogLogCacheValuesMessage
+	AddHyperLogLogCacheValuesReply
+	MergeHyperLogLogCacheMessage
+	MergeHyperLogLogCacheReply
+	GetCacheKeysMessage
+	GetCacheKeysReply
+	CacheKey
+	GetCacheStatsMessage
+	GetCacheStatsReply
+	KeyValue
+	GetListCacheKeyMessage
+	GetListCacheKeyReply
+	DeleteListCacheKeyMessage
+	DeleteListCacheKeyReply
+	PostListCacheKeyMessage
+	PostListCacheKeyReply
+	PutListCacheValueMessage
+	PutListCacheValueReply
+	DeleteListCacheValueMessage
+	DeleteListCacheValueReply
+	PostListCacheValueMessage
+	PostListCacheValueReply
+	GetListCacheIndexMessage
+	GetListCacheIndexReply
+	SetListCacheIndexMessage
+	SetListCacheIndexReply
+	InsertListCacheValueMessage
+	InsertListCacheValueReply
+	RemoveListCacheIndexMessage
+	RemoveListCacheIndexReply
+	GetListCacheRangeMessage
+	GetListCacheRangeReply
+	PushListCacheValuesMessage
+	PushListCacheValuesReply
+	PopListCacheValuesMessage
+	PopListCacheValuesReply
+	TrimListCacheKeyMessage
+	TrimListCacheKeyReply
+	GetLockCacheKeyMessage
+	GetLockCacheKeyReply
+	AcquireLockCacheKeyMessage
+	AcquireLockCacheKeyReply
+	RenewLockCacheKeyMessage
+	RenewLockCacheKeyReply
+	ReleaseLockCacheKeyMessage
+	ReleaseLockCacheKeyReply
+	NewQueueItem
+	QueueItem
+	GetQueueCacheKeyMessage
+	GetQueueCacheKeyReply
+	DeleteQueueCacheKeyMessage
+	DeleteQueueCacheKeyReply
+	PostQueueCacheKeyMessage
+	PostQueueCacheKeyReply
+	PushQueueCacheItemsMessage
+	PushQueueCacheItemsReply
+	PopQueueCacheItemsMessage
+	PopQueueCacheItemsReply
+	PeekQueueCacheItemsMessage
+	PeekQueueCacheItemsReply
+	AckQueueCacheItemsMessage
+	AckQueueCacheItemsReply
+	NackQueueCacheItemMessage
+	NackQueueCacheItemReply
+	GetQueueCacheDeadMessage
+	GetQueueCacheDeadReply
+	RedriveQueueCacheDeadMessage
+	RedriveQueueCacheDeadReply
+	GetRateLimitCacheKeyMessage
+	GetRateLimitCacheKeyReply
+	DeleteRateLimitCacheKeyMessage
+	DeleteRateLimitCacheKeyReply
+	TakeRateLimitCacheKeyMessage
+	TakeRateLimitCacheKeyReply
+	GetSetCacheKeyMessage
+	GetSetCacheKeyReply
+	DeleteSetCacheKeyMessage
+	DeleteSetCacheKeyReply
+	PostSetCacheKeyMessage
+	PostSetCacheKeyReply
+	PostSetCacheValuesMessage
+	PostSetCacheValuesReply
+	DeleteSetCacheValuesMessage
+	DeleteSetCacheValuesReply
+	ContainsSetCacheValueMessage
+	ContainsSetCacheValueReply
+	GetSetCacheCardinalityMessage
+	GetSetCacheCardinalityReply
+	GetSetCacheRandomValuesMessage
+	GetSetCacheRandomValuesReply
+	SortedSetMember
+	GetSortedSetCacheKeyMessage
+	GetSortedSetCacheKeyReply
+	DeleteSortedSetCacheKeyMessage
+	DeleteSortedSetCacheKeyReply
+	PostSortedSetCacheKeyMessage
+	PostSortedSetCacheKeyReply
+	PostSortedSetCacheValuesMessage
+	PostSortedSetCacheValuesReply
+	IncrementSortedSetCacheScoreMessage
+	IncrementSortedSetCacheScoreReply
+	DeleteSortedSetCacheValuesMessage
+	DeleteSortedSetCacheValuesReply
+	GetSortedSetCacheRangeMessage
+	GetSortedSetCacheRangeReply
+	GetSortedSetCacheRangeByScoreMessage
+	GetSortedSetCacheRangeByScoreReply
+	GetSortedSetCacheRankMessage
+	GetSortedSetCacheRankReply
+	StreamEntry
+	StreamGroupInfo
+	StreamPendingEntry
+	GetStreamCacheKeyMessage
+	GetStreamCacheKeyReply
+	DeleteStreamCacheKeyMessage
+	DeleteStreamCacheKeyReply
+	PostStreamCacheKeyMessage
+	PostStreamCacheKeyReply
+	AppendStreamCacheEntryMessage
+	AppendStreamCacheEntryReply
+	GetStreamCacheRangeMessage
+	GetStreamCacheRangeReply
+	TrimStreamCacheKeyMessage
+	TrimStreamCacheKeyReply
+	GetStreamCacheGroupsMessage
+	GetStreamCacheGroupsReply
+	CreateStreamCacheGroupMessage
+	CreateStreamCacheGroupReply
+	DeleteStreamCacheGroupMessage
+	DeleteStreamCacheGroupReply
+	ReadStreamCacheGroupMessage
+	ReadStreamCacheGroupReply
+	AckStreamCacheGroupMessage
+	AckStreamCacheGroupReply
+	GetStreamCachePendingMessage
+	GetStreamCachePendingReply
+	GetStringCacheKeyMessage
+	GetStringCacheKeyReply
+	DeleteStringCacheKeyMessage
+	DeleteStringCacheKeyReply
+	PostStringCacheKeyMessage
+	PostStringCacheKeyReply
+	PutStringCacheKeyMessage
+	PutStringCacheKeyReply
+	SetStringCacheKeyMessage
+	SetStringCacheKeyReply
+	ReplaceStringCacheKeyMessage
+	ReplaceStringCacheKeyReply
+	CompareAndSwapStringCacheKeyMessage
+	CompareAndSwapStringCacheKeyReply
+	TouchStringCacheKeyMessage
+	TouchStringCacheKeyReply
+	IncrementStringCacheKeyMessage
+	IncrementStringCacheKeyReply
+	SetBitStringCacheKeyMessage
+	SetBitStringCacheKeyReply
+	GetBitStringCacheKeyMessage
+	GetBitStringCacheKeyReply
+	CountBitsStringCacheKeyMessage
+	CountBitsStringCacheKeyReply
+	GetBitPosStringCacheKeyMessage
+	GetBitPosStringCacheKeyReply
+	BitOpStringCacheKeyMessage
+	BitOpStringCacheKeyReply
+	BitFieldOperation
+	BitFieldResult
+	BitFieldStringCacheKeyMessage
+	BitFieldStringCacheKeyReply
+	GetCacheTTLMessage
+	GetCacheTTLReply
+	SetCacheTTLMessage
+	SetCacheTTLReply
+	PersistCacheKeyMessage
+	PersistCacheKeyReply
+	GetCacheMetadataMessage
+	GetCacheMetadataReply
+*/
+package messages
+
+import proto "github.com/gogo/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "github.com/gogo/protobuf/gogoproto"
+import _ "github.com/gogo/protobuf/types"
+
+import time "time"
+
+import strings "strings"
+import reflect "reflect"
+
+import binary "encoding/binary"
+import types "github.com/gogo/protobuf/types"
+
+import io "io"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+type GetBloomFilterCacheKeyMessage struct {
+	Key string `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key,omitempty"`
+}
+
+func (m *GetBloomFilterCacheKeyMessage) Reset()      { *m = GetBloomFilterCacheKeyMessage{} }
+func (*GetBloomFilterCacheKeyMessage) ProtoMessage() {}
+func (*GetBloomFilterCacheKeyMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptorBloom, []int{0}
+}
+
+func (m *GetBloomFilterCacheKeyMessage) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+type GetBloomFilterCacheKeyReply struct {
+	Key       string  `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key,omitempty"`
+	Capacity  int64   `protobuf:"varint,2,opt,name=Capacity,proto3" json:"Capacity,omitempty"`
+	ErrorRate float64 `protobuf:"fixed64,3,opt,name=ErrorRate,proto3" json:"ErrorRate,omitempty"`
+	Bits      uint64  `protobuf:"varint,4,opt,name=Bits,proto3" json:"Bits,omitempty"`
+	Hashes    int32   `protobuf:"varint,5,opt,name=Hashes,proto3" json:"Hashes,omitempty"`
+	Count     int64   `protobuf:"varint,6,opt,name=Count,proto3" json:"Count,omitempty"`
+	Success   bool    `protobuf:"varint,7,opt,name=Success,proto3" json:"Success,omitempty"`
+	Version   int64   `protobuf:"varint,8,opt,name=Version,proto3" json:"Version,omitempty"`
+}
+
+func (m *GetBloomFilterCacheKeyReply) Reset()      { *m = GetBloomFilterCacheKeyReply{} }
+func (*GetBloomFilterCacheKeyReply) ProtoMessage() {}
+func (*GetBloomFilterCacheKeyReply) Descriptor() ([]byte, []int) {
+	return fileDescriptorBloom, []int{1}
+}
+
+func (m *GetBloomFilterCacheKeyReply) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *GetBloomFilterCacheKeyReply) GetCapacity() int64 {
+	if m != nil {
+		return m.Capacity
+	}
+	return 0
+}
+
+func (m *GetBloomFilterCacheKeyReply) GetErrorRate() float64 {
+	if m != nil {
+		return m.ErrorRate
+	}
+	return 0
+}
+
+func (m *GetBloomFilterCacheKeyReply) GetBits() uint64 {
+	if m != nil {
+		return m.Bits
+	}
+	return 0
+}
+
+func (m *GetBloomFilterCacheKeyReply) GetHashes() int32 {
+	if m != nil {
+		return m.Hashes
+	}
+	return 0
+}
+
+func (m *GetBloomFilterCacheKeyReply) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *GetBloomFilterCacheKeyReply) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *GetBloomFilterCacheKeyReply) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+type DeleteBloomFilterCacheKeyMessage struct {
+	Key     string `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key,omitempty"`
+	Version int64  `protobuf:"varint,2,opt,name=Version,proto3" json:"Version,omitempty"`
+}
+
+func (m *DeleteBloomFilterCacheKeyMessage) Reset()      { *m = DeleteBloomFilterCacheKeyMessage{} }
+func (*DeleteBloomFilterCacheKeyMessage) ProtoMessage() {}
+func (*DeleteBloomFilterCacheKeyMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptorBloom, []int{2}
+}
+
+func (m *DeleteBloomFilterCacheKeyMessage) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *DeleteBloomFilterCacheKeyMessage) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+type DeleteBloomFilterCacheKeyReply struct {
+	Key      string `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key,omitempty"`
+	Success  bool   `protobuf:"varint,2,opt,name=Success,proto3" json:"Success,omitempty"`
+	Conflict bool   `protobuf:"varint,3,opt,name=Conflict,proto3" json:"Conflict,omitempty"`
+}
+
+func (m *DeleteBloomFilterCacheKeyReply) Reset()      { *m = DeleteBloomFilterCacheKeyReply{} }
+func (*DeleteBloomFilterCacheKeyReply) ProtoMessage() {}
+func (*DeleteBloomFilterCacheKeyReply) Descriptor() ([]byte, []int) {
+	return fileDescriptorBloom, []int{3}
+}
+
+func (m *DeleteBloomFilterCacheKeyReply) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *DeleteBloomFilterCacheKeyReply) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *DeleteBloomFilterCacheKeyReply) GetConflict() bool {
+	if m != nil {
+		return m.Conflict
+	}
+	return false
+}
+
+type PostBloomFilterCacheKeyMessage struct {
+	Key       string        `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key,omitempty"`
+	Capacity  int64         `protobuf:"varint,2,opt,name=Capacity,proto3" json:"Capacity,omitempty"`
+	ErrorRate float64       `protobuf:"fixed64,3,opt,name=ErrorRate,proto3" json:"ErrorRate,omitempty"`
+	TTL       time.Duration `protobuf:"bytes,4,opt,name=TTL,stdduration" json:"TTL"`
+	Sliding   bool          `protobuf:"varint,5,opt,name=Sliding,proto3" json:"Sliding,omitempty"`
+}
+
+func (m *PostBloomFilterCacheKeyMessage) Reset()      { *m = PostBloomFilterCacheKeyMessage{} }
+func (*PostBloomFilterCacheKeyMessage) ProtoMessage() {}
+func (*PostBloomFilterCacheKeyMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptorBloom, []int{4}
+}
+
+func (m *PostBloomFilterCacheKeyMessage) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *PostBloomFilterCacheKeyMessage) GetCapacity() int64 {
+	if m != nil {
+		return m.Capacity
+	}
+	return 0
+}
+
+func (m *PostBloomFilterCacheKeyMessage) GetErrorRate() float64 {
+	if m != nil {
+		return m.ErrorRate
+	}
+	return 0
+}
+
+func (m *PostBloomFilterCacheKeyMessage) GetTTL() time.Duration {
+	if m != nil {
+		return m.TTL
+	}
+	return 0
+}
+
+func (m *PostBloomFilterCacheKeyMessage) GetSliding() bool {
+	if m != nil {
+		return m.Sliding
+	}
+	return false
+}
+
+type PostBloomFilterCacheKeyReply struct {
+	Key     string `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key,omitempty"`
+	Success bool   `protobuf:"varint,2,opt,name=Success,proto3" json:"Success,omitempty"`
+	Version int64  `protobuf:"varint,3,opt,name=Version,proto3" json:"Version,omitempty"`
+	Error   string `protobuf:"bytes,4,opt,name=Error,proto3" json:"Error,omitempty"`
+}
+
+func (m *PostBloomFilterCacheKeyReply) Reset()      { *m = PostBloomFilterCacheKeyReply{} }
+func (*PostBloomFilterCacheKeyReply) ProtoMessage() {}
+func (*PostBloomFilterCacheKeyReply) Descriptor() ([]byte, []int) {
+	return fileDescriptorBloom, []int{5}
+}
+
+func (m *PostBloomFilterCacheKeyReply) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *PostBloomFilterCacheKeyReply) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *PostBloomFilterCacheKeyReply) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *PostBloomFilterCacheKeyReply) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type AddBloomFilterCacheValuesMessage struct {
+	Key       string   `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key,omitempty"`
+	Values    []string `protobuf:"bytes,2,rep,name=Values" json:"Values,omitempty"`
+	Create    bool     `protobuf:"varint,3,opt,name=Create,proto3" json:"Create,omitempty"`
+	Capacity  int64    `protobuf:"varint,4,opt,name=Capacity,proto3" json:"Capacity,omitempty"`
+	ErrorRate float64  `protobuf:"fixed64,5,opt,name=ErrorRate,proto3" json:"ErrorRate,omitempty"`
+	Version   int64    `protobuf:"varint,6,opt,name=Version,proto3" json:"Version,omitempty"`
+}
+
+func (m *AddBloomFilterCacheValuesMessage) Reset()      { *m = AddBloomFilterCacheValuesMessage{} }
+func (*AddBloomFilterCacheValuesMessage) ProtoMessage() {}
+func (*AddBloomFilterCacheValuesMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptorBloom, []int{6}
+}
+
+func (m *AddBloomFilterCacheValuesMessage) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *AddBloomFilterCacheValuesMessage) GetValues() []string {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+func (m *AddBloomFilterCacheValuesMessage) GetCreate() bool {
+	if m != nil {
+		return m.Create
+	}
+	return false
+}
+
+func (m *AddBloomFilterCacheValuesMessage) GetCapacity() int64 {
+	if m != nil {
+		return m.Capacity
+	}
+	return 0
+}
+
+func (m *AddBloomFilterCacheValuesMessage) GetErrorRate() float64 {
+	if m != nil {
+		return m.ErrorRate
+	}
+	return 0
+}
+
+func (m *AddBloomFilterCacheValuesMessage) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+type AddBloomFilterCacheValuesReply struct {
+	Key      string   `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key,omitempty"`
+	Values   []string `protobuf:"bytes,2,rep,name=Values" json:"Values,omitempty"`
+	Results  []bool   `protobuf:"varint,3,rep,packed,name=Results" json:"Results,omitempty"`
+	Success  bool     `protobuf:"varint,4,opt,name=Success,proto3" json:"Success,omitempty"`
+	Created  bool     `protobuf:"varint,5,opt,name=Created,proto3" json:"Created,omitempty"`
+	Version  int64    `protobuf:"varint,6,opt,name=Version,proto3" json:"Version,omitempty"`
+	Conflict bool     `protobuf:"varint,7,opt,name=Conflict,proto3" json:"Conflict,omitempty"`
+	Error    string   `protobuf:"bytes,8,opt,name=Error,proto3" json:"Error,omitempty"`
+}
+
+func (m *AddBloomFilterCacheValuesReply) Reset()      { *m = AddBloomFilterCacheValuesReply{} }
+func (*AddBloomFilterCacheValuesReply) ProtoMessage() {}
+func (*AddBloomFilterCacheValuesReply) Descriptor() ([]byte, []int) {
+	return fileDescriptorBloom, []int{7}
+}
+
+func (m *AddBloomFilterCacheValuesReply) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *AddBloomFilterCacheValuesReply) GetValues() []string {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+func (m *AddBloomFilterCacheValuesReply) GetResults() []bool {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+func (m *AddBloomFilterCacheValuesReply) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *AddBloomFilterCacheValuesReply) GetCreated() bool {
+	if m != nil {
+		return m.Created
+	}
+	return false
+}
+
+func (m *AddBloomFilterCacheValuesReply) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *AddBloomFilterCacheValuesReply) GetConflict() bool {
+	if m != nil {
+		return m.Conflict
+	}
+	return false
+}
+
+func (m *AddBloomFilterCacheValuesReply) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type ExistsBloomFilterCacheValuesMessage struct {
+	Key    string   `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key,omitempty"`
+	Values []string `protobuf:"bytes,2,rep,name=Values" json:"Values,omitempty"`
+}
+
+func (m *ExistsBloomFilterCacheValuesMessage) Reset()      { *m = ExistsBloomFilterCacheValuesMessage{} }
+func (*ExistsBloomFilterCacheValuesMessage) ProtoMessage() {}
+func (*ExistsBloomFilterCacheValuesMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptorBloom, []int{8}
+}
+
+func (m *ExistsBloomFilterCacheValuesMessage) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *ExistsBloomFilterCacheValuesMessage) GetValues() []string {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+type ExistsBloomFilterCacheValuesReply struct {
+	Key     string   `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key,omitempty"`
+	Values  []string `protobuf:"bytes,2,rep,name=Values" json:"Values,omitempty"`
+	Results []bool   `protobuf:"varint,3,rep,packed,name=Results" json:"Results,omitempty"`
+	Success bool     `protobuf:"varint,4,opt,name=Success,proto3" json:"Success,omitempty"`
+	Version int64    `protobuf:"varint,5,opt,name=Version,proto3" json:"Version,omitempty"`
+}
+
+func (m *ExistsBloomFilterCacheValuesReply) Reset()      { *m = ExistsBloomFilterCacheValuesReply{} }
+func (*ExistsBloomFilterCacheValuesReply) ProtoMessage() {}
+func (*ExistsBloomFilterCacheValuesReply) Descriptor() ([]byte, []int) {
+	return fileDescriptorBloom, []int{9}
+}
+
+func (m *ExistsBloomFilterCacheValuesReply) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *ExistsBloomFilterCacheValuesReply) GetValues() []string {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+func (m *ExistsBloomFilterCacheValuesReply) GetResults() []bool {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+func (m *ExistsBloomFilterCacheValuesReply) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *ExistsBloomFilterCacheValuesReply) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*GetBloomFilterCacheKeyMessage)(nil), "messages.GetBloomFilterCacheKeyMessage")
+	proto.RegisterType((*GetBloomFilterCacheKeyReply)(nil), "messages.GetBloomFilterCacheKeyReply")
+	proto.RegisterType((*DeleteBloomFilterCacheKeyMessage)(nil), "messages.DeleteBloomFilterCacheKeyMessage")
+	proto.RegisterType((*DeleteBloomFilterCacheKeyReply)(nil), "messages.DeleteBloomFilterCacheKeyReply")
+	proto.RegisterType((*PostBloomFilterCacheKeyMessage)(nil), "messages.PostBloomFilterCacheKeyMessage")
+	proto.RegisterType((*PostBloomFilterCacheKeyReply)(nil), "messages.PostBloomFilterCacheKeyReply")
+	proto.RegisterType((*AddBloomFilterCacheValuesMessage)(nil), "messages.AddBloomFilterCacheValuesMessage")
+	proto.RegisterType((*AddBloomFilterCacheValuesReply)(nil), "messages.AddBloomFilterCacheValuesReply")
+	proto.RegisterType((*ExistsBloomFilterCacheValuesMessage)(nil), "messages.ExistsBloomFilterCacheValuesMessage")
+	proto.RegisterType((*ExistsBloomFilterCacheValuesReply)(nil), "messages.ExistsBloomFilterCacheValuesReply")
+}
+func (this *GetBloomFilterCacheKeyMessage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GetBloomFilterCacheKeyMessage)
+	if !ok {
+		that2, ok := that.(GetBloomFilterCacheKeyMessage)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Key != that1.Key {
+		return false
+	}
+	return true
+}
+func (this *GetBloomFilterCacheKeyReply) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GetBloomFilterCacheKeyReply)
+	if !ok {
+		that2, ok := that.(GetBloomFilterCacheKeyReply)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Key != that1.Key {
+		return false
+	}
+	if this.Capacity != that1.Capacity {
+		return false
+	}
+	if this.ErrorRate != that1.ErrorRate {
+		return false
+	}
+	if this.Bits != that1.Bits {
+		return false
+	}
+	if this.Hashes != that1.Hashes {
+		return false
+	}
+	if this.Count != that1.Count {
+		return false
+	}
+	if this.Success != that1.Success {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	return true
+}
+func (this *DeleteBloomFilterCacheKeyMessage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DeleteBloomFilterCacheKeyMessage)
+	if !ok {
+		that2, ok := that.(DeleteBloomFilterCacheKeyMessage)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Key != that1.Key {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	return true
+}
+func (this *DeleteBloomFilterCacheKeyReply) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DeleteBloomFilterCacheKeyReply)
+	if !ok {
+		that2, ok := that.(DeleteBloomFilterCacheKeyReply)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Key != that1.Key {
+		return false
+	}
+	if this.Success != that1.Success {
+		return false
+	}
+	if this.Conflict != that1.Conflict {
+		return false
+	}
+	return true
+}
+func (this *PostBloomFilterCacheKeyMessage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PostBloomFilterCacheKeyMessage)
+	if !ok {
+		that2, ok := that.(PostBloomFilterCacheKeyMessage)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Key != that1.Key {
+		return false
+	}
+	if this.Capacity != that1.Capacity {
+		return false
+	}
+	if this.ErrorRate != that1.ErrorRate {
+		return false
+	}
+	if this.TTL != that1.TTL {
+		return false
+	}
+	if this.Sliding != that1.Sliding {
+		return false
+	}
+	return true
+}
+func (this *PostBloomFilterCacheKeyReply) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PostBloomFilterCacheKeyReply)
+	if !ok {
+		that2, ok := that.(PostBloomFilterCacheKeyReply)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Key != that1.Key {
+		return false
+	}
+	if this.Success != that1.Success {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	if this.Error != that1.Error {
+		return false
+	}
+	return true
+}
+func (this *AddBloomFilterCacheValuesMessage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AddBloomFilterCacheValuesMessage)
+	if !ok {
+		that2, ok := that.(AddBloomFilterCacheValuesMessage)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Key != that1.Key {
+		return false
+	}
+	if len(this.Values) != len(that1.Values) {
+		return false
+	}
+	for i := range this.Values {
+		if this.Values[i] != that1.Values[i] {
+			return false
+		}
+	}
+	if this.Create != that1.Create {
+		return false
+	}
+	if this.Capacity != that1.Capacity {
+		return false
+	}
+	if this.ErrorRate != that1.ErrorRate {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	return true
+}
+func (this *AddBloomFilterCacheValuesReply) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AddBloomFilterCacheValuesReply)
+	if !ok {
+		that2, ok := that.(AddBloomFilterCacheValuesReply)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Key != that1.Key {
+		return false
+	}
+	if len(this.Values) != len(that1.Values) {
+		return false
+	}
+	for i := range this.Values {
+		if this.Values[i] != that1.Values[i] {
+			return false
+		}
+	}
+	if len(this.Results) != len(that1.Results) {
+		return false
+	}
+	for i := range this.Results {
+		if this.Results[i] != that1.Results[i] {
+			return false
+		}
+	}
+	if this.Success != that1.Success {
+		return false
+	}
+	if this.Created != that1.Created {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	if this.Conflict != that1.Conflict {
+		return false
+	}
+	if this.Error != that1.Error {
+		return false
+	}
+	return true
+}
+func (this *ExistsBloomFilterCacheValuesMessage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ExistsBloomFilterCacheValuesMessage)
+	if !ok {
+		that2, ok := that.(ExistsBloomFilterCacheValuesMessage)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Key != that1.Key {
+		return false
+	}
+	if len(this.Values) != len(that1.Values) {
+		return false
+	}
+	for i := range this.Values {
+		if this.Values[i] != that1.Values[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *ExistsBloomFilterCacheValuesReply) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ExistsBloomFilterCacheValuesReply)
+	if !ok {
+		that2, ok := that.(ExistsBloomFilterCacheValuesReply)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Key != that1.Key {
+		return false
+	}
+	if len(this.Values) != len(that1.Values) {
+		return false
+	}
+	for i := range this.Values {
+		if this.Values[i] != that1.Values[i] {
+			return false
+		}
+	}
+	if len(this.Results) != len(that1.Results) {
+		return false
+	}
+	for i := range this.Results {
+		if this.Results[i] != that1.Results[i] {
+			return false
+		}
+	}
+	if this.Success != that1.Success {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	return true
+}
+func (this *GetBloomFilterCacheKeyMessage) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&messages.GetBloomFilterCacheKeyMessage{")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetBloomFilterCacheKeyReply) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 12)
+	s = append(s, "&messages.GetBloomFilterCacheKeyReply{")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "Capacity: "+fmt.Sprintf("%#v", this.Capacity)+",\n")
+	s = append(s, "ErrorRate: "+fmt.Sprintf("%#v", this.ErrorRate)+",\n")
+	s = append(s, "Bits: "+fmt.Sprintf("%#v", this.Bits)+",\n")
+	s = append(s, "Hashes: "+fmt.Sprintf("%#v", this.Hashes)+",\n")
+	s = append(s, "Count: "+fmt.Sprintf("%#v", this.Count)+",\n")
+	s = append(s, "Success: "+fmt.Sprintf("%#v", this.Success)+",\n")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DeleteBloomFilterCacheKeyMessage) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&messages.DeleteBloomFilterCacheKeyMessage{")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DeleteBloomFilterCacheKeyReply) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&messages.DeleteBloomFilterCacheKeyReply{")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "Success: "+fmt.Sprintf("%#v", this.Success)+",\n")
+	s = append(s, "Conflict: "+fmt.Sprintf("%#v", this.Conflict)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PostBloomFilterCacheKeyMessage) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&messages.PostBloomFilterCacheKeyMessage{")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "Capacity: "+fmt.Sprintf("%#v", this.Capacity)+",\n")
+	s = append(s, "ErrorRate: "+fmt.Sprintf("%#v", this.ErrorRate)+",\n")
+	s = append(s, "TTL: "+fmt.Sprintf("%#v", this.TTL)+",\n")
+	s = append(s, "Sliding: "+fmt.Sprintf("%#v", this.Sliding)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PostBloomFilterCacheKeyReply) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&messages.PostBloomFilterCacheKeyReply{")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "Success: "+fmt.Sprintf("%#v", this.Success)+",\n")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AddBloomFilterCacheValuesMessage) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&messages.AddBloomFilterCacheValuesMessage{")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "Values: "+fmt.Sprintf("%#v", this.Values)+",\n")
+	s = append(s, "Create: "+fmt.Sprintf("%#v", this.Create)+",\n")
+	s = append(s, "Capacity: "+fmt.Sprintf("%#v", this.Capacity)+",\n")
+	s = append(s, "ErrorRate: "+fmt.Sprintf("%#v", this.ErrorRate)+",\n")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AddBloomFilterCacheValuesReply) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 12)
+	s = append(s, "&messages.AddBloomFilterCacheValuesReply{")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "Values: "+fmt.Sprintf("%#v", this.Values)+",\n")
+	s = append(s, "Results: "+fmt.Sprintf("%#v", this.Results)+",\n")
+	s = append(s, "Success: "+fmt.Sprintf("%#v", this.Success)+",\n")
+	s = append(s, "Created: "+fmt.Sprintf("%#v", this.Created)+",\n")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	s = append(s, "Conflict: "+fmt.Sprintf("%#v", this.Conflict)+",\n")
+	s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ExistsBloomFilterCacheValuesMessage) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&messages.ExistsBloomFilterCacheValuesMessage{")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "Values: "+fmt.Sprintf("%#v", this.Values)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ExistsBloomFilterCacheValuesReply) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&messages.ExistsBloomFilterCacheValuesReply{")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "Values: "+fmt.Sprintf("%#v", this.Values)+",\n")
+	s = append(s, "Results: "+fmt.Sprintf("%#v", this.Results)+",\n")
+	s = append(s, "Success: "+fmt.Sprintf("%#v", this.Success)+",\n")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringBloom(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *GetBloomFilterCacheKeyMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetBloomFilterCacheKeyMessage) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBloom(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
+	}
+	return i, nil
+}
+
+func (m *GetBloomFilterCacheKeyReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetBloomFilterCacheKeyReply) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBloom(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
+	}
+	if m.Capacity != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBloom(dAtA, i, uint64(m.Capacity))
+	}
+	if m.ErrorRate != 0 {
+		dAtA[i] = 0x19
+		i++
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.ErrorRate))))
+		i += 8
+	}
+	if m.Bits != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintBloom(dAtA, i, uint64(m.Bits))
+	}
+	if m.Hashes != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintBloom(dAtA, i, uint64(m.Hashes))
+	}
+	if m.Count != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintBloom(dAtA, i, uint64(m.Count))
+	}
+	if m.Success {
+		dAtA[i] = 0x38
+		i++
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Version != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintBloom(dAtA, i, uint64(m.Version))
+	}
+	return i, nil
+}
+
+func (m *DeleteBloomFilterCacheKeyMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteBloomFilterCacheKeyMessage) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBloom(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
+	}
+	if m.Version != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBloom(dAtA, i, uint64(m.Version))
+	}
+	return i, nil
+}
+
+func (m *DeleteBloomFilterCacheKeyReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteBloomFilterCacheKeyReply) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBloom(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
+	}
+	if m.Success {
+		dAtA[i] = 0x10
+		i++
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Conflict {
+		dAtA[i] = 0x18
+		i++
+		if m.Conflict {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *PostBloomFilterCacheKeyMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PostBloomFilterCacheKeyMessage) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBloom(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
+	}
+	if m.Capacity != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBloom(dAtA, i, uint64(m.Capacity))
+	}
+	if m.ErrorRate != 0 {
+		dAtA[i] = 0x19
+		i++
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.ErrorRate))))
+		i += 8
+	}
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintBloom(dAtA, i, uint64(types.SizeOfStdDuration(m.TTL)))
+	n1, err := types.StdDurationMarshalTo(m.TTL, dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n1
+	if m.Sliding {
+		dAtA[i] = 0x28
+		i++
+		if m.Sliding {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *PostBloomFilterCacheKeyReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PostBloomFilterCacheKeyReply) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBloom(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
+	}
+	if m.Success {
+		dAtA[i] = 0x10
+		i++
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Version != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintBloom(dAtA, i, uint64(m.Version))
+	}
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintBloom(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	return i, nil
+}
+
+func (m *AddBloomFilterCacheValuesMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddBloomFilterCacheValuesMessage) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBloom(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
+	}
+	if len(m.Values) > 0 {
+		for _, s := range m.Values {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.Create {
+		dAtA[i] = 0x18
+		i++
+		if m.Create {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Capacity != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintBloom(dAtA, i, uint64(m.Capacity))
+	}
+	if m.ErrorRate != 0 {
+		dAtA[i] = 0x29
+		i++
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.ErrorRate))))
+		i += 8
+	}
+	if m.Version != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintBloom(dAtA, i, uint64(m.Version))
+	}
+	return i, nil
+}
+
+func (m *AddBloomFilterCacheValuesReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddBloomFilterCacheValuesReply) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBloom(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
+	}
+	if len(m.Values) > 0 {
+		for _, s := range m.Values {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Results) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintBloom(dAtA, i, uint64(len(m.Results)))
+		for _, b := range m.Results {
+			if b {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i++
+		}
+	}
+	if m.Success {
+		dAtA[i] = 0x20
+		i++
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Created {
+		dAtA[i] = 0x28
+		i++
+		if m.Created {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Version != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintBloom(dAtA, i, uint64(m.Version))
+	}
+	if m.Conflict {
+		dAtA[i] = 0x38
+		i++
+		if m.Conflict {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintBloom(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	return i, nil
+}
+
+func (m *ExistsBloomFilterCacheValuesMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExistsBloomFilterCacheValuesMessage) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBloom(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
+	}
+	if len(m.Values) > 0 {
+		for _, s := range m.Values {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func (m *ExistsBloomFilterCacheValuesReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExistsBloomFilterCacheValuesReply) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBloom(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
+	}
+	if len(m.Values) > 0 {
+		for _, s := range m.Values {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Results) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintBloom(dAtA, i, uint64(len(m.Results)))
+		for _, b := range m.Results {
+			if b {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i++
+		}
+	}
+	if m.Success {
+		dAtA[i] = 0x20
+		i++
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Version != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintBloom(dAtA, i, uint64(m.Version))
+	}
+	return i, nil
+}
+
+func encodeVarintBloom(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *GetBloomFilterCacheKeyMessage) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovBloom(uint64(l))
+	}
+	return n
+}
+
+func (m *GetBloomFilterCacheKeyReply) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovBloom(uint64(l))
+	}
+	if m.Capacity != 0 {
+		n += 1 + sovBloom(uint64(m.Capacity))
+	}
+	if m.ErrorRate != 0 {
+		n += 9
+	}
+	if m.Bits != 0 {
+		n += 1 + sovBloom(uint64(m.Bits))
+	}
+	if m.Hashes != 0 {
+		n += 1 + sovBloom(uint64(m.Hashes))
+	}
+	if m.Count != 0 {
+		n += 1 + sovBloom(uint64(m.Count))
+	}
+	if m.Success {
+		n += 2
+	}
+	if m.Version != 0 {
+		n += 1 + sovBloom(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *DeleteBloomFilterCacheKeyMessage) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovBloom(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovBloom(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *DeleteBloomFilterCacheKeyReply) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovBloom(uint64(l))
+	}
+	if m.Success {
+		n += 2
+	}
+	if m.Conflict {
+		n += 2
+	}
+	return n
+}
+
+func (m *PostBloomFilterCacheKeyMessage) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovBloom(uint64(l))
+	}
+	if m.Capacity != 0 {
+		n += 1 + sovBloom(uint64(m.Capacity))
+	}
+	if m.ErrorRate != 0 {
+		n += 9
+	}
+	l = types.SizeOfStdDuration(m.TTL)
+	n += 1 + l + sovBloom(uint64(l))
+	if m.Sliding {
+		n += 2
+	}
+	return n
+}
+
+func (m *PostBloomFilterCacheKeyReply) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovBloom(uint64(l))
+	}
+	if m.Success {
+		n += 2
+	}
+	if m.Version != 0 {
+		n += 1 + sovBloom(uint64(m.Version))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovBloom(uint64(l))
+	}
+	return n
+}
+
+func (m *AddBloomFilterCacheValuesMessage) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovBloom(uint64(l))
+	}
+	if len(m.Values) > 0 {
+		for _, s := range m.Values {
+			l = len(s)
+			n += 1 + l + sovBloom(uint64(l))
+		}
+	}
+	if m.Create {
+		n += 2
+	}
+	if m.Capacity != 0 {
+		n += 1 + sovBloom(uint64(m.Capacity))
+	}
+	if m.ErrorRate != 0 {
+		n += 9
+	}
+	if m.Version != 0 {
+		n += 1 + sovBloom(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *AddBloomFilterCacheValuesReply) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovBloom(uint64(l))
+	}
+	if len(m.Values) > 0 {
+		for _, s := range m.Values {
+			l = len(s)
+			n += 1 + l + sovBloom(uint64(l))
+		}
+	}
+	if len(m.Results) > 0 {
+		n += 1 + sovBloom(uint64(len(m.Results))) + len(m.Results)*1
+	}
+	if m.Success {
+		n += 2
+	}
+	if m.Created {
+		n += 2
+	}
+	if m.Version != 0 {
+		n += 1 + sovBloom(uint64(m.Version))
+	}
+	if m.Conflict {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovBloom(uint64(l))
+	}
+	return n
+}
+
+func (m *ExistsBloomFilterCacheValuesMessage) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovBloom(uint64(l))
+	}
+	if len(m.Values) > 0 {
+		for _, s := range m.Values {
+			l = len(s)
+			n += 1 + l + sovBloom(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ExistsBloomFilterCacheValuesReply) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovBloom(uint64(l))
+	}
+	if len(m.Values) > 0 {
+		for _, s := range m.Values {
+			l = len(s)
+			n += 1 + l + sovBloom(uint64(l))
+		}
+	}
+	if len(m.Results) > 0 {
+		n += 1 + sovBloom(uint64(len(m.Results))) + len(m.Results)*1
+	}
+	if m.Success {
+		n += 2
+	}
+	if m.Version != 0 {
+		n += 1 + sovBloom(uint64(m.Version))
+	}
+	return n
+}
+
+func sovBloom(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozBloom(x uint64) (n int) {
+	return sovBloom(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *GetBloomFilterCacheKeyMessage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetBloomFilterCacheKeyMessage{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetBloomFilterCacheKeyReply) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetBloomFilterCacheKeyReply{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`Capacity:` + fmt.Sprintf("%v", this.Capacity) + `,`,
+		`ErrorRate:` + fmt.Sprintf("%v", this.ErrorRate) + `,`,
+		`Bits:` + fmt.Sprintf("%v", this.Bits) + `,`,
+		`Hashes:` + fmt.Sprintf("%v", this.Hashes) + `,`,
+		`Count:` + fmt.Sprintf("%v", this.Count) + `,`,
+		`Success:` + fmt.Sprintf("%v", this.Success) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeleteBloomFilterCacheKeyMessage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeleteBloomFilterCacheKeyMessage{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeleteBloomFilterCacheKeyReply) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeleteBloomFilterCacheKeyReply{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`Success:` + fmt.Sprintf("%v", this.Success) + `,`,
+		`Conflict:` + fmt.Sprintf("%v", this.Conflict) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PostBloomFilterCacheKeyMessage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PostBloomFilterCacheKeyMessage{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`Capacity:` + fmt.Sprintf("%v", this.Capacity) + `,`,
+		`ErrorRate:` + fmt.Sprintf("%v", this.ErrorRate) + `,`,
+		`TTL:` + strings.Replace(strings.Replace(this.TTL.String(), "Duration", "google_protobuf1.Duration", 1), `&`, ``, 1) + `,`,
+		`Sliding:` + fmt.Sprintf("%v", this.Sliding) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PostBloomFilterCacheKeyReply) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PostBloomFilterCacheKeyReply{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`Success:` + fmt.Sprintf("%v", this.Success) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AddBloomFilterCacheValuesMessage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AddBloomFilterCacheValuesMessage{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`Values:` + fmt.Sprintf("%v", this.Values) + `,`,
+		`Create:` + fmt.Sprintf("%v", this.Create) + `,`,
+		`Capacity:` + fmt.Sprintf("%v", this.Capacity) + `,`,
+		`ErrorRate:` + fmt.Sprintf("%v", this.ErrorRate) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AddBloomFilterCacheValuesReply) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AddBloomFilterCacheValuesReply{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`Values:` + fmt.Sprintf("%v", this.Values) + `,`,
+		`Results:` + fmt.Sprintf("%v", this.Results) + `,`,
+		`Success:` + fmt.Sprintf("%v", this.Success) + `,`,
+		`Created:` + fmt.Sprintf("%v", this.Created) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`Conflict:` + fmt.Sprintf("%v", this.Conflict) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ExistsBloomFilterCacheValuesMessage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ExistsBloomFilterCacheValuesMessage{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`Values:` + fmt.Sprintf("%v", this.Values) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ExistsBloomFilterCacheValuesReply) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ExistsBloomFilterCacheValuesReply{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`Values:` + fmt.Sprintf("%v", this.Values) + `,`,
+		`Results:` + fmt.Sprintf("%v", this.Results) + `,`,
+		`Success:` + fmt.Sprintf("%v", this.Success) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringBloom(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *GetBloomFilterCacheKeyMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBloom
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetBloomFilterCacheKeyMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetBloomFilterCacheKeyMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBloom
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBloom(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBloom
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetBloomFilterCacheKeyReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBloom
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetBloomFilterCacheKeyReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetBloomFilterCacheKeyReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBloom
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
+			}
+			m.Capacity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Capacity |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorRate", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.ErrorRate = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bits", wireType)
+			}
+			m.Bits = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Bits |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hashes", wireType)
+			}
+			m.Hashes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Hashes |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBloom(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBloom
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteBloomFilterCacheKeyMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBloom
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteBloomFilterCacheKeyMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteBloomFilterCacheKeyMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBloom
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBloom(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBloom
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteBloomFilterCacheKeyReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBloom
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteBloomFilterCacheKeyReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteBloomFilterCacheKeyReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBloom
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conflict", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Conflict = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBloom(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBloom
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PostBloomFilterCacheKeyMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBloom
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PostBloomFilterCacheKeyMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PostBloomFilterCacheKeyMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBloom
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
+			}
+			m.Capacity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Capacity |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorRate", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.ErrorRate = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TTL", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBloom
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := types.StdDurationUnmarshal(&m.TTL, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sliding", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Sliding = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBloom(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBloom
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PostBloomFilterCacheKeyReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBloom
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PostBloomFilterCacheKeyReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PostBloomFilterCacheKeyReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBloom
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBloom
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBloom(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBloom
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddBloomFilterCacheValuesMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBloom
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddBloomFilterCacheValuesMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddBloomFilterCacheValuesMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBloom
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBloom
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Values = append(m.Values, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Create", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Create = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
+			}
+			m.Capacity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Capacity |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorRate", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.ErrorRate = float64(math.Float64frombits(v))
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBloom(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBloom
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddBloomFilterCacheValuesReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBloom
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddBloomFilterCacheValuesReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddBloomFilterCacheValuesReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBloom
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBloom
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Values = append(m.Values, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBloom
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Results = append(m.Results, bool(v != 0))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBloom
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthBloom
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBloom
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (int(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Results = append(m.Results, bool(v != 0))
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Created = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conflict", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Conflict = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBloom
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBloom(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBloom
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExistsBloomFilterCacheValuesMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBloom
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExistsBloomFilterCacheValuesMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExistsBloomFilterCacheValuesMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBloom
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBloom
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Values = append(m.Values, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBloom(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBloom
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExistsBloomFilterCacheValuesReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBloom
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExistsBloomFilterCacheValuesReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExistsBloomFilterCacheValuesReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBloom
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBloom
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Values = append(m.Values, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBloom
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Results = append(m.Results, bool(v != 0))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBloom
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthBloom
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBloom
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (int(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Results = append(m.Results, bool(v != 0))
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBloom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBloom(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBloom
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipBloom(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowBloom
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBloom
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+			return iNdEx, nil
+		case 1:
+			iNdEx += 8
+			return iNdEx, nil
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBloom
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			iNdEx += length
+			if length < 0 {
+				return 0, ErrInvalidLengthBloom
+			}
+			return iNdEx, nil
+		case 3:
+			for {
+				var innerWire uint64
+				var start int = iNdEx
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return 0, ErrIntOverflowBloom
+					}
+					if iNdEx >= l {
+						return 0, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					innerWire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				innerWireType := int(innerWire & 0x7)
+				if innerWireType == 4 {
+					break
+				}
+				next, err := skipBloom(dAtA[start:])
+				if err != nil {
+					return 0, err
+				}
+				iNdEx = start + next
+			}
+			return iNdEx, nil
+		case 4:
+			return iNdEx, nil
+		case 5:
+			iNdEx += 4
+			return iNdEx, nil
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+	}
+	panic("unreachable")
+}
+
+var (
+	ErrInvalidLengthBloom = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowBloom   = fmt.Errorf("proto: integer overflow")
+)
+
+func init() { proto.RegisterFile("bloom.proto", fileDescriptorBloom) }
+
+var fileDescriptorBloom = []byte{
+	// 581 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x93, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0x86, 0x33, 0x71, 0x9c, 0x38, 0xa7, 0x9b, 0x4f, 0xd6, 0xa7, 0x6a, 0x08, 0x65, 0x6a, 0xcc,
+	0xc6, 0x0b, 0x48, 0x05, 0x88, 0x0b, 0x20, 0x69, 0x01, 0xa9, 0xfc, 0x69, 0xa8, 0xba, 0x77, 0x9c,
+	0xa9, 0x63, 0xc9, 0xc9, 0x44, 0x9e, 0xb1, 0x44, 0x58, 0x71, 0x09, 0x2c, 0x91, 0xb8, 0x01, 0x2e,
+	0x81, 0x0d, 0xfb, 0x2e, 0xbb, 0x64, 0x45, 0x89, 0x61, 0xc1, 0xb2, 0x97, 0x80, 0x3c, 0xb6, 0x1b,
+	0xbb, 0xc5, 0x95, 0x22, 0x10, 0xbb, 0x79, 0x3d, 0x73, 0xfe, 0x9e, 0xf7, 0x18, 0x36, 0x46, 0x21,
+	0xe7, 0xd3, 0xfe, 0x3c, 0xe2, 0x92, 0x9b, 0xc6, 0x94, 0x09, 0xe1, 0xfa, 0x4c, 0xf4, 0xee, 0xf8,
+	0x81, 0x9c, 0xc4, 0xa3, 0xbe, 0xc7, 0xa7, 0x3b, 0x3e, 0xf7, 0xf9, 0x8e, 0x7a, 0x30, 0x8a, 0x8f,
+	0x94, 0x52, 0x42, 0x9d, 0xb2, 0xc0, 0x1e, 0xf1, 0x39, 0xf7, 0x43, 0xb6, 0x7a, 0x35, 0x8e, 0x23,
+	0x57, 0x06, 0x7c, 0x96, 0xdd, 0xdb, 0x77, 0xe1, 0xc6, 0x63, 0x26, 0x07, 0x69, 0xa9, 0x47, 0x41,
+	0x28, 0x59, 0x34, 0x74, 0xbd, 0x09, 0xdb, 0x67, 0x8b, 0x67, 0x59, 0x41, 0xf3, 0x3f, 0xd0, 0xf6,
+	0xd9, 0x02, 0x23, 0x0b, 0x39, 0x5d, 0x9a, 0x1e, 0xed, 0x53, 0x04, 0xd7, 0x7f, 0x1f, 0x43, 0xd9,
+	0x3c, 0x5c, 0x5c, 0x8e, 0x30, 0x7b, 0x60, 0x0c, 0xdd, 0xb9, 0xeb, 0x05, 0x72, 0x81, 0x9b, 0x16,
+	0x72, 0x34, 0x7a, 0xae, 0xcd, 0x2d, 0xe8, 0xee, 0x45, 0x11, 0x8f, 0xa8, 0x2b, 0x19, 0xd6, 0x2c,
+	0xe4, 0x20, 0xba, 0xfa, 0x60, 0x9a, 0xd0, 0x1a, 0x04, 0x52, 0xe0, 0x96, 0x85, 0x9c, 0x16, 0x55,
+	0x67, 0x73, 0x13, 0xda, 0x4f, 0x5c, 0x31, 0x61, 0x02, 0xeb, 0x16, 0x72, 0x74, 0x9a, 0x2b, 0xf3,
+	0x7f, 0xd0, 0x87, 0x3c, 0x9e, 0x49, 0xdc, 0x56, 0x25, 0x32, 0x61, 0x62, 0xe8, 0xbc, 0x8a, 0x3d,
+	0x8f, 0x09, 0x81, 0x3b, 0x16, 0x72, 0x0c, 0x5a, 0xc8, 0xf4, 0xe6, 0x90, 0x45, 0x22, 0xe0, 0x33,
+	0x6c, 0xa8, 0x88, 0x42, 0xda, 0xcf, 0xc1, 0xda, 0x65, 0x21, 0x93, 0x6c, 0x1d, 0x2e, 0xe5, 0x7c,
+	0xcd, 0x6a, 0xbe, 0x09, 0x90, 0xda, 0x7c, 0x75, 0xcc, 0x4a, 0x7d, 0x37, 0xab, 0x7d, 0xa7, 0x34,
+	0xf9, 0xec, 0x28, 0x0c, 0x3c, 0xa9, 0x80, 0x19, 0xf4, 0x5c, 0xdb, 0x9f, 0x11, 0x90, 0x97, 0x5c,
+	0xac, 0x65, 0xe8, 0x1f, 0xd8, 0xf3, 0x00, 0xb4, 0x83, 0x83, 0xa7, 0xca, 0x9d, 0x8d, 0x7b, 0xd7,
+	0xfa, 0xd9, 0xae, 0xf5, 0x8b, 0x5d, 0xeb, 0xef, 0xe6, 0xbb, 0x36, 0x30, 0x8e, 0xbf, 0x6e, 0x37,
+	0xde, 0x9f, 0x6e, 0x23, 0x9a, 0xbe, 0x57, 0xb3, 0x85, 0xc1, 0x38, 0x98, 0xf9, 0x58, 0xcf, 0x67,
+	0xcb, 0xa4, 0xfd, 0x06, 0xb6, 0x6a, 0xda, 0x5f, 0x9f, 0x53, 0xc9, 0x0f, 0xad, 0xe2, 0x47, 0xba,
+	0x29, 0x6a, 0x06, 0xd5, 0x78, 0x97, 0x66, 0xc2, 0xfe, 0x84, 0xc0, 0x7a, 0x38, 0x1e, 0x5f, 0xac,
+	0x7d, 0xe8, 0x86, 0x31, 0x13, 0xf5, 0xf4, 0x36, 0xa1, 0x9d, 0x3d, 0xc1, 0x4d, 0x4b, 0x73, 0xba,
+	0x34, 0x57, 0xe9, 0xf7, 0x61, 0xc4, 0x0a, 0x6c, 0x06, 0xcd, 0x55, 0x85, 0x76, 0xeb, 0x2a, 0xda,
+	0xfa, 0x45, 0xda, 0xa5, 0x81, 0xda, 0xd5, 0x05, 0xfb, 0x81, 0x80, 0xd4, 0xb6, 0x5e, 0x47, 0xae,
+	0xae, 0x71, 0x0c, 0x1d, 0xca, 0x44, 0x1c, 0x4a, 0x81, 0x35, 0x4b, 0x4b, 0x89, 0xe6, 0xb2, 0xcc,
+	0xba, 0x75, 0x89, 0x75, 0x36, 0xde, 0xb8, 0x70, 0x34, 0x97, 0xf5, 0x4d, 0x57, 0xf6, 0xb8, 0x53,
+	0xdd, 0xe3, 0x95, 0x43, 0x46, 0xd9, 0xa1, 0x17, 0x70, 0x6b, 0xef, 0x75, 0x20, 0xa4, 0xf8, 0x4b,
+	0x1e, 0xd9, 0x1f, 0x10, 0xdc, 0xbc, 0x2a, 0xe3, 0x3f, 0x42, 0x57, 0x00, 0xd2, 0x2b, 0x80, 0x06,
+	0xb7, 0x4f, 0x96, 0xa4, 0xf1, 0x65, 0x49, 0x1a, 0x67, 0x4b, 0x82, 0xde, 0x26, 0x04, 0x7d, 0x4c,
+	0x08, 0x3a, 0x4e, 0x08, 0x3a, 0x49, 0x08, 0xfa, 0x96, 0x10, 0xf4, 0x33, 0x21, 0x8d, 0xb3, 0x84,
+	0xa0, 0x77, 0xdf, 0x49, 0x63, 0xd4, 0x56, 0xbf, 0xdd, 0xfd, 0x5f, 0x03, 0x00, 0x05, 0x69, 0xa9,
+	0x70, 0x38, 0x06, 0x00, 0x00,
+}
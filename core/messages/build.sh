@@ -1,3 +1,3 @@
 #!/bin/bash
 #Don't forget to put $GOPATH/bin into PATH for gogoslick_out to work with protoc
-protoc --gogoslick_out=plugins=grpc:. --proto_path=. --proto_path="$GOPATH/src" ./*.proto
\ No newline at end of file
+protoc --gogoslick_out=plugins=grpc,Mgoogle/protobuf/duration.proto=github.com/gogo/protobuf/types:. --proto_path=. --proto_path="$GOPATH/src" ./*.proto
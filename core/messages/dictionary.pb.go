@@ -1,50 +1,29 @@
 // Code generated by protoc-gen-gogo. DO NOT EDIT.
 // source: dictionary.proto
 
-/*
-	Package messages is a generated protocol buffer package.
-
-	It is generated from these files:
-		dictionary.proto
-		KeyValue.proto
-
-	It has these top-level messages:
-		GetDictionaryCacheKeyMessage
-		GetDictionaryCacheKeyReply
-		DeleteDictionaryCacheKeyMessage
-		DeleteDictionaryCacheKeyReply
-		PostDictionaryCacheKeyMessage
-		PostDictionaryCacheKeyReply
-		PutDictionaryCacheValueMessage
-		PutDictionaryCacheValueReply
-		DeleteDictionaryCacheValueMessage
-		DeleteDictionaryCacheValueReply
-		PostDictionaryCacheValueMessage
-		PostDictionaryCacheValueReply
-		KeyValue
-*/
 package messages
 
 import proto "github.com/gogo/protobuf/proto"
 import fmt "fmt"
 import math "math"
-import google_protobuf "github.com/golang/protobuf/ptypes/duration"
+import _ "github.com/gogo/protobuf/gogoproto"
+import _ "github.com/gogo/protobuf/types"
+
+import time "time"
 
 import strings "strings"
 import reflect "reflect"
 
+import binary "encoding/binary"
+import types "github.com/gogo/protobuf/types"
+
 import io "io"
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the proto package it is being compiled against.
-// A compilation error at this line likely means your copy of the
-// proto package needs to be updated.
-const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+var _ = time.Kitchen
 
 type GetDictionaryCacheKeyMessage struct {
 	Key string `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key,omitempty"`
@@ -64,9 +43,10 @@ func (m *GetDictionaryCacheKeyMessage) GetKey() string {
 }
 
 type GetDictionaryCacheKeyReply struct {
-	Key     string      `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key,omitempty"`
-	Values  []*KeyValue `protobuf:"bytes,2,rep,name=Values" json:"Values,omitempty"`
-	Success bool        `protobuf:"varint,3,opt,name=Success,proto3" json:"Success,omitempty"`
+	Key     string     `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key,omitempty"`
+	Values  []KeyValue `protobuf:"bytes,2,rep,name=Values" json:"Values"`
+	Success bool       `protobuf:"varint,3,opt,name=Success,proto3" json:"Success,omitempty"`
+	Version int64      `protobuf:"varint,4,opt,name=Version,proto3" json:"Version,omitempty"`
 }
 
 func (m *GetDictionaryCacheKeyReply) Reset()      { *m = GetDictionaryCacheKeyReply{} }
@@ -82,7 +62,7 @@ func (m *GetDictionaryCacheKeyReply) GetKey() string {
 	return ""
 }
 
-func (m *GetDictionaryCacheKeyReply) GetValues() []*KeyValue {
+func (m *GetDictionaryCacheKeyReply) GetValues() []KeyValue {
 	if m != nil {
 		return m.Values
 	}
@@ -96,8 +76,16 @@ func (m *GetDictionaryCacheKeyReply) GetSuccess() bool {
 	return false
 }
 
+func (m *GetDictionaryCacheKeyReply) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
 type DeleteDictionaryCacheKeyMessage struct {
-	Key string `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key,omitempty"`
+	Key     string `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key,omitempty"`
+	Version int64  `protobuf:"varint,2,opt,name=Version,proto3" json:"Version,omitempty"`
 }
 
 func (m *DeleteDictionaryCacheKeyMessage) Reset()      { *m = DeleteDictionaryCacheKeyMessage{} }
@@ -113,10 +101,18 @@ func (m *DeleteDictionaryCacheKeyMessage) GetKey() string {
 	return ""
 }
 
+func (m *DeleteDictionaryCacheKeyMessage) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
 type DeleteDictionaryCacheKeyReply struct {
-	Key           string      `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key,omitempty"`
-	DeletedValues []*KeyValue `protobuf:"bytes,2,rep,name=DeletedValues" json:"DeletedValues,omitempty"`
-	Success       bool        `protobuf:"varint,3,opt,name=Success,proto3" json:"Success,omitempty"`
+	Key           string     `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key,omitempty"`
+	DeletedValues []KeyValue `protobuf:"bytes,2,rep,name=DeletedValues" json:"DeletedValues"`
+	Success       bool       `protobuf:"varint,3,opt,name=Success,proto3" json:"Success,omitempty"`
+	Conflict      bool       `protobuf:"varint,4,opt,name=Conflict,proto3" json:"Conflict,omitempty"`
 }
 
 func (m *DeleteDictionaryCacheKeyReply) Reset()      { *m = DeleteDictionaryCacheKeyReply{} }
@@ -132,7 +128,7 @@ func (m *DeleteDictionaryCacheKeyReply) GetKey() string {
 	return ""
 }
 
-func (m *DeleteDictionaryCacheKeyReply) GetDeletedValues() []*KeyValue {
+func (m *DeleteDictionaryCacheKeyReply) GetDeletedValues() []KeyValue {
 	if m != nil {
 		return m.DeletedValues
 	}
@@ -146,10 +142,18 @@ func (m *DeleteDictionaryCacheKeyReply) GetSuccess() bool {
 	return false
 }
 
+func (m *DeleteDictionaryCacheKeyReply) GetConflict() bool {
+	if m != nil {
+		return m.Conflict
+	}
+	return false
+}
+
 type PostDictionaryCacheKeyMessage struct {
-	Key    string                    `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key,omitempty"`
-	Values []*KeyValue               `protobuf:"bytes,2,rep,name=Values" json:"Values,omitempty"`
-	TTL    *google_protobuf.Duration `protobuf:"bytes,3,opt,name=TTL" json:"TTL,omitempty"`
+	Key     string        `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key,omitempty"`
+	Values  []KeyValue    `protobuf:"bytes,2,rep,name=Values" json:"Values"`
+	TTL     time.Duration `protobuf:"bytes,3,opt,name=TTL,stdduration" json:"TTL"`
+	Sliding bool          `protobuf:"varint,4,opt,name=Sliding,proto3" json:"Sliding,omitempty"`
 }
 
 func (m *PostDictionaryCacheKeyMessage) Reset()      { *m = PostDictionaryCacheKeyMessage{} }
@@ -165,23 +169,31 @@ func (m *PostDictionaryCacheKeyMessage) GetKey() string {
 	return ""
 }
 
-func (m *PostDictionaryCacheKeyMessage) GetValues() []*KeyValue {
+func (m *PostDictionaryCacheKeyMessage) GetValues() []KeyValue {
 	if m != nil {
 		return m.Values
 	}
 	return nil
 }
 
-func (m *PostDictionaryCacheKeyMessage) GetTTL() *google_protobuf.Duration {
+func (m *PostDictionaryCacheKeyMessage) GetTTL() time.Duration {
 	if m != nil {
 		return m.TTL
 	}
-	return nil
+	return 0
+}
+
+func (m *PostDictionaryCacheKeyMessage) GetSliding() bool {
+	if m != nil {
+		return m.Sliding
+	}
+	return false
 }
 
 type PostDictionaryCacheKeyReply struct {
 	Key     string `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key,omitempty"`
 	Success bool   `protobuf:"varint,2,opt,name=Success,proto3" json:"Success,omitempty"`
+	Version int64  `protobuf:"varint,3,opt,name=Version,proto3" json:"Version,omitempty"`
 }
 
 func (m *PostDictionaryCacheKeyReply) Reset()      { *m = PostDictionaryCacheKeyReply{} }
@@ -204,11 +216,19 @@ func (m *PostDictionaryCacheKeyReply) GetSuccess() bool {
 	return false
 }
 
+func (m *PostDictionaryCacheKeyReply) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
 type PutDictionaryCacheValueMessage struct {
 	Key           string `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key,omitempty"`
 	SubKey        string `protobuf:"bytes,2,opt,name=SubKey,proto3" json:"SubKey,omitempty"`
 	NewValue      string `protobuf:"bytes,3,opt,name=NewValue,proto3" json:"NewValue,omitempty"`
 	OriginalValue string `protobuf:"bytes,4,opt,name=OriginalValue,proto3" json:"OriginalValue,omitempty"`
+	Version       int64  `protobuf:"varint,5,opt,name=Version,proto3" json:"Version,omitempty"`
 }
 
 func (m *PutDictionaryCacheValueMessage) Reset()      { *m = PutDictionaryCacheValueMessage{} }
@@ -245,12 +265,21 @@ func (m *PutDictionaryCacheValueMessage) GetOriginalValue() string {
 	return ""
 }
 
+func (m *PutDictionaryCacheValueMessage) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
 type PutDictionaryCacheValueReply struct {
 	Key           string `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key,omitempty"`
 	SubKey        string `protobuf:"bytes,2,opt,name=SubKey,proto3" json:"SubKey,omitempty"`
 	Success       bool   `protobuf:"varint,3,opt,name=Success,proto3" json:"Success,omitempty"`
 	NewValue      string `protobuf:"bytes,4,opt,name=NewValue,proto3" json:"NewValue,omitempty"`
 	OriginalValue string `protobuf:"bytes,5,opt,name=OriginalValue,proto3" json:"OriginalValue,omitempty"`
+	Version       int64  `protobuf:"varint,6,opt,name=Version,proto3" json:"Version,omitempty"`
+	Conflict      bool   `protobuf:"varint,7,opt,name=Conflict,proto3" json:"Conflict,omitempty"`
 }
 
 func (m *PutDictionaryCacheValueReply) Reset()      { *m = PutDictionaryCacheValueReply{} }
@@ -294,9 +323,24 @@ func (m *PutDictionaryCacheValueReply) GetOriginalValue() string {
 	return ""
 }
 
+func (m *PutDictionaryCacheValueReply) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *PutDictionaryCacheValueReply) GetConflict() bool {
+	if m != nil {
+		return m.Conflict
+	}
+	return false
+}
+
 type DeleteDictionaryCacheValueMessage struct {
-	Key    string `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key,omitempty"`
-	SubKey string `protobuf:"bytes,2,opt,name=SubKey,proto3" json:"SubKey,omitempty"`
+	Key     string `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key,omitempty"`
+	SubKey  string `protobuf:"bytes,2,opt,name=SubKey,proto3" json:"SubKey,omitempty"`
+	Version int64  `protobuf:"varint,3,opt,name=Version,proto3" json:"Version,omitempty"`
 }
 
 func (m *DeleteDictionaryCacheValueMessage) Reset()      { *m = DeleteDictionaryCacheValueMessage{} }
@@ -319,11 +363,20 @@ func (m *DeleteDictionaryCacheValueMessage) GetSubKey() string {
 	return ""
 }
 
+func (m *DeleteDictionaryCacheValueMessage) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
 type DeleteDictionaryCacheValueReply struct {
-	Key          string    `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key,omitempty"`
-	SubKey       string    `protobuf:"bytes,2,opt,name=SubKey,proto3" json:"SubKey,omitempty"`
-	DeletedValue *KeyValue `protobuf:"bytes,3,opt,name=DeletedValue" json:"DeletedValue,omitempty"`
-	Success      bool      `protobuf:"varint,4,opt,name=Success,proto3" json:"Success,omitempty"`
+	Key          string   `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key,omitempty"`
+	SubKey       string   `protobuf:"bytes,2,opt,name=SubKey,proto3" json:"SubKey,omitempty"`
+	DeletedValue KeyValue `protobuf:"bytes,3,opt,name=DeletedValue" json:"DeletedValue"`
+	Success      bool     `protobuf:"varint,4,opt,name=Success,proto3" json:"Success,omitempty"`
+	Version      int64    `protobuf:"varint,5,opt,name=Version,proto3" json:"Version,omitempty"`
+	Conflict     bool     `protobuf:"varint,6,opt,name=Conflict,proto3" json:"Conflict,omitempty"`
 }
 
 func (m *DeleteDictionaryCacheValueReply) Reset()      { *m = DeleteDictionaryCacheValueReply{} }
@@ -346,11 +399,11 @@ func (m *DeleteDictionaryCacheValueReply) GetSubKey() string {
 	return ""
 }
 
-func (m *DeleteDictionaryCacheValueReply) GetDeletedValue() *KeyValue {
+func (m *DeleteDictionaryCacheValueReply) GetDeletedValue() KeyValue {
 	if m != nil {
 		return m.DeletedValue
 	}
-	return nil
+	return KeyValue{}
 }
 
 func (m *DeleteDictionaryCacheValueReply) GetSuccess() bool {
@@ -360,9 +413,25 @@ func (m *DeleteDictionaryCacheValueReply) GetSuccess() bool {
 	return false
 }
 
+func (m *DeleteDictionaryCacheValueReply) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *DeleteDictionaryCacheValueReply) GetConflict() bool {
+	if m != nil {
+		return m.Conflict
+	}
+	return false
+}
+
 type PostDictionaryCacheValueMessage struct {
-	Key      string    `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key,omitempty"`
-	NewValue *KeyValue `protobuf:"bytes,2,opt,name=NewValue" json:"NewValue,omitempty"`
+	Key      string        `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key,omitempty"`
+	NewValue KeyValue      `protobuf:"bytes,2,opt,name=NewValue" json:"NewValue"`
+	Version  int64         `protobuf:"varint,3,opt,name=Version,proto3" json:"Version,omitempty"`
+	TTL      time.Duration `protobuf:"bytes,4,opt,name=TTL,stdduration" json:"TTL"`
 }
 
 func (m *PostDictionaryCacheValueMessage) Reset()      { *m = PostDictionaryCacheValueMessage{} }
@@ -378,17 +447,33 @@ func (m *PostDictionaryCacheValueMessage) GetKey() string {
 	return ""
 }
 
-func (m *PostDictionaryCacheValueMessage) GetNewValue() *KeyValue {
+func (m *PostDictionaryCacheValueMessage) GetNewValue() KeyValue {
 	if m != nil {
 		return m.NewValue
 	}
-	return nil
+	return KeyValue{}
+}
+
+func (m *PostDictionaryCacheValueMessage) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *PostDictionaryCacheValueMessage) GetTTL() time.Duration {
+	if m != nil {
+		return m.TTL
+	}
+	return 0
 }
 
 type PostDictionaryCacheValueReply struct {
-	Key        string    `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key,omitempty"`
-	Success    bool      `protobuf:"varint,2,opt,name=Success,proto3" json:"Success,omitempty"`
-	AddedValue *KeyValue `protobuf:"bytes,3,opt,name=AddedValue" json:"AddedValue,omitempty"`
+	Key        string   `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key,omitempty"`
+	Success    bool     `protobuf:"varint,2,opt,name=Success,proto3" json:"Success,omitempty"`
+	AddedValue KeyValue `protobuf:"bytes,3,opt,name=AddedValue" json:"AddedValue"`
+	Version    int64    `protobuf:"varint,4,opt,name=Version,proto3" json:"Version,omitempty"`
+	Conflict   bool     `protobuf:"varint,5,opt,name=Conflict,proto3" json:"Conflict,omitempty"`
 }
 
 func (m *PostDictionaryCacheValueReply) Reset()      { *m = PostDictionaryCacheValueReply{} }
@@ -411,335 +496,609 @@ func (m *PostDictionaryCacheValueReply) GetSuccess() bool {
 	return false
 }
 
-func (m *PostDictionaryCacheValueReply) GetAddedValue() *KeyValue {
+func (m *PostDictionaryCacheValueReply) GetAddedValue() KeyValue {
 	if m != nil {
 		return m.AddedValue
 	}
-	return nil
+	return KeyValue{}
 }
 
-func init() {
-	proto.RegisterType((*GetDictionaryCacheKeyMessage)(nil), "messages.GetDictionaryCacheKeyMessage")
-	proto.RegisterType((*GetDictionaryCacheKeyReply)(nil), "messages.GetDictionaryCacheKeyReply")
-	proto.RegisterType((*DeleteDictionaryCacheKeyMessage)(nil), "messages.DeleteDictionaryCacheKeyMessage")
-	proto.RegisterType((*DeleteDictionaryCacheKeyReply)(nil), "messages.DeleteDictionaryCacheKeyReply")
-	proto.RegisterType((*PostDictionaryCacheKeyMessage)(nil), "messages.PostDictionaryCacheKeyMessage")
-	proto.RegisterType((*PostDictionaryCacheKeyReply)(nil), "messages.PostDictionaryCacheKeyReply")
-	proto.RegisterType((*PutDictionaryCacheValueMessage)(nil), "messages.PutDictionaryCacheValueMessage")
-	proto.RegisterType((*PutDictionaryCacheValueReply)(nil), "messages.PutDictionaryCacheValueReply")
-	proto.RegisterType((*DeleteDictionaryCacheValueMessage)(nil), "messages.DeleteDictionaryCacheValueMessage")
-	proto.RegisterType((*DeleteDictionaryCacheValueReply)(nil), "messages.DeleteDictionaryCacheValueReply")
-	proto.RegisterType((*PostDictionaryCacheValueMessage)(nil), "messages.PostDictionaryCacheValueMessage")
-	proto.RegisterType((*PostDictionaryCacheValueReply)(nil), "messages.PostDictionaryCacheValueReply")
+func (m *PostDictionaryCacheValueReply) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
 }
-func (this *GetDictionaryCacheKeyMessage) Equal(that interface{}) bool {
-	if that == nil {
-		if this == nil {
-			return true
-		}
-		return false
+
+func (m *PostDictionaryCacheValueReply) GetConflict() bool {
+	if m != nil {
+		return m.Conflict
 	}
+	return false
+}
 
-	that1, ok := that.(*GetDictionaryCacheKeyMessage)
-	if !ok {
-		that2, ok := that.(GetDictionaryCacheKeyMessage)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+type IncrementDictionaryCacheValueMessage struct {
+	Key        string        `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key,omitempty"`
+	SubKey     string        `protobuf:"bytes,2,opt,name=SubKey,proto3" json:"SubKey,omitempty"`
+	Delta      int64         `protobuf:"varint,3,opt,name=Delta,proto3" json:"Delta,omitempty"`
+	FloatDelta float64       `protobuf:"fixed64,4,opt,name=FloatDelta,proto3" json:"FloatDelta,omitempty"`
+	Float      bool          `protobuf:"varint,5,opt,name=Float,proto3" json:"Float,omitempty"`
+	Create     bool          `protobuf:"varint,6,opt,name=Create,proto3" json:"Create,omitempty"`
+	TTL        time.Duration `protobuf:"bytes,7,opt,name=TTL,stdduration" json:"TTL"`
+	Sliding    bool          `protobuf:"varint,8,opt,name=Sliding,proto3" json:"Sliding,omitempty"`
+	Version    int64         `protobuf:"varint,9,opt,name=Version,proto3" json:"Version,omitempty"`
+}
+
+func (m *IncrementDictionaryCacheValueMessage) Reset()      { *m = IncrementDictionaryCacheValueMessage{} }
+func (*IncrementDictionaryCacheValueMessage) ProtoMessage() {}
+func (*IncrementDictionaryCacheValueMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptorDictionary, []int{12}
+}
+
+func (m *IncrementDictionaryCacheValueMessage) GetKey() string {
+	if m != nil {
+		return m.Key
 	}
-	if that1 == nil {
-		if this == nil {
-			return true
-		}
-		return false
-	} else if this == nil {
-		return false
+	return ""
+}
+
+func (m *IncrementDictionaryCacheValueMessage) GetSubKey() string {
+	if m != nil {
+		return m.SubKey
 	}
-	if this.Key != that1.Key {
-		return false
+	return ""
+}
+
+func (m *IncrementDictionaryCacheValueMessage) GetDelta() int64 {
+	if m != nil {
+		return m.Delta
 	}
-	return true
+	return 0
 }
-func (this *GetDictionaryCacheKeyReply) Equal(that interface{}) bool {
-	if that == nil {
-		if this == nil {
-			return true
-		}
-		return false
+
+func (m *IncrementDictionaryCacheValueMessage) GetFloatDelta() float64 {
+	if m != nil {
+		return m.FloatDelta
 	}
+	return 0
+}
 
-	that1, ok := that.(*GetDictionaryCacheKeyReply)
-	if !ok {
-		that2, ok := that.(GetDictionaryCacheKeyReply)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *IncrementDictionaryCacheValueMessage) GetFloat() bool {
+	if m != nil {
+		return m.Float
 	}
-	if that1 == nil {
-		if this == nil {
-			return true
-		}
-		return false
-	} else if this == nil {
-		return false
+	return false
+}
+
+func (m *IncrementDictionaryCacheValueMessage) GetCreate() bool {
+	if m != nil {
+		return m.Create
 	}
-	if this.Key != that1.Key {
-		return false
+	return false
+}
+
+func (m *IncrementDictionaryCacheValueMessage) GetTTL() time.Duration {
+	if m != nil {
+		return m.TTL
 	}
-	if len(this.Values) != len(that1.Values) {
-		return false
+	return 0
+}
+
+func (m *IncrementDictionaryCacheValueMessage) GetSliding() bool {
+	if m != nil {
+		return m.Sliding
 	}
-	for i := range this.Values {
-		if !this.Values[i].Equal(that1.Values[i]) {
-			return false
-		}
+	return false
+}
+
+func (m *IncrementDictionaryCacheValueMessage) GetVersion() int64 {
+	if m != nil {
+		return m.Version
 	}
-	if this.Success != that1.Success {
-		return false
+	return 0
+}
+
+type IncrementDictionaryCacheValueReply struct {
+	Key      string `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key,omitempty"`
+	SubKey   string `protobuf:"bytes,2,opt,name=SubKey,proto3" json:"SubKey,omitempty"`
+	Value    string `protobuf:"bytes,3,opt,name=Value,proto3" json:"Value,omitempty"`
+	Success  bool   `protobuf:"varint,4,opt,name=Success,proto3" json:"Success,omitempty"`
+	Created  bool   `protobuf:"varint,5,opt,name=Created,proto3" json:"Created,omitempty"`
+	Version  int64  `protobuf:"varint,6,opt,name=Version,proto3" json:"Version,omitempty"`
+	Conflict bool   `protobuf:"varint,7,opt,name=Conflict,proto3" json:"Conflict,omitempty"`
+	Error    string `protobuf:"bytes,8,opt,name=Error,proto3" json:"Error,omitempty"`
+}
+
+func (m *IncrementDictionaryCacheValueReply) Reset()      { *m = IncrementDictionaryCacheValueReply{} }
+func (*IncrementDictionaryCacheValueReply) ProtoMessage() {}
+func (*IncrementDictionaryCacheValueReply) Descriptor() ([]byte, []int) {
+	return fileDescriptorDictionary, []int{13}
+}
+
+func (m *IncrementDictionaryCacheValueReply) GetKey() string {
+	if m != nil {
+		return m.Key
 	}
-	return true
+	return ""
 }
-func (this *DeleteDictionaryCacheKeyMessage) Equal(that interface{}) bool {
-	if that == nil {
-		if this == nil {
-			return true
-		}
-		return false
+
+func (m *IncrementDictionaryCacheValueReply) GetSubKey() string {
+	if m != nil {
+		return m.SubKey
 	}
+	return ""
+}
 
-	that1, ok := that.(*DeleteDictionaryCacheKeyMessage)
-	if !ok {
-		that2, ok := that.(DeleteDictionaryCacheKeyMessage)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *IncrementDictionaryCacheValueReply) GetValue() string {
+	if m != nil {
+		return m.Value
 	}
-	if that1 == nil {
-		if this == nil {
-			return true
-		}
-		return false
-	} else if this == nil {
-		return false
+	return ""
+}
+
+func (m *IncrementDictionaryCacheValueReply) GetSuccess() bool {
+	if m != nil {
+		return m.Success
 	}
-	if this.Key != that1.Key {
-		return false
+	return false
+}
+
+func (m *IncrementDictionaryCacheValueReply) GetCreated() bool {
+	if m != nil {
+		return m.Created
 	}
-	return true
+	return false
 }
-func (this *DeleteDictionaryCacheKeyReply) Equal(that interface{}) bool {
-	if that == nil {
-		if this == nil {
-			return true
-		}
-		return false
+
+func (m *IncrementDictionaryCacheValueReply) GetVersion() int64 {
+	if m != nil {
+		return m.Version
 	}
+	return 0
+}
 
-	that1, ok := that.(*DeleteDictionaryCacheKeyReply)
-	if !ok {
-		that2, ok := that.(DeleteDictionaryCacheKeyReply)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *IncrementDictionaryCacheValueReply) GetConflict() bool {
+	if m != nil {
+		return m.Conflict
 	}
-	if that1 == nil {
-		if this == nil {
-			return true
-		}
-		return false
-	} else if this == nil {
-		return false
+	return false
+}
+
+func (m *IncrementDictionaryCacheValueReply) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	if this.Key != that1.Key {
-		return false
+	return ""
+}
+
+type GetDictionaryCacheValuesMessage struct {
+	Key     string   `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key,omitempty"`
+	SubKeys []string `protobuf:"bytes,2,rep,name=SubKeys" json:"SubKeys,omitempty"`
+}
+
+func (m *GetDictionaryCacheValuesMessage) Reset()      { *m = GetDictionaryCacheValuesMessage{} }
+func (*GetDictionaryCacheValuesMessage) ProtoMessage() {}
+func (*GetDictionaryCacheValuesMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptorDictionary, []int{14}
+}
+
+func (m *GetDictionaryCacheValuesMessage) GetKey() string {
+	if m != nil {
+		return m.Key
 	}
-	if len(this.DeletedValues) != len(that1.DeletedValues) {
-		return false
+	return ""
+}
+
+func (m *GetDictionaryCacheValuesMessage) GetSubKeys() []string {
+	if m != nil {
+		return m.SubKeys
 	}
-	for i := range this.DeletedValues {
-		if !this.DeletedValues[i].Equal(that1.DeletedValues[i]) {
-			return false
-		}
-	}
-	if this.Success != that1.Success {
-		return false
-	}
-	return true
+	return nil
 }
-func (this *PostDictionaryCacheKeyMessage) Equal(that interface{}) bool {
-	if that == nil {
-		if this == nil {
-			return true
-		}
-		return false
+
+type GetDictionaryCacheValuesReply struct {
+	Key     string     `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key,omitempty"`
+	Values  []KeyValue `protobuf:"bytes,2,rep,name=Values" json:"Values"`
+	Success bool       `protobuf:"varint,3,opt,name=Success,proto3" json:"Success,omitempty"`
+	Version int64      `protobuf:"varint,4,opt,name=Version,proto3" json:"Version,omitempty"`
+}
+
+func (m *GetDictionaryCacheValuesReply) Reset()      { *m = GetDictionaryCacheValuesReply{} }
+func (*GetDictionaryCacheValuesReply) ProtoMessage() {}
+func (*GetDictionaryCacheValuesReply) Descriptor() ([]byte, []int) {
+	return fileDescriptorDictionary, []int{15}
+}
+
+func (m *GetDictionaryCacheValuesReply) GetKey() string {
+	if m != nil {
+		return m.Key
 	}
+	return ""
+}
 
-	that1, ok := that.(*PostDictionaryCacheKeyMessage)
-	if !ok {
-		that2, ok := that.(PostDictionaryCacheKeyMessage)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *GetDictionaryCacheValuesReply) GetValues() []KeyValue {
+	if m != nil {
+		return m.Values
 	}
-	if that1 == nil {
-		if this == nil {
-			return true
-		}
-		return false
-	} else if this == nil {
-		return false
+	return nil
+}
+
+func (m *GetDictionaryCacheValuesReply) GetSuccess() bool {
+	if m != nil {
+		return m.Success
 	}
-	if this.Key != that1.Key {
-		return false
+	return false
+}
+
+func (m *GetDictionaryCacheValuesReply) GetVersion() int64 {
+	if m != nil {
+		return m.Version
 	}
-	if len(this.Values) != len(that1.Values) {
-		return false
+	return 0
+}
+
+type SetDictionaryCacheValuesMessage struct {
+	Key     string        `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key,omitempty"`
+	Values  []KeyValue    `protobuf:"bytes,2,rep,name=Values" json:"Values"`
+	Create  bool          `protobuf:"varint,3,opt,name=Create,proto3" json:"Create,omitempty"`
+	TTL     time.Duration `protobuf:"bytes,4,opt,name=TTL,stdduration" json:"TTL"`
+	Sliding bool          `protobuf:"varint,5,opt,name=Sliding,proto3" json:"Sliding,omitempty"`
+	Version int64         `protobuf:"varint,6,opt,name=Version,proto3" json:"Version,omitempty"`
+}
+
+func (m *SetDictionaryCacheValuesMessage) Reset()      { *m = SetDictionaryCacheValuesMessage{} }
+func (*SetDictionaryCacheValuesMessage) ProtoMessage() {}
+func (*SetDictionaryCacheValuesMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptorDictionary, []int{16}
+}
+
+func (m *SetDictionaryCacheValuesMessage) GetKey() string {
+	if m != nil {
+		return m.Key
 	}
-	for i := range this.Values {
-		if !this.Values[i].Equal(that1.Values[i]) {
-			return false
-		}
+	return ""
+}
+
+func (m *SetDictionaryCacheValuesMessage) GetValues() []KeyValue {
+	if m != nil {
+		return m.Values
 	}
-	if !this.TTL.Equal(that1.TTL) {
-		return false
+	return nil
+}
+
+func (m *SetDictionaryCacheValuesMessage) GetCreate() bool {
+	if m != nil {
+		return m.Create
 	}
-	return true
+	return false
 }
-func (this *PostDictionaryCacheKeyReply) Equal(that interface{}) bool {
-	if that == nil {
-		if this == nil {
-			return true
-		}
-		return false
+
+func (m *SetDictionaryCacheValuesMessage) GetTTL() time.Duration {
+	if m != nil {
+		return m.TTL
 	}
+	return 0
+}
 
-	that1, ok := that.(*PostDictionaryCacheKeyReply)
-	if !ok {
-		that2, ok := that.(PostDictionaryCacheKeyReply)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *SetDictionaryCacheValuesMessage) GetSliding() bool {
+	if m != nil {
+		return m.Sliding
 	}
-	if that1 == nil {
-		if this == nil {
-			return true
-		}
-		return false
-	} else if this == nil {
-		return false
+	return false
+}
+
+func (m *SetDictionaryCacheValuesMessage) GetVersion() int64 {
+	if m != nil {
+		return m.Version
 	}
-	if this.Key != that1.Key {
-		return false
+	return 0
+}
+
+type SetDictionaryCacheValuesReply struct {
+	Key      string `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key,omitempty"`
+	Added    int32  `protobuf:"varint,2,opt,name=Added,proto3" json:"Added,omitempty"`
+	Updated  int32  `protobuf:"varint,3,opt,name=Updated,proto3" json:"Updated,omitempty"`
+	Success  bool   `protobuf:"varint,4,opt,name=Success,proto3" json:"Success,omitempty"`
+	Created  bool   `protobuf:"varint,5,opt,name=Created,proto3" json:"Created,omitempty"`
+	Version  int64  `protobuf:"varint,6,opt,name=Version,proto3" json:"Version,omitempty"`
+	Conflict bool   `protobuf:"varint,7,opt,name=Conflict,proto3" json:"Conflict,omitempty"`
+}
+
+func (m *SetDictionaryCacheValuesReply) Reset()      { *m = SetDictionaryCacheValuesReply{} }
+func (*SetDictionaryCacheValuesReply) ProtoMessage() {}
+func (*SetDictionaryCacheValuesReply) Descriptor() ([]byte, []int) {
+	return fileDescriptorDictionary, []int{17}
+}
+
+func (m *SetDictionaryCacheValuesReply) GetKey() string {
+	if m != nil {
+		return m.Key
 	}
-	if this.Success != that1.Success {
-		return false
+	return ""
+}
+
+func (m *SetDictionaryCacheValuesReply) GetAdded() int32 {
+	if m != nil {
+		return m.Added
 	}
-	return true
+	return 0
 }
-func (this *PutDictionaryCacheValueMessage) Equal(that interface{}) bool {
-	if that == nil {
-		if this == nil {
-			return true
-		}
-		return false
+
+func (m *SetDictionaryCacheValuesReply) GetUpdated() int32 {
+	if m != nil {
+		return m.Updated
 	}
+	return 0
+}
 
-	that1, ok := that.(*PutDictionaryCacheValueMessage)
-	if !ok {
-		that2, ok := that.(PutDictionaryCacheValueMessage)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *SetDictionaryCacheValuesReply) GetSuccess() bool {
+	if m != nil {
+		return m.Success
 	}
-	if that1 == nil {
-		if this == nil {
-			return true
-		}
-		return false
-	} else if this == nil {
-		return false
+	return false
+}
+
+func (m *SetDictionaryCacheValuesReply) GetCreated() bool {
+	if m != nil {
+		return m.Created
 	}
-	if this.Key != that1.Key {
-		return false
+	return false
+}
+
+func (m *SetDictionaryCacheValuesReply) GetVersion() int64 {
+	if m != nil {
+		return m.Version
 	}
-	if this.SubKey != that1.SubKey {
-		return false
+	return 0
+}
+
+func (m *SetDictionaryCacheValuesReply) GetConflict() bool {
+	if m != nil {
+		return m.Conflict
 	}
-	if this.NewValue != that1.NewValue {
-		return false
+	return false
+}
+
+type DeleteDictionaryCacheValuesMessage struct {
+	Key     string   `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key,omitempty"`
+	SubKeys []string `protobuf:"bytes,2,rep,name=SubKeys" json:"SubKeys,omitempty"`
+	Version int64    `protobuf:"varint,3,opt,name=Version,proto3" json:"Version,omitempty"`
+}
+
+func (m *DeleteDictionaryCacheValuesMessage) Reset()      { *m = DeleteDictionaryCacheValuesMessage{} }
+func (*DeleteDictionaryCacheValuesMessage) ProtoMessage() {}
+func (*DeleteDictionaryCacheValuesMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptorDictionary, []int{18}
+}
+
+func (m *DeleteDictionaryCacheValuesMessage) GetKey() string {
+	if m != nil {
+		return m.Key
 	}
-	if this.OriginalValue != that1.OriginalValue {
-		return false
+	return ""
+}
+
+func (m *DeleteDictionaryCacheValuesMessage) GetSubKeys() []string {
+	if m != nil {
+		return m.SubKeys
 	}
-	return true
+	return nil
 }
-func (this *PutDictionaryCacheValueReply) Equal(that interface{}) bool {
-	if that == nil {
-		if this == nil {
-			return true
-		}
-		return false
+
+func (m *DeleteDictionaryCacheValuesMessage) GetVersion() int64 {
+	if m != nil {
+		return m.Version
 	}
+	return 0
+}
 
-	that1, ok := that.(*PutDictionaryCacheValueReply)
-	if !ok {
-		that2, ok := that.(PutDictionaryCacheValueReply)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+type DeleteDictionaryCacheValuesReply struct {
+	Key           string     `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key,omitempty"`
+	DeletedValues []KeyValue `protobuf:"bytes,2,rep,name=DeletedValues" json:"DeletedValues"`
+	Success       bool       `protobuf:"varint,3,opt,name=Success,proto3" json:"Success,omitempty"`
+	Version       int64      `protobuf:"varint,4,opt,name=Version,proto3" json:"Version,omitempty"`
+	Conflict      bool       `protobuf:"varint,5,opt,name=Conflict,proto3" json:"Conflict,omitempty"`
+}
+
+func (m *DeleteDictionaryCacheValuesReply) Reset()      { *m = DeleteDictionaryCacheValuesReply{} }
+func (*DeleteDictionaryCacheValuesReply) ProtoMessage() {}
+func (*DeleteDictionaryCacheValuesReply) Descriptor() ([]byte, []int) {
+	return fileDescriptorDictionary, []int{19}
+}
+
+func (m *DeleteDictionaryCacheValuesReply) GetKey() string {
+	if m != nil {
+		return m.Key
 	}
-	if that1 == nil {
-		if this == nil {
-			return true
-		}
-		return false
-	} else if this == nil {
-		return false
+	return ""
+}
+
+func (m *DeleteDictionaryCacheValuesReply) GetDeletedValues() []KeyValue {
+	if m != nil {
+		return m.DeletedValues
 	}
-	if this.Key != that1.Key {
-		return false
+	return nil
+}
+
+func (m *DeleteDictionaryCacheValuesReply) GetSuccess() bool {
+	if m != nil {
+		return m.Success
 	}
-	if this.SubKey != that1.SubKey {
-		return false
+	return false
+}
+
+func (m *DeleteDictionaryCacheValuesReply) GetVersion() int64 {
+	if m != nil {
+		return m.Version
 	}
-	if this.Success != that1.Success {
-		return false
+	return 0
+}
+
+func (m *DeleteDictionaryCacheValuesReply) GetConflict() bool {
+	if m != nil {
+		return m.Conflict
 	}
-	if this.NewValue != that1.NewValue {
-		return false
+	return false
+}
+
+type GetDictionaryCacheSubKeysMessage struct {
+	Key string `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key,omitempty"`
+}
+
+func (m *GetDictionaryCacheSubKeysMessage) Reset()      { *m = GetDictionaryCacheSubKeysMessage{} }
+func (*GetDictionaryCacheSubKeysMessage) ProtoMessage() {}
+func (*GetDictionaryCacheSubKeysMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptorDictionary, []int{20}
+}
+
+func (m *GetDictionaryCacheSubKeysMessage) GetKey() string {
+	if m != nil {
+		return m.Key
 	}
-	if this.OriginalValue != that1.OriginalValue {
-		return false
+	return ""
+}
+
+type GetDictionaryCacheSubKeysReply struct {
+	Key     string   `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key,omitempty"`
+	SubKeys []string `protobuf:"bytes,2,rep,name=SubKeys" json:"SubKeys,omitempty"`
+	Success bool     `protobuf:"varint,3,opt,name=Success,proto3" json:"Success,omitempty"`
+	Version int64    `protobuf:"varint,4,opt,name=Version,proto3" json:"Version,omitempty"`
+}
+
+func (m *GetDictionaryCacheSubKeysReply) Reset()      { *m = GetDictionaryCacheSubKeysReply{} }
+func (*GetDictionaryCacheSubKeysReply) ProtoMessage() {}
+func (*GetDictionaryCacheSubKeysReply) Descriptor() ([]byte, []int) {
+	return fileDescriptorDictionary, []int{21}
+}
+
+func (m *GetDictionaryCacheSubKeysReply) GetKey() string {
+	if m != nil {
+		return m.Key
 	}
-	return true
+	return ""
 }
-func (this *DeleteDictionaryCacheValueMessage) Equal(that interface{}) bool {
-	if that == nil {
-		if this == nil {
-			return true
-		}
-		return false
+
+func (m *GetDictionaryCacheSubKeysReply) GetSubKeys() []string {
+	if m != nil {
+		return m.SubKeys
 	}
+	return nil
+}
 
-	that1, ok := that.(*DeleteDictionaryCacheValueMessage)
-	if !ok {
-		that2, ok := that.(DeleteDictionaryCacheValueMessage)
+func (m *GetDictionaryCacheSubKeysReply) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *GetDictionaryCacheSubKeysReply) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+type ContainsDictionaryCacheValuesMessage struct {
+	Key     string   `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key,omitempty"`
+	SubKeys []string `protobuf:"bytes,2,rep,name=SubKeys" json:"SubKeys,omitempty"`
+}
+
+func (m *ContainsDictionaryCacheValuesMessage) Reset()      { *m = ContainsDictionaryCacheValuesMessage{} }
+func (*ContainsDictionaryCacheValuesMessage) ProtoMessage() {}
+func (*ContainsDictionaryCacheValuesMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptorDictionary, []int{22}
+}
+
+func (m *ContainsDictionaryCacheValuesMessage) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *ContainsDictionaryCacheValuesMessage) GetSubKeys() []string {
+	if m != nil {
+		return m.SubKeys
+	}
+	return nil
+}
+
+type ContainsDictionaryCacheValuesReply struct {
+	Key     string   `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key,omitempty"`
+	SubKeys []string `protobuf:"bytes,2,rep,name=SubKeys" json:"SubKeys,omitempty"`
+	Exists  []bool   `protobuf:"varint,3,rep,packed,name=Exists" json:"Exists,omitempty"`
+	Success bool     `protobuf:"varint,4,opt,name=Success,proto3" json:"Success,omitempty"`
+}
+
+func (m *ContainsDictionaryCacheValuesReply) Reset()      { *m = ContainsDictionaryCacheValuesReply{} }
+func (*ContainsDictionaryCacheValuesReply) ProtoMessage() {}
+func (*ContainsDictionaryCacheValuesReply) Descriptor() ([]byte, []int) {
+	return fileDescriptorDictionary, []int{23}
+}
+
+func (m *ContainsDictionaryCacheValuesReply) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *ContainsDictionaryCacheValuesReply) GetSubKeys() []string {
+	if m != nil {
+		return m.SubKeys
+	}
+	return nil
+}
+
+func (m *ContainsDictionaryCacheValuesReply) GetExists() []bool {
+	if m != nil {
+		return m.Exists
+	}
+	return nil
+}
+
+func (m *ContainsDictionaryCacheValuesReply) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*GetDictionaryCacheKeyMessage)(nil), "messages.GetDictionaryCacheKeyMessage")
+	proto.RegisterType((*GetDictionaryCacheKeyReply)(nil), "messages.GetDictionaryCacheKeyReply")
+	proto.RegisterType((*DeleteDictionaryCacheKeyMessage)(nil), "messages.DeleteDictionaryCacheKeyMessage")
+	proto.RegisterType((*DeleteDictionaryCacheKeyReply)(nil), "messages.DeleteDictionaryCacheKeyReply")
+	proto.RegisterType((*PostDictionaryCacheKeyMessage)(nil), "messages.PostDictionaryCacheKeyMessage")
+	proto.RegisterType((*PostDictionaryCacheKeyReply)(nil), "messages.PostDictionaryCacheKeyReply")
+	proto.RegisterType((*PutDictionaryCacheValueMessage)(nil), "messages.PutDictionaryCacheValueMessage")
+	proto.RegisterType((*PutDictionaryCacheValueReply)(nil), "messages.PutDictionaryCacheValueReply")
+	proto.RegisterType((*DeleteDictionaryCacheValueMessage)(nil), "messages.DeleteDictionaryCacheValueMessage")
+	proto.RegisterType((*DeleteDictionaryCacheValueReply)(nil), "messages.DeleteDictionaryCacheValueReply")
+	proto.RegisterType((*PostDictionaryCacheValueMessage)(nil), "messages.PostDictionaryCacheValueMessage")
+	proto.RegisterType((*PostDictionaryCacheValueReply)(nil), "messages.PostDictionaryCacheValueReply")
+	proto.RegisterType((*IncrementDictionaryCacheValueMessage)(nil), "messages.IncrementDictionaryCacheValueMessage")
+	proto.RegisterType((*IncrementDictionaryCacheValueReply)(nil), "messages.IncrementDictionaryCacheValueReply")
+	proto.RegisterType((*GetDictionaryCacheValuesMessage)(nil), "messages.GetDictionaryCacheValuesMessage")
+	proto.RegisterType((*GetDictionaryCacheValuesReply)(nil), "messages.GetDictionaryCacheValuesReply")
+	proto.RegisterType((*SetDictionaryCacheValuesMessage)(nil), "messages.SetDictionaryCacheValuesMessage")
+	proto.RegisterType((*SetDictionaryCacheValuesReply)(nil), "messages.SetDictionaryCacheValuesReply")
+	proto.RegisterType((*DeleteDictionaryCacheValuesMessage)(nil), "messages.DeleteDictionaryCacheValuesMessage")
+	proto.RegisterType((*DeleteDictionaryCacheValuesReply)(nil), "messages.DeleteDictionaryCacheValuesReply")
+	proto.RegisterType((*GetDictionaryCacheSubKeysMessage)(nil), "messages.GetDictionaryCacheSubKeysMessage")
+	proto.RegisterType((*GetDictionaryCacheSubKeysReply)(nil), "messages.GetDictionaryCacheSubKeysReply")
+	proto.RegisterType((*ContainsDictionaryCacheValuesMessage)(nil), "messages.ContainsDictionaryCacheValuesMessage")
+	proto.RegisterType((*ContainsDictionaryCacheValuesReply)(nil), "messages.ContainsDictionaryCacheValuesReply")
+}
+func (this *GetDictionaryCacheKeyMessage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GetDictionaryCacheKeyMessage)
+	if !ok {
+		that2, ok := that.(GetDictionaryCacheKeyMessage)
 		if ok {
 			that1 = &that2
 		} else {
@@ -747,32 +1106,23 @@ func (this *DeleteDictionaryCacheValueMessage) Equal(that interface{}) bool {
 		}
 	}
 	if that1 == nil {
-		if this == nil {
-			return true
-		}
-		return false
+		return this == nil
 	} else if this == nil {
 		return false
 	}
 	if this.Key != that1.Key {
 		return false
 	}
-	if this.SubKey != that1.SubKey {
-		return false
-	}
 	return true
 }
-func (this *DeleteDictionaryCacheValueReply) Equal(that interface{}) bool {
+func (this *GetDictionaryCacheKeyReply) Equal(that interface{}) bool {
 	if that == nil {
-		if this == nil {
-			return true
-		}
-		return false
+		return this == nil
 	}
 
-	that1, ok := that.(*DeleteDictionaryCacheValueReply)
+	that1, ok := that.(*GetDictionaryCacheKeyReply)
 	if !ok {
-		that2, ok := that.(DeleteDictionaryCacheValueReply)
+		that2, ok := that.(GetDictionaryCacheKeyReply)
 		if ok {
 			that1 = &that2
 		} else {
@@ -780,38 +1130,37 @@ func (this *DeleteDictionaryCacheValueReply) Equal(that interface{}) bool {
 		}
 	}
 	if that1 == nil {
-		if this == nil {
-			return true
-		}
-		return false
+		return this == nil
 	} else if this == nil {
 		return false
 	}
 	if this.Key != that1.Key {
 		return false
 	}
-	if this.SubKey != that1.SubKey {
+	if len(this.Values) != len(that1.Values) {
 		return false
 	}
-	if !this.DeletedValue.Equal(that1.DeletedValue) {
-		return false
+	for i := range this.Values {
+		if !this.Values[i].Equal(&that1.Values[i]) {
+			return false
+		}
 	}
 	if this.Success != that1.Success {
 		return false
 	}
+	if this.Version != that1.Version {
+		return false
+	}
 	return true
 }
-func (this *PostDictionaryCacheValueMessage) Equal(that interface{}) bool {
+func (this *DeleteDictionaryCacheKeyMessage) Equal(that interface{}) bool {
 	if that == nil {
-		if this == nil {
-			return true
-		}
-		return false
+		return this == nil
 	}
 
-	that1, ok := that.(*PostDictionaryCacheValueMessage)
+	that1, ok := that.(*DeleteDictionaryCacheKeyMessage)
 	if !ok {
-		that2, ok := that.(PostDictionaryCacheValueMessage)
+		that2, ok := that.(DeleteDictionaryCacheKeyMessage)
 		if ok {
 			that1 = &that2
 		} else {
@@ -819,32 +1168,26 @@ func (this *PostDictionaryCacheValueMessage) Equal(that interface{}) bool {
 		}
 	}
 	if that1 == nil {
-		if this == nil {
-			return true
-		}
-		return false
+		return this == nil
 	} else if this == nil {
 		return false
 	}
 	if this.Key != that1.Key {
 		return false
 	}
-	if !this.NewValue.Equal(that1.NewValue) {
+	if this.Version != that1.Version {
 		return false
 	}
 	return true
 }
-func (this *PostDictionaryCacheValueReply) Equal(that interface{}) bool {
+func (this *DeleteDictionaryCacheKeyReply) Equal(that interface{}) bool {
 	if that == nil {
-		if this == nil {
-			return true
-		}
-		return false
+		return this == nil
 	}
 
-	that1, ok := that.(*PostDictionaryCacheValueReply)
+	that1, ok := that.(*DeleteDictionaryCacheKeyReply)
 	if !ok {
-		that2, ok := that.(PostDictionaryCacheValueReply)
+		that2, ok := that.(DeleteDictionaryCacheKeyReply)
 		if ok {
 			that1 = &that2
 		} else {
@@ -852,1053 +1195,5057 @@ func (this *PostDictionaryCacheValueReply) Equal(that interface{}) bool {
 		}
 	}
 	if that1 == nil {
-		if this == nil {
-			return true
-		}
-		return false
+		return this == nil
 	} else if this == nil {
 		return false
 	}
 	if this.Key != that1.Key {
 		return false
 	}
+	if len(this.DeletedValues) != len(that1.DeletedValues) {
+		return false
+	}
+	for i := range this.DeletedValues {
+		if !this.DeletedValues[i].Equal(&that1.DeletedValues[i]) {
+			return false
+		}
+	}
 	if this.Success != that1.Success {
 		return false
 	}
-	if !this.AddedValue.Equal(that1.AddedValue) {
+	if this.Conflict != that1.Conflict {
 		return false
 	}
 	return true
 }
-func (this *GetDictionaryCacheKeyMessage) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *PostDictionaryCacheKeyMessage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&messages.GetDictionaryCacheKeyMessage{")
-	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *GetDictionaryCacheKeyReply) GoString() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*PostDictionaryCacheKeyMessage)
+	if !ok {
+		that2, ok := that.(PostDictionaryCacheKeyMessage)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&messages.GetDictionaryCacheKeyReply{")
-	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
-	if this.Values != nil {
-		s = append(s, "Values: "+fmt.Sprintf("%#v", this.Values)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "Success: "+fmt.Sprintf("%#v", this.Success)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *DeleteDictionaryCacheKeyMessage) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.Key != that1.Key {
+		return false
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&messages.DeleteDictionaryCacheKeyMessage{")
-	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *DeleteDictionaryCacheKeyReply) GoString() string {
-	if this == nil {
-		return "nil"
+	if len(this.Values) != len(that1.Values) {
+		return false
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&messages.DeleteDictionaryCacheKeyReply{")
-	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
-	if this.DeletedValues != nil {
-		s = append(s, "DeletedValues: "+fmt.Sprintf("%#v", this.DeletedValues)+",\n")
+	for i := range this.Values {
+		if !this.Values[i].Equal(&that1.Values[i]) {
+			return false
+		}
 	}
-	s = append(s, "Success: "+fmt.Sprintf("%#v", this.Success)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	if this.TTL != that1.TTL {
+		return false
+	}
+	if this.Sliding != that1.Sliding {
+		return false
+	}
+	return true
 }
-func (this *PostDictionaryCacheKeyMessage) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *PostDictionaryCacheKeyReply) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&messages.PostDictionaryCacheKeyMessage{")
-	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
-	if this.Values != nil {
-		s = append(s, "Values: "+fmt.Sprintf("%#v", this.Values)+",\n")
+
+	that1, ok := that.(*PostDictionaryCacheKeyReply)
+	if !ok {
+		that2, ok := that.(PostDictionaryCacheKeyReply)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if this.TTL != nil {
-		s = append(s, "TTL: "+fmt.Sprintf("%#v", this.TTL)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *PostDictionaryCacheKeyReply) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.Key != that1.Key {
+		return false
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&messages.PostDictionaryCacheKeyReply{")
-	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
-	s = append(s, "Success: "+fmt.Sprintf("%#v", this.Success)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *PutDictionaryCacheValueMessage) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.Success != that1.Success {
+		return false
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&messages.PutDictionaryCacheValueMessage{")
-	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
-	s = append(s, "SubKey: "+fmt.Sprintf("%#v", this.SubKey)+",\n")
-	s = append(s, "NewValue: "+fmt.Sprintf("%#v", this.NewValue)+",\n")
-	s = append(s, "OriginalValue: "+fmt.Sprintf("%#v", this.OriginalValue)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *PutDictionaryCacheValueReply) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.Version != that1.Version {
+		return false
 	}
-	s := make([]string, 0, 9)
-	s = append(s, "&messages.PutDictionaryCacheValueReply{")
-	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
-	s = append(s, "SubKey: "+fmt.Sprintf("%#v", this.SubKey)+",\n")
-	s = append(s, "Success: "+fmt.Sprintf("%#v", this.Success)+",\n")
-	s = append(s, "NewValue: "+fmt.Sprintf("%#v", this.NewValue)+",\n")
-	s = append(s, "OriginalValue: "+fmt.Sprintf("%#v", this.OriginalValue)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return true
 }
-func (this *DeleteDictionaryCacheValueMessage) GoString() string {
-	if this == nil {
-		return "nil"
+func (this *PutDictionaryCacheValueMessage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&messages.DeleteDictionaryCacheValueMessage{")
-	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
-	s = append(s, "SubKey: "+fmt.Sprintf("%#v", this.SubKey)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *DeleteDictionaryCacheValueReply) GoString() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*PutDictionaryCacheValueMessage)
+	if !ok {
+		that2, ok := that.(PutDictionaryCacheValueMessage)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := make([]string, 0, 8)
-	s = append(s, "&messages.DeleteDictionaryCacheValueReply{")
-	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
-	s = append(s, "SubKey: "+fmt.Sprintf("%#v", this.SubKey)+",\n")
-	if this.DeletedValue != nil {
-		s = append(s, "DeletedValue: "+fmt.Sprintf("%#v", this.DeletedValue)+",\n")
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s = append(s, "Success: "+fmt.Sprintf("%#v", this.Success)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *PostDictionaryCacheValueMessage) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.Key != that1.Key {
+		return false
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&messages.PostDictionaryCacheValueMessage{")
-	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
-	if this.NewValue != nil {
-		s = append(s, "NewValue: "+fmt.Sprintf("%#v", this.NewValue)+",\n")
+	if this.SubKey != that1.SubKey {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func (this *PostDictionaryCacheValueReply) GoString() string {
-	if this == nil {
-		return "nil"
+	if this.NewValue != that1.NewValue {
+		return false
 	}
-	s := make([]string, 0, 7)
-	s = append(s, "&messages.PostDictionaryCacheValueReply{")
-	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
-	s = append(s, "Success: "+fmt.Sprintf("%#v", this.Success)+",\n")
-	if this.AddedValue != nil {
-		s = append(s, "AddedValue: "+fmt.Sprintf("%#v", this.AddedValue)+",\n")
+	if this.OriginalValue != that1.OriginalValue {
+		return false
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
-}
-func valueToGoStringDictionary(v interface{}, typ string) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+	if this.Version != that1.Version {
+		return false
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+	return true
 }
-func (m *GetDictionaryCacheKeyMessage) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (this *PutDictionaryCacheValueReply) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
 
-func (m *GetDictionaryCacheKeyMessage) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Key) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintDictionary(dAtA, i, uint64(len(m.Key)))
-		i += copy(dAtA[i:], m.Key)
+	that1, ok := that.(*PutDictionaryCacheValueReply)
+	if !ok {
+		that2, ok := that.(PutDictionaryCacheValueReply)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return i, nil
-}
-
-func (m *GetDictionaryCacheKeyReply) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetDictionaryCacheKeyReply) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Key) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintDictionary(dAtA, i, uint64(len(m.Key)))
-		i += copy(dAtA[i:], m.Key)
+	if this.Key != that1.Key {
+		return false
 	}
-	if len(m.Values) > 0 {
-		for _, msg := range m.Values {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintDictionary(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	if this.SubKey != that1.SubKey {
+		return false
 	}
-	if m.Success {
-		dAtA[i] = 0x18
-		i++
-		if m.Success {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+	if this.Success != that1.Success {
+		return false
 	}
-	return i, nil
-}
-
-func (m *DeleteDictionaryCacheKeyMessage) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if this.NewValue != that1.NewValue {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DeleteDictionaryCacheKeyMessage) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Key) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintDictionary(dAtA, i, uint64(len(m.Key)))
-		i += copy(dAtA[i:], m.Key)
+	if this.OriginalValue != that1.OriginalValue {
+		return false
 	}
-	return i, nil
-}
-
-func (m *DeleteDictionaryCacheKeyReply) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if this.Version != that1.Version {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DeleteDictionaryCacheKeyReply) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Key) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintDictionary(dAtA, i, uint64(len(m.Key)))
-		i += copy(dAtA[i:], m.Key)
+	if this.Conflict != that1.Conflict {
+		return false
 	}
-	if len(m.DeletedValues) > 0 {
-		for _, msg := range m.DeletedValues {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintDictionary(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	return true
+}
+func (this *DeleteDictionaryCacheValueMessage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if m.Success {
-		dAtA[i] = 0x18
-		i++
-		if m.Success {
-			dAtA[i] = 1
+
+	that1, ok := that.(*DeleteDictionaryCacheValueMessage)
+	if !ok {
+		that2, ok := that.(DeleteDictionaryCacheValueMessage)
+		if ok {
+			that1 = &that2
 		} else {
-			dAtA[i] = 0
+			return false
 		}
-		i++
 	}
-	return i, nil
-}
-
-func (m *PostDictionaryCacheKeyMessage) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
+	if this.Key != that1.Key {
+		return false
+	}
+	if this.SubKey != that1.SubKey {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	return true
 }
+func (this *DeleteDictionaryCacheValueReply) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *PostDictionaryCacheKeyMessage) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Key) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintDictionary(dAtA, i, uint64(len(m.Key)))
-		i += copy(dAtA[i:], m.Key)
-	}
-	if len(m.Values) > 0 {
-		for _, msg := range m.Values {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintDictionary(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+	that1, ok := that.(*DeleteDictionaryCacheValueReply)
+	if !ok {
+		that2, ok := that.(DeleteDictionaryCacheValueReply)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if m.TTL != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintDictionary(dAtA, i, uint64(m.TTL.Size()))
-		n1, err := m.TTL.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n1
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return i, nil
-}
-
-func (m *PostDictionaryCacheKeyReply) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if this.Key != that1.Key {
+		return false
 	}
-	return dAtA[:n], nil
+	if this.SubKey != that1.SubKey {
+		return false
+	}
+	if !this.DeletedValue.Equal(&that1.DeletedValue) {
+		return false
+	}
+	if this.Success != that1.Success {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	if this.Conflict != that1.Conflict {
+		return false
+	}
+	return true
 }
-
-func (m *PostDictionaryCacheKeyReply) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Key) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintDictionary(dAtA, i, uint64(len(m.Key)))
-		i += copy(dAtA[i:], m.Key)
+func (this *PostDictionaryCacheValueMessage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if m.Success {
-		dAtA[i] = 0x10
-		i++
-		if m.Success {
-			dAtA[i] = 1
+
+	that1, ok := that.(*PostDictionaryCacheValueMessage)
+	if !ok {
+		that2, ok := that.(PostDictionaryCacheValueMessage)
+		if ok {
+			that1 = &that2
 		} else {
-			dAtA[i] = 0
+			return false
 		}
-		i++
 	}
-	return i, nil
-}
-
-func (m *PutDictionaryCacheValueMessage) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *PutDictionaryCacheValueMessage) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Key) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintDictionary(dAtA, i, uint64(len(m.Key)))
-		i += copy(dAtA[i:], m.Key)
+	if this.Key != that1.Key {
+		return false
 	}
-	if len(m.SubKey) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintDictionary(dAtA, i, uint64(len(m.SubKey)))
-		i += copy(dAtA[i:], m.SubKey)
+	if !this.NewValue.Equal(&that1.NewValue) {
+		return false
 	}
-	if len(m.NewValue) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintDictionary(dAtA, i, uint64(len(m.NewValue)))
-		i += copy(dAtA[i:], m.NewValue)
+	if this.Version != that1.Version {
+		return false
 	}
-	if len(m.OriginalValue) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintDictionary(dAtA, i, uint64(len(m.OriginalValue)))
-		i += copy(dAtA[i:], m.OriginalValue)
+	if this.TTL != that1.TTL {
+		return false
 	}
-	return i, nil
+	return true
 }
-
-func (m *PutDictionaryCacheValueReply) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (this *PostDictionaryCacheValueReply) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
 
-func (m *PutDictionaryCacheValueReply) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Key) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintDictionary(dAtA, i, uint64(len(m.Key)))
-		i += copy(dAtA[i:], m.Key)
-	}
-	if len(m.SubKey) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintDictionary(dAtA, i, uint64(len(m.SubKey)))
-		i += copy(dAtA[i:], m.SubKey)
-	}
-	if m.Success {
-		dAtA[i] = 0x18
-		i++
-		if m.Success {
-			dAtA[i] = 1
+	that1, ok := that.(*PostDictionaryCacheValueReply)
+	if !ok {
+		that2, ok := that.(PostDictionaryCacheValueReply)
+		if ok {
+			that1 = &that2
 		} else {
-			dAtA[i] = 0
+			return false
 		}
-		i++
 	}
-	if len(m.NewValue) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintDictionary(dAtA, i, uint64(len(m.NewValue)))
-		i += copy(dAtA[i:], m.NewValue)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.OriginalValue) > 0 {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintDictionary(dAtA, i, uint64(len(m.OriginalValue)))
-		i += copy(dAtA[i:], m.OriginalValue)
+	if this.Key != that1.Key {
+		return false
 	}
-	return i, nil
-}
-
-func (m *DeleteDictionaryCacheValueMessage) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if this.Success != that1.Success {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DeleteDictionaryCacheValueMessage) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Key) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintDictionary(dAtA, i, uint64(len(m.Key)))
-		i += copy(dAtA[i:], m.Key)
+	if !this.AddedValue.Equal(&that1.AddedValue) {
+		return false
 	}
-	if len(m.SubKey) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintDictionary(dAtA, i, uint64(len(m.SubKey)))
-		i += copy(dAtA[i:], m.SubKey)
+	if this.Version != that1.Version {
+		return false
 	}
-	return i, nil
-}
-
-func (m *DeleteDictionaryCacheValueReply) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if this.Conflict != that1.Conflict {
+		return false
 	}
-	return dAtA[:n], nil
+	return true
 }
+func (this *IncrementDictionaryCacheValueMessage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *DeleteDictionaryCacheValueReply) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Key) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintDictionary(dAtA, i, uint64(len(m.Key)))
-		i += copy(dAtA[i:], m.Key)
+	that1, ok := that.(*IncrementDictionaryCacheValueMessage)
+	if !ok {
+		that2, ok := that.(IncrementDictionaryCacheValueMessage)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if len(m.SubKey) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintDictionary(dAtA, i, uint64(len(m.SubKey)))
-		i += copy(dAtA[i:], m.SubKey)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.DeletedValue != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintDictionary(dAtA, i, uint64(m.DeletedValue.Size()))
-		n2, err := m.DeletedValue.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n2
+	if this.Key != that1.Key {
+		return false
 	}
-	if m.Success {
-		dAtA[i] = 0x20
-		i++
-		if m.Success {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+	if this.SubKey != that1.SubKey {
+		return false
 	}
-	return i, nil
-}
-
-func (m *PostDictionaryCacheValueMessage) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if this.Delta != that1.Delta {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *PostDictionaryCacheValueMessage) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Key) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintDictionary(dAtA, i, uint64(len(m.Key)))
-		i += copy(dAtA[i:], m.Key)
+	if this.FloatDelta != that1.FloatDelta {
+		return false
 	}
-	if m.NewValue != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintDictionary(dAtA, i, uint64(m.NewValue.Size()))
-		n3, err := m.NewValue.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n3
+	if this.Float != that1.Float {
+		return false
 	}
-	return i, nil
-}
-
-func (m *PostDictionaryCacheValueReply) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if this.Create != that1.Create {
+		return false
 	}
-	return dAtA[:n], nil
+	if this.TTL != that1.TTL {
+		return false
+	}
+	if this.Sliding != that1.Sliding {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	return true
 }
-
-func (m *PostDictionaryCacheValueReply) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Key) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintDictionary(dAtA, i, uint64(len(m.Key)))
-		i += copy(dAtA[i:], m.Key)
+func (this *IncrementDictionaryCacheValueReply) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if m.Success {
-		dAtA[i] = 0x10
-		i++
-		if m.Success {
-			dAtA[i] = 1
+
+	that1, ok := that.(*IncrementDictionaryCacheValueReply)
+	if !ok {
+		that2, ok := that.(IncrementDictionaryCacheValueReply)
+		if ok {
+			that1 = &that2
 		} else {
-			dAtA[i] = 0
+			return false
 		}
-		i++
 	}
-	if m.AddedValue != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintDictionary(dAtA, i, uint64(m.AddedValue.Size()))
-		n4, err := m.AddedValue.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n4
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return i, nil
-}
-
-func encodeFixed64Dictionary(dAtA []byte, offset int, v uint64) int {
-	dAtA[offset] = uint8(v)
-	dAtA[offset+1] = uint8(v >> 8)
-	dAtA[offset+2] = uint8(v >> 16)
-	dAtA[offset+3] = uint8(v >> 24)
-	dAtA[offset+4] = uint8(v >> 32)
-	dAtA[offset+5] = uint8(v >> 40)
-	dAtA[offset+6] = uint8(v >> 48)
-	dAtA[offset+7] = uint8(v >> 56)
-	return offset + 8
-}
-func encodeFixed32Dictionary(dAtA []byte, offset int, v uint32) int {
-	dAtA[offset] = uint8(v)
-	dAtA[offset+1] = uint8(v >> 8)
-	dAtA[offset+2] = uint8(v >> 16)
-	dAtA[offset+3] = uint8(v >> 24)
-	return offset + 4
-}
-func encodeVarintDictionary(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if this.Key != that1.Key {
+		return false
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
-}
-func (m *GetDictionaryCacheKeyMessage) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovDictionary(uint64(l))
+	if this.SubKey != that1.SubKey {
+		return false
 	}
-	return n
-}
-
-func (m *GetDictionaryCacheKeyReply) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovDictionary(uint64(l))
+	if this.Value != that1.Value {
+		return false
 	}
-	if len(m.Values) > 0 {
-		for _, e := range m.Values {
-			l = e.Size()
-			n += 1 + l + sovDictionary(uint64(l))
-		}
+	if this.Success != that1.Success {
+		return false
 	}
-	if m.Success {
-		n += 2
+	if this.Created != that1.Created {
+		return false
 	}
-	return n
-}
-
-func (m *DeleteDictionaryCacheKeyMessage) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovDictionary(uint64(l))
+	if this.Version != that1.Version {
+		return false
 	}
-	return n
+	if this.Conflict != that1.Conflict {
+		return false
+	}
+	if this.Error != that1.Error {
+		return false
+	}
+	return true
 }
-
-func (m *DeleteDictionaryCacheKeyReply) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovDictionary(uint64(l))
+func (this *GetDictionaryCacheValuesMessage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	if len(m.DeletedValues) > 0 {
-		for _, e := range m.DeletedValues {
-			l = e.Size()
-			n += 1 + l + sovDictionary(uint64(l))
+
+	that1, ok := that.(*GetDictionaryCacheValuesMessage)
+	if !ok {
+		that2, ok := that.(GetDictionaryCacheValuesMessage)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if m.Success {
-		n += 2
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return n
-}
-
-func (m *PostDictionaryCacheKeyMessage) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovDictionary(uint64(l))
+	if this.Key != that1.Key {
+		return false
 	}
-	if len(m.Values) > 0 {
-		for _, e := range m.Values {
-			l = e.Size()
-			n += 1 + l + sovDictionary(uint64(l))
-		}
+	if len(this.SubKeys) != len(that1.SubKeys) {
+		return false
 	}
-	if m.TTL != nil {
-		l = m.TTL.Size()
-		n += 1 + l + sovDictionary(uint64(l))
+	for i := range this.SubKeys {
+		if this.SubKeys[i] != that1.SubKeys[i] {
+			return false
+		}
 	}
-	return n
+	return true
 }
+func (this *GetDictionaryCacheValuesReply) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *PostDictionaryCacheKeyReply) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovDictionary(uint64(l))
+	that1, ok := that.(*GetDictionaryCacheValuesReply)
+	if !ok {
+		that2, ok := that.(GetDictionaryCacheValuesReply)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.Success {
-		n += 2
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return n
-}
-
-func (m *PutDictionaryCacheValueMessage) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovDictionary(uint64(l))
+	if this.Key != that1.Key {
+		return false
 	}
-	l = len(m.SubKey)
-	if l > 0 {
-		n += 1 + l + sovDictionary(uint64(l))
+	if len(this.Values) != len(that1.Values) {
+		return false
 	}
-	l = len(m.NewValue)
-	if l > 0 {
-		n += 1 + l + sovDictionary(uint64(l))
+	for i := range this.Values {
+		if !this.Values[i].Equal(&that1.Values[i]) {
+			return false
+		}
 	}
-	l = len(m.OriginalValue)
-	if l > 0 {
-		n += 1 + l + sovDictionary(uint64(l))
+	if this.Success != that1.Success {
+		return false
 	}
-	return n
+	if this.Version != that1.Version {
+		return false
+	}
+	return true
 }
+func (this *SetDictionaryCacheValuesMessage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *PutDictionaryCacheValueReply) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovDictionary(uint64(l))
+	that1, ok := that.(*SetDictionaryCacheValuesMessage)
+	if !ok {
+		that2, ok := that.(SetDictionaryCacheValuesMessage)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	l = len(m.SubKey)
-	if l > 0 {
-		n += 1 + l + sovDictionary(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Success {
-		n += 2
+	if this.Key != that1.Key {
+		return false
 	}
-	l = len(m.NewValue)
-	if l > 0 {
-		n += 1 + l + sovDictionary(uint64(l))
+	if len(this.Values) != len(that1.Values) {
+		return false
 	}
-	l = len(m.OriginalValue)
-	if l > 0 {
-		n += 1 + l + sovDictionary(uint64(l))
+	for i := range this.Values {
+		if !this.Values[i].Equal(&that1.Values[i]) {
+			return false
+		}
 	}
-	return n
+	if this.Create != that1.Create {
+		return false
+	}
+	if this.TTL != that1.TTL {
+		return false
+	}
+	if this.Sliding != that1.Sliding {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	return true
 }
+func (this *SetDictionaryCacheValuesReply) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *DeleteDictionaryCacheValueMessage) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovDictionary(uint64(l))
+	that1, ok := that.(*SetDictionaryCacheValuesReply)
+	if !ok {
+		that2, ok := that.(SetDictionaryCacheValuesReply)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	l = len(m.SubKey)
-	if l > 0 {
-		n += 1 + l + sovDictionary(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return n
+	if this.Key != that1.Key {
+		return false
+	}
+	if this.Added != that1.Added {
+		return false
+	}
+	if this.Updated != that1.Updated {
+		return false
+	}
+	if this.Success != that1.Success {
+		return false
+	}
+	if this.Created != that1.Created {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	if this.Conflict != that1.Conflict {
+		return false
+	}
+	return true
 }
+func (this *DeleteDictionaryCacheValuesMessage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *DeleteDictionaryCacheValueReply) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovDictionary(uint64(l))
+	that1, ok := that.(*DeleteDictionaryCacheValuesMessage)
+	if !ok {
+		that2, ok := that.(DeleteDictionaryCacheValuesMessage)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	l = len(m.SubKey)
-	if l > 0 {
-		n += 1 + l + sovDictionary(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.DeletedValue != nil {
-		l = m.DeletedValue.Size()
-		n += 1 + l + sovDictionary(uint64(l))
+	if this.Key != that1.Key {
+		return false
 	}
-	if m.Success {
-		n += 2
+	if len(this.SubKeys) != len(that1.SubKeys) {
+		return false
 	}
-	return n
+	for i := range this.SubKeys {
+		if this.SubKeys[i] != that1.SubKeys[i] {
+			return false
+		}
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	return true
 }
+func (this *DeleteDictionaryCacheValuesReply) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *PostDictionaryCacheValueMessage) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovDictionary(uint64(l))
+	that1, ok := that.(*DeleteDictionaryCacheValuesReply)
+	if !ok {
+		that2, ok := that.(DeleteDictionaryCacheValuesReply)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.NewValue != nil {
-		l = m.NewValue.Size()
-		n += 1 + l + sovDictionary(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return n
+	if this.Key != that1.Key {
+		return false
+	}
+	if len(this.DeletedValues) != len(that1.DeletedValues) {
+		return false
+	}
+	for i := range this.DeletedValues {
+		if !this.DeletedValues[i].Equal(&that1.DeletedValues[i]) {
+			return false
+		}
+	}
+	if this.Success != that1.Success {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	if this.Conflict != that1.Conflict {
+		return false
+	}
+	return true
 }
+func (this *GetDictionaryCacheSubKeysMessage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *PostDictionaryCacheValueReply) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovDictionary(uint64(l))
+	that1, ok := that.(*GetDictionaryCacheSubKeysMessage)
+	if !ok {
+		that2, ok := that.(GetDictionaryCacheSubKeysMessage)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.Success {
-		n += 2
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.AddedValue != nil {
-		l = m.AddedValue.Size()
-		n += 1 + l + sovDictionary(uint64(l))
+	if this.Key != that1.Key {
+		return false
 	}
-	return n
+	return true
 }
+func (this *GetDictionaryCacheSubKeysReply) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func sovDictionary(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
+	that1, ok := that.(*GetDictionaryCacheSubKeysReply)
+	if !ok {
+		that2, ok := that.(GetDictionaryCacheSubKeysReply)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	return n
-}
-func sozDictionary(x uint64) (n int) {
-	return sovDictionary(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (this *GetDictionaryCacheKeyMessage) String() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := strings.Join([]string{`&GetDictionaryCacheKeyMessage{`,
-		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *GetDictionaryCacheKeyReply) String() string {
-	if this == nil {
-		return "nil"
+	if this.Key != that1.Key {
+		return false
 	}
-	s := strings.Join([]string{`&GetDictionaryCacheKeyReply{`,
-		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
-		`Values:` + strings.Replace(fmt.Sprintf("%v", this.Values), "KeyValue", "KeyValue", 1) + `,`,
-		`Success:` + fmt.Sprintf("%v", this.Success) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *DeleteDictionaryCacheKeyMessage) String() string {
-	if this == nil {
-		return "nil"
+	if len(this.SubKeys) != len(that1.SubKeys) {
+		return false
 	}
-	s := strings.Join([]string{`&DeleteDictionaryCacheKeyMessage{`,
-		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *DeleteDictionaryCacheKeyReply) String() string {
-	if this == nil {
-		return "nil"
+	for i := range this.SubKeys {
+		if this.SubKeys[i] != that1.SubKeys[i] {
+			return false
+		}
 	}
-	s := strings.Join([]string{`&DeleteDictionaryCacheKeyReply{`,
-		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
-		`DeletedValues:` + strings.Replace(fmt.Sprintf("%v", this.DeletedValues), "KeyValue", "KeyValue", 1) + `,`,
-		`Success:` + fmt.Sprintf("%v", this.Success) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *PostDictionaryCacheKeyMessage) String() string {
-	if this == nil {
-		return "nil"
+	if this.Success != that1.Success {
+		return false
 	}
-	s := strings.Join([]string{`&PostDictionaryCacheKeyMessage{`,
-		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
-		`Values:` + strings.Replace(fmt.Sprintf("%v", this.Values), "KeyValue", "KeyValue", 1) + `,`,
-		`TTL:` + strings.Replace(fmt.Sprintf("%v", this.TTL), "Duration", "google_protobuf.Duration", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *PostDictionaryCacheKeyReply) String() string {
-	if this == nil {
-		return "nil"
+	if this.Version != that1.Version {
+		return false
 	}
-	s := strings.Join([]string{`&PostDictionaryCacheKeyReply{`,
-		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
-		`Success:` + fmt.Sprintf("%v", this.Success) + `,`,
-		`}`,
-	}, "")
-	return s
+	return true
 }
-func (this *PutDictionaryCacheValueMessage) String() string {
-	if this == nil {
-		return "nil"
+func (this *ContainsDictionaryCacheValuesMessage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := strings.Join([]string{`&PutDictionaryCacheValueMessage{`,
-		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
-		`SubKey:` + fmt.Sprintf("%v", this.SubKey) + `,`,
-		`NewValue:` + fmt.Sprintf("%v", this.NewValue) + `,`,
-		`OriginalValue:` + fmt.Sprintf("%v", this.OriginalValue) + `,`,
-		`}`,
-	}, "")
-	return s
+
+	that1, ok := that.(*ContainsDictionaryCacheValuesMessage)
+	if !ok {
+		that2, ok := that.(ContainsDictionaryCacheValuesMessage)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Key != that1.Key {
+		return false
+	}
+	if len(this.SubKeys) != len(that1.SubKeys) {
+		return false
+	}
+	for i := range this.SubKeys {
+		if this.SubKeys[i] != that1.SubKeys[i] {
+			return false
+		}
+	}
+	return true
 }
-func (this *PutDictionaryCacheValueReply) String() string {
+func (this *ContainsDictionaryCacheValuesReply) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ContainsDictionaryCacheValuesReply)
+	if !ok {
+		that2, ok := that.(ContainsDictionaryCacheValuesReply)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Key != that1.Key {
+		return false
+	}
+	if len(this.SubKeys) != len(that1.SubKeys) {
+		return false
+	}
+	for i := range this.SubKeys {
+		if this.SubKeys[i] != that1.SubKeys[i] {
+			return false
+		}
+	}
+	if len(this.Exists) != len(that1.Exists) {
+		return false
+	}
+	for i := range this.Exists {
+		if this.Exists[i] != that1.Exists[i] {
+			return false
+		}
+	}
+	if this.Success != that1.Success {
+		return false
+	}
+	return true
+}
+func (this *GetDictionaryCacheKeyMessage) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&PutDictionaryCacheValueReply{`,
-		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
-		`SubKey:` + fmt.Sprintf("%v", this.SubKey) + `,`,
-		`Success:` + fmt.Sprintf("%v", this.Success) + `,`,
-		`NewValue:` + fmt.Sprintf("%v", this.NewValue) + `,`,
-		`OriginalValue:` + fmt.Sprintf("%v", this.OriginalValue) + `,`,
-		`}`,
-	}, "")
-	return s
+	s := make([]string, 0, 5)
+	s = append(s, "&messages.GetDictionaryCacheKeyMessage{")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (this *DeleteDictionaryCacheValueMessage) String() string {
+func (this *GetDictionaryCacheKeyReply) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&DeleteDictionaryCacheValueMessage{`,
-		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
-		`SubKey:` + fmt.Sprintf("%v", this.SubKey) + `,`,
-		`}`,
-	}, "")
-	return s
+	s := make([]string, 0, 8)
+	s = append(s, "&messages.GetDictionaryCacheKeyReply{")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	if this.Values != nil {
+		vs := make([]*KeyValue, len(this.Values))
+		for i := range vs {
+			vs[i] = &this.Values[i]
+		}
+		s = append(s, "Values: "+fmt.Sprintf("%#v", vs)+",\n")
+	}
+	s = append(s, "Success: "+fmt.Sprintf("%#v", this.Success)+",\n")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (this *DeleteDictionaryCacheValueReply) String() string {
+func (this *DeleteDictionaryCacheKeyMessage) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&DeleteDictionaryCacheValueReply{`,
-		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
-		`SubKey:` + fmt.Sprintf("%v", this.SubKey) + `,`,
-		`DeletedValue:` + strings.Replace(fmt.Sprintf("%v", this.DeletedValue), "KeyValue", "KeyValue", 1) + `,`,
-		`Success:` + fmt.Sprintf("%v", this.Success) + `,`,
-		`}`,
-	}, "")
-	return s
+	s := make([]string, 0, 6)
+	s = append(s, "&messages.DeleteDictionaryCacheKeyMessage{")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (this *PostDictionaryCacheValueMessage) String() string {
+func (this *DeleteDictionaryCacheKeyReply) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&PostDictionaryCacheValueMessage{`,
-		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
-		`NewValue:` + strings.Replace(fmt.Sprintf("%v", this.NewValue), "KeyValue", "KeyValue", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	s := make([]string, 0, 8)
+	s = append(s, "&messages.DeleteDictionaryCacheKeyReply{")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	if this.DeletedValues != nil {
+		vs := make([]*KeyValue, len(this.DeletedValues))
+		for i := range vs {
+			vs[i] = &this.DeletedValues[i]
+		}
+		s = append(s, "DeletedValues: "+fmt.Sprintf("%#v", vs)+",\n")
+	}
+	s = append(s, "Success: "+fmt.Sprintf("%#v", this.Success)+",\n")
+	s = append(s, "Conflict: "+fmt.Sprintf("%#v", this.Conflict)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func (this *PostDictionaryCacheValueReply) String() string {
+func (this *PostDictionaryCacheKeyMessage) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&PostDictionaryCacheValueReply{`,
-		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
-		`Success:` + fmt.Sprintf("%v", this.Success) + `,`,
-		`AddedValue:` + strings.Replace(fmt.Sprintf("%v", this.AddedValue), "KeyValue", "KeyValue", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	s := make([]string, 0, 8)
+	s = append(s, "&messages.PostDictionaryCacheKeyMessage{")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	if this.Values != nil {
+		vs := make([]*KeyValue, len(this.Values))
+		for i := range vs {
+			vs[i] = &this.Values[i]
+		}
+		s = append(s, "Values: "+fmt.Sprintf("%#v", vs)+",\n")
+	}
+	s = append(s, "TTL: "+fmt.Sprintf("%#v", this.TTL)+",\n")
+	s = append(s, "Sliding: "+fmt.Sprintf("%#v", this.Sliding)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-func valueToStringDictionary(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
+func (this *PostDictionaryCacheKeyReply) GoString() string {
+	if this == nil {
 		return "nil"
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	s := make([]string, 0, 7)
+	s = append(s, "&messages.PostDictionaryCacheKeyReply{")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "Success: "+fmt.Sprintf("%#v", this.Success)+",\n")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PutDictionaryCacheValueMessage) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&messages.PutDictionaryCacheValueMessage{")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "SubKey: "+fmt.Sprintf("%#v", this.SubKey)+",\n")
+	s = append(s, "NewValue: "+fmt.Sprintf("%#v", this.NewValue)+",\n")
+	s = append(s, "OriginalValue: "+fmt.Sprintf("%#v", this.OriginalValue)+",\n")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PutDictionaryCacheValueReply) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 11)
+	s = append(s, "&messages.PutDictionaryCacheValueReply{")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "SubKey: "+fmt.Sprintf("%#v", this.SubKey)+",\n")
+	s = append(s, "Success: "+fmt.Sprintf("%#v", this.Success)+",\n")
+	s = append(s, "NewValue: "+fmt.Sprintf("%#v", this.NewValue)+",\n")
+	s = append(s, "OriginalValue: "+fmt.Sprintf("%#v", this.OriginalValue)+",\n")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	s = append(s, "Conflict: "+fmt.Sprintf("%#v", this.Conflict)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DeleteDictionaryCacheValueMessage) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&messages.DeleteDictionaryCacheValueMessage{")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "SubKey: "+fmt.Sprintf("%#v", this.SubKey)+",\n")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DeleteDictionaryCacheValueReply) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&messages.DeleteDictionaryCacheValueReply{")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "SubKey: "+fmt.Sprintf("%#v", this.SubKey)+",\n")
+	s = append(s, "DeletedValue: "+strings.Replace(this.DeletedValue.GoString(), `&`, ``, 1)+",\n")
+	s = append(s, "Success: "+fmt.Sprintf("%#v", this.Success)+",\n")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	s = append(s, "Conflict: "+fmt.Sprintf("%#v", this.Conflict)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PostDictionaryCacheValueMessage) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&messages.PostDictionaryCacheValueMessage{")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "NewValue: "+strings.Replace(this.NewValue.GoString(), `&`, ``, 1)+",\n")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	s = append(s, "TTL: "+fmt.Sprintf("%#v", this.TTL)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PostDictionaryCacheValueReply) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&messages.PostDictionaryCacheValueReply{")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "Success: "+fmt.Sprintf("%#v", this.Success)+",\n")
+	s = append(s, "AddedValue: "+strings.Replace(this.AddedValue.GoString(), `&`, ``, 1)+",\n")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	s = append(s, "Conflict: "+fmt.Sprintf("%#v", this.Conflict)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *IncrementDictionaryCacheValueMessage) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 13)
+	s = append(s, "&messages.IncrementDictionaryCacheValueMessage{")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "SubKey: "+fmt.Sprintf("%#v", this.SubKey)+",\n")
+	s = append(s, "Delta: "+fmt.Sprintf("%#v", this.Delta)+",\n")
+	s = append(s, "FloatDelta: "+fmt.Sprintf("%#v", this.FloatDelta)+",\n")
+	s = append(s, "Float: "+fmt.Sprintf("%#v", this.Float)+",\n")
+	s = append(s, "Create: "+fmt.Sprintf("%#v", this.Create)+",\n")
+	s = append(s, "TTL: "+fmt.Sprintf("%#v", this.TTL)+",\n")
+	s = append(s, "Sliding: "+fmt.Sprintf("%#v", this.Sliding)+",\n")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *IncrementDictionaryCacheValueReply) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 12)
+	s = append(s, "&messages.IncrementDictionaryCacheValueReply{")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "SubKey: "+fmt.Sprintf("%#v", this.SubKey)+",\n")
+	s = append(s, "Value: "+fmt.Sprintf("%#v", this.Value)+",\n")
+	s = append(s, "Success: "+fmt.Sprintf("%#v", this.Success)+",\n")
+	s = append(s, "Created: "+fmt.Sprintf("%#v", this.Created)+",\n")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	s = append(s, "Conflict: "+fmt.Sprintf("%#v", this.Conflict)+",\n")
+	s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetDictionaryCacheValuesMessage) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&messages.GetDictionaryCacheValuesMessage{")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "SubKeys: "+fmt.Sprintf("%#v", this.SubKeys)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetDictionaryCacheValuesReply) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&messages.GetDictionaryCacheValuesReply{")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	if this.Values != nil {
+		vs := make([]*KeyValue, len(this.Values))
+		for i := range vs {
+			vs[i] = &this.Values[i]
+		}
+		s = append(s, "Values: "+fmt.Sprintf("%#v", vs)+",\n")
+	}
+	s = append(s, "Success: "+fmt.Sprintf("%#v", this.Success)+",\n")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SetDictionaryCacheValuesMessage) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&messages.SetDictionaryCacheValuesMessage{")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	if this.Values != nil {
+		vs := make([]*KeyValue, len(this.Values))
+		for i := range vs {
+			vs[i] = &this.Values[i]
+		}
+		s = append(s, "Values: "+fmt.Sprintf("%#v", vs)+",\n")
+	}
+	s = append(s, "Create: "+fmt.Sprintf("%#v", this.Create)+",\n")
+	s = append(s, "TTL: "+fmt.Sprintf("%#v", this.TTL)+",\n")
+	s = append(s, "Sliding: "+fmt.Sprintf("%#v", this.Sliding)+",\n")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SetDictionaryCacheValuesReply) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 11)
+	s = append(s, "&messages.SetDictionaryCacheValuesReply{")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "Added: "+fmt.Sprintf("%#v", this.Added)+",\n")
+	s = append(s, "Updated: "+fmt.Sprintf("%#v", this.Updated)+",\n")
+	s = append(s, "Success: "+fmt.Sprintf("%#v", this.Success)+",\n")
+	s = append(s, "Created: "+fmt.Sprintf("%#v", this.Created)+",\n")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	s = append(s, "Conflict: "+fmt.Sprintf("%#v", this.Conflict)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DeleteDictionaryCacheValuesMessage) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&messages.DeleteDictionaryCacheValuesMessage{")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "SubKeys: "+fmt.Sprintf("%#v", this.SubKeys)+",\n")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DeleteDictionaryCacheValuesReply) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&messages.DeleteDictionaryCacheValuesReply{")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	if this.DeletedValues != nil {
+		vs := make([]*KeyValue, len(this.DeletedValues))
+		for i := range vs {
+			vs[i] = &this.DeletedValues[i]
+		}
+		s = append(s, "DeletedValues: "+fmt.Sprintf("%#v", vs)+",\n")
+	}
+	s = append(s, "Success: "+fmt.Sprintf("%#v", this.Success)+",\n")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	s = append(s, "Conflict: "+fmt.Sprintf("%#v", this.Conflict)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetDictionaryCacheSubKeysMessage) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&messages.GetDictionaryCacheSubKeysMessage{")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetDictionaryCacheSubKeysReply) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&messages.GetDictionaryCacheSubKeysReply{")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "SubKeys: "+fmt.Sprintf("%#v", this.SubKeys)+",\n")
+	s = append(s, "Success: "+fmt.Sprintf("%#v", this.Success)+",\n")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ContainsDictionaryCacheValuesMessage) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&messages.ContainsDictionaryCacheValuesMessage{")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "SubKeys: "+fmt.Sprintf("%#v", this.SubKeys)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ContainsDictionaryCacheValuesReply) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&messages.ContainsDictionaryCacheValuesReply{")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "SubKeys: "+fmt.Sprintf("%#v", this.SubKeys)+",\n")
+	s = append(s, "Exists: "+fmt.Sprintf("%#v", this.Exists)+",\n")
+	s = append(s, "Success: "+fmt.Sprintf("%#v", this.Success)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringDictionary(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *GetDictionaryCacheKeyMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetDictionaryCacheKeyMessage) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintDictionary(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
+	}
+	return i, nil
+}
+
+func (m *GetDictionaryCacheKeyReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetDictionaryCacheKeyReply) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintDictionary(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
+	}
+	if len(m.Values) > 0 {
+		for _, msg := range m.Values {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintDictionary(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Success {
+		dAtA[i] = 0x18
+		i++
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Version != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintDictionary(dAtA, i, uint64(m.Version))
+	}
+	return i, nil
+}
+
+func (m *DeleteDictionaryCacheKeyMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteDictionaryCacheKeyMessage) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintDictionary(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
+	}
+	if m.Version != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintDictionary(dAtA, i, uint64(m.Version))
+	}
+	return i, nil
+}
+
+func (m *DeleteDictionaryCacheKeyReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteDictionaryCacheKeyReply) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintDictionary(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
+	}
+	if len(m.DeletedValues) > 0 {
+		for _, msg := range m.DeletedValues {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintDictionary(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Success {
+		dAtA[i] = 0x18
+		i++
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Conflict {
+		dAtA[i] = 0x20
+		i++
+		if m.Conflict {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *PostDictionaryCacheKeyMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PostDictionaryCacheKeyMessage) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintDictionary(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
+	}
+	if len(m.Values) > 0 {
+		for _, msg := range m.Values {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintDictionary(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintDictionary(dAtA, i, uint64(types.SizeOfStdDuration(m.TTL)))
+	n1, err := types.StdDurationMarshalTo(m.TTL, dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n1
+	if m.Sliding {
+		dAtA[i] = 0x20
+		i++
+		if m.Sliding {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *PostDictionaryCacheKeyReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PostDictionaryCacheKeyReply) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintDictionary(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
+	}
+	if m.Success {
+		dAtA[i] = 0x10
+		i++
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Version != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintDictionary(dAtA, i, uint64(m.Version))
+	}
+	return i, nil
+}
+
+func (m *PutDictionaryCacheValueMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PutDictionaryCacheValueMessage) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintDictionary(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
+	}
+	if len(m.SubKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintDictionary(dAtA, i, uint64(len(m.SubKey)))
+		i += copy(dAtA[i:], m.SubKey)
+	}
+	if len(m.NewValue) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintDictionary(dAtA, i, uint64(len(m.NewValue)))
+		i += copy(dAtA[i:], m.NewValue)
+	}
+	if len(m.OriginalValue) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintDictionary(dAtA, i, uint64(len(m.OriginalValue)))
+		i += copy(dAtA[i:], m.OriginalValue)
+	}
+	if m.Version != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintDictionary(dAtA, i, uint64(m.Version))
+	}
+	return i, nil
+}
+
+func (m *PutDictionaryCacheValueReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PutDictionaryCacheValueReply) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintDictionary(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
+	}
+	if len(m.SubKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintDictionary(dAtA, i, uint64(len(m.SubKey)))
+		i += copy(dAtA[i:], m.SubKey)
+	}
+	if m.Success {
+		dAtA[i] = 0x18
+		i++
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.NewValue) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintDictionary(dAtA, i, uint64(len(m.NewValue)))
+		i += copy(dAtA[i:], m.NewValue)
+	}
+	if len(m.OriginalValue) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintDictionary(dAtA, i, uint64(len(m.OriginalValue)))
+		i += copy(dAtA[i:], m.OriginalValue)
+	}
+	if m.Version != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintDictionary(dAtA, i, uint64(m.Version))
+	}
+	if m.Conflict {
+		dAtA[i] = 0x38
+		i++
+		if m.Conflict {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *DeleteDictionaryCacheValueMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteDictionaryCacheValueMessage) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintDictionary(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
+	}
+	if len(m.SubKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintDictionary(dAtA, i, uint64(len(m.SubKey)))
+		i += copy(dAtA[i:], m.SubKey)
+	}
+	if m.Version != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintDictionary(dAtA, i, uint64(m.Version))
+	}
+	return i, nil
+}
+
+func (m *DeleteDictionaryCacheValueReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteDictionaryCacheValueReply) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintDictionary(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
+	}
+	if len(m.SubKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintDictionary(dAtA, i, uint64(len(m.SubKey)))
+		i += copy(dAtA[i:], m.SubKey)
+	}
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintDictionary(dAtA, i, uint64(m.DeletedValue.Size()))
+	n2, err := m.DeletedValue.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n2
+	if m.Success {
+		dAtA[i] = 0x20
+		i++
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Version != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintDictionary(dAtA, i, uint64(m.Version))
+	}
+	if m.Conflict {
+		dAtA[i] = 0x30
+		i++
+		if m.Conflict {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *PostDictionaryCacheValueMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PostDictionaryCacheValueMessage) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintDictionary(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
+	}
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintDictionary(dAtA, i, uint64(m.NewValue.Size()))
+	n3, err := m.NewValue.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n3
+	if m.Version != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintDictionary(dAtA, i, uint64(m.Version))
+	}
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintDictionary(dAtA, i, uint64(types.SizeOfStdDuration(m.TTL)))
+	n4, err := types.StdDurationMarshalTo(m.TTL, dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n4
+	return i, nil
+}
+
+func (m *PostDictionaryCacheValueReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PostDictionaryCacheValueReply) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintDictionary(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
+	}
+	if m.Success {
+		dAtA[i] = 0x10
+		i++
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintDictionary(dAtA, i, uint64(m.AddedValue.Size()))
+	n5, err := m.AddedValue.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n5
+	if m.Version != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintDictionary(dAtA, i, uint64(m.Version))
+	}
+	if m.Conflict {
+		dAtA[i] = 0x28
+		i++
+		if m.Conflict {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *IncrementDictionaryCacheValueMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IncrementDictionaryCacheValueMessage) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintDictionary(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
+	}
+	if len(m.SubKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintDictionary(dAtA, i, uint64(len(m.SubKey)))
+		i += copy(dAtA[i:], m.SubKey)
+	}
+	if m.Delta != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintDictionary(dAtA, i, uint64(m.Delta))
+	}
+	if m.FloatDelta != 0 {
+		dAtA[i] = 0x21
+		i++
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.FloatDelta))))
+		i += 8
+	}
+	if m.Float {
+		dAtA[i] = 0x28
+		i++
+		if m.Float {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Create {
+		dAtA[i] = 0x30
+		i++
+		if m.Create {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	dAtA[i] = 0x3a
+	i++
+	i = encodeVarintDictionary(dAtA, i, uint64(types.SizeOfStdDuration(m.TTL)))
+	n6, err := types.StdDurationMarshalTo(m.TTL, dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n6
+	if m.Sliding {
+		dAtA[i] = 0x40
+		i++
+		if m.Sliding {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Version != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintDictionary(dAtA, i, uint64(m.Version))
+	}
+	return i, nil
+}
+
+func (m *IncrementDictionaryCacheValueReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IncrementDictionaryCacheValueReply) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintDictionary(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
+	}
+	if len(m.SubKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintDictionary(dAtA, i, uint64(len(m.SubKey)))
+		i += copy(dAtA[i:], m.SubKey)
+	}
+	if len(m.Value) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintDictionary(dAtA, i, uint64(len(m.Value)))
+		i += copy(dAtA[i:], m.Value)
+	}
+	if m.Success {
+		dAtA[i] = 0x20
+		i++
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Created {
+		dAtA[i] = 0x28
+		i++
+		if m.Created {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Version != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintDictionary(dAtA, i, uint64(m.Version))
+	}
+	if m.Conflict {
+		dAtA[i] = 0x38
+		i++
+		if m.Conflict {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintDictionary(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	return i, nil
+}
+
+func (m *GetDictionaryCacheValuesMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetDictionaryCacheValuesMessage) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintDictionary(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
+	}
+	if len(m.SubKeys) > 0 {
+		for _, s := range m.SubKeys {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func (m *GetDictionaryCacheValuesReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetDictionaryCacheValuesReply) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintDictionary(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
+	}
+	if len(m.Values) > 0 {
+		for _, msg := range m.Values {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintDictionary(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Success {
+		dAtA[i] = 0x18
+		i++
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Version != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintDictionary(dAtA, i, uint64(m.Version))
+	}
+	return i, nil
+}
+
+func (m *SetDictionaryCacheValuesMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetDictionaryCacheValuesMessage) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintDictionary(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
+	}
+	if len(m.Values) > 0 {
+		for _, msg := range m.Values {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintDictionary(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Create {
+		dAtA[i] = 0x18
+		i++
+		if m.Create {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintDictionary(dAtA, i, uint64(types.SizeOfStdDuration(m.TTL)))
+	n7, err := types.StdDurationMarshalTo(m.TTL, dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n7
+	if m.Sliding {
+		dAtA[i] = 0x28
+		i++
+		if m.Sliding {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Version != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintDictionary(dAtA, i, uint64(m.Version))
+	}
+	return i, nil
+}
+
+func (m *SetDictionaryCacheValuesReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetDictionaryCacheValuesReply) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintDictionary(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
+	}
+	if m.Added != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintDictionary(dAtA, i, uint64(m.Added))
+	}
+	if m.Updated != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintDictionary(dAtA, i, uint64(m.Updated))
+	}
+	if m.Success {
+		dAtA[i] = 0x20
+		i++
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Created {
+		dAtA[i] = 0x28
+		i++
+		if m.Created {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Version != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintDictionary(dAtA, i, uint64(m.Version))
+	}
+	if m.Conflict {
+		dAtA[i] = 0x38
+		i++
+		if m.Conflict {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *DeleteDictionaryCacheValuesMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteDictionaryCacheValuesMessage) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintDictionary(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
+	}
+	if len(m.SubKeys) > 0 {
+		for _, s := range m.SubKeys {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.Version != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintDictionary(dAtA, i, uint64(m.Version))
+	}
+	return i, nil
+}
+
+func (m *DeleteDictionaryCacheValuesReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteDictionaryCacheValuesReply) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintDictionary(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
+	}
+	if len(m.DeletedValues) > 0 {
+		for _, msg := range m.DeletedValues {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintDictionary(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Success {
+		dAtA[i] = 0x18
+		i++
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Version != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintDictionary(dAtA, i, uint64(m.Version))
+	}
+	if m.Conflict {
+		dAtA[i] = 0x28
+		i++
+		if m.Conflict {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *GetDictionaryCacheSubKeysMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetDictionaryCacheSubKeysMessage) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintDictionary(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
+	}
+	return i, nil
+}
+
+func (m *GetDictionaryCacheSubKeysReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetDictionaryCacheSubKeysReply) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintDictionary(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
+	}
+	if len(m.SubKeys) > 0 {
+		for _, s := range m.SubKeys {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.Success {
+		dAtA[i] = 0x18
+		i++
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Version != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintDictionary(dAtA, i, uint64(m.Version))
+	}
+	return i, nil
+}
+
+func (m *ContainsDictionaryCacheValuesMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContainsDictionaryCacheValuesMessage) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintDictionary(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
+	}
+	if len(m.SubKeys) > 0 {
+		for _, s := range m.SubKeys {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func (m *ContainsDictionaryCacheValuesReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContainsDictionaryCacheValuesReply) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintDictionary(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
+	}
+	if len(m.SubKeys) > 0 {
+		for _, s := range m.SubKeys {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Exists) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintDictionary(dAtA, i, uint64(len(m.Exists)))
+		for _, b := range m.Exists {
+			if b {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i++
+		}
+	}
+	if m.Success {
+		dAtA[i] = 0x20
+		i++
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func encodeVarintDictionary(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *GetDictionaryCacheKeyMessage) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovDictionary(uint64(l))
+	}
+	return n
+}
+
+func (m *GetDictionaryCacheKeyReply) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovDictionary(uint64(l))
+	}
+	if len(m.Values) > 0 {
+		for _, e := range m.Values {
+			l = e.Size()
+			n += 1 + l + sovDictionary(uint64(l))
+		}
+	}
+	if m.Success {
+		n += 2
+	}
+	if m.Version != 0 {
+		n += 1 + sovDictionary(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *DeleteDictionaryCacheKeyMessage) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovDictionary(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovDictionary(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *DeleteDictionaryCacheKeyReply) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovDictionary(uint64(l))
+	}
+	if len(m.DeletedValues) > 0 {
+		for _, e := range m.DeletedValues {
+			l = e.Size()
+			n += 1 + l + sovDictionary(uint64(l))
+		}
+	}
+	if m.Success {
+		n += 2
+	}
+	if m.Conflict {
+		n += 2
+	}
+	return n
+}
+
+func (m *PostDictionaryCacheKeyMessage) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovDictionary(uint64(l))
+	}
+	if len(m.Values) > 0 {
+		for _, e := range m.Values {
+			l = e.Size()
+			n += 1 + l + sovDictionary(uint64(l))
+		}
+	}
+	l = types.SizeOfStdDuration(m.TTL)
+	n += 1 + l + sovDictionary(uint64(l))
+	if m.Sliding {
+		n += 2
+	}
+	return n
+}
+
+func (m *PostDictionaryCacheKeyReply) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovDictionary(uint64(l))
+	}
+	if m.Success {
+		n += 2
+	}
+	if m.Version != 0 {
+		n += 1 + sovDictionary(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *PutDictionaryCacheValueMessage) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovDictionary(uint64(l))
+	}
+	l = len(m.SubKey)
+	if l > 0 {
+		n += 1 + l + sovDictionary(uint64(l))
+	}
+	l = len(m.NewValue)
+	if l > 0 {
+		n += 1 + l + sovDictionary(uint64(l))
+	}
+	l = len(m.OriginalValue)
+	if l > 0 {
+		n += 1 + l + sovDictionary(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovDictionary(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *PutDictionaryCacheValueReply) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovDictionary(uint64(l))
+	}
+	l = len(m.SubKey)
+	if l > 0 {
+		n += 1 + l + sovDictionary(uint64(l))
+	}
+	if m.Success {
+		n += 2
+	}
+	l = len(m.NewValue)
+	if l > 0 {
+		n += 1 + l + sovDictionary(uint64(l))
+	}
+	l = len(m.OriginalValue)
+	if l > 0 {
+		n += 1 + l + sovDictionary(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovDictionary(uint64(m.Version))
+	}
+	if m.Conflict {
+		n += 2
+	}
+	return n
+}
+
+func (m *DeleteDictionaryCacheValueMessage) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovDictionary(uint64(l))
+	}
+	l = len(m.SubKey)
+	if l > 0 {
+		n += 1 + l + sovDictionary(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovDictionary(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *DeleteDictionaryCacheValueReply) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovDictionary(uint64(l))
+	}
+	l = len(m.SubKey)
+	if l > 0 {
+		n += 1 + l + sovDictionary(uint64(l))
+	}
+	l = m.DeletedValue.Size()
+	n += 1 + l + sovDictionary(uint64(l))
+	if m.Success {
+		n += 2
+	}
+	if m.Version != 0 {
+		n += 1 + sovDictionary(uint64(m.Version))
+	}
+	if m.Conflict {
+		n += 2
+	}
+	return n
+}
+
+func (m *PostDictionaryCacheValueMessage) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovDictionary(uint64(l))
+	}
+	l = m.NewValue.Size()
+	n += 1 + l + sovDictionary(uint64(l))
+	if m.Version != 0 {
+		n += 1 + sovDictionary(uint64(m.Version))
+	}
+	l = types.SizeOfStdDuration(m.TTL)
+	n += 1 + l + sovDictionary(uint64(l))
+	return n
+}
+
+func (m *PostDictionaryCacheValueReply) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovDictionary(uint64(l))
+	}
+	if m.Success {
+		n += 2
+	}
+	l = m.AddedValue.Size()
+	n += 1 + l + sovDictionary(uint64(l))
+	if m.Version != 0 {
+		n += 1 + sovDictionary(uint64(m.Version))
+	}
+	if m.Conflict {
+		n += 2
+	}
+	return n
+}
+
+func (m *IncrementDictionaryCacheValueMessage) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovDictionary(uint64(l))
+	}
+	l = len(m.SubKey)
+	if l > 0 {
+		n += 1 + l + sovDictionary(uint64(l))
+	}
+	if m.Delta != 0 {
+		n += 1 + sovDictionary(uint64(m.Delta))
+	}
+	if m.FloatDelta != 0 {
+		n += 9
+	}
+	if m.Float {
+		n += 2
+	}
+	if m.Create {
+		n += 2
+	}
+	l = types.SizeOfStdDuration(m.TTL)
+	n += 1 + l + sovDictionary(uint64(l))
+	if m.Sliding {
+		n += 2
+	}
+	if m.Version != 0 {
+		n += 1 + sovDictionary(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *IncrementDictionaryCacheValueReply) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovDictionary(uint64(l))
+	}
+	l = len(m.SubKey)
+	if l > 0 {
+		n += 1 + l + sovDictionary(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovDictionary(uint64(l))
+	}
+	if m.Success {
+		n += 2
+	}
+	if m.Created {
+		n += 2
+	}
+	if m.Version != 0 {
+		n += 1 + sovDictionary(uint64(m.Version))
+	}
+	if m.Conflict {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovDictionary(uint64(l))
+	}
+	return n
+}
+
+func (m *GetDictionaryCacheValuesMessage) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovDictionary(uint64(l))
+	}
+	if len(m.SubKeys) > 0 {
+		for _, s := range m.SubKeys {
+			l = len(s)
+			n += 1 + l + sovDictionary(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetDictionaryCacheValuesReply) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovDictionary(uint64(l))
+	}
+	if len(m.Values) > 0 {
+		for _, e := range m.Values {
+			l = e.Size()
+			n += 1 + l + sovDictionary(uint64(l))
+		}
+	}
+	if m.Success {
+		n += 2
+	}
+	if m.Version != 0 {
+		n += 1 + sovDictionary(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *SetDictionaryCacheValuesMessage) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovDictionary(uint64(l))
+	}
+	if len(m.Values) > 0 {
+		for _, e := range m.Values {
+			l = e.Size()
+			n += 1 + l + sovDictionary(uint64(l))
+		}
+	}
+	if m.Create {
+		n += 2
+	}
+	l = types.SizeOfStdDuration(m.TTL)
+	n += 1 + l + sovDictionary(uint64(l))
+	if m.Sliding {
+		n += 2
+	}
+	if m.Version != 0 {
+		n += 1 + sovDictionary(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *SetDictionaryCacheValuesReply) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovDictionary(uint64(l))
+	}
+	if m.Added != 0 {
+		n += 1 + sovDictionary(uint64(m.Added))
+	}
+	if m.Updated != 0 {
+		n += 1 + sovDictionary(uint64(m.Updated))
+	}
+	if m.Success {
+		n += 2
+	}
+	if m.Created {
+		n += 2
+	}
+	if m.Version != 0 {
+		n += 1 + sovDictionary(uint64(m.Version))
+	}
+	if m.Conflict {
+		n += 2
+	}
+	return n
+}
+
+func (m *DeleteDictionaryCacheValuesMessage) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovDictionary(uint64(l))
+	}
+	if len(m.SubKeys) > 0 {
+		for _, s := range m.SubKeys {
+			l = len(s)
+			n += 1 + l + sovDictionary(uint64(l))
+		}
+	}
+	if m.Version != 0 {
+		n += 1 + sovDictionary(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *DeleteDictionaryCacheValuesReply) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovDictionary(uint64(l))
+	}
+	if len(m.DeletedValues) > 0 {
+		for _, e := range m.DeletedValues {
+			l = e.Size()
+			n += 1 + l + sovDictionary(uint64(l))
+		}
+	}
+	if m.Success {
+		n += 2
+	}
+	if m.Version != 0 {
+		n += 1 + sovDictionary(uint64(m.Version))
+	}
+	if m.Conflict {
+		n += 2
+	}
+	return n
+}
+
+func (m *GetDictionaryCacheSubKeysMessage) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovDictionary(uint64(l))
+	}
+	return n
+}
+
+func (m *GetDictionaryCacheSubKeysReply) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovDictionary(uint64(l))
+	}
+	if len(m.SubKeys) > 0 {
+		for _, s := range m.SubKeys {
+			l = len(s)
+			n += 1 + l + sovDictionary(uint64(l))
+		}
+	}
+	if m.Success {
+		n += 2
+	}
+	if m.Version != 0 {
+		n += 1 + sovDictionary(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *ContainsDictionaryCacheValuesMessage) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovDictionary(uint64(l))
+	}
+	if len(m.SubKeys) > 0 {
+		for _, s := range m.SubKeys {
+			l = len(s)
+			n += 1 + l + sovDictionary(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ContainsDictionaryCacheValuesReply) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovDictionary(uint64(l))
+	}
+	if len(m.SubKeys) > 0 {
+		for _, s := range m.SubKeys {
+			l = len(s)
+			n += 1 + l + sovDictionary(uint64(l))
+		}
+	}
+	if len(m.Exists) > 0 {
+		n += 1 + sovDictionary(uint64(len(m.Exists))) + len(m.Exists)*1
+	}
+	if m.Success {
+		n += 2
+	}
+	return n
+}
+
+func sovDictionary(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozDictionary(x uint64) (n int) {
+	return sovDictionary(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *GetDictionaryCacheKeyMessage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetDictionaryCacheKeyMessage{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetDictionaryCacheKeyReply) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetDictionaryCacheKeyReply{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`Values:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Values), "KeyValue", "KeyValue", 1), `&`, ``, 1) + `,`,
+		`Success:` + fmt.Sprintf("%v", this.Success) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeleteDictionaryCacheKeyMessage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeleteDictionaryCacheKeyMessage{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeleteDictionaryCacheKeyReply) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeleteDictionaryCacheKeyReply{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`DeletedValues:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.DeletedValues), "KeyValue", "KeyValue", 1), `&`, ``, 1) + `,`,
+		`Success:` + fmt.Sprintf("%v", this.Success) + `,`,
+		`Conflict:` + fmt.Sprintf("%v", this.Conflict) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PostDictionaryCacheKeyMessage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PostDictionaryCacheKeyMessage{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`Values:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Values), "KeyValue", "KeyValue", 1), `&`, ``, 1) + `,`,
+		`TTL:` + strings.Replace(strings.Replace(this.TTL.String(), "Duration", "google_protobuf1.Duration", 1), `&`, ``, 1) + `,`,
+		`Sliding:` + fmt.Sprintf("%v", this.Sliding) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PostDictionaryCacheKeyReply) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PostDictionaryCacheKeyReply{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`Success:` + fmt.Sprintf("%v", this.Success) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PutDictionaryCacheValueMessage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PutDictionaryCacheValueMessage{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`SubKey:` + fmt.Sprintf("%v", this.SubKey) + `,`,
+		`NewValue:` + fmt.Sprintf("%v", this.NewValue) + `,`,
+		`OriginalValue:` + fmt.Sprintf("%v", this.OriginalValue) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PutDictionaryCacheValueReply) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PutDictionaryCacheValueReply{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`SubKey:` + fmt.Sprintf("%v", this.SubKey) + `,`,
+		`Success:` + fmt.Sprintf("%v", this.Success) + `,`,
+		`NewValue:` + fmt.Sprintf("%v", this.NewValue) + `,`,
+		`OriginalValue:` + fmt.Sprintf("%v", this.OriginalValue) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`Conflict:` + fmt.Sprintf("%v", this.Conflict) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeleteDictionaryCacheValueMessage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeleteDictionaryCacheValueMessage{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`SubKey:` + fmt.Sprintf("%v", this.SubKey) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeleteDictionaryCacheValueReply) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeleteDictionaryCacheValueReply{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`SubKey:` + fmt.Sprintf("%v", this.SubKey) + `,`,
+		`DeletedValue:` + strings.Replace(strings.Replace(this.DeletedValue.String(), "KeyValue", "KeyValue", 1), `&`, ``, 1) + `,`,
+		`Success:` + fmt.Sprintf("%v", this.Success) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`Conflict:` + fmt.Sprintf("%v", this.Conflict) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PostDictionaryCacheValueMessage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PostDictionaryCacheValueMessage{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`NewValue:` + strings.Replace(strings.Replace(this.NewValue.String(), "KeyValue", "KeyValue", 1), `&`, ``, 1) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`TTL:` + strings.Replace(strings.Replace(this.TTL.String(), "Duration", "google_protobuf1.Duration", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PostDictionaryCacheValueReply) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PostDictionaryCacheValueReply{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`Success:` + fmt.Sprintf("%v", this.Success) + `,`,
+		`AddedValue:` + strings.Replace(strings.Replace(this.AddedValue.String(), "KeyValue", "KeyValue", 1), `&`, ``, 1) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`Conflict:` + fmt.Sprintf("%v", this.Conflict) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *IncrementDictionaryCacheValueMessage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&IncrementDictionaryCacheValueMessage{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`SubKey:` + fmt.Sprintf("%v", this.SubKey) + `,`,
+		`Delta:` + fmt.Sprintf("%v", this.Delta) + `,`,
+		`FloatDelta:` + fmt.Sprintf("%v", this.FloatDelta) + `,`,
+		`Float:` + fmt.Sprintf("%v", this.Float) + `,`,
+		`Create:` + fmt.Sprintf("%v", this.Create) + `,`,
+		`TTL:` + strings.Replace(strings.Replace(this.TTL.String(), "Duration", "google_protobuf1.Duration", 1), `&`, ``, 1) + `,`,
+		`Sliding:` + fmt.Sprintf("%v", this.Sliding) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *IncrementDictionaryCacheValueReply) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&IncrementDictionaryCacheValueReply{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`SubKey:` + fmt.Sprintf("%v", this.SubKey) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`Success:` + fmt.Sprintf("%v", this.Success) + `,`,
+		`Created:` + fmt.Sprintf("%v", this.Created) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`Conflict:` + fmt.Sprintf("%v", this.Conflict) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetDictionaryCacheValuesMessage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetDictionaryCacheValuesMessage{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`SubKeys:` + fmt.Sprintf("%v", this.SubKeys) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetDictionaryCacheValuesReply) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetDictionaryCacheValuesReply{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`Values:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Values), "KeyValue", "KeyValue", 1), `&`, ``, 1) + `,`,
+		`Success:` + fmt.Sprintf("%v", this.Success) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SetDictionaryCacheValuesMessage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SetDictionaryCacheValuesMessage{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`Values:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Values), "KeyValue", "KeyValue", 1), `&`, ``, 1) + `,`,
+		`Create:` + fmt.Sprintf("%v", this.Create) + `,`,
+		`TTL:` + strings.Replace(strings.Replace(this.TTL.String(), "Duration", "google_protobuf1.Duration", 1), `&`, ``, 1) + `,`,
+		`Sliding:` + fmt.Sprintf("%v", this.Sliding) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SetDictionaryCacheValuesReply) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SetDictionaryCacheValuesReply{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`Added:` + fmt.Sprintf("%v", this.Added) + `,`,
+		`Updated:` + fmt.Sprintf("%v", this.Updated) + `,`,
+		`Success:` + fmt.Sprintf("%v", this.Success) + `,`,
+		`Created:` + fmt.Sprintf("%v", this.Created) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`Conflict:` + fmt.Sprintf("%v", this.Conflict) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeleteDictionaryCacheValuesMessage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeleteDictionaryCacheValuesMessage{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`SubKeys:` + fmt.Sprintf("%v", this.SubKeys) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeleteDictionaryCacheValuesReply) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeleteDictionaryCacheValuesReply{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`DeletedValues:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.DeletedValues), "KeyValue", "KeyValue", 1), `&`, ``, 1) + `,`,
+		`Success:` + fmt.Sprintf("%v", this.Success) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`Conflict:` + fmt.Sprintf("%v", this.Conflict) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetDictionaryCacheSubKeysMessage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetDictionaryCacheSubKeysMessage{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetDictionaryCacheSubKeysReply) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetDictionaryCacheSubKeysReply{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`SubKeys:` + fmt.Sprintf("%v", this.SubKeys) + `,`,
+		`Success:` + fmt.Sprintf("%v", this.Success) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ContainsDictionaryCacheValuesMessage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ContainsDictionaryCacheValuesMessage{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`SubKeys:` + fmt.Sprintf("%v", this.SubKeys) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ContainsDictionaryCacheValuesReply) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ContainsDictionaryCacheValuesReply{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`SubKeys:` + fmt.Sprintf("%v", this.SubKeys) + `,`,
+		`Exists:` + fmt.Sprintf("%v", this.Exists) + `,`,
+		`Success:` + fmt.Sprintf("%v", this.Success) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringDictionary(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *GetDictionaryCacheKeyMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDictionary
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetDictionaryCacheKeyMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetDictionaryCacheKeyMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDictionary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDictionary
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDictionary(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDictionary
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetDictionaryCacheKeyReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDictionary
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetDictionaryCacheKeyReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetDictionaryCacheKeyReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDictionary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDictionary
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDictionary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDictionary
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Values = append(m.Values, KeyValue{})
+			if err := m.Values[len(m.Values)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDictionary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDictionary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDictionary(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDictionary
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteDictionaryCacheKeyMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDictionary
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteDictionaryCacheKeyMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteDictionaryCacheKeyMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDictionary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDictionary
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDictionary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDictionary(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDictionary
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteDictionaryCacheKeyReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDictionary
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteDictionaryCacheKeyReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteDictionaryCacheKeyReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDictionary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDictionary
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletedValues", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDictionary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDictionary
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeletedValues = append(m.DeletedValues, KeyValue{})
+			if err := m.DeletedValues[len(m.DeletedValues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDictionary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conflict", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDictionary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Conflict = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDictionary(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDictionary
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PostDictionaryCacheKeyMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDictionary
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PostDictionaryCacheKeyMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PostDictionaryCacheKeyMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDictionary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDictionary
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDictionary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDictionary
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Values = append(m.Values, KeyValue{})
+			if err := m.Values[len(m.Values)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TTL", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDictionary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDictionary
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := types.StdDurationUnmarshal(&m.TTL, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sliding", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDictionary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Sliding = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDictionary(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDictionary
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PostDictionaryCacheKeyReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDictionary
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PostDictionaryCacheKeyReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PostDictionaryCacheKeyReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDictionary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDictionary
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDictionary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDictionary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDictionary(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDictionary
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PutDictionaryCacheValueMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDictionary
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PutDictionaryCacheValueMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PutDictionaryCacheValueMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDictionary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDictionary
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDictionary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDictionary
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDictionary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDictionary
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDictionary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDictionary
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDictionary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDictionary(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDictionary
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PutDictionaryCacheValueReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDictionary
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PutDictionaryCacheValueReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PutDictionaryCacheValueReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDictionary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDictionary
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDictionary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDictionary
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDictionary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDictionary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDictionary
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDictionary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDictionary
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDictionary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conflict", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDictionary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Conflict = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDictionary(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDictionary
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteDictionaryCacheValueMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDictionary
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteDictionaryCacheValueMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteDictionaryCacheValueMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDictionary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDictionary
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDictionary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDictionary
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDictionary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDictionary(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDictionary
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteDictionaryCacheValueReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDictionary
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteDictionaryCacheValueReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteDictionaryCacheValueReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDictionary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDictionary
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDictionary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDictionary
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletedValue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDictionary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDictionary
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DeletedValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDictionary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDictionary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conflict", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDictionary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Conflict = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDictionary(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDictionary
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PostDictionaryCacheValueMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDictionary
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PostDictionaryCacheValueMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PostDictionaryCacheValueMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDictionary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDictionary
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewValue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDictionary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDictionary
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NewValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDictionary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TTL", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDictionary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDictionary
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := types.StdDurationUnmarshal(&m.TTL, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDictionary(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDictionary
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PostDictionaryCacheValueReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDictionary
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PostDictionaryCacheValueReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PostDictionaryCacheValueReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDictionary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDictionary
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDictionary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddedValue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDictionary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDictionary
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AddedValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDictionary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conflict", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDictionary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Conflict = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDictionary(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDictionary
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *GetDictionaryCacheKeyMessage) Unmarshal(dAtA []byte) error {
+func (m *IncrementDictionaryCacheValueMessage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1921,10 +6268,10 @@ func (m *GetDictionaryCacheKeyMessage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetDictionaryCacheKeyMessage: wiretype end group for non-group")
+			return fmt.Errorf("proto: IncrementDictionaryCacheValueMessage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetDictionaryCacheKeyMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IncrementDictionaryCacheValueMessage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1956,6 +6303,174 @@ func (m *GetDictionaryCacheKeyMessage) Unmarshal(dAtA []byte) error {
 			}
 			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDictionary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDictionary
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delta", wireType)
+			}
+			m.Delta = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDictionary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Delta |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FloatDelta", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.FloatDelta = float64(math.Float64frombits(v))
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Float", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDictionary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Float = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Create", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDictionary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Create = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TTL", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDictionary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDictionary
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := types.StdDurationUnmarshal(&m.TTL, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sliding", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDictionary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Sliding = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDictionary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDictionary(dAtA[iNdEx:])
@@ -1977,7 +6492,7 @@ func (m *GetDictionaryCacheKeyMessage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetDictionaryCacheKeyReply) Unmarshal(dAtA []byte) error {
+func (m *IncrementDictionaryCacheValueReply) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2000,17 +6515,124 @@ func (m *GetDictionaryCacheKeyReply) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetDictionaryCacheKeyReply: wiretype end group for non-group")
+			return fmt.Errorf("proto: IncrementDictionaryCacheValueReply: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetDictionaryCacheKeyReply: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IncrementDictionaryCacheValueReply: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var stringLen uint64
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDictionary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDictionary
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDictionary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDictionary
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDictionary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDictionary
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDictionary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDictionary
@@ -2020,26 +6642,17 @@ func (m *GetDictionaryCacheKeyReply) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDictionary
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Key = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			m.Created = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var msglen int
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDictionary
@@ -2049,26 +6662,14 @@ func (m *GetDictionaryCacheKeyReply) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Version |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthDictionary
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Values = append(m.Values, &KeyValue{})
-			if err := m.Values[len(m.Values)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Conflict", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -2085,7 +6686,36 @@ func (m *GetDictionaryCacheKeyReply) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Success = bool(v != 0)
+			m.Conflict = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDictionary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDictionary
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDictionary(dAtA[iNdEx:])
@@ -2107,7 +6737,7 @@ func (m *GetDictionaryCacheKeyReply) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteDictionaryCacheKeyMessage) Unmarshal(dAtA []byte) error {
+func (m *GetDictionaryCacheValuesMessage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2130,10 +6760,10 @@ func (m *DeleteDictionaryCacheKeyMessage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteDictionaryCacheKeyMessage: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetDictionaryCacheValuesMessage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteDictionaryCacheKeyMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetDictionaryCacheValuesMessage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2165,6 +6795,35 @@ func (m *DeleteDictionaryCacheKeyMessage) Unmarshal(dAtA []byte) error {
 			}
 			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubKeys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDictionary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDictionary
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubKeys = append(m.SubKeys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDictionary(dAtA[iNdEx:])
@@ -2186,7 +6845,7 @@ func (m *DeleteDictionaryCacheKeyMessage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteDictionaryCacheKeyReply) Unmarshal(dAtA []byte) error {
+func (m *GetDictionaryCacheValuesReply) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2209,10 +6868,10 @@ func (m *DeleteDictionaryCacheKeyReply) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteDictionaryCacheKeyReply: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetDictionaryCacheValuesReply: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteDictionaryCacheKeyReply: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetDictionaryCacheValuesReply: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2246,7 +6905,7 @@ func (m *DeleteDictionaryCacheKeyReply) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeletedValues", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2270,8 +6929,8 @@ func (m *DeleteDictionaryCacheKeyReply) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DeletedValues = append(m.DeletedValues, &KeyValue{})
-			if err := m.DeletedValues[len(m.DeletedValues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Values = append(m.Values, KeyValue{})
+			if err := m.Values[len(m.Values)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2295,6 +6954,25 @@ func (m *DeleteDictionaryCacheKeyReply) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Success = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDictionary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDictionary(dAtA[iNdEx:])
@@ -2316,7 +6994,7 @@ func (m *DeleteDictionaryCacheKeyReply) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PostDictionaryCacheKeyMessage) Unmarshal(dAtA []byte) error {
+func (m *SetDictionaryCacheValuesMessage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2339,10 +7017,10 @@ func (m *PostDictionaryCacheKeyMessage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PostDictionaryCacheKeyMessage: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetDictionaryCacheValuesMessage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PostDictionaryCacheKeyMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetDictionaryCacheValuesMessage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2400,12 +7078,32 @@ func (m *PostDictionaryCacheKeyMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Values = append(m.Values, &KeyValue{})
+			m.Values = append(m.Values, KeyValue{})
 			if err := m.Values[len(m.Values)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Create", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDictionary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Create = bool(v != 0)
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TTL", wireType)
 			}
@@ -2431,13 +7129,49 @@ func (m *PostDictionaryCacheKeyMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TTL == nil {
-				m.TTL = &google_protobuf.Duration{}
-			}
-			if err := m.TTL.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := types.StdDurationUnmarshal(&m.TTL, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sliding", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDictionary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Sliding = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDictionary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDictionary(dAtA[iNdEx:])
@@ -2459,7 +7193,7 @@ func (m *PostDictionaryCacheKeyMessage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PostDictionaryCacheKeyReply) Unmarshal(dAtA []byte) error {
+func (m *SetDictionaryCacheValuesReply) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2482,10 +7216,10 @@ func (m *PostDictionaryCacheKeyReply) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PostDictionaryCacheKeyReply: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetDictionaryCacheValuesReply: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PostDictionaryCacheKeyReply: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetDictionaryCacheValuesReply: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2515,11 +7249,108 @@ func (m *PostDictionaryCacheKeyReply) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Added", wireType)
+			}
+			m.Added = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDictionary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Added |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Updated", wireType)
+			}
+			m.Updated = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDictionary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Updated |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDictionary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDictionary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Created = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDictionary
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Conflict", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -2536,7 +7367,7 @@ func (m *PostDictionaryCacheKeyReply) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Success = bool(v != 0)
+			m.Conflict = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDictionary(dAtA[iNdEx:])
@@ -2558,7 +7389,7 @@ func (m *PostDictionaryCacheKeyReply) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PutDictionaryCacheValueMessage) Unmarshal(dAtA []byte) error {
+func (m *DeleteDictionaryCacheValuesMessage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2581,10 +7412,10 @@ func (m *PutDictionaryCacheValueMessage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PutDictionaryCacheValueMessage: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteDictionaryCacheValuesMessage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PutDictionaryCacheValueMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteDictionaryCacheValuesMessage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2618,7 +7449,7 @@ func (m *PutDictionaryCacheValueMessage) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubKeys", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2643,42 +7474,13 @@ func (m *PutDictionaryCacheValueMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SubKey = string(dAtA[iNdEx:postIndex])
+			m.SubKeys = append(m.SubKeys, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewValue", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDictionary
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDictionary
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NewValue = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginalValue", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var stringLen uint64
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDictionary
@@ -2688,21 +7490,11 @@ func (m *PutDictionaryCacheValueMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.Version |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDictionary
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OriginalValue = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDictionary(dAtA[iNdEx:])
@@ -2724,7 +7516,7 @@ func (m *PutDictionaryCacheValueMessage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PutDictionaryCacheValueReply) Unmarshal(dAtA []byte) error {
+func (m *DeleteDictionaryCacheValuesReply) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2747,10 +7539,10 @@ func (m *PutDictionaryCacheValueReply) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PutDictionaryCacheValueReply: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteDictionaryCacheValuesReply: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PutDictionaryCacheValueReply: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteDictionaryCacheValuesReply: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2784,9 +7576,9 @@ func (m *PutDictionaryCacheValueReply) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletedValues", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDictionary
@@ -2796,20 +7588,22 @@ func (m *PutDictionaryCacheValueReply) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDictionary
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SubKey = string(dAtA[iNdEx:postIndex])
+			m.DeletedValues = append(m.DeletedValues, KeyValue{})
+			if err := m.DeletedValues[len(m.DeletedValues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
@@ -2832,10 +7626,10 @@ func (m *PutDictionaryCacheValueReply) Unmarshal(dAtA []byte) error {
 			}
 			m.Success = bool(v != 0)
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewValue", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var stringLen uint64
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDictionary
@@ -2845,26 +7639,16 @@ func (m *PutDictionaryCacheValueReply) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.Version |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDictionary
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NewValue = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginalValue", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conflict", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDictionary
@@ -2874,21 +7658,12 @@ func (m *PutDictionaryCacheValueReply) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDictionary
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OriginalValue = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Conflict = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDictionary(dAtA[iNdEx:])
@@ -2910,7 +7685,7 @@ func (m *PutDictionaryCacheValueReply) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteDictionaryCacheValueMessage) Unmarshal(dAtA []byte) error {
+func (m *GetDictionaryCacheSubKeysMessage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2933,10 +7708,10 @@ func (m *DeleteDictionaryCacheValueMessage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteDictionaryCacheValueMessage: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetDictionaryCacheSubKeysMessage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteDictionaryCacheValueMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetDictionaryCacheSubKeysMessage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2968,35 +7743,6 @@ func (m *DeleteDictionaryCacheValueMessage) Unmarshal(dAtA []byte) error {
 			}
 			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubKey", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDictionary
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDictionary
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SubKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDictionary(dAtA[iNdEx:])
@@ -3018,7 +7764,7 @@ func (m *DeleteDictionaryCacheValueMessage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteDictionaryCacheValueReply) Unmarshal(dAtA []byte) error {
+func (m *GetDictionaryCacheSubKeysReply) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3041,10 +7787,10 @@ func (m *DeleteDictionaryCacheValueReply) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteDictionaryCacheValueReply: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetDictionaryCacheSubKeysReply: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteDictionaryCacheValueReply: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetDictionaryCacheSubKeysReply: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3078,7 +7824,7 @@ func (m *DeleteDictionaryCacheValueReply) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubKeys", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3103,13 +7849,13 @@ func (m *DeleteDictionaryCacheValueReply) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SubKey = string(dAtA[iNdEx:postIndex])
+			m.SubKeys = append(m.SubKeys, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeletedValue", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDictionary
@@ -3119,30 +7865,17 @@ func (m *DeleteDictionaryCacheValueReply) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthDictionary
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.DeletedValue == nil {
-				m.DeletedValue = &KeyValue{}
-			}
-			if err := m.DeletedValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Success = bool(v != 0)
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var v int
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDictionary
@@ -3152,12 +7885,11 @@ func (m *DeleteDictionaryCacheValueReply) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.Version |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Success = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDictionary(dAtA[iNdEx:])
@@ -3179,7 +7911,7 @@ func (m *DeleteDictionaryCacheValueReply) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PostDictionaryCacheValueMessage) Unmarshal(dAtA []byte) error {
+func (m *ContainsDictionaryCacheValuesMessage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3202,10 +7934,10 @@ func (m *PostDictionaryCacheValueMessage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PostDictionaryCacheValueMessage: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContainsDictionaryCacheValuesMessage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PostDictionaryCacheValueMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContainsDictionaryCacheValuesMessage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3239,9 +7971,9 @@ func (m *PostDictionaryCacheValueMessage) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubKeys", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDictionary
@@ -3251,24 +7983,20 @@ func (m *PostDictionaryCacheValueMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDictionary
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NewValue == nil {
-				m.NewValue = &KeyValue{}
-			}
-			if err := m.NewValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SubKeys = append(m.SubKeys, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3291,7 +8019,7 @@ func (m *PostDictionaryCacheValueMessage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PostDictionaryCacheValueReply) Unmarshal(dAtA []byte) error {
+func (m *ContainsDictionaryCacheValuesReply) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3314,10 +8042,10 @@ func (m *PostDictionaryCacheValueReply) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PostDictionaryCacheValueReply: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContainsDictionaryCacheValuesReply: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PostDictionaryCacheValueReply: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContainsDictionaryCacheValuesReply: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3350,10 +8078,10 @@ func (m *PostDictionaryCacheValueReply) Unmarshal(dAtA []byte) error {
 			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubKeys", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDictionary
@@ -3363,17 +8091,88 @@ func (m *PostDictionaryCacheValueReply) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Success = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDictionary
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubKeys = append(m.SubKeys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AddedValue", wireType)
+			if wireType == 0 {
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDictionary
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Exists = append(m.Exists, bool(v != 0))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDictionary
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthDictionary
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDictionary
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (int(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Exists = append(m.Exists, bool(v != 0))
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exists", wireType)
 			}
-			var msglen int
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDictionary
@@ -3383,25 +8182,12 @@ func (m *PostDictionaryCacheValueReply) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthDictionary
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.AddedValue == nil {
-				m.AddedValue = &KeyValue{}
-			}
-			if err := m.AddedValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Success = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDictionary(dAtA[iNdEx:])
@@ -3531,35 +8317,61 @@ var (
 func init() { proto.RegisterFile("dictionary.proto", fileDescriptorDictionary) }
 
 var fileDescriptorDictionary = []byte{
-	// 478 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x9c, 0x93, 0xbf, 0x8e, 0xd3, 0x40,
-	0x10, 0xc6, 0xbd, 0x76, 0x08, 0xb9, 0x39, 0x0e, 0x9d, 0xb6, 0x40, 0xc6, 0xdc, 0xed, 0x05, 0x8b,
-	0x22, 0x02, 0xe4, 0x43, 0x39, 0x09, 0xd1, 0x02, 0x91, 0x10, 0x0a, 0x81, 0xc8, 0x89, 0xe8, 0x1d,
-	0x7b, 0x31, 0x96, 0x4c, 0x1c, 0xf9, 0x8f, 0x90, 0x2b, 0x68, 0x68, 0x10, 0x05, 0x2f, 0x40, 0x47,
-	0xc1, 0xa3, 0x50, 0xa6, 0xa4, 0x24, 0xa6, 0xa1, 0xcc, 0x23, 0x20, 0xaf, 0xed, 0xc8, 0xe6, 0xbc,
-	0xb1, 0x92, 0xce, 0x33, 0xfa, 0x66, 0xf7, 0x37, 0x9f, 0xbf, 0x85, 0x63, 0xcb, 0x31, 0x43, 0xc7,
-	0x9b, 0x1b, 0x7e, 0xac, 0x2d, 0x7c, 0x2f, 0xf4, 0x70, 0xe7, 0x1d, 0x0d, 0x02, 0xc3, 0xa6, 0x81,
-	0x42, 0x6c, 0xcf, 0xb3, 0x5d, 0x7a, 0xce, 0xfa, 0xb3, 0xe8, 0xcd, 0xb9, 0x15, 0xf9, 0x46, 0x2a,
-	0xce, 0x94, 0xca, 0xf5, 0x21, 0x8d, 0x5f, 0x1b, 0x6e, 0x44, 0xb3, 0x5a, 0x7d, 0x00, 0x27, 0xcf,
-	0x68, 0x38, 0xd8, 0x1c, 0xf8, 0xd4, 0x30, 0xdf, 0xd2, 0x21, 0x8d, 0x47, 0xd9, 0x81, 0xf8, 0x18,
-	0xa4, 0x21, 0x8d, 0x65, 0xd4, 0x45, 0xbd, 0x03, 0x3d, 0xfd, 0x54, 0x43, 0x50, 0x6a, 0x27, 0x74,
-	0xba, 0x70, 0xe3, 0xcb, 0x7a, 0x7c, 0x17, 0xda, 0xec, 0xc2, 0x40, 0x16, 0xbb, 0x52, 0xef, 0xb0,
-	0x8f, 0xb5, 0x02, 0x56, 0x2b, 0x58, 0xf4, 0x5c, 0x81, 0x65, 0xb8, 0x3a, 0x89, 0x4c, 0x93, 0x06,
-	0x81, 0x2c, 0x75, 0x51, 0xaf, 0xa3, 0x17, 0xa5, 0x7a, 0x01, 0x67, 0x03, 0xea, 0xd2, 0x90, 0xee,
-	0x82, 0xfa, 0x09, 0xc1, 0x29, 0x6f, 0x8a, 0x87, 0xfb, 0x08, 0x8e, 0xb2, 0x11, 0xab, 0x91, 0xba,
-	0x2a, 0xdc, 0x02, 0xff, 0x19, 0xc1, 0xe9, 0xd8, 0x0b, 0x76, 0xb1, 0x79, 0x27, 0xdb, 0xee, 0x81,
-	0x34, 0x9d, 0xbe, 0x60, 0xb7, 0x1e, 0xf6, 0x6f, 0x6a, 0x59, 0x04, 0xb4, 0x22, 0x02, 0xda, 0x20,
-	0x8f, 0x80, 0x9e, 0xaa, 0xd4, 0xe7, 0x70, 0xab, 0x9e, 0x85, 0xe7, 0x48, 0x69, 0x2f, 0xb1, 0xba,
-	0xd7, 0x17, 0x04, 0x64, 0x1c, 0xfd, 0x7f, 0x14, 0x83, 0xe2, 0x2f, 0x76, 0x03, 0xda, 0x93, 0x68,
-	0x96, 0x36, 0x45, 0xd6, 0xcc, 0x2b, 0xac, 0x40, 0xe7, 0x25, 0x7d, 0xcf, 0x86, 0xd9, 0x26, 0x07,
-	0xfa, 0xa6, 0xc6, 0x77, 0xe0, 0xe8, 0x95, 0xef, 0xd8, 0xce, 0xdc, 0x70, 0x33, 0x41, 0x8b, 0x09,
-	0xaa, 0x4d, 0xf5, 0x3b, 0x82, 0x13, 0x0e, 0x0e, 0x6f, 0x37, 0x1e, 0x0c, 0xf7, 0x5f, 0x56, 0x30,
-	0x5b, 0x4d, 0x98, 0x57, 0xea, 0x30, 0x47, 0x70, 0xbb, 0x36, 0x94, 0xfb, 0xf9, 0xa6, 0x7e, 0x43,
-	0x9c, 0xa7, 0xb1, 0xd7, 0xe2, 0x0f, 0xe1, 0x5a, 0x39, 0xd5, 0x79, 0xa6, 0xea, 0xc2, 0x57, 0xd1,
-	0x95, 0x0d, 0x6b, 0x55, 0x43, 0x62, 0xc2, 0x59, 0x4d, 0xde, 0x1a, 0x96, 0xd5, 0x4a, 0x2e, 0x8b,
-	0x5c, 0x84, 0x8d, 0x46, 0xfd, 0x50, 0xfb, 0xc0, 0xb6, 0x3a, 0xc0, 0x8d, 0x35, 0xee, 0x03, 0x3c,
-	0xb6, 0xac, 0x66, 0x07, 0x4a, 0xaa, 0x27, 0xf7, 0x97, 0x2b, 0x22, 0xfc, 0x5a, 0x11, 0x61, 0xbd,
-	0x22, 0xe8, 0x63, 0x42, 0xd0, 0x8f, 0x84, 0xa0, 0x9f, 0x09, 0x41, 0xcb, 0x84, 0xa0, 0xdf, 0x09,
-	0x41, 0x7f, 0x13, 0x22, 0xac, 0x13, 0x82, 0xbe, 0xfe, 0x21, 0xc2, 0xac, 0xcd, 0xde, 0xe6, 0xc5,
-	0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x75, 0x08, 0x0a, 0x6a, 0xca, 0x05, 0x00, 0x00,
+	// 885 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0x41, 0x6f, 0xd3, 0x4a,
+	0x10, 0xce, 0xc6, 0x71, 0x9a, 0x4c, 0x5f, 0x9f, 0xaa, 0xa8, 0xaa, 0xf2, 0xf2, 0x1a, 0x27, 0xcf,
+	0xea, 0x21, 0x87, 0x47, 0x5a, 0x95, 0x22, 0x71, 0x40, 0x48, 0x34, 0x29, 0x08, 0x95, 0x42, 0xe5,
+	0x94, 0xde, 0x1d, 0x7b, 0xeb, 0x1a, 0xb9, 0x76, 0x65, 0x3b, 0x82, 0xdc, 0x2a, 0x7e, 0x01, 0x07,
+	0x24, 0xb8, 0x71, 0x03, 0x7e, 0x00, 0x27, 0xb8, 0x72, 0xa8, 0xc4, 0xa5, 0x47, 0xe0, 0x00, 0x34,
+	0x70, 0xe8, 0xb1, 0x3f, 0x01, 0x79, 0xd7, 0x4e, 0xed, 0x34, 0xeb, 0xd4, 0x51, 0x55, 0x6e, 0xf9,
+	0xd6, 0xb3, 0x3b, 0xf3, 0xcd, 0x7c, 0x33, 0xbb, 0x81, 0x69, 0x55, 0x57, 0x5c, 0xdd, 0x32, 0x65,
+	0xbb, 0x5b, 0xdf, 0xb3, 0x2d, 0xd7, 0x2a, 0xe4, 0x76, 0xb1, 0xe3, 0xc8, 0x1a, 0x76, 0x4a, 0x57,
+	0x34, 0xdd, 0xdd, 0xe9, 0xb4, 0xeb, 0x8a, 0xb5, 0xbb, 0xa0, 0x59, 0x9a, 0xb5, 0x40, 0x0c, 0xda,
+	0x9d, 0x6d, 0x82, 0x08, 0x20, 0xbf, 0xe8, 0xc6, 0x92, 0xa0, 0x59, 0x96, 0x66, 0xe0, 0x53, 0x2b,
+	0xb5, 0x63, 0xcb, 0xde, 0xd9, 0xfe, 0xf7, 0xbf, 0xd7, 0x70, 0x77, 0x4b, 0x36, 0x3a, 0x98, 0x62,
+	0x71, 0x11, 0xe6, 0xee, 0x60, 0xb7, 0xd9, 0xf7, 0xdf, 0x90, 0x95, 0x1d, 0xbc, 0x86, 0xbb, 0xeb,
+	0xd4, 0x7f, 0x61, 0x1a, 0xb8, 0x35, 0xdc, 0x2d, 0xa2, 0x2a, 0xaa, 0xe5, 0x25, 0xef, 0xa7, 0xf8,
+	0x1c, 0x41, 0x69, 0xe8, 0x16, 0x09, 0xef, 0x19, 0xdd, 0xb3, 0x1b, 0x0a, 0x8b, 0x90, 0x25, 0x1e,
+	0x9d, 0x62, 0xba, 0xca, 0xd5, 0x26, 0x97, 0x0a, 0xf5, 0x80, 0x5c, 0x3d, 0x08, 0x66, 0x25, 0x73,
+	0xf0, 0xad, 0x92, 0x92, 0x7c, 0xbb, 0x42, 0x11, 0x26, 0x5a, 0x1d, 0x45, 0xc1, 0x8e, 0x53, 0xe4,
+	0xaa, 0xa8, 0x96, 0x93, 0x02, 0xe8, 0x7d, 0xd9, 0xc2, 0xb6, 0xa3, 0x5b, 0x66, 0x31, 0x53, 0x45,
+	0x35, 0x4e, 0x0a, 0xa0, 0xb8, 0x0e, 0x95, 0x26, 0x36, 0xb0, 0x8b, 0x13, 0x70, 0x09, 0x1f, 0x97,
+	0x8e, 0x1e, 0xf7, 0x06, 0x41, 0x99, 0x75, 0x1e, 0x8b, 0xe8, 0x4d, 0x98, 0xa2, 0x5b, 0xd4, 0x73,
+	0xf2, 0x8d, 0x9a, 0xc7, 0xd0, 0x2e, 0x41, 0xae, 0x61, 0x99, 0xdb, 0x86, 0xae, 0xb8, 0x84, 0x77,
+	0x4e, 0xea, 0x63, 0xf1, 0x1d, 0x82, 0xf2, 0x86, 0xe5, 0x24, 0xa9, 0xe1, 0x18, 0x25, 0xb9, 0x06,
+	0xdc, 0xe6, 0xe6, 0x3d, 0x12, 0xd7, 0xe4, 0xd2, 0x3f, 0x75, 0xaa, 0xb2, 0x7a, 0xa0, 0xb2, 0x7a,
+	0xd3, 0x57, 0xd9, 0x4a, 0xce, 0xdb, 0xf5, 0xf2, 0x7b, 0x05, 0x49, 0x9e, 0x3d, 0xa1, 0x64, 0xe8,
+	0xaa, 0x6e, 0x6a, 0x7e, 0xdc, 0x01, 0x14, 0x15, 0xf8, 0x77, 0x78, 0xd4, 0xac, 0xec, 0x86, 0xb2,
+	0x93, 0x66, 0x8a, 0x82, 0x8b, 0x56, 0xf1, 0x35, 0x02, 0x61, 0xa3, 0x33, 0xe8, 0x84, 0x50, 0x62,
+	0x27, 0x67, 0x16, 0xb2, 0xad, 0x4e, 0xdb, 0x5b, 0x4c, 0x93, 0x45, 0x1f, 0x79, 0x45, 0xb8, 0x8f,
+	0x1f, 0x93, 0xcd, 0xc4, 0x4f, 0x5e, 0xea, 0xe3, 0xc2, 0x3c, 0x4c, 0x3d, 0xb0, 0x75, 0x4d, 0x37,
+	0x65, 0x83, 0x1a, 0x64, 0x88, 0x41, 0x74, 0x31, 0x1c, 0x28, 0x1f, 0x0d, 0xf4, 0x2b, 0x82, 0x39,
+	0x46, 0xa0, 0xac, 0x7c, 0xb0, 0xc2, 0x8c, 0x55, 0x51, 0x9f, 0x40, 0x66, 0x14, 0x01, 0x7e, 0x04,
+	0x81, 0x6c, 0x84, 0x40, 0x44, 0xa1, 0x13, 0x03, 0x0a, 0xd5, 0xe0, 0xbf, 0xa1, 0xad, 0x34, 0x66,
+	0x1d, 0xd8, 0xe5, 0xfe, 0x82, 0xa0, 0xc2, 0xf6, 0x94, 0x34, 0x91, 0x37, 0xe0, 0xaf, 0x70, 0x7f,
+	0xfa, 0xda, 0x67, 0xb7, 0x4a, 0xc4, 0x3a, 0x5c, 0x86, 0x0c, 0x53, 0xae, 0x3c, 0x3b, 0x89, 0xd9,
+	0x81, 0x24, 0xbe, 0x47, 0x50, 0x19, 0xd2, 0x30, 0x23, 0x72, 0xb8, 0x1c, 0x2a, 0x79, 0x7a, 0x44,
+	0xfc, 0xa7, 0x62, 0x60, 0x66, 0x38, 0x18, 0x03, 0x99, 0x64, 0x63, 0x40, 0xfc, 0x30, 0x7c, 0x46,
+	0xc5, 0x96, 0x85, 0xdd, 0xef, 0xd7, 0x01, 0x6e, 0xa9, 0xea, 0x79, 0xcb, 0x12, 0xb2, 0x65, 0x5f,
+	0x1f, 0x91, 0xd4, 0xf3, 0x03, 0xa9, 0x7f, 0x95, 0x86, 0xf9, 0xbb, 0xa6, 0x62, 0xe3, 0x5d, 0x6c,
+	0x5e, 0xd0, 0x2c, 0x99, 0x01, 0xbe, 0x89, 0x0d, 0x57, 0xf6, 0xf3, 0x4b, 0x41, 0x41, 0x00, 0xb8,
+	0x6d, 0x58, 0xb2, 0x4b, 0x3f, 0x79, 0x11, 0x22, 0x29, 0xb4, 0xe2, 0xed, 0x22, 0xc8, 0x8f, 0x90,
+	0x02, 0xcf, 0x47, 0xc3, 0xc6, 0xb2, 0x8b, 0x7d, 0xcd, 0xf8, 0x28, 0xa8, 0xd5, 0xc4, 0xf8, 0x23,
+	0x3b, 0x17, 0x19, 0xd9, 0xe1, 0xec, 0xe5, 0xa3, 0x8d, 0xf7, 0x0b, 0x81, 0x18, 0x9b, 0xa1, 0xa4,
+	0xbd, 0x37, 0x03, 0x7c, 0x78, 0xd0, 0xf2, 0xe7, 0xe8, 0x29, 0xca, 0x5a, 0xf5, 0x73, 0x13, 0xc0,
+	0xf1, 0x46, 0x96, 0xe7, 0x7f, 0xd5, 0xb6, 0x2d, 0x9b, 0xa4, 0x20, 0x2f, 0x51, 0xe0, 0xbd, 0x31,
+	0xce, 0xbe, 0x7c, 0xe8, 0x05, 0x19, 0xfb, 0xc6, 0xa0, 0xa4, 0xe8, 0x65, 0x9b, 0x97, 0x02, 0x28,
+	0xbe, 0x40, 0x50, 0x66, 0x9d, 0xf7, 0x67, 0x1f, 0x53, 0xc7, 0x08, 0x2a, 0xad, 0xc4, 0x4c, 0x93,
+	0xc7, 0x76, 0x2a, 0x5d, 0x6e, 0x98, 0x74, 0x33, 0xe3, 0x4b, 0x97, 0x67, 0x4a, 0x37, 0xaa, 0x02,
+	0xf1, 0x13, 0x82, 0x72, 0x2b, 0x61, 0x11, 0x66, 0x80, 0x27, 0x43, 0x85, 0x88, 0x96, 0x97, 0x28,
+	0xf0, 0x7c, 0x3c, 0xdc, 0x53, 0x89, 0x06, 0x39, 0xb2, 0x1e, 0xc0, 0xcb, 0xd3, 0xad, 0xf8, 0x08,
+	0x44, 0xf6, 0x05, 0x38, 0x8e, 0x48, 0x63, 0x6e, 0xdb, 0x8f, 0x08, 0xaa, 0x31, 0xce, 0x2e, 0xff,
+	0x95, 0x3c, 0xde, 0x74, 0x5f, 0x86, 0xea, 0xd9, 0x26, 0xf4, 0xd9, 0xb3, 0xff, 0x05, 0xed, 0x23,
+	0x10, 0x98, 0xdb, 0x62, 0xaf, 0x34, 0x56, 0x96, 0x13, 0x37, 0xa9, 0x04, 0xf3, 0x0d, 0xcb, 0x74,
+	0x65, 0xdd, 0x74, 0x2e, 0x6c, 0x24, 0x3d, 0x45, 0x20, 0xc6, 0x1e, 0x9a, 0x9c, 0xda, 0x2c, 0x64,
+	0x57, 0x9f, 0xe8, 0x8e, 0xeb, 0x31, 0xe3, 0xbc, 0x1e, 0xa7, 0x88, 0xdd, 0x14, 0x2b, 0xff, 0x1f,
+	0x1e, 0x09, 0xa9, 0xcf, 0x47, 0x42, 0xea, 0xe4, 0x48, 0x40, 0xfb, 0x3d, 0x01, 0xbd, 0xed, 0x09,
+	0xe8, 0xa0, 0x27, 0xa0, 0xc3, 0x9e, 0x80, 0x7e, 0xf4, 0x04, 0x74, 0xdc, 0x13, 0x52, 0x27, 0x3d,
+	0x01, 0x3d, 0xfb, 0x29, 0xa4, 0xda, 0x59, 0x32, 0x16, 0xae, 0xfe, 0x1e, 0x00, 0x5d, 0x4f, 0xe1,
+	0x4d, 0x45, 0x0f, 0x00, 0x00,
 }
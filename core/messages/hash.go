@@ -0,0 +1,591 @@
+package messages
+
+// Hash is used for partitioning in actor cluster.
+func (m *GetDictionaryCacheKeyMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *DeleteDictionaryCacheKeyMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *PostDictionaryCacheKeyMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *PutDictionaryCacheValueMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *DeleteDictionaryCacheValueMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *PostDictionaryCacheValueMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *GetListCacheKeyMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *DeleteListCacheKeyMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *PostListCacheKeyMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *PutListCacheValueMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *DeleteListCacheValueMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *PostListCacheValueMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *GetListCacheIndexMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *SetListCacheIndexMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *InsertListCacheValueMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *RemoveListCacheIndexMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *GetListCacheRangeMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *PushListCacheValuesMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *PopListCacheValuesMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *TrimListCacheKeyMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *GetStringCacheKeyMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *DeleteStringCacheKeyMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *PostStringCacheKeyMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *PutStringCacheKeyMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *SetStringCacheKeyMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *ReplaceStringCacheKeyMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *CompareAndSwapStringCacheKeyMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *TouchStringCacheKeyMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *GetCacheTTLMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *SetCacheTTLMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *PersistCacheKeyMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *GetCacheMetadataMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *GetSetCacheKeyMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *DeleteSetCacheKeyMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *PostSetCacheKeyMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *PostSetCacheValuesMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *DeleteSetCacheValuesMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *ContainsSetCacheValueMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *GetSetCacheCardinalityMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *GetSetCacheRandomValuesMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *GetSortedSetCacheKeyMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *PostSortedSetCacheKeyMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *DeleteSortedSetCacheKeyMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *PostSortedSetCacheValuesMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *IncrementSortedSetCacheScoreMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *DeleteSortedSetCacheValuesMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *GetSortedSetCacheRangeMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *GetSortedSetCacheRangeByScoreMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *GetSortedSetCacheRankMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *IncrementStringCacheKeyMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *SetBitStringCacheKeyMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *GetBitStringCacheKeyMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *CountBitsStringCacheKeyMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *GetBitPosStringCacheKeyMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *BitOpStringCacheKeyMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *BitFieldStringCacheKeyMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *IncrementDictionaryCacheValueMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *GetDictionaryCacheValuesMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *SetDictionaryCacheValuesMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *DeleteDictionaryCacheValuesMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *GetDictionaryCacheSubKeysMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *ContainsDictionaryCacheValuesMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *GetDocumentCacheKeyMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *PostDocumentCacheKeyMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *DeleteDocumentCacheKeyMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *GetDocumentCachePathMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *SetDocumentCachePathMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *DeleteDocumentCachePathMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *AppendDocumentCacheValuesMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *MergeDocumentCacheMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *PatchDocumentCacheMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *GetHyperLogLogCacheKeyMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *DeleteHyperLogLogCacheKeyMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *PostHyperLogLogCacheKeyMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *AddHyperLogLogCacheValuesMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *MergeHyperLogLogCacheMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *GetBloomFilterCacheKeyMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *DeleteBloomFilterCacheKeyMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *PostBloomFilterCacheKeyMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *AddBloomFilterCacheValuesMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *ExistsBloomFilterCacheValuesMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *GetStreamCacheKeyMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *DeleteStreamCacheKeyMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *PostStreamCacheKeyMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *AppendStreamCacheEntryMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *GetStreamCacheRangeMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *TrimStreamCacheKeyMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *GetStreamCacheGroupsMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *CreateStreamCacheGroupMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *DeleteStreamCacheGroupMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *ReadStreamCacheGroupMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *AckStreamCacheGroupMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *GetStreamCachePendingMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *GetGeoCacheKeyMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *DeleteGeoCacheKeyMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *PostGeoCacheKeyMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *AddGeoCacheMembersMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *RemoveGeoCacheMembersMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *GetGeoCachePositionsMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *GetGeoCacheDistanceMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *SearchGeoCacheMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *GetQueueCacheKeyMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *DeleteQueueCacheKeyMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *PostQueueCacheKeyMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *PushQueueCacheItemsMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *PopQueueCacheItemsMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *PeekQueueCacheItemsMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *AckQueueCacheItemsMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *NackQueueCacheItemMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *GetQueueCacheDeadMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *RedriveQueueCacheDeadMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *GetRateLimitCacheKeyMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *DeleteRateLimitCacheKeyMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *TakeRateLimitCacheKeyMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *GetLockCacheKeyMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *AcquireLockCacheKeyMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *RenewLockCacheKeyMessage) Hash() string {
+	return m.Key
+}
+
+// Hash is used for partitioning in actor cluster.
+func (m *ReleaseLockCacheKeyMessage) Hash() string {
+	return m.Key
+}
@@ -0,0 +1,3316 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: hyperloglog.proto
+
+package messages
+
+import proto "github.com/gogo/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "github.com/gogo/protobuf/gogoproto"
+import _ "github.com/gogo/protobuf/types"
+
+import time "time"
+
+import bytes "bytes"
+
+import strings "strings"
+import reflect "reflect"
+
+import types "github.com/gogo/protobuf/types"
+
+import io "io"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+type GetHyperLogLogCacheKeyMessage struct {
+	Key string `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key,omitempty"`
+}
+
+func (m *GetHyperLogLogCacheKeyMessage) Reset()      { *m = GetHyperLogLogCacheKeyMessage{} }
+func (*GetHyperLogLogCacheKeyMessage) ProtoMessage() {}
+func (*GetHyperLogLogCacheKeyMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptorHyperloglog, []int{0}
+}
+
+func (m *GetHyperLogLogCacheKeyMessage) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+type GetHyperLogLogCacheKeyReply struct {
+	Key     string `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key,omitempty"`
+	Count   int64  `protobuf:"varint,2,opt,name=Count,proto3" json:"Count,omitempty"`
+	Data    []byte `protobuf:"bytes,3,opt,name=Data,proto3" json:"Data,omitempty"`
+	Success bool   `protobuf:"varint,4,opt,name=Success,proto3" json:"Success,omitempty"`
+	Version int64  `protobuf:"varint,5,opt,name=Version,proto3" json:"Version,omitempty"`
+}
+
+func (m *GetHyperLogLogCacheKeyReply) Reset()      { *m = GetHyperLogLogCacheKeyReply{} }
+func (*GetHyperLogLogCacheKeyReply) ProtoMessage() {}
+func (*GetHyperLogLogCacheKeyReply) Descriptor() ([]byte, []int) {
+	return fileDescriptorHyperloglog, []int{1}
+}
+
+func (m *GetHyperLogLogCacheKeyReply) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *GetHyperLogLogCacheKeyReply) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *GetHyperLogLogCacheKeyReply) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *GetHyperLogLogCacheKeyReply) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *GetHyperLogLogCacheKeyReply) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+type DeleteHyperLogLogCacheKeyMessage struct {
+	Key     string `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key,omitempty"`
+	Version int64  `protobuf:"varint,2,opt,name=Version,proto3" json:"Version,omitempty"`
+}
+
+func (m *DeleteHyperLogLogCacheKeyMessage) Reset()      { *m = DeleteHyperLogLogCacheKeyMessage{} }
+func (*DeleteHyperLogLogCacheKeyMessage) ProtoMessage() {}
+func (*DeleteHyperLogLogCacheKeyMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptorHyperloglog, []int{2}
+}
+
+func (m *DeleteHyperLogLogCacheKeyMessage) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *DeleteHyperLogLogCacheKeyMessage) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+type DeleteHyperLogLogCacheKeyReply struct {
+	Key      string `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key,omitempty"`
+	Success  bool   `protobuf:"varint,2,opt,name=Success,proto3" json:"Success,omitempty"`
+	Conflict bool   `protobuf:"varint,3,opt,name=Conflict,proto3" json:"Conflict,omitempty"`
+}
+
+func (m *DeleteHyperLogLogCacheKeyReply) Reset()      { *m = DeleteHyperLogLogCacheKeyReply{} }
+func (*DeleteHyperLogLogCacheKeyReply) ProtoMessage() {}
+func (*DeleteHyperLogLogCacheKeyReply) Descriptor() ([]byte, []int) {
+	return fileDescriptorHyperloglog, []int{3}
+}
+
+func (m *DeleteHyperLogLogCacheKeyReply) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *DeleteHyperLogLogCacheKeyReply) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *DeleteHyperLogLogCacheKeyReply) GetConflict() bool {
+	if m != nil {
+		return m.Conflict
+	}
+	return false
+}
+
+type PostHyperLogLogCacheKeyMessage struct {
+	Key     string        `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key,omitempty"`
+	Values  []string      `protobuf:"bytes,2,rep,name=Values" json:"Values,omitempty"`
+	TTL     time.Duration `protobuf:"bytes,3,opt,name=TTL,stdduration" json:"TTL"`
+	Sliding bool          `protobuf:"varint,4,opt,name=Sliding,proto3" json:"Sliding,omitempty"`
+}
+
+func (m *PostHyperLogLogCacheKeyMessage) Reset()      { *m = PostHyperLogLogCacheKeyMessage{} }
+func (*PostHyperLogLogCacheKeyMessage) ProtoMessage() {}
+func (*PostHyperLogLogCacheKeyMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptorHyperloglog, []int{4}
+}
+
+func (m *PostHyperLogLogCacheKeyMessage) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *PostHyperLogLogCacheKeyMessage) GetValues() []string {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+func (m *PostHyperLogLogCacheKeyMessage) GetTTL() time.Duration {
+	if m != nil {
+		return m.TTL
+	}
+	return 0
+}
+
+func (m *PostHyperLogLogCacheKeyMessage) GetSliding() bool {
+	if m != nil {
+		return m.Sliding
+	}
+	return false
+}
+
+type PostHyperLogLogCacheKeyReply struct {
+	Key     string `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key,omitempty"`
+	Success bool   `protobuf:"varint,2,opt,name=Success,proto3" json:"Success,omitempty"`
+	Version int64  `protobuf:"varint,3,opt,name=Version,proto3" json:"Version,omitempty"`
+}
+
+func (m *PostHyperLogLogCacheKeyReply) Reset()      { *m = PostHyperLogLogCacheKeyReply{} }
+func (*PostHyperLogLogCacheKeyReply) ProtoMessage() {}
+func (*PostHyperLogLogCacheKeyReply) Descriptor() ([]byte, []int) {
+	return fileDescriptorHyperloglog, []int{5}
+}
+
+func (m *PostHyperLogLogCacheKeyReply) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *PostHyperLogLogCacheKeyReply) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *PostHyperLogLogCacheKeyReply) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+type AddHyperLogLogCacheValuesMessage struct {
+	Key     string   `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key,omitempty"`
+	Values  []string `protobuf:"bytes,2,rep,name=Values" json:"Values,omitempty"`
+	Create  bool     `protobuf:"varint,3,opt,name=Create,proto3" json:"Create,omitempty"`
+	Version int64    `protobuf:"varint,4,opt,name=Version,proto3" json:"Version,omitempty"`
+}
+
+func (m *AddHyperLogLogCacheValuesMessage) Reset()      { *m = AddHyperLogLogCacheValuesMessage{} }
+func (*AddHyperLogLogCacheValuesMessage) ProtoMessage() {}
+func (*AddHyperLogLogCacheValuesMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptorHyperloglog, []int{6}
+}
+
+func (m *AddHyperLogLogCacheValuesMessage) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *AddHyperLogLogCacheValuesMessage) GetValues() []string {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+func (m *AddHyperLogLogCacheValuesMessage) GetCreate() bool {
+	if m != nil {
+		return m.Create
+	}
+	return false
+}
+
+func (m *AddHyperLogLogCacheValuesMessage) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+type AddHyperLogLogCacheValuesReply struct {
+	Key      string `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key,omitempty"`
+	Changed  bool   `protobuf:"varint,2,opt,name=Changed,proto3" json:"Changed,omitempty"`
+	Count    int64  `protobuf:"varint,3,opt,name=Count,proto3" json:"Count,omitempty"`
+	Success  bool   `protobuf:"varint,4,opt,name=Success,proto3" json:"Success,omitempty"`
+	Created  bool   `protobuf:"varint,5,opt,name=Created,proto3" json:"Created,omitempty"`
+	Version  int64  `protobuf:"varint,6,opt,name=Version,proto3" json:"Version,omitempty"`
+	Conflict bool   `protobuf:"varint,7,opt,name=Conflict,proto3" json:"Conflict,omitempty"`
+}
+
+func (m *AddHyperLogLogCacheValuesReply) Reset()      { *m = AddHyperLogLogCacheValuesReply{} }
+func (*AddHyperLogLogCacheValuesReply) ProtoMessage() {}
+func (*AddHyperLogLogCacheValuesReply) Descriptor() ([]byte, []int) {
+	return fileDescriptorHyperloglog, []int{7}
+}
+
+func (m *AddHyperLogLogCacheValuesReply) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *AddHyperLogLogCacheValuesReply) GetChanged() bool {
+	if m != nil {
+		return m.Changed
+	}
+	return false
+}
+
+func (m *AddHyperLogLogCacheValuesReply) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *AddHyperLogLogCacheValuesReply) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *AddHyperLogLogCacheValuesReply) GetCreated() bool {
+	if m != nil {
+		return m.Created
+	}
+	return false
+}
+
+func (m *AddHyperLogLogCacheValuesReply) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *AddHyperLogLogCacheValuesReply) GetConflict() bool {
+	if m != nil {
+		return m.Conflict
+	}
+	return false
+}
+
+type MergeHyperLogLogCacheMessage struct {
+	Key     string   `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key,omitempty"`
+	Sources [][]byte `protobuf:"bytes,2,rep,name=Sources" json:"Sources,omitempty"`
+	Version int64    `protobuf:"varint,3,opt,name=Version,proto3" json:"Version,omitempty"`
+}
+
+func (m *MergeHyperLogLogCacheMessage) Reset()      { *m = MergeHyperLogLogCacheMessage{} }
+func (*MergeHyperLogLogCacheMessage) ProtoMessage() {}
+func (*MergeHyperLogLogCacheMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptorHyperloglog, []int{8}
+}
+
+func (m *MergeHyperLogLogCacheMessage) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *MergeHyperLogLogCacheMessage) GetSources() [][]byte {
+	if m != nil {
+		return m.Sources
+	}
+	return nil
+}
+
+func (m *MergeHyperLogLogCacheMessage) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+type MergeHyperLogLogCacheReply struct {
+	Key      string `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key,omitempty"`
+	Count    int64  `protobuf:"varint,2,opt,name=Count,proto3" json:"Count,omitempty"`
+	Success  bool   `protobuf:"varint,3,opt,name=Success,proto3" json:"Success,omitempty"`
+	Created  bool   `protobuf:"varint,4,opt,name=Created,proto3" json:"Created,omitempty"`
+	Version  int64  `protobuf:"varint,5,opt,name=Version,proto3" json:"Version,omitempty"`
+	Conflict bool   `protobuf:"varint,6,opt,name=Conflict,proto3" json:"Conflict,omitempty"`
+	Error    string `protobuf:"bytes,7,opt,name=Error,proto3" json:"Error,omitempty"`
+}
+
+func (m *MergeHyperLogLogCacheReply) Reset()      { *m = MergeHyperLogLogCacheReply{} }
+func (*MergeHyperLogLogCacheReply) ProtoMessage() {}
+func (*MergeHyperLogLogCacheReply) Descriptor() ([]byte, []int) {
+	return fileDescriptorHyperloglog, []int{9}
+}
+
+func (m *MergeHyperLogLogCacheReply) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *MergeHyperLogLogCacheReply) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *MergeHyperLogLogCacheReply) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *MergeHyperLogLogCacheReply) GetCreated() bool {
+	if m != nil {
+		return m.Created
+	}
+	return false
+}
+
+func (m *MergeHyperLogLogCacheReply) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *MergeHyperLogLogCacheReply) GetConflict() bool {
+	if m != nil {
+		return m.Conflict
+	}
+	return false
+}
+
+func (m *MergeHyperLogLogCacheReply) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*GetHyperLogLogCacheKeyMessage)(nil), "messages.GetHyperLogLogCacheKeyMessage")
+	proto.RegisterType((*GetHyperLogLogCacheKeyReply)(nil), "messages.GetHyperLogLogCacheKeyReply")
+	proto.RegisterType((*DeleteHyperLogLogCacheKeyMessage)(nil), "messages.DeleteHyperLogLogCacheKeyMessage")
+	proto.RegisterType((*DeleteHyperLogLogCacheKeyReply)(nil), "messages.DeleteHyperLogLogCacheKeyReply")
+	proto.RegisterType((*PostHyperLogLogCacheKeyMessage)(nil), "messages.PostHyperLogLogCacheKeyMessage")
+	proto.RegisterType((*PostHyperLogLogCacheKeyReply)(nil), "messages.PostHyperLogLogCacheKeyReply")
+	proto.RegisterType((*AddHyperLogLogCacheValuesMessage)(nil), "messages.AddHyperLogLogCacheValuesMessage")
+	proto.RegisterType((*AddHyperLogLogCacheValuesReply)(nil), "messages.AddHyperLogLogCacheValuesReply")
+	proto.RegisterType((*MergeHyperLogLogCacheMessage)(nil), "messages.MergeHyperLogLogCacheMessage")
+	proto.RegisterType((*MergeHyperLogLogCacheReply)(nil), "messages.MergeHyperLogLogCacheReply")
+}
+func (this *GetHyperLogLogCacheKeyMessage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GetHyperLogLogCacheKeyMessage)
+	if !ok {
+		that2, ok := that.(GetHyperLogLogCacheKeyMessage)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Key != that1.Key {
+		return false
+	}
+	return true
+}
+func (this *GetHyperLogLogCacheKeyReply) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GetHyperLogLogCacheKeyReply)
+	if !ok {
+		that2, ok := that.(GetHyperLogLogCacheKeyReply)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Key != that1.Key {
+		return false
+	}
+	if this.Count != that1.Count {
+		return false
+	}
+	if !bytes.Equal(this.Data, that1.Data) {
+		return false
+	}
+	if this.Success != that1.Success {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	return true
+}
+func (this *DeleteHyperLogLogCacheKeyMessage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DeleteHyperLogLogCacheKeyMessage)
+	if !ok {
+		that2, ok := that.(DeleteHyperLogLogCacheKeyMessage)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Key != that1.Key {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	return true
+}
+func (this *DeleteHyperLogLogCacheKeyReply) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DeleteHyperLogLogCacheKeyReply)
+	if !ok {
+		that2, ok := that.(DeleteHyperLogLogCacheKeyReply)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Key != that1.Key {
+		return false
+	}
+	if this.Success != that1.Success {
+		return false
+	}
+	if this.Conflict != that1.Conflict {
+		return false
+	}
+	return true
+}
+func (this *PostHyperLogLogCacheKeyMessage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PostHyperLogLogCacheKeyMessage)
+	if !ok {
+		that2, ok := that.(PostHyperLogLogCacheKeyMessage)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Key != that1.Key {
+		return false
+	}
+	if len(this.Values) != len(that1.Values) {
+		return false
+	}
+	for i := range this.Values {
+		if this.Values[i] != that1.Values[i] {
+			return false
+		}
+	}
+	if this.TTL != that1.TTL {
+		return false
+	}
+	if this.Sliding != that1.Sliding {
+		return false
+	}
+	return true
+}
+func (this *PostHyperLogLogCacheKeyReply) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PostHyperLogLogCacheKeyReply)
+	if !ok {
+		that2, ok := that.(PostHyperLogLogCacheKeyReply)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Key != that1.Key {
+		return false
+	}
+	if this.Success != that1.Success {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	return true
+}
+func (this *AddHyperLogLogCacheValuesMessage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AddHyperLogLogCacheValuesMessage)
+	if !ok {
+		that2, ok := that.(AddHyperLogLogCacheValuesMessage)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Key != that1.Key {
+		return false
+	}
+	if len(this.Values) != len(that1.Values) {
+		return false
+	}
+	for i := range this.Values {
+		if this.Values[i] != that1.Values[i] {
+			return false
+		}
+	}
+	if this.Create != that1.Create {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	return true
+}
+func (this *AddHyperLogLogCacheValuesReply) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AddHyperLogLogCacheValuesReply)
+	if !ok {
+		that2, ok := that.(AddHyperLogLogCacheValuesReply)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Key != that1.Key {
+		return false
+	}
+	if this.Changed != that1.Changed {
+		return false
+	}
+	if this.Count != that1.Count {
+		return false
+	}
+	if this.Success != that1.Success {
+		return false
+	}
+	if this.Created != that1.Created {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	if this.Conflict != that1.Conflict {
+		return false
+	}
+	return true
+}
+func (this *MergeHyperLogLogCacheMessage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MergeHyperLogLogCacheMessage)
+	if !ok {
+		that2, ok := that.(MergeHyperLogLogCacheMessage)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Key != that1.Key {
+		return false
+	}
+	if len(this.Sources) != len(that1.Sources) {
+		return false
+	}
+	for i := range this.Sources {
+		if !bytes.Equal(this.Sources[i], that1.Sources[i]) {
+			return false
+		}
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	return true
+}
+func (this *MergeHyperLogLogCacheReply) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MergeHyperLogLogCacheReply)
+	if !ok {
+		that2, ok := that.(MergeHyperLogLogCacheReply)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Key != that1.Key {
+		return false
+	}
+	if this.Count != that1.Count {
+		return false
+	}
+	if this.Success != that1.Success {
+		return false
+	}
+	if this.Created != that1.Created {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	if this.Conflict != that1.Conflict {
+		return false
+	}
+	if this.Error != that1.Error {
+		return false
+	}
+	return true
+}
+func (this *GetHyperLogLogCacheKeyMessage) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&messages.GetHyperLogLogCacheKeyMessage{")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetHyperLogLogCacheKeyReply) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&messages.GetHyperLogLogCacheKeyReply{")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "Count: "+fmt.Sprintf("%#v", this.Count)+",\n")
+	s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
+	s = append(s, "Success: "+fmt.Sprintf("%#v", this.Success)+",\n")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DeleteHyperLogLogCacheKeyMessage) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&messages.DeleteHyperLogLogCacheKeyMessage{")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DeleteHyperLogLogCacheKeyReply) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&messages.DeleteHyperLogLogCacheKeyReply{")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "Success: "+fmt.Sprintf("%#v", this.Success)+",\n")
+	s = append(s, "Conflict: "+fmt.Sprintf("%#v", this.Conflict)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PostHyperLogLogCacheKeyMessage) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&messages.PostHyperLogLogCacheKeyMessage{")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "Values: "+fmt.Sprintf("%#v", this.Values)+",\n")
+	s = append(s, "TTL: "+fmt.Sprintf("%#v", this.TTL)+",\n")
+	s = append(s, "Sliding: "+fmt.Sprintf("%#v", this.Sliding)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PostHyperLogLogCacheKeyReply) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&messages.PostHyperLogLogCacheKeyReply{")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "Success: "+fmt.Sprintf("%#v", this.Success)+",\n")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AddHyperLogLogCacheValuesMessage) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&messages.AddHyperLogLogCacheValuesMessage{")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "Values: "+fmt.Sprintf("%#v", this.Values)+",\n")
+	s = append(s, "Create: "+fmt.Sprintf("%#v", this.Create)+",\n")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AddHyperLogLogCacheValuesReply) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 11)
+	s = append(s, "&messages.AddHyperLogLogCacheValuesReply{")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "Changed: "+fmt.Sprintf("%#v", this.Changed)+",\n")
+	s = append(s, "Count: "+fmt.Sprintf("%#v", this.Count)+",\n")
+	s = append(s, "Success: "+fmt.Sprintf("%#v", this.Success)+",\n")
+	s = append(s, "Created: "+fmt.Sprintf("%#v", this.Created)+",\n")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	s = append(s, "Conflict: "+fmt.Sprintf("%#v", this.Conflict)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *MergeHyperLogLogCacheMessage) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&messages.MergeHyperLogLogCacheMessage{")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "Sources: "+fmt.Sprintf("%#v", this.Sources)+",\n")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *MergeHyperLogLogCacheReply) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 11)
+	s = append(s, "&messages.MergeHyperLogLogCacheReply{")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "Count: "+fmt.Sprintf("%#v", this.Count)+",\n")
+	s = append(s, "Success: "+fmt.Sprintf("%#v", this.Success)+",\n")
+	s = append(s, "Created: "+fmt.Sprintf("%#v", this.Created)+",\n")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	s = append(s, "Conflict: "+fmt.Sprintf("%#v", this.Conflict)+",\n")
+	s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringHyperloglog(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *GetHyperLogLogCacheKeyMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetHyperLogLogCacheKeyMessage) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintHyperloglog(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
+	}
+	return i, nil
+}
+
+func (m *GetHyperLogLogCacheKeyReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetHyperLogLogCacheKeyReply) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintHyperloglog(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
+	}
+	if m.Count != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintHyperloglog(dAtA, i, uint64(m.Count))
+	}
+	if len(m.Data) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintHyperloglog(dAtA, i, uint64(len(m.Data)))
+		i += copy(dAtA[i:], m.Data)
+	}
+	if m.Success {
+		dAtA[i] = 0x20
+		i++
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Version != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintHyperloglog(dAtA, i, uint64(m.Version))
+	}
+	return i, nil
+}
+
+func (m *DeleteHyperLogLogCacheKeyMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteHyperLogLogCacheKeyMessage) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintHyperloglog(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
+	}
+	if m.Version != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintHyperloglog(dAtA, i, uint64(m.Version))
+	}
+	return i, nil
+}
+
+func (m *DeleteHyperLogLogCacheKeyReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteHyperLogLogCacheKeyReply) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintHyperloglog(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
+	}
+	if m.Success {
+		dAtA[i] = 0x10
+		i++
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Conflict {
+		dAtA[i] = 0x18
+		i++
+		if m.Conflict {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *PostHyperLogLogCacheKeyMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PostHyperLogLogCacheKeyMessage) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintHyperloglog(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
+	}
+	if len(m.Values) > 0 {
+		for _, s := range m.Values {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintHyperloglog(dAtA, i, uint64(types.SizeOfStdDuration(m.TTL)))
+	n1, err := types.StdDurationMarshalTo(m.TTL, dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n1
+	if m.Sliding {
+		dAtA[i] = 0x20
+		i++
+		if m.Sliding {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *PostHyperLogLogCacheKeyReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PostHyperLogLogCacheKeyReply) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintHyperloglog(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
+	}
+	if m.Success {
+		dAtA[i] = 0x10
+		i++
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Version != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintHyperloglog(dAtA, i, uint64(m.Version))
+	}
+	return i, nil
+}
+
+func (m *AddHyperLogLogCacheValuesMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddHyperLogLogCacheValuesMessage) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintHyperloglog(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
+	}
+	if len(m.Values) > 0 {
+		for _, s := range m.Values {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.Create {
+		dAtA[i] = 0x18
+		i++
+		if m.Create {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Version != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintHyperloglog(dAtA, i, uint64(m.Version))
+	}
+	return i, nil
+}
+
+func (m *AddHyperLogLogCacheValuesReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddHyperLogLogCacheValuesReply) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintHyperloglog(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
+	}
+	if m.Changed {
+		dAtA[i] = 0x10
+		i++
+		if m.Changed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Count != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintHyperloglog(dAtA, i, uint64(m.Count))
+	}
+	if m.Success {
+		dAtA[i] = 0x20
+		i++
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Created {
+		dAtA[i] = 0x28
+		i++
+		if m.Created {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Version != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintHyperloglog(dAtA, i, uint64(m.Version))
+	}
+	if m.Conflict {
+		dAtA[i] = 0x38
+		i++
+		if m.Conflict {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *MergeHyperLogLogCacheMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MergeHyperLogLogCacheMessage) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintHyperloglog(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
+	}
+	if len(m.Sources) > 0 {
+		for _, b := range m.Sources {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintHyperloglog(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	if m.Version != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintHyperloglog(dAtA, i, uint64(m.Version))
+	}
+	return i, nil
+}
+
+func (m *MergeHyperLogLogCacheReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MergeHyperLogLogCacheReply) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintHyperloglog(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
+	}
+	if m.Count != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintHyperloglog(dAtA, i, uint64(m.Count))
+	}
+	if m.Success {
+		dAtA[i] = 0x18
+		i++
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Created {
+		dAtA[i] = 0x20
+		i++
+		if m.Created {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Version != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintHyperloglog(dAtA, i, uint64(m.Version))
+	}
+	if m.Conflict {
+		dAtA[i] = 0x30
+		i++
+		if m.Conflict {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintHyperloglog(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	return i, nil
+}
+
+func encodeVarintHyperloglog(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *GetHyperLogLogCacheKeyMessage) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovHyperloglog(uint64(l))
+	}
+	return n
+}
+
+func (m *GetHyperLogLogCacheKeyReply) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovHyperloglog(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovHyperloglog(uint64(m.Count))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovHyperloglog(uint64(l))
+	}
+	if m.Success {
+		n += 2
+	}
+	if m.Version != 0 {
+		n += 1 + sovHyperloglog(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *DeleteHyperLogLogCacheKeyMessage) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovHyperloglog(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovHyperloglog(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *DeleteHyperLogLogCacheKeyReply) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovHyperloglog(uint64(l))
+	}
+	if m.Success {
+		n += 2
+	}
+	if m.Conflict {
+		n += 2
+	}
+	return n
+}
+
+func (m *PostHyperLogLogCacheKeyMessage) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovHyperloglog(uint64(l))
+	}
+	if len(m.Values) > 0 {
+		for _, s := range m.Values {
+			l = len(s)
+			n += 1 + l + sovHyperloglog(uint64(l))
+		}
+	}
+	l = types.SizeOfStdDuration(m.TTL)
+	n += 1 + l + sovHyperloglog(uint64(l))
+	if m.Sliding {
+		n += 2
+	}
+	return n
+}
+
+func (m *PostHyperLogLogCacheKeyReply) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovHyperloglog(uint64(l))
+	}
+	if m.Success {
+		n += 2
+	}
+	if m.Version != 0 {
+		n += 1 + sovHyperloglog(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *AddHyperLogLogCacheValuesMessage) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovHyperloglog(uint64(l))
+	}
+	if len(m.Values) > 0 {
+		for _, s := range m.Values {
+			l = len(s)
+			n += 1 + l + sovHyperloglog(uint64(l))
+		}
+	}
+	if m.Create {
+		n += 2
+	}
+	if m.Version != 0 {
+		n += 1 + sovHyperloglog(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *AddHyperLogLogCacheValuesReply) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovHyperloglog(uint64(l))
+	}
+	if m.Changed {
+		n += 2
+	}
+	if m.Count != 0 {
+		n += 1 + sovHyperloglog(uint64(m.Count))
+	}
+	if m.Success {
+		n += 2
+	}
+	if m.Created {
+		n += 2
+	}
+	if m.Version != 0 {
+		n += 1 + sovHyperloglog(uint64(m.Version))
+	}
+	if m.Conflict {
+		n += 2
+	}
+	return n
+}
+
+func (m *MergeHyperLogLogCacheMessage) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovHyperloglog(uint64(l))
+	}
+	if len(m.Sources) > 0 {
+		for _, b := range m.Sources {
+			l = len(b)
+			n += 1 + l + sovHyperloglog(uint64(l))
+		}
+	}
+	if m.Version != 0 {
+		n += 1 + sovHyperloglog(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *MergeHyperLogLogCacheReply) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovHyperloglog(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovHyperloglog(uint64(m.Count))
+	}
+	if m.Success {
+		n += 2
+	}
+	if m.Created {
+		n += 2
+	}
+	if m.Version != 0 {
+		n += 1 + sovHyperloglog(uint64(m.Version))
+	}
+	if m.Conflict {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovHyperloglog(uint64(l))
+	}
+	return n
+}
+
+func sovHyperloglog(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozHyperloglog(x uint64) (n int) {
+	return sovHyperloglog(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *GetHyperLogLogCacheKeyMessage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetHyperLogLogCacheKeyMessage{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetHyperLogLogCacheKeyReply) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetHyperLogLogCacheKeyReply{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`Count:` + fmt.Sprintf("%v", this.Count) + `,`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`Success:` + fmt.Sprintf("%v", this.Success) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeleteHyperLogLogCacheKeyMessage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeleteHyperLogLogCacheKeyMessage{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeleteHyperLogLogCacheKeyReply) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeleteHyperLogLogCacheKeyReply{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`Success:` + fmt.Sprintf("%v", this.Success) + `,`,
+		`Conflict:` + fmt.Sprintf("%v", this.Conflict) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PostHyperLogLogCacheKeyMessage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PostHyperLogLogCacheKeyMessage{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`Values:` + fmt.Sprintf("%v", this.Values) + `,`,
+		`TTL:` + strings.Replace(strings.Replace(this.TTL.String(), "Duration", "google_protobuf1.Duration", 1), `&`, ``, 1) + `,`,
+		`Sliding:` + fmt.Sprintf("%v", this.Sliding) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PostHyperLogLogCacheKeyReply) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PostHyperLogLogCacheKeyReply{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`Success:` + fmt.Sprintf("%v", this.Success) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AddHyperLogLogCacheValuesMessage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AddHyperLogLogCacheValuesMessage{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`Values:` + fmt.Sprintf("%v", this.Values) + `,`,
+		`Create:` + fmt.Sprintf("%v", this.Create) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AddHyperLogLogCacheValuesReply) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AddHyperLogLogCacheValuesReply{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`Changed:` + fmt.Sprintf("%v", this.Changed) + `,`,
+		`Count:` + fmt.Sprintf("%v", this.Count) + `,`,
+		`Success:` + fmt.Sprintf("%v", this.Success) + `,`,
+		`Created:` + fmt.Sprintf("%v", this.Created) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`Conflict:` + fmt.Sprintf("%v", this.Conflict) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MergeHyperLogLogCacheMessage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MergeHyperLogLogCacheMessage{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`Sources:` + fmt.Sprintf("%v", this.Sources) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MergeHyperLogLogCacheReply) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MergeHyperLogLogCacheReply{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`Count:` + fmt.Sprintf("%v", this.Count) + `,`,
+		`Success:` + fmt.Sprintf("%v", this.Success) + `,`,
+		`Created:` + fmt.Sprintf("%v", this.Created) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`Conflict:` + fmt.Sprintf("%v", this.Conflict) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringHyperloglog(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *GetHyperLogLogCacheKeyMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHyperloglog
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetHyperLogLogCacheKeyMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetHyperLogLogCacheKeyMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHyperloglog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHyperloglog
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHyperloglog(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHyperloglog
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetHyperLogLogCacheKeyReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHyperloglog
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetHyperLogLogCacheKeyReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetHyperLogLogCacheKeyReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHyperloglog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHyperloglog
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHyperloglog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHyperloglog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHyperloglog
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHyperloglog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHyperloglog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHyperloglog(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHyperloglog
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteHyperLogLogCacheKeyMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHyperloglog
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteHyperLogLogCacheKeyMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteHyperLogLogCacheKeyMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHyperloglog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHyperloglog
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHyperloglog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHyperloglog(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHyperloglog
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteHyperLogLogCacheKeyReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHyperloglog
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteHyperLogLogCacheKeyReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteHyperLogLogCacheKeyReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHyperloglog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHyperloglog
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHyperloglog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conflict", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHyperloglog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Conflict = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHyperloglog(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHyperloglog
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PostHyperLogLogCacheKeyMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHyperloglog
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PostHyperLogLogCacheKeyMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PostHyperLogLogCacheKeyMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHyperloglog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHyperloglog
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHyperloglog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHyperloglog
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Values = append(m.Values, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TTL", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHyperloglog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHyperloglog
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := types.StdDurationUnmarshal(&m.TTL, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sliding", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHyperloglog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Sliding = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHyperloglog(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHyperloglog
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PostHyperLogLogCacheKeyReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHyperloglog
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PostHyperLogLogCacheKeyReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PostHyperLogLogCacheKeyReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHyperloglog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHyperloglog
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHyperloglog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHyperloglog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHyperloglog(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHyperloglog
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddHyperLogLogCacheValuesMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHyperloglog
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddHyperLogLogCacheValuesMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddHyperLogLogCacheValuesMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHyperloglog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHyperloglog
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHyperloglog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHyperloglog
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Values = append(m.Values, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Create", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHyperloglog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Create = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHyperloglog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHyperloglog(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHyperloglog
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddHyperLogLogCacheValuesReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHyperloglog
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddHyperLogLogCacheValuesReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddHyperLogLogCacheValuesReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHyperloglog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHyperloglog
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHyperloglog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Changed = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHyperloglog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHyperloglog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHyperloglog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Created = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHyperloglog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conflict", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHyperloglog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Conflict = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHyperloglog(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHyperloglog
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MergeHyperLogLogCacheMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHyperloglog
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MergeHyperLogLogCacheMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MergeHyperLogLogCacheMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHyperloglog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHyperloglog
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHyperloglog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHyperloglog
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sources = append(m.Sources, make([]byte, postIndex-iNdEx))
+			copy(m.Sources[len(m.Sources)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHyperloglog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHyperloglog(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHyperloglog
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MergeHyperLogLogCacheReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHyperloglog
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MergeHyperLogLogCacheReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MergeHyperLogLogCacheReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHyperloglog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHyperloglog
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHyperloglog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHyperloglog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHyperloglog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Created = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHyperloglog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conflict", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHyperloglog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Conflict = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHyperloglog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHyperloglog
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHyperloglog(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHyperloglog
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipHyperloglog(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowHyperloglog
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHyperloglog
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+			return iNdEx, nil
+		case 1:
+			iNdEx += 8
+			return iNdEx, nil
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHyperloglog
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			iNdEx += length
+			if length < 0 {
+				return 0, ErrInvalidLengthHyperloglog
+			}
+			return iNdEx, nil
+		case 3:
+			for {
+				var innerWire uint64
+				var start int = iNdEx
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return 0, ErrIntOverflowHyperloglog
+					}
+					if iNdEx >= l {
+						return 0, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					innerWire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				innerWireType := int(innerWire & 0x7)
+				if innerWireType == 4 {
+					break
+				}
+				next, err := skipHyperloglog(dAtA[start:])
+				if err != nil {
+					return 0, err
+				}
+				iNdEx = start + next
+			}
+			return iNdEx, nil
+		case 4:
+			return iNdEx, nil
+		case 5:
+			iNdEx += 4
+			return iNdEx, nil
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+	}
+	panic("unreachable")
+}
+
+var (
+	ErrInvalidLengthHyperloglog = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowHyperloglog   = fmt.Errorf("proto: integer overflow")
+)
+
+func init() { proto.RegisterFile("hyperloglog.proto", fileDescriptorHyperloglog) }
+
+var fileDescriptorHyperloglog = []byte{
+	// 544 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xb1, 0x6e, 0xd4, 0x30,
+	0x18, 0xc7, 0xcf, 0x97, 0xbb, 0x6b, 0xce, 0x74, 0x80, 0x08, 0x55, 0xe1, 0x28, 0x6e, 0x94, 0x29,
+	0x03, 0xa4, 0x02, 0xc4, 0x03, 0xd0, 0x1c, 0x02, 0xa9, 0x57, 0x84, 0xdc, 0xaa, 0x7b, 0x2e, 0x71,
+	0x9d, 0x48, 0x69, 0x7c, 0x72, 0x92, 0xe1, 0x16, 0xc4, 0x0b, 0x20, 0x31, 0x22, 0xf1, 0x02, 0x3c,
+	0x08, 0x43, 0x07, 0x86, 0x8e, 0x4c, 0xc0, 0x85, 0x85, 0xb1, 0x8f, 0x50, 0xc5, 0x4e, 0xda, 0xa4,
+	0x6d, 0x4e, 0xca, 0xe6, 0xbf, 0x62, 0x7f, 0xff, 0xff, 0xf7, 0xf3, 0xe7, 0xc0, 0x07, 0xc1, 0x72,
+	0x41, 0x78, 0xc4, 0x68, 0xc4, 0xa8, 0xbd, 0xe0, 0x2c, 0x65, 0x9a, 0x7a, 0x4a, 0x92, 0xc4, 0xa5,
+	0x24, 0x99, 0x3c, 0xa3, 0x61, 0x1a, 0x64, 0x73, 0xdb, 0x63, 0xa7, 0xbb, 0x94, 0x51, 0xb6, 0x2b,
+	0x36, 0xcc, 0xb3, 0x13, 0xa1, 0x84, 0x10, 0x2b, 0x79, 0x70, 0x82, 0x28, 0x63, 0x34, 0x22, 0xd7,
+	0xbb, 0xfc, 0x8c, 0xbb, 0x69, 0xc8, 0x62, 0xf9, 0xdd, 0x7c, 0x0e, 0x9f, 0xbc, 0x25, 0xe9, 0xbb,
+	0xc2, 0x70, 0xc6, 0xe8, 0x8c, 0x51, 0xc7, 0xf5, 0x02, 0xb2, 0x4f, 0x96, 0x07, 0xd2, 0x50, 0xbb,
+	0x0f, 0x95, 0x7d, 0xb2, 0xd4, 0x81, 0x01, 0xac, 0x31, 0x2e, 0x96, 0xe6, 0x67, 0x00, 0x1f, 0xdf,
+	0x7d, 0x06, 0x93, 0x45, 0xb4, 0xbc, 0x7d, 0x42, 0x7b, 0x08, 0x87, 0x0e, 0xcb, 0xe2, 0x54, 0xef,
+	0x1b, 0xc0, 0x52, 0xb0, 0x14, 0x9a, 0x06, 0x07, 0x53, 0x37, 0x75, 0x75, 0xc5, 0x00, 0xd6, 0x26,
+	0x16, 0x6b, 0x4d, 0x87, 0x1b, 0x87, 0x99, 0xe7, 0x91, 0x24, 0xd1, 0x07, 0x06, 0xb0, 0x54, 0x5c,
+	0xc9, 0xe2, 0xcb, 0x31, 0xe1, 0x49, 0xc8, 0x62, 0x7d, 0x28, 0xaa, 0x54, 0xd2, 0x7c, 0x0f, 0x8d,
+	0x29, 0x89, 0x48, 0x4a, 0xba, 0x74, 0x51, 0xaf, 0xd7, 0x6f, 0xd6, 0x0b, 0x20, 0x6a, 0xad, 0xd7,
+	0xd6, 0x61, 0x2d, 0x77, 0xbf, 0x99, 0x7b, 0x02, 0x55, 0x87, 0xc5, 0x27, 0x51, 0xe8, 0xa5, 0xa2,
+	0x53, 0x15, 0x5f, 0x69, 0xf3, 0x1b, 0x80, 0xe8, 0x03, 0x4b, 0x3a, 0xe1, 0xd7, 0xb6, 0xe0, 0xe8,
+	0xd8, 0x8d, 0x32, 0x52, 0x38, 0x29, 0xd6, 0x18, 0x97, 0x4a, 0x7b, 0x05, 0x95, 0xa3, 0xa3, 0x99,
+	0xf0, 0xb8, 0xf7, 0xe2, 0x91, 0x2d, 0xef, 0xdd, 0xae, 0xee, 0xdd, 0x9e, 0x96, 0xf7, 0xbe, 0xa7,
+	0x9e, 0xfd, 0xde, 0xe9, 0x7d, 0xfd, 0xb3, 0x03, 0x70, 0xb1, 0x5f, 0x24, 0x8f, 0x42, 0x3f, 0x8c,
+	0xe9, 0x15, 0x71, 0x29, 0x4d, 0x1f, 0x6e, 0xb7, 0x84, 0xeb, 0x4e, 0xa1, 0x46, 0x5b, 0x69, 0xd2,
+	0xfe, 0x08, 0x8d, 0xd7, 0xbe, 0x7f, 0xd3, 0x44, 0xf6, 0xd4, 0x1d, 0xc2, 0x16, 0x1c, 0x39, 0x9c,
+	0xb8, 0x29, 0x29, 0x59, 0x97, 0xaa, 0xee, 0x3f, 0x68, 0xfa, 0xff, 0x04, 0x10, 0xb5, 0x06, 0x58,
+	0xd3, 0xa8, 0x13, 0xb8, 0x31, 0x25, 0x7e, 0xd5, 0x68, 0x29, 0xaf, 0x47, 0x5d, 0xa9, 0x8f, 0xfa,
+	0xda, 0xb1, 0x96, 0x11, 0x7d, 0x7d, 0x58, 0x56, 0x92, 0xb2, 0x1e, 0x79, 0xd4, 0x88, 0xdc, 0x18,
+	0xa9, 0x8d, 0x1b, 0x23, 0xe5, 0xc3, 0xed, 0x03, 0xc2, 0xe9, 0xad, 0xd9, 0x5d, 0xfb, 0x10, 0x0e,
+	0x59, 0xc6, 0xbd, 0x92, 0xe5, 0x26, 0xae, 0xe4, 0x9a, 0x4b, 0xfb, 0x01, 0xe0, 0xe4, 0x4e, 0x9b,
+	0x6e, 0x7f, 0x80, 0x1a, 0x16, 0xa5, 0x15, 0xcb, 0xa0, 0x15, 0xcb, 0xb0, 0x1d, 0xcb, 0xa8, 0x89,
+	0xa5, 0xf0, 0x7f, 0xc3, 0x39, 0xe3, 0x82, 0xd7, 0x18, 0x4b, 0xb1, 0xf7, 0xf4, 0x7c, 0x85, 0x7a,
+	0xbf, 0x56, 0xa8, 0x77, 0xb1, 0x42, 0xe0, 0x53, 0x8e, 0xc0, 0xf7, 0x1c, 0x81, 0xb3, 0x1c, 0x81,
+	0xf3, 0x1c, 0x81, 0xbf, 0x39, 0x02, 0xff, 0x73, 0xd4, 0xbb, 0xc8, 0x11, 0xf8, 0xf2, 0x0f, 0xf5,
+	0xe6, 0x23, 0xf1, 0x96, 0x5e, 0x5e, 0x0e, 0x00, 0x6d, 0x46, 0xba, 0x2e, 0x9f, 0x05, 0x00, 0x00,
+}
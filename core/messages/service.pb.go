@@ -0,0 +1,4744 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: service.proto
+
+package messages
+
+import proto "github.com/gogo/protobuf/proto"
+import fmt "fmt"
+import math "math"
+
+import context "golang.org/x/net/context"
+import grpc "google.golang.org/grpc"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for CacheService service
+
+type CacheServiceClient interface {
+	GetStringKeys(ctx context.Context, in *GetCacheKeysMessage, opts ...grpc.CallOption) (CacheService_GetStringKeysClient, error)
+	GetString(ctx context.Context, in *GetStringCacheKeyMessage, opts ...grpc.CallOption) (*GetStringCacheKeyReply, error)
+	PostString(ctx context.Context, in *PostStringCacheKeyMessage, opts ...grpc.CallOption) (*PostStringCacheKeyReply, error)
+	PutString(ctx context.Context, in *PutStringCacheKeyMessage, opts ...grpc.CallOption) (*PutStringCacheKeyReply, error)
+	SetString(ctx context.Context, in *SetStringCacheKeyMessage, opts ...grpc.CallOption) (*SetStringCacheKeyReply, error)
+	ReplaceString(ctx context.Context, in *ReplaceStringCacheKeyMessage, opts ...grpc.CallOption) (*ReplaceStringCacheKeyReply, error)
+	CompareAndSwapString(ctx context.Context, in *CompareAndSwapStringCacheKeyMessage, opts ...grpc.CallOption) (*CompareAndSwapStringCacheKeyReply, error)
+	TouchString(ctx context.Context, in *TouchStringCacheKeyMessage, opts ...grpc.CallOption) (*TouchStringCacheKeyReply, error)
+	DeleteString(ctx context.Context, in *DeleteStringCacheKeyMessage, opts ...grpc.CallOption) (*DeleteStringCacheKeyReply, error)
+	IncrementString(ctx context.Context, in *IncrementStringCacheKeyMessage, opts ...grpc.CallOption) (*IncrementStringCacheKeyReply, error)
+	SetBitString(ctx context.Context, in *SetBitStringCacheKeyMessage, opts ...grpc.CallOption) (*SetBitStringCacheKeyReply, error)
+	GetBitString(ctx context.Context, in *GetBitStringCacheKeyMessage, opts ...grpc.CallOption) (*GetBitStringCacheKeyReply, error)
+	CountBitsString(ctx context.Context, in *CountBitsStringCacheKeyMessage, opts ...grpc.CallOption) (*CountBitsStringCacheKeyReply, error)
+	GetBitPosString(ctx context.Context, in *GetBitPosStringCacheKeyMessage, opts ...grpc.CallOption) (*GetBitPosStringCacheKeyReply, error)
+	BitOpString(ctx context.Context, in *BitOpStringCacheKeyMessage, opts ...grpc.CallOption) (*BitOpStringCacheKeyReply, error)
+	BitFieldString(ctx context.Context, in *BitFieldStringCacheKeyMessage, opts ...grpc.CallOption) (*BitFieldStringCacheKeyReply, error)
+	GetListKeys(ctx context.Context, in *GetCacheKeysMessage, opts ...grpc.CallOption) (CacheService_GetListKeysClient, error)
+	GetList(ctx context.Context, in *GetListCacheKeyMessage, opts ...grpc.CallOption) (*GetListCacheKeyReply, error)
+	PostList(ctx context.Context, in *PostListCacheKeyMessage, opts ...grpc.CallOption) (*PostListCacheKeyReply, error)
+	DeleteList(ctx context.Context, in *DeleteListCacheKeyMessage, opts ...grpc.CallOption) (*DeleteListCacheKeyReply, error)
+	PostListValue(ctx context.Context, in *PostListCacheValueMessage, opts ...grpc.CallOption) (*PostListCacheValueReply, error)
+	PutListValue(ctx context.Context, in *PutListCacheValueMessage, opts ...grpc.CallOption) (*PutListCacheValueReply, error)
+	DeleteListValue(ctx context.Context, in *DeleteListCacheValueMessage, opts ...grpc.CallOption) (*DeleteListCacheValueReply, error)
+	GetListIndex(ctx context.Context, in *GetListCacheIndexMessage, opts ...grpc.CallOption) (*GetListCacheIndexReply, error)
+	SetListIndex(ctx context.Context, in *SetListCacheIndexMessage, opts ...grpc.CallOption) (*SetListCacheIndexReply, error)
+	InsertListValue(ctx context.Context, in *InsertListCacheValueMessage, opts ...grpc.CallOption) (*InsertListCacheValueReply, error)
+	RemoveListIndex(ctx context.Context, in *RemoveListCacheIndexMessage, opts ...grpc.CallOption) (*RemoveListCacheIndexReply, error)
+	GetListRange(ctx context.Context, in *GetListCacheRangeMessage, opts ...grpc.CallOption) (*GetListCacheRangeReply, error)
+	PushListValues(ctx context.Context, in *PushListCacheValuesMessage, opts ...grpc.CallOption) (*PushListCacheValuesReply, error)
+	PopListValues(ctx context.Context, in *PopListCacheValuesMessage, opts ...grpc.CallOption) (*PopListCacheValuesReply, error)
+	TrimList(ctx context.Context, in *TrimListCacheKeyMessage, opts ...grpc.CallOption) (*TrimListCacheKeyReply, error)
+	GetDictionaryKeys(ctx context.Context, in *GetCacheKeysMessage, opts ...grpc.CallOption) (CacheService_GetDictionaryKeysClient, error)
+	GetDictionary(ctx context.Context, in *GetDictionaryCacheKeyMessage, opts ...grpc.CallOption) (*GetDictionaryCacheKeyReply, error)
+	PostDictionary(ctx context.Context, in *PostDictionaryCacheKeyMessage, opts ...grpc.CallOption) (*PostDictionaryCacheKeyReply, error)
+	DeleteDictionary(ctx context.Context, in *DeleteDictionaryCacheKeyMessage, opts ...grpc.CallOption) (*DeleteDictionaryCacheKeyReply, error)
+	PostDictionaryValue(ctx context.Context, in *PostDictionaryCacheValueMessage, opts ...grpc.CallOption) (*PostDictionaryCacheValueReply, error)
+	PutDictionaryValue(ctx context.Context, in *PutDictionaryCacheValueMessage, opts ...grpc.CallOption) (*PutDictionaryCacheValueReply, error)
+	DeleteDictionaryValue(ctx context.Context, in *DeleteDictionaryCacheValueMessage, opts ...grpc.CallOption) (*DeleteDictionaryCacheValueReply, error)
+	GetDictionaryValues(ctx context.Context, in *GetDictionaryCacheValuesMessage, opts ...grpc.CallOption) (*GetDictionaryCacheValuesReply, error)
+	SetDictionaryValues(ctx context.Context, in *SetDictionaryCacheValuesMessage, opts ...grpc.CallOption) (*SetDictionaryCacheValuesReply, error)
+	DeleteDictionaryValues(ctx context.Context, in *DeleteDictionaryCacheValuesMessage, opts ...grpc.CallOption) (*DeleteDictionaryCacheValuesReply, error)
+	GetDictionarySubKeys(ctx context.Context, in *GetDictionaryCacheSubKeysMessage, opts ...grpc.CallOption) (*GetDictionaryCacheSubKeysReply, error)
+	ContainsDictionaryValues(ctx context.Context, in *ContainsDictionaryCacheValuesMessage, opts ...grpc.CallOption) (*ContainsDictionaryCacheValuesReply, error)
+	IncrementDictionaryValue(ctx context.Context, in *IncrementDictionaryCacheValueMessage, opts ...grpc.CallOption) (*IncrementDictionaryCacheValueReply, error)
+	GetSetKeys(ctx context.Context, in *GetCacheKeysMessage, opts ...grpc.CallOption) (CacheService_GetSetKeysClient, error)
+	GetSet(ctx context.Context, in *GetSetCacheKeyMessage, opts ...grpc.CallOption) (*GetSetCacheKeyReply, error)
+	PostSet(ctx context.Context, in *PostSetCacheKeyMessage, opts ...grpc.CallOption) (*PostSetCacheKeyReply, error)
+	DeleteSet(ctx context.Context, in *DeleteSetCacheKeyMessage, opts ...grpc.CallOption) (*DeleteSetCacheKeyReply, error)
+	PostSetValues(ctx context.Context, in *PostSetCacheValuesMessage, opts ...grpc.CallOption) (*PostSetCacheValuesReply, error)
+	DeleteSetValues(ctx context.Context, in *DeleteSetCacheValuesMessage, opts ...grpc.CallOption) (*DeleteSetCacheValuesReply, error)
+	ContainsSetValue(ctx context.Context, in *ContainsSetCacheValueMessage, opts ...grpc.CallOption) (*ContainsSetCacheValueReply, error)
+	GetSetCardinality(ctx context.Context, in *GetSetCacheCardinalityMessage, opts ...grpc.CallOption) (*GetSetCacheCardinalityReply, error)
+	GetSetRandomValues(ctx context.Context, in *GetSetCacheRandomValuesMessage, opts ...grpc.CallOption) (*GetSetCacheRandomValuesReply, error)
+	GetSortedSetKeys(ctx context.Context, in *GetCacheKeysMessage, opts ...grpc.CallOption) (CacheService_GetSortedSetKeysClient, error)
+	GetSortedSet(ctx context.Context, in *GetSortedSetCacheKeyMessage, opts ...grpc.CallOption) (*GetSortedSetCacheKeyReply, error)
+	PostSortedSet(ctx context.Context, in *PostSortedSetCacheKeyMessage, opts ...grpc.CallOption) (*PostSortedSetCacheKeyReply, error)
+	DeleteSortedSet(ctx context.Context, in *DeleteSortedSetCacheKeyMessage, opts ...grpc.CallOption) (*DeleteSortedSetCacheKeyReply, error)
+	PostSortedSetValues(ctx context.Context, in *PostSortedSetCacheValuesMessage, opts ...grpc.CallOption) (*PostSortedSetCacheValuesReply, error)
+	IncrementSortedSetScore(ctx context.Context, in *IncrementSortedSetCacheScoreMessage, opts ...grpc.CallOption) (*IncrementSortedSetCacheScoreReply, error)
+	DeleteSortedSetValues(ctx context.Context, in *DeleteSortedSetCacheValuesMessage, opts ...grpc.CallOption) (*DeleteSortedSetCacheValuesReply, error)
+	GetSortedSetRange(ctx context.Context, in *GetSortedSetCacheRangeMessage, opts ...grpc.CallOption) (*GetSortedSetCacheRangeReply, error)
+	GetSortedSetRangeByScore(ctx context.Context, in *GetSortedSetCacheRangeByScoreMessage, opts ...grpc.CallOption) (*GetSortedSetCacheRangeByScoreReply, error)
+	GetSortedSetRank(ctx context.Context, in *GetSortedSetCacheRankMessage, opts ...grpc.CallOption) (*GetSortedSetCacheRankReply, error)
+	GetDocumentKeys(ctx context.Context, in *GetCacheKeysMessage, opts ...grpc.CallOption) (CacheService_GetDocumentKeysClient, error)
+	GetDocument(ctx context.Context, in *GetDocumentCacheKeyMessage, opts ...grpc.CallOption) (*GetDocumentCacheKeyReply, error)
+	PostDocument(ctx context.Context, in *PostDocumentCacheKeyMessage, opts ...grpc.CallOption) (*PostDocumentCacheKeyReply, error)
+	DeleteDocument(ctx context.Context, in *DeleteDocumentCacheKeyMessage, opts ...grpc.CallOption) (*DeleteDocumentCacheKeyReply, error)
+	GetDocumentPath(ctx context.Context, in *GetDocumentCachePathMessage, opts ...grpc.CallOption) (*GetDocumentCachePathReply, error)
+	SetDocumentPath(ctx context.Context, in *SetDocumentCachePathMessage, opts ...grpc.CallOption) (*SetDocumentCachePathReply, error)
+	DeleteDocumentPath(ctx context.Context, in *DeleteDocumentCachePathMessage, opts ...grpc.CallOption) (*DeleteDocumentCachePathReply, error)
+	AppendDocumentValues(ctx context.Context, in *AppendDocumentCacheValuesMessage, opts ...grpc.CallOption) (*AppendDocumentCacheValuesReply, error)
+	MergeDocument(ctx context.Context, in *MergeDocumentCacheMessage, opts ...grpc.CallOption) (*MergeDocumentCacheReply, error)
+	PatchDocument(ctx context.Context, in *PatchDocumentCacheMessage, opts ...grpc.CallOption) (*PatchDocumentCacheReply, error)
+	GetHyperLogLogKeys(ctx context.Context, in *GetCacheKeysMessage, opts ...grpc.CallOption) (CacheService_GetHyperLogLogKeysClient, error)
+	GetHyperLogLog(ctx context.Context, in *GetHyperLogLogCacheKeyMessage, opts ...grpc.CallOption) (*GetHyperLogLogCacheKeyReply, error)
+	PostHyperLogLog(ctx context.Context, in *PostHyperLogLogCacheKeyMessage, opts ...grpc.CallOption) (*PostHyperLogLogCacheKeyReply, error)
+	DeleteHyperLogLog(ctx context.Context, in *DeleteHyperLogLogCacheKeyMessage, opts ...grpc.CallOption) (*DeleteHyperLogLogCacheKeyReply, error)
+	AddHyperLogLogValues(ctx context.Context, in *AddHyperLogLogCacheValuesMessage, opts ...grpc.CallOption) (*AddHyperLogLogCacheValuesReply, error)
+	MergeHyperLogLog(ctx context.Context, in *MergeHyperLogLogCacheMessage, opts ...grpc.CallOption) (*MergeHyperLogLogCacheReply, error)
+	GetBloomFilterKeys(ctx context.Context, in *GetCacheKeysMessage, opts ...grpc.CallOption) (CacheService_GetBloomFilterKeysClient, error)
+	GetBloomFilter(ctx context.Context, in *GetBloomFilterCacheKeyMessage, opts ...grpc.CallOption) (*GetBloomFilterCacheKeyReply, error)
+	PostBloomFilter(ctx context.Context, in *PostBloomFilterCacheKeyMessage, opts ...grpc.CallOption) (*PostBloomFilterCacheKeyReply, error)
+	DeleteBloomFilter(ctx context.Context, in *DeleteBloomFilterCacheKeyMessage, opts ...grpc.CallOption) (*DeleteBloomFilterCacheKeyReply, error)
+	AddBloomFilterValues(ctx context.Context, in *AddBloomFilterCacheValuesMessage, opts ...grpc.CallOption) (*AddBloomFilterCacheValuesReply, error)
+	ExistsBloomFilterValues(ctx context.Context, in *ExistsBloomFilterCacheValuesMessage, opts ...grpc.CallOption) (*ExistsBloomFilterCacheValuesReply, error)
+	GetStreamKeys(ctx context.Context, in *GetCacheKeysMessage, opts ...grpc.CallOption) (CacheService_GetStreamKeysClient, error)
+	GetStream(ctx context.Context, in *GetStreamCacheKeyMessage, opts ...grpc.CallOption) (*GetStreamCacheKeyReply, error)
+	PostStream(ctx context.Context, in *PostStreamCacheKeyMessage, opts ...grpc.CallOption) (*PostStreamCacheKeyReply, error)
+	DeleteStream(ctx context.Context, in *DeleteStreamCacheKeyMessage, opts ...grpc.CallOption) (*DeleteStreamCacheKeyReply, error)
+	AppendStreamEntry(ctx context.Context, in *AppendStreamCacheEntryMessage, opts ...grpc.CallOption) (*AppendStreamCacheEntryReply, error)
+	GetStreamRange(ctx context.Context, in *GetStreamCacheRangeMessage, opts ...grpc.CallOption) (*GetStreamCacheRangeReply, error)
+	TrimStream(ctx context.Context, in *TrimStreamCacheKeyMessage, opts ...grpc.CallOption) (*TrimStreamCacheKeyReply, error)
+	GetStreamGroups(ctx context.Context, in *GetStreamCacheGroupsMessage, opts ...grpc.CallOption) (*GetStreamCacheGroupsReply, error)
+	CreateStreamGroup(ctx context.Context, in *CreateStreamCacheGroupMessage, opts ...grpc.CallOption) (*CreateStreamCacheGroupReply, error)
+	DeleteStreamGroup(ctx context.Context, in *DeleteStreamCacheGroupMessage, opts ...grpc.CallOption) (*DeleteStreamCacheGroupReply, error)
+	ReadStreamGroup(ctx context.Context, in *ReadStreamCacheGroupMessage, opts ...grpc.CallOption) (*ReadStreamCacheGroupReply, error)
+	AckStreamGroup(ctx context.Context, in *AckStreamCacheGroupMessage, opts ...grpc.CallOption) (*AckStreamCacheGroupReply, error)
+	GetStreamPending(ctx context.Context, in *GetStreamCachePendingMessage, opts ...grpc.CallOption) (*GetStreamCachePendingReply, error)
+	GetGeoKeys(ctx context.Context, in *GetCacheKeysMessage, opts ...grpc.CallOption) (CacheService_GetGeoKeysClient, error)
+	GetGeo(ctx context.Context, in *GetGeoCacheKeyMessage, opts ...grpc.CallOption) (*GetGeoCacheKeyReply, error)
+	PostGeo(ctx context.Context, in *PostGeoCacheKeyMessage, opts ...grpc.CallOption) (*PostGeoCacheKeyReply, error)
+	DeleteGeo(ctx context.Context, in *DeleteGeoCacheKeyMessage, opts ...grpc.CallOption) (*DeleteGeoCacheKeyReply, error)
+	AddGeoMembers(ctx context.Context, in *AddGeoCacheMembersMessage, opts ...grpc.CallOption) (*AddGeoCacheMembersReply, error)
+	RemoveGeoMembers(ctx context.Context, in *RemoveGeoCacheMembersMessage, opts ...grpc.CallOption) (*RemoveGeoCacheMembersReply, error)
+	GetGeoPositions(ctx context.Context, in *GetGeoCachePositionsMessage, opts ...grpc.CallOption) (*GetGeoCachePositionsReply, error)
+	GetGeoDistance(ctx context.Context, in *GetGeoCacheDistanceMessage, opts ...grpc.CallOption) (*GetGeoCacheDistanceReply, error)
+	SearchGeo(ctx context.Context, in *SearchGeoCacheMessage, opts ...grpc.CallOption) (*SearchGeoCacheReply, error)
+	GetQueueKeys(ctx context.Context, in *GetCacheKeysMessage, opts ...grpc.CallOption) (CacheService_GetQueueKeysClient, error)
+	GetQueue(ctx context.Context, in *GetQueueCacheKeyMessage, opts ...grpc.CallOption) (*GetQueueCacheKeyReply, error)
+	PostQueue(ctx context.Context, in *PostQueueCacheKeyMessage, opts ...grpc.CallOption) (*PostQueueCacheKeyReply, error)
+	DeleteQueue(ctx context.Context, in *DeleteQueueCacheKeyMessage, opts ...grpc.CallOption) (*DeleteQueueCacheKeyReply, error)
+	PushQueue(ctx context.Context, in *PushQueueCacheItemsMessage, opts ...grpc.CallOption) (*PushQueueCacheItemsReply, error)
+	PopQueue(ctx context.Context, in *PopQueueCacheItemsMessage, opts ...grpc.CallOption) (*PopQueueCacheItemsReply, error)
+	PeekQueue(ctx context.Context, in *PeekQueueCacheItemsMessage, opts ...grpc.CallOption) (*PeekQueueCacheItemsReply, error)
+	AckQueue(ctx context.Context, in *AckQueueCacheItemsMessage, opts ...grpc.CallOption) (*AckQueueCacheItemsReply, error)
+	NackQueue(ctx context.Context, in *NackQueueCacheItemMessage, opts ...grpc.CallOption) (*NackQueueCacheItemReply, error)
+	GetQueueDead(ctx context.Context, in *GetQueueCacheDeadMessage, opts ...grpc.CallOption) (*GetQueueCacheDeadReply, error)
+	RedriveQueue(ctx context.Context, in *RedriveQueueCacheDeadMessage, opts ...grpc.CallOption) (*RedriveQueueCacheDeadReply, error)
+	GetRateLimitKeys(ctx context.Context, in *GetCacheKeysMessage, opts ...grpc.CallOption) (CacheService_GetRateLimitKeysClient, error)
+	GetRateLimit(ctx context.Context, in *GetRateLimitCacheKeyMessage, opts ...grpc.CallOption) (*GetRateLimitCacheKeyReply, error)
+	DeleteRateLimit(ctx context.Context, in *DeleteRateLimitCacheKeyMessage, opts ...grpc.CallOption) (*DeleteRateLimitCacheKeyReply, error)
+	TakeRateLimit(ctx context.Context, in *TakeRateLimitCacheKeyMessage, opts ...grpc.CallOption) (*TakeRateLimitCacheKeyReply, error)
+	GetLockKeys(ctx context.Context, in *GetCacheKeysMessage, opts ...grpc.CallOption) (CacheService_GetLockKeysClient, error)
+	GetLock(ctx context.Context, in *GetLockCacheKeyMessage, opts ...grpc.CallOption) (*GetLockCacheKeyReply, error)
+	AcquireLock(ctx context.Context, in *AcquireLockCacheKeyMessage, opts ...grpc.CallOption) (*AcquireLockCacheKeyReply, error)
+	RenewLock(ctx context.Context, in *RenewLockCacheKeyMessage, opts ...grpc.CallOption) (*RenewLockCacheKeyReply, error)
+	ReleaseLock(ctx context.Context, in *ReleaseLockCacheKeyMessage, opts ...grpc.CallOption) (*ReleaseLockCacheKeyReply, error)
+}
+
+type cacheServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewCacheServiceClient(cc *grpc.ClientConn) CacheServiceClient {
+	return &cacheServiceClient{cc}
+}
+
+func (c *cacheServiceClient) GetStringKeys(ctx context.Context, in *GetCacheKeysMessage, opts ...grpc.CallOption) (CacheService_GetStringKeysClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_CacheService_serviceDesc.Streams[0], c.cc, "/messages.CacheService/GetStringKeys", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &cacheServiceGetStringKeysClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CacheService_GetStringKeysClient interface {
+	Recv() (*CacheKey, error)
+	grpc.ClientStream
+}
+
+type cacheServiceGetStringKeysClient struct {
+	grpc.ClientStream
+}
+
+func (x *cacheServiceGetStringKeysClient) Recv() (*CacheKey, error) {
+	m := new(CacheKey)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *cacheServiceClient) GetString(ctx context.Context, in *GetStringCacheKeyMessage, opts ...grpc.CallOption) (*GetStringCacheKeyReply, error) {
+	out := new(GetStringCacheKeyReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/GetString", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) PostString(ctx context.Context, in *PostStringCacheKeyMessage, opts ...grpc.CallOption) (*PostStringCacheKeyReply, error) {
+	out := new(PostStringCacheKeyReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/PostString", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) PutString(ctx context.Context, in *PutStringCacheKeyMessage, opts ...grpc.CallOption) (*PutStringCacheKeyReply, error) {
+	out := new(PutStringCacheKeyReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/PutString", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) SetString(ctx context.Context, in *SetStringCacheKeyMessage, opts ...grpc.CallOption) (*SetStringCacheKeyReply, error) {
+	out := new(SetStringCacheKeyReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/SetString", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) ReplaceString(ctx context.Context, in *ReplaceStringCacheKeyMessage, opts ...grpc.CallOption) (*ReplaceStringCacheKeyReply, error) {
+	out := new(ReplaceStringCacheKeyReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/ReplaceString", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) CompareAndSwapString(ctx context.Context, in *CompareAndSwapStringCacheKeyMessage, opts ...grpc.CallOption) (*CompareAndSwapStringCacheKeyReply, error) {
+	out := new(CompareAndSwapStringCacheKeyReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/CompareAndSwapString", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) TouchString(ctx context.Context, in *TouchStringCacheKeyMessage, opts ...grpc.CallOption) (*TouchStringCacheKeyReply, error) {
+	out := new(TouchStringCacheKeyReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/TouchString", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) DeleteString(ctx context.Context, in *DeleteStringCacheKeyMessage, opts ...grpc.CallOption) (*DeleteStringCacheKeyReply, error) {
+	out := new(DeleteStringCacheKeyReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/DeleteString", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) IncrementString(ctx context.Context, in *IncrementStringCacheKeyMessage, opts ...grpc.CallOption) (*IncrementStringCacheKeyReply, error) {
+	out := new(IncrementStringCacheKeyReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/IncrementString", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) SetBitString(ctx context.Context, in *SetBitStringCacheKeyMessage, opts ...grpc.CallOption) (*SetBitStringCacheKeyReply, error) {
+	out := new(SetBitStringCacheKeyReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/SetBitString", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) GetBitString(ctx context.Context, in *GetBitStringCacheKeyMessage, opts ...grpc.CallOption) (*GetBitStringCacheKeyReply, error) {
+	out := new(GetBitStringCacheKeyReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/GetBitString", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) CountBitsString(ctx context.Context, in *CountBitsStringCacheKeyMessage, opts ...grpc.CallOption) (*CountBitsStringCacheKeyReply, error) {
+	out := new(CountBitsStringCacheKeyReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/CountBitsString", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) GetBitPosString(ctx context.Context, in *GetBitPosStringCacheKeyMessage, opts ...grpc.CallOption) (*GetBitPosStringCacheKeyReply, error) {
+	out := new(GetBitPosStringCacheKeyReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/GetBitPosString", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) BitOpString(ctx context.Context, in *BitOpStringCacheKeyMessage, opts ...grpc.CallOption) (*BitOpStringCacheKeyReply, error) {
+	out := new(BitOpStringCacheKeyReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/BitOpString", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) BitFieldString(ctx context.Context, in *BitFieldStringCacheKeyMessage, opts ...grpc.CallOption) (*BitFieldStringCacheKeyReply, error) {
+	out := new(BitFieldStringCacheKeyReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/BitFieldString", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) GetListKeys(ctx context.Context, in *GetCacheKeysMessage, opts ...grpc.CallOption) (CacheService_GetListKeysClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_CacheService_serviceDesc.Streams[1], c.cc, "/messages.CacheService/GetListKeys", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &cacheServiceGetListKeysClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CacheService_GetListKeysClient interface {
+	Recv() (*CacheKey, error)
+	grpc.ClientStream
+}
+
+type cacheServiceGetListKeysClient struct {
+	grpc.ClientStream
+}
+
+func (x *cacheServiceGetListKeysClient) Recv() (*CacheKey, error) {
+	m := new(CacheKey)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *cacheServiceClient) GetList(ctx context.Context, in *GetListCacheKeyMessage, opts ...grpc.CallOption) (*GetListCacheKeyReply, error) {
+	out := new(GetListCacheKeyReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/GetList", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) PostList(ctx context.Context, in *PostListCacheKeyMessage, opts ...grpc.CallOption) (*PostListCacheKeyReply, error) {
+	out := new(PostListCacheKeyReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/PostList", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) DeleteList(ctx context.Context, in *DeleteListCacheKeyMessage, opts ...grpc.CallOption) (*DeleteListCacheKeyReply, error) {
+	out := new(DeleteListCacheKeyReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/DeleteList", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) PostListValue(ctx context.Context, in *PostListCacheValueMessage, opts ...grpc.CallOption) (*PostListCacheValueReply, error) {
+	out := new(PostListCacheValueReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/PostListValue", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) PutListValue(ctx context.Context, in *PutListCacheValueMessage, opts ...grpc.CallOption) (*PutListCacheValueReply, error) {
+	out := new(PutListCacheValueReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/PutListValue", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) DeleteListValue(ctx context.Context, in *DeleteListCacheValueMessage, opts ...grpc.CallOption) (*DeleteListCacheValueReply, error) {
+	out := new(DeleteListCacheValueReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/DeleteListValue", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) GetListIndex(ctx context.Context, in *GetListCacheIndexMessage, opts ...grpc.CallOption) (*GetListCacheIndexReply, error) {
+	out := new(GetListCacheIndexReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/GetListIndex", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) SetListIndex(ctx context.Context, in *SetListCacheIndexMessage, opts ...grpc.CallOption) (*SetListCacheIndexReply, error) {
+	out := new(SetListCacheIndexReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/SetListIndex", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) InsertListValue(ctx context.Context, in *InsertListCacheValueMessage, opts ...grpc.CallOption) (*InsertListCacheValueReply, error) {
+	out := new(InsertListCacheValueReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/InsertListValue", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) RemoveListIndex(ctx context.Context, in *RemoveListCacheIndexMessage, opts ...grpc.CallOption) (*RemoveListCacheIndexReply, error) {
+	out := new(RemoveListCacheIndexReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/RemoveListIndex", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) GetListRange(ctx context.Context, in *GetListCacheRangeMessage, opts ...grpc.CallOption) (*GetListCacheRangeReply, error) {
+	out := new(GetListCacheRangeReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/GetListRange", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) PushListValues(ctx context.Context, in *PushListCacheValuesMessage, opts ...grpc.CallOption) (*PushListCacheValuesReply, error) {
+	out := new(PushListCacheValuesReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/PushListValues", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) PopListValues(ctx context.Context, in *PopListCacheValuesMessage, opts ...grpc.CallOption) (*PopListCacheValuesReply, error) {
+	out := new(PopListCacheValuesReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/PopListValues", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) TrimList(ctx context.Context, in *TrimListCacheKeyMessage, opts ...grpc.CallOption) (*TrimListCacheKeyReply, error) {
+	out := new(TrimListCacheKeyReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/TrimList", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) GetDictionaryKeys(ctx context.Context, in *GetCacheKeysMessage, opts ...grpc.CallOption) (CacheService_GetDictionaryKeysClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_CacheService_serviceDesc.Streams[2], c.cc, "/messages.CacheService/GetDictionaryKeys", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &cacheServiceGetDictionaryKeysClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CacheService_GetDictionaryKeysClient interface {
+	Recv() (*CacheKey, error)
+	grpc.ClientStream
+}
+
+type cacheServiceGetDictionaryKeysClient struct {
+	grpc.ClientStream
+}
+
+func (x *cacheServiceGetDictionaryKeysClient) Recv() (*CacheKey, error) {
+	m := new(CacheKey)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *cacheServiceClient) GetDictionary(ctx context.Context, in *GetDictionaryCacheKeyMessage, opts ...grpc.CallOption) (*GetDictionaryCacheKeyReply, error) {
+	out := new(GetDictionaryCacheKeyReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/GetDictionary", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) PostDictionary(ctx context.Context, in *PostDictionaryCacheKeyMessage, opts ...grpc.CallOption) (*PostDictionaryCacheKeyReply, error) {
+	out := new(PostDictionaryCacheKeyReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/PostDictionary", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) DeleteDictionary(ctx context.Context, in *DeleteDictionaryCacheKeyMessage, opts ...grpc.CallOption) (*DeleteDictionaryCacheKeyReply, error) {
+	out := new(DeleteDictionaryCacheKeyReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/DeleteDictionary", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) PostDictionaryValue(ctx context.Context, in *PostDictionaryCacheValueMessage, opts ...grpc.CallOption) (*PostDictionaryCacheValueReply, error) {
+	out := new(PostDictionaryCacheValueReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/PostDictionaryValue", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) PutDictionaryValue(ctx context.Context, in *PutDictionaryCacheValueMessage, opts ...grpc.CallOption) (*PutDictionaryCacheValueReply, error) {
+	out := new(PutDictionaryCacheValueReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/PutDictionaryValue", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) DeleteDictionaryValue(ctx context.Context, in *DeleteDictionaryCacheValueMessage, opts ...grpc.CallOption) (*DeleteDictionaryCacheValueReply, error) {
+	out := new(DeleteDictionaryCacheValueReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/DeleteDictionaryValue", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) GetDictionaryValues(ctx context.Context, in *GetDictionaryCacheValuesMessage, opts ...grpc.CallOption) (*GetDictionaryCacheValuesReply, error) {
+	out := new(GetDictionaryCacheValuesReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/GetDictionaryValues", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) SetDictionaryValues(ctx context.Context, in *SetDictionaryCacheValuesMessage, opts ...grpc.CallOption) (*SetDictionaryCacheValuesReply, error) {
+	out := new(SetDictionaryCacheValuesReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/SetDictionaryValues", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) DeleteDictionaryValues(ctx context.Context, in *DeleteDictionaryCacheValuesMessage, opts ...grpc.CallOption) (*DeleteDictionaryCacheValuesReply, error) {
+	out := new(DeleteDictionaryCacheValuesReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/DeleteDictionaryValues", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) GetDictionarySubKeys(ctx context.Context, in *GetDictionaryCacheSubKeysMessage, opts ...grpc.CallOption) (*GetDictionaryCacheSubKeysReply, error) {
+	out := new(GetDictionaryCacheSubKeysReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/GetDictionarySubKeys", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) ContainsDictionaryValues(ctx context.Context, in *ContainsDictionaryCacheValuesMessage, opts ...grpc.CallOption) (*ContainsDictionaryCacheValuesReply, error) {
+	out := new(ContainsDictionaryCacheValuesReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/ContainsDictionaryValues", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) IncrementDictionaryValue(ctx context.Context, in *IncrementDictionaryCacheValueMessage, opts ...grpc.CallOption) (*IncrementDictionaryCacheValueReply, error) {
+	out := new(IncrementDictionaryCacheValueReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/IncrementDictionaryValue", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) GetSetKeys(ctx context.Context, in *GetCacheKeysMessage, opts ...grpc.CallOption) (CacheService_GetSetKeysClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_CacheService_serviceDesc.Streams[3], c.cc, "/messages.CacheService/GetSetKeys", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &cacheServiceGetSetKeysClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CacheService_GetSetKeysClient interface {
+	Recv() (*CacheKey, error)
+	grpc.ClientStream
+}
+
+type cacheServiceGetSetKeysClient struct {
+	grpc.ClientStream
+}
+
+func (x *cacheServiceGetSetKeysClient) Recv() (*CacheKey, error) {
+	m := new(CacheKey)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *cacheServiceClient) GetSet(ctx context.Context, in *GetSetCacheKeyMessage, opts ...grpc.CallOption) (*GetSetCacheKeyReply, error) {
+	out := new(GetSetCacheKeyReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/GetSet", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) PostSet(ctx context.Context, in *PostSetCacheKeyMessage, opts ...grpc.CallOption) (*PostSetCacheKeyReply, error) {
+	out := new(PostSetCacheKeyReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/PostSet", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) DeleteSet(ctx context.Context, in *DeleteSetCacheKeyMessage, opts ...grpc.CallOption) (*DeleteSetCacheKeyReply, error) {
+	out := new(DeleteSetCacheKeyReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/DeleteSet", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) PostSetValues(ctx context.Context, in *PostSetCacheValuesMessage, opts ...grpc.CallOption) (*PostSetCacheValuesReply, error) {
+	out := new(PostSetCacheValuesReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/PostSetValues", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) DeleteSetValues(ctx context.Context, in *DeleteSetCacheValuesMessage, opts ...grpc.CallOption) (*DeleteSetCacheValuesReply, error) {
+	out := new(DeleteSetCacheValuesReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/DeleteSetValues", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) ContainsSetValue(ctx context.Context, in *ContainsSetCacheValueMessage, opts ...grpc.CallOption) (*ContainsSetCacheValueReply, error) {
+	out := new(ContainsSetCacheValueReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/ContainsSetValue", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) GetSetCardinality(ctx context.Context, in *GetSetCacheCardinalityMessage, opts ...grpc.CallOption) (*GetSetCacheCardinalityReply, error) {
+	out := new(GetSetCacheCardinalityReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/GetSetCardinality", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) GetSetRandomValues(ctx context.Context, in *GetSetCacheRandomValuesMessage, opts ...grpc.CallOption) (*GetSetCacheRandomValuesReply, error) {
+	out := new(GetSetCacheRandomValuesReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/GetSetRandomValues", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) GetSortedSetKeys(ctx context.Context, in *GetCacheKeysMessage, opts ...grpc.CallOption) (CacheService_GetSortedSetKeysClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_CacheService_serviceDesc.Streams[4], c.cc, "/messages.CacheService/GetSortedSetKeys", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &cacheServiceGetSortedSetKeysClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CacheService_GetSortedSetKeysClient interface {
+	Recv() (*CacheKey, error)
+	grpc.ClientStream
+}
+
+type cacheServiceGetSortedSetKeysClient struct {
+	grpc.ClientStream
+}
+
+func (x *cacheServiceGetSortedSetKeysClient) Recv() (*CacheKey, error) {
+	m := new(CacheKey)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *cacheServiceClient) GetSortedSet(ctx context.Context, in *GetSortedSetCacheKeyMessage, opts ...grpc.CallOption) (*GetSortedSetCacheKeyReply, error) {
+	out := new(GetSortedSetCacheKeyReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/GetSortedSet", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) PostSortedSet(ctx context.Context, in *PostSortedSetCacheKeyMessage, opts ...grpc.CallOption) (*PostSortedSetCacheKeyReply, error) {
+	out := new(PostSortedSetCacheKeyReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/PostSortedSet", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) DeleteSortedSet(ctx context.Context, in *DeleteSortedSetCacheKeyMessage, opts ...grpc.CallOption) (*DeleteSortedSetCacheKeyReply, error) {
+	out := new(DeleteSortedSetCacheKeyReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/DeleteSortedSet", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) PostSortedSetValues(ctx context.Context, in *PostSortedSetCacheValuesMessage, opts ...grpc.CallOption) (*PostSortedSetCacheValuesReply, error) {
+	out := new(PostSortedSetCacheValuesReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/PostSortedSetValues", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) IncrementSortedSetScore(ctx context.Context, in *IncrementSortedSetCacheScoreMessage, opts ...grpc.CallOption) (*IncrementSortedSetCacheScoreReply, error) {
+	out := new(IncrementSortedSetCacheScoreReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/IncrementSortedSetScore", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) DeleteSortedSetValues(ctx context.Context, in *DeleteSortedSetCacheValuesMessage, opts ...grpc.CallOption) (*DeleteSortedSetCacheValuesReply, error) {
+	out := new(DeleteSortedSetCacheValuesReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/DeleteSortedSetValues", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) GetSortedSetRange(ctx context.Context, in *GetSortedSetCacheRangeMessage, opts ...grpc.CallOption) (*GetSortedSetCacheRangeReply, error) {
+	out := new(GetSortedSetCacheRangeReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/GetSortedSetRange", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) GetSortedSetRangeByScore(ctx context.Context, in *GetSortedSetCacheRangeByScoreMessage, opts ...grpc.CallOption) (*GetSortedSetCacheRangeByScoreReply, error) {
+	out := new(GetSortedSetCacheRangeByScoreReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/GetSortedSetRangeByScore", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) GetSortedSetRank(ctx context.Context, in *GetSortedSetCacheRankMessage, opts ...grpc.CallOption) (*GetSortedSetCacheRankReply, error) {
+	out := new(GetSortedSetCacheRankReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/GetSortedSetRank", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) GetDocumentKeys(ctx context.Context, in *GetCacheKeysMessage, opts ...grpc.CallOption) (CacheService_GetDocumentKeysClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_CacheService_serviceDesc.Streams[5], c.cc, "/messages.CacheService/GetDocumentKeys", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &cacheServiceGetDocumentKeysClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CacheService_GetDocumentKeysClient interface {
+	Recv() (*CacheKey, error)
+	grpc.ClientStream
+}
+
+type cacheServiceGetDocumentKeysClient struct {
+	grpc.ClientStream
+}
+
+func (x *cacheServiceGetDocumentKeysClient) Recv() (*CacheKey, error) {
+	m := new(CacheKey)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *cacheServiceClient) GetDocument(ctx context.Context, in *GetDocumentCacheKeyMessage, opts ...grpc.CallOption) (*GetDocumentCacheKeyReply, error) {
+	out := new(GetDocumentCacheKeyReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/GetDocument", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) PostDocument(ctx context.Context, in *PostDocumentCacheKeyMessage, opts ...grpc.CallOption) (*PostDocumentCacheKeyReply, error) {
+	out := new(PostDocumentCacheKeyReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/PostDocument", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) DeleteDocument(ctx context.Context, in *DeleteDocumentCacheKeyMessage, opts ...grpc.CallOption) (*DeleteDocumentCacheKeyReply, error) {
+	out := new(DeleteDocumentCacheKeyReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/DeleteDocument", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) GetDocumentPath(ctx context.Context, in *GetDocumentCachePathMessage, opts ...grpc.CallOption) (*GetDocumentCachePathReply, error) {
+	out := new(GetDocumentCachePathReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/GetDocumentPath", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) SetDocumentPath(ctx context.Context, in *SetDocumentCachePathMessage, opts ...grpc.CallOption) (*SetDocumentCachePathReply, error) {
+	out := new(SetDocumentCachePathReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/SetDocumentPath", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) DeleteDocumentPath(ctx context.Context, in *DeleteDocumentCachePathMessage, opts ...grpc.CallOption) (*DeleteDocumentCachePathReply, error) {
+	out := new(DeleteDocumentCachePathReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/DeleteDocumentPath", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) AppendDocumentValues(ctx context.Context, in *AppendDocumentCacheValuesMessage, opts ...grpc.CallOption) (*AppendDocumentCacheValuesReply, error) {
+	out := new(AppendDocumentCacheValuesReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/AppendDocumentValues", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) MergeDocument(ctx context.Context, in *MergeDocumentCacheMessage, opts ...grpc.CallOption) (*MergeDocumentCacheReply, error) {
+	out := new(MergeDocumentCacheReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/MergeDocument", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) PatchDocument(ctx context.Context, in *PatchDocumentCacheMessage, opts ...grpc.CallOption) (*PatchDocumentCacheReply, error) {
+	out := new(PatchDocumentCacheReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/PatchDocument", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) GetHyperLogLogKeys(ctx context.Context, in *GetCacheKeysMessage, opts ...grpc.CallOption) (CacheService_GetHyperLogLogKeysClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_CacheService_serviceDesc.Streams[6], c.cc, "/messages.CacheService/GetHyperLogLogKeys", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &cacheServiceGetHyperLogLogKeysClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CacheService_GetHyperLogLogKeysClient interface {
+	Recv() (*CacheKey, error)
+	grpc.ClientStream
+}
+
+type cacheServiceGetHyperLogLogKeysClient struct {
+	grpc.ClientStream
+}
+
+func (x *cacheServiceGetHyperLogLogKeysClient) Recv() (*CacheKey, error) {
+	m := new(CacheKey)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *cacheServiceClient) GetHyperLogLog(ctx context.Context, in *GetHyperLogLogCacheKeyMessage, opts ...grpc.CallOption) (*GetHyperLogLogCacheKeyReply, error) {
+	out := new(GetHyperLogLogCacheKeyReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/GetHyperLogLog", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) PostHyperLogLog(ctx context.Context, in *PostHyperLogLogCacheKeyMessage, opts ...grpc.CallOption) (*PostHyperLogLogCacheKeyReply, error) {
+	out := new(PostHyperLogLogCacheKeyReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/PostHyperLogLog", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) DeleteHyperLogLog(ctx context.Context, in *DeleteHyperLogLogCacheKeyMessage, opts ...grpc.CallOption) (*DeleteHyperLogLogCacheKeyReply, error) {
+	out := new(DeleteHyperLogLogCacheKeyReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/DeleteHyperLogLog", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) AddHyperLogLogValues(ctx context.Context, in *AddHyperLogLogCacheValuesMessage, opts ...grpc.CallOption) (*AddHyperLogLogCacheValuesReply, error) {
+	out := new(AddHyperLogLogCacheValuesReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/AddHyperLogLogValues", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) MergeHyperLogLog(ctx context.Context, in *MergeHyperLogLogCacheMessage, opts ...grpc.CallOption) (*MergeHyperLogLogCacheReply, error) {
+	out := new(MergeHyperLogLogCacheReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/MergeHyperLogLog", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) GetBloomFilterKeys(ctx context.Context, in *GetCacheKeysMessage, opts ...grpc.CallOption) (CacheService_GetBloomFilterKeysClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_CacheService_serviceDesc.Streams[7], c.cc, "/messages.CacheService/GetBloomFilterKeys", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &cacheServiceGetBloomFilterKeysClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CacheService_GetBloomFilterKeysClient interface {
+	Recv() (*CacheKey, error)
+	grpc.ClientStream
+}
+
+type cacheServiceGetBloomFilterKeysClient struct {
+	grpc.ClientStream
+}
+
+func (x *cacheServiceGetBloomFilterKeysClient) Recv() (*CacheKey, error) {
+	m := new(CacheKey)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *cacheServiceClient) GetBloomFilter(ctx context.Context, in *GetBloomFilterCacheKeyMessage, opts ...grpc.CallOption) (*GetBloomFilterCacheKeyReply, error) {
+	out := new(GetBloomFilterCacheKeyReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/GetBloomFilter", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) PostBloomFilter(ctx context.Context, in *PostBloomFilterCacheKeyMessage, opts ...grpc.CallOption) (*PostBloomFilterCacheKeyReply, error) {
+	out := new(PostBloomFilterCacheKeyReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/PostBloomFilter", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) DeleteBloomFilter(ctx context.Context, in *DeleteBloomFilterCacheKeyMessage, opts ...grpc.CallOption) (*DeleteBloomFilterCacheKeyReply, error) {
+	out := new(DeleteBloomFilterCacheKeyReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/DeleteBloomFilter", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) AddBloomFilterValues(ctx context.Context, in *AddBloomFilterCacheValuesMessage, opts ...grpc.CallOption) (*AddBloomFilterCacheValuesReply, error) {
+	out := new(AddBloomFilterCacheValuesReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/AddBloomFilterValues", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) ExistsBloomFilterValues(ctx context.Context, in *ExistsBloomFilterCacheValuesMessage, opts ...grpc.CallOption) (*ExistsBloomFilterCacheValuesReply, error) {
+	out := new(ExistsBloomFilterCacheValuesReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/ExistsBloomFilterValues", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) GetStreamKeys(ctx context.Context, in *GetCacheKeysMessage, opts ...grpc.CallOption) (CacheService_GetStreamKeysClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_CacheService_serviceDesc.Streams[8], c.cc, "/messages.CacheService/GetStreamKeys", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &cacheServiceGetStreamKeysClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CacheService_GetStreamKeysClient interface {
+	Recv() (*CacheKey, error)
+	grpc.ClientStream
+}
+
+type cacheServiceGetStreamKeysClient struct {
+	grpc.ClientStream
+}
+
+func (x *cacheServiceGetStreamKeysClient) Recv() (*CacheKey, error) {
+	m := new(CacheKey)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *cacheServiceClient) GetStream(ctx context.Context, in *GetStreamCacheKeyMessage, opts ...grpc.CallOption) (*GetStreamCacheKeyReply, error) {
+	out := new(GetStreamCacheKeyReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/GetStream", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) PostStream(ctx context.Context, in *PostStreamCacheKeyMessage, opts ...grpc.CallOption) (*PostStreamCacheKeyReply, error) {
+	out := new(PostStreamCacheKeyReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/PostStream", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) DeleteStream(ctx context.Context, in *DeleteStreamCacheKeyMessage, opts ...grpc.CallOption) (*DeleteStreamCacheKeyReply, error) {
+	out := new(DeleteStreamCacheKeyReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/DeleteStream", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) AppendStreamEntry(ctx context.Context, in *AppendStreamCacheEntryMessage, opts ...grpc.CallOption) (*AppendStreamCacheEntryReply, error) {
+	out := new(AppendStreamCacheEntryReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/AppendStreamEntry", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) GetStreamRange(ctx context.Context, in *GetStreamCacheRangeMessage, opts ...grpc.CallOption) (*GetStreamCacheRangeReply, error) {
+	out := new(GetStreamCacheRangeReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/GetStreamRange", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) TrimStream(ctx context.Context, in *TrimStreamCacheKeyMessage, opts ...grpc.CallOption) (*TrimStreamCacheKeyReply, error) {
+	out := new(TrimStreamCacheKeyReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/TrimStream", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) GetStreamGroups(ctx context.Context, in *GetStreamCacheGroupsMessage, opts ...grpc.CallOption) (*GetStreamCacheGroupsReply, error) {
+	out := new(GetStreamCacheGroupsReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/GetStreamGroups", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) CreateStreamGroup(ctx context.Context, in *CreateStreamCacheGroupMessage, opts ...grpc.CallOption) (*CreateStreamCacheGroupReply, error) {
+	out := new(CreateStreamCacheGroupReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/CreateStreamGroup", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) DeleteStreamGroup(ctx context.Context, in *DeleteStreamCacheGroupMessage, opts ...grpc.CallOption) (*DeleteStreamCacheGroupReply, error) {
+	out := new(DeleteStreamCacheGroupReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/DeleteStreamGroup", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) ReadStreamGroup(ctx context.Context, in *ReadStreamCacheGroupMessage, opts ...grpc.CallOption) (*ReadStreamCacheGroupReply, error) {
+	out := new(ReadStreamCacheGroupReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/ReadStreamGroup", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) AckStreamGroup(ctx context.Context, in *AckStreamCacheGroupMessage, opts ...grpc.CallOption) (*AckStreamCacheGroupReply, error) {
+	out := new(AckStreamCacheGroupReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/AckStreamGroup", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) GetStreamPending(ctx context.Context, in *GetStreamCachePendingMessage, opts ...grpc.CallOption) (*GetStreamCachePendingReply, error) {
+	out := new(GetStreamCachePendingReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/GetStreamPending", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) GetGeoKeys(ctx context.Context, in *GetCacheKeysMessage, opts ...grpc.CallOption) (CacheService_GetGeoKeysClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_CacheService_serviceDesc.Streams[9], c.cc, "/messages.CacheService/GetGeoKeys", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &cacheServiceGetGeoKeysClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CacheService_GetGeoKeysClient interface {
+	Recv() (*CacheKey, error)
+	grpc.ClientStream
+}
+
+type cacheServiceGetGeoKeysClient struct {
+	grpc.ClientStream
+}
+
+func (x *cacheServiceGetGeoKeysClient) Recv() (*CacheKey, error) {
+	m := new(CacheKey)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *cacheServiceClient) GetGeo(ctx context.Context, in *GetGeoCacheKeyMessage, opts ...grpc.CallOption) (*GetGeoCacheKeyReply, error) {
+	out := new(GetGeoCacheKeyReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/GetGeo", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) PostGeo(ctx context.Context, in *PostGeoCacheKeyMessage, opts ...grpc.CallOption) (*PostGeoCacheKeyReply, error) {
+	out := new(PostGeoCacheKeyReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/PostGeo", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) DeleteGeo(ctx context.Context, in *DeleteGeoCacheKeyMessage, opts ...grpc.CallOption) (*DeleteGeoCacheKeyReply, error) {
+	out := new(DeleteGeoCacheKeyReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/DeleteGeo", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) AddGeoMembers(ctx context.Context, in *AddGeoCacheMembersMessage, opts ...grpc.CallOption) (*AddGeoCacheMembersReply, error) {
+	out := new(AddGeoCacheMembersReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/AddGeoMembers", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) RemoveGeoMembers(ctx context.Context, in *RemoveGeoCacheMembersMessage, opts ...grpc.CallOption) (*RemoveGeoCacheMembersReply, error) {
+	out := new(RemoveGeoCacheMembersReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/RemoveGeoMembers", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) GetGeoPositions(ctx context.Context, in *GetGeoCachePositionsMessage, opts ...grpc.CallOption) (*GetGeoCachePositionsReply, error) {
+	out := new(GetGeoCachePositionsReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/GetGeoPositions", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) GetGeoDistance(ctx context.Context, in *GetGeoCacheDistanceMessage, opts ...grpc.CallOption) (*GetGeoCacheDistanceReply, error) {
+	out := new(GetGeoCacheDistanceReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/GetGeoDistance", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) SearchGeo(ctx context.Context, in *SearchGeoCacheMessage, opts ...grpc.CallOption) (*SearchGeoCacheReply, error) {
+	out := new(SearchGeoCacheReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/SearchGeo", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) GetQueueKeys(ctx context.Context, in *GetCacheKeysMessage, opts ...grpc.CallOption) (CacheService_GetQueueKeysClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_CacheService_serviceDesc.Streams[10], c.cc, "/messages.CacheService/GetQueueKeys", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &cacheServiceGetQueueKeysClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CacheService_GetQueueKeysClient interface {
+	Recv() (*CacheKey, error)
+	grpc.ClientStream
+}
+
+type cacheServiceGetQueueKeysClient struct {
+	grpc.ClientStream
+}
+
+func (x *cacheServiceGetQueueKeysClient) Recv() (*CacheKey, error) {
+	m := new(CacheKey)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *cacheServiceClient) GetQueue(ctx context.Context, in *GetQueueCacheKeyMessage, opts ...grpc.CallOption) (*GetQueueCacheKeyReply, error) {
+	out := new(GetQueueCacheKeyReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/GetQueue", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) PostQueue(ctx context.Context, in *PostQueueCacheKeyMessage, opts ...grpc.CallOption) (*PostQueueCacheKeyReply, error) {
+	out := new(PostQueueCacheKeyReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/PostQueue", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) DeleteQueue(ctx context.Context, in *DeleteQueueCacheKeyMessage, opts ...grpc.CallOption) (*DeleteQueueCacheKeyReply, error) {
+	out := new(DeleteQueueCacheKeyReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/DeleteQueue", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) PushQueue(ctx context.Context, in *PushQueueCacheItemsMessage, opts ...grpc.CallOption) (*PushQueueCacheItemsReply, error) {
+	out := new(PushQueueCacheItemsReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/PushQueue", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) PopQueue(ctx context.Context, in *PopQueueCacheItemsMessage, opts ...grpc.CallOption) (*PopQueueCacheItemsReply, error) {
+	out := new(PopQueueCacheItemsReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/PopQueue", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) PeekQueue(ctx context.Context, in *PeekQueueCacheItemsMessage, opts ...grpc.CallOption) (*PeekQueueCacheItemsReply, error) {
+	out := new(PeekQueueCacheItemsReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/PeekQueue", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) AckQueue(ctx context.Context, in *AckQueueCacheItemsMessage, opts ...grpc.CallOption) (*AckQueueCacheItemsReply, error) {
+	out := new(AckQueueCacheItemsReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/AckQueue", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) NackQueue(ctx context.Context, in *NackQueueCacheItemMessage, opts ...grpc.CallOption) (*NackQueueCacheItemReply, error) {
+	out := new(NackQueueCacheItemReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/NackQueue", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) GetQueueDead(ctx context.Context, in *GetQueueCacheDeadMessage, opts ...grpc.CallOption) (*GetQueueCacheDeadReply, error) {
+	out := new(GetQueueCacheDeadReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/GetQueueDead", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) RedriveQueue(ctx context.Context, in *RedriveQueueCacheDeadMessage, opts ...grpc.CallOption) (*RedriveQueueCacheDeadReply, error) {
+	out := new(RedriveQueueCacheDeadReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/RedriveQueue", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) GetRateLimitKeys(ctx context.Context, in *GetCacheKeysMessage, opts ...grpc.CallOption) (CacheService_GetRateLimitKeysClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_CacheService_serviceDesc.Streams[11], c.cc, "/messages.CacheService/GetRateLimitKeys", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &cacheServiceGetRateLimitKeysClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CacheService_GetRateLimitKeysClient interface {
+	Recv() (*CacheKey, error)
+	grpc.ClientStream
+}
+
+type cacheServiceGetRateLimitKeysClient struct {
+	grpc.ClientStream
+}
+
+func (x *cacheServiceGetRateLimitKeysClient) Recv() (*CacheKey, error) {
+	m := new(CacheKey)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *cacheServiceClient) GetRateLimit(ctx context.Context, in *GetRateLimitCacheKeyMessage, opts ...grpc.CallOption) (*GetRateLimitCacheKeyReply, error) {
+	out := new(GetRateLimitCacheKeyReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/GetRateLimit", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) DeleteRateLimit(ctx context.Context, in *DeleteRateLimitCacheKeyMessage, opts ...grpc.CallOption) (*DeleteRateLimitCacheKeyReply, error) {
+	out := new(DeleteRateLimitCacheKeyReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/DeleteRateLimit", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) TakeRateLimit(ctx context.Context, in *TakeRateLimitCacheKeyMessage, opts ...grpc.CallOption) (*TakeRateLimitCacheKeyReply, error) {
+	out := new(TakeRateLimitCacheKeyReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/TakeRateLimit", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) GetLockKeys(ctx context.Context, in *GetCacheKeysMessage, opts ...grpc.CallOption) (CacheService_GetLockKeysClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_CacheService_serviceDesc.Streams[12], c.cc, "/messages.CacheService/GetLockKeys", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &cacheServiceGetLockKeysClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CacheService_GetLockKeysClient interface {
+	Recv() (*CacheKey, error)
+	grpc.ClientStream
+}
+
+type cacheServiceGetLockKeysClient struct {
+	grpc.ClientStream
+}
+
+func (x *cacheServiceGetLockKeysClient) Recv() (*CacheKey, error) {
+	m := new(CacheKey)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *cacheServiceClient) GetLock(ctx context.Context, in *GetLockCacheKeyMessage, opts ...grpc.CallOption) (*GetLockCacheKeyReply, error) {
+	out := new(GetLockCacheKeyReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/GetLock", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) AcquireLock(ctx context.Context, in *AcquireLockCacheKeyMessage, opts ...grpc.CallOption) (*AcquireLockCacheKeyReply, error) {
+	out := new(AcquireLockCacheKeyReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/AcquireLock", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) RenewLock(ctx context.Context, in *RenewLockCacheKeyMessage, opts ...grpc.CallOption) (*RenewLockCacheKeyReply, error) {
+	out := new(RenewLockCacheKeyReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/RenewLock", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) ReleaseLock(ctx context.Context, in *ReleaseLockCacheKeyMessage, opts ...grpc.CallOption) (*ReleaseLockCacheKeyReply, error) {
+	out := new(ReleaseLockCacheKeyReply)
+	err := grpc.Invoke(ctx, "/messages.CacheService/ReleaseLock", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for CacheService service
+
+type CacheServiceServer interface {
+	GetStringKeys(*GetCacheKeysMessage, CacheService_GetStringKeysServer) error
+	GetString(context.Context, *GetStringCacheKeyMessage) (*GetStringCacheKeyReply, error)
+	PostString(context.Context, *PostStringCacheKeyMessage) (*PostStringCacheKeyReply, error)
+	PutString(context.Context, *PutStringCacheKeyMessage) (*PutStringCacheKeyReply, error)
+	SetString(context.Context, *SetStringCacheKeyMessage) (*SetStringCacheKeyReply, error)
+	ReplaceString(context.Context, *ReplaceStringCacheKeyMessage) (*ReplaceStringCacheKeyReply, error)
+	CompareAndSwapString(context.Context, *CompareAndSwapStringCacheKeyMessage) (*CompareAndSwapStringCacheKeyReply, error)
+	TouchString(context.Context, *TouchStringCacheKeyMessage) (*TouchStringCacheKeyReply, error)
+	DeleteString(context.Context, *DeleteStringCacheKeyMessage) (*DeleteStringCacheKeyReply, error)
+	IncrementString(context.Context, *IncrementStringCacheKeyMessage) (*IncrementStringCacheKeyReply, error)
+	SetBitString(context.Context, *SetBitStringCacheKeyMessage) (*SetBitStringCacheKeyReply, error)
+	GetBitString(context.Context, *GetBitStringCacheKeyMessage) (*GetBitStringCacheKeyReply, error)
+	CountBitsString(context.Context, *CountBitsStringCacheKeyMessage) (*CountBitsStringCacheKeyReply, error)
+	GetBitPosString(context.Context, *GetBitPosStringCacheKeyMessage) (*GetBitPosStringCacheKeyReply, error)
+	BitOpString(context.Context, *BitOpStringCacheKeyMessage) (*BitOpStringCacheKeyReply, error)
+	BitFieldString(context.Context, *BitFieldStringCacheKeyMessage) (*BitFieldStringCacheKeyReply, error)
+	GetListKeys(*GetCacheKeysMessage, CacheService_GetListKeysServer) error
+	GetList(context.Context, *GetListCacheKeyMessage) (*GetListCacheKeyReply, error)
+	PostList(context.Context, *PostListCacheKeyMessage) (*PostListCacheKeyReply, error)
+	DeleteList(context.Context, *DeleteListCacheKeyMessage) (*DeleteListCacheKeyReply, error)
+	PostListValue(context.Context, *PostListCacheValueMessage) (*PostListCacheValueReply, error)
+	PutListValue(context.Context, *PutListCacheValueMessage) (*PutListCacheValueReply, error)
+	DeleteListValue(context.Context, *DeleteListCacheValueMessage) (*DeleteListCacheValueReply, error)
+	GetListIndex(context.Context, *GetListCacheIndexMessage) (*GetListCacheIndexReply, error)
+	SetListIndex(context.Context, *SetListCacheIndexMessage) (*SetListCacheIndexReply, error)
+	InsertListValue(context.Context, *InsertListCacheValueMessage) (*InsertListCacheValueReply, error)
+	RemoveListIndex(context.Context, *RemoveListCacheIndexMessage) (*RemoveListCacheIndexReply, error)
+	GetListRange(context.Context, *GetListCacheRangeMessage) (*GetListCacheRangeReply, error)
+	PushListValues(context.Context, *PushListCacheValuesMessage) (*PushListCacheValuesReply, error)
+	PopListValues(context.Context, *PopListCacheValuesMessage) (*PopListCacheValuesReply, error)
+	TrimList(context.Context, *TrimListCacheKeyMessage) (*TrimListCacheKeyReply, error)
+	GetDictionaryKeys(*GetCacheKeysMessage, CacheService_GetDictionaryKeysServer) error
+	GetDictionary(context.Context, *GetDictionaryCacheKeyMessage) (*GetDictionaryCacheKeyReply, error)
+	PostDictionary(context.Context, *PostDictionaryCacheKeyMessage) (*PostDictionaryCacheKeyReply, error)
+	DeleteDictionary(context.Context, *DeleteDictionaryCacheKeyMessage) (*DeleteDictionaryCacheKeyReply, error)
+	PostDictionaryValue(context.Context, *PostDictionaryCacheValueMessage) (*PostDictionaryCacheValueReply, error)
+	PutDictionaryValue(context.Context, *PutDictionaryCacheValueMessage) (*PutDictionaryCacheValueReply, error)
+	DeleteDictionaryValue(context.Context, *DeleteDictionaryCacheValueMessage) (*DeleteDictionaryCacheValueReply, error)
+	GetDictionaryValues(context.Context, *GetDictionaryCacheValuesMessage) (*GetDictionaryCacheValuesReply, error)
+	SetDictionaryValues(context.Context, *SetDictionaryCacheValuesMessage) (*SetDictionaryCacheValuesReply, error)
+	DeleteDictionaryValues(context.Context, *DeleteDictionaryCacheValuesMessage) (*DeleteDictionaryCacheValuesReply, error)
+	GetDictionarySubKeys(context.Context, *GetDictionaryCacheSubKeysMessage) (*GetDictionaryCacheSubKeysReply, error)
+	ContainsDictionaryValues(context.Context, *ContainsDictionaryCacheValuesMessage) (*ContainsDictionaryCacheValuesReply, error)
+	IncrementDictionaryValue(context.Context, *IncrementDictionaryCacheValueMessage) (*IncrementDictionaryCacheValueReply, error)
+	GetSetKeys(*GetCacheKeysMessage, CacheService_GetSetKeysServer) error
+	GetSet(context.Context, *GetSetCacheKeyMessage) (*GetSetCacheKeyReply, error)
+	PostSet(context.Context, *PostSetCacheKeyMessage) (*PostSetCacheKeyReply, error)
+	DeleteSet(context.Context, *DeleteSetCacheKeyMessage) (*DeleteSetCacheKeyReply, error)
+	PostSetValues(context.Context, *PostSetCacheValuesMessage) (*PostSetCacheValuesReply, error)
+	DeleteSetValues(context.Context, *DeleteSetCacheValuesMessage) (*DeleteSetCacheValuesReply, error)
+	ContainsSetValue(context.Context, *ContainsSetCacheValueMessage) (*ContainsSetCacheValueReply, error)
+	GetSetCardinality(context.Context, *GetSetCacheCardinalityMessage) (*GetSetCacheCardinalityReply, error)
+	GetSetRandomValues(context.Context, *GetSetCacheRandomValuesMessage) (*GetSetCacheRandomValuesReply, error)
+	GetSortedSetKeys(*GetCacheKeysMessage, CacheService_GetSortedSetKeysServer) error
+	GetSortedSet(context.Context, *GetSortedSetCacheKeyMessage) (*GetSortedSetCacheKeyReply, error)
+	PostSortedSet(context.Context, *PostSortedSetCacheKeyMessage) (*PostSortedSetCacheKeyReply, error)
+	DeleteSortedSet(context.Context, *DeleteSortedSetCacheKeyMessage) (*DeleteSortedSetCacheKeyReply, error)
+	PostSortedSetValues(context.Context, *PostSortedSetCacheValuesMessage) (*PostSortedSetCacheValuesReply, error)
+	IncrementSortedSetScore(context.Context, *IncrementSortedSetCacheScoreMessage) (*IncrementSortedSetCacheScoreReply, error)
+	DeleteSortedSetValues(context.Context, *DeleteSortedSetCacheValuesMessage) (*DeleteSortedSetCacheValuesReply, error)
+	GetSortedSetRange(context.Context, *GetSortedSetCacheRangeMessage) (*GetSortedSetCacheRangeReply, error)
+	GetSortedSetRangeByScore(context.Context, *GetSortedSetCacheRangeByScoreMessage) (*GetSortedSetCacheRangeByScoreReply, error)
+	GetSortedSetRank(context.Context, *GetSortedSetCacheRankMessage) (*GetSortedSetCacheRankReply, error)
+	GetDocumentKeys(*GetCacheKeysMessage, CacheService_GetDocumentKeysServer) error
+	GetDocument(context.Context, *GetDocumentCacheKeyMessage) (*GetDocumentCacheKeyReply, error)
+	PostDocument(context.Context, *PostDocumentCacheKeyMessage) (*PostDocumentCacheKeyReply, error)
+	DeleteDocument(context.Context, *DeleteDocumentCacheKeyMessage) (*DeleteDocumentCacheKeyReply, error)
+	GetDocumentPath(context.Context, *GetDocumentCachePathMessage) (*GetDocumentCachePathReply, error)
+	SetDocumentPath(context.Context, *SetDocumentCachePathMessage) (*SetDocumentCachePathReply, error)
+	DeleteDocumentPath(context.Context, *DeleteDocumentCachePathMessage) (*DeleteDocumentCachePathReply, error)
+	AppendDocumentValues(context.Context, *AppendDocumentCacheValuesMessage) (*AppendDocumentCacheValuesReply, error)
+	MergeDocument(context.Context, *MergeDocumentCacheMessage) (*MergeDocumentCacheReply, error)
+	PatchDocument(context.Context, *PatchDocumentCacheMessage) (*PatchDocumentCacheReply, error)
+	GetHyperLogLogKeys(*GetCacheKeysMessage, CacheService_GetHyperLogLogKeysServer) error
+	GetHyperLogLog(context.Context, *GetHyperLogLogCacheKeyMessage) (*GetHyperLogLogCacheKeyReply, error)
+	PostHyperLogLog(context.Context, *PostHyperLogLogCacheKeyMessage) (*PostHyperLogLogCacheKeyReply, error)
+	DeleteHyperLogLog(context.Context, *DeleteHyperLogLogCacheKeyMessage) (*DeleteHyperLogLogCacheKeyReply, error)
+	AddHyperLogLogValues(context.Context, *AddHyperLogLogCacheValuesMessage) (*AddHyperLogLogCacheValuesReply, error)
+	MergeHyperLogLog(context.Context, *MergeHyperLogLogCacheMessage) (*MergeHyperLogLogCacheReply, error)
+	GetBloomFilterKeys(*GetCacheKeysMessage, CacheService_GetBloomFilterKeysServer) error
+	GetBloomFilter(context.Context, *GetBloomFilterCacheKeyMessage) (*GetBloomFilterCacheKeyReply, error)
+	PostBloomFilter(context.Context, *PostBloomFilterCacheKeyMessage) (*PostBloomFilterCacheKeyReply, error)
+	DeleteBloomFilter(context.Context, *DeleteBloomFilterCacheKeyMessage) (*DeleteBloomFilterCacheKeyReply, error)
+	AddBloomFilterValues(context.Context, *AddBloomFilterCacheValuesMessage) (*AddBloomFilterCacheValuesReply, error)
+	ExistsBloomFilterValues(context.Context, *ExistsBloomFilterCacheValuesMessage) (*ExistsBloomFilterCacheValuesReply, error)
+	GetStreamKeys(*GetCacheKeysMessage, CacheService_GetStreamKeysServer) error
+	GetStream(context.Context, *GetStreamCacheKeyMessage) (*GetStreamCacheKeyReply, error)
+	PostStream(context.Context, *PostStreamCacheKeyMessage) (*PostStreamCacheKeyReply, error)
+	DeleteStream(context.Context, *DeleteStreamCacheKeyMessage) (*DeleteStreamCacheKeyReply, error)
+	AppendStreamEntry(context.Context, *AppendStreamCacheEntryMessage) (*AppendStreamCacheEntryReply, error)
+	GetStreamRange(context.Context, *GetStreamCacheRangeMessage) (*GetStreamCacheRangeReply, error)
+	TrimStream(context.Context, *TrimStreamCacheKeyMessage) (*TrimStreamCacheKeyReply, error)
+	GetStreamGroups(context.Context, *GetStreamCacheGroupsMessage) (*GetStreamCacheGroupsReply, error)
+	CreateStreamGroup(context.Context, *CreateStreamCacheGroupMessage) (*CreateStreamCacheGroupReply, error)
+	DeleteStreamGroup(context.Context, *DeleteStreamCacheGroupMessage) (*DeleteStreamCacheGroupReply, error)
+	ReadStreamGroup(context.Context, *ReadStreamCacheGroupMessage) (*ReadStreamCacheGroupReply, error)
+	AckStreamGroup(context.Context, *AckStreamCacheGroupMessage) (*AckStreamCacheGroupReply, error)
+	GetStreamPending(context.Context, *GetStreamCachePendingMessage) (*GetStreamCachePendingReply, error)
+	GetGeoKeys(*GetCacheKeysMessage, CacheService_GetGeoKeysServer) error
+	GetGeo(context.Context, *GetGeoCacheKeyMessage) (*GetGeoCacheKeyReply, error)
+	PostGeo(context.Context, *PostGeoCacheKeyMessage) (*PostGeoCacheKeyReply, error)
+	DeleteGeo(context.Context, *DeleteGeoCacheKeyMessage) (*DeleteGeoCacheKeyReply, error)
+	AddGeoMembers(context.Context, *AddGeoCacheMembersMessage) (*AddGeoCacheMembersReply, error)
+	RemoveGeoMembers(context.Context, *RemoveGeoCacheMembersMessage) (*RemoveGeoCacheMembersReply, error)
+	GetGeoPositions(context.Context, *GetGeoCachePositionsMessage) (*GetGeoCachePositionsReply, error)
+	GetGeoDistance(context.Context, *GetGeoCacheDistanceMessage) (*GetGeoCacheDistanceReply, error)
+	SearchGeo(context.Context, *SearchGeoCacheMessage) (*SearchGeoCacheReply, error)
+	GetQueueKeys(*GetCacheKeysMessage, CacheService_GetQueueKeysServer) error
+	GetQueue(context.Context, *GetQueueCacheKeyMessage) (*GetQueueCacheKeyReply, error)
+	PostQueue(context.Context, *PostQueueCacheKeyMessage) (*PostQueueCacheKeyReply, error)
+	DeleteQueue(context.Context, *DeleteQueueCacheKeyMessage) (*DeleteQueueCacheKeyReply, error)
+	PushQueue(context.Context, *PushQueueCacheItemsMessage) (*PushQueueCacheItemsReply, error)
+	PopQueue(context.Context, *PopQueueCacheItemsMessage) (*PopQueueCacheItemsReply, error)
+	PeekQueue(context.Context, *PeekQueueCacheItemsMessage) (*PeekQueueCacheItemsReply, error)
+	AckQueue(context.Context, *AckQueueCacheItemsMessage) (*AckQueueCacheItemsReply, error)
+	NackQueue(context.Context, *NackQueueCacheItemMessage) (*NackQueueCacheItemReply, error)
+	GetQueueDead(context.Context, *GetQueueCacheDeadMessage) (*GetQueueCacheDeadReply, error)
+	RedriveQueue(context.Context, *RedriveQueueCacheDeadMessage) (*RedriveQueueCacheDeadReply, error)
+	GetRateLimitKeys(*GetCacheKeysMessage, CacheService_GetRateLimitKeysServer) error
+	GetRateLimit(context.Context, *GetRateLimitCacheKeyMessage) (*GetRateLimitCacheKeyReply, error)
+	DeleteRateLimit(context.Context, *DeleteRateLimitCacheKeyMessage) (*DeleteRateLimitCacheKeyReply, error)
+	TakeRateLimit(context.Context, *TakeRateLimitCacheKeyMessage) (*TakeRateLimitCacheKeyReply, error)
+	GetLockKeys(*GetCacheKeysMessage, CacheService_GetLockKeysServer) error
+	GetLock(context.Context, *GetLockCacheKeyMessage) (*GetLockCacheKeyReply, error)
+	AcquireLock(context.Context, *AcquireLockCacheKeyMessage) (*AcquireLockCacheKeyReply, error)
+	RenewLock(context.Context, *RenewLockCacheKeyMessage) (*RenewLockCacheKeyReply, error)
+	ReleaseLock(context.Context, *ReleaseLockCacheKeyMessage) (*ReleaseLockCacheKeyReply, error)
+}
+
+func RegisterCacheServiceServer(s *grpc.Server, srv CacheServiceServer) {
+	s.RegisterService(&_CacheService_serviceDesc, srv)
+}
+
+func _CacheService_GetStringKeys_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetCacheKeysMessage)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CacheServiceServer).GetStringKeys(m, &cacheServiceGetStringKeysServer{stream})
+}
+
+type CacheService_GetStringKeysServer interface {
+	Send(*CacheKey) error
+	grpc.ServerStream
+}
+
+type cacheServiceGetStringKeysServer struct {
+	grpc.ServerStream
+}
+
+func (x *cacheServiceGetStringKeysServer) Send(m *CacheKey) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _CacheService_GetString_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStringCacheKeyMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).GetString(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/GetString",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).GetString(ctx, req.(*GetStringCacheKeyMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_PostString_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostStringCacheKeyMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).PostString(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/PostString",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).PostString(ctx, req.(*PostStringCacheKeyMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_PutString_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutStringCacheKeyMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).PutString(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/PutString",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).PutString(ctx, req.(*PutStringCacheKeyMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_SetString_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetStringCacheKeyMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).SetString(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/SetString",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).SetString(ctx, req.(*SetStringCacheKeyMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_ReplaceString_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplaceStringCacheKeyMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).ReplaceString(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/ReplaceString",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).ReplaceString(ctx, req.(*ReplaceStringCacheKeyMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_CompareAndSwapString_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompareAndSwapStringCacheKeyMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).CompareAndSwapString(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/CompareAndSwapString",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).CompareAndSwapString(ctx, req.(*CompareAndSwapStringCacheKeyMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_TouchString_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TouchStringCacheKeyMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).TouchString(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/TouchString",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).TouchString(ctx, req.(*TouchStringCacheKeyMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_DeleteString_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteStringCacheKeyMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).DeleteString(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/DeleteString",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).DeleteString(ctx, req.(*DeleteStringCacheKeyMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_IncrementString_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IncrementStringCacheKeyMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).IncrementString(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/IncrementString",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).IncrementString(ctx, req.(*IncrementStringCacheKeyMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_SetBitString_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBitStringCacheKeyMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).SetBitString(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/SetBitString",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).SetBitString(ctx, req.(*SetBitStringCacheKeyMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_GetBitString_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBitStringCacheKeyMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).GetBitString(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/GetBitString",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).GetBitString(ctx, req.(*GetBitStringCacheKeyMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_CountBitsString_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountBitsStringCacheKeyMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).CountBitsString(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/CountBitsString",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).CountBitsString(ctx, req.(*CountBitsStringCacheKeyMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_GetBitPosString_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBitPosStringCacheKeyMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).GetBitPosString(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/GetBitPosString",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).GetBitPosString(ctx, req.(*GetBitPosStringCacheKeyMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_BitOpString_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BitOpStringCacheKeyMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).BitOpString(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/BitOpString",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).BitOpString(ctx, req.(*BitOpStringCacheKeyMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_BitFieldString_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BitFieldStringCacheKeyMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).BitFieldString(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/BitFieldString",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).BitFieldString(ctx, req.(*BitFieldStringCacheKeyMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_GetListKeys_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetCacheKeysMessage)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CacheServiceServer).GetListKeys(m, &cacheServiceGetListKeysServer{stream})
+}
+
+type CacheService_GetListKeysServer interface {
+	Send(*CacheKey) error
+	grpc.ServerStream
+}
+
+type cacheServiceGetListKeysServer struct {
+	grpc.ServerStream
+}
+
+func (x *cacheServiceGetListKeysServer) Send(m *CacheKey) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _CacheService_GetList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListCacheKeyMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).GetList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/GetList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).GetList(ctx, req.(*GetListCacheKeyMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_PostList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostListCacheKeyMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).PostList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/PostList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).PostList(ctx, req.(*PostListCacheKeyMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_DeleteList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteListCacheKeyMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).DeleteList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/DeleteList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).DeleteList(ctx, req.(*DeleteListCacheKeyMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_PostListValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostListCacheValueMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).PostListValue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/PostListValue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).PostListValue(ctx, req.(*PostListCacheValueMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_PutListValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutListCacheValueMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).PutListValue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/PutListValue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).PutListValue(ctx, req.(*PutListCacheValueMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_DeleteListValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteListCacheValueMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).DeleteListValue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/DeleteListValue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).DeleteListValue(ctx, req.(*DeleteListCacheValueMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_GetListIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListCacheIndexMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).GetListIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/GetListIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).GetListIndex(ctx, req.(*GetListCacheIndexMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_SetListIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetListCacheIndexMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).SetListIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/SetListIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).SetListIndex(ctx, req.(*SetListCacheIndexMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_InsertListValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InsertListCacheValueMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).InsertListValue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/InsertListValue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).InsertListValue(ctx, req.(*InsertListCacheValueMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_RemoveListIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveListCacheIndexMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).RemoveListIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/RemoveListIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).RemoveListIndex(ctx, req.(*RemoveListCacheIndexMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_GetListRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListCacheRangeMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).GetListRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/GetListRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).GetListRange(ctx, req.(*GetListCacheRangeMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_PushListValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PushListCacheValuesMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).PushListValues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/PushListValues",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).PushListValues(ctx, req.(*PushListCacheValuesMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_PopListValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PopListCacheValuesMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).PopListValues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/PopListValues",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).PopListValues(ctx, req.(*PopListCacheValuesMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_TrimList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrimListCacheKeyMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).TrimList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/TrimList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).TrimList(ctx, req.(*TrimListCacheKeyMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_GetDictionaryKeys_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetCacheKeysMessage)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CacheServiceServer).GetDictionaryKeys(m, &cacheServiceGetDictionaryKeysServer{stream})
+}
+
+type CacheService_GetDictionaryKeysServer interface {
+	Send(*CacheKey) error
+	grpc.ServerStream
+}
+
+type cacheServiceGetDictionaryKeysServer struct {
+	grpc.ServerStream
+}
+
+func (x *cacheServiceGetDictionaryKeysServer) Send(m *CacheKey) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _CacheService_GetDictionary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDictionaryCacheKeyMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).GetDictionary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/GetDictionary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).GetDictionary(ctx, req.(*GetDictionaryCacheKeyMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_PostDictionary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostDictionaryCacheKeyMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).PostDictionary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/PostDictionary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).PostDictionary(ctx, req.(*PostDictionaryCacheKeyMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_DeleteDictionary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDictionaryCacheKeyMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).DeleteDictionary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/DeleteDictionary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).DeleteDictionary(ctx, req.(*DeleteDictionaryCacheKeyMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_PostDictionaryValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostDictionaryCacheValueMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).PostDictionaryValue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/PostDictionaryValue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).PostDictionaryValue(ctx, req.(*PostDictionaryCacheValueMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_PutDictionaryValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutDictionaryCacheValueMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).PutDictionaryValue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/PutDictionaryValue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).PutDictionaryValue(ctx, req.(*PutDictionaryCacheValueMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_DeleteDictionaryValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDictionaryCacheValueMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).DeleteDictionaryValue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/DeleteDictionaryValue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).DeleteDictionaryValue(ctx, req.(*DeleteDictionaryCacheValueMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_GetDictionaryValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDictionaryCacheValuesMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).GetDictionaryValues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/GetDictionaryValues",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).GetDictionaryValues(ctx, req.(*GetDictionaryCacheValuesMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_SetDictionaryValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDictionaryCacheValuesMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).SetDictionaryValues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/SetDictionaryValues",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).SetDictionaryValues(ctx, req.(*SetDictionaryCacheValuesMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_DeleteDictionaryValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDictionaryCacheValuesMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).DeleteDictionaryValues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/DeleteDictionaryValues",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).DeleteDictionaryValues(ctx, req.(*DeleteDictionaryCacheValuesMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_GetDictionarySubKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDictionaryCacheSubKeysMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).GetDictionarySubKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/GetDictionarySubKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).GetDictionarySubKeys(ctx, req.(*GetDictionaryCacheSubKeysMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_ContainsDictionaryValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContainsDictionaryCacheValuesMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).ContainsDictionaryValues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/ContainsDictionaryValues",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).ContainsDictionaryValues(ctx, req.(*ContainsDictionaryCacheValuesMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_IncrementDictionaryValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IncrementDictionaryCacheValueMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).IncrementDictionaryValue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/IncrementDictionaryValue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).IncrementDictionaryValue(ctx, req.(*IncrementDictionaryCacheValueMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_GetSetKeys_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetCacheKeysMessage)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CacheServiceServer).GetSetKeys(m, &cacheServiceGetSetKeysServer{stream})
+}
+
+type CacheService_GetSetKeysServer interface {
+	Send(*CacheKey) error
+	grpc.ServerStream
+}
+
+type cacheServiceGetSetKeysServer struct {
+	grpc.ServerStream
+}
+
+func (x *cacheServiceGetSetKeysServer) Send(m *CacheKey) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _CacheService_GetSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSetCacheKeyMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).GetSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/GetSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).GetSet(ctx, req.(*GetSetCacheKeyMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_PostSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostSetCacheKeyMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).PostSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/PostSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).PostSet(ctx, req.(*PostSetCacheKeyMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_DeleteSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSetCacheKeyMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).DeleteSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/DeleteSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).DeleteSet(ctx, req.(*DeleteSetCacheKeyMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_PostSetValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostSetCacheValuesMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).PostSetValues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/PostSetValues",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).PostSetValues(ctx, req.(*PostSetCacheValuesMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_DeleteSetValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSetCacheValuesMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).DeleteSetValues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/DeleteSetValues",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).DeleteSetValues(ctx, req.(*DeleteSetCacheValuesMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_ContainsSetValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContainsSetCacheValueMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).ContainsSetValue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/ContainsSetValue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).ContainsSetValue(ctx, req.(*ContainsSetCacheValueMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_GetSetCardinality_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSetCacheCardinalityMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).GetSetCardinality(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/GetSetCardinality",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).GetSetCardinality(ctx, req.(*GetSetCacheCardinalityMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_GetSetRandomValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSetCacheRandomValuesMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).GetSetRandomValues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/GetSetRandomValues",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).GetSetRandomValues(ctx, req.(*GetSetCacheRandomValuesMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_GetSortedSetKeys_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetCacheKeysMessage)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CacheServiceServer).GetSortedSetKeys(m, &cacheServiceGetSortedSetKeysServer{stream})
+}
+
+type CacheService_GetSortedSetKeysServer interface {
+	Send(*CacheKey) error
+	grpc.ServerStream
+}
+
+type cacheServiceGetSortedSetKeysServer struct {
+	grpc.ServerStream
+}
+
+func (x *cacheServiceGetSortedSetKeysServer) Send(m *CacheKey) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _CacheService_GetSortedSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSortedSetCacheKeyMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).GetSortedSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/GetSortedSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).GetSortedSet(ctx, req.(*GetSortedSetCacheKeyMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_PostSortedSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostSortedSetCacheKeyMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).PostSortedSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/PostSortedSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).PostSortedSet(ctx, req.(*PostSortedSetCacheKeyMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_DeleteSortedSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSortedSetCacheKeyMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).DeleteSortedSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/DeleteSortedSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).DeleteSortedSet(ctx, req.(*DeleteSortedSetCacheKeyMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_PostSortedSetValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostSortedSetCacheValuesMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).PostSortedSetValues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/PostSortedSetValues",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).PostSortedSetValues(ctx, req.(*PostSortedSetCacheValuesMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_IncrementSortedSetScore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IncrementSortedSetCacheScoreMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).IncrementSortedSetScore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/IncrementSortedSetScore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).IncrementSortedSetScore(ctx, req.(*IncrementSortedSetCacheScoreMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_DeleteSortedSetValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSortedSetCacheValuesMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).DeleteSortedSetValues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/DeleteSortedSetValues",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).DeleteSortedSetValues(ctx, req.(*DeleteSortedSetCacheValuesMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_GetSortedSetRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSortedSetCacheRangeMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).GetSortedSetRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/GetSortedSetRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).GetSortedSetRange(ctx, req.(*GetSortedSetCacheRangeMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_GetSortedSetRangeByScore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSortedSetCacheRangeByScoreMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).GetSortedSetRangeByScore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/GetSortedSetRangeByScore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).GetSortedSetRangeByScore(ctx, req.(*GetSortedSetCacheRangeByScoreMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_GetSortedSetRank_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSortedSetCacheRankMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).GetSortedSetRank(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/GetSortedSetRank",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).GetSortedSetRank(ctx, req.(*GetSortedSetCacheRankMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_GetDocumentKeys_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetCacheKeysMessage)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CacheServiceServer).GetDocumentKeys(m, &cacheServiceGetDocumentKeysServer{stream})
+}
+
+type CacheService_GetDocumentKeysServer interface {
+	Send(*CacheKey) error
+	grpc.ServerStream
+}
+
+type cacheServiceGetDocumentKeysServer struct {
+	grpc.ServerStream
+}
+
+func (x *cacheServiceGetDocumentKeysServer) Send(m *CacheKey) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _CacheService_GetDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDocumentCacheKeyMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).GetDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/GetDocument",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).GetDocument(ctx, req.(*GetDocumentCacheKeyMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_PostDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostDocumentCacheKeyMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).PostDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/PostDocument",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).PostDocument(ctx, req.(*PostDocumentCacheKeyMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_DeleteDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDocumentCacheKeyMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).DeleteDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/DeleteDocument",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).DeleteDocument(ctx, req.(*DeleteDocumentCacheKeyMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_GetDocumentPath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDocumentCachePathMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).GetDocumentPath(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/GetDocumentPath",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).GetDocumentPath(ctx, req.(*GetDocumentCachePathMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_SetDocumentPath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDocumentCachePathMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).SetDocumentPath(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/SetDocumentPath",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).SetDocumentPath(ctx, req.(*SetDocumentCachePathMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_DeleteDocumentPath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDocumentCachePathMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).DeleteDocumentPath(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/DeleteDocumentPath",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).DeleteDocumentPath(ctx, req.(*DeleteDocumentCachePathMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_AppendDocumentValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AppendDocumentCacheValuesMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).AppendDocumentValues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/AppendDocumentValues",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).AppendDocumentValues(ctx, req.(*AppendDocumentCacheValuesMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_MergeDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeDocumentCacheMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).MergeDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/MergeDocument",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).MergeDocument(ctx, req.(*MergeDocumentCacheMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_PatchDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchDocumentCacheMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).PatchDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/PatchDocument",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).PatchDocument(ctx, req.(*PatchDocumentCacheMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_GetHyperLogLogKeys_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetCacheKeysMessage)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CacheServiceServer).GetHyperLogLogKeys(m, &cacheServiceGetHyperLogLogKeysServer{stream})
+}
+
+type CacheService_GetHyperLogLogKeysServer interface {
+	Send(*CacheKey) error
+	grpc.ServerStream
+}
+
+type cacheServiceGetHyperLogLogKeysServer struct {
+	grpc.ServerStream
+}
+
+func (x *cacheServiceGetHyperLogLogKeysServer) Send(m *CacheKey) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _CacheService_GetHyperLogLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHyperLogLogCacheKeyMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).GetHyperLogLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/GetHyperLogLog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).GetHyperLogLog(ctx, req.(*GetHyperLogLogCacheKeyMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_PostHyperLogLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostHyperLogLogCacheKeyMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).PostHyperLogLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/PostHyperLogLog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).PostHyperLogLog(ctx, req.(*PostHyperLogLogCacheKeyMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_DeleteHyperLogLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteHyperLogLogCacheKeyMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).DeleteHyperLogLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/DeleteHyperLogLog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).DeleteHyperLogLog(ctx, req.(*DeleteHyperLogLogCacheKeyMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_AddHyperLogLogValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddHyperLogLogCacheValuesMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).AddHyperLogLogValues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/AddHyperLogLogValues",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).AddHyperLogLogValues(ctx, req.(*AddHyperLogLogCacheValuesMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_MergeHyperLogLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeHyperLogLogCacheMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).MergeHyperLogLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/MergeHyperLogLog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).MergeHyperLogLog(ctx, req.(*MergeHyperLogLogCacheMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_GetBloomFilterKeys_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetCacheKeysMessage)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CacheServiceServer).GetBloomFilterKeys(m, &cacheServiceGetBloomFilterKeysServer{stream})
+}
+
+type CacheService_GetBloomFilterKeysServer interface {
+	Send(*CacheKey) error
+	grpc.ServerStream
+}
+
+type cacheServiceGetBloomFilterKeysServer struct {
+	grpc.ServerStream
+}
+
+func (x *cacheServiceGetBloomFilterKeysServer) Send(m *CacheKey) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _CacheService_GetBloomFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBloomFilterCacheKeyMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).GetBloomFilter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/GetBloomFilter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).GetBloomFilter(ctx, req.(*GetBloomFilterCacheKeyMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_PostBloomFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostBloomFilterCacheKeyMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).PostBloomFilter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/PostBloomFilter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).PostBloomFilter(ctx, req.(*PostBloomFilterCacheKeyMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_DeleteBloomFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBloomFilterCacheKeyMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).DeleteBloomFilter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/DeleteBloomFilter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).DeleteBloomFilter(ctx, req.(*DeleteBloomFilterCacheKeyMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_AddBloomFilterValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddBloomFilterCacheValuesMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).AddBloomFilterValues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/AddBloomFilterValues",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).AddBloomFilterValues(ctx, req.(*AddBloomFilterCacheValuesMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_ExistsBloomFilterValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExistsBloomFilterCacheValuesMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).ExistsBloomFilterValues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/ExistsBloomFilterValues",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).ExistsBloomFilterValues(ctx, req.(*ExistsBloomFilterCacheValuesMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_GetStreamKeys_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetCacheKeysMessage)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CacheServiceServer).GetStreamKeys(m, &cacheServiceGetStreamKeysServer{stream})
+}
+
+type CacheService_GetStreamKeysServer interface {
+	Send(*CacheKey) error
+	grpc.ServerStream
+}
+
+type cacheServiceGetStreamKeysServer struct {
+	grpc.ServerStream
+}
+
+func (x *cacheServiceGetStreamKeysServer) Send(m *CacheKey) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _CacheService_GetStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStreamCacheKeyMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).GetStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/GetStream",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).GetStream(ctx, req.(*GetStreamCacheKeyMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_PostStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostStreamCacheKeyMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).PostStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/PostStream",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).PostStream(ctx, req.(*PostStreamCacheKeyMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_DeleteStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteStreamCacheKeyMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).DeleteStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/DeleteStream",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).DeleteStream(ctx, req.(*DeleteStreamCacheKeyMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_AppendStreamEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AppendStreamCacheEntryMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).AppendStreamEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/AppendStreamEntry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).AppendStreamEntry(ctx, req.(*AppendStreamCacheEntryMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_GetStreamRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStreamCacheRangeMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).GetStreamRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/GetStreamRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).GetStreamRange(ctx, req.(*GetStreamCacheRangeMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_TrimStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrimStreamCacheKeyMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).TrimStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/TrimStream",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).TrimStream(ctx, req.(*TrimStreamCacheKeyMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_GetStreamGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStreamCacheGroupsMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).GetStreamGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/GetStreamGroups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).GetStreamGroups(ctx, req.(*GetStreamCacheGroupsMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_CreateStreamGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateStreamCacheGroupMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).CreateStreamGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/CreateStreamGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).CreateStreamGroup(ctx, req.(*CreateStreamCacheGroupMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_DeleteStreamGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteStreamCacheGroupMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).DeleteStreamGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/DeleteStreamGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).DeleteStreamGroup(ctx, req.(*DeleteStreamCacheGroupMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_ReadStreamGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadStreamCacheGroupMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).ReadStreamGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/ReadStreamGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).ReadStreamGroup(ctx, req.(*ReadStreamCacheGroupMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_AckStreamGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AckStreamCacheGroupMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).AckStreamGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/AckStreamGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).AckStreamGroup(ctx, req.(*AckStreamCacheGroupMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_GetStreamPending_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStreamCachePendingMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).GetStreamPending(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/GetStreamPending",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).GetStreamPending(ctx, req.(*GetStreamCachePendingMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_GetGeoKeys_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetCacheKeysMessage)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CacheServiceServer).GetGeoKeys(m, &cacheServiceGetGeoKeysServer{stream})
+}
+
+type CacheService_GetGeoKeysServer interface {
+	Send(*CacheKey) error
+	grpc.ServerStream
+}
+
+type cacheServiceGetGeoKeysServer struct {
+	grpc.ServerStream
+}
+
+func (x *cacheServiceGetGeoKeysServer) Send(m *CacheKey) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _CacheService_GetGeo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGeoCacheKeyMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).GetGeo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/GetGeo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).GetGeo(ctx, req.(*GetGeoCacheKeyMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_PostGeo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostGeoCacheKeyMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).PostGeo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/PostGeo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).PostGeo(ctx, req.(*PostGeoCacheKeyMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_DeleteGeo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGeoCacheKeyMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).DeleteGeo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/DeleteGeo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).DeleteGeo(ctx, req.(*DeleteGeoCacheKeyMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_AddGeoMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddGeoCacheMembersMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).AddGeoMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/AddGeoMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).AddGeoMembers(ctx, req.(*AddGeoCacheMembersMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_RemoveGeoMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveGeoCacheMembersMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).RemoveGeoMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/RemoveGeoMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).RemoveGeoMembers(ctx, req.(*RemoveGeoCacheMembersMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_GetGeoPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGeoCachePositionsMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).GetGeoPositions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/GetGeoPositions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).GetGeoPositions(ctx, req.(*GetGeoCachePositionsMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_GetGeoDistance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGeoCacheDistanceMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).GetGeoDistance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/GetGeoDistance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).GetGeoDistance(ctx, req.(*GetGeoCacheDistanceMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_SearchGeo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchGeoCacheMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).SearchGeo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/SearchGeo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).SearchGeo(ctx, req.(*SearchGeoCacheMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_GetQueueKeys_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetCacheKeysMessage)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CacheServiceServer).GetQueueKeys(m, &cacheServiceGetQueueKeysServer{stream})
+}
+
+type CacheService_GetQueueKeysServer interface {
+	Send(*CacheKey) error
+	grpc.ServerStream
+}
+
+type cacheServiceGetQueueKeysServer struct {
+	grpc.ServerStream
+}
+
+func (x *cacheServiceGetQueueKeysServer) Send(m *CacheKey) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _CacheService_GetQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQueueCacheKeyMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).GetQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/GetQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).GetQueue(ctx, req.(*GetQueueCacheKeyMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_PostQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostQueueCacheKeyMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).PostQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/PostQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).PostQueue(ctx, req.(*PostQueueCacheKeyMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_DeleteQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteQueueCacheKeyMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).DeleteQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/DeleteQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).DeleteQueue(ctx, req.(*DeleteQueueCacheKeyMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_PushQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PushQueueCacheItemsMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).PushQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/PushQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).PushQueue(ctx, req.(*PushQueueCacheItemsMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_PopQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PopQueueCacheItemsMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).PopQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/PopQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).PopQueue(ctx, req.(*PopQueueCacheItemsMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_PeekQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PeekQueueCacheItemsMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).PeekQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/PeekQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).PeekQueue(ctx, req.(*PeekQueueCacheItemsMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_AckQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AckQueueCacheItemsMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).AckQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/AckQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).AckQueue(ctx, req.(*AckQueueCacheItemsMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_NackQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NackQueueCacheItemMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).NackQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/NackQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).NackQueue(ctx, req.(*NackQueueCacheItemMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_GetQueueDead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQueueCacheDeadMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).GetQueueDead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/GetQueueDead",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).GetQueueDead(ctx, req.(*GetQueueCacheDeadMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_RedriveQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedriveQueueCacheDeadMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).RedriveQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/RedriveQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).RedriveQueue(ctx, req.(*RedriveQueueCacheDeadMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_GetRateLimitKeys_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetCacheKeysMessage)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CacheServiceServer).GetRateLimitKeys(m, &cacheServiceGetRateLimitKeysServer{stream})
+}
+
+type CacheService_GetRateLimitKeysServer interface {
+	Send(*CacheKey) error
+	grpc.ServerStream
+}
+
+type cacheServiceGetRateLimitKeysServer struct {
+	grpc.ServerStream
+}
+
+func (x *cacheServiceGetRateLimitKeysServer) Send(m *CacheKey) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _CacheService_GetRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRateLimitCacheKeyMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).GetRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/GetRateLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).GetRateLimit(ctx, req.(*GetRateLimitCacheKeyMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_DeleteRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRateLimitCacheKeyMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).DeleteRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/DeleteRateLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).DeleteRateLimit(ctx, req.(*DeleteRateLimitCacheKeyMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_TakeRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TakeRateLimitCacheKeyMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).TakeRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/TakeRateLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).TakeRateLimit(ctx, req.(*TakeRateLimitCacheKeyMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_GetLockKeys_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetCacheKeysMessage)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CacheServiceServer).GetLockKeys(m, &cacheServiceGetLockKeysServer{stream})
+}
+
+type CacheService_GetLockKeysServer interface {
+	Send(*CacheKey) error
+	grpc.ServerStream
+}
+
+type cacheServiceGetLockKeysServer struct {
+	grpc.ServerStream
+}
+
+func (x *cacheServiceGetLockKeysServer) Send(m *CacheKey) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _CacheService_GetLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLockCacheKeyMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).GetLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/GetLock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).GetLock(ctx, req.(*GetLockCacheKeyMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_AcquireLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcquireLockCacheKeyMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).AcquireLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/AcquireLock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).AcquireLock(ctx, req.(*AcquireLockCacheKeyMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_RenewLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenewLockCacheKeyMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).RenewLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/RenewLock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).RenewLock(ctx, req.(*RenewLockCacheKeyMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_ReleaseLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseLockCacheKeyMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).ReleaseLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.CacheService/ReleaseLock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).ReleaseLock(ctx, req.(*ReleaseLockCacheKeyMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _CacheService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "messages.CacheService",
+	HandlerType: (*CacheServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetString",
+			Handler:    _CacheService_GetString_Handler,
+		},
+		{
+			MethodName: "PostString",
+			Handler:    _CacheService_PostString_Handler,
+		},
+		{
+			MethodName: "PutString",
+			Handler:    _CacheService_PutString_Handler,
+		},
+		{
+			MethodName: "SetString",
+			Handler:    _CacheService_SetString_Handler,
+		},
+		{
+			MethodName: "ReplaceString",
+			Handler:    _CacheService_ReplaceString_Handler,
+		},
+		{
+			MethodName: "CompareAndSwapString",
+			Handler:    _CacheService_CompareAndSwapString_Handler,
+		},
+		{
+			MethodName: "TouchString",
+			Handler:    _CacheService_TouchString_Handler,
+		},
+		{
+			MethodName: "DeleteString",
+			Handler:    _CacheService_DeleteString_Handler,
+		},
+		{
+			MethodName: "IncrementString",
+			Handler:    _CacheService_IncrementString_Handler,
+		},
+		{
+			MethodName: "SetBitString",
+			Handler:    _CacheService_SetBitString_Handler,
+		},
+		{
+			MethodName: "GetBitString",
+			Handler:    _CacheService_GetBitString_Handler,
+		},
+		{
+			MethodName: "CountBitsString",
+			Handler:    _CacheService_CountBitsString_Handler,
+		},
+		{
+			MethodName: "GetBitPosString",
+			Handler:    _CacheService_GetBitPosString_Handler,
+		},
+		{
+			MethodName: "BitOpString",
+			Handler:    _CacheService_BitOpString_Handler,
+		},
+		{
+			MethodName: "BitFieldString",
+			Handler:    _CacheService_BitFieldString_Handler,
+		},
+		{
+			MethodName: "GetList",
+			Handler:    _CacheService_GetList_Handler,
+		},
+		{
+			MethodName: "PostList",
+			Handler:    _CacheService_PostList_Handler,
+		},
+		{
+			MethodName: "DeleteList",
+			Handler:    _CacheService_DeleteList_Handler,
+		},
+		{
+			MethodName: "PostListValue",
+			Handler:    _CacheService_PostListValue_Handler,
+		},
+		{
+			MethodName: "PutListValue",
+			Handler:    _CacheService_PutListValue_Handler,
+		},
+		{
+			MethodName: "DeleteListValue",
+			Handler:    _CacheService_DeleteListValue_Handler,
+		},
+		{
+			MethodName: "GetListIndex",
+			Handler:    _CacheService_GetListIndex_Handler,
+		},
+		{
+			MethodName: "SetListIndex",
+			Handler:    _CacheService_SetListIndex_Handler,
+		},
+		{
+			MethodName: "InsertListValue",
+			Handler:    _CacheService_InsertListValue_Handler,
+		},
+		{
+			MethodName: "RemoveListIndex",
+			Handler:    _CacheService_RemoveListIndex_Handler,
+		},
+		{
+			MethodName: "GetListRange",
+			Handler:    _CacheService_GetListRange_Handler,
+		},
+		{
+			MethodName: "PushListValues",
+			Handler:    _CacheService_PushListValues_Handler,
+		},
+		{
+			MethodName: "PopListValues",
+			Handler:    _CacheService_PopListValues_Handler,
+		},
+		{
+			MethodName: "TrimList",
+			Handler:    _CacheService_TrimList_Handler,
+		},
+		{
+			MethodName: "GetDictionary",
+			Handler:    _CacheService_GetDictionary_Handler,
+		},
+		{
+			MethodName: "PostDictionary",
+			Handler:    _CacheService_PostDictionary_Handler,
+		},
+		{
+			MethodName: "DeleteDictionary",
+			Handler:    _CacheService_DeleteDictionary_Handler,
+		},
+		{
+			MethodName: "PostDictionaryValue",
+			Handler:    _CacheService_PostDictionaryValue_Handler,
+		},
+		{
+			MethodName: "PutDictionaryValue",
+			Handler:    _CacheService_PutDictionaryValue_Handler,
+		},
+		{
+			MethodName: "DeleteDictionaryValue",
+			Handler:    _CacheService_DeleteDictionaryValue_Handler,
+		},
+		{
+			MethodName: "GetDictionaryValues",
+			Handler:    _CacheService_GetDictionaryValues_Handler,
+		},
+		{
+			MethodName: "SetDictionaryValues",
+			Handler:    _CacheService_SetDictionaryValues_Handler,
+		},
+		{
+			MethodName: "DeleteDictionaryValues",
+			Handler:    _CacheService_DeleteDictionaryValues_Handler,
+		},
+		{
+			MethodName: "GetDictionarySubKeys",
+			Handler:    _CacheService_GetDictionarySubKeys_Handler,
+		},
+		{
+			MethodName: "ContainsDictionaryValues",
+			Handler:    _CacheService_ContainsDictionaryValues_Handler,
+		},
+		{
+			MethodName: "IncrementDictionaryValue",
+			Handler:    _CacheService_IncrementDictionaryValue_Handler,
+		},
+		{
+			MethodName: "GetSet",
+			Handler:    _CacheService_GetSet_Handler,
+		},
+		{
+			MethodName: "PostSet",
+			Handler:    _CacheService_PostSet_Handler,
+		},
+		{
+			MethodName: "DeleteSet",
+			Handler:    _CacheService_DeleteSet_Handler,
+		},
+		{
+			MethodName: "PostSetValues",
+			Handler:    _CacheService_PostSetValues_Handler,
+		},
+		{
+			MethodName: "DeleteSetValues",
+			Handler:    _CacheService_DeleteSetValues_Handler,
+		},
+		{
+			MethodName: "ContainsSetValue",
+			Handler:    _CacheService_ContainsSetValue_Handler,
+		},
+		{
+			MethodName: "GetSetCardinality",
+			Handler:    _CacheService_GetSetCardinality_Handler,
+		},
+		{
+			MethodName: "GetSetRandomValues",
+			Handler:    _CacheService_GetSetRandomValues_Handler,
+		},
+		{
+			MethodName: "GetSortedSet",
+			Handler:    _CacheService_GetSortedSet_Handler,
+		},
+		{
+			MethodName: "PostSortedSet",
+			Handler:    _CacheService_PostSortedSet_Handler,
+		},
+		{
+			MethodName: "DeleteSortedSet",
+			Handler:    _CacheService_DeleteSortedSet_Handler,
+		},
+		{
+			MethodName: "PostSortedSetValues",
+			Handler:    _CacheService_PostSortedSetValues_Handler,
+		},
+		{
+			MethodName: "IncrementSortedSetScore",
+			Handler:    _CacheService_IncrementSortedSetScore_Handler,
+		},
+		{
+			MethodName: "DeleteSortedSetValues",
+			Handler:    _CacheService_DeleteSortedSetValues_Handler,
+		},
+		{
+			MethodName: "GetSortedSetRange",
+			Handler:    _CacheService_GetSortedSetRange_Handler,
+		},
+		{
+			MethodName: "GetSortedSetRangeByScore",
+			Handler:    _CacheService_GetSortedSetRangeByScore_Handler,
+		},
+		{
+			MethodName: "GetSortedSetRank",
+			Handler:    _CacheService_GetSortedSetRank_Handler,
+		},
+		{
+			MethodName: "GetDocument",
+			Handler:    _CacheService_GetDocument_Handler,
+		},
+		{
+			MethodName: "PostDocument",
+			Handler:    _CacheService_PostDocument_Handler,
+		},
+		{
+			MethodName: "DeleteDocument",
+			Handler:    _CacheService_DeleteDocument_Handler,
+		},
+		{
+			MethodName: "GetDocumentPath",
+			Handler:    _CacheService_GetDocumentPath_Handler,
+		},
+		{
+			MethodName: "SetDocumentPath",
+			Handler:    _CacheService_SetDocumentPath_Handler,
+		},
+		{
+			MethodName: "DeleteDocumentPath",
+			Handler:    _CacheService_DeleteDocumentPath_Handler,
+		},
+		{
+			MethodName: "AppendDocumentValues",
+			Handler:    _CacheService_AppendDocumentValues_Handler,
+		},
+		{
+			MethodName: "MergeDocument",
+			Handler:    _CacheService_MergeDocument_Handler,
+		},
+		{
+			MethodName: "PatchDocument",
+			Handler:    _CacheService_PatchDocument_Handler,
+		},
+		{
+			MethodName: "GetHyperLogLog",
+			Handler:    _CacheService_GetHyperLogLog_Handler,
+		},
+		{
+			MethodName: "PostHyperLogLog",
+			Handler:    _CacheService_PostHyperLogLog_Handler,
+		},
+		{
+			MethodName: "DeleteHyperLogLog",
+			Handler:    _CacheService_DeleteHyperLogLog_Handler,
+		},
+		{
+			MethodName: "AddHyperLogLogValues",
+			Handler:    _CacheService_AddHyperLogLogValues_Handler,
+		},
+		{
+			MethodName: "MergeHyperLogLog",
+			Handler:    _CacheService_MergeHyperLogLog_Handler,
+		},
+		{
+			MethodName: "GetBloomFilter",
+			Handler:    _CacheService_GetBloomFilter_Handler,
+		},
+		{
+			MethodName: "PostBloomFilter",
+			Handler:    _CacheService_PostBloomFilter_Handler,
+		},
+		{
+			MethodName: "DeleteBloomFilter",
+			Handler:    _CacheService_DeleteBloomFilter_Handler,
+		},
+		{
+			MethodName: "AddBloomFilterValues",
+			Handler:    _CacheService_AddBloomFilterValues_Handler,
+		},
+		{
+			MethodName: "ExistsBloomFilterValues",
+			Handler:    _CacheService_ExistsBloomFilterValues_Handler,
+		},
+		{
+			MethodName: "GetStream",
+			Handler:    _CacheService_GetStream_Handler,
+		},
+		{
+			MethodName: "PostStream",
+			Handler:    _CacheService_PostStream_Handler,
+		},
+		{
+			MethodName: "DeleteStream",
+			Handler:    _CacheService_DeleteStream_Handler,
+		},
+		{
+			MethodName: "AppendStreamEntry",
+			Handler:    _CacheService_AppendStreamEntry_Handler,
+		},
+		{
+			MethodName: "GetStreamRange",
+			Handler:    _CacheService_GetStreamRange_Handler,
+		},
+		{
+			MethodName: "TrimStream",
+			Handler:    _CacheService_TrimStream_Handler,
+		},
+		{
+			MethodName: "GetStreamGroups",
+			Handler:    _CacheService_GetStreamGroups_Handler,
+		},
+		{
+			MethodName: "CreateStreamGroup",
+			Handler:    _CacheService_CreateStreamGroup_Handler,
+		},
+		{
+			MethodName: "DeleteStreamGroup",
+			Handler:    _CacheService_DeleteStreamGroup_Handler,
+		},
+		{
+			MethodName: "ReadStreamGroup",
+			Handler:    _CacheService_ReadStreamGroup_Handler,
+		},
+		{
+			MethodName: "AckStreamGroup",
+			Handler:    _CacheService_AckStreamGroup_Handler,
+		},
+		{
+			MethodName: "GetStreamPending",
+			Handler:    _CacheService_GetStreamPending_Handler,
+		},
+		{
+			MethodName: "GetGeo",
+			Handler:    _CacheService_GetGeo_Handler,
+		},
+		{
+			MethodName: "PostGeo",
+			Handler:    _CacheService_PostGeo_Handler,
+		},
+		{
+			MethodName: "DeleteGeo",
+			Handler:    _CacheService_DeleteGeo_Handler,
+		},
+		{
+			MethodName: "AddGeoMembers",
+			Handler:    _CacheService_AddGeoMembers_Handler,
+		},
+		{
+			MethodName: "RemoveGeoMembers",
+			Handler:    _CacheService_RemoveGeoMembers_Handler,
+		},
+		{
+			MethodName: "GetGeoPositions",
+			Handler:    _CacheService_GetGeoPositions_Handler,
+		},
+		{
+			MethodName: "GetGeoDistance",
+			Handler:    _CacheService_GetGeoDistance_Handler,
+		},
+		{
+			MethodName: "SearchGeo",
+			Handler:    _CacheService_SearchGeo_Handler,
+		},
+		{
+			MethodName: "GetQueue",
+			Handler:    _CacheService_GetQueue_Handler,
+		},
+		{
+			MethodName: "PostQueue",
+			Handler:    _CacheService_PostQueue_Handler,
+		},
+		{
+			MethodName: "DeleteQueue",
+			Handler:    _CacheService_DeleteQueue_Handler,
+		},
+		{
+			MethodName: "PushQueue",
+			Handler:    _CacheService_PushQueue_Handler,
+		},
+		{
+			MethodName: "PopQueue",
+			Handler:    _CacheService_PopQueue_Handler,
+		},
+		{
+			MethodName: "PeekQueue",
+			Handler:    _CacheService_PeekQueue_Handler,
+		},
+		{
+			MethodName: "AckQueue",
+			Handler:    _CacheService_AckQueue_Handler,
+		},
+		{
+			MethodName: "NackQueue",
+			Handler:    _CacheService_NackQueue_Handler,
+		},
+		{
+			MethodName: "GetQueueDead",
+			Handler:    _CacheService_GetQueueDead_Handler,
+		},
+		{
+			MethodName: "RedriveQueue",
+			Handler:    _CacheService_RedriveQueue_Handler,
+		},
+		{
+			MethodName: "GetRateLimit",
+			Handler:    _CacheService_GetRateLimit_Handler,
+		},
+		{
+			MethodName: "DeleteRateLimit",
+			Handler:    _CacheService_DeleteRateLimit_Handler,
+		},
+		{
+			MethodName: "TakeRateLimit",
+			Handler:    _CacheService_TakeRateLimit_Handler,
+		},
+		{
+			MethodName: "GetLock",
+			Handler:    _CacheService_GetLock_Handler,
+		},
+		{
+			MethodName: "AcquireLock",
+			Handler:    _CacheService_AcquireLock_Handler,
+		},
+		{
+			MethodName: "RenewLock",
+			Handler:    _CacheService_RenewLock_Handler,
+		},
+		{
+			MethodName: "ReleaseLock",
+			Handler:    _CacheService_ReleaseLock_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GetStringKeys",
+			Handler:       _CacheService_GetStringKeys_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetListKeys",
+			Handler:       _CacheService_GetListKeys_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetDictionaryKeys",
+			Handler:       _CacheService_GetDictionaryKeys_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetSetKeys",
+			Handler:       _CacheService_GetSetKeys_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetSortedSetKeys",
+			Handler:       _CacheService_GetSortedSetKeys_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetDocumentKeys",
+			Handler:       _CacheService_GetDocumentKeys_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetHyperLogLogKeys",
+			Handler:       _CacheService_GetHyperLogLogKeys_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetBloomFilterKeys",
+			Handler:       _CacheService_GetBloomFilterKeys_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetStreamKeys",
+			Handler:       _CacheService_GetStreamKeys_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetGeoKeys",
+			Handler:       _CacheService_GetGeoKeys_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetQueueKeys",
+			Handler:       _CacheService_GetQueueKeys_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetRateLimitKeys",
+			Handler:       _CacheService_GetRateLimitKeys_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetLockKeys",
+			Handler:       _CacheService_GetLockKeys_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "service.proto",
+}
+
+func init() { proto.RegisterFile("service.proto", fileDescriptorService) }
+
+var fileDescriptorService = []byte{
+	// 2227 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x9a, 0x49, 0x73, 0xdc, 0xb8,
+	0x15, 0xc7, 0xad, 0xcb, 0x8c, 0x05, 0x4b, 0x5e, 0x3a, 0x93, 0xa5, 0x5c, 0xe5, 0x1e, 0x6b, 0x6b,
+	0xb5, 0xc6, 0x8e, 0x2a, 0x95, 0x7c, 0x02, 0x2d, 0xe3, 0xb6, 0xcb, 0x4b, 0xda, 0x4d, 0x27, 0x53,
+	0x95, 0xc4, 0x55, 0x43, 0x93, 0x6f, 0xd4, 0xac, 0xee, 0x26, 0x7a, 0xb8, 0x78, 0xac, 0x5b, 0x3e,
+	0x42, 0x3e, 0x46, 0x3e, 0x4a, 0x8e, 0x73, 0x4a, 0xe5, 0x18, 0x2b, 0x97, 0x1c, 0xe7, 0x23, 0xa4,
+	0x48, 0x10, 0x8f, 0x58, 0x1e, 0x40, 0x96, 0x95, 0x9b, 0x84, 0xf7, 0xc3, 0xff, 0x01, 0xe8, 0x87,
+	0x87, 0x8d, 0x6c, 0x3b, 0x87, 0xec, 0x7d, 0x12, 0xc1, 0xf1, 0x3a, 0xe3, 0x05, 0x1f, 0xdc, 0x5c,
+	0x41, 0x9e, 0x87, 0x17, 0x90, 0xdf, 0xdf, 0xca, 0x8b, 0x2c, 0x49, 0x2f, 0x44, 0xf9, 0x7d, 0xb6,
+	0x4c, 0xf2, 0xa2, 0xf9, 0xfb, 0x6e, 0x9c, 0x44, 0x45, 0xc2, 0xd3, 0x30, 0xbb, 0x6c, 0x4a, 0x36,
+	0x73, 0x90, 0xc6, 0x3b, 0x39, 0xcf, 0x0a, 0x88, 0xdb, 0x82, 0xdb, 0x31, 0x8f, 0xca, 0x15, 0xa4,
+	0xf2, 0xff, 0x7b, 0xf3, 0xcb, 0x35, 0x64, 0x4b, 0x7e, 0xb1, 0xe4, 0x52, 0xfc, 0xd6, 0xbb, 0x25,
+	0xe7, 0xab, 0xe6, 0x9f, 0xca, 0x2f, 0x84, 0xf2, 0xbf, 0xcd, 0x0b, 0xe0, 0x92, 0xfa, 0xbe, 0x84,
+	0x12, 0xa4, 0x9b, 0x2c, 0x2c, 0x60, 0x99, 0xac, 0x92, 0x02, 0x1b, 0xc8, 0xa3, 0x85, 0xfc, 0x7b,
+	0x01, 0x97, 0xb9, 0xf8, 0xfb, 0xb7, 0xff, 0xfc, 0x33, 0xdb, 0x3a, 0x0b, 0xa3, 0x39, 0x04, 0xa2,
+	0x9f, 0x83, 0x73, 0xb6, 0x3d, 0x81, 0x22, 0xa8, 0x3b, 0xf7, 0x1c, 0x2e, 0xf3, 0xc1, 0x83, 0x63,
+	0xd9, 0xe7, 0xe3, 0x09, 0x14, 0x35, 0x5b, 0x95, 0xbf, 0x14, 0x85, 0xf7, 0x07, 0xad, 0x59, 0xda,
+	0x7e, 0xb3, 0x31, 0x78, 0xcd, 0x36, 0x51, 0x65, 0xb0, 0xab, 0x29, 0x88, 0x42, 0xc9, 0x4a, 0x99,
+	0x87, 0x1e, 0x66, 0x06, 0xeb, 0xe5, 0xe5, 0xe0, 0x0d, 0x63, 0x53, 0x9e, 0x4b, 0xcd, 0xbd, 0x96,
+	0x6f, 0x4b, 0x4d, 0xd1, 0x1d, 0x1f, 0x24, 0x54, 0x5f, 0xb3, 0xcd, 0x69, 0x49, 0x34, 0x74, 0x5a,
+	0x1a, 0x38, 0xd1, 0xd0, 0x69, 0xe9, 0x92, 0x0c, 0xa8, 0xbe, 0x07, 0x3d, 0xfa, 0x1e, 0xd0, 0x7d,
+	0x7f, 0xcb, 0xb6, 0xab, 0x3f, 0xc2, 0x08, 0x1a, 0xd9, 0x51, 0x5b, 0x45, 0x33, 0x98, 0xd2, 0xfb,
+	0x1d, 0x9c, 0x90, 0xcf, 0xd8, 0x17, 0x67, 0x7c, 0xb5, 0x0e, 0x33, 0x38, 0x49, 0xe3, 0xe0, 0x87,
+	0x70, 0xdd, 0x78, 0xf9, 0xb5, 0xf2, 0xdb, 0x12, 0x76, 0xd3, 0xd9, 0xa3, 0x7e, 0xb8, 0xf0, 0xf9,
+	0x0d, 0xbb, 0xf5, 0x86, 0x97, 0xd1, 0xbc, 0x71, 0xa5, 0x34, 0x54, 0x29, 0x36, 0x3d, 0xec, 0x7a,
+	0x29, 0x21, 0xfc, 0x27, 0xb6, 0x75, 0x0e, 0x4b, 0x28, 0xe4, 0x50, 0x1d, 0xb4, 0x75, 0xd4, 0x72,
+	0x53, 0x7a, 0xcf, 0x8f, 0x09, 0xed, 0x88, 0xdd, 0x79, 0x96, 0x46, 0x19, 0x54, 0xf3, 0xb5, 0x91,
+	0x1f, 0xb7, 0xf5, 0x0c, 0x93, 0xe9, 0x61, 0xd4, 0x49, 0x62, 0x07, 0x02, 0x28, 0x4e, 0x93, 0xc2,
+	0xee, 0x80, 0x5a, 0xee, 0xe9, 0x00, 0x85, 0xa1, 0xf6, 0xc4, 0xa1, 0x3d, 0xe9, 0xa7, 0x3d, 0x71,
+	0x6a, 0x47, 0xec, 0xce, 0x19, 0x2f, 0xd3, 0xca, 0x9c, 0xdb, 0x83, 0x63, 0x98, 0x3c, 0x83, 0xe3,
+	0x20, 0xd1, 0x89, 0x68, 0xc1, 0x94, 0x13, 0x4e, 0x0c, 0x93, 0xc7, 0x89, 0x83, 0xc4, 0xd8, 0x3c,
+	0x4d, 0x8a, 0xdf, 0xaf, 0xed, 0xd8, 0x54, 0x8a, 0x3d, 0xb1, 0x49, 0x50, 0x42, 0xf8, 0x5b, 0x76,
+	0xfb, 0x34, 0x29, 0x9e, 0x24, 0xb0, 0x8c, 0x1b, 0xed, 0x43, 0xad, 0x96, 0x62, 0x31, 0xe5, 0x0f,
+	0xba, 0x40, 0xe1, 0xe1, 0x94, 0xdd, 0x9a, 0x40, 0xf1, 0x22, 0xc9, 0x8b, 0x4f, 0x4f, 0xde, 0xcf,
+	0xd9, 0xe7, 0x8d, 0xc6, 0x40, 0x4f, 0xcb, 0x55, 0x91, 0xd9, 0xae, 0xa1, 0x93, 0x10, 0x0d, 0x7a,
+	0xc5, 0x6e, 0x56, 0xb9, 0xb7, 0x56, 0x33, 0xf2, 0x31, 0x25, 0xf7, 0xa5, 0x1b, 0xc1, 0x65, 0x40,
+	0xcc, 0xcf, 0x5a, 0xd1, 0x9a, 0xb5, 0x94, 0xe6, 0x8e, 0x0f, 0x92, 0xbf, 0xf8, 0xb6, 0x74, 0xf7,
+	0xc7, 0x70, 0x59, 0x82, 0xb9, 0xbe, 0x60, 0x8d, 0xda, 0xea, 0x58, 0x5f, 0x74, 0x48, 0x36, 0x77,
+	0x6b, 0x5a, 0x2a, 0xba, 0xfa, 0x12, 0x43, 0xcb, 0x3e, 0xf4, 0x30, 0x72, 0x3d, 0xb8, 0xd3, 0xf6,
+	0x44, 0x08, 0x1f, 0x38, 0x3b, 0xa9, 0x69, 0xef, 0xf9, 0x31, 0x6c, 0x74, 0xf3, 0x5b, 0x3e, 0x4b,
+	0x63, 0xf8, 0x60, 0x2c, 0xe0, 0x58, 0xa3, 0x36, 0xd2, 0x0b, 0xb8, 0xce, 0xa0, 0x6a, 0xe0, 0x50,
+	0x0d, 0x7a, 0xa8, 0x06, 0xb4, 0xea, 0xdb, 0x2a, 0x25, 0xe7, 0x90, 0x15, 0xe4, 0x50, 0xb4, 0x26,
+	0xef, 0x50, 0x50, 0x18, 0xca, 0xcf, 0x60, 0xc5, 0xdf, 0x43, 0xdb, 0xee, 0x03, 0x75, 0x4d, 0x95,
+	0x26, 0xbb, 0xe9, 0x7b, 0x7e, 0xcc, 0x1c, 0xe9, 0x59, 0x98, 0x5e, 0x80, 0x6b, 0xa4, 0x6b, 0x63,
+	0xc7, 0x48, 0xd7, 0x8c, 0xcc, 0xf2, 0xb7, 0xa7, 0x65, 0x3e, 0xc7, 0x11, 0xc9, 0xd5, 0x14, 0x26,
+	0x2d, 0x6d, 0x4f, 0x73, 0x22, 0x85, 0x11, 0x94, 0x32, 0x53, 0xd6, 0x8a, 0xb4, 0x36, 0x53, 0xd6,
+	0x0e, 0xe5, 0x1d, 0x1f, 0x84, 0x89, 0xe2, 0x4d, 0x96, 0xac, 0xcc, 0x44, 0x21, 0xcb, 0x3c, 0x89,
+	0xc2, 0x44, 0x84, 0xde, 0x53, 0x76, 0x6f, 0x02, 0xc5, 0x39, 0xee, 0xc5, 0x3f, 0x3d, 0x1f, 0xbe,
+	0x65, 0xdb, 0x9a, 0xd2, 0x40, 0x5f, 0x47, 0x5a, 0x83, 0x67, 0xf7, 0x45, 0x72, 0xb8, 0x28, 0x54,
+	0xd9, 0x43, 0xd1, 0x3f, 0xd4, 0xf3, 0x8a, 0xdb, 0xc1, 0x41, 0x17, 0x28, 0x3c, 0x7c, 0xc7, 0xee,
+	0x8a, 0xc9, 0xae, 0xf8, 0x38, 0x32, 0x13, 0x81, 0xdb, 0xcb, 0x61, 0x37, 0x2a, 0xfc, 0x24, 0xec,
+	0x67, 0x7a, 0x33, 0xc4, 0x7c, 0x3c, 0xf2, 0xb6, 0x52, 0x9b, 0x93, 0x87, 0xdd, 0xa8, 0xec, 0xd2,
+	0x60, 0x5a, 0x5a, 0x9e, 0xc6, 0x5a, 0xe6, 0xf4, 0x39, 0x1a, 0x75, 0x92, 0xc2, 0x0f, 0x67, 0x3f,
+	0x37, 0xfb, 0x2c, 0x5c, 0x3d, 0xea, 0x18, 0x14, 0xcd, 0xdb, 0x51, 0x1f, 0x18, 0xc7, 0x50, 0x8b,
+	0x95, 0x66, 0x96, 0x1d, 0xf9, 0x42, 0x49, 0x9f, 0x6b, 0x87, 0xdd, 0x28, 0xba, 0x0a, 0xfc, 0xae,
+	0x82, 0xfe, 0xae, 0x02, 0xaf, 0xab, 0x8c, 0xfd, 0x82, 0x1c, 0xc6, 0x7c, 0xf0, 0xb8, 0xcf, 0xd0,
+	0xa0, 0xc3, 0xaf, 0x7a, 0xd1, 0xc2, 0xe7, 0x92, 0x7d, 0xa1, 0xf5, 0x3f, 0x28, 0xdf, 0xd5, 0x39,
+	0xe0, 0x2b, 0xdf, 0xf8, 0x34, 0x90, 0xf4, 0x37, 0xee, 0xc1, 0x0a, 0x6f, 0x1f, 0xd8, 0xaf, 0xce,
+	0x78, 0x5a, 0x84, 0x49, 0x9a, 0x5b, 0x7d, 0x3c, 0x56, 0x37, 0xb7, 0x26, 0x43, 0xf4, 0xf2, 0x71,
+	0x4f, 0x1e, 0x3d, 0xe3, 0x79, 0xc2, 0x8c, 0xd2, 0x63, 0xe2, 0xcc, 0xe1, 0x0b, 0xd4, 0xc7, 0x3d,
+	0x79, 0xe1, 0xf9, 0x84, 0xb1, 0xea, 0xb0, 0x0e, 0xd7, 0xd8, 0x6b, 0x3e, 0x65, 0x9f, 0x09, 0x89,
+	0xc1, 0x97, 0x5a, 0xf5, 0x00, 0xac, 0x8c, 0xff, 0xc0, 0x05, 0x88, 0xc6, 0x3c, 0x67, 0x9f, 0xd7,
+	0x87, 0x7c, 0xd0, 0x76, 0xad, 0x4d, 0x91, 0x67, 0xd7, 0x6a, 0x10, 0x78, 0x86, 0x6f, 0x4e, 0x81,
+	0x50, 0xa8, 0x8b, 0x32, 0x16, 0x7a, 0xce, 0xf0, 0x16, 0xa3, 0x6d, 0x31, 0x03, 0x20, 0x17, 0xce,
+	0xb6, 0x0d, 0x9e, 0x85, 0xd3, 0x84, 0x8c, 0xcd, 0x60, 0x2b, 0x7d, 0xe0, 0x6a, 0x8d, 0x2e, 0xbe,
+	0xe7, 0xc7, 0xe4, 0xf2, 0x74, 0x57, 0x06, 0xa1, 0x74, 0x30, 0x18, 0xd9, 0x01, 0xaa, 0x55, 0x25,
+	0x16, 0x40, 0x92, 0x93, 0x67, 0xba, 0x7b, 0xf2, 0x07, 0xcd, 0xe2, 0x24, 0x0d, 0x97, 0x49, 0xa1,
+	0xad, 0x81, 0xca, 0xaf, 0xad, 0x10, 0xc4, 0x1a, 0x48, 0x83, 0xb8, 0x60, 0x08, 0xf3, 0x2c, 0x4c,
+	0x63, 0xbe, 0x6a, 0x06, 0x6a, 0x4c, 0x56, 0x56, 0x11, 0xfa, 0xec, 0x48, 0x91, 0xc2, 0xcf, 0x84,
+	0xdd, 0xad, 0xec, 0xf5, 0x2d, 0xdf, 0xb5, 0x66, 0x86, 0x38, 0xaa, 0xa3, 0x90, 0x71, 0x54, 0xc7,
+	0x72, 0xff, 0x51, 0xdd, 0xc2, 0xf0, 0x3e, 0xa9, 0x8e, 0x26, 0x14, 0x1f, 0x19, 0x61, 0xe6, 0x52,
+	0xdf, 0xef, 0xe0, 0xf0, 0x90, 0xde, 0xc4, 0x13, 0x3a, 0x18, 0x5b, 0xa1, 0xe6, 0x72, 0x31, 0xea,
+	0x24, 0xb5, 0xcd, 0x06, 0x5a, 0xed, 0xd5, 0xcb, 0x6e, 0xa1, 0x73, 0xf5, 0x72, 0xa1, 0xc2, 0x55,
+	0xc9, 0x7e, 0xd9, 0xde, 0xd8, 0x48, 0x2a, 0x88, 0x78, 0x06, 0xea, 0x15, 0x99, 0x8d, 0x88, 0x55,
+	0xa2, 0xe2, 0x88, 0x2b, 0x32, 0x1f, 0x6e, 0xec, 0x3d, 0xcc, 0x3e, 0x3e, 0xf2, 0x0f, 0x91, 0xde,
+	0xcb, 0xa3, 0x3e, 0xb0, 0x36, 0x11, 0xa5, 0x5d, 0x1c, 0x49, 0x0e, 0x3d, 0x01, 0xa5, 0x9d, 0x4b,
+	0x0e, 0xba, 0x40, 0x5c, 0xae, 0x2c, 0x27, 0xa7, 0x97, 0x62, 0x34, 0x8f, 0xbb, 0x24, 0x1a, 0x90,
+	0x58, 0xae, 0xbc, 0x3c, 0x66, 0x32, 0xc3, 0xf3, 0xc2, 0xd8, 0xca, 0x5b, 0x0a, 0x0b, 0x7a, 0x2b,
+	0x6f, 0x73, 0xc2, 0xc3, 0x93, 0xfa, 0x76, 0xea, 0xbc, 0xb9, 0xd1, 0xff, 0xf4, 0xb9, 0xff, 0x0d,
+	0xbb, 0xa5, 0xe8, 0x0c, 0x8c, 0x73, 0x44, 0x53, 0xec, 0xb9, 0x80, 0x22, 0x28, 0xbc, 0xff, 0xab,
+	0xf7, 0xd5, 0x52, 0xd9, 0x3c, 0x40, 0x38, 0xa4, 0xf7, 0xfc, 0x18, 0x9e, 0x63, 0x9a, 0x3d, 0x99,
+	0x54, 0xb7, 0x0f, 0x0e, 0x0e, 0xfd, 0x83, 0x2e, 0x10, 0x57, 0x3a, 0xa5, 0x67, 0xd3, 0xb0, 0x98,
+	0x1b, 0x59, 0x51, 0xab, 0x56, 0xd9, 0xe9, 0xac, 0x68, 0x61, 0x28, 0x1f, 0xb8, 0xe5, 0x83, 0x7e,
+	0xf2, 0x81, 0x53, 0xfe, 0x3b, 0x36, 0xd0, 0x3b, 0x57, 0x7b, 0x18, 0x7b, 0xbb, 0xae, 0x3a, 0x19,
+	0x75, 0x92, 0xb8, 0xef, 0x3d, 0x59, 0xaf, 0x21, 0x8d, 0xa5, 0xbd, 0xc9, 0x1a, 0xca, 0xbe, 0x57,
+	0xb7, 0x13, 0x49, 0x63, 0xdc, 0x83, 0xc5, 0x6d, 0xcd, 0x4b, 0xc8, 0x2e, 0xda, 0x1f, 0x5d, 0x19,
+	0x0b, 0xcd, 0x50, 0xd7, 0x24, 0xb6, 0x35, 0x36, 0xd4, 0xee, 0x97, 0xc2, 0x22, 0x9a, 0x53, 0xc2,
+	0x9a, 0xc1, 0x25, 0x6c, 0x43, 0x42, 0xf8, 0x59, 0xbd, 0x13, 0x78, 0x5a, 0x3d, 0xb3, 0xbd, 0xe0,
+	0x17, 0x2f, 0xf8, 0x35, 0x9e, 0xb9, 0xbe, 0x65, 0xb7, 0x75, 0x29, 0x23, 0x5b, 0x2a, 0x16, 0x4f,
+	0xc8, 0xd3, 0x20, 0x2e, 0xa5, 0xd5, 0x8c, 0x53, 0x5d, 0x8c, 0xf5, 0xc9, 0xe8, 0xf1, 0x31, 0xea,
+	0x24, 0xe5, 0x52, 0x7a, 0x4f, 0x44, 0x94, 0xea, 0xc6, 0x3a, 0x6a, 0x79, 0x1c, 0x8d, 0x7b, 0xb0,
+	0x6d, 0x70, 0xc6, 0xb1, 0x62, 0x26, 0x82, 0x33, 0x8e, 0xcd, 0xea, 0xee, 0xe0, 0x74, 0xb1, 0x98,
+	0xf1, 0xeb, 0xf0, 0x52, 0xfb, 0x35, 0x32, 0x42, 0xcf, 0xac, 0x4f, 0x64, 0x7c, 0x92, 0x53, 0x83,
+	0xe9, 0xb4, 0x7a, 0xa0, 0x7d, 0x92, 0x2c, 0x0b, 0xc8, 0xae, 0x1b, 0x4c, 0x8a, 0x94, 0x11, 0x4c,
+	0x8a, 0xc5, 0x1f, 0x4c, 0x04, 0xa8, 0x05, 0x93, 0xea, 0xc2, 0x08, 0x26, 0x8f, 0x8f, 0x51, 0x27,
+	0x69, 0x04, 0x93, 0xea, 0xc6, 0x0a, 0x26, 0x8f, 0xa3, 0x71, 0x0f, 0x56, 0x0d, 0x26, 0xc5, 0x4c,
+	0x06, 0x93, 0x59, 0xdd, 0x17, 0x4c, 0x34, 0x8b, 0xbb, 0xc0, 0xaf, 0x3f, 0x24, 0x79, 0x91, 0xdb,
+	0x0e, 0x95, 0x5d, 0xa0, 0x85, 0x10, 0x3e, 0x1f, 0xf5, 0xc3, 0x85, 0x5b, 0x7c, 0x90, 0x87, 0x70,
+	0xf5, 0x7f, 0x78, 0x90, 0x87, 0x70, 0x65, 0x3f, 0xc8, 0x43, 0xb8, 0xea, 0x7c, 0x90, 0x57, 0x18,
+	0xf3, 0x41, 0xbe, 0xd2, 0xb4, 0x1f, 0xe4, 0x09, 0xd1, 0x1d, 0x1f, 0x64, 0x3f, 0xdf, 0x56, 0xba,
+	0xd4, 0xf3, 0x2d, 0xa1, 0xbc, 0xe7, 0xc7, 0x70, 0x7f, 0x2b, 0x56, 0x33, 0x61, 0xfc, 0x3a, 0x2d,
+	0xf4, 0xcb, 0x56, 0xd5, 0x58, 0xd7, 0xac, 0x09, 0x62, 0x92, 0xd1, 0x20, 0x5e, 0xbe, 0xe3, 0x80,
+	0x89, 0x1d, 0xf4, 0xbe, 0x6b, 0x28, 0xb5, 0xed, 0xf3, 0xae, 0x97, 0xc2, 0x21, 0xaf, 0x2e, 0xbb,
+	0xed, 0x21, 0x6f, 0x4b, 0x3d, 0x43, 0x6e, 0x43, 0xea, 0xb6, 0x4a, 0x58, 0x26, 0x19, 0x2f, 0xd7,
+	0xb9, 0x79, 0xd8, 0x6c, 0x2b, 0x09, 0xbb, 0xe3, 0xb0, 0x69, 0x62, 0x38, 0xea, 0x67, 0x19, 0x84,
+	0xf2, 0x27, 0xa9, 0x4d, 0xea, 0xa8, 0xab, 0xc6, 0xb6, 0x32, 0x31, 0xea, 0x34, 0x88, 0x4e, 0xd4,
+	0xdf, 0xdd, 0x72, 0x62, 0x05, 0x85, 0xcb, 0x09, 0x0d, 0x2a, 0x8f, 0x41, 0x61, 0xac, 0xba, 0xd0,
+	0x1e, 0x83, 0xc2, 0xd8, 0xe5, 0x60, 0xcf, 0x8f, 0x61, 0xe4, 0x9c, 0x44, 0x0b, 0x55, 0x5d, 0x89,
+	0x1c, 0xb4, 0xd8, 0xe2, 0xbb, 0x5e, 0x4a, 0x3b, 0xfb, 0xd4, 0xb6, 0x29, 0xa4, 0xb1, 0xf1, 0x11,
+	0x89, 0xfe, 0xeb, 0x35, 0x80, 0xe3, 0xec, 0x63, 0x71, 0xea, 0x65, 0xe0, 0x04, 0xf8, 0x75, 0x2f,
+	0x03, 0x27, 0xc0, 0x8d, 0xcb, 0xc0, 0x09, 0x70, 0xff, 0x65, 0xa0, 0x02, 0x68, 0x97, 0x81, 0x95,
+	0x94, 0x71, 0x19, 0x48, 0x68, 0x0d, 0x9d, 0x84, 0x71, 0x19, 0x58, 0xc9, 0x59, 0x97, 0x81, 0x84,
+	0xe0, 0x43, 0x0f, 0x83, 0x9b, 0xdb, 0x93, 0x38, 0x9e, 0x00, 0x7f, 0x09, 0xab, 0x77, 0x90, 0x69,
+	0x97, 0x81, 0xc2, 0xd0, 0xec, 0x45, 0x6a, 0x2b, 0x31, 0x97, 0x6d, 0x08, 0x7f, 0x67, 0xf1, 0xda,
+	0xa8, 0x68, 0x8f, 0xcc, 0x97, 0x48, 0x87, 0xfc, 0x7e, 0x07, 0xa7, 0x66, 0x8b, 0x09, 0xf0, 0x29,
+	0xcf, 0x93, 0xea, 0x62, 0xd8, 0xcc, 0x16, 0xb2, 0x16, 0xda, 0xe9, 0x6c, 0x61, 0x61, 0x6a, 0xfa,
+	0x9c, 0x00, 0x3f, 0x4f, 0xf2, 0x22, 0x4c, 0x23, 0x33, 0x7d, 0xca, 0x6a, 0xd2, 0x4c, 0xa7, 0x4f,
+	0x93, 0x92, 0x51, 0xb1, 0x19, 0x40, 0x98, 0x45, 0x73, 0x23, 0xc4, 0xb0, 0x50, 0xdb, 0x00, 0x3e,
+	0x70, 0x01, 0x42, 0xec, 0xac, 0xbe, 0x9f, 0x7b, 0x5d, 0x42, 0x09, 0x9f, 0x1e, 0xf1, 0xaf, 0xd8,
+	0x4d, 0x29, 0xa2, 0x3e, 0x7a, 0xca, 0x32, 0xcf, 0xa3, 0xa7, 0x89, 0xb4, 0x9f, 0xb3, 0xf1, 0xbc,
+	0x11, 0xdc, 0xd5, 0xe3, 0x9a, 0x54, 0x7c, 0xe8, 0x61, 0xf0, 0x63, 0x18, 0x11, 0xc4, 0x42, 0x74,
+	0xdf, 0x8c, 0x6d, 0x52, 0x76, 0xd7, 0x4b, 0x09, 0xe1, 0x3f, 0x54, 0x9f, 0xde, 0xe5, 0x73, 0x4b,
+	0x16, 0x0b, 0xc5, 0x63, 0x79, 0x01, 0x2b, 0xd7, 0x03, 0xb5, 0x41, 0x09, 0xd9, 0x59, 0xf5, 0xc1,
+	0xc9, 0x5a, 0xa8, 0xea, 0x6f, 0xd3, 0x0e, 0xd1, 0x1d, 0x1f, 0xd4, 0x36, 0x15, 0x60, 0x61, 0x37,
+	0x55, 0x16, 0xfa, 0x9b, 0x6a, 0x53, 0xd8, 0xd4, 0x93, 0x68, 0x61, 0x35, 0xf5, 0x24, 0x32, 0x71,
+	0x2a, 0x01, 0x44, 0xb4, 0x66, 0xc0, 0x36, 0x5f, 0x85, 0x84, 0xe8, 0xab, 0xd0, 0xac, 0x40, 0x88,
+	0xda, 0x90, 0xfa, 0x99, 0x42, 0x6d, 0x39, 0x87, 0x30, 0x36, 0x36, 0x90, 0x6d, 0x8d, 0xca, 0x48,
+	0x6f, 0x20, 0x75, 0x46, 0xa8, 0xfe, 0x85, 0x6d, 0xcd, 0x20, 0xce, 0x92, 0xf7, 0x4d, 0x68, 0x69,
+	0x79, 0xaa, 0x2d, 0xb7, 0x94, 0xf7, 0x3b, 0x38, 0xf5, 0x22, 0x7e, 0x16, 0x16, 0xf0, 0x22, 0x59,
+	0x25, 0xd7, 0xbe, 0x88, 0x47, 0x21, 0x23, 0xdb, 0x61, 0xb9, 0xff, 0x22, 0xde, 0xc2, 0x8c, 0x9b,
+	0xf2, 0x56, 0xde, 0x3a, 0xfe, 0x38, 0x3d, 0x8c, 0x3a, 0x49, 0xbc, 0xed, 0x7f, 0x13, 0x2e, 0x14,
+	0x17, 0x4a, 0x45, 0xcd, 0xe0, 0xb9, 0xed, 0x27, 0x39, 0xed, 0x93, 0x33, 0x1e, 0x2d, 0xae, 0xfd,
+	0xc9, 0x19, 0x8f, 0x16, 0xe6, 0x27, 0x67, 0x3c, 0x5a, 0x74, 0x7c, 0x72, 0xa6, 0x10, 0x98, 0xb1,
+	0x4e, 0xa2, 0xef, 0xcb, 0x24, 0x83, 0x5a, 0x50, 0xdb, 0x44, 0x61, 0xb1, 0x27, 0x63, 0x11, 0x14,
+	0x66, 0xd7, 0x19, 0xa4, 0xf0, 0x43, 0x2d, 0xbb, 0xab, 0x46, 0x61, 0x53, 0xe8, 0xc9, 0xae, 0x16,
+	0x83, 0x6d, 0x9d, 0xc1, 0x12, 0xc2, 0xdc, 0x6a, 0xab, 0x52, 0xec, 0x69, 0x2b, 0x41, 0xd5, 0xc2,
+	0xa7, 0x8f, 0x7f, 0xfc, 0x38, 0xbc, 0xf1, 0xaf, 0x8f, 0xc3, 0x1b, 0x3f, 0x7d, 0x1c, 0x6e, 0xfc,
+	0xf5, 0x6a, 0xb8, 0xf1, 0xf7, 0xab, 0xe1, 0xc6, 0x3f, 0xae, 0x86, 0x1b, 0x3f, 0x5e, 0x0d, 0x37,
+	0xfe, 0x7d, 0x35, 0xdc, 0xf8, 0xef, 0xd5, 0xf0, 0xc6, 0x4f, 0x57, 0xc3, 0x8d, 0xbf, 0xfd, 0x67,
+	0x78, 0xe3, 0xdd, 0x67, 0xf5, 0xd7, 0xe0, 0xbf, 0xfb, 0xdf, 0x00, 0x68, 0x07, 0xe2, 0x2d, 0xef,
+	0x2e, 0x00, 0x00,
+}
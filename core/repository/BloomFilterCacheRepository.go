@@ -0,0 +1,102 @@
+package repo
+
+import (
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+	"log"
+)
+
+// BloomFilterCacheDBEntry is a contract which is serialized to BSON and saved in MongoDB.
+// The value is the binary form of Bloom filter options and bits, see cache.BloomFilter.Marshal.
+type BloomFilterCacheDBEntry struct {
+	Key         string
+	Value       []byte
+	ExpireAfter int64
+	Sliding     int64
+	Added       int64
+	Updated     int64
+	Version     int64
+}
+
+// IBloomFilterCacheRepository is an interface for BloomFilterCacheRepository.
+type IBloomFilterCacheRepository interface {
+	GetAll() []BloomFilterCacheDBEntry
+	SaveAll(newEntries []BloomFilterCacheDBEntry, updatedEntries []BloomFilterCacheDBEntry)
+}
+
+// BloomFilterCacheRepository for persisting cache entries to MongoDB.
+type BloomFilterCacheRepository struct {
+	Host    string
+	DBName  string
+	ColName string
+}
+
+// GetAll returns cache snapshot from DB.
+func (r BloomFilterCacheRepository) GetAll() []BloomFilterCacheDBEntry {
+	session, err := mgo.Dial(r.Host)
+	if err != nil {
+		panic(err)
+	}
+	defer session.Close()
+	c := session.DB(r.DBName).C(r.ColName)
+	var result []BloomFilterCacheDBEntry
+	err = c.Find(bson.M{}).All(&result)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if result != nil {
+		log.Printf("[BloomFilterCacheDBEntry] Read snapshot from %s.%s successfully.", r.DBName, r.ColName)
+	}
+	return result
+}
+
+// SaveAll saves cache snapshot to DB.
+func (r BloomFilterCacheRepository) SaveAll(newEntries []BloomFilterCacheDBEntry, updatedEntries []BloomFilterCacheDBEntry) {
+	session, err := mgo.Dial(r.Host)
+	if err != nil {
+		panic(err)
+	}
+	defer session.Close()
+	c := session.DB(r.DBName).C(r.ColName)
+	c.EnsureIndexKey("key")
+	var existingKeys []string
+
+	for _, entry := range newEntries {
+		existingKeys = append(existingKeys, entry.Key)
+		e := c.Insert(entry)
+		if e != nil {
+			log.Fatal(err)
+		}
+	}
+
+	for _, entry := range updatedEntries {
+		existingKeys = append(existingKeys, entry.Key)
+		if entry.Updated > entry.Added {
+			e := c.Update(bson.M{"key": entry.Key}, bson.M{"$set": bson.M{"value": entry.Value, "updated": entry.Updated, "expireafter": entry.ExpireAfter, "sliding": entry.Sliding, "version": entry.Version}})
+			if e != nil {
+				log.Fatal(err)
+			}
+		}
+	}
+	if existingKeys == nil {
+		existingKeys = make([]string, 0)
+	}
+	_, e := c.RemoveAll(bson.M{"key": bson.M{"$nin": existingKeys}})
+	if e != nil {
+		log.Fatal(err)
+	}
+	log.Printf("[BloomFilterCacheDBEntry] Persisted data to %s.%s successfully.", r.DBName, r.ColName)
+}
+
+// EmptyBloomFilterCacheRepository for testing only.
+type EmptyBloomFilterCacheRepository struct {
+}
+
+// GetAll returns empty cache.
+func (r EmptyBloomFilterCacheRepository) GetAll() []BloomFilterCacheDBEntry {
+	return make([]BloomFilterCacheDBEntry, 0)
+}
+
+// SaveAll saves nothing.
+func (r EmptyBloomFilterCacheRepository) SaveAll(newEntries []BloomFilterCacheDBEntry, updatedEntries []BloomFilterCacheDBEntry) {
+}
\ No newline at end of file
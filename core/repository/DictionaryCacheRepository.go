@@ -7,9 +7,11 @@ import (
 )
 
 // DictionaryValueDBEntry is a dictionary key/value contract which is serialized to BSON and saved in MongoDB.
+// ExpireAfter is set for the sub-keys which have their own ttl, zero means the sub-key lives as long as the entry.
 type DictionaryValueDBEntry struct {
-	Key string
-	Value string
+	Key         string
+	Value       string
+	ExpireAfter int64
 }
 
 // DictionaryCacheDBEntry is a contract which is serialized to BSON and saved in MongoDB.
@@ -17,8 +19,10 @@ type DictionaryCacheDBEntry struct {
 	Key         string
 	Values      []DictionaryValueDBEntry
 	ExpireAfter int64
+	Sliding     int64
 	Added       int64
 	Updated     int64
+	Version     int64
 }
 
 // IDictionaryCacheRepository is an interface for DictionaryCacheRepository.
@@ -75,7 +79,7 @@ func (r DictionaryCacheRepository) SaveAll(newEntries []DictionaryCacheDBEntry,
 	for _, entry := range updatedEntries {
 		existingKeys = append(existingKeys, entry.Key)
 		if entry.Updated > entry.Added {
-			e := c.Update(bson.M{"key": entry.Key}, bson.M{"$set": bson.M{"values": entry.Values, "updated": entry.Updated}})
+			e := c.Update(bson.M{"key": entry.Key}, bson.M{"$set": bson.M{"values": entry.Values, "updated": entry.Updated, "expireafter": entry.ExpireAfter, "sliding": entry.Sliding, "version": entry.Version}})
 			if e != nil {
 				log.Fatal(err)
 			}
@@ -0,0 +1,102 @@
+package repo
+
+import (
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+	"log"
+)
+
+// DocumentCacheDBEntry is a contract which is serialized to BSON and saved in MongoDB.
+// The document is saved as JSON text since its member names may contain dots and dollar signs which BSON documents do not allow.
+type DocumentCacheDBEntry struct {
+	Key         string
+	Value       string
+	ExpireAfter int64
+	Sliding     int64
+	Added       int64
+	Updated     int64
+	Version     int64
+}
+
+// IDocumentCacheRepository is an interface for DocumentCacheRepository.
+type IDocumentCacheRepository interface {
+	GetAll() []DocumentCacheDBEntry
+	SaveAll(newEntries []DocumentCacheDBEntry, updatedEntries []DocumentCacheDBEntry)
+}
+
+// DocumentCacheRepository for persisting cache entries to MongoDB.
+type DocumentCacheRepository struct {
+	Host    string
+	DBName  string
+	ColName string
+}
+
+// GetAll returns cache snapshot from DB.
+func (r DocumentCacheRepository) GetAll() []DocumentCacheDBEntry {
+	session, err := mgo.Dial(r.Host)
+	if err != nil {
+		panic(err)
+	}
+	defer session.Close()
+	c := session.DB(r.DBName).C(r.ColName)
+	var result []DocumentCacheDBEntry
+	err = c.Find(bson.M{}).All(&result)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if result != nil {
+		log.Printf("[DocumentCacheDBEntry] Read snapshot from %s.%s successfully.", r.DBName, r.ColName)
+	}
+	return result
+}
+
+// SaveAll saves cache snapshot to DB.
+func (r DocumentCacheRepository) SaveAll(newEntries []DocumentCacheDBEntry, updatedEntries []DocumentCacheDBEntry) {
+	session, err := mgo.Dial(r.Host)
+	if err != nil {
+		panic(err)
+	}
+	defer session.Close()
+	c := session.DB(r.DBName).C(r.ColName)
+	c.EnsureIndexKey("key")
+	var existingKeys []string
+
+	for _, entry := range newEntries {
+		existingKeys = append(existingKeys, entry.Key)
+		e := c.Insert(entry)
+		if e != nil {
+			log.Fatal(err)
+		}
+	}
+
+	for _, entry := range updatedEntries {
+		existingKeys = append(existingKeys, entry.Key)
+		if entry.Updated > entry.Added {
+			e := c.Update(bson.M{"key": entry.Key}, bson.M{"$set": bson.M{"value": entry.Value, "updated": entry.Updated, "expireafter": entry.ExpireAfter, "sliding": entry.Sliding, "version": entry.Version}})
+			if e != nil {
+				log.Fatal(err)
+			}
+		}
+	}
+	if existingKeys == nil {
+		existingKeys = make([]string, 0)
+	}
+	_, e := c.RemoveAll(bson.M{"key": bson.M{"$nin": existingKeys}})
+	if e != nil {
+		log.Fatal(err)
+	}
+	log.Printf("[DocumentCacheDBEntry] Persisted data to %s.%s successfully.", r.DBName, r.ColName)
+}
+
+// EmptyDocumentCacheRepository for testing only.
+type EmptyDocumentCacheRepository struct {
+}
+
+// GetAll returns empty cache.
+func (r EmptyDocumentCacheRepository) GetAll() []DocumentCacheDBEntry {
+	return make([]DocumentCacheDBEntry, 0)
+}
+
+// SaveAll saves nothing.
+func (r EmptyDocumentCacheRepository) SaveAll(newEntries []DocumentCacheDBEntry, updatedEntries []DocumentCacheDBEntry) {
+}
\ No newline at end of file
@@ -0,0 +1,108 @@
+package repo
+
+import (
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+	"log"
+)
+
+// GeoMemberDBEntry is a member with its 52-bit geohash which is serialized to BSON and saved in MongoDB.
+type GeoMemberDBEntry struct {
+	Member string
+	Hash   int64
+}
+
+// GeoCacheDBEntry is a contract which is serialized to BSON and saved in MongoDB.
+type GeoCacheDBEntry struct {
+	Key         string
+	Members     []GeoMemberDBEntry
+	ExpireAfter int64
+	Sliding     int64
+	Added       int64
+	Updated     int64
+	Version     int64
+}
+
+// IGeoCacheRepository is an interface for GeoCacheRepository.
+type IGeoCacheRepository interface {
+	GetAll() []GeoCacheDBEntry
+	SaveAll(newEntries []GeoCacheDBEntry, updatedEntries []GeoCacheDBEntry)
+}
+
+// GeoCacheRepository for persisting cache entries to MongoDB.
+type GeoCacheRepository struct {
+	Host    string
+	DBName  string
+	ColName string
+}
+
+// GetAll returns cache snapshot from DB.
+func (r GeoCacheRepository) GetAll() []GeoCacheDBEntry {
+	session, err := mgo.Dial(r.Host)
+	if err != nil {
+		panic(err)
+	}
+	defer session.Close()
+	c := session.DB(r.DBName).C(r.ColName)
+	var result []GeoCacheDBEntry
+	err = c.Find(bson.M{}).All(&result)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if result != nil {
+		log.Printf("[GeoCacheDBEntry] Read snapshot from %s.%s successfully.", r.DBName, r.ColName)
+	}
+	return result
+}
+
+// SaveAll saves cache snapshot to DB.
+func (r GeoCacheRepository) SaveAll(newEntries []GeoCacheDBEntry, updatedEntries []GeoCacheDBEntry) {
+	session, err := mgo.Dial(r.Host)
+	if err != nil {
+		panic(err)
+	}
+	defer session.Close()
+	c := session.DB(r.DBName).C(r.ColName)
+	c.EnsureIndexKey("key")
+	var existingKeys []string
+
+	for _, entry := range newEntries {
+		existingKeys = append(existingKeys, entry.Key)
+		e := c.Insert(entry)
+		if e != nil {
+			log.Fatal(err)
+		}
+	}
+
+	for _, entry := range updatedEntries {
+		existingKeys = append(existingKeys, entry.Key)
+		if entry.Updated > entry.Added {
+			e := c.Update(bson.M{"key": entry.Key}, bson.M{"$set": bson.M{"members": entry.Members, "updated": entry.Updated, "expireafter": entry.ExpireAfter, "sliding": entry.Sliding, "version": entry.Version}})
+			if e != nil {
+				log.Fatal(err)
+			}
+		}
+	}
+	if existingKeys == nil {
+		existingKeys = make([]string, 0)
+	}
+	_, e := c.RemoveAll(bson.M{"key": bson.M{"$nin": existingKeys}})
+	if e != nil {
+		log.Fatal(err)
+	}
+	log.Printf("[GeoCacheDBEntry] Persisted data to %s.%s successfully.", r.DBName, r.ColName)
+}
+
+// EmptyGeoCacheRepository for testing only.
+type EmptyGeoCacheRepository struct {
+
+}
+
+// GetAll returns empty cache.
+func (r EmptyGeoCacheRepository) GetAll() []GeoCacheDBEntry {
+	return make([]GeoCacheDBEntry, 0)
+}
+
+// SaveAll saves nothing.
+func (r EmptyGeoCacheRepository) SaveAll(newEntries []GeoCacheDBEntry, updatedEntries []GeoCacheDBEntry) {
+}
\ No newline at end of file
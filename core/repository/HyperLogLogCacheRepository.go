@@ -0,0 +1,102 @@
+package repo
+
+import (
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+	"log"
+)
+
+// HyperLogLogCacheDBEntry is a contract which is serialized to BSON and saved in MongoDB.
+// The value is the compact binary form of HyperLogLog registers, see cache.HyperLogLog.Marshal.
+type HyperLogLogCacheDBEntry struct {
+	Key         string
+	Value       []byte
+	ExpireAfter int64
+	Sliding     int64
+	Added       int64
+	Updated     int64
+	Version     int64
+}
+
+// IHyperLogLogCacheRepository is an interface for HyperLogLogCacheRepository.
+type IHyperLogLogCacheRepository interface {
+	GetAll() []HyperLogLogCacheDBEntry
+	SaveAll(newEntries []HyperLogLogCacheDBEntry, updatedEntries []HyperLogLogCacheDBEntry)
+}
+
+// HyperLogLogCacheRepository for persisting cache entries to MongoDB.
+type HyperLogLogCacheRepository struct {
+	Host    string
+	DBName  string
+	ColName string
+}
+
+// GetAll returns cache snapshot from DB.
+func (r HyperLogLogCacheRepository) GetAll() []HyperLogLogCacheDBEntry {
+	session, err := mgo.Dial(r.Host)
+	if err != nil {
+		panic(err)
+	}
+	defer session.Close()
+	c := session.DB(r.DBName).C(r.ColName)
+	var result []HyperLogLogCacheDBEntry
+	err = c.Find(bson.M{}).All(&result)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if result != nil {
+		log.Printf("[HyperLogLogCacheDBEntry] Read snapshot from %s.%s successfully.", r.DBName, r.ColName)
+	}
+	return result
+}
+
+// SaveAll saves cache snapshot to DB.
+func (r HyperLogLogCacheRepository) SaveAll(newEntries []HyperLogLogCacheDBEntry, updatedEntries []HyperLogLogCacheDBEntry) {
+	session, err := mgo.Dial(r.Host)
+	if err != nil {
+		panic(err)
+	}
+	defer session.Close()
+	c := session.DB(r.DBName).C(r.ColName)
+	c.EnsureIndexKey("key")
+	var existingKeys []string
+
+	for _, entry := range newEntries {
+		existingKeys = append(existingKeys, entry.Key)
+		e := c.Insert(entry)
+		if e != nil {
+			log.Fatal(err)
+		}
+	}
+
+	for _, entry := range updatedEntries {
+		existingKeys = append(existingKeys, entry.Key)
+		if entry.Updated > entry.Added {
+			e := c.Update(bson.M{"key": entry.Key}, bson.M{"$set": bson.M{"value": entry.Value, "updated": entry.Updated, "expireafter": entry.ExpireAfter, "sliding": entry.Sliding, "version": entry.Version}})
+			if e != nil {
+				log.Fatal(err)
+			}
+		}
+	}
+	if existingKeys == nil {
+		existingKeys = make([]string, 0)
+	}
+	_, e := c.RemoveAll(bson.M{"key": bson.M{"$nin": existingKeys}})
+	if e != nil {
+		log.Fatal(err)
+	}
+	log.Printf("[HyperLogLogCacheDBEntry] Persisted data to %s.%s successfully.", r.DBName, r.ColName)
+}
+
+// EmptyHyperLogLogCacheRepository for testing only.
+type EmptyHyperLogLogCacheRepository struct {
+}
+
+// GetAll returns empty cache.
+func (r EmptyHyperLogLogCacheRepository) GetAll() []HyperLogLogCacheDBEntry {
+	return make([]HyperLogLogCacheDBEntry, 0)
+}
+
+// SaveAll saves nothing.
+func (r EmptyHyperLogLogCacheRepository) SaveAll(newEntries []HyperLogLogCacheDBEntry, updatedEntries []HyperLogLogCacheDBEntry) {
+}
\ No newline at end of file
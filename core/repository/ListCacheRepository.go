@@ -11,8 +11,10 @@ type ListCacheDBEntry struct {
 	Key         string
 	Values      []string
 	ExpireAfter int64
+	Sliding     int64
 	Added       int64
 	Updated     int64
+	Version     int64
 }
 
 // IListCacheRepository is an interface for ListCacheRepository.
@@ -69,7 +71,7 @@ func (r ListCacheRepository) SaveAll(newEntries []ListCacheDBEntry, updatedEntri
 	for _, entry := range updatedEntries {
 		existingKeys = append(existingKeys, entry.Key)
 		if entry.Updated > entry.Added {
-			e := c.Update(bson.M{"key": entry.Key}, bson.M{"$set": bson.M{"values": entry.Values, "updated": entry.Updated}})
+			e := c.Update(bson.M{"key": entry.Key}, bson.M{"$set": bson.M{"values": entry.Values, "updated": entry.Updated, "expireafter": entry.ExpireAfter, "sliding": entry.Sliding, "version": entry.Version}})
 			if e != nil {
 				log.Fatal(err)
 			}
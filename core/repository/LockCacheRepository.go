@@ -0,0 +1,103 @@
+package repo
+
+import (
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+	"log"
+)
+
+// LockCacheDBEntry is a contract which is serialized to BSON and saved in MongoDB, empty owner means that the lock was released.
+type LockCacheDBEntry struct {
+	Key         string
+	Owner       string
+	Fence       int64
+	Acquired    int64
+	ExpireAfter int64
+	Sliding     int64
+	Added       int64
+	Updated     int64
+	Version     int64
+}
+
+// ILockCacheRepository is an interface for LockCacheRepository.
+type ILockCacheRepository interface {
+	GetAll() []LockCacheDBEntry
+	SaveAll(newEntries []LockCacheDBEntry, updatedEntries []LockCacheDBEntry)
+}
+
+// LockCacheRepository for persisting cache entries to MongoDB.
+type LockCacheRepository struct {
+	Host    string
+	DBName  string
+	ColName string
+}
+
+// GetAll returns cache snapshot from DB.
+func (r LockCacheRepository) GetAll() []LockCacheDBEntry {
+	session, err := mgo.Dial(r.Host)
+	if err != nil {
+		panic(err)
+	}
+	defer session.Close()
+	c := session.DB(r.DBName).C(r.ColName)
+	var result []LockCacheDBEntry
+	err = c.Find(bson.M{}).All(&result)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if result != nil {
+		log.Printf("[LockCacheDBEntry] Read snapshot from %s.%s successfully.", r.DBName, r.ColName)
+	}
+	return result
+}
+
+// SaveAll saves cache snapshot to DB.
+func (r LockCacheRepository) SaveAll(newEntries []LockCacheDBEntry, updatedEntries []LockCacheDBEntry) {
+	session, err := mgo.Dial(r.Host)
+	if err != nil {
+		panic(err)
+	}
+	defer session.Close()
+	c := session.DB(r.DBName).C(r.ColName)
+	c.EnsureIndexKey("key")
+	var existingKeys []string
+
+	for _, entry := range newEntries {
+		existingKeys = append(existingKeys, entry.Key)
+		e := c.Insert(entry)
+		if e != nil {
+			log.Fatal(err)
+		}
+	}
+
+	for _, entry := range updatedEntries {
+		existingKeys = append(existingKeys, entry.Key)
+		// The released lock keeps only its fence and has no timestamps, so every existing entry is updated.
+		e := c.Update(bson.M{"key": entry.Key}, bson.M{"$set": bson.M{"owner": entry.Owner, "fence": entry.Fence, "acquired": entry.Acquired, "updated": entry.Updated, "expireafter": entry.ExpireAfter, "sliding": entry.Sliding, "version": entry.Version}})
+		if e != nil {
+			log.Fatal(err)
+		}
+	}
+	if existingKeys == nil {
+		existingKeys = make([]string, 0)
+	}
+	_, e := c.RemoveAll(bson.M{"key": bson.M{"$nin": existingKeys}})
+	if e != nil {
+		log.Fatal(err)
+	}
+	log.Printf("[LockCacheDBEntry] Persisted data to %s.%s successfully.", r.DBName, r.ColName)
+}
+
+// EmptyLockCacheRepository for testing only.
+type EmptyLockCacheRepository struct {
+
+}
+
+// GetAll returns empty cache.
+func (r EmptyLockCacheRepository) GetAll() []LockCacheDBEntry {
+	return make([]LockCacheDBEntry, 0)
+}
+
+// SaveAll saves nothing.
+func (r EmptyLockCacheRepository) SaveAll(newEntries []LockCacheDBEntry, updatedEntries []LockCacheDBEntry) {
+}
\ No newline at end of file
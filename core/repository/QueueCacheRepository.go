@@ -0,0 +1,118 @@
+package repo
+
+import (
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+	"log"
+)
+
+// QueueItemDBEntry is an item of the priority queue saved in MongoDB, the times are in nanoseconds.
+type QueueItemDBEntry struct {
+	ID        int64
+	Value     string
+	Priority  int64
+	NotBefore int64
+	Enqueued  int64
+	Attempts  int64
+	Deadline  int64
+}
+
+// QueueCacheDBEntry is a contract which is serialized to BSON and saved in MongoDB.
+type QueueCacheDBEntry struct {
+	Key         string
+	Items       []QueueItemDBEntry
+	InFlight    []QueueItemDBEntry
+	Dead        []QueueItemDBEntry
+	NextID      int64
+	Visibility  int64
+	MaxAttempts int64
+	ExpireAfter int64
+	Sliding     int64
+	Added       int64
+	Updated     int64
+	Version     int64
+}
+
+// IQueueCacheRepository is an interface for QueueCacheRepository.
+type IQueueCacheRepository interface {
+	GetAll() []QueueCacheDBEntry
+	SaveAll(newEntries []QueueCacheDBEntry, updatedEntries []QueueCacheDBEntry)
+}
+
+// QueueCacheRepository for persisting cache entries to MongoDB.
+type QueueCacheRepository struct {
+	Host    string
+	DBName  string
+	ColName string
+}
+
+// GetAll returns cache snapshot from DB.
+func (r QueueCacheRepository) GetAll() []QueueCacheDBEntry {
+	session, err := mgo.Dial(r.Host)
+	if err != nil {
+		panic(err)
+	}
+	defer session.Close()
+	c := session.DB(r.DBName).C(r.ColName)
+	var result []QueueCacheDBEntry
+	err = c.Find(bson.M{}).All(&result)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if result != nil {
+		log.Printf("[QueueCacheDBEntry] Read snapshot from %s.%s successfully.", r.DBName, r.ColName)
+	}
+	return result
+}
+
+// SaveAll saves cache snapshot to DB.
+func (r QueueCacheRepository) SaveAll(newEntries []QueueCacheDBEntry, updatedEntries []QueueCacheDBEntry) {
+	session, err := mgo.Dial(r.Host)
+	if err != nil {
+		panic(err)
+	}
+	defer session.Close()
+	c := session.DB(r.DBName).C(r.ColName)
+	c.EnsureIndexKey("key")
+	var existingKeys []string
+
+	for _, entry := range newEntries {
+		existingKeys = append(existingKeys, entry.Key)
+		e := c.Insert(entry)
+		if e != nil {
+			log.Fatal(err)
+		}
+	}
+
+	for _, entry := range updatedEntries {
+		existingKeys = append(existingKeys, entry.Key)
+		if entry.Updated > entry.Added {
+			e := c.Update(bson.M{"key": entry.Key}, bson.M{"$set": bson.M{"items": entry.Items, "inflight": entry.InFlight, "dead": entry.Dead, "nextid": entry.NextID, "visibility": entry.Visibility, "maxattempts": entry.MaxAttempts, "updated": entry.Updated, "expireafter": entry.ExpireAfter, "sliding": entry.Sliding, "version": entry.Version}})
+			if e != nil {
+				log.Fatal(err)
+			}
+		}
+	}
+	if existingKeys == nil {
+		existingKeys = make([]string, 0)
+	}
+	_, e := c.RemoveAll(bson.M{"key": bson.M{"$nin": existingKeys}})
+	if e != nil {
+		log.Fatal(err)
+	}
+	log.Printf("[QueueCacheDBEntry] Persisted data to %s.%s successfully.", r.DBName, r.ColName)
+}
+
+// EmptyQueueCacheRepository for testing only.
+type EmptyQueueCacheRepository struct {
+
+}
+
+// GetAll returns empty cache.
+func (r EmptyQueueCacheRepository) GetAll() []QueueCacheDBEntry {
+	return make([]QueueCacheDBEntry, 0)
+}
+
+// SaveAll saves nothing.
+func (r EmptyQueueCacheRepository) SaveAll(newEntries []QueueCacheDBEntry, updatedEntries []QueueCacheDBEntry) {
+}
\ No newline at end of file
@@ -0,0 +1,114 @@
+package repo
+
+import (
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+	"log"
+)
+
+// RateLimitHitDBEntry is the cost taken from the sliding log saved in MongoDB, the time is in nanoseconds.
+type RateLimitHitDBEntry struct {
+	Time int64
+	Cost int64
+}
+
+// RateLimitCacheDBEntry is a contract which is serialized to BSON and saved in MongoDB.
+type RateLimitCacheDBEntry struct {
+	Key         string
+	Algorithm   string
+	Capacity    int64
+	RefillRate  float64
+	Window      int64
+	Tokens      float64
+	Refilled    int64
+	Log         []RateLimitHitDBEntry
+	ExpireAfter int64
+	Sliding     int64
+	Added       int64
+	Updated     int64
+	Version     int64
+}
+
+// IRateLimitCacheRepository is an interface for RateLimitCacheRepository.
+type IRateLimitCacheRepository interface {
+	GetAll() []RateLimitCacheDBEntry
+	SaveAll(newEntries []RateLimitCacheDBEntry, updatedEntries []RateLimitCacheDBEntry)
+}
+
+// RateLimitCacheRepository for persisting cache entries to MongoDB.
+type RateLimitCacheRepository struct {
+	Host    string
+	DBName  string
+	ColName string
+}
+
+// GetAll returns cache snapshot from DB.
+func (r RateLimitCacheRepository) GetAll() []RateLimitCacheDBEntry {
+	session, err := mgo.Dial(r.Host)
+	if err != nil {
+		panic(err)
+	}
+	defer session.Close()
+	c := session.DB(r.DBName).C(r.ColName)
+	var result []RateLimitCacheDBEntry
+	err = c.Find(bson.M{}).All(&result)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if result != nil {
+		log.Printf("[RateLimitCacheDBEntry] Read snapshot from %s.%s successfully.", r.DBName, r.ColName)
+	}
+	return result
+}
+
+// SaveAll saves cache snapshot to DB.
+func (r RateLimitCacheRepository) SaveAll(newEntries []RateLimitCacheDBEntry, updatedEntries []RateLimitCacheDBEntry) {
+	session, err := mgo.Dial(r.Host)
+	if err != nil {
+		panic(err)
+	}
+	defer session.Close()
+	c := session.DB(r.DBName).C(r.ColName)
+	c.EnsureIndexKey("key")
+	var existingKeys []string
+
+	for _, entry := range newEntries {
+		existingKeys = append(existingKeys, entry.Key)
+		e := c.Insert(entry)
+		if e != nil {
+			log.Fatal(err)
+		}
+	}
+
+	for _, entry := range updatedEntries {
+		existingKeys = append(existingKeys, entry.Key)
+		if entry.Updated > entry.Added {
+			e := c.Update(bson.M{"key": entry.Key}, bson.M{"$set": bson.M{"algorithm": entry.Algorithm, "capacity": entry.Capacity, "refillrate": entry.RefillRate, "window": entry.Window, "tokens": entry.Tokens, "refilled": entry.Refilled, "log": entry.Log, "updated": entry.Updated, "expireafter": entry.ExpireAfter, "sliding": entry.Sliding, "version": entry.Version}})
+			if e != nil {
+				log.Fatal(err)
+			}
+		}
+	}
+	if existingKeys == nil {
+		existingKeys = make([]string, 0)
+	}
+	_, e := c.RemoveAll(bson.M{"key": bson.M{"$nin": existingKeys}})
+	if e != nil {
+		log.Fatal(err)
+	}
+	log.Printf("[RateLimitCacheDBEntry] Persisted data to %s.%s successfully.", r.DBName, r.ColName)
+}
+
+// EmptyRateLimitCacheRepository for testing only.
+type EmptyRateLimitCacheRepository struct {
+
+}
+
+// GetAll returns empty cache.
+func (r EmptyRateLimitCacheRepository) GetAll() []RateLimitCacheDBEntry {
+	return make([]RateLimitCacheDBEntry, 0)
+}
+
+// SaveAll saves nothing.
+func (r EmptyRateLimitCacheRepository) SaveAll(newEntries []RateLimitCacheDBEntry, updatedEntries []RateLimitCacheDBEntry) {
+}
\ No newline at end of file
@@ -0,0 +1,101 @@
+package repo
+
+import (
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+	"log"
+)
+
+// SetCacheDBEntry is a contract which is serialized to BSON and saved in MongoDB.
+type SetCacheDBEntry struct {
+	Key         string
+	Values      []string
+	ExpireAfter int64
+	Sliding     int64
+	Added       int64
+	Updated     int64
+	Version     int64
+}
+
+// ISetCacheRepository is an interface for SetCacheRepository.
+type ISetCacheRepository interface {
+	GetAll() []SetCacheDBEntry
+	SaveAll(newEntries []SetCacheDBEntry, updatedEntries []SetCacheDBEntry)
+}
+
+// SetCacheRepository for persisting cache entries to MongoDB.
+type SetCacheRepository struct {
+	Host    string
+	DBName  string
+	ColName string
+}
+
+// GetAll returns cache snapshot from DB.
+func (r SetCacheRepository) GetAll() []SetCacheDBEntry {
+	session, err := mgo.Dial(r.Host)
+	if err != nil {
+		panic(err)
+	}
+	defer session.Close()
+	c := session.DB(r.DBName).C(r.ColName)
+	var result []SetCacheDBEntry
+	err = c.Find(bson.M{}).All(&result)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if result != nil {
+		log.Printf("[SetCacheDBEntry] Read snapshot from %s.%s successfully.", r.DBName, r.ColName)
+	}
+	return result
+}
+
+// SaveAll saves cache snapshot to DB.
+func (r SetCacheRepository) SaveAll(newEntries []SetCacheDBEntry, updatedEntries []SetCacheDBEntry) {
+	session, err := mgo.Dial(r.Host)
+	if err != nil {
+		panic(err)
+	}
+	defer session.Close()
+	c := session.DB(r.DBName).C(r.ColName)
+	c.EnsureIndexKey("key")
+	var existingKeys []string
+
+	for _, entry := range newEntries {
+		existingKeys = append(existingKeys, entry.Key)
+		e := c.Insert(entry)
+		if e != nil {
+			log.Fatal(err)
+		}
+	}
+
+	for _, entry := range updatedEntries {
+		existingKeys = append(existingKeys, entry.Key)
+		if entry.Updated > entry.Added {
+			e := c.Update(bson.M{"key": entry.Key}, bson.M{"$set": bson.M{"values": entry.Values, "updated": entry.Updated, "expireafter": entry.ExpireAfter, "sliding": entry.Sliding, "version": entry.Version}})
+			if e != nil {
+				log.Fatal(err)
+			}
+		}
+	}
+	if existingKeys == nil {
+		existingKeys = make([]string, 0)
+	}
+	_, e := c.RemoveAll(bson.M{"key": bson.M{"$nin": existingKeys}})
+	if e != nil {
+		log.Fatal(err)
+	}
+	log.Printf("[SetCacheDBEntry] Persisted data to %s.%s successfully.", r.DBName, r.ColName)
+}
+
+// EmptySetCacheRepository for testing only.
+type EmptySetCacheRepository struct {
+}
+
+// GetAll returns empty cache.
+func (r EmptySetCacheRepository) GetAll() []SetCacheDBEntry {
+	return make([]SetCacheDBEntry, 0)
+}
+
+// SaveAll saves nothing.
+func (r EmptySetCacheRepository) SaveAll(newEntries []SetCacheDBEntry, updatedEntries []SetCacheDBEntry) {
+}
\ No newline at end of file
@@ -0,0 +1,107 @@
+package repo
+
+import (
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+	"log"
+)
+
+// SortedSetCacheDBEntry is a contract which is serialized to BSON and saved in MongoDB.
+type SortedSetCacheDBEntry struct {
+	Key         string
+	Members     []SortedSetMemberDBEntry
+	ExpireAfter int64
+	Sliding     int64
+	Added       int64
+	Updated     int64
+	Version     int64
+}
+
+// SortedSetMemberDBEntry is a sorted set member with its score saved in MongoDB.
+type SortedSetMemberDBEntry struct {
+	Member string
+	Score  float64
+}
+
+// ISortedSetCacheRepository is an interface for SortedSetCacheRepository.
+type ISortedSetCacheRepository interface {
+	GetAll() []SortedSetCacheDBEntry
+	SaveAll(newEntries []SortedSetCacheDBEntry, updatedEntries []SortedSetCacheDBEntry)
+}
+
+// SortedSetCacheRepository for persisting cache entries to MongoDB.
+type SortedSetCacheRepository struct {
+	Host    string
+	DBName  string
+	ColName string
+}
+
+// GetAll returns cache snapshot from DB.
+func (r SortedSetCacheRepository) GetAll() []SortedSetCacheDBEntry {
+	session, err := mgo.Dial(r.Host)
+	if err != nil {
+		panic(err)
+	}
+	defer session.Close()
+	c := session.DB(r.DBName).C(r.ColName)
+	var result []SortedSetCacheDBEntry
+	err = c.Find(bson.M{}).All(&result)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if result != nil {
+		log.Printf("[SortedSetCacheDBEntry] Read snapshot from %s.%s successfully.", r.DBName, r.ColName)
+	}
+	return result
+}
+
+// SaveAll saves cache snapshot to DB.
+func (r SortedSetCacheRepository) SaveAll(newEntries []SortedSetCacheDBEntry, updatedEntries []SortedSetCacheDBEntry) {
+	session, err := mgo.Dial(r.Host)
+	if err != nil {
+		panic(err)
+	}
+	defer session.Close()
+	c := session.DB(r.DBName).C(r.ColName)
+	c.EnsureIndexKey("key")
+	var existingKeys []string
+
+	for _, entry := range newEntries {
+		existingKeys = append(existingKeys, entry.Key)
+		e := c.Insert(entry)
+		if e != nil {
+			log.Fatal(err)
+		}
+	}
+
+	for _, entry := range updatedEntries {
+		existingKeys = append(existingKeys, entry.Key)
+		if entry.Updated > entry.Added {
+			e := c.Update(bson.M{"key": entry.Key}, bson.M{"$set": bson.M{"members": entry.Members, "updated": entry.Updated, "expireafter": entry.ExpireAfter, "sliding": entry.Sliding, "version": entry.Version}})
+			if e != nil {
+				log.Fatal(err)
+			}
+		}
+	}
+	if existingKeys == nil {
+		existingKeys = make([]string, 0)
+	}
+	_, e := c.RemoveAll(bson.M{"key": bson.M{"$nin": existingKeys}})
+	if e != nil {
+		log.Fatal(err)
+	}
+	log.Printf("[SortedSetCacheDBEntry] Persisted data to %s.%s successfully.", r.DBName, r.ColName)
+}
+
+// EmptySortedSetCacheRepository for testing only.
+type EmptySortedSetCacheRepository struct {
+}
+
+// GetAll returns empty cache.
+func (r EmptySortedSetCacheRepository) GetAll() []SortedSetCacheDBEntry {
+	return make([]SortedSetCacheDBEntry, 0)
+}
+
+// SaveAll saves nothing.
+func (r EmptySortedSetCacheRepository) SaveAll(newEntries []SortedSetCacheDBEntry, updatedEntries []SortedSetCacheDBEntry) {
+}
\ No newline at end of file
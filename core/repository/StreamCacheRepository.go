@@ -0,0 +1,138 @@
+package repo
+
+import (
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+	"log"
+)
+
+// StreamCacheDBEntry is a contract which is serialized to BSON and saved in MongoDB.
+type StreamCacheDBEntry struct {
+	Key         string
+	Entries     []StreamEntryDBEntry
+	LastID      string
+	MaxLen      int64
+	Groups      []StreamGroupDBEntry
+	ExpireAfter int64
+	Sliding     int64
+	Added       int64
+	Updated     int64
+	Version     int64
+}
+
+// StreamEntryDBEntry is a stream entry saved in MongoDB, the fields are kept as a list to keep their order.
+type StreamEntryDBEntry struct {
+	ID     string
+	Fields []StreamFieldDBEntry
+}
+
+// StreamFieldDBEntry is a field of stream entry saved in MongoDB.
+type StreamFieldDBEntry struct {
+	Key   string
+	Value string
+}
+
+// StreamGroupDBEntry is a consumer group of the stream saved in MongoDB.
+type StreamGroupDBEntry struct {
+	Name          string
+	LastDelivered string
+	Pending       []StreamPendingDBEntry
+	Consumers     []StreamConsumerDBEntry
+}
+
+// StreamPendingDBEntry is a pending entry of the consumer group saved in MongoDB.
+type StreamPendingDBEntry struct {
+	ID         string
+	Consumer   string
+	Delivered  int64
+	Deliveries int64
+}
+
+// StreamConsumerDBEntry is a consumer of the group with the time it was seen last saved in MongoDB.
+type StreamConsumerDBEntry struct {
+	Name string
+	Seen int64
+}
+
+// IStreamCacheRepository is an interface for StreamCacheRepository.
+type IStreamCacheRepository interface {
+	GetAll() []StreamCacheDBEntry
+	SaveAll(newEntries []StreamCacheDBEntry, updatedEntries []StreamCacheDBEntry)
+}
+
+// StreamCacheRepository for persisting cache entries to MongoDB.
+type StreamCacheRepository struct {
+	Host    string
+	DBName  string
+	ColName string
+}
+
+// GetAll returns cache snapshot from DB.
+func (r StreamCacheRepository) GetAll() []StreamCacheDBEntry {
+	session, err := mgo.Dial(r.Host)
+	if err != nil {
+		panic(err)
+	}
+	defer session.Close()
+	c := session.DB(r.DBName).C(r.ColName)
+	var result []StreamCacheDBEntry
+	err = c.Find(bson.M{}).All(&result)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if result != nil {
+		log.Printf("[StreamCacheDBEntry] Read snapshot from %s.%s successfully.", r.DBName, r.ColName)
+	}
+	return result
+}
+
+// SaveAll saves cache snapshot to DB.
+func (r StreamCacheRepository) SaveAll(newEntries []StreamCacheDBEntry, updatedEntries []StreamCacheDBEntry) {
+	session, err := mgo.Dial(r.Host)
+	if err != nil {
+		panic(err)
+	}
+	defer session.Close()
+	c := session.DB(r.DBName).C(r.ColName)
+	c.EnsureIndexKey("key")
+	var existingKeys []string
+
+	for _, entry := range newEntries {
+		existingKeys = append(existingKeys, entry.Key)
+		e := c.Insert(entry)
+		if e != nil {
+			log.Fatal(err)
+		}
+	}
+
+	for _, entry := range updatedEntries {
+		existingKeys = append(existingKeys, entry.Key)
+		if entry.Updated > entry.Added {
+			e := c.Update(bson.M{"key": entry.Key}, bson.M{"$set": bson.M{"entries": entry.Entries, "lastid": entry.LastID, "maxlen": entry.MaxLen, "groups": entry.Groups, "updated": entry.Updated, "expireafter": entry.ExpireAfter, "sliding": entry.Sliding, "version": entry.Version}})
+			if e != nil {
+				log.Fatal(err)
+			}
+		}
+	}
+	if existingKeys == nil {
+		existingKeys = make([]string, 0)
+	}
+	_, e := c.RemoveAll(bson.M{"key": bson.M{"$nin": existingKeys}})
+	if e != nil {
+		log.Fatal(err)
+	}
+	log.Printf("[StreamCacheDBEntry] Persisted data to %s.%s successfully.", r.DBName, r.ColName)
+}
+
+// EmptyStreamCacheRepository for testing only.
+type EmptyStreamCacheRepository struct {
+}
+
+// GetAll returns empty cache.
+func (r EmptyStreamCacheRepository) GetAll() []StreamCacheDBEntry {
+	return make([]StreamCacheDBEntry, 0)
+}
+
+// SaveAll saves nothing.
+func (r EmptyStreamCacheRepository) SaveAll(newEntries []StreamCacheDBEntry, updatedEntries []StreamCacheDBEntry) {
+}
\ No newline at end of file
@@ -7,12 +7,18 @@ import (
 )
 
 // StringCacheDBEntry is a contract which is serialized to BSON and saved in MongoDB.
+// Binary values are saved to Data as BSON binary data since BSON strings must be valid UTF-8.
 type StringCacheDBEntry struct {
 	Key         string
 	Value       string
+	Data        []byte
+	ContentType string
+	Flags       uint32
 	ExpireAfter int64
+	Sliding     int64
 	Added       int64
 	Updated     int64
+	Version     int64
 }
 
 // IStringCacheRepository is an interface for StringCacheRepository.
@@ -69,7 +75,7 @@ func (r StringCacheRepository) SaveAll(newEntries []StringCacheDBEntry, updatedE
 	for _, entry := range updatedEntries {
 		existingKeys = append(existingKeys, entry.Key)
 		if entry.Updated > entry.Added {
-			e := c.Update(bson.M{"key": entry.Key}, bson.M{"$set": bson.M{"value": entry.Value, "updated": entry.Updated}})
+			e := c.Update(bson.M{"key": entry.Key}, bson.M{"$set": bson.M{"value": entry.Value, "data": entry.Data, "contenttype": entry.ContentType, "flags": entry.Flags, "updated": entry.Updated, "expireafter": entry.ExpireAfter, "sliding": entry.Sliding, "version": entry.Version}})
 			if e != nil {
 				log.Fatal(err)
 			}
@@ -4,36 +4,100 @@ import (
 	"flag"
 	"fmt"
 	"log"
+	"time"
 	_ "github.com/AsynkronIT/goconsole"
 	"github.com/AsynkronIT/protoactor-go/remote"
 	"github.com/VitalKrasilnikau/memcache/core/actors"
+	"github.com/VitalKrasilnikau/memcache/core/cache"
 )
 
 var (
 	port = flag.Int("port", 1, "port to start the node at")
-	nodeType = flag.String("type", "", "node type: string, list or dictionary")
+	nodeType = flag.String("type", "", "node type: string, list, dictionary, set, sortedset, document, hyperloglog, bloom, stream, geo, queue, ratelimit or lock")
 	nodeIndex = flag.Int("index", 1, "node index in cluster")
 	usePersistence = flag.Bool("persist", true, "use DB persistence")
+	maxEntries = flag.Int("max-entries", 0, "max number of keys in the node, 0 means no limit")
+	maxBytes = flag.Int64("max-bytes", 0, "max size of keys and values in the node, 0 means no limit")
+	evictionPolicy = flag.String("eviction", "lru", "eviction policy: lru, lfu, random, volatile-ttl or noeviction")
+	sweepInterval = flag.Duration("sweep-interval", time.Second, "how often expired keys are removed in background, 0 turns the sweep off")
+	sweepBudget = flag.Duration("sweep-budget", 10*time.Millisecond, "max time of one sweep, 0 means no limit")
 )
 
 func main() {
 	flag.Parse()
 	p := fmt.Sprintf("127.0.0.1:%d", *port)
+	policy, err := cache.ParseEvictionPolicy(*evictionPolicy)
+	if err != nil {
+		log.Fatal(err)
+	}
+	options := cache.Options{
+		Eviction: cache.EvictionOptions{MaxEntries: *maxEntries, MaxBytes: *maxBytes, Policy: policy},
+		Sweep:    cache.SweepOptions{Interval: *sweepInterval, Budget: *sweepBudget}}
 	started := false
 	switch *nodeType {
 	case "string":
 		remote.Start(p)
-		act.NewStringCacheActor("memcache", *nodeIndex, *usePersistence)
+		act.NewStringCacheActor("memcache", *nodeIndex, *usePersistence, options)
 		started = true
 		break
 	case "list":
 		remote.Start(p)
-		act.NewListCacheActor("memcache", *nodeIndex, *usePersistence)
+		act.NewListCacheActor("memcache", *nodeIndex, *usePersistence, options)
 		started = true
 		break
 	case "dictionary":
 		remote.Start(p)
-		act.NewDictionaryCacheActor("memcache", *nodeIndex, *usePersistence)
+		act.NewDictionaryCacheActor("memcache", *nodeIndex, *usePersistence, options)
+		started = true
+		break
+	case "set":
+		remote.Start(p)
+		act.NewSetCacheActor("memcache", *nodeIndex, *usePersistence, options)
+		started = true
+		break
+	case "sortedset":
+		remote.Start(p)
+		act.NewSortedSetCacheActor("memcache", *nodeIndex, *usePersistence, options)
+		started = true
+		break
+	case "document":
+		remote.Start(p)
+		act.NewDocumentCacheActor("memcache", *nodeIndex, *usePersistence, options)
+		started = true
+		break
+	case "hyperloglog":
+		remote.Start(p)
+		act.NewHyperLogLogCacheActor("memcache", *nodeIndex, *usePersistence, options)
+		started = true
+		break
+	case "bloom":
+		remote.Start(p)
+		act.NewBloomFilterCacheActor("memcache", *nodeIndex, *usePersistence, options)
+		started = true
+		break
+	case "stream":
+		remote.Start(p)
+		act.NewStreamCacheActor("memcache", *nodeIndex, *usePersistence, options)
+		started = true
+		break
+	case "geo":
+		remote.Start(p)
+		act.NewGeoCacheActor("memcache", *nodeIndex, *usePersistence, options)
+		started = true
+		break
+	case "queue":
+		remote.Start(p)
+		act.NewQueueCacheActor("memcache", *nodeIndex, *usePersistence, options)
+		started = true
+		break
+	case "ratelimit":
+		remote.Start(p)
+		act.NewRateLimitCacheActor("memcache", *nodeIndex, *usePersistence, options)
+		started = true
+		break
+	case "lock":
+		remote.Start(p)
+		act.NewLockCacheActor("memcache", *nodeIndex, *usePersistence, options)
 		started = true
 		break
 	}
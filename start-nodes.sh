@@ -10,4 +10,44 @@ done
 for i in {0..10}
 do
     ./memcache-node -port "$(($i + 60000))" -type "dictionary" -index $i &
+done
+for i in {0..10}
+do
+    ./memcache-node -port "$(($i + 61000))" -type "set" -index $i &
+done
+for i in {0..10}
+do
+    ./memcache-node -port "$(($i + 62000))" -type "sortedset" -index $i &
+done
+for i in {0..10}
+do
+    ./memcache-node -port "$(($i + 63000))" -type "document" -index $i &
+done
+for i in {0..10}
+do
+    ./memcache-node -port "$(($i + 64000))" -type "hyperloglog" -index $i &
+done
+for i in {0..10}
+do
+    ./memcache-node -port "$(($i + 65000))" -type "bloom" -index $i &
+done
+for i in {0..10}
+do
+    ./memcache-node -port "$(($i + 57000))" -type "stream" -index $i &
+done
+for i in {0..10}
+do
+    ./memcache-node -port "$(($i + 56000))" -type "geo" -index $i &
+done
+for i in {0..10}
+do
+    ./memcache-node -port "$(($i + 55000))" -type "queue" -index $i &
+done
+for i in {0..10}
+do
+    ./memcache-node -port "$(($i + 54000))" -type "ratelimit" -index $i &
+done
+for i in {0..10}
+do
+    ./memcache-node -port "$(($i + 53000))" -type "lock" -index $i &
 done
\ No newline at end of file